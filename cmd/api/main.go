@@ -13,8 +13,11 @@ import (
 	"base-website/internal/services"
 	configservice "base-website/internal/services/config"
 	databaseservice "base-website/internal/services/database"
+	janitorservice "base-website/internal/services/janitor"
 	openidservice "base-website/internal/services/openid"
+	ratelimitservice "base-website/internal/services/ratelimit"
 	rbacservice "base-website/internal/services/rbac"
+	sanctionsservice "base-website/internal/services/sanctions"
 	"base-website/pkg/logger"
 	"base-website/spa"
 
@@ -46,6 +49,9 @@ func apiBootstrap() {
 
 	loggerAPIBootstrap.Info("Services initialized")
 
+	// Services are lazy, the janitor has to be invoked to schedule its jobs
+	do.MustInvoke[janitorservice.JanitorService](injector)
+
 	router := chi.NewRouter()
 	spa, err := spa.NewSPAHandler("public", "index.html", spa.SPAFS)
 	if err != nil {
@@ -78,30 +84,61 @@ func apiMux(
 		humaConfig = openapi.WithOverviewDoc(humaConfig)
 		humaConfig = openapi.WithServers(humaConfig, config)
 		api := humachi.New(router, humaConfig)
+		trustedProxies, err := middlewares.ParseTrustedProxies(config.TrustedProxies)
+		if err != nil {
+			loggerAPIBootstrap.Fatal("Failed to parse TRUSTED_PROXIES: %v", err)
+		}
 		api.UseMiddleware(
 			middlewares.CorsMiddleware(),
+			middlewares.RequestInfoMiddleware(trustedProxies),
 			middlewares.NewAuthMiddleware(
 				api,
 				do.MustInvoke[configservice.ConfigService](injector),
 				do.MustInvoke[databaseservice.DatabaseService](injector),
 				do.MustInvoke[openidservice.OpenIDService](injector),
 			),
-			middlewares.NewRbacMiddleware(
+			middlewares.NewRolesMiddleware(
 				api,
 				do.MustInvoke[databaseservice.DatabaseService](injector),
 				do.MustInvoke[rbacservice.RBACService](injector),
 			),
+			middlewares.NewRateLimitMiddleware(
+				api,
+				do.MustInvoke[ratelimitservice.RateLimitService](injector),
+			),
+			middlewares.NewRbacMiddleware(
+				api,
+				do.MustInvoke[rbacservice.RBACService](injector),
+			),
+			middlewares.NewSanctionsMiddleware(
+				api,
+				do.MustInvoke[sanctionsservice.SanctionsService](injector),
+			),
 			middlewares.RequestLoggerMiddleware(
 				logger.New().WithContext("ReqLogMiddleware"),
 			))
 
-		err := controllers.ControllersInit(api, injector)
+		err = controllers.ControllersInit(api, injector)
 		if err != nil {
 			log.Fatalf("Failed to initialize controllers: %v", err)
 		}
 
 		loggerAPIBootstrap.Info("Controllers initialized")
 
+		err = do.MustInvoke[rbacservice.RBACService](injector).Validate(
+			rbacservice.RoutesFromOpenAPI(api.OpenAPI()),
+		)
+		if err != nil {
+			loggerAPIBootstrap.Fatal("Invalid RBAC policy: %v", err)
+		}
+
+		err = do.MustInvoke[ratelimitservice.RateLimitService](injector).Validate(
+			ratelimitservice.RoutesFromOpenAPI(api.OpenAPI()),
+		)
+		if err != nil {
+			loggerAPIBootstrap.Fatal("Invalid rate limits: %v", err)
+		}
+
 		router.Get("/docs", openapi.ScalarDocHandler(config))
 
 		// Generate api spec after routes are initialized
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	rbacservice "base-website/internal/services/rbac"
+	"base-website/pkg/logger"
+	"base-website/pkg/rbac"
+
+	"gopkg.in/yaml.v3"
+)
+
+const usage = `Usage: rbac <command> [flags]
+
+Commands:
+  validate    Check the RBAC policy: inheritance cycles, unknown inherited roles
+              and permission paths matching no operation of the OpenAPI spec
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	switch os.Args[1] {
+	case "validate":
+		validate(os.Args[2:])
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+}
+
+// validate checks the policy against the spec written by the API at startup
+func validate(args []string) {
+	loggerRBAC := logger.New().WithContext("RBAC")
+	flags := flag.NewFlagSet("validate", flag.ExitOnError)
+	policyPath := flags.String("policy", "./configs/rbac.yaml", "path of the RBAC policy")
+	specPath := flags.String("spec", "./docs/42lan-api.yaml", "path of the OpenAPI spec, empty to skip the path checks")
+	_ = flags.Parse(args)
+
+	var routes []rbac.Route
+	if *specPath != "" {
+		var err error
+		routes, err = routesFromSpec(*specPath)
+		if err != nil {
+			loggerRBAC.Fatal("Failed to read the OpenAPI spec: %v", err)
+		}
+	}
+
+	if _, err := rbacservice.Load(*policyPath, routes); err != nil {
+		loggerRBAC.Fatal("%v", err)
+	}
+	loggerRBAC.Info("%s is valid", *policyPath)
+}
+
+func routesFromSpec(path string) ([]rbac.Route, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var spec struct {
+		Paths map[string]map[string]any `yaml:"paths"`
+	}
+	if err := yaml.Unmarshal(content, &spec); err != nil {
+		return nil, err
+	}
+	routes := make([]rbac.Route, 0)
+	for path, item := range spec.Paths {
+		for method := range item {
+			routes = append(routes, rbac.Route{Method: strings.ToUpper(method), Path: path})
+		}
+	}
+	return routes, nil
+}
@@ -39,6 +39,10 @@ type App struct {
 	OwnerID int `json:"owner_id,omitempty"`
 	// Roles holds the value of the "roles" field.
 	Roles []string `json:"roles,omitempty"`
+	// AccessTokenLifetime holds the value of the "access_token_lifetime" field.
+	AccessTokenLifetime *int `json:"access_token_lifetime,omitempty"`
+	// RefreshTokenLifetime holds the value of the "refresh_token_lifetime" field.
+	RefreshTokenLifetime *int `json:"refresh_token_lifetime,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AppQuery when eager-loading is set.
 	Edges        AppEdges `json:"edges"`
@@ -85,7 +89,7 @@ func (*App) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case app.FieldImplicitConsent:
 			values[i] = new(sql.NullBool)
-		case app.FieldOwnerID:
+		case app.FieldOwnerID, app.FieldAccessTokenLifetime, app.FieldRefreshTokenLifetime:
 			values[i] = new(sql.NullInt64)
 		case app.FieldID, app.FieldSecret, app.FieldName, app.FieldDescription:
 			values[i] = new(sql.NullString)
@@ -177,6 +181,20 @@ func (_m *App) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field roles: %w", err)
 				}
 			}
+		case app.FieldAccessTokenLifetime:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field access_token_lifetime", values[i])
+			} else if value.Valid {
+				_m.AccessTokenLifetime = new(int)
+				*_m.AccessTokenLifetime = int(value.Int64)
+			}
+		case app.FieldRefreshTokenLifetime:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field refresh_token_lifetime", values[i])
+			} else if value.Valid {
+				_m.RefreshTokenLifetime = new(int)
+				*_m.RefreshTokenLifetime = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -254,6 +272,16 @@ func (_m *App) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("roles=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Roles))
+	builder.WriteString(", ")
+	if v := _m.AccessTokenLifetime; v != nil {
+		builder.WriteString("access_token_lifetime=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RefreshTokenLifetime; v != nil {
+		builder.WriteString("refresh_token_lifetime=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
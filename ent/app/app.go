@@ -34,6 +34,10 @@ const (
 	FieldOwnerID = "owner_id"
 	// FieldRoles holds the string denoting the roles field in the database.
 	FieldRoles = "roles"
+	// FieldAccessTokenLifetime holds the string denoting the access_token_lifetime field in the database.
+	FieldAccessTokenLifetime = "access_token_lifetime"
+	// FieldRefreshTokenLifetime holds the string denoting the refresh_token_lifetime field in the database.
+	FieldRefreshTokenLifetime = "refresh_token_lifetime"
 	// EdgeOwner holds the string denoting the owner edge name in mutations.
 	EdgeOwner = "owner"
 	// EdgeConsents holds the string denoting the consents edge name in mutations.
@@ -69,6 +73,8 @@ var Columns = []string{
 	FieldLastLoginAt,
 	FieldOwnerID,
 	FieldRoles,
+	FieldAccessTokenLifetime,
+	FieldRefreshTokenLifetime,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -140,6 +146,16 @@ func ByOwnerID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOwnerID, opts...).ToFunc()
 }
 
+// ByAccessTokenLifetime orders the results by the access_token_lifetime field.
+func ByAccessTokenLifetime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccessTokenLifetime, opts...).ToFunc()
+}
+
+// ByRefreshTokenLifetime orders the results by the refresh_token_lifetime field.
+func ByRefreshTokenLifetime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefreshTokenLifetime, opts...).ToFunc()
+}
+
 // ByOwnerField orders the results by owner field.
 func ByOwnerField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -105,6 +105,16 @@ func OwnerID(v int) predicate.App {
 	return predicate.App(sql.FieldEQ(FieldOwnerID, v))
 }
 
+// AccessTokenLifetime applies equality check predicate on the "access_token_lifetime" field. It's identical to AccessTokenLifetimeEQ.
+func AccessTokenLifetime(v int) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldAccessTokenLifetime, v))
+}
+
+// RefreshTokenLifetime applies equality check predicate on the "refresh_token_lifetime" field. It's identical to RefreshTokenLifetimeEQ.
+func RefreshTokenLifetime(v int) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldRefreshTokenLifetime, v))
+}
+
 // SecretEQ applies the EQ predicate on the "secret" field.
 func SecretEQ(v string) predicate.App {
 	return predicate.App(sql.FieldEQ(FieldSecret, v))
@@ -460,6 +470,106 @@ func OwnerIDNotIn(vs ...int) predicate.App {
 	return predicate.App(sql.FieldNotIn(FieldOwnerID, vs...))
 }
 
+// AccessTokenLifetimeEQ applies the EQ predicate on the "access_token_lifetime" field.
+func AccessTokenLifetimeEQ(v int) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldAccessTokenLifetime, v))
+}
+
+// AccessTokenLifetimeNEQ applies the NEQ predicate on the "access_token_lifetime" field.
+func AccessTokenLifetimeNEQ(v int) predicate.App {
+	return predicate.App(sql.FieldNEQ(FieldAccessTokenLifetime, v))
+}
+
+// AccessTokenLifetimeIn applies the In predicate on the "access_token_lifetime" field.
+func AccessTokenLifetimeIn(vs ...int) predicate.App {
+	return predicate.App(sql.FieldIn(FieldAccessTokenLifetime, vs...))
+}
+
+// AccessTokenLifetimeNotIn applies the NotIn predicate on the "access_token_lifetime" field.
+func AccessTokenLifetimeNotIn(vs ...int) predicate.App {
+	return predicate.App(sql.FieldNotIn(FieldAccessTokenLifetime, vs...))
+}
+
+// AccessTokenLifetimeGT applies the GT predicate on the "access_token_lifetime" field.
+func AccessTokenLifetimeGT(v int) predicate.App {
+	return predicate.App(sql.FieldGT(FieldAccessTokenLifetime, v))
+}
+
+// AccessTokenLifetimeGTE applies the GTE predicate on the "access_token_lifetime" field.
+func AccessTokenLifetimeGTE(v int) predicate.App {
+	return predicate.App(sql.FieldGTE(FieldAccessTokenLifetime, v))
+}
+
+// AccessTokenLifetimeLT applies the LT predicate on the "access_token_lifetime" field.
+func AccessTokenLifetimeLT(v int) predicate.App {
+	return predicate.App(sql.FieldLT(FieldAccessTokenLifetime, v))
+}
+
+// AccessTokenLifetimeLTE applies the LTE predicate on the "access_token_lifetime" field.
+func AccessTokenLifetimeLTE(v int) predicate.App {
+	return predicate.App(sql.FieldLTE(FieldAccessTokenLifetime, v))
+}
+
+// AccessTokenLifetimeIsNil applies the IsNil predicate on the "access_token_lifetime" field.
+func AccessTokenLifetimeIsNil() predicate.App {
+	return predicate.App(sql.FieldIsNull(FieldAccessTokenLifetime))
+}
+
+// AccessTokenLifetimeNotNil applies the NotNil predicate on the "access_token_lifetime" field.
+func AccessTokenLifetimeNotNil() predicate.App {
+	return predicate.App(sql.FieldNotNull(FieldAccessTokenLifetime))
+}
+
+// RefreshTokenLifetimeEQ applies the EQ predicate on the "refresh_token_lifetime" field.
+func RefreshTokenLifetimeEQ(v int) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldRefreshTokenLifetime, v))
+}
+
+// RefreshTokenLifetimeNEQ applies the NEQ predicate on the "refresh_token_lifetime" field.
+func RefreshTokenLifetimeNEQ(v int) predicate.App {
+	return predicate.App(sql.FieldNEQ(FieldRefreshTokenLifetime, v))
+}
+
+// RefreshTokenLifetimeIn applies the In predicate on the "refresh_token_lifetime" field.
+func RefreshTokenLifetimeIn(vs ...int) predicate.App {
+	return predicate.App(sql.FieldIn(FieldRefreshTokenLifetime, vs...))
+}
+
+// RefreshTokenLifetimeNotIn applies the NotIn predicate on the "refresh_token_lifetime" field.
+func RefreshTokenLifetimeNotIn(vs ...int) predicate.App {
+	return predicate.App(sql.FieldNotIn(FieldRefreshTokenLifetime, vs...))
+}
+
+// RefreshTokenLifetimeGT applies the GT predicate on the "refresh_token_lifetime" field.
+func RefreshTokenLifetimeGT(v int) predicate.App {
+	return predicate.App(sql.FieldGT(FieldRefreshTokenLifetime, v))
+}
+
+// RefreshTokenLifetimeGTE applies the GTE predicate on the "refresh_token_lifetime" field.
+func RefreshTokenLifetimeGTE(v int) predicate.App {
+	return predicate.App(sql.FieldGTE(FieldRefreshTokenLifetime, v))
+}
+
+// RefreshTokenLifetimeLT applies the LT predicate on the "refresh_token_lifetime" field.
+func RefreshTokenLifetimeLT(v int) predicate.App {
+	return predicate.App(sql.FieldLT(FieldRefreshTokenLifetime, v))
+}
+
+// RefreshTokenLifetimeLTE applies the LTE predicate on the "refresh_token_lifetime" field.
+func RefreshTokenLifetimeLTE(v int) predicate.App {
+	return predicate.App(sql.FieldLTE(FieldRefreshTokenLifetime, v))
+}
+
+// RefreshTokenLifetimeIsNil applies the IsNil predicate on the "refresh_token_lifetime" field.
+func RefreshTokenLifetimeIsNil() predicate.App {
+	return predicate.App(sql.FieldIsNull(FieldRefreshTokenLifetime))
+}
+
+// RefreshTokenLifetimeNotNil applies the NotNil predicate on the "refresh_token_lifetime" field.
+func RefreshTokenLifetimeNotNil() predicate.App {
+	return predicate.App(sql.FieldNotNull(FieldRefreshTokenLifetime))
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.App {
 	return predicate.App(func(s *sql.Selector) {
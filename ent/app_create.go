@@ -106,6 +106,34 @@ func (_c *AppCreate) SetRoles(v []string) *AppCreate {
 	return _c
 }
 
+// SetAccessTokenLifetime sets the "access_token_lifetime" field.
+func (_c *AppCreate) SetAccessTokenLifetime(v int) *AppCreate {
+	_c.mutation.SetAccessTokenLifetime(v)
+	return _c
+}
+
+// SetNillableAccessTokenLifetime sets the "access_token_lifetime" field if the given value is not nil.
+func (_c *AppCreate) SetNillableAccessTokenLifetime(v *int) *AppCreate {
+	if v != nil {
+		_c.SetAccessTokenLifetime(*v)
+	}
+	return _c
+}
+
+// SetRefreshTokenLifetime sets the "refresh_token_lifetime" field.
+func (_c *AppCreate) SetRefreshTokenLifetime(v int) *AppCreate {
+	_c.mutation.SetRefreshTokenLifetime(v)
+	return _c
+}
+
+// SetNillableRefreshTokenLifetime sets the "refresh_token_lifetime" field if the given value is not nil.
+func (_c *AppCreate) SetNillableRefreshTokenLifetime(v *int) *AppCreate {
+	if v != nil {
+		_c.SetRefreshTokenLifetime(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *AppCreate) SetID(v string) *AppCreate {
 	_c.mutation.SetID(v)
@@ -284,6 +312,14 @@ func (_c *AppCreate) createSpec() (*App, *sqlgraph.CreateSpec) {
 		_spec.SetField(app.FieldRoles, field.TypeJSON, value)
 		_node.Roles = value
 	}
+	if value, ok := _c.mutation.AccessTokenLifetime(); ok {
+		_spec.SetField(app.FieldAccessTokenLifetime, field.TypeInt, value)
+		_node.AccessTokenLifetime = &value
+	}
+	if value, ok := _c.mutation.RefreshTokenLifetime(); ok {
+		_spec.SetField(app.FieldRefreshTokenLifetime, field.TypeInt, value)
+		_node.RefreshTokenLifetime = &value
+	}
 	if nodes := _c.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
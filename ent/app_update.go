@@ -21,8 +21,9 @@ import (
 // AppUpdate is the builder for updating App entities.
 type AppUpdate struct {
 	config
-	hooks    []Hook
-	mutation *AppMutation
+	hooks     []Hook
+	mutation  *AppMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the AppUpdate builder.
@@ -151,6 +152,60 @@ func (_u *AppUpdate) AppendRoles(v []string) *AppUpdate {
 	return _u
 }
 
+// SetAccessTokenLifetime sets the "access_token_lifetime" field.
+func (_u *AppUpdate) SetAccessTokenLifetime(v int) *AppUpdate {
+	_u.mutation.ResetAccessTokenLifetime()
+	_u.mutation.SetAccessTokenLifetime(v)
+	return _u
+}
+
+// SetNillableAccessTokenLifetime sets the "access_token_lifetime" field if the given value is not nil.
+func (_u *AppUpdate) SetNillableAccessTokenLifetime(v *int) *AppUpdate {
+	if v != nil {
+		_u.SetAccessTokenLifetime(*v)
+	}
+	return _u
+}
+
+// AddAccessTokenLifetime adds value to the "access_token_lifetime" field.
+func (_u *AppUpdate) AddAccessTokenLifetime(v int) *AppUpdate {
+	_u.mutation.AddAccessTokenLifetime(v)
+	return _u
+}
+
+// ClearAccessTokenLifetime clears the value of the "access_token_lifetime" field.
+func (_u *AppUpdate) ClearAccessTokenLifetime() *AppUpdate {
+	_u.mutation.ClearAccessTokenLifetime()
+	return _u
+}
+
+// SetRefreshTokenLifetime sets the "refresh_token_lifetime" field.
+func (_u *AppUpdate) SetRefreshTokenLifetime(v int) *AppUpdate {
+	_u.mutation.ResetRefreshTokenLifetime()
+	_u.mutation.SetRefreshTokenLifetime(v)
+	return _u
+}
+
+// SetNillableRefreshTokenLifetime sets the "refresh_token_lifetime" field if the given value is not nil.
+func (_u *AppUpdate) SetNillableRefreshTokenLifetime(v *int) *AppUpdate {
+	if v != nil {
+		_u.SetRefreshTokenLifetime(*v)
+	}
+	return _u
+}
+
+// AddRefreshTokenLifetime adds value to the "refresh_token_lifetime" field.
+func (_u *AppUpdate) AddRefreshTokenLifetime(v int) *AppUpdate {
+	_u.mutation.AddRefreshTokenLifetime(v)
+	return _u
+}
+
+// ClearRefreshTokenLifetime clears the value of the "refresh_token_lifetime" field.
+func (_u *AppUpdate) ClearRefreshTokenLifetime() *AppUpdate {
+	_u.mutation.ClearRefreshTokenLifetime()
+	return _u
+}
+
 // SetOwner sets the "owner" edge to the User entity.
 func (_u *AppUpdate) SetOwner(v *User) *AppUpdate {
 	return _u.SetOwnerID(v.ID)
@@ -247,6 +302,12 @@ func (_u *AppUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AppUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AppUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AppUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -296,6 +357,24 @@ func (_u *AppUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			sqljson.Append(u, app.FieldRoles, value)
 		})
 	}
+	if value, ok := _u.mutation.AccessTokenLifetime(); ok {
+		_spec.SetField(app.FieldAccessTokenLifetime, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAccessTokenLifetime(); ok {
+		_spec.AddField(app.FieldAccessTokenLifetime, field.TypeInt, value)
+	}
+	if _u.mutation.AccessTokenLifetimeCleared() {
+		_spec.ClearField(app.FieldAccessTokenLifetime, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RefreshTokenLifetime(); ok {
+		_spec.SetField(app.FieldRefreshTokenLifetime, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRefreshTokenLifetime(); ok {
+		_spec.AddField(app.FieldRefreshTokenLifetime, field.TypeInt, value)
+	}
+	if _u.mutation.RefreshTokenLifetimeCleared() {
+		_spec.ClearField(app.FieldRefreshTokenLifetime, field.TypeInt)
+	}
 	if _u.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -370,6 +449,7 @@ func (_u *AppUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{app.Label}
@@ -385,9 +465,10 @@ func (_u *AppUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // AppUpdateOne is the builder for updating a single App entity.
 type AppUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *AppMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *AppMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetSecret sets the "secret" field.
@@ -510,6 +591,60 @@ func (_u *AppUpdateOne) AppendRoles(v []string) *AppUpdateOne {
 	return _u
 }
 
+// SetAccessTokenLifetime sets the "access_token_lifetime" field.
+func (_u *AppUpdateOne) SetAccessTokenLifetime(v int) *AppUpdateOne {
+	_u.mutation.ResetAccessTokenLifetime()
+	_u.mutation.SetAccessTokenLifetime(v)
+	return _u
+}
+
+// SetNillableAccessTokenLifetime sets the "access_token_lifetime" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillableAccessTokenLifetime(v *int) *AppUpdateOne {
+	if v != nil {
+		_u.SetAccessTokenLifetime(*v)
+	}
+	return _u
+}
+
+// AddAccessTokenLifetime adds value to the "access_token_lifetime" field.
+func (_u *AppUpdateOne) AddAccessTokenLifetime(v int) *AppUpdateOne {
+	_u.mutation.AddAccessTokenLifetime(v)
+	return _u
+}
+
+// ClearAccessTokenLifetime clears the value of the "access_token_lifetime" field.
+func (_u *AppUpdateOne) ClearAccessTokenLifetime() *AppUpdateOne {
+	_u.mutation.ClearAccessTokenLifetime()
+	return _u
+}
+
+// SetRefreshTokenLifetime sets the "refresh_token_lifetime" field.
+func (_u *AppUpdateOne) SetRefreshTokenLifetime(v int) *AppUpdateOne {
+	_u.mutation.ResetRefreshTokenLifetime()
+	_u.mutation.SetRefreshTokenLifetime(v)
+	return _u
+}
+
+// SetNillableRefreshTokenLifetime sets the "refresh_token_lifetime" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillableRefreshTokenLifetime(v *int) *AppUpdateOne {
+	if v != nil {
+		_u.SetRefreshTokenLifetime(*v)
+	}
+	return _u
+}
+
+// AddRefreshTokenLifetime adds value to the "refresh_token_lifetime" field.
+func (_u *AppUpdateOne) AddRefreshTokenLifetime(v int) *AppUpdateOne {
+	_u.mutation.AddRefreshTokenLifetime(v)
+	return _u
+}
+
+// ClearRefreshTokenLifetime clears the value of the "refresh_token_lifetime" field.
+func (_u *AppUpdateOne) ClearRefreshTokenLifetime() *AppUpdateOne {
+	_u.mutation.ClearRefreshTokenLifetime()
+	return _u
+}
+
 // SetOwner sets the "owner" edge to the User entity.
 func (_u *AppUpdateOne) SetOwner(v *User) *AppUpdateOne {
 	return _u.SetOwnerID(v.ID)
@@ -619,6 +754,12 @@ func (_u *AppUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AppUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AppUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AppUpdateOne) sqlSave(ctx context.Context) (_node *App, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -685,6 +826,24 @@ func (_u *AppUpdateOne) sqlSave(ctx context.Context) (_node *App, err error) {
 			sqljson.Append(u, app.FieldRoles, value)
 		})
 	}
+	if value, ok := _u.mutation.AccessTokenLifetime(); ok {
+		_spec.SetField(app.FieldAccessTokenLifetime, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAccessTokenLifetime(); ok {
+		_spec.AddField(app.FieldAccessTokenLifetime, field.TypeInt, value)
+	}
+	if _u.mutation.AccessTokenLifetimeCleared() {
+		_spec.ClearField(app.FieldAccessTokenLifetime, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RefreshTokenLifetime(); ok {
+		_spec.SetField(app.FieldRefreshTokenLifetime, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRefreshTokenLifetime(); ok {
+		_spec.AddField(app.FieldRefreshTokenLifetime, field.TypeInt, value)
+	}
+	if _u.mutation.RefreshTokenLifetimeCleared() {
+		_spec.ClearField(app.FieldRefreshTokenLifetime, field.TypeInt)
+	}
 	if _u.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -759,6 +918,7 @@ func (_u *AppUpdateOne) sqlSave(ctx context.Context) (_node *App, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &App{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -18,8 +18,9 @@ import (
 // AuthCodeUpdate is the builder for updating AuthCode entities.
 type AuthCodeUpdate struct {
 	config
-	hooks    []Hook
-	mutation *AuthCodeMutation
+	hooks     []Hook
+	mutation  *AuthCodeMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the AuthCodeUpdate builder.
@@ -88,6 +89,12 @@ func (_u *AuthCodeUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AuthCodeUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuthCodeUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AuthCodeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	_spec := sqlgraph.NewUpdateSpec(authcode.Table, authcode.Columns, sqlgraph.NewFieldSpec(authcode.FieldID, field.TypeString))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
@@ -103,6 +110,7 @@ func (_u *AuthCodeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Expiration(); ok {
 		_spec.SetField(authcode.FieldExpiration, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{authcode.Label}
@@ -118,9 +126,10 @@ func (_u *AuthCodeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // AuthCodeUpdateOne is the builder for updating a single AuthCode entity.
 type AuthCodeUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *AuthCodeMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *AuthCodeMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetAuthRequestID sets the "auth_request_id" field.
@@ -196,6 +205,12 @@ func (_u *AuthCodeUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AuthCodeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuthCodeUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AuthCodeUpdateOne) sqlSave(ctx context.Context) (_node *AuthCode, err error) {
 	_spec := sqlgraph.NewUpdateSpec(authcode.Table, authcode.Columns, sqlgraph.NewFieldSpec(authcode.FieldID, field.TypeString))
 	id, ok := _u.mutation.ID()
@@ -228,6 +243,7 @@ func (_u *AuthCodeUpdateOne) sqlSave(ctx context.Context) (_node *AuthCode, err
 	if value, ok := _u.mutation.Expiration(); ok {
 		_spec.SetField(authcode.FieldExpiration, field.TypeTime, value)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &AuthCode{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
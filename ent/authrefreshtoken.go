@@ -35,8 +35,16 @@ type AuthRefreshToken struct {
 	// Expiration holds the value of the "expiration" field.
 	Expiration time.Time `json:"expiration,omitempty"`
 	// Scopes holds the value of the "scopes" field.
-	Scopes       []string `json:"scopes,omitempty"`
-	selectValues sql.SelectValues
+	Scopes []string `json:"scopes,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// FamilyID holds the value of the "family_id" field.
+	FamilyID string `json:"family_id,omitempty"`
+	// Used holds the value of the "used" field.
+	Used bool `json:"used,omitempty"`
+	// SessionExpiration holds the value of the "session_expiration" field.
+	SessionExpiration time.Time `json:"session_expiration,omitempty"`
+	selectValues      sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -46,9 +54,11 @@ func (*AuthRefreshToken) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case authrefreshtoken.FieldAmr, authrefreshtoken.FieldAudience, authrefreshtoken.FieldScopes:
 			values[i] = new([]byte)
-		case authrefreshtoken.FieldID, authrefreshtoken.FieldToken, authrefreshtoken.FieldSubject, authrefreshtoken.FieldUserID, authrefreshtoken.FieldApplicationID:
+		case authrefreshtoken.FieldUsed:
+			values[i] = new(sql.NullBool)
+		case authrefreshtoken.FieldID, authrefreshtoken.FieldToken, authrefreshtoken.FieldSubject, authrefreshtoken.FieldUserID, authrefreshtoken.FieldApplicationID, authrefreshtoken.FieldFamilyID:
 			values[i] = new(sql.NullString)
-		case authrefreshtoken.FieldAuthTime, authrefreshtoken.FieldExpiration:
+		case authrefreshtoken.FieldAuthTime, authrefreshtoken.FieldExpiration, authrefreshtoken.FieldCreatedAt, authrefreshtoken.FieldSessionExpiration:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -131,6 +141,30 @@ func (_m *AuthRefreshToken) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field scopes: %w", err)
 				}
 			}
+		case authrefreshtoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case authrefreshtoken.FieldFamilyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field family_id", values[i])
+			} else if value.Valid {
+				_m.FamilyID = value.String
+			}
+		case authrefreshtoken.FieldUsed:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field used", values[i])
+			} else if value.Valid {
+				_m.Used = value.Bool
+			}
+		case authrefreshtoken.FieldSessionExpiration:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field session_expiration", values[i])
+			} else if value.Valid {
+				_m.SessionExpiration = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -193,6 +227,18 @@ func (_m *AuthRefreshToken) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("scopes=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Scopes))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("family_id=")
+	builder.WriteString(_m.FamilyID)
+	builder.WriteString(", ")
+	builder.WriteString("used=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Used))
+	builder.WriteString(", ")
+	builder.WriteString("session_expiration=")
+	builder.WriteString(_m.SessionExpiration.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
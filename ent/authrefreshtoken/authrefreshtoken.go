@@ -3,6 +3,8 @@
 package authrefreshtoken
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 )
 
@@ -29,6 +31,14 @@ const (
 	FieldExpiration = "expiration"
 	// FieldScopes holds the string denoting the scopes field in the database.
 	FieldScopes = "scopes"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldFamilyID holds the string denoting the family_id field in the database.
+	FieldFamilyID = "family_id"
+	// FieldUsed holds the string denoting the used field in the database.
+	FieldUsed = "used"
+	// FieldSessionExpiration holds the string denoting the session_expiration field in the database.
+	FieldSessionExpiration = "session_expiration"
 	// Table holds the table name of the authrefreshtoken in the database.
 	Table = "auth_refresh_tokens"
 )
@@ -45,6 +55,10 @@ var Columns = []string{
 	FieldApplicationID,
 	FieldExpiration,
 	FieldScopes,
+	FieldCreatedAt,
+	FieldFamilyID,
+	FieldUsed,
+	FieldSessionExpiration,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -57,6 +71,13 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUsed holds the default value on creation for the "used" field.
+	DefaultUsed bool
+)
+
 // OrderOption defines the ordering options for the AuthRefreshToken queries.
 type OrderOption func(*sql.Selector)
 
@@ -94,3 +115,23 @@ func ByApplicationID(opts ...sql.OrderTermOption) OrderOption {
 func ByExpiration(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExpiration, opts...).ToFunc()
 }
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByFamilyID orders the results by the family_id field.
+func ByFamilyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFamilyID, opts...).ToFunc()
+}
+
+// ByUsed orders the results by the used field.
+func ByUsed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsed, opts...).ToFunc()
+}
+
+// BySessionExpiration orders the results by the session_expiration field.
+func BySessionExpiration(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSessionExpiration, opts...).ToFunc()
+}
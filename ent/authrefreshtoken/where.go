@@ -94,6 +94,26 @@ func Expiration(v time.Time) predicate.AuthRefreshToken {
 	return predicate.AuthRefreshToken(sql.FieldEQ(FieldExpiration, v))
 }
 
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// FamilyID applies equality check predicate on the "family_id" field. It's identical to FamilyIDEQ.
+func FamilyID(v string) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldEQ(FieldFamilyID, v))
+}
+
+// Used applies equality check predicate on the "used" field. It's identical to UsedEQ.
+func Used(v bool) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldEQ(FieldUsed, v))
+}
+
+// SessionExpiration applies equality check predicate on the "session_expiration" field. It's identical to SessionExpirationEQ.
+func SessionExpiration(v time.Time) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldEQ(FieldSessionExpiration, v))
+}
+
 // TokenEQ applies the EQ predicate on the "Token" field.
 func TokenEQ(v string) predicate.AuthRefreshToken {
 	return predicate.AuthRefreshToken(sql.FieldEQ(FieldToken, v))
@@ -434,6 +454,161 @@ func ExpirationLTE(v time.Time) predicate.AuthRefreshToken {
 	return predicate.AuthRefreshToken(sql.FieldLTE(FieldExpiration, v))
 }
 
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// FamilyIDEQ applies the EQ predicate on the "family_id" field.
+func FamilyIDEQ(v string) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldEQ(FieldFamilyID, v))
+}
+
+// FamilyIDNEQ applies the NEQ predicate on the "family_id" field.
+func FamilyIDNEQ(v string) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldNEQ(FieldFamilyID, v))
+}
+
+// FamilyIDIn applies the In predicate on the "family_id" field.
+func FamilyIDIn(vs ...string) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldIn(FieldFamilyID, vs...))
+}
+
+// FamilyIDNotIn applies the NotIn predicate on the "family_id" field.
+func FamilyIDNotIn(vs ...string) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldNotIn(FieldFamilyID, vs...))
+}
+
+// FamilyIDGT applies the GT predicate on the "family_id" field.
+func FamilyIDGT(v string) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldGT(FieldFamilyID, v))
+}
+
+// FamilyIDGTE applies the GTE predicate on the "family_id" field.
+func FamilyIDGTE(v string) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldGTE(FieldFamilyID, v))
+}
+
+// FamilyIDLT applies the LT predicate on the "family_id" field.
+func FamilyIDLT(v string) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldLT(FieldFamilyID, v))
+}
+
+// FamilyIDLTE applies the LTE predicate on the "family_id" field.
+func FamilyIDLTE(v string) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldLTE(FieldFamilyID, v))
+}
+
+// FamilyIDContains applies the Contains predicate on the "family_id" field.
+func FamilyIDContains(v string) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldContains(FieldFamilyID, v))
+}
+
+// FamilyIDHasPrefix applies the HasPrefix predicate on the "family_id" field.
+func FamilyIDHasPrefix(v string) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldHasPrefix(FieldFamilyID, v))
+}
+
+// FamilyIDHasSuffix applies the HasSuffix predicate on the "family_id" field.
+func FamilyIDHasSuffix(v string) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldHasSuffix(FieldFamilyID, v))
+}
+
+// FamilyIDEqualFold applies the EqualFold predicate on the "family_id" field.
+func FamilyIDEqualFold(v string) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldEqualFold(FieldFamilyID, v))
+}
+
+// FamilyIDContainsFold applies the ContainsFold predicate on the "family_id" field.
+func FamilyIDContainsFold(v string) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldContainsFold(FieldFamilyID, v))
+}
+
+// UsedEQ applies the EQ predicate on the "used" field.
+func UsedEQ(v bool) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldEQ(FieldUsed, v))
+}
+
+// UsedNEQ applies the NEQ predicate on the "used" field.
+func UsedNEQ(v bool) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldNEQ(FieldUsed, v))
+}
+
+// SessionExpirationEQ applies the EQ predicate on the "session_expiration" field.
+func SessionExpirationEQ(v time.Time) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldEQ(FieldSessionExpiration, v))
+}
+
+// SessionExpirationNEQ applies the NEQ predicate on the "session_expiration" field.
+func SessionExpirationNEQ(v time.Time) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldNEQ(FieldSessionExpiration, v))
+}
+
+// SessionExpirationIn applies the In predicate on the "session_expiration" field.
+func SessionExpirationIn(vs ...time.Time) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldIn(FieldSessionExpiration, vs...))
+}
+
+// SessionExpirationNotIn applies the NotIn predicate on the "session_expiration" field.
+func SessionExpirationNotIn(vs ...time.Time) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldNotIn(FieldSessionExpiration, vs...))
+}
+
+// SessionExpirationGT applies the GT predicate on the "session_expiration" field.
+func SessionExpirationGT(v time.Time) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldGT(FieldSessionExpiration, v))
+}
+
+// SessionExpirationGTE applies the GTE predicate on the "session_expiration" field.
+func SessionExpirationGTE(v time.Time) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldGTE(FieldSessionExpiration, v))
+}
+
+// SessionExpirationLT applies the LT predicate on the "session_expiration" field.
+func SessionExpirationLT(v time.Time) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldLT(FieldSessionExpiration, v))
+}
+
+// SessionExpirationLTE applies the LTE predicate on the "session_expiration" field.
+func SessionExpirationLTE(v time.Time) predicate.AuthRefreshToken {
+	return predicate.AuthRefreshToken(sql.FieldLTE(FieldSessionExpiration, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.AuthRefreshToken) predicate.AuthRefreshToken {
 	return predicate.AuthRefreshToken(sql.AndPredicates(predicates...))
@@ -74,6 +74,46 @@ func (_c *AuthRefreshTokenCreate) SetScopes(v []string) *AuthRefreshTokenCreate
 	return _c
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (_c *AuthRefreshTokenCreate) SetCreatedAt(v time.Time) *AuthRefreshTokenCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AuthRefreshTokenCreate) SetNillableCreatedAt(v *time.Time) *AuthRefreshTokenCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetFamilyID sets the "family_id" field.
+func (_c *AuthRefreshTokenCreate) SetFamilyID(v string) *AuthRefreshTokenCreate {
+	_c.mutation.SetFamilyID(v)
+	return _c
+}
+
+// SetUsed sets the "used" field.
+func (_c *AuthRefreshTokenCreate) SetUsed(v bool) *AuthRefreshTokenCreate {
+	_c.mutation.SetUsed(v)
+	return _c
+}
+
+// SetNillableUsed sets the "used" field if the given value is not nil.
+func (_c *AuthRefreshTokenCreate) SetNillableUsed(v *bool) *AuthRefreshTokenCreate {
+	if v != nil {
+		_c.SetUsed(*v)
+	}
+	return _c
+}
+
+// SetSessionExpiration sets the "session_expiration" field.
+func (_c *AuthRefreshTokenCreate) SetSessionExpiration(v time.Time) *AuthRefreshTokenCreate {
+	_c.mutation.SetSessionExpiration(v)
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *AuthRefreshTokenCreate) SetID(v string) *AuthRefreshTokenCreate {
 	_c.mutation.SetID(v)
@@ -87,6 +127,7 @@ func (_c *AuthRefreshTokenCreate) Mutation() *AuthRefreshTokenMutation {
 
 // Save creates the AuthRefreshToken in the database.
 func (_c *AuthRefreshTokenCreate) Save(ctx context.Context) (*AuthRefreshToken, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -112,6 +153,18 @@ func (_c *AuthRefreshTokenCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *AuthRefreshTokenCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := authrefreshtoken.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.Used(); !ok {
+		v := authrefreshtoken.DefaultUsed
+		_c.mutation.SetUsed(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *AuthRefreshTokenCreate) check() error {
 	if _, ok := _c.mutation.Token(); !ok {
@@ -141,6 +194,18 @@ func (_c *AuthRefreshTokenCreate) check() error {
 	if _, ok := _c.mutation.Scopes(); !ok {
 		return &ValidationError{Name: "scopes", err: errors.New(`ent: missing required field "AuthRefreshToken.scopes"`)}
 	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuthRefreshToken.created_at"`)}
+	}
+	if _, ok := _c.mutation.FamilyID(); !ok {
+		return &ValidationError{Name: "family_id", err: errors.New(`ent: missing required field "AuthRefreshToken.family_id"`)}
+	}
+	if _, ok := _c.mutation.Used(); !ok {
+		return &ValidationError{Name: "used", err: errors.New(`ent: missing required field "AuthRefreshToken.used"`)}
+	}
+	if _, ok := _c.mutation.SessionExpiration(); !ok {
+		return &ValidationError{Name: "session_expiration", err: errors.New(`ent: missing required field "AuthRefreshToken.session_expiration"`)}
+	}
 	return nil
 }
 
@@ -212,6 +277,22 @@ func (_c *AuthRefreshTokenCreate) createSpec() (*AuthRefreshToken, *sqlgraph.Cre
 		_spec.SetField(authrefreshtoken.FieldScopes, field.TypeJSON, value)
 		_node.Scopes = value
 	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(authrefreshtoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.FamilyID(); ok {
+		_spec.SetField(authrefreshtoken.FieldFamilyID, field.TypeString, value)
+		_node.FamilyID = value
+	}
+	if value, ok := _c.mutation.Used(); ok {
+		_spec.SetField(authrefreshtoken.FieldUsed, field.TypeBool, value)
+		_node.Used = value
+	}
+	if value, ok := _c.mutation.SessionExpiration(); ok {
+		_spec.SetField(authrefreshtoken.FieldSessionExpiration, field.TypeTime, value)
+		_node.SessionExpiration = value
+	}
 	return _node, _spec
 }
 
@@ -233,6 +314,7 @@ func (_c *AuthRefreshTokenCreateBulk) Save(ctx context.Context) ([]*AuthRefreshT
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*AuthRefreshTokenMutation)
 				if !ok {
@@ -19,8 +19,9 @@ import (
 // AuthRefreshTokenUpdate is the builder for updating AuthRefreshToken entities.
 type AuthRefreshTokenUpdate struct {
 	config
-	hooks    []Hook
-	mutation *AuthRefreshTokenMutation
+	hooks     []Hook
+	mutation  *AuthRefreshTokenMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the AuthRefreshTokenUpdate builder.
@@ -149,6 +150,62 @@ func (_u *AuthRefreshTokenUpdate) AppendScopes(v []string) *AuthRefreshTokenUpda
 	return _u
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (_u *AuthRefreshTokenUpdate) SetCreatedAt(v time.Time) *AuthRefreshTokenUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *AuthRefreshTokenUpdate) SetNillableCreatedAt(v *time.Time) *AuthRefreshTokenUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetFamilyID sets the "family_id" field.
+func (_u *AuthRefreshTokenUpdate) SetFamilyID(v string) *AuthRefreshTokenUpdate {
+	_u.mutation.SetFamilyID(v)
+	return _u
+}
+
+// SetNillableFamilyID sets the "family_id" field if the given value is not nil.
+func (_u *AuthRefreshTokenUpdate) SetNillableFamilyID(v *string) *AuthRefreshTokenUpdate {
+	if v != nil {
+		_u.SetFamilyID(*v)
+	}
+	return _u
+}
+
+// SetUsed sets the "used" field.
+func (_u *AuthRefreshTokenUpdate) SetUsed(v bool) *AuthRefreshTokenUpdate {
+	_u.mutation.SetUsed(v)
+	return _u
+}
+
+// SetNillableUsed sets the "used" field if the given value is not nil.
+func (_u *AuthRefreshTokenUpdate) SetNillableUsed(v *bool) *AuthRefreshTokenUpdate {
+	if v != nil {
+		_u.SetUsed(*v)
+	}
+	return _u
+}
+
+// SetSessionExpiration sets the "session_expiration" field.
+func (_u *AuthRefreshTokenUpdate) SetSessionExpiration(v time.Time) *AuthRefreshTokenUpdate {
+	_u.mutation.SetSessionExpiration(v)
+	return _u
+}
+
+// SetNillableSessionExpiration sets the "session_expiration" field if the given value is not nil.
+func (_u *AuthRefreshTokenUpdate) SetNillableSessionExpiration(v *time.Time) *AuthRefreshTokenUpdate {
+	if v != nil {
+		_u.SetSessionExpiration(*v)
+	}
+	return _u
+}
+
 // Mutation returns the AuthRefreshTokenMutation object of the builder.
 func (_u *AuthRefreshTokenUpdate) Mutation() *AuthRefreshTokenMutation {
 	return _u.mutation
@@ -181,6 +238,12 @@ func (_u *AuthRefreshTokenUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AuthRefreshTokenUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuthRefreshTokenUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AuthRefreshTokenUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	_spec := sqlgraph.NewUpdateSpec(authrefreshtoken.Table, authrefreshtoken.Columns, sqlgraph.NewFieldSpec(authrefreshtoken.FieldID, field.TypeString))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
@@ -232,6 +295,19 @@ func (_u *AuthRefreshTokenUpdate) sqlSave(ctx context.Context) (_node int, err e
 			sqljson.Append(u, authrefreshtoken.FieldScopes, value)
 		})
 	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(authrefreshtoken.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FamilyID(); ok {
+		_spec.SetField(authrefreshtoken.FieldFamilyID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Used(); ok {
+		_spec.SetField(authrefreshtoken.FieldUsed, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SessionExpiration(); ok {
+		_spec.SetField(authrefreshtoken.FieldSessionExpiration, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{authrefreshtoken.Label}
@@ -247,9 +323,10 @@ func (_u *AuthRefreshTokenUpdate) sqlSave(ctx context.Context) (_node int, err e
 // AuthRefreshTokenUpdateOne is the builder for updating a single AuthRefreshToken entity.
 type AuthRefreshTokenUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *AuthRefreshTokenMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *AuthRefreshTokenMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetToken sets the "Token" field.
@@ -372,6 +449,62 @@ func (_u *AuthRefreshTokenUpdateOne) AppendScopes(v []string) *AuthRefreshTokenU
 	return _u
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (_u *AuthRefreshTokenUpdateOne) SetCreatedAt(v time.Time) *AuthRefreshTokenUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *AuthRefreshTokenUpdateOne) SetNillableCreatedAt(v *time.Time) *AuthRefreshTokenUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetFamilyID sets the "family_id" field.
+func (_u *AuthRefreshTokenUpdateOne) SetFamilyID(v string) *AuthRefreshTokenUpdateOne {
+	_u.mutation.SetFamilyID(v)
+	return _u
+}
+
+// SetNillableFamilyID sets the "family_id" field if the given value is not nil.
+func (_u *AuthRefreshTokenUpdateOne) SetNillableFamilyID(v *string) *AuthRefreshTokenUpdateOne {
+	if v != nil {
+		_u.SetFamilyID(*v)
+	}
+	return _u
+}
+
+// SetUsed sets the "used" field.
+func (_u *AuthRefreshTokenUpdateOne) SetUsed(v bool) *AuthRefreshTokenUpdateOne {
+	_u.mutation.SetUsed(v)
+	return _u
+}
+
+// SetNillableUsed sets the "used" field if the given value is not nil.
+func (_u *AuthRefreshTokenUpdateOne) SetNillableUsed(v *bool) *AuthRefreshTokenUpdateOne {
+	if v != nil {
+		_u.SetUsed(*v)
+	}
+	return _u
+}
+
+// SetSessionExpiration sets the "session_expiration" field.
+func (_u *AuthRefreshTokenUpdateOne) SetSessionExpiration(v time.Time) *AuthRefreshTokenUpdateOne {
+	_u.mutation.SetSessionExpiration(v)
+	return _u
+}
+
+// SetNillableSessionExpiration sets the "session_expiration" field if the given value is not nil.
+func (_u *AuthRefreshTokenUpdateOne) SetNillableSessionExpiration(v *time.Time) *AuthRefreshTokenUpdateOne {
+	if v != nil {
+		_u.SetSessionExpiration(*v)
+	}
+	return _u
+}
+
 // Mutation returns the AuthRefreshTokenMutation object of the builder.
 func (_u *AuthRefreshTokenUpdateOne) Mutation() *AuthRefreshTokenMutation {
 	return _u.mutation
@@ -417,6 +550,12 @@ func (_u *AuthRefreshTokenUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AuthRefreshTokenUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuthRefreshTokenUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AuthRefreshTokenUpdateOne) sqlSave(ctx context.Context) (_node *AuthRefreshToken, err error) {
 	_spec := sqlgraph.NewUpdateSpec(authrefreshtoken.Table, authrefreshtoken.Columns, sqlgraph.NewFieldSpec(authrefreshtoken.FieldID, field.TypeString))
 	id, ok := _u.mutation.ID()
@@ -485,6 +624,19 @@ func (_u *AuthRefreshTokenUpdateOne) sqlSave(ctx context.Context) (_node *AuthRe
 			sqljson.Append(u, authrefreshtoken.FieldScopes, value)
 		})
 	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(authrefreshtoken.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FamilyID(); ok {
+		_spec.SetField(authrefreshtoken.FieldFamilyID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Used(); ok {
+		_spec.SetField(authrefreshtoken.FieldUsed, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SessionExpiration(); ok {
+		_spec.SetField(authrefreshtoken.FieldSessionExpiration, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &AuthRefreshToken{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
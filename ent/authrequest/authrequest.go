@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package authrequest
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the authrequest type in the database.
+	Label = "auth_request"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldApplicationID holds the string denoting the application_id field in the database.
+	FieldApplicationID = "application_id"
+	// FieldRedirectURI holds the string denoting the redirect_uri field in the database.
+	FieldRedirectURI = "redirect_uri"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// FieldNonce holds the string denoting the nonce field in the database.
+	FieldNonce = "nonce"
+	// FieldScopes holds the string denoting the scopes field in the database.
+	FieldScopes = "scopes"
+	// FieldPrompt holds the string denoting the prompt field in the database.
+	FieldPrompt = "prompt"
+	// FieldResponseType holds the string denoting the response_type field in the database.
+	FieldResponseType = "response_type"
+	// FieldResponseMode holds the string denoting the response_mode field in the database.
+	FieldResponseMode = "response_mode"
+	// FieldCodeChallenge holds the string denoting the code_challenge field in the database.
+	FieldCodeChallenge = "code_challenge"
+	// FieldCodeChallengeMethod holds the string denoting the code_challenge_method field in the database.
+	FieldCodeChallengeMethod = "code_challenge_method"
+	// FieldLoginHint holds the string denoting the login_hint field in the database.
+	FieldLoginHint = "login_hint"
+	// FieldMaxAuthAge holds the string denoting the max_auth_age field in the database.
+	FieldMaxAuthAge = "max_auth_age"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldDone holds the string denoting the done field in the database.
+	FieldDone = "done"
+	// FieldAuthTime holds the string denoting the auth_time field in the database.
+	FieldAuthTime = "auth_time"
+	// FieldAmr holds the string denoting the amr field in the database.
+	FieldAmr = "amr"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldExpiration holds the string denoting the expiration field in the database.
+	FieldExpiration = "expiration"
+	// Table holds the table name of the authrequest in the database.
+	Table = "auth_requests"
+)
+
+// Columns holds all SQL columns for authrequest fields.
+var Columns = []string{
+	FieldID,
+	FieldApplicationID,
+	FieldRedirectURI,
+	FieldState,
+	FieldNonce,
+	FieldScopes,
+	FieldPrompt,
+	FieldResponseType,
+	FieldResponseMode,
+	FieldCodeChallenge,
+	FieldCodeChallengeMethod,
+	FieldLoginHint,
+	FieldMaxAuthAge,
+	FieldUserID,
+	FieldDone,
+	FieldAuthTime,
+	FieldAmr,
+	FieldCreatedAt,
+	FieldExpiration,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultDone holds the default value on creation for the "done" field.
+	DefaultDone bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultExpiration holds the default value on creation for the "expiration" field.
+	DefaultExpiration func() time.Time
+)
+
+// OrderOption defines the ordering options for the AuthRequest queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByApplicationID orders the results by the application_id field.
+func ByApplicationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApplicationID, opts...).ToFunc()
+}
+
+// ByRedirectURI orders the results by the redirect_uri field.
+func ByRedirectURI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRedirectURI, opts...).ToFunc()
+}
+
+// ByState orders the results by the state field.
+func ByState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldState, opts...).ToFunc()
+}
+
+// ByNonce orders the results by the nonce field.
+func ByNonce(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNonce, opts...).ToFunc()
+}
+
+// ByResponseType orders the results by the response_type field.
+func ByResponseType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResponseType, opts...).ToFunc()
+}
+
+// ByResponseMode orders the results by the response_mode field.
+func ByResponseMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResponseMode, opts...).ToFunc()
+}
+
+// ByCodeChallenge orders the results by the code_challenge field.
+func ByCodeChallenge(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCodeChallenge, opts...).ToFunc()
+}
+
+// ByCodeChallengeMethod orders the results by the code_challenge_method field.
+func ByCodeChallengeMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCodeChallengeMethod, opts...).ToFunc()
+}
+
+// ByLoginHint orders the results by the login_hint field.
+func ByLoginHint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLoginHint, opts...).ToFunc()
+}
+
+// ByMaxAuthAge orders the results by the max_auth_age field.
+func ByMaxAuthAge(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxAuthAge, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByDone orders the results by the done field.
+func ByDone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDone, opts...).ToFunc()
+}
+
+// ByAuthTime orders the results by the auth_time field.
+func ByAuthTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthTime, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByExpiration orders the results by the expiration field.
+func ByExpiration(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiration, opts...).ToFunc()
+}
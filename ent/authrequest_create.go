@@ -0,0 +1,497 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"base-website/ent/authrequest"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuthRequestCreate is the builder for creating a AuthRequest entity.
+type AuthRequestCreate struct {
+	config
+	mutation *AuthRequestMutation
+	hooks    []Hook
+}
+
+// SetApplicationID sets the "application_id" field.
+func (_c *AuthRequestCreate) SetApplicationID(v string) *AuthRequestCreate {
+	_c.mutation.SetApplicationID(v)
+	return _c
+}
+
+// SetRedirectURI sets the "redirect_uri" field.
+func (_c *AuthRequestCreate) SetRedirectURI(v string) *AuthRequestCreate {
+	_c.mutation.SetRedirectURI(v)
+	return _c
+}
+
+// SetState sets the "state" field.
+func (_c *AuthRequestCreate) SetState(v string) *AuthRequestCreate {
+	_c.mutation.SetState(v)
+	return _c
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_c *AuthRequestCreate) SetNillableState(v *string) *AuthRequestCreate {
+	if v != nil {
+		_c.SetState(*v)
+	}
+	return _c
+}
+
+// SetNonce sets the "nonce" field.
+func (_c *AuthRequestCreate) SetNonce(v string) *AuthRequestCreate {
+	_c.mutation.SetNonce(v)
+	return _c
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (_c *AuthRequestCreate) SetNillableNonce(v *string) *AuthRequestCreate {
+	if v != nil {
+		_c.SetNonce(*v)
+	}
+	return _c
+}
+
+// SetScopes sets the "scopes" field.
+func (_c *AuthRequestCreate) SetScopes(v []string) *AuthRequestCreate {
+	_c.mutation.SetScopes(v)
+	return _c
+}
+
+// SetPrompt sets the "prompt" field.
+func (_c *AuthRequestCreate) SetPrompt(v []string) *AuthRequestCreate {
+	_c.mutation.SetPrompt(v)
+	return _c
+}
+
+// SetResponseType sets the "response_type" field.
+func (_c *AuthRequestCreate) SetResponseType(v string) *AuthRequestCreate {
+	_c.mutation.SetResponseType(v)
+	return _c
+}
+
+// SetResponseMode sets the "response_mode" field.
+func (_c *AuthRequestCreate) SetResponseMode(v string) *AuthRequestCreate {
+	_c.mutation.SetResponseMode(v)
+	return _c
+}
+
+// SetNillableResponseMode sets the "response_mode" field if the given value is not nil.
+func (_c *AuthRequestCreate) SetNillableResponseMode(v *string) *AuthRequestCreate {
+	if v != nil {
+		_c.SetResponseMode(*v)
+	}
+	return _c
+}
+
+// SetCodeChallenge sets the "code_challenge" field.
+func (_c *AuthRequestCreate) SetCodeChallenge(v string) *AuthRequestCreate {
+	_c.mutation.SetCodeChallenge(v)
+	return _c
+}
+
+// SetNillableCodeChallenge sets the "code_challenge" field if the given value is not nil.
+func (_c *AuthRequestCreate) SetNillableCodeChallenge(v *string) *AuthRequestCreate {
+	if v != nil {
+		_c.SetCodeChallenge(*v)
+	}
+	return _c
+}
+
+// SetCodeChallengeMethod sets the "code_challenge_method" field.
+func (_c *AuthRequestCreate) SetCodeChallengeMethod(v string) *AuthRequestCreate {
+	_c.mutation.SetCodeChallengeMethod(v)
+	return _c
+}
+
+// SetNillableCodeChallengeMethod sets the "code_challenge_method" field if the given value is not nil.
+func (_c *AuthRequestCreate) SetNillableCodeChallengeMethod(v *string) *AuthRequestCreate {
+	if v != nil {
+		_c.SetCodeChallengeMethod(*v)
+	}
+	return _c
+}
+
+// SetLoginHint sets the "login_hint" field.
+func (_c *AuthRequestCreate) SetLoginHint(v string) *AuthRequestCreate {
+	_c.mutation.SetLoginHint(v)
+	return _c
+}
+
+// SetNillableLoginHint sets the "login_hint" field if the given value is not nil.
+func (_c *AuthRequestCreate) SetNillableLoginHint(v *string) *AuthRequestCreate {
+	if v != nil {
+		_c.SetLoginHint(*v)
+	}
+	return _c
+}
+
+// SetMaxAuthAge sets the "max_auth_age" field.
+func (_c *AuthRequestCreate) SetMaxAuthAge(v int64) *AuthRequestCreate {
+	_c.mutation.SetMaxAuthAge(v)
+	return _c
+}
+
+// SetNillableMaxAuthAge sets the "max_auth_age" field if the given value is not nil.
+func (_c *AuthRequestCreate) SetNillableMaxAuthAge(v *int64) *AuthRequestCreate {
+	if v != nil {
+		_c.SetMaxAuthAge(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *AuthRequestCreate) SetUserID(v string) *AuthRequestCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *AuthRequestCreate) SetNillableUserID(v *string) *AuthRequestCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetDone sets the "done" field.
+func (_c *AuthRequestCreate) SetDone(v bool) *AuthRequestCreate {
+	_c.mutation.SetDone(v)
+	return _c
+}
+
+// SetNillableDone sets the "done" field if the given value is not nil.
+func (_c *AuthRequestCreate) SetNillableDone(v *bool) *AuthRequestCreate {
+	if v != nil {
+		_c.SetDone(*v)
+	}
+	return _c
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_c *AuthRequestCreate) SetAuthTime(v time.Time) *AuthRequestCreate {
+	_c.mutation.SetAuthTime(v)
+	return _c
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_c *AuthRequestCreate) SetNillableAuthTime(v *time.Time) *AuthRequestCreate {
+	if v != nil {
+		_c.SetAuthTime(*v)
+	}
+	return _c
+}
+
+// SetAmr sets the "amr" field.
+func (_c *AuthRequestCreate) SetAmr(v []string) *AuthRequestCreate {
+	_c.mutation.SetAmr(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AuthRequestCreate) SetCreatedAt(v time.Time) *AuthRequestCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AuthRequestCreate) SetNillableCreatedAt(v *time.Time) *AuthRequestCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetExpiration sets the "expiration" field.
+func (_c *AuthRequestCreate) SetExpiration(v time.Time) *AuthRequestCreate {
+	_c.mutation.SetExpiration(v)
+	return _c
+}
+
+// SetNillableExpiration sets the "expiration" field if the given value is not nil.
+func (_c *AuthRequestCreate) SetNillableExpiration(v *time.Time) *AuthRequestCreate {
+	if v != nil {
+		_c.SetExpiration(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AuthRequestCreate) SetID(v string) *AuthRequestCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the AuthRequestMutation object of the builder.
+func (_c *AuthRequestCreate) Mutation() *AuthRequestMutation {
+	return _c.mutation
+}
+
+// Save creates the AuthRequest in the database.
+func (_c *AuthRequestCreate) Save(ctx context.Context) (*AuthRequest, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AuthRequestCreate) SaveX(ctx context.Context) *AuthRequest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuthRequestCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuthRequestCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AuthRequestCreate) defaults() {
+	if _, ok := _c.mutation.Done(); !ok {
+		v := authrequest.DefaultDone
+		_c.mutation.SetDone(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := authrequest.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.Expiration(); !ok {
+		v := authrequest.DefaultExpiration()
+		_c.mutation.SetExpiration(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AuthRequestCreate) check() error {
+	if _, ok := _c.mutation.ApplicationID(); !ok {
+		return &ValidationError{Name: "application_id", err: errors.New(`ent: missing required field "AuthRequest.application_id"`)}
+	}
+	if _, ok := _c.mutation.RedirectURI(); !ok {
+		return &ValidationError{Name: "redirect_uri", err: errors.New(`ent: missing required field "AuthRequest.redirect_uri"`)}
+	}
+	if _, ok := _c.mutation.Scopes(); !ok {
+		return &ValidationError{Name: "scopes", err: errors.New(`ent: missing required field "AuthRequest.scopes"`)}
+	}
+	if _, ok := _c.mutation.ResponseType(); !ok {
+		return &ValidationError{Name: "response_type", err: errors.New(`ent: missing required field "AuthRequest.response_type"`)}
+	}
+	if _, ok := _c.mutation.Done(); !ok {
+		return &ValidationError{Name: "done", err: errors.New(`ent: missing required field "AuthRequest.done"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuthRequest.created_at"`)}
+	}
+	if _, ok := _c.mutation.Expiration(); !ok {
+		return &ValidationError{Name: "expiration", err: errors.New(`ent: missing required field "AuthRequest.expiration"`)}
+	}
+	return nil
+}
+
+func (_c *AuthRequestCreate) sqlSave(ctx context.Context) (*AuthRequest, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected AuthRequest.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AuthRequestCreate) createSpec() (*AuthRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuthRequest{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(authrequest.Table, sqlgraph.NewFieldSpec(authrequest.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ApplicationID(); ok {
+		_spec.SetField(authrequest.FieldApplicationID, field.TypeString, value)
+		_node.ApplicationID = value
+	}
+	if value, ok := _c.mutation.RedirectURI(); ok {
+		_spec.SetField(authrequest.FieldRedirectURI, field.TypeString, value)
+		_node.RedirectURI = value
+	}
+	if value, ok := _c.mutation.State(); ok {
+		_spec.SetField(authrequest.FieldState, field.TypeString, value)
+		_node.State = value
+	}
+	if value, ok := _c.mutation.Nonce(); ok {
+		_spec.SetField(authrequest.FieldNonce, field.TypeString, value)
+		_node.Nonce = value
+	}
+	if value, ok := _c.mutation.Scopes(); ok {
+		_spec.SetField(authrequest.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := _c.mutation.Prompt(); ok {
+		_spec.SetField(authrequest.FieldPrompt, field.TypeJSON, value)
+		_node.Prompt = value
+	}
+	if value, ok := _c.mutation.ResponseType(); ok {
+		_spec.SetField(authrequest.FieldResponseType, field.TypeString, value)
+		_node.ResponseType = value
+	}
+	if value, ok := _c.mutation.ResponseMode(); ok {
+		_spec.SetField(authrequest.FieldResponseMode, field.TypeString, value)
+		_node.ResponseMode = value
+	}
+	if value, ok := _c.mutation.CodeChallenge(); ok {
+		_spec.SetField(authrequest.FieldCodeChallenge, field.TypeString, value)
+		_node.CodeChallenge = value
+	}
+	if value, ok := _c.mutation.CodeChallengeMethod(); ok {
+		_spec.SetField(authrequest.FieldCodeChallengeMethod, field.TypeString, value)
+		_node.CodeChallengeMethod = value
+	}
+	if value, ok := _c.mutation.LoginHint(); ok {
+		_spec.SetField(authrequest.FieldLoginHint, field.TypeString, value)
+		_node.LoginHint = value
+	}
+	if value, ok := _c.mutation.MaxAuthAge(); ok {
+		_spec.SetField(authrequest.FieldMaxAuthAge, field.TypeInt64, value)
+		_node.MaxAuthAge = &value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(authrequest.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Done(); ok {
+		_spec.SetField(authrequest.FieldDone, field.TypeBool, value)
+		_node.Done = value
+	}
+	if value, ok := _c.mutation.AuthTime(); ok {
+		_spec.SetField(authrequest.FieldAuthTime, field.TypeTime, value)
+		_node.AuthTime = &value
+	}
+	if value, ok := _c.mutation.Amr(); ok {
+		_spec.SetField(authrequest.FieldAmr, field.TypeJSON, value)
+		_node.Amr = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(authrequest.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.Expiration(); ok {
+		_spec.SetField(authrequest.FieldExpiration, field.TypeTime, value)
+		_node.Expiration = value
+	}
+	return _node, _spec
+}
+
+// AuthRequestCreateBulk is the builder for creating many AuthRequest entities in bulk.
+type AuthRequestCreateBulk struct {
+	config
+	err      error
+	builders []*AuthRequestCreate
+}
+
+// Save creates the AuthRequest entities in the database.
+func (_c *AuthRequestCreateBulk) Save(ctx context.Context) ([]*AuthRequest, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AuthRequest, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuthRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AuthRequestCreateBulk) SaveX(ctx context.Context) []*AuthRequest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuthRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuthRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
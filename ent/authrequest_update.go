@@ -0,0 +1,1041 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"base-website/ent/authrequest"
+	"base-website/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// AuthRequestUpdate is the builder for updating AuthRequest entities.
+type AuthRequestUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AuthRequestMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AuthRequestUpdate builder.
+func (_u *AuthRequestUpdate) Where(ps ...predicate.AuthRequest) *AuthRequestUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetApplicationID sets the "application_id" field.
+func (_u *AuthRequestUpdate) SetApplicationID(v string) *AuthRequestUpdate {
+	_u.mutation.SetApplicationID(v)
+	return _u
+}
+
+// SetNillableApplicationID sets the "application_id" field if the given value is not nil.
+func (_u *AuthRequestUpdate) SetNillableApplicationID(v *string) *AuthRequestUpdate {
+	if v != nil {
+		_u.SetApplicationID(*v)
+	}
+	return _u
+}
+
+// SetRedirectURI sets the "redirect_uri" field.
+func (_u *AuthRequestUpdate) SetRedirectURI(v string) *AuthRequestUpdate {
+	_u.mutation.SetRedirectURI(v)
+	return _u
+}
+
+// SetNillableRedirectURI sets the "redirect_uri" field if the given value is not nil.
+func (_u *AuthRequestUpdate) SetNillableRedirectURI(v *string) *AuthRequestUpdate {
+	if v != nil {
+		_u.SetRedirectURI(*v)
+	}
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *AuthRequestUpdate) SetState(v string) *AuthRequestUpdate {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *AuthRequestUpdate) SetNillableState(v *string) *AuthRequestUpdate {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// ClearState clears the value of the "state" field.
+func (_u *AuthRequestUpdate) ClearState() *AuthRequestUpdate {
+	_u.mutation.ClearState()
+	return _u
+}
+
+// SetNonce sets the "nonce" field.
+func (_u *AuthRequestUpdate) SetNonce(v string) *AuthRequestUpdate {
+	_u.mutation.SetNonce(v)
+	return _u
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (_u *AuthRequestUpdate) SetNillableNonce(v *string) *AuthRequestUpdate {
+	if v != nil {
+		_u.SetNonce(*v)
+	}
+	return _u
+}
+
+// ClearNonce clears the value of the "nonce" field.
+func (_u *AuthRequestUpdate) ClearNonce() *AuthRequestUpdate {
+	_u.mutation.ClearNonce()
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *AuthRequestUpdate) SetScopes(v []string) *AuthRequestUpdate {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *AuthRequestUpdate) AppendScopes(v []string) *AuthRequestUpdate {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// SetPrompt sets the "prompt" field.
+func (_u *AuthRequestUpdate) SetPrompt(v []string) *AuthRequestUpdate {
+	_u.mutation.SetPrompt(v)
+	return _u
+}
+
+// AppendPrompt appends value to the "prompt" field.
+func (_u *AuthRequestUpdate) AppendPrompt(v []string) *AuthRequestUpdate {
+	_u.mutation.AppendPrompt(v)
+	return _u
+}
+
+// ClearPrompt clears the value of the "prompt" field.
+func (_u *AuthRequestUpdate) ClearPrompt() *AuthRequestUpdate {
+	_u.mutation.ClearPrompt()
+	return _u
+}
+
+// SetResponseType sets the "response_type" field.
+func (_u *AuthRequestUpdate) SetResponseType(v string) *AuthRequestUpdate {
+	_u.mutation.SetResponseType(v)
+	return _u
+}
+
+// SetNillableResponseType sets the "response_type" field if the given value is not nil.
+func (_u *AuthRequestUpdate) SetNillableResponseType(v *string) *AuthRequestUpdate {
+	if v != nil {
+		_u.SetResponseType(*v)
+	}
+	return _u
+}
+
+// SetResponseMode sets the "response_mode" field.
+func (_u *AuthRequestUpdate) SetResponseMode(v string) *AuthRequestUpdate {
+	_u.mutation.SetResponseMode(v)
+	return _u
+}
+
+// SetNillableResponseMode sets the "response_mode" field if the given value is not nil.
+func (_u *AuthRequestUpdate) SetNillableResponseMode(v *string) *AuthRequestUpdate {
+	if v != nil {
+		_u.SetResponseMode(*v)
+	}
+	return _u
+}
+
+// ClearResponseMode clears the value of the "response_mode" field.
+func (_u *AuthRequestUpdate) ClearResponseMode() *AuthRequestUpdate {
+	_u.mutation.ClearResponseMode()
+	return _u
+}
+
+// SetCodeChallenge sets the "code_challenge" field.
+func (_u *AuthRequestUpdate) SetCodeChallenge(v string) *AuthRequestUpdate {
+	_u.mutation.SetCodeChallenge(v)
+	return _u
+}
+
+// SetNillableCodeChallenge sets the "code_challenge" field if the given value is not nil.
+func (_u *AuthRequestUpdate) SetNillableCodeChallenge(v *string) *AuthRequestUpdate {
+	if v != nil {
+		_u.SetCodeChallenge(*v)
+	}
+	return _u
+}
+
+// ClearCodeChallenge clears the value of the "code_challenge" field.
+func (_u *AuthRequestUpdate) ClearCodeChallenge() *AuthRequestUpdate {
+	_u.mutation.ClearCodeChallenge()
+	return _u
+}
+
+// SetCodeChallengeMethod sets the "code_challenge_method" field.
+func (_u *AuthRequestUpdate) SetCodeChallengeMethod(v string) *AuthRequestUpdate {
+	_u.mutation.SetCodeChallengeMethod(v)
+	return _u
+}
+
+// SetNillableCodeChallengeMethod sets the "code_challenge_method" field if the given value is not nil.
+func (_u *AuthRequestUpdate) SetNillableCodeChallengeMethod(v *string) *AuthRequestUpdate {
+	if v != nil {
+		_u.SetCodeChallengeMethod(*v)
+	}
+	return _u
+}
+
+// ClearCodeChallengeMethod clears the value of the "code_challenge_method" field.
+func (_u *AuthRequestUpdate) ClearCodeChallengeMethod() *AuthRequestUpdate {
+	_u.mutation.ClearCodeChallengeMethod()
+	return _u
+}
+
+// SetLoginHint sets the "login_hint" field.
+func (_u *AuthRequestUpdate) SetLoginHint(v string) *AuthRequestUpdate {
+	_u.mutation.SetLoginHint(v)
+	return _u
+}
+
+// SetNillableLoginHint sets the "login_hint" field if the given value is not nil.
+func (_u *AuthRequestUpdate) SetNillableLoginHint(v *string) *AuthRequestUpdate {
+	if v != nil {
+		_u.SetLoginHint(*v)
+	}
+	return _u
+}
+
+// ClearLoginHint clears the value of the "login_hint" field.
+func (_u *AuthRequestUpdate) ClearLoginHint() *AuthRequestUpdate {
+	_u.mutation.ClearLoginHint()
+	return _u
+}
+
+// SetMaxAuthAge sets the "max_auth_age" field.
+func (_u *AuthRequestUpdate) SetMaxAuthAge(v int64) *AuthRequestUpdate {
+	_u.mutation.ResetMaxAuthAge()
+	_u.mutation.SetMaxAuthAge(v)
+	return _u
+}
+
+// SetNillableMaxAuthAge sets the "max_auth_age" field if the given value is not nil.
+func (_u *AuthRequestUpdate) SetNillableMaxAuthAge(v *int64) *AuthRequestUpdate {
+	if v != nil {
+		_u.SetMaxAuthAge(*v)
+	}
+	return _u
+}
+
+// AddMaxAuthAge adds value to the "max_auth_age" field.
+func (_u *AuthRequestUpdate) AddMaxAuthAge(v int64) *AuthRequestUpdate {
+	_u.mutation.AddMaxAuthAge(v)
+	return _u
+}
+
+// ClearMaxAuthAge clears the value of the "max_auth_age" field.
+func (_u *AuthRequestUpdate) ClearMaxAuthAge() *AuthRequestUpdate {
+	_u.mutation.ClearMaxAuthAge()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *AuthRequestUpdate) SetUserID(v string) *AuthRequestUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *AuthRequestUpdate) SetNillableUserID(v *string) *AuthRequestUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *AuthRequestUpdate) ClearUserID() *AuthRequestUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetDone sets the "done" field.
+func (_u *AuthRequestUpdate) SetDone(v bool) *AuthRequestUpdate {
+	_u.mutation.SetDone(v)
+	return _u
+}
+
+// SetNillableDone sets the "done" field if the given value is not nil.
+func (_u *AuthRequestUpdate) SetNillableDone(v *bool) *AuthRequestUpdate {
+	if v != nil {
+		_u.SetDone(*v)
+	}
+	return _u
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_u *AuthRequestUpdate) SetAuthTime(v time.Time) *AuthRequestUpdate {
+	_u.mutation.SetAuthTime(v)
+	return _u
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_u *AuthRequestUpdate) SetNillableAuthTime(v *time.Time) *AuthRequestUpdate {
+	if v != nil {
+		_u.SetAuthTime(*v)
+	}
+	return _u
+}
+
+// ClearAuthTime clears the value of the "auth_time" field.
+func (_u *AuthRequestUpdate) ClearAuthTime() *AuthRequestUpdate {
+	_u.mutation.ClearAuthTime()
+	return _u
+}
+
+// SetAmr sets the "amr" field.
+func (_u *AuthRequestUpdate) SetAmr(v []string) *AuthRequestUpdate {
+	_u.mutation.SetAmr(v)
+	return _u
+}
+
+// AppendAmr appends value to the "amr" field.
+func (_u *AuthRequestUpdate) AppendAmr(v []string) *AuthRequestUpdate {
+	_u.mutation.AppendAmr(v)
+	return _u
+}
+
+// ClearAmr clears the value of the "amr" field.
+func (_u *AuthRequestUpdate) ClearAmr() *AuthRequestUpdate {
+	_u.mutation.ClearAmr()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *AuthRequestUpdate) SetCreatedAt(v time.Time) *AuthRequestUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *AuthRequestUpdate) SetNillableCreatedAt(v *time.Time) *AuthRequestUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetExpiration sets the "expiration" field.
+func (_u *AuthRequestUpdate) SetExpiration(v time.Time) *AuthRequestUpdate {
+	_u.mutation.SetExpiration(v)
+	return _u
+}
+
+// SetNillableExpiration sets the "expiration" field if the given value is not nil.
+func (_u *AuthRequestUpdate) SetNillableExpiration(v *time.Time) *AuthRequestUpdate {
+	if v != nil {
+		_u.SetExpiration(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AuthRequestMutation object of the builder.
+func (_u *AuthRequestUpdate) Mutation() *AuthRequestMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AuthRequestUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuthRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AuthRequestUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuthRequestUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AuthRequestUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuthRequestUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AuthRequestUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(authrequest.Table, authrequest.Columns, sqlgraph.NewFieldSpec(authrequest.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ApplicationID(); ok {
+		_spec.SetField(authrequest.FieldApplicationID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RedirectURI(); ok {
+		_spec.SetField(authrequest.FieldRedirectURI, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(authrequest.FieldState, field.TypeString, value)
+	}
+	if _u.mutation.StateCleared() {
+		_spec.ClearField(authrequest.FieldState, field.TypeString)
+	}
+	if value, ok := _u.mutation.Nonce(); ok {
+		_spec.SetField(authrequest.FieldNonce, field.TypeString, value)
+	}
+	if _u.mutation.NonceCleared() {
+		_spec.ClearField(authrequest.FieldNonce, field.TypeString)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(authrequest.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, authrequest.FieldScopes, value)
+		})
+	}
+	if value, ok := _u.mutation.Prompt(); ok {
+		_spec.SetField(authrequest.FieldPrompt, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPrompt(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, authrequest.FieldPrompt, value)
+		})
+	}
+	if _u.mutation.PromptCleared() {
+		_spec.ClearField(authrequest.FieldPrompt, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ResponseType(); ok {
+		_spec.SetField(authrequest.FieldResponseType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ResponseMode(); ok {
+		_spec.SetField(authrequest.FieldResponseMode, field.TypeString, value)
+	}
+	if _u.mutation.ResponseModeCleared() {
+		_spec.ClearField(authrequest.FieldResponseMode, field.TypeString)
+	}
+	if value, ok := _u.mutation.CodeChallenge(); ok {
+		_spec.SetField(authrequest.FieldCodeChallenge, field.TypeString, value)
+	}
+	if _u.mutation.CodeChallengeCleared() {
+		_spec.ClearField(authrequest.FieldCodeChallenge, field.TypeString)
+	}
+	if value, ok := _u.mutation.CodeChallengeMethod(); ok {
+		_spec.SetField(authrequest.FieldCodeChallengeMethod, field.TypeString, value)
+	}
+	if _u.mutation.CodeChallengeMethodCleared() {
+		_spec.ClearField(authrequest.FieldCodeChallengeMethod, field.TypeString)
+	}
+	if value, ok := _u.mutation.LoginHint(); ok {
+		_spec.SetField(authrequest.FieldLoginHint, field.TypeString, value)
+	}
+	if _u.mutation.LoginHintCleared() {
+		_spec.ClearField(authrequest.FieldLoginHint, field.TypeString)
+	}
+	if value, ok := _u.mutation.MaxAuthAge(); ok {
+		_spec.SetField(authrequest.FieldMaxAuthAge, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAuthAge(); ok {
+		_spec.AddField(authrequest.FieldMaxAuthAge, field.TypeInt64, value)
+	}
+	if _u.mutation.MaxAuthAgeCleared() {
+		_spec.ClearField(authrequest.FieldMaxAuthAge, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(authrequest.FieldUserID, field.TypeString, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(authrequest.FieldUserID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Done(); ok {
+		_spec.SetField(authrequest.FieldDone, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AuthTime(); ok {
+		_spec.SetField(authrequest.FieldAuthTime, field.TypeTime, value)
+	}
+	if _u.mutation.AuthTimeCleared() {
+		_spec.ClearField(authrequest.FieldAuthTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Amr(); ok {
+		_spec.SetField(authrequest.FieldAmr, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAmr(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, authrequest.FieldAmr, value)
+		})
+	}
+	if _u.mutation.AmrCleared() {
+		_spec.ClearField(authrequest.FieldAmr, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(authrequest.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Expiration(); ok {
+		_spec.SetField(authrequest.FieldExpiration, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{authrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AuthRequestUpdateOne is the builder for updating a single AuthRequest entity.
+type AuthRequestUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AuthRequestMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetApplicationID sets the "application_id" field.
+func (_u *AuthRequestUpdateOne) SetApplicationID(v string) *AuthRequestUpdateOne {
+	_u.mutation.SetApplicationID(v)
+	return _u
+}
+
+// SetNillableApplicationID sets the "application_id" field if the given value is not nil.
+func (_u *AuthRequestUpdateOne) SetNillableApplicationID(v *string) *AuthRequestUpdateOne {
+	if v != nil {
+		_u.SetApplicationID(*v)
+	}
+	return _u
+}
+
+// SetRedirectURI sets the "redirect_uri" field.
+func (_u *AuthRequestUpdateOne) SetRedirectURI(v string) *AuthRequestUpdateOne {
+	_u.mutation.SetRedirectURI(v)
+	return _u
+}
+
+// SetNillableRedirectURI sets the "redirect_uri" field if the given value is not nil.
+func (_u *AuthRequestUpdateOne) SetNillableRedirectURI(v *string) *AuthRequestUpdateOne {
+	if v != nil {
+		_u.SetRedirectURI(*v)
+	}
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *AuthRequestUpdateOne) SetState(v string) *AuthRequestUpdateOne {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *AuthRequestUpdateOne) SetNillableState(v *string) *AuthRequestUpdateOne {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// ClearState clears the value of the "state" field.
+func (_u *AuthRequestUpdateOne) ClearState() *AuthRequestUpdateOne {
+	_u.mutation.ClearState()
+	return _u
+}
+
+// SetNonce sets the "nonce" field.
+func (_u *AuthRequestUpdateOne) SetNonce(v string) *AuthRequestUpdateOne {
+	_u.mutation.SetNonce(v)
+	return _u
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (_u *AuthRequestUpdateOne) SetNillableNonce(v *string) *AuthRequestUpdateOne {
+	if v != nil {
+		_u.SetNonce(*v)
+	}
+	return _u
+}
+
+// ClearNonce clears the value of the "nonce" field.
+func (_u *AuthRequestUpdateOne) ClearNonce() *AuthRequestUpdateOne {
+	_u.mutation.ClearNonce()
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *AuthRequestUpdateOne) SetScopes(v []string) *AuthRequestUpdateOne {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *AuthRequestUpdateOne) AppendScopes(v []string) *AuthRequestUpdateOne {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// SetPrompt sets the "prompt" field.
+func (_u *AuthRequestUpdateOne) SetPrompt(v []string) *AuthRequestUpdateOne {
+	_u.mutation.SetPrompt(v)
+	return _u
+}
+
+// AppendPrompt appends value to the "prompt" field.
+func (_u *AuthRequestUpdateOne) AppendPrompt(v []string) *AuthRequestUpdateOne {
+	_u.mutation.AppendPrompt(v)
+	return _u
+}
+
+// ClearPrompt clears the value of the "prompt" field.
+func (_u *AuthRequestUpdateOne) ClearPrompt() *AuthRequestUpdateOne {
+	_u.mutation.ClearPrompt()
+	return _u
+}
+
+// SetResponseType sets the "response_type" field.
+func (_u *AuthRequestUpdateOne) SetResponseType(v string) *AuthRequestUpdateOne {
+	_u.mutation.SetResponseType(v)
+	return _u
+}
+
+// SetNillableResponseType sets the "response_type" field if the given value is not nil.
+func (_u *AuthRequestUpdateOne) SetNillableResponseType(v *string) *AuthRequestUpdateOne {
+	if v != nil {
+		_u.SetResponseType(*v)
+	}
+	return _u
+}
+
+// SetResponseMode sets the "response_mode" field.
+func (_u *AuthRequestUpdateOne) SetResponseMode(v string) *AuthRequestUpdateOne {
+	_u.mutation.SetResponseMode(v)
+	return _u
+}
+
+// SetNillableResponseMode sets the "response_mode" field if the given value is not nil.
+func (_u *AuthRequestUpdateOne) SetNillableResponseMode(v *string) *AuthRequestUpdateOne {
+	if v != nil {
+		_u.SetResponseMode(*v)
+	}
+	return _u
+}
+
+// ClearResponseMode clears the value of the "response_mode" field.
+func (_u *AuthRequestUpdateOne) ClearResponseMode() *AuthRequestUpdateOne {
+	_u.mutation.ClearResponseMode()
+	return _u
+}
+
+// SetCodeChallenge sets the "code_challenge" field.
+func (_u *AuthRequestUpdateOne) SetCodeChallenge(v string) *AuthRequestUpdateOne {
+	_u.mutation.SetCodeChallenge(v)
+	return _u
+}
+
+// SetNillableCodeChallenge sets the "code_challenge" field if the given value is not nil.
+func (_u *AuthRequestUpdateOne) SetNillableCodeChallenge(v *string) *AuthRequestUpdateOne {
+	if v != nil {
+		_u.SetCodeChallenge(*v)
+	}
+	return _u
+}
+
+// ClearCodeChallenge clears the value of the "code_challenge" field.
+func (_u *AuthRequestUpdateOne) ClearCodeChallenge() *AuthRequestUpdateOne {
+	_u.mutation.ClearCodeChallenge()
+	return _u
+}
+
+// SetCodeChallengeMethod sets the "code_challenge_method" field.
+func (_u *AuthRequestUpdateOne) SetCodeChallengeMethod(v string) *AuthRequestUpdateOne {
+	_u.mutation.SetCodeChallengeMethod(v)
+	return _u
+}
+
+// SetNillableCodeChallengeMethod sets the "code_challenge_method" field if the given value is not nil.
+func (_u *AuthRequestUpdateOne) SetNillableCodeChallengeMethod(v *string) *AuthRequestUpdateOne {
+	if v != nil {
+		_u.SetCodeChallengeMethod(*v)
+	}
+	return _u
+}
+
+// ClearCodeChallengeMethod clears the value of the "code_challenge_method" field.
+func (_u *AuthRequestUpdateOne) ClearCodeChallengeMethod() *AuthRequestUpdateOne {
+	_u.mutation.ClearCodeChallengeMethod()
+	return _u
+}
+
+// SetLoginHint sets the "login_hint" field.
+func (_u *AuthRequestUpdateOne) SetLoginHint(v string) *AuthRequestUpdateOne {
+	_u.mutation.SetLoginHint(v)
+	return _u
+}
+
+// SetNillableLoginHint sets the "login_hint" field if the given value is not nil.
+func (_u *AuthRequestUpdateOne) SetNillableLoginHint(v *string) *AuthRequestUpdateOne {
+	if v != nil {
+		_u.SetLoginHint(*v)
+	}
+	return _u
+}
+
+// ClearLoginHint clears the value of the "login_hint" field.
+func (_u *AuthRequestUpdateOne) ClearLoginHint() *AuthRequestUpdateOne {
+	_u.mutation.ClearLoginHint()
+	return _u
+}
+
+// SetMaxAuthAge sets the "max_auth_age" field.
+func (_u *AuthRequestUpdateOne) SetMaxAuthAge(v int64) *AuthRequestUpdateOne {
+	_u.mutation.ResetMaxAuthAge()
+	_u.mutation.SetMaxAuthAge(v)
+	return _u
+}
+
+// SetNillableMaxAuthAge sets the "max_auth_age" field if the given value is not nil.
+func (_u *AuthRequestUpdateOne) SetNillableMaxAuthAge(v *int64) *AuthRequestUpdateOne {
+	if v != nil {
+		_u.SetMaxAuthAge(*v)
+	}
+	return _u
+}
+
+// AddMaxAuthAge adds value to the "max_auth_age" field.
+func (_u *AuthRequestUpdateOne) AddMaxAuthAge(v int64) *AuthRequestUpdateOne {
+	_u.mutation.AddMaxAuthAge(v)
+	return _u
+}
+
+// ClearMaxAuthAge clears the value of the "max_auth_age" field.
+func (_u *AuthRequestUpdateOne) ClearMaxAuthAge() *AuthRequestUpdateOne {
+	_u.mutation.ClearMaxAuthAge()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *AuthRequestUpdateOne) SetUserID(v string) *AuthRequestUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *AuthRequestUpdateOne) SetNillableUserID(v *string) *AuthRequestUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *AuthRequestUpdateOne) ClearUserID() *AuthRequestUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetDone sets the "done" field.
+func (_u *AuthRequestUpdateOne) SetDone(v bool) *AuthRequestUpdateOne {
+	_u.mutation.SetDone(v)
+	return _u
+}
+
+// SetNillableDone sets the "done" field if the given value is not nil.
+func (_u *AuthRequestUpdateOne) SetNillableDone(v *bool) *AuthRequestUpdateOne {
+	if v != nil {
+		_u.SetDone(*v)
+	}
+	return _u
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_u *AuthRequestUpdateOne) SetAuthTime(v time.Time) *AuthRequestUpdateOne {
+	_u.mutation.SetAuthTime(v)
+	return _u
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_u *AuthRequestUpdateOne) SetNillableAuthTime(v *time.Time) *AuthRequestUpdateOne {
+	if v != nil {
+		_u.SetAuthTime(*v)
+	}
+	return _u
+}
+
+// ClearAuthTime clears the value of the "auth_time" field.
+func (_u *AuthRequestUpdateOne) ClearAuthTime() *AuthRequestUpdateOne {
+	_u.mutation.ClearAuthTime()
+	return _u
+}
+
+// SetAmr sets the "amr" field.
+func (_u *AuthRequestUpdateOne) SetAmr(v []string) *AuthRequestUpdateOne {
+	_u.mutation.SetAmr(v)
+	return _u
+}
+
+// AppendAmr appends value to the "amr" field.
+func (_u *AuthRequestUpdateOne) AppendAmr(v []string) *AuthRequestUpdateOne {
+	_u.mutation.AppendAmr(v)
+	return _u
+}
+
+// ClearAmr clears the value of the "amr" field.
+func (_u *AuthRequestUpdateOne) ClearAmr() *AuthRequestUpdateOne {
+	_u.mutation.ClearAmr()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *AuthRequestUpdateOne) SetCreatedAt(v time.Time) *AuthRequestUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *AuthRequestUpdateOne) SetNillableCreatedAt(v *time.Time) *AuthRequestUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetExpiration sets the "expiration" field.
+func (_u *AuthRequestUpdateOne) SetExpiration(v time.Time) *AuthRequestUpdateOne {
+	_u.mutation.SetExpiration(v)
+	return _u
+}
+
+// SetNillableExpiration sets the "expiration" field if the given value is not nil.
+func (_u *AuthRequestUpdateOne) SetNillableExpiration(v *time.Time) *AuthRequestUpdateOne {
+	if v != nil {
+		_u.SetExpiration(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AuthRequestMutation object of the builder.
+func (_u *AuthRequestUpdateOne) Mutation() *AuthRequestMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AuthRequestUpdate builder.
+func (_u *AuthRequestUpdateOne) Where(ps ...predicate.AuthRequest) *AuthRequestUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AuthRequestUpdateOne) Select(field string, fields ...string) *AuthRequestUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AuthRequest entity.
+func (_u *AuthRequestUpdateOne) Save(ctx context.Context) (*AuthRequest, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuthRequestUpdateOne) SaveX(ctx context.Context) *AuthRequest {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AuthRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuthRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AuthRequestUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuthRequestUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AuthRequestUpdateOne) sqlSave(ctx context.Context) (_node *AuthRequest, err error) {
+	_spec := sqlgraph.NewUpdateSpec(authrequest.Table, authrequest.Columns, sqlgraph.NewFieldSpec(authrequest.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuthRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, authrequest.FieldID)
+		for _, f := range fields {
+			if !authrequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != authrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ApplicationID(); ok {
+		_spec.SetField(authrequest.FieldApplicationID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RedirectURI(); ok {
+		_spec.SetField(authrequest.FieldRedirectURI, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(authrequest.FieldState, field.TypeString, value)
+	}
+	if _u.mutation.StateCleared() {
+		_spec.ClearField(authrequest.FieldState, field.TypeString)
+	}
+	if value, ok := _u.mutation.Nonce(); ok {
+		_spec.SetField(authrequest.FieldNonce, field.TypeString, value)
+	}
+	if _u.mutation.NonceCleared() {
+		_spec.ClearField(authrequest.FieldNonce, field.TypeString)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(authrequest.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, authrequest.FieldScopes, value)
+		})
+	}
+	if value, ok := _u.mutation.Prompt(); ok {
+		_spec.SetField(authrequest.FieldPrompt, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPrompt(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, authrequest.FieldPrompt, value)
+		})
+	}
+	if _u.mutation.PromptCleared() {
+		_spec.ClearField(authrequest.FieldPrompt, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ResponseType(); ok {
+		_spec.SetField(authrequest.FieldResponseType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ResponseMode(); ok {
+		_spec.SetField(authrequest.FieldResponseMode, field.TypeString, value)
+	}
+	if _u.mutation.ResponseModeCleared() {
+		_spec.ClearField(authrequest.FieldResponseMode, field.TypeString)
+	}
+	if value, ok := _u.mutation.CodeChallenge(); ok {
+		_spec.SetField(authrequest.FieldCodeChallenge, field.TypeString, value)
+	}
+	if _u.mutation.CodeChallengeCleared() {
+		_spec.ClearField(authrequest.FieldCodeChallenge, field.TypeString)
+	}
+	if value, ok := _u.mutation.CodeChallengeMethod(); ok {
+		_spec.SetField(authrequest.FieldCodeChallengeMethod, field.TypeString, value)
+	}
+	if _u.mutation.CodeChallengeMethodCleared() {
+		_spec.ClearField(authrequest.FieldCodeChallengeMethod, field.TypeString)
+	}
+	if value, ok := _u.mutation.LoginHint(); ok {
+		_spec.SetField(authrequest.FieldLoginHint, field.TypeString, value)
+	}
+	if _u.mutation.LoginHintCleared() {
+		_spec.ClearField(authrequest.FieldLoginHint, field.TypeString)
+	}
+	if value, ok := _u.mutation.MaxAuthAge(); ok {
+		_spec.SetField(authrequest.FieldMaxAuthAge, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAuthAge(); ok {
+		_spec.AddField(authrequest.FieldMaxAuthAge, field.TypeInt64, value)
+	}
+	if _u.mutation.MaxAuthAgeCleared() {
+		_spec.ClearField(authrequest.FieldMaxAuthAge, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(authrequest.FieldUserID, field.TypeString, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(authrequest.FieldUserID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Done(); ok {
+		_spec.SetField(authrequest.FieldDone, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AuthTime(); ok {
+		_spec.SetField(authrequest.FieldAuthTime, field.TypeTime, value)
+	}
+	if _u.mutation.AuthTimeCleared() {
+		_spec.ClearField(authrequest.FieldAuthTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Amr(); ok {
+		_spec.SetField(authrequest.FieldAmr, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAmr(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, authrequest.FieldAmr, value)
+		})
+	}
+	if _u.mutation.AmrCleared() {
+		_spec.ClearField(authrequest.FieldAmr, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(authrequest.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Expiration(); ok {
+		_spec.SetField(authrequest.FieldExpiration, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AuthRequest{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{authrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
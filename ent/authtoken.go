@@ -29,7 +29,13 @@ type AuthToken struct {
 	// Expiration holds the value of the "expiration" field.
 	Expiration time.Time `json:"expiration,omitempty"`
 	// Scopes holds the value of the "scopes" field.
-	Scopes       []string `json:"scopes,omitempty"`
+	Scopes []string `json:"scopes,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// LastUsedAt holds the value of the "last_used_at" field.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// Actor holds the value of the "actor" field.
+	Actor        *string `json:"actor,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -40,9 +46,9 @@ func (*AuthToken) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case authtoken.FieldAudience, authtoken.FieldScopes:
 			values[i] = new([]byte)
-		case authtoken.FieldID, authtoken.FieldApplicationID, authtoken.FieldSubject, authtoken.FieldRefreshTokenID:
+		case authtoken.FieldID, authtoken.FieldApplicationID, authtoken.FieldSubject, authtoken.FieldRefreshTokenID, authtoken.FieldActor:
 			values[i] = new(sql.NullString)
-		case authtoken.FieldExpiration:
+		case authtoken.FieldExpiration, authtoken.FieldCreatedAt, authtoken.FieldLastUsedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -105,6 +111,26 @@ func (_m *AuthToken) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field scopes: %w", err)
 				}
 			}
+		case authtoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case authtoken.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				_m.LastUsedAt = new(time.Time)
+				*_m.LastUsedAt = value.Time
+			}
+		case authtoken.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				_m.Actor = new(string)
+				*_m.Actor = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -158,6 +184,19 @@ func (_m *AuthToken) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("scopes=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Scopes))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.LastUsedAt; v != nil {
+		builder.WriteString("last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.Actor; v != nil {
+		builder.WriteString("actor=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,8 @@
 package authtoken
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 )
 
@@ -23,6 +25,12 @@ const (
 	FieldExpiration = "expiration"
 	// FieldScopes holds the string denoting the scopes field in the database.
 	FieldScopes = "scopes"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldLastUsedAt holds the string denoting the last_used_at field in the database.
+	FieldLastUsedAt = "last_used_at"
+	// FieldActor holds the string denoting the actor field in the database.
+	FieldActor = "actor"
 	// Table holds the table name of the authtoken in the database.
 	Table = "auth_tokens"
 )
@@ -36,6 +44,9 @@ var Columns = []string{
 	FieldAudience,
 	FieldExpiration,
 	FieldScopes,
+	FieldCreatedAt,
+	FieldLastUsedAt,
+	FieldActor,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -48,6 +59,11 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
 // OrderOption defines the ordering options for the AuthToken queries.
 type OrderOption func(*sql.Selector)
 
@@ -75,3 +91,18 @@ func ByRefreshTokenID(opts ...sql.OrderTermOption) OrderOption {
 func ByExpiration(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExpiration, opts...).ToFunc()
 }
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByLastUsedAt orders the results by the last_used_at field.
+func ByLastUsedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastUsedAt, opts...).ToFunc()
+}
+
+// ByActor orders the results by the actor field.
+func ByActor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActor, opts...).ToFunc()
+}
@@ -56,6 +56,48 @@ func (_c *AuthTokenCreate) SetScopes(v []string) *AuthTokenCreate {
 	return _c
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (_c *AuthTokenCreate) SetCreatedAt(v time.Time) *AuthTokenCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AuthTokenCreate) SetNillableCreatedAt(v *time.Time) *AuthTokenCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_c *AuthTokenCreate) SetLastUsedAt(v time.Time) *AuthTokenCreate {
+	_c.mutation.SetLastUsedAt(v)
+	return _c
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_c *AuthTokenCreate) SetNillableLastUsedAt(v *time.Time) *AuthTokenCreate {
+	if v != nil {
+		_c.SetLastUsedAt(*v)
+	}
+	return _c
+}
+
+// SetActor sets the "actor" field.
+func (_c *AuthTokenCreate) SetActor(v string) *AuthTokenCreate {
+	_c.mutation.SetActor(v)
+	return _c
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (_c *AuthTokenCreate) SetNillableActor(v *string) *AuthTokenCreate {
+	if v != nil {
+		_c.SetActor(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *AuthTokenCreate) SetID(v string) *AuthTokenCreate {
 	_c.mutation.SetID(v)
@@ -69,6 +111,7 @@ func (_c *AuthTokenCreate) Mutation() *AuthTokenMutation {
 
 // Save creates the AuthToken in the database.
 func (_c *AuthTokenCreate) Save(ctx context.Context) (*AuthToken, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -94,6 +137,14 @@ func (_c *AuthTokenCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *AuthTokenCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := authtoken.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *AuthTokenCreate) check() error {
 	if _, ok := _c.mutation.ApplicationID(); !ok {
@@ -114,6 +165,9 @@ func (_c *AuthTokenCreate) check() error {
 	if _, ok := _c.mutation.Scopes(); !ok {
 		return &ValidationError{Name: "scopes", err: errors.New(`ent: missing required field "AuthToken.scopes"`)}
 	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuthToken.created_at"`)}
+	}
 	return nil
 }
 
@@ -173,6 +227,18 @@ func (_c *AuthTokenCreate) createSpec() (*AuthToken, *sqlgraph.CreateSpec) {
 		_spec.SetField(authtoken.FieldScopes, field.TypeJSON, value)
 		_node.Scopes = value
 	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(authtoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.LastUsedAt(); ok {
+		_spec.SetField(authtoken.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if value, ok := _c.mutation.Actor(); ok {
+		_spec.SetField(authtoken.FieldActor, field.TypeString, value)
+		_node.Actor = &value
+	}
 	return _node, _spec
 }
 
@@ -194,6 +260,7 @@ func (_c *AuthTokenCreateBulk) Save(ctx context.Context) ([]*AuthToken, error) {
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*AuthTokenMutation)
 				if !ok {
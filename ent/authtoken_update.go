@@ -19,8 +19,9 @@ import (
 // AuthTokenUpdate is the builder for updating AuthToken entities.
 type AuthTokenUpdate struct {
 	config
-	hooks    []Hook
-	mutation *AuthTokenMutation
+	hooks     []Hook
+	mutation  *AuthTokenMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the AuthTokenUpdate builder.
@@ -109,6 +110,60 @@ func (_u *AuthTokenUpdate) AppendScopes(v []string) *AuthTokenUpdate {
 	return _u
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (_u *AuthTokenUpdate) SetCreatedAt(v time.Time) *AuthTokenUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *AuthTokenUpdate) SetNillableCreatedAt(v *time.Time) *AuthTokenUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *AuthTokenUpdate) SetLastUsedAt(v time.Time) *AuthTokenUpdate {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *AuthTokenUpdate) SetNillableLastUsedAt(v *time.Time) *AuthTokenUpdate {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *AuthTokenUpdate) ClearLastUsedAt() *AuthTokenUpdate {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetActor sets the "actor" field.
+func (_u *AuthTokenUpdate) SetActor(v string) *AuthTokenUpdate {
+	_u.mutation.SetActor(v)
+	return _u
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (_u *AuthTokenUpdate) SetNillableActor(v *string) *AuthTokenUpdate {
+	if v != nil {
+		_u.SetActor(*v)
+	}
+	return _u
+}
+
+// ClearActor clears the value of the "actor" field.
+func (_u *AuthTokenUpdate) ClearActor() *AuthTokenUpdate {
+	_u.mutation.ClearActor()
+	return _u
+}
+
 // Mutation returns the AuthTokenMutation object of the builder.
 func (_u *AuthTokenUpdate) Mutation() *AuthTokenMutation {
 	return _u.mutation
@@ -141,6 +196,12 @@ func (_u *AuthTokenUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AuthTokenUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuthTokenUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AuthTokenUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	_spec := sqlgraph.NewUpdateSpec(authtoken.Table, authtoken.Columns, sqlgraph.NewFieldSpec(authtoken.FieldID, field.TypeString))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
@@ -178,6 +239,22 @@ func (_u *AuthTokenUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			sqljson.Append(u, authtoken.FieldScopes, value)
 		})
 	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(authtoken.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(authtoken.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(authtoken.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Actor(); ok {
+		_spec.SetField(authtoken.FieldActor, field.TypeString, value)
+	}
+	if _u.mutation.ActorCleared() {
+		_spec.ClearField(authtoken.FieldActor, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{authtoken.Label}
@@ -193,9 +270,10 @@ func (_u *AuthTokenUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // AuthTokenUpdateOne is the builder for updating a single AuthToken entity.
 type AuthTokenUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *AuthTokenMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *AuthTokenMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetApplicationID sets the "application_id" field.
@@ -278,6 +356,60 @@ func (_u *AuthTokenUpdateOne) AppendScopes(v []string) *AuthTokenUpdateOne {
 	return _u
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (_u *AuthTokenUpdateOne) SetCreatedAt(v time.Time) *AuthTokenUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *AuthTokenUpdateOne) SetNillableCreatedAt(v *time.Time) *AuthTokenUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *AuthTokenUpdateOne) SetLastUsedAt(v time.Time) *AuthTokenUpdateOne {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *AuthTokenUpdateOne) SetNillableLastUsedAt(v *time.Time) *AuthTokenUpdateOne {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *AuthTokenUpdateOne) ClearLastUsedAt() *AuthTokenUpdateOne {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetActor sets the "actor" field.
+func (_u *AuthTokenUpdateOne) SetActor(v string) *AuthTokenUpdateOne {
+	_u.mutation.SetActor(v)
+	return _u
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (_u *AuthTokenUpdateOne) SetNillableActor(v *string) *AuthTokenUpdateOne {
+	if v != nil {
+		_u.SetActor(*v)
+	}
+	return _u
+}
+
+// ClearActor clears the value of the "actor" field.
+func (_u *AuthTokenUpdateOne) ClearActor() *AuthTokenUpdateOne {
+	_u.mutation.ClearActor()
+	return _u
+}
+
 // Mutation returns the AuthTokenMutation object of the builder.
 func (_u *AuthTokenUpdateOne) Mutation() *AuthTokenMutation {
 	return _u.mutation
@@ -323,6 +455,12 @@ func (_u *AuthTokenUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AuthTokenUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuthTokenUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *AuthTokenUpdateOne) sqlSave(ctx context.Context) (_node *AuthToken, err error) {
 	_spec := sqlgraph.NewUpdateSpec(authtoken.Table, authtoken.Columns, sqlgraph.NewFieldSpec(authtoken.FieldID, field.TypeString))
 	id, ok := _u.mutation.ID()
@@ -377,6 +515,22 @@ func (_u *AuthTokenUpdateOne) sqlSave(ctx context.Context) (_node *AuthToken, er
 			sqljson.Append(u, authtoken.FieldScopes, value)
 		})
 	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(authtoken.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(authtoken.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(authtoken.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Actor(); ok {
+		_spec.SetField(authtoken.FieldActor, field.TypeString, value)
+	}
+	if _u.mutation.ActorCleared() {
+		_spec.ClearField(authtoken.FieldActor, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &AuthToken{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
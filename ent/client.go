@@ -12,14 +12,20 @@ import (
 	"base-website/ent/migrate"
 
 	"base-website/ent/app"
+	"base-website/ent/auditlog"
 	"base-website/ent/authcode"
 	"base-website/ent/authrefreshtoken"
+	"base-website/ent/authrequest"
 	"base-website/ent/authtoken"
 	"base-website/ent/component"
 	"base-website/ent/consent"
+	"base-website/ent/deviceauthorization"
 	"base-website/ent/invitation"
 	"base-website/ent/notification"
 	"base-website/ent/rankgroup"
+	"base-website/ent/rolegrant"
+	"base-website/ent/sanction"
+	"base-website/ent/signingkey"
 	"base-website/ent/team"
 	"base-website/ent/teammember"
 	"base-website/ent/tournament"
@@ -27,6 +33,7 @@ import (
 	"base-website/ent/user"
 	"base-website/ent/uservote"
 	"base-website/ent/vote"
+	"base-website/ent/votetemplate"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -41,22 +48,34 @@ type Client struct {
 	Schema *migrate.Schema
 	// App is the client for interacting with the App builders.
 	App *AppClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
 	// AuthCode is the client for interacting with the AuthCode builders.
 	AuthCode *AuthCodeClient
 	// AuthRefreshToken is the client for interacting with the AuthRefreshToken builders.
 	AuthRefreshToken *AuthRefreshTokenClient
+	// AuthRequest is the client for interacting with the AuthRequest builders.
+	AuthRequest *AuthRequestClient
 	// AuthToken is the client for interacting with the AuthToken builders.
 	AuthToken *AuthTokenClient
 	// Component is the client for interacting with the Component builders.
 	Component *ComponentClient
 	// Consent is the client for interacting with the Consent builders.
 	Consent *ConsentClient
+	// DeviceAuthorization is the client for interacting with the DeviceAuthorization builders.
+	DeviceAuthorization *DeviceAuthorizationClient
 	// Invitation is the client for interacting with the Invitation builders.
 	Invitation *InvitationClient
 	// Notification is the client for interacting with the Notification builders.
 	Notification *NotificationClient
 	// RankGroup is the client for interacting with the RankGroup builders.
 	RankGroup *RankGroupClient
+	// RoleGrant is the client for interacting with the RoleGrant builders.
+	RoleGrant *RoleGrantClient
+	// Sanction is the client for interacting with the Sanction builders.
+	Sanction *SanctionClient
+	// SigningKey is the client for interacting with the SigningKey builders.
+	SigningKey *SigningKeyClient
 	// Team is the client for interacting with the Team builders.
 	Team *TeamClient
 	// TeamMember is the client for interacting with the TeamMember builders.
@@ -71,6 +90,8 @@ type Client struct {
 	UserVote *UserVoteClient
 	// Vote is the client for interacting with the Vote builders.
 	Vote *VoteClient
+	// VoteTemplate is the client for interacting with the VoteTemplate builders.
+	VoteTemplate *VoteTemplateClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -83,14 +104,20 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.App = NewAppClient(c.config)
+	c.AuditLog = NewAuditLogClient(c.config)
 	c.AuthCode = NewAuthCodeClient(c.config)
 	c.AuthRefreshToken = NewAuthRefreshTokenClient(c.config)
+	c.AuthRequest = NewAuthRequestClient(c.config)
 	c.AuthToken = NewAuthTokenClient(c.config)
 	c.Component = NewComponentClient(c.config)
 	c.Consent = NewConsentClient(c.config)
+	c.DeviceAuthorization = NewDeviceAuthorizationClient(c.config)
 	c.Invitation = NewInvitationClient(c.config)
 	c.Notification = NewNotificationClient(c.config)
 	c.RankGroup = NewRankGroupClient(c.config)
+	c.RoleGrant = NewRoleGrantClient(c.config)
+	c.Sanction = NewSanctionClient(c.config)
+	c.SigningKey = NewSigningKeyClient(c.config)
 	c.Team = NewTeamClient(c.config)
 	c.TeamMember = NewTeamMemberClient(c.config)
 	c.Tournament = NewTournamentClient(c.config)
@@ -98,6 +125,7 @@ func (c *Client) init() {
 	c.User = NewUserClient(c.config)
 	c.UserVote = NewUserVoteClient(c.config)
 	c.Vote = NewVoteClient(c.config)
+	c.VoteTemplate = NewVoteTemplateClient(c.config)
 }
 
 type (
@@ -188,24 +216,31 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:              ctx,
-		config:           cfg,
-		App:              NewAppClient(cfg),
-		AuthCode:         NewAuthCodeClient(cfg),
-		AuthRefreshToken: NewAuthRefreshTokenClient(cfg),
-		AuthToken:        NewAuthTokenClient(cfg),
-		Component:        NewComponentClient(cfg),
-		Consent:          NewConsentClient(cfg),
-		Invitation:       NewInvitationClient(cfg),
-		Notification:     NewNotificationClient(cfg),
-		RankGroup:        NewRankGroupClient(cfg),
-		Team:             NewTeamClient(cfg),
-		TeamMember:       NewTeamMemberClient(cfg),
-		Tournament:       NewTournamentClient(cfg),
-		TournamentAdmin:  NewTournamentAdminClient(cfg),
-		User:             NewUserClient(cfg),
-		UserVote:         NewUserVoteClient(cfg),
-		Vote:             NewVoteClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		App:                 NewAppClient(cfg),
+		AuditLog:            NewAuditLogClient(cfg),
+		AuthCode:            NewAuthCodeClient(cfg),
+		AuthRefreshToken:    NewAuthRefreshTokenClient(cfg),
+		AuthRequest:         NewAuthRequestClient(cfg),
+		AuthToken:           NewAuthTokenClient(cfg),
+		Component:           NewComponentClient(cfg),
+		Consent:             NewConsentClient(cfg),
+		DeviceAuthorization: NewDeviceAuthorizationClient(cfg),
+		Invitation:          NewInvitationClient(cfg),
+		Notification:        NewNotificationClient(cfg),
+		RankGroup:           NewRankGroupClient(cfg),
+		RoleGrant:           NewRoleGrantClient(cfg),
+		Sanction:            NewSanctionClient(cfg),
+		SigningKey:          NewSigningKeyClient(cfg),
+		Team:                NewTeamClient(cfg),
+		TeamMember:          NewTeamMemberClient(cfg),
+		Tournament:          NewTournamentClient(cfg),
+		TournamentAdmin:     NewTournamentAdminClient(cfg),
+		User:                NewUserClient(cfg),
+		UserVote:            NewUserVoteClient(cfg),
+		Vote:                NewVoteClient(cfg),
+		VoteTemplate:        NewVoteTemplateClient(cfg),
 	}, nil
 }
 
@@ -223,24 +258,31 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:              ctx,
-		config:           cfg,
-		App:              NewAppClient(cfg),
-		AuthCode:         NewAuthCodeClient(cfg),
-		AuthRefreshToken: NewAuthRefreshTokenClient(cfg),
-		AuthToken:        NewAuthTokenClient(cfg),
-		Component:        NewComponentClient(cfg),
-		Consent:          NewConsentClient(cfg),
-		Invitation:       NewInvitationClient(cfg),
-		Notification:     NewNotificationClient(cfg),
-		RankGroup:        NewRankGroupClient(cfg),
-		Team:             NewTeamClient(cfg),
-		TeamMember:       NewTeamMemberClient(cfg),
-		Tournament:       NewTournamentClient(cfg),
-		TournamentAdmin:  NewTournamentAdminClient(cfg),
-		User:             NewUserClient(cfg),
-		UserVote:         NewUserVoteClient(cfg),
-		Vote:             NewVoteClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		App:                 NewAppClient(cfg),
+		AuditLog:            NewAuditLogClient(cfg),
+		AuthCode:            NewAuthCodeClient(cfg),
+		AuthRefreshToken:    NewAuthRefreshTokenClient(cfg),
+		AuthRequest:         NewAuthRequestClient(cfg),
+		AuthToken:           NewAuthTokenClient(cfg),
+		Component:           NewComponentClient(cfg),
+		Consent:             NewConsentClient(cfg),
+		DeviceAuthorization: NewDeviceAuthorizationClient(cfg),
+		Invitation:          NewInvitationClient(cfg),
+		Notification:        NewNotificationClient(cfg),
+		RankGroup:           NewRankGroupClient(cfg),
+		RoleGrant:           NewRoleGrantClient(cfg),
+		Sanction:            NewSanctionClient(cfg),
+		SigningKey:          NewSigningKeyClient(cfg),
+		Team:                NewTeamClient(cfg),
+		TeamMember:          NewTeamMemberClient(cfg),
+		Tournament:          NewTournamentClient(cfg),
+		TournamentAdmin:     NewTournamentAdminClient(cfg),
+		User:                NewUserClient(cfg),
+		UserVote:            NewUserVoteClient(cfg),
+		Vote:                NewVoteClient(cfg),
+		VoteTemplate:        NewVoteTemplateClient(cfg),
 	}, nil
 }
 
@@ -270,9 +312,10 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.App, c.AuthCode, c.AuthRefreshToken, c.AuthToken, c.Component, c.Consent,
-		c.Invitation, c.Notification, c.RankGroup, c.Team, c.TeamMember, c.Tournament,
-		c.TournamentAdmin, c.User, c.UserVote, c.Vote,
+		c.App, c.AuditLog, c.AuthCode, c.AuthRefreshToken, c.AuthRequest, c.AuthToken,
+		c.Component, c.Consent, c.DeviceAuthorization, c.Invitation, c.Notification,
+		c.RankGroup, c.RoleGrant, c.Sanction, c.SigningKey, c.Team, c.TeamMember,
+		c.Tournament, c.TournamentAdmin, c.User, c.UserVote, c.Vote, c.VoteTemplate,
 	} {
 		n.Use(hooks...)
 	}
@@ -282,9 +325,10 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.App, c.AuthCode, c.AuthRefreshToken, c.AuthToken, c.Component, c.Consent,
-		c.Invitation, c.Notification, c.RankGroup, c.Team, c.TeamMember, c.Tournament,
-		c.TournamentAdmin, c.User, c.UserVote, c.Vote,
+		c.App, c.AuditLog, c.AuthCode, c.AuthRefreshToken, c.AuthRequest, c.AuthToken,
+		c.Component, c.Consent, c.DeviceAuthorization, c.Invitation, c.Notification,
+		c.RankGroup, c.RoleGrant, c.Sanction, c.SigningKey, c.Team, c.TeamMember,
+		c.Tournament, c.TournamentAdmin, c.User, c.UserVote, c.Vote, c.VoteTemplate,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -295,22 +339,34 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *AppMutation:
 		return c.App.mutate(ctx, m)
+	case *AuditLogMutation:
+		return c.AuditLog.mutate(ctx, m)
 	case *AuthCodeMutation:
 		return c.AuthCode.mutate(ctx, m)
 	case *AuthRefreshTokenMutation:
 		return c.AuthRefreshToken.mutate(ctx, m)
+	case *AuthRequestMutation:
+		return c.AuthRequest.mutate(ctx, m)
 	case *AuthTokenMutation:
 		return c.AuthToken.mutate(ctx, m)
 	case *ComponentMutation:
 		return c.Component.mutate(ctx, m)
 	case *ConsentMutation:
 		return c.Consent.mutate(ctx, m)
+	case *DeviceAuthorizationMutation:
+		return c.DeviceAuthorization.mutate(ctx, m)
 	case *InvitationMutation:
 		return c.Invitation.mutate(ctx, m)
 	case *NotificationMutation:
 		return c.Notification.mutate(ctx, m)
 	case *RankGroupMutation:
 		return c.RankGroup.mutate(ctx, m)
+	case *RoleGrantMutation:
+		return c.RoleGrant.mutate(ctx, m)
+	case *SanctionMutation:
+		return c.Sanction.mutate(ctx, m)
+	case *SigningKeyMutation:
+		return c.SigningKey.mutate(ctx, m)
 	case *TeamMutation:
 		return c.Team.mutate(ctx, m)
 	case *TeamMemberMutation:
@@ -325,6 +381,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.UserVote.mutate(ctx, m)
 	case *VoteMutation:
 		return c.Vote.mutate(ctx, m)
+	case *VoteTemplateMutation:
+		return c.VoteTemplate.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -495,6 +553,171 @@ func (c *AppClient) mutate(ctx context.Context, m *AppMutation) (Value, error) {
 	}
 }
 
+// AuditLogClient is a client for the AuditLog schema.
+type AuditLogClient struct {
+	config
+}
+
+// NewAuditLogClient returns a client for the AuditLog from the given config.
+func NewAuditLogClient(c config) *AuditLogClient {
+	return &AuditLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditlog.Hooks(f(g(h())))`.
+func (c *AuditLogClient) Use(hooks ...Hook) {
+	c.hooks.AuditLog = append(c.hooks.AuditLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditlog.Intercept(f(g(h())))`.
+func (c *AuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditLog = append(c.inters.AuditLog, interceptors...)
+}
+
+// Create returns a builder for creating a AuditLog entity.
+func (c *AuditLogClient) Create() *AuditLogCreate {
+	mutation := newAuditLogMutation(c.config, OpCreate)
+	return &AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditLog entities.
+func (c *AuditLogClient) CreateBulk(builders ...*AuditLogCreate) *AuditLogCreateBulk {
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditLogClient) MapCreateBulk(slice any, setFunc func(*AuditLogCreate, int)) *AuditLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditLogCreateBulk{err: fmt.Errorf("calling to AuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditLog.
+func (c *AuditLogClient) Update() *AuditLogUpdate {
+	mutation := newAuditLogMutation(c.config, OpUpdate)
+	return &AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditLogClient) UpdateOne(_m *AuditLog) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLog(_m))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditLogClient) UpdateOneID(id int) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLogID(id))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditLog.
+func (c *AuditLogClient) Delete() *AuditLogDelete {
+	mutation := newAuditLogMutation(c.config, OpDelete)
+	return &AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditLogClient) DeleteOne(_m *AuditLog) *AuditLogDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditLogClient) DeleteOneID(id int) *AuditLogDeleteOne {
+	builder := c.Delete().Where(auditlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditLogDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditLog.
+func (c *AuditLogClient) Query() *AuditLogQuery {
+	return &AuditLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditLog entity by its id.
+func (c *AuditLogClient) Get(ctx context.Context, id int) (*AuditLog, error) {
+	return c.Query().Where(auditlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditLogClient) GetX(ctx context.Context, id int) *AuditLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryActor queries the actor edge of a AuditLog.
+func (c *AuditLogClient) QueryActor(_m *AuditLog) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(auditlog.Table, auditlog.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, auditlog.ActorTable, auditlog.ActorColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryImpersonator queries the impersonator edge of a AuditLog.
+func (c *AuditLogClient) QueryImpersonator(_m *AuditLog) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(auditlog.Table, auditlog.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, auditlog.ImpersonatorTable, auditlog.ImpersonatorColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AuditLogClient) Hooks() []Hook {
+	return c.hooks.AuditLog
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditLogClient) Interceptors() []Interceptor {
+	return c.inters.AuditLog
+}
+
+func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditLog mutation op: %q", m.Op())
+	}
+}
+
 // AuthCodeClient is a client for the AuthCode schema.
 type AuthCodeClient struct {
 	config
@@ -761,6 +984,139 @@ func (c *AuthRefreshTokenClient) mutate(ctx context.Context, m *AuthRefreshToken
 	}
 }
 
+// AuthRequestClient is a client for the AuthRequest schema.
+type AuthRequestClient struct {
+	config
+}
+
+// NewAuthRequestClient returns a client for the AuthRequest from the given config.
+func NewAuthRequestClient(c config) *AuthRequestClient {
+	return &AuthRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `authrequest.Hooks(f(g(h())))`.
+func (c *AuthRequestClient) Use(hooks ...Hook) {
+	c.hooks.AuthRequest = append(c.hooks.AuthRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `authrequest.Intercept(f(g(h())))`.
+func (c *AuthRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuthRequest = append(c.inters.AuthRequest, interceptors...)
+}
+
+// Create returns a builder for creating a AuthRequest entity.
+func (c *AuthRequestClient) Create() *AuthRequestCreate {
+	mutation := newAuthRequestMutation(c.config, OpCreate)
+	return &AuthRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuthRequest entities.
+func (c *AuthRequestClient) CreateBulk(builders ...*AuthRequestCreate) *AuthRequestCreateBulk {
+	return &AuthRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuthRequestClient) MapCreateBulk(slice any, setFunc func(*AuthRequestCreate, int)) *AuthRequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuthRequestCreateBulk{err: fmt.Errorf("calling to AuthRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuthRequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuthRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuthRequest.
+func (c *AuthRequestClient) Update() *AuthRequestUpdate {
+	mutation := newAuthRequestMutation(c.config, OpUpdate)
+	return &AuthRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuthRequestClient) UpdateOne(_m *AuthRequest) *AuthRequestUpdateOne {
+	mutation := newAuthRequestMutation(c.config, OpUpdateOne, withAuthRequest(_m))
+	return &AuthRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuthRequestClient) UpdateOneID(id string) *AuthRequestUpdateOne {
+	mutation := newAuthRequestMutation(c.config, OpUpdateOne, withAuthRequestID(id))
+	return &AuthRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuthRequest.
+func (c *AuthRequestClient) Delete() *AuthRequestDelete {
+	mutation := newAuthRequestMutation(c.config, OpDelete)
+	return &AuthRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuthRequestClient) DeleteOne(_m *AuthRequest) *AuthRequestDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuthRequestClient) DeleteOneID(id string) *AuthRequestDeleteOne {
+	builder := c.Delete().Where(authrequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuthRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for AuthRequest.
+func (c *AuthRequestClient) Query() *AuthRequestQuery {
+	return &AuthRequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuthRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuthRequest entity by its id.
+func (c *AuthRequestClient) Get(ctx context.Context, id string) (*AuthRequest, error) {
+	return c.Query().Where(authrequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuthRequestClient) GetX(ctx context.Context, id string) *AuthRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuthRequestClient) Hooks() []Hook {
+	return c.hooks.AuthRequest
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuthRequestClient) Interceptors() []Interceptor {
+	return c.inters.AuthRequest
+}
+
+func (c *AuthRequestClient) mutate(ctx context.Context, m *AuthRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuthRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuthRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuthRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuthRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuthRequest mutation op: %q", m.Op())
+	}
+}
+
 // AuthTokenClient is a client for the AuthToken schema.
 type AuthTokenClient struct {
 	config
@@ -1224,6 +1580,139 @@ func (c *ConsentClient) mutate(ctx context.Context, m *ConsentMutation) (Value,
 	}
 }
 
+// DeviceAuthorizationClient is a client for the DeviceAuthorization schema.
+type DeviceAuthorizationClient struct {
+	config
+}
+
+// NewDeviceAuthorizationClient returns a client for the DeviceAuthorization from the given config.
+func NewDeviceAuthorizationClient(c config) *DeviceAuthorizationClient {
+	return &DeviceAuthorizationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `deviceauthorization.Hooks(f(g(h())))`.
+func (c *DeviceAuthorizationClient) Use(hooks ...Hook) {
+	c.hooks.DeviceAuthorization = append(c.hooks.DeviceAuthorization, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `deviceauthorization.Intercept(f(g(h())))`.
+func (c *DeviceAuthorizationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeviceAuthorization = append(c.inters.DeviceAuthorization, interceptors...)
+}
+
+// Create returns a builder for creating a DeviceAuthorization entity.
+func (c *DeviceAuthorizationClient) Create() *DeviceAuthorizationCreate {
+	mutation := newDeviceAuthorizationMutation(c.config, OpCreate)
+	return &DeviceAuthorizationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DeviceAuthorization entities.
+func (c *DeviceAuthorizationClient) CreateBulk(builders ...*DeviceAuthorizationCreate) *DeviceAuthorizationCreateBulk {
+	return &DeviceAuthorizationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeviceAuthorizationClient) MapCreateBulk(slice any, setFunc func(*DeviceAuthorizationCreate, int)) *DeviceAuthorizationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeviceAuthorizationCreateBulk{err: fmt.Errorf("calling to DeviceAuthorizationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeviceAuthorizationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeviceAuthorizationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DeviceAuthorization.
+func (c *DeviceAuthorizationClient) Update() *DeviceAuthorizationUpdate {
+	mutation := newDeviceAuthorizationMutation(c.config, OpUpdate)
+	return &DeviceAuthorizationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeviceAuthorizationClient) UpdateOne(_m *DeviceAuthorization) *DeviceAuthorizationUpdateOne {
+	mutation := newDeviceAuthorizationMutation(c.config, OpUpdateOne, withDeviceAuthorization(_m))
+	return &DeviceAuthorizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeviceAuthorizationClient) UpdateOneID(id string) *DeviceAuthorizationUpdateOne {
+	mutation := newDeviceAuthorizationMutation(c.config, OpUpdateOne, withDeviceAuthorizationID(id))
+	return &DeviceAuthorizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DeviceAuthorization.
+func (c *DeviceAuthorizationClient) Delete() *DeviceAuthorizationDelete {
+	mutation := newDeviceAuthorizationMutation(c.config, OpDelete)
+	return &DeviceAuthorizationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeviceAuthorizationClient) DeleteOne(_m *DeviceAuthorization) *DeviceAuthorizationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeviceAuthorizationClient) DeleteOneID(id string) *DeviceAuthorizationDeleteOne {
+	builder := c.Delete().Where(deviceauthorization.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeviceAuthorizationDeleteOne{builder}
+}
+
+// Query returns a query builder for DeviceAuthorization.
+func (c *DeviceAuthorizationClient) Query() *DeviceAuthorizationQuery {
+	return &DeviceAuthorizationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDeviceAuthorization},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DeviceAuthorization entity by its id.
+func (c *DeviceAuthorizationClient) Get(ctx context.Context, id string) (*DeviceAuthorization, error) {
+	return c.Query().Where(deviceauthorization.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeviceAuthorizationClient) GetX(ctx context.Context, id string) *DeviceAuthorization {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DeviceAuthorizationClient) Hooks() []Hook {
+	return c.hooks.DeviceAuthorization
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeviceAuthorizationClient) Interceptors() []Interceptor {
+	return c.inters.DeviceAuthorization
+}
+
+func (c *DeviceAuthorizationClient) mutate(ctx context.Context, m *DeviceAuthorizationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeviceAuthorizationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeviceAuthorizationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeviceAuthorizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeviceAuthorizationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DeviceAuthorization mutation op: %q", m.Op())
+	}
+}
+
 // InvitationClient is a client for the Invitation schema.
 type InvitationClient struct {
 	config
@@ -1578,67 +2067,594 @@ func (c *RankGroupClient) MapCreateBulk(slice any, setFunc func(*RankGroupCreate
 	if rv.Kind() != reflect.Slice {
 		return &RankGroupCreateBulk{err: fmt.Errorf("calling to RankGroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RankGroupCreate, rv.Len())
+	builders := make([]*RankGroupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RankGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RankGroup.
+func (c *RankGroupClient) Update() *RankGroupUpdate {
+	mutation := newRankGroupMutation(c.config, OpUpdate)
+	return &RankGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RankGroupClient) UpdateOne(_m *RankGroup) *RankGroupUpdateOne {
+	mutation := newRankGroupMutation(c.config, OpUpdateOne, withRankGroup(_m))
+	return &RankGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RankGroupClient) UpdateOneID(id int) *RankGroupUpdateOne {
+	mutation := newRankGroupMutation(c.config, OpUpdateOne, withRankGroupID(id))
+	return &RankGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RankGroup.
+func (c *RankGroupClient) Delete() *RankGroupDelete {
+	mutation := newRankGroupMutation(c.config, OpDelete)
+	return &RankGroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RankGroupClient) DeleteOne(_m *RankGroup) *RankGroupDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RankGroupClient) DeleteOneID(id int) *RankGroupDeleteOne {
+	builder := c.Delete().Where(rankgroup.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RankGroupDeleteOne{builder}
+}
+
+// Query returns a query builder for RankGroup.
+func (c *RankGroupClient) Query() *RankGroupQuery {
+	return &RankGroupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRankGroup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RankGroup entity by its id.
+func (c *RankGroupClient) Get(ctx context.Context, id int) (*RankGroup, error) {
+	return c.Query().Where(rankgroup.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RankGroupClient) GetX(ctx context.Context, id int) *RankGroup {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTournament queries the tournament edge of a RankGroup.
+func (c *RankGroupClient) QueryTournament(_m *RankGroup) *TournamentQuery {
+	query := (&TournamentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rankgroup.Table, rankgroup.FieldID, id),
+			sqlgraph.To(tournament.Table, tournament.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rankgroup.TournamentTable, rankgroup.TournamentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTeams queries the teams edge of a RankGroup.
+func (c *RankGroupClient) QueryTeams(_m *RankGroup) *TeamQuery {
+	query := (&TeamClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rankgroup.Table, rankgroup.FieldID, id),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, rankgroup.TeamsTable, rankgroup.TeamsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RankGroupClient) Hooks() []Hook {
+	return c.hooks.RankGroup
+}
+
+// Interceptors returns the client interceptors.
+func (c *RankGroupClient) Interceptors() []Interceptor {
+	return c.inters.RankGroup
+}
+
+func (c *RankGroupClient) mutate(ctx context.Context, m *RankGroupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RankGroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RankGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RankGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RankGroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RankGroup mutation op: %q", m.Op())
+	}
+}
+
+// RoleGrantClient is a client for the RoleGrant schema.
+type RoleGrantClient struct {
+	config
+}
+
+// NewRoleGrantClient returns a client for the RoleGrant from the given config.
+func NewRoleGrantClient(c config) *RoleGrantClient {
+	return &RoleGrantClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `rolegrant.Hooks(f(g(h())))`.
+func (c *RoleGrantClient) Use(hooks ...Hook) {
+	c.hooks.RoleGrant = append(c.hooks.RoleGrant, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `rolegrant.Intercept(f(g(h())))`.
+func (c *RoleGrantClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RoleGrant = append(c.inters.RoleGrant, interceptors...)
+}
+
+// Create returns a builder for creating a RoleGrant entity.
+func (c *RoleGrantClient) Create() *RoleGrantCreate {
+	mutation := newRoleGrantMutation(c.config, OpCreate)
+	return &RoleGrantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RoleGrant entities.
+func (c *RoleGrantClient) CreateBulk(builders ...*RoleGrantCreate) *RoleGrantCreateBulk {
+	return &RoleGrantCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoleGrantClient) MapCreateBulk(slice any, setFunc func(*RoleGrantCreate, int)) *RoleGrantCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoleGrantCreateBulk{err: fmt.Errorf("calling to RoleGrantClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoleGrantCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoleGrantCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RoleGrant.
+func (c *RoleGrantClient) Update() *RoleGrantUpdate {
+	mutation := newRoleGrantMutation(c.config, OpUpdate)
+	return &RoleGrantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoleGrantClient) UpdateOne(_m *RoleGrant) *RoleGrantUpdateOne {
+	mutation := newRoleGrantMutation(c.config, OpUpdateOne, withRoleGrant(_m))
+	return &RoleGrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoleGrantClient) UpdateOneID(id int) *RoleGrantUpdateOne {
+	mutation := newRoleGrantMutation(c.config, OpUpdateOne, withRoleGrantID(id))
+	return &RoleGrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RoleGrant.
+func (c *RoleGrantClient) Delete() *RoleGrantDelete {
+	mutation := newRoleGrantMutation(c.config, OpDelete)
+	return &RoleGrantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoleGrantClient) DeleteOne(_m *RoleGrant) *RoleGrantDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoleGrantClient) DeleteOneID(id int) *RoleGrantDeleteOne {
+	builder := c.Delete().Where(rolegrant.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoleGrantDeleteOne{builder}
+}
+
+// Query returns a query builder for RoleGrant.
+func (c *RoleGrantClient) Query() *RoleGrantQuery {
+	return &RoleGrantQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRoleGrant},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RoleGrant entity by its id.
+func (c *RoleGrantClient) Get(ctx context.Context, id int) (*RoleGrant, error) {
+	return c.Query().Where(rolegrant.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoleGrantClient) GetX(ctx context.Context, id int) *RoleGrant {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a RoleGrant.
+func (c *RoleGrantClient) QueryUser(_m *RoleGrant) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rolegrant.Table, rolegrant.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rolegrant.UserTable, rolegrant.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGrantedBy queries the granted_by edge of a RoleGrant.
+func (c *RoleGrantClient) QueryGrantedBy(_m *RoleGrant) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rolegrant.Table, rolegrant.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rolegrant.GrantedByTable, rolegrant.GrantedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RoleGrantClient) Hooks() []Hook {
+	return c.hooks.RoleGrant
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoleGrantClient) Interceptors() []Interceptor {
+	return c.inters.RoleGrant
+}
+
+func (c *RoleGrantClient) mutate(ctx context.Context, m *RoleGrantMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoleGrantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoleGrantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoleGrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoleGrantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RoleGrant mutation op: %q", m.Op())
+	}
+}
+
+// SanctionClient is a client for the Sanction schema.
+type SanctionClient struct {
+	config
+}
+
+// NewSanctionClient returns a client for the Sanction from the given config.
+func NewSanctionClient(c config) *SanctionClient {
+	return &SanctionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sanction.Hooks(f(g(h())))`.
+func (c *SanctionClient) Use(hooks ...Hook) {
+	c.hooks.Sanction = append(c.hooks.Sanction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sanction.Intercept(f(g(h())))`.
+func (c *SanctionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Sanction = append(c.inters.Sanction, interceptors...)
+}
+
+// Create returns a builder for creating a Sanction entity.
+func (c *SanctionClient) Create() *SanctionCreate {
+	mutation := newSanctionMutation(c.config, OpCreate)
+	return &SanctionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Sanction entities.
+func (c *SanctionClient) CreateBulk(builders ...*SanctionCreate) *SanctionCreateBulk {
+	return &SanctionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SanctionClient) MapCreateBulk(slice any, setFunc func(*SanctionCreate, int)) *SanctionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SanctionCreateBulk{err: fmt.Errorf("calling to SanctionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SanctionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SanctionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Sanction.
+func (c *SanctionClient) Update() *SanctionUpdate {
+	mutation := newSanctionMutation(c.config, OpUpdate)
+	return &SanctionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SanctionClient) UpdateOne(_m *Sanction) *SanctionUpdateOne {
+	mutation := newSanctionMutation(c.config, OpUpdateOne, withSanction(_m))
+	return &SanctionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SanctionClient) UpdateOneID(id int) *SanctionUpdateOne {
+	mutation := newSanctionMutation(c.config, OpUpdateOne, withSanctionID(id))
+	return &SanctionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Sanction.
+func (c *SanctionClient) Delete() *SanctionDelete {
+	mutation := newSanctionMutation(c.config, OpDelete)
+	return &SanctionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SanctionClient) DeleteOne(_m *Sanction) *SanctionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SanctionClient) DeleteOneID(id int) *SanctionDeleteOne {
+	builder := c.Delete().Where(sanction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SanctionDeleteOne{builder}
+}
+
+// Query returns a query builder for Sanction.
+func (c *SanctionClient) Query() *SanctionQuery {
+	return &SanctionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSanction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Sanction entity by its id.
+func (c *SanctionClient) Get(ctx context.Context, id int) (*Sanction, error) {
+	return c.Query().Where(sanction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SanctionClient) GetX(ctx context.Context, id int) *Sanction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Sanction.
+func (c *SanctionClient) QueryUser(_m *Sanction) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sanction.Table, sanction.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sanction.UserTable, sanction.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIssuer queries the issuer edge of a Sanction.
+func (c *SanctionClient) QueryIssuer(_m *Sanction) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sanction.Table, sanction.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sanction.IssuerTable, sanction.IssuerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLiftedBy queries the lifted_by edge of a Sanction.
+func (c *SanctionClient) QueryLiftedBy(_m *Sanction) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sanction.Table, sanction.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sanction.LiftedByTable, sanction.LiftedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTournament queries the tournament edge of a Sanction.
+func (c *SanctionClient) QueryTournament(_m *Sanction) *TournamentQuery {
+	query := (&TournamentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sanction.Table, sanction.FieldID, id),
+			sqlgraph.To(tournament.Table, tournament.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sanction.TournamentTable, sanction.TournamentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SanctionClient) Hooks() []Hook {
+	return c.hooks.Sanction
+}
+
+// Interceptors returns the client interceptors.
+func (c *SanctionClient) Interceptors() []Interceptor {
+	return c.inters.Sanction
+}
+
+func (c *SanctionClient) mutate(ctx context.Context, m *SanctionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SanctionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SanctionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SanctionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SanctionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Sanction mutation op: %q", m.Op())
+	}
+}
+
+// SigningKeyClient is a client for the SigningKey schema.
+type SigningKeyClient struct {
+	config
+}
+
+// NewSigningKeyClient returns a client for the SigningKey from the given config.
+func NewSigningKeyClient(c config) *SigningKeyClient {
+	return &SigningKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `signingkey.Hooks(f(g(h())))`.
+func (c *SigningKeyClient) Use(hooks ...Hook) {
+	c.hooks.SigningKey = append(c.hooks.SigningKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `signingkey.Intercept(f(g(h())))`.
+func (c *SigningKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SigningKey = append(c.inters.SigningKey, interceptors...)
+}
+
+// Create returns a builder for creating a SigningKey entity.
+func (c *SigningKeyClient) Create() *SigningKeyCreate {
+	mutation := newSigningKeyMutation(c.config, OpCreate)
+	return &SigningKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SigningKey entities.
+func (c *SigningKeyClient) CreateBulk(builders ...*SigningKeyCreate) *SigningKeyCreateBulk {
+	return &SigningKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SigningKeyClient) MapCreateBulk(slice any, setFunc func(*SigningKeyCreate, int)) *SigningKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SigningKeyCreateBulk{err: fmt.Errorf("calling to SigningKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SigningKeyCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RankGroupCreateBulk{config: c.config, builders: builders}
+	return &SigningKeyCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for RankGroup.
-func (c *RankGroupClient) Update() *RankGroupUpdate {
-	mutation := newRankGroupMutation(c.config, OpUpdate)
-	return &RankGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SigningKey.
+func (c *SigningKeyClient) Update() *SigningKeyUpdate {
+	mutation := newSigningKeyMutation(c.config, OpUpdate)
+	return &SigningKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RankGroupClient) UpdateOne(_m *RankGroup) *RankGroupUpdateOne {
-	mutation := newRankGroupMutation(c.config, OpUpdateOne, withRankGroup(_m))
-	return &RankGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SigningKeyClient) UpdateOne(_m *SigningKey) *SigningKeyUpdateOne {
+	mutation := newSigningKeyMutation(c.config, OpUpdateOne, withSigningKey(_m))
+	return &SigningKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RankGroupClient) UpdateOneID(id int) *RankGroupUpdateOne {
-	mutation := newRankGroupMutation(c.config, OpUpdateOne, withRankGroupID(id))
-	return &RankGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SigningKeyClient) UpdateOneID(id string) *SigningKeyUpdateOne {
+	mutation := newSigningKeyMutation(c.config, OpUpdateOne, withSigningKeyID(id))
+	return &SigningKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for RankGroup.
-func (c *RankGroupClient) Delete() *RankGroupDelete {
-	mutation := newRankGroupMutation(c.config, OpDelete)
-	return &RankGroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SigningKey.
+func (c *SigningKeyClient) Delete() *SigningKeyDelete {
+	mutation := newSigningKeyMutation(c.config, OpDelete)
+	return &SigningKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RankGroupClient) DeleteOne(_m *RankGroup) *RankGroupDeleteOne {
+func (c *SigningKeyClient) DeleteOne(_m *SigningKey) *SigningKeyDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RankGroupClient) DeleteOneID(id int) *RankGroupDeleteOne {
-	builder := c.Delete().Where(rankgroup.ID(id))
+func (c *SigningKeyClient) DeleteOneID(id string) *SigningKeyDeleteOne {
+	builder := c.Delete().Where(signingkey.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RankGroupDeleteOne{builder}
+	return &SigningKeyDeleteOne{builder}
 }
 
-// Query returns a query builder for RankGroup.
-func (c *RankGroupClient) Query() *RankGroupQuery {
-	return &RankGroupQuery{
+// Query returns a query builder for SigningKey.
+func (c *SigningKeyClient) Query() *SigningKeyQuery {
+	return &SigningKeyQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRankGroup},
+		ctx:    &QueryContext{Type: TypeSigningKey},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a RankGroup entity by its id.
-func (c *RankGroupClient) Get(ctx context.Context, id int) (*RankGroup, error) {
-	return c.Query().Where(rankgroup.ID(id)).Only(ctx)
+// Get returns a SigningKey entity by its id.
+func (c *SigningKeyClient) Get(ctx context.Context, id string) (*SigningKey, error) {
+	return c.Query().Where(signingkey.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RankGroupClient) GetX(ctx context.Context, id int) *RankGroup {
+func (c *SigningKeyClient) GetX(ctx context.Context, id string) *SigningKey {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1646,60 +2662,28 @@ func (c *RankGroupClient) GetX(ctx context.Context, id int) *RankGroup {
 	return obj
 }
 
-// QueryTournament queries the tournament edge of a RankGroup.
-func (c *RankGroupClient) QueryTournament(_m *RankGroup) *TournamentQuery {
-	query := (&TournamentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(rankgroup.Table, rankgroup.FieldID, id),
-			sqlgraph.To(tournament.Table, tournament.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, rankgroup.TournamentTable, rankgroup.TournamentColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryTeams queries the teams edge of a RankGroup.
-func (c *RankGroupClient) QueryTeams(_m *RankGroup) *TeamQuery {
-	query := (&TeamClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(rankgroup.Table, rankgroup.FieldID, id),
-			sqlgraph.To(team.Table, team.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, rankgroup.TeamsTable, rankgroup.TeamsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *RankGroupClient) Hooks() []Hook {
-	return c.hooks.RankGroup
+func (c *SigningKeyClient) Hooks() []Hook {
+	return c.hooks.SigningKey
 }
 
 // Interceptors returns the client interceptors.
-func (c *RankGroupClient) Interceptors() []Interceptor {
-	return c.inters.RankGroup
+func (c *SigningKeyClient) Interceptors() []Interceptor {
+	return c.inters.SigningKey
 }
 
-func (c *RankGroupClient) mutate(ctx context.Context, m *RankGroupMutation) (Value, error) {
+func (c *SigningKeyClient) mutate(ctx context.Context, m *SigningKeyMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RankGroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SigningKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RankGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SigningKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RankGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SigningKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RankGroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SigningKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown RankGroup mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SigningKey mutation op: %q", m.Op())
 	}
 }
 
@@ -2285,6 +3269,22 @@ func (c *TournamentClient) QueryTeamMembers(_m *Tournament) *TeamMemberQuery {
 	return query
 }
 
+// QuerySanctions queries the sanctions edge of a Tournament.
+func (c *TournamentClient) QuerySanctions(_m *Tournament) *SanctionQuery {
+	query := (&SanctionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tournament.Table, tournament.FieldID, id),
+			sqlgraph.To(sanction.Table, sanction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tournament.SanctionsTable, tournament.SanctionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *TournamentClient) Hooks() []Hook {
 	return c.hooks.Tournament
@@ -2615,6 +3615,22 @@ func (c *UserClient) QueryCreatedVotes(_m *User) *VoteQuery {
 	return query
 }
 
+// QueryCreatedVoteTemplates queries the created_vote_templates edge of a User.
+func (c *UserClient) QueryCreatedVoteTemplates(_m *User) *VoteTemplateQuery {
+	query := (&VoteTemplateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(votetemplate.Table, votetemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.CreatedVoteTemplatesTable, user.CreatedVoteTemplatesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryApps queries the apps edge of a User.
 func (c *UserClient) QueryApps(_m *User) *AppQuery {
 	query := (&AppClient{config: c.config}).Query()
@@ -2743,6 +3759,118 @@ func (c *UserClient) QueryNotifications(_m *User) *NotificationQuery {
 	return query
 }
 
+// QueryAuditLogs queries the audit_logs edge of a User.
+func (c *UserClient) QueryAuditLogs(_m *User) *AuditLogQuery {
+	query := (&AuditLogClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(auditlog.Table, auditlog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AuditLogsTable, user.AuditLogsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryImpersonatedAuditLogs queries the impersonated_audit_logs edge of a User.
+func (c *UserClient) QueryImpersonatedAuditLogs(_m *User) *AuditLogQuery {
+	query := (&AuditLogClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(auditlog.Table, auditlog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ImpersonatedAuditLogsTable, user.ImpersonatedAuditLogsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRoleGrants queries the role_grants edge of a User.
+func (c *UserClient) QueryRoleGrants(_m *User) *RoleGrantQuery {
+	query := (&RoleGrantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(rolegrant.Table, rolegrant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RoleGrantsTable, user.RoleGrantsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGivenRoleGrants queries the given_role_grants edge of a User.
+func (c *UserClient) QueryGivenRoleGrants(_m *User) *RoleGrantQuery {
+	query := (&RoleGrantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(rolegrant.Table, rolegrant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.GivenRoleGrantsTable, user.GivenRoleGrantsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySanctions queries the sanctions edge of a User.
+func (c *UserClient) QuerySanctions(_m *User) *SanctionQuery {
+	query := (&SanctionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(sanction.Table, sanction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SanctionsTable, user.SanctionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIssuedSanctions queries the issued_sanctions edge of a User.
+func (c *UserClient) QueryIssuedSanctions(_m *User) *SanctionQuery {
+	query := (&SanctionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(sanction.Table, sanction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.IssuedSanctionsTable, user.IssuedSanctionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLiftedSanctions queries the lifted_sanctions edge of a User.
+func (c *UserClient) QueryLiftedSanctions(_m *User) *SanctionQuery {
+	query := (&SanctionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(sanction.Table, sanction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LiftedSanctionsTable, user.LiftedSanctionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -3098,16 +4226,167 @@ func (c *VoteClient) mutate(ctx context.Context, m *VoteMutation) (Value, error)
 	}
 }
 
+// VoteTemplateClient is a client for the VoteTemplate schema.
+type VoteTemplateClient struct {
+	config
+}
+
+// NewVoteTemplateClient returns a client for the VoteTemplate from the given config.
+func NewVoteTemplateClient(c config) *VoteTemplateClient {
+	return &VoteTemplateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `votetemplate.Hooks(f(g(h())))`.
+func (c *VoteTemplateClient) Use(hooks ...Hook) {
+	c.hooks.VoteTemplate = append(c.hooks.VoteTemplate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `votetemplate.Intercept(f(g(h())))`.
+func (c *VoteTemplateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VoteTemplate = append(c.inters.VoteTemplate, interceptors...)
+}
+
+// Create returns a builder for creating a VoteTemplate entity.
+func (c *VoteTemplateClient) Create() *VoteTemplateCreate {
+	mutation := newVoteTemplateMutation(c.config, OpCreate)
+	return &VoteTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VoteTemplate entities.
+func (c *VoteTemplateClient) CreateBulk(builders ...*VoteTemplateCreate) *VoteTemplateCreateBulk {
+	return &VoteTemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VoteTemplateClient) MapCreateBulk(slice any, setFunc func(*VoteTemplateCreate, int)) *VoteTemplateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VoteTemplateCreateBulk{err: fmt.Errorf("calling to VoteTemplateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VoteTemplateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VoteTemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VoteTemplate.
+func (c *VoteTemplateClient) Update() *VoteTemplateUpdate {
+	mutation := newVoteTemplateMutation(c.config, OpUpdate)
+	return &VoteTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VoteTemplateClient) UpdateOne(_m *VoteTemplate) *VoteTemplateUpdateOne {
+	mutation := newVoteTemplateMutation(c.config, OpUpdateOne, withVoteTemplate(_m))
+	return &VoteTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VoteTemplateClient) UpdateOneID(id int) *VoteTemplateUpdateOne {
+	mutation := newVoteTemplateMutation(c.config, OpUpdateOne, withVoteTemplateID(id))
+	return &VoteTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VoteTemplate.
+func (c *VoteTemplateClient) Delete() *VoteTemplateDelete {
+	mutation := newVoteTemplateMutation(c.config, OpDelete)
+	return &VoteTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VoteTemplateClient) DeleteOne(_m *VoteTemplate) *VoteTemplateDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VoteTemplateClient) DeleteOneID(id int) *VoteTemplateDeleteOne {
+	builder := c.Delete().Where(votetemplate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VoteTemplateDeleteOne{builder}
+}
+
+// Query returns a query builder for VoteTemplate.
+func (c *VoteTemplateClient) Query() *VoteTemplateQuery {
+	return &VoteTemplateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVoteTemplate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a VoteTemplate entity by its id.
+func (c *VoteTemplateClient) Get(ctx context.Context, id int) (*VoteTemplate, error) {
+	return c.Query().Where(votetemplate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VoteTemplateClient) GetX(ctx context.Context, id int) *VoteTemplate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCreator queries the creator edge of a VoteTemplate.
+func (c *VoteTemplateClient) QueryCreator(_m *VoteTemplate) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(votetemplate.Table, votetemplate.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, votetemplate.CreatorTable, votetemplate.CreatorColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *VoteTemplateClient) Hooks() []Hook {
+	return c.hooks.VoteTemplate
+}
+
+// Interceptors returns the client interceptors.
+func (c *VoteTemplateClient) Interceptors() []Interceptor {
+	return c.inters.VoteTemplate
+}
+
+func (c *VoteTemplateClient) mutate(ctx context.Context, m *VoteTemplateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VoteTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VoteTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VoteTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VoteTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown VoteTemplate mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		App, AuthCode, AuthRefreshToken, AuthToken, Component, Consent, Invitation,
-		Notification, RankGroup, Team, TeamMember, Tournament, TournamentAdmin, User,
-		UserVote, Vote []ent.Hook
+		App, AuditLog, AuthCode, AuthRefreshToken, AuthRequest, AuthToken, Component,
+		Consent, DeviceAuthorization, Invitation, Notification, RankGroup, RoleGrant,
+		Sanction, SigningKey, Team, TeamMember, Tournament, TournamentAdmin, User,
+		UserVote, Vote, VoteTemplate []ent.Hook
 	}
 	inters struct {
-		App, AuthCode, AuthRefreshToken, AuthToken, Component, Consent, Invitation,
-		Notification, RankGroup, Team, TeamMember, Tournament, TournamentAdmin, User,
-		UserVote, Vote []ent.Interceptor
+		App, AuditLog, AuthCode, AuthRefreshToken, AuthRequest, AuthToken, Component,
+		Consent, DeviceAuthorization, Invitation, Notification, RankGroup, RoleGrant,
+		Sanction, SigningKey, Team, TeamMember, Tournament, TournamentAdmin, User,
+		UserVote, Vote, VoteTemplate []ent.Interceptor
 	}
 )
@@ -19,8 +19,9 @@ import (
 // ComponentUpdate is the builder for updating Component entities.
 type ComponentUpdate struct {
 	config
-	hooks    []Hook
-	mutation *ComponentMutation
+	hooks     []Hook
+	mutation  *ComponentMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the ComponentUpdate builder.
@@ -196,6 +197,12 @@ func (_u *ComponentUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ComponentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ComponentUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ComponentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -303,6 +310,7 @@ func (_u *ComponentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{component.Label}
@@ -318,9 +326,10 @@ func (_u *ComponentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // ComponentUpdateOne is the builder for updating a single Component entity.
 type ComponentUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *ComponentMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *ComponentMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetName sets the "name" field.
@@ -503,6 +512,12 @@ func (_u *ComponentUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ComponentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ComponentUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ComponentUpdateOne) sqlSave(ctx context.Context) (_node *Component, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -627,6 +642,7 @@ func (_u *ComponentUpdateOne) sqlSave(ctx context.Context) (_node *Component, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Component{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,120 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deviceauthorization
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the deviceauthorization type in the database.
+	Label = "device_authorization"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserCode holds the string denoting the user_code field in the database.
+	FieldUserCode = "user_code"
+	// FieldApplicationID holds the string denoting the application_id field in the database.
+	FieldApplicationID = "application_id"
+	// FieldScopes holds the string denoting the scopes field in the database.
+	FieldScopes = "scopes"
+	// FieldDone holds the string denoting the done field in the database.
+	FieldDone = "done"
+	// FieldDenied holds the string denoting the denied field in the database.
+	FieldDenied = "denied"
+	// FieldSubject holds the string denoting the subject field in the database.
+	FieldSubject = "subject"
+	// FieldAuthTime holds the string denoting the auth_time field in the database.
+	FieldAuthTime = "auth_time"
+	// FieldAmr holds the string denoting the amr field in the database.
+	FieldAmr = "amr"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldExpiration holds the string denoting the expiration field in the database.
+	FieldExpiration = "expiration"
+	// Table holds the table name of the deviceauthorization in the database.
+	Table = "device_authorizations"
+)
+
+// Columns holds all SQL columns for deviceauthorization fields.
+var Columns = []string{
+	FieldID,
+	FieldUserCode,
+	FieldApplicationID,
+	FieldScopes,
+	FieldDone,
+	FieldDenied,
+	FieldSubject,
+	FieldAuthTime,
+	FieldAmr,
+	FieldCreatedAt,
+	FieldExpiration,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultDone holds the default value on creation for the "done" field.
+	DefaultDone bool
+	// DefaultDenied holds the default value on creation for the "denied" field.
+	DefaultDenied bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the DeviceAuthorization queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserCode orders the results by the user_code field.
+func ByUserCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserCode, opts...).ToFunc()
+}
+
+// ByApplicationID orders the results by the application_id field.
+func ByApplicationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApplicationID, opts...).ToFunc()
+}
+
+// ByDone orders the results by the done field.
+func ByDone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDone, opts...).ToFunc()
+}
+
+// ByDenied orders the results by the denied field.
+func ByDenied(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDenied, opts...).ToFunc()
+}
+
+// BySubject orders the results by the subject field.
+func BySubject(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubject, opts...).ToFunc()
+}
+
+// ByAuthTime orders the results by the auth_time field.
+func ByAuthTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthTime, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByExpiration orders the results by the expiration field.
+func ByExpiration(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiration, opts...).ToFunc()
+}
@@ -0,0 +1,485 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deviceauthorization
+
+import (
+	"base-website/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldContainsFold(FieldID, id))
+}
+
+// UserCode applies equality check predicate on the "user_code" field. It's identical to UserCodeEQ.
+func UserCode(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldUserCode, v))
+}
+
+// ApplicationID applies equality check predicate on the "application_id" field. It's identical to ApplicationIDEQ.
+func ApplicationID(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldApplicationID, v))
+}
+
+// Done applies equality check predicate on the "done" field. It's identical to DoneEQ.
+func Done(v bool) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldDone, v))
+}
+
+// Denied applies equality check predicate on the "denied" field. It's identical to DeniedEQ.
+func Denied(v bool) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldDenied, v))
+}
+
+// Subject applies equality check predicate on the "subject" field. It's identical to SubjectEQ.
+func Subject(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldSubject, v))
+}
+
+// AuthTime applies equality check predicate on the "auth_time" field. It's identical to AuthTimeEQ.
+func AuthTime(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldAuthTime, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// Expiration applies equality check predicate on the "expiration" field. It's identical to ExpirationEQ.
+func Expiration(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldExpiration, v))
+}
+
+// UserCodeEQ applies the EQ predicate on the "user_code" field.
+func UserCodeEQ(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldUserCode, v))
+}
+
+// UserCodeNEQ applies the NEQ predicate on the "user_code" field.
+func UserCodeNEQ(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNEQ(FieldUserCode, v))
+}
+
+// UserCodeIn applies the In predicate on the "user_code" field.
+func UserCodeIn(vs ...string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIn(FieldUserCode, vs...))
+}
+
+// UserCodeNotIn applies the NotIn predicate on the "user_code" field.
+func UserCodeNotIn(vs ...string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotIn(FieldUserCode, vs...))
+}
+
+// UserCodeGT applies the GT predicate on the "user_code" field.
+func UserCodeGT(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGT(FieldUserCode, v))
+}
+
+// UserCodeGTE applies the GTE predicate on the "user_code" field.
+func UserCodeGTE(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGTE(FieldUserCode, v))
+}
+
+// UserCodeLT applies the LT predicate on the "user_code" field.
+func UserCodeLT(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLT(FieldUserCode, v))
+}
+
+// UserCodeLTE applies the LTE predicate on the "user_code" field.
+func UserCodeLTE(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLTE(FieldUserCode, v))
+}
+
+// UserCodeContains applies the Contains predicate on the "user_code" field.
+func UserCodeContains(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldContains(FieldUserCode, v))
+}
+
+// UserCodeHasPrefix applies the HasPrefix predicate on the "user_code" field.
+func UserCodeHasPrefix(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldHasPrefix(FieldUserCode, v))
+}
+
+// UserCodeHasSuffix applies the HasSuffix predicate on the "user_code" field.
+func UserCodeHasSuffix(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldHasSuffix(FieldUserCode, v))
+}
+
+// UserCodeEqualFold applies the EqualFold predicate on the "user_code" field.
+func UserCodeEqualFold(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEqualFold(FieldUserCode, v))
+}
+
+// UserCodeContainsFold applies the ContainsFold predicate on the "user_code" field.
+func UserCodeContainsFold(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldContainsFold(FieldUserCode, v))
+}
+
+// ApplicationIDEQ applies the EQ predicate on the "application_id" field.
+func ApplicationIDEQ(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldApplicationID, v))
+}
+
+// ApplicationIDNEQ applies the NEQ predicate on the "application_id" field.
+func ApplicationIDNEQ(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNEQ(FieldApplicationID, v))
+}
+
+// ApplicationIDIn applies the In predicate on the "application_id" field.
+func ApplicationIDIn(vs ...string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIn(FieldApplicationID, vs...))
+}
+
+// ApplicationIDNotIn applies the NotIn predicate on the "application_id" field.
+func ApplicationIDNotIn(vs ...string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotIn(FieldApplicationID, vs...))
+}
+
+// ApplicationIDGT applies the GT predicate on the "application_id" field.
+func ApplicationIDGT(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGT(FieldApplicationID, v))
+}
+
+// ApplicationIDGTE applies the GTE predicate on the "application_id" field.
+func ApplicationIDGTE(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGTE(FieldApplicationID, v))
+}
+
+// ApplicationIDLT applies the LT predicate on the "application_id" field.
+func ApplicationIDLT(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLT(FieldApplicationID, v))
+}
+
+// ApplicationIDLTE applies the LTE predicate on the "application_id" field.
+func ApplicationIDLTE(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLTE(FieldApplicationID, v))
+}
+
+// ApplicationIDContains applies the Contains predicate on the "application_id" field.
+func ApplicationIDContains(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldContains(FieldApplicationID, v))
+}
+
+// ApplicationIDHasPrefix applies the HasPrefix predicate on the "application_id" field.
+func ApplicationIDHasPrefix(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldHasPrefix(FieldApplicationID, v))
+}
+
+// ApplicationIDHasSuffix applies the HasSuffix predicate on the "application_id" field.
+func ApplicationIDHasSuffix(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldHasSuffix(FieldApplicationID, v))
+}
+
+// ApplicationIDEqualFold applies the EqualFold predicate on the "application_id" field.
+func ApplicationIDEqualFold(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEqualFold(FieldApplicationID, v))
+}
+
+// ApplicationIDContainsFold applies the ContainsFold predicate on the "application_id" field.
+func ApplicationIDContainsFold(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldContainsFold(FieldApplicationID, v))
+}
+
+// DoneEQ applies the EQ predicate on the "done" field.
+func DoneEQ(v bool) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldDone, v))
+}
+
+// DoneNEQ applies the NEQ predicate on the "done" field.
+func DoneNEQ(v bool) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNEQ(FieldDone, v))
+}
+
+// DeniedEQ applies the EQ predicate on the "denied" field.
+func DeniedEQ(v bool) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldDenied, v))
+}
+
+// DeniedNEQ applies the NEQ predicate on the "denied" field.
+func DeniedNEQ(v bool) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNEQ(FieldDenied, v))
+}
+
+// SubjectEQ applies the EQ predicate on the "subject" field.
+func SubjectEQ(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldSubject, v))
+}
+
+// SubjectNEQ applies the NEQ predicate on the "subject" field.
+func SubjectNEQ(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNEQ(FieldSubject, v))
+}
+
+// SubjectIn applies the In predicate on the "subject" field.
+func SubjectIn(vs ...string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIn(FieldSubject, vs...))
+}
+
+// SubjectNotIn applies the NotIn predicate on the "subject" field.
+func SubjectNotIn(vs ...string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotIn(FieldSubject, vs...))
+}
+
+// SubjectGT applies the GT predicate on the "subject" field.
+func SubjectGT(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGT(FieldSubject, v))
+}
+
+// SubjectGTE applies the GTE predicate on the "subject" field.
+func SubjectGTE(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGTE(FieldSubject, v))
+}
+
+// SubjectLT applies the LT predicate on the "subject" field.
+func SubjectLT(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLT(FieldSubject, v))
+}
+
+// SubjectLTE applies the LTE predicate on the "subject" field.
+func SubjectLTE(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLTE(FieldSubject, v))
+}
+
+// SubjectContains applies the Contains predicate on the "subject" field.
+func SubjectContains(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldContains(FieldSubject, v))
+}
+
+// SubjectHasPrefix applies the HasPrefix predicate on the "subject" field.
+func SubjectHasPrefix(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldHasPrefix(FieldSubject, v))
+}
+
+// SubjectHasSuffix applies the HasSuffix predicate on the "subject" field.
+func SubjectHasSuffix(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldHasSuffix(FieldSubject, v))
+}
+
+// SubjectIsNil applies the IsNil predicate on the "subject" field.
+func SubjectIsNil() predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIsNull(FieldSubject))
+}
+
+// SubjectNotNil applies the NotNil predicate on the "subject" field.
+func SubjectNotNil() predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotNull(FieldSubject))
+}
+
+// SubjectEqualFold applies the EqualFold predicate on the "subject" field.
+func SubjectEqualFold(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEqualFold(FieldSubject, v))
+}
+
+// SubjectContainsFold applies the ContainsFold predicate on the "subject" field.
+func SubjectContainsFold(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldContainsFold(FieldSubject, v))
+}
+
+// AuthTimeEQ applies the EQ predicate on the "auth_time" field.
+func AuthTimeEQ(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldAuthTime, v))
+}
+
+// AuthTimeNEQ applies the NEQ predicate on the "auth_time" field.
+func AuthTimeNEQ(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNEQ(FieldAuthTime, v))
+}
+
+// AuthTimeIn applies the In predicate on the "auth_time" field.
+func AuthTimeIn(vs ...time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIn(FieldAuthTime, vs...))
+}
+
+// AuthTimeNotIn applies the NotIn predicate on the "auth_time" field.
+func AuthTimeNotIn(vs ...time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotIn(FieldAuthTime, vs...))
+}
+
+// AuthTimeGT applies the GT predicate on the "auth_time" field.
+func AuthTimeGT(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGT(FieldAuthTime, v))
+}
+
+// AuthTimeGTE applies the GTE predicate on the "auth_time" field.
+func AuthTimeGTE(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGTE(FieldAuthTime, v))
+}
+
+// AuthTimeLT applies the LT predicate on the "auth_time" field.
+func AuthTimeLT(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLT(FieldAuthTime, v))
+}
+
+// AuthTimeLTE applies the LTE predicate on the "auth_time" field.
+func AuthTimeLTE(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLTE(FieldAuthTime, v))
+}
+
+// AuthTimeIsNil applies the IsNil predicate on the "auth_time" field.
+func AuthTimeIsNil() predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIsNull(FieldAuthTime))
+}
+
+// AuthTimeNotNil applies the NotNil predicate on the "auth_time" field.
+func AuthTimeNotNil() predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotNull(FieldAuthTime))
+}
+
+// AmrIsNil applies the IsNil predicate on the "amr" field.
+func AmrIsNil() predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIsNull(FieldAmr))
+}
+
+// AmrNotNil applies the NotNil predicate on the "amr" field.
+func AmrNotNil() predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotNull(FieldAmr))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ExpirationEQ applies the EQ predicate on the "expiration" field.
+func ExpirationEQ(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldExpiration, v))
+}
+
+// ExpirationNEQ applies the NEQ predicate on the "expiration" field.
+func ExpirationNEQ(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNEQ(FieldExpiration, v))
+}
+
+// ExpirationIn applies the In predicate on the "expiration" field.
+func ExpirationIn(vs ...time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIn(FieldExpiration, vs...))
+}
+
+// ExpirationNotIn applies the NotIn predicate on the "expiration" field.
+func ExpirationNotIn(vs ...time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotIn(FieldExpiration, vs...))
+}
+
+// ExpirationGT applies the GT predicate on the "expiration" field.
+func ExpirationGT(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGT(FieldExpiration, v))
+}
+
+// ExpirationGTE applies the GTE predicate on the "expiration" field.
+func ExpirationGTE(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGTE(FieldExpiration, v))
+}
+
+// ExpirationLT applies the LT predicate on the "expiration" field.
+func ExpirationLT(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLT(FieldExpiration, v))
+}
+
+// ExpirationLTE applies the LTE predicate on the "expiration" field.
+func ExpirationLTE(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLTE(FieldExpiration, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DeviceAuthorization) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DeviceAuthorization) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DeviceAuthorization) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.NotPredicates(p))
+}
@@ -0,0 +1,361 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"base-website/ent/deviceauthorization"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DeviceAuthorizationCreate is the builder for creating a DeviceAuthorization entity.
+type DeviceAuthorizationCreate struct {
+	config
+	mutation *DeviceAuthorizationMutation
+	hooks    []Hook
+}
+
+// SetUserCode sets the "user_code" field.
+func (_c *DeviceAuthorizationCreate) SetUserCode(v string) *DeviceAuthorizationCreate {
+	_c.mutation.SetUserCode(v)
+	return _c
+}
+
+// SetApplicationID sets the "application_id" field.
+func (_c *DeviceAuthorizationCreate) SetApplicationID(v string) *DeviceAuthorizationCreate {
+	_c.mutation.SetApplicationID(v)
+	return _c
+}
+
+// SetScopes sets the "scopes" field.
+func (_c *DeviceAuthorizationCreate) SetScopes(v []string) *DeviceAuthorizationCreate {
+	_c.mutation.SetScopes(v)
+	return _c
+}
+
+// SetDone sets the "done" field.
+func (_c *DeviceAuthorizationCreate) SetDone(v bool) *DeviceAuthorizationCreate {
+	_c.mutation.SetDone(v)
+	return _c
+}
+
+// SetNillableDone sets the "done" field if the given value is not nil.
+func (_c *DeviceAuthorizationCreate) SetNillableDone(v *bool) *DeviceAuthorizationCreate {
+	if v != nil {
+		_c.SetDone(*v)
+	}
+	return _c
+}
+
+// SetDenied sets the "denied" field.
+func (_c *DeviceAuthorizationCreate) SetDenied(v bool) *DeviceAuthorizationCreate {
+	_c.mutation.SetDenied(v)
+	return _c
+}
+
+// SetNillableDenied sets the "denied" field if the given value is not nil.
+func (_c *DeviceAuthorizationCreate) SetNillableDenied(v *bool) *DeviceAuthorizationCreate {
+	if v != nil {
+		_c.SetDenied(*v)
+	}
+	return _c
+}
+
+// SetSubject sets the "subject" field.
+func (_c *DeviceAuthorizationCreate) SetSubject(v string) *DeviceAuthorizationCreate {
+	_c.mutation.SetSubject(v)
+	return _c
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_c *DeviceAuthorizationCreate) SetNillableSubject(v *string) *DeviceAuthorizationCreate {
+	if v != nil {
+		_c.SetSubject(*v)
+	}
+	return _c
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_c *DeviceAuthorizationCreate) SetAuthTime(v time.Time) *DeviceAuthorizationCreate {
+	_c.mutation.SetAuthTime(v)
+	return _c
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_c *DeviceAuthorizationCreate) SetNillableAuthTime(v *time.Time) *DeviceAuthorizationCreate {
+	if v != nil {
+		_c.SetAuthTime(*v)
+	}
+	return _c
+}
+
+// SetAmr sets the "amr" field.
+func (_c *DeviceAuthorizationCreate) SetAmr(v []string) *DeviceAuthorizationCreate {
+	_c.mutation.SetAmr(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DeviceAuthorizationCreate) SetCreatedAt(v time.Time) *DeviceAuthorizationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DeviceAuthorizationCreate) SetNillableCreatedAt(v *time.Time) *DeviceAuthorizationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetExpiration sets the "expiration" field.
+func (_c *DeviceAuthorizationCreate) SetExpiration(v time.Time) *DeviceAuthorizationCreate {
+	_c.mutation.SetExpiration(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DeviceAuthorizationCreate) SetID(v string) *DeviceAuthorizationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the DeviceAuthorizationMutation object of the builder.
+func (_c *DeviceAuthorizationCreate) Mutation() *DeviceAuthorizationMutation {
+	return _c.mutation
+}
+
+// Save creates the DeviceAuthorization in the database.
+func (_c *DeviceAuthorizationCreate) Save(ctx context.Context) (*DeviceAuthorization, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DeviceAuthorizationCreate) SaveX(ctx context.Context) *DeviceAuthorization {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeviceAuthorizationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeviceAuthorizationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DeviceAuthorizationCreate) defaults() {
+	if _, ok := _c.mutation.Done(); !ok {
+		v := deviceauthorization.DefaultDone
+		_c.mutation.SetDone(v)
+	}
+	if _, ok := _c.mutation.Denied(); !ok {
+		v := deviceauthorization.DefaultDenied
+		_c.mutation.SetDenied(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := deviceauthorization.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DeviceAuthorizationCreate) check() error {
+	if _, ok := _c.mutation.UserCode(); !ok {
+		return &ValidationError{Name: "user_code", err: errors.New(`ent: missing required field "DeviceAuthorization.user_code"`)}
+	}
+	if _, ok := _c.mutation.ApplicationID(); !ok {
+		return &ValidationError{Name: "application_id", err: errors.New(`ent: missing required field "DeviceAuthorization.application_id"`)}
+	}
+	if _, ok := _c.mutation.Scopes(); !ok {
+		return &ValidationError{Name: "scopes", err: errors.New(`ent: missing required field "DeviceAuthorization.scopes"`)}
+	}
+	if _, ok := _c.mutation.Done(); !ok {
+		return &ValidationError{Name: "done", err: errors.New(`ent: missing required field "DeviceAuthorization.done"`)}
+	}
+	if _, ok := _c.mutation.Denied(); !ok {
+		return &ValidationError{Name: "denied", err: errors.New(`ent: missing required field "DeviceAuthorization.denied"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DeviceAuthorization.created_at"`)}
+	}
+	if _, ok := _c.mutation.Expiration(); !ok {
+		return &ValidationError{Name: "expiration", err: errors.New(`ent: missing required field "DeviceAuthorization.expiration"`)}
+	}
+	return nil
+}
+
+func (_c *DeviceAuthorizationCreate) sqlSave(ctx context.Context) (*DeviceAuthorization, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected DeviceAuthorization.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DeviceAuthorizationCreate) createSpec() (*DeviceAuthorization, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DeviceAuthorization{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(deviceauthorization.Table, sqlgraph.NewFieldSpec(deviceauthorization.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserCode(); ok {
+		_spec.SetField(deviceauthorization.FieldUserCode, field.TypeString, value)
+		_node.UserCode = value
+	}
+	if value, ok := _c.mutation.ApplicationID(); ok {
+		_spec.SetField(deviceauthorization.FieldApplicationID, field.TypeString, value)
+		_node.ApplicationID = value
+	}
+	if value, ok := _c.mutation.Scopes(); ok {
+		_spec.SetField(deviceauthorization.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := _c.mutation.Done(); ok {
+		_spec.SetField(deviceauthorization.FieldDone, field.TypeBool, value)
+		_node.Done = value
+	}
+	if value, ok := _c.mutation.Denied(); ok {
+		_spec.SetField(deviceauthorization.FieldDenied, field.TypeBool, value)
+		_node.Denied = value
+	}
+	if value, ok := _c.mutation.Subject(); ok {
+		_spec.SetField(deviceauthorization.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := _c.mutation.AuthTime(); ok {
+		_spec.SetField(deviceauthorization.FieldAuthTime, field.TypeTime, value)
+		_node.AuthTime = &value
+	}
+	if value, ok := _c.mutation.Amr(); ok {
+		_spec.SetField(deviceauthorization.FieldAmr, field.TypeJSON, value)
+		_node.Amr = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(deviceauthorization.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.Expiration(); ok {
+		_spec.SetField(deviceauthorization.FieldExpiration, field.TypeTime, value)
+		_node.Expiration = value
+	}
+	return _node, _spec
+}
+
+// DeviceAuthorizationCreateBulk is the builder for creating many DeviceAuthorization entities in bulk.
+type DeviceAuthorizationCreateBulk struct {
+	config
+	err      error
+	builders []*DeviceAuthorizationCreate
+}
+
+// Save creates the DeviceAuthorization entities in the database.
+func (_c *DeviceAuthorizationCreateBulk) Save(ctx context.Context) ([]*DeviceAuthorization, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DeviceAuthorization, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeviceAuthorizationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DeviceAuthorizationCreateBulk) SaveX(ctx context.Context) []*DeviceAuthorization {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeviceAuthorizationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeviceAuthorizationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,599 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"base-website/ent/deviceauthorization"
+	"base-website/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// DeviceAuthorizationUpdate is the builder for updating DeviceAuthorization entities.
+type DeviceAuthorizationUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DeviceAuthorizationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DeviceAuthorizationUpdate builder.
+func (_u *DeviceAuthorizationUpdate) Where(ps ...predicate.DeviceAuthorization) *DeviceAuthorizationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserCode sets the "user_code" field.
+func (_u *DeviceAuthorizationUpdate) SetUserCode(v string) *DeviceAuthorizationUpdate {
+	_u.mutation.SetUserCode(v)
+	return _u
+}
+
+// SetNillableUserCode sets the "user_code" field if the given value is not nil.
+func (_u *DeviceAuthorizationUpdate) SetNillableUserCode(v *string) *DeviceAuthorizationUpdate {
+	if v != nil {
+		_u.SetUserCode(*v)
+	}
+	return _u
+}
+
+// SetApplicationID sets the "application_id" field.
+func (_u *DeviceAuthorizationUpdate) SetApplicationID(v string) *DeviceAuthorizationUpdate {
+	_u.mutation.SetApplicationID(v)
+	return _u
+}
+
+// SetNillableApplicationID sets the "application_id" field if the given value is not nil.
+func (_u *DeviceAuthorizationUpdate) SetNillableApplicationID(v *string) *DeviceAuthorizationUpdate {
+	if v != nil {
+		_u.SetApplicationID(*v)
+	}
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *DeviceAuthorizationUpdate) SetScopes(v []string) *DeviceAuthorizationUpdate {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *DeviceAuthorizationUpdate) AppendScopes(v []string) *DeviceAuthorizationUpdate {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// SetDone sets the "done" field.
+func (_u *DeviceAuthorizationUpdate) SetDone(v bool) *DeviceAuthorizationUpdate {
+	_u.mutation.SetDone(v)
+	return _u
+}
+
+// SetNillableDone sets the "done" field if the given value is not nil.
+func (_u *DeviceAuthorizationUpdate) SetNillableDone(v *bool) *DeviceAuthorizationUpdate {
+	if v != nil {
+		_u.SetDone(*v)
+	}
+	return _u
+}
+
+// SetDenied sets the "denied" field.
+func (_u *DeviceAuthorizationUpdate) SetDenied(v bool) *DeviceAuthorizationUpdate {
+	_u.mutation.SetDenied(v)
+	return _u
+}
+
+// SetNillableDenied sets the "denied" field if the given value is not nil.
+func (_u *DeviceAuthorizationUpdate) SetNillableDenied(v *bool) *DeviceAuthorizationUpdate {
+	if v != nil {
+		_u.SetDenied(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *DeviceAuthorizationUpdate) SetSubject(v string) *DeviceAuthorizationUpdate {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *DeviceAuthorizationUpdate) SetNillableSubject(v *string) *DeviceAuthorizationUpdate {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// ClearSubject clears the value of the "subject" field.
+func (_u *DeviceAuthorizationUpdate) ClearSubject() *DeviceAuthorizationUpdate {
+	_u.mutation.ClearSubject()
+	return _u
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_u *DeviceAuthorizationUpdate) SetAuthTime(v time.Time) *DeviceAuthorizationUpdate {
+	_u.mutation.SetAuthTime(v)
+	return _u
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_u *DeviceAuthorizationUpdate) SetNillableAuthTime(v *time.Time) *DeviceAuthorizationUpdate {
+	if v != nil {
+		_u.SetAuthTime(*v)
+	}
+	return _u
+}
+
+// ClearAuthTime clears the value of the "auth_time" field.
+func (_u *DeviceAuthorizationUpdate) ClearAuthTime() *DeviceAuthorizationUpdate {
+	_u.mutation.ClearAuthTime()
+	return _u
+}
+
+// SetAmr sets the "amr" field.
+func (_u *DeviceAuthorizationUpdate) SetAmr(v []string) *DeviceAuthorizationUpdate {
+	_u.mutation.SetAmr(v)
+	return _u
+}
+
+// AppendAmr appends value to the "amr" field.
+func (_u *DeviceAuthorizationUpdate) AppendAmr(v []string) *DeviceAuthorizationUpdate {
+	_u.mutation.AppendAmr(v)
+	return _u
+}
+
+// ClearAmr clears the value of the "amr" field.
+func (_u *DeviceAuthorizationUpdate) ClearAmr() *DeviceAuthorizationUpdate {
+	_u.mutation.ClearAmr()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *DeviceAuthorizationUpdate) SetCreatedAt(v time.Time) *DeviceAuthorizationUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *DeviceAuthorizationUpdate) SetNillableCreatedAt(v *time.Time) *DeviceAuthorizationUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetExpiration sets the "expiration" field.
+func (_u *DeviceAuthorizationUpdate) SetExpiration(v time.Time) *DeviceAuthorizationUpdate {
+	_u.mutation.SetExpiration(v)
+	return _u
+}
+
+// SetNillableExpiration sets the "expiration" field if the given value is not nil.
+func (_u *DeviceAuthorizationUpdate) SetNillableExpiration(v *time.Time) *DeviceAuthorizationUpdate {
+	if v != nil {
+		_u.SetExpiration(*v)
+	}
+	return _u
+}
+
+// Mutation returns the DeviceAuthorizationMutation object of the builder.
+func (_u *DeviceAuthorizationUpdate) Mutation() *DeviceAuthorizationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DeviceAuthorizationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeviceAuthorizationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DeviceAuthorizationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeviceAuthorizationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DeviceAuthorizationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DeviceAuthorizationUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *DeviceAuthorizationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(deviceauthorization.Table, deviceauthorization.Columns, sqlgraph.NewFieldSpec(deviceauthorization.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserCode(); ok {
+		_spec.SetField(deviceauthorization.FieldUserCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ApplicationID(); ok {
+		_spec.SetField(deviceauthorization.FieldApplicationID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(deviceauthorization.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, deviceauthorization.FieldScopes, value)
+		})
+	}
+	if value, ok := _u.mutation.Done(); ok {
+		_spec.SetField(deviceauthorization.FieldDone, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Denied(); ok {
+		_spec.SetField(deviceauthorization.FieldDenied, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(deviceauthorization.FieldSubject, field.TypeString, value)
+	}
+	if _u.mutation.SubjectCleared() {
+		_spec.ClearField(deviceauthorization.FieldSubject, field.TypeString)
+	}
+	if value, ok := _u.mutation.AuthTime(); ok {
+		_spec.SetField(deviceauthorization.FieldAuthTime, field.TypeTime, value)
+	}
+	if _u.mutation.AuthTimeCleared() {
+		_spec.ClearField(deviceauthorization.FieldAuthTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Amr(); ok {
+		_spec.SetField(deviceauthorization.FieldAmr, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAmr(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, deviceauthorization.FieldAmr, value)
+		})
+	}
+	if _u.mutation.AmrCleared() {
+		_spec.ClearField(deviceauthorization.FieldAmr, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(deviceauthorization.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Expiration(); ok {
+		_spec.SetField(deviceauthorization.FieldExpiration, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deviceauthorization.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DeviceAuthorizationUpdateOne is the builder for updating a single DeviceAuthorization entity.
+type DeviceAuthorizationUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DeviceAuthorizationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserCode sets the "user_code" field.
+func (_u *DeviceAuthorizationUpdateOne) SetUserCode(v string) *DeviceAuthorizationUpdateOne {
+	_u.mutation.SetUserCode(v)
+	return _u
+}
+
+// SetNillableUserCode sets the "user_code" field if the given value is not nil.
+func (_u *DeviceAuthorizationUpdateOne) SetNillableUserCode(v *string) *DeviceAuthorizationUpdateOne {
+	if v != nil {
+		_u.SetUserCode(*v)
+	}
+	return _u
+}
+
+// SetApplicationID sets the "application_id" field.
+func (_u *DeviceAuthorizationUpdateOne) SetApplicationID(v string) *DeviceAuthorizationUpdateOne {
+	_u.mutation.SetApplicationID(v)
+	return _u
+}
+
+// SetNillableApplicationID sets the "application_id" field if the given value is not nil.
+func (_u *DeviceAuthorizationUpdateOne) SetNillableApplicationID(v *string) *DeviceAuthorizationUpdateOne {
+	if v != nil {
+		_u.SetApplicationID(*v)
+	}
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *DeviceAuthorizationUpdateOne) SetScopes(v []string) *DeviceAuthorizationUpdateOne {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *DeviceAuthorizationUpdateOne) AppendScopes(v []string) *DeviceAuthorizationUpdateOne {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// SetDone sets the "done" field.
+func (_u *DeviceAuthorizationUpdateOne) SetDone(v bool) *DeviceAuthorizationUpdateOne {
+	_u.mutation.SetDone(v)
+	return _u
+}
+
+// SetNillableDone sets the "done" field if the given value is not nil.
+func (_u *DeviceAuthorizationUpdateOne) SetNillableDone(v *bool) *DeviceAuthorizationUpdateOne {
+	if v != nil {
+		_u.SetDone(*v)
+	}
+	return _u
+}
+
+// SetDenied sets the "denied" field.
+func (_u *DeviceAuthorizationUpdateOne) SetDenied(v bool) *DeviceAuthorizationUpdateOne {
+	_u.mutation.SetDenied(v)
+	return _u
+}
+
+// SetNillableDenied sets the "denied" field if the given value is not nil.
+func (_u *DeviceAuthorizationUpdateOne) SetNillableDenied(v *bool) *DeviceAuthorizationUpdateOne {
+	if v != nil {
+		_u.SetDenied(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *DeviceAuthorizationUpdateOne) SetSubject(v string) *DeviceAuthorizationUpdateOne {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *DeviceAuthorizationUpdateOne) SetNillableSubject(v *string) *DeviceAuthorizationUpdateOne {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// ClearSubject clears the value of the "subject" field.
+func (_u *DeviceAuthorizationUpdateOne) ClearSubject() *DeviceAuthorizationUpdateOne {
+	_u.mutation.ClearSubject()
+	return _u
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_u *DeviceAuthorizationUpdateOne) SetAuthTime(v time.Time) *DeviceAuthorizationUpdateOne {
+	_u.mutation.SetAuthTime(v)
+	return _u
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_u *DeviceAuthorizationUpdateOne) SetNillableAuthTime(v *time.Time) *DeviceAuthorizationUpdateOne {
+	if v != nil {
+		_u.SetAuthTime(*v)
+	}
+	return _u
+}
+
+// ClearAuthTime clears the value of the "auth_time" field.
+func (_u *DeviceAuthorizationUpdateOne) ClearAuthTime() *DeviceAuthorizationUpdateOne {
+	_u.mutation.ClearAuthTime()
+	return _u
+}
+
+// SetAmr sets the "amr" field.
+func (_u *DeviceAuthorizationUpdateOne) SetAmr(v []string) *DeviceAuthorizationUpdateOne {
+	_u.mutation.SetAmr(v)
+	return _u
+}
+
+// AppendAmr appends value to the "amr" field.
+func (_u *DeviceAuthorizationUpdateOne) AppendAmr(v []string) *DeviceAuthorizationUpdateOne {
+	_u.mutation.AppendAmr(v)
+	return _u
+}
+
+// ClearAmr clears the value of the "amr" field.
+func (_u *DeviceAuthorizationUpdateOne) ClearAmr() *DeviceAuthorizationUpdateOne {
+	_u.mutation.ClearAmr()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *DeviceAuthorizationUpdateOne) SetCreatedAt(v time.Time) *DeviceAuthorizationUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *DeviceAuthorizationUpdateOne) SetNillableCreatedAt(v *time.Time) *DeviceAuthorizationUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetExpiration sets the "expiration" field.
+func (_u *DeviceAuthorizationUpdateOne) SetExpiration(v time.Time) *DeviceAuthorizationUpdateOne {
+	_u.mutation.SetExpiration(v)
+	return _u
+}
+
+// SetNillableExpiration sets the "expiration" field if the given value is not nil.
+func (_u *DeviceAuthorizationUpdateOne) SetNillableExpiration(v *time.Time) *DeviceAuthorizationUpdateOne {
+	if v != nil {
+		_u.SetExpiration(*v)
+	}
+	return _u
+}
+
+// Mutation returns the DeviceAuthorizationMutation object of the builder.
+func (_u *DeviceAuthorizationUpdateOne) Mutation() *DeviceAuthorizationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DeviceAuthorizationUpdate builder.
+func (_u *DeviceAuthorizationUpdateOne) Where(ps ...predicate.DeviceAuthorization) *DeviceAuthorizationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DeviceAuthorizationUpdateOne) Select(field string, fields ...string) *DeviceAuthorizationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DeviceAuthorization entity.
+func (_u *DeviceAuthorizationUpdateOne) Save(ctx context.Context) (*DeviceAuthorization, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeviceAuthorizationUpdateOne) SaveX(ctx context.Context) *DeviceAuthorization {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DeviceAuthorizationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeviceAuthorizationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DeviceAuthorizationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DeviceAuthorizationUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *DeviceAuthorizationUpdateOne) sqlSave(ctx context.Context) (_node *DeviceAuthorization, err error) {
+	_spec := sqlgraph.NewUpdateSpec(deviceauthorization.Table, deviceauthorization.Columns, sqlgraph.NewFieldSpec(deviceauthorization.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DeviceAuthorization.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deviceauthorization.FieldID)
+		for _, f := range fields {
+			if !deviceauthorization.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != deviceauthorization.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserCode(); ok {
+		_spec.SetField(deviceauthorization.FieldUserCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ApplicationID(); ok {
+		_spec.SetField(deviceauthorization.FieldApplicationID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(deviceauthorization.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, deviceauthorization.FieldScopes, value)
+		})
+	}
+	if value, ok := _u.mutation.Done(); ok {
+		_spec.SetField(deviceauthorization.FieldDone, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Denied(); ok {
+		_spec.SetField(deviceauthorization.FieldDenied, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(deviceauthorization.FieldSubject, field.TypeString, value)
+	}
+	if _u.mutation.SubjectCleared() {
+		_spec.ClearField(deviceauthorization.FieldSubject, field.TypeString)
+	}
+	if value, ok := _u.mutation.AuthTime(); ok {
+		_spec.SetField(deviceauthorization.FieldAuthTime, field.TypeTime, value)
+	}
+	if _u.mutation.AuthTimeCleared() {
+		_spec.ClearField(deviceauthorization.FieldAuthTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Amr(); ok {
+		_spec.SetField(deviceauthorization.FieldAmr, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAmr(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, deviceauthorization.FieldAmr, value)
+		})
+	}
+	if _u.mutation.AmrCleared() {
+		_spec.ClearField(deviceauthorization.FieldAmr, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(deviceauthorization.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Expiration(); ok {
+		_spec.SetField(deviceauthorization.FieldExpiration, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &DeviceAuthorization{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deviceauthorization.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
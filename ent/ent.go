@@ -4,14 +4,20 @@ package ent
 
 import (
 	"base-website/ent/app"
+	"base-website/ent/auditlog"
 	"base-website/ent/authcode"
 	"base-website/ent/authrefreshtoken"
+	"base-website/ent/authrequest"
 	"base-website/ent/authtoken"
 	"base-website/ent/component"
 	"base-website/ent/consent"
+	"base-website/ent/deviceauthorization"
 	"base-website/ent/invitation"
 	"base-website/ent/notification"
 	"base-website/ent/rankgroup"
+	"base-website/ent/rolegrant"
+	"base-website/ent/sanction"
+	"base-website/ent/signingkey"
 	"base-website/ent/team"
 	"base-website/ent/teammember"
 	"base-website/ent/tournament"
@@ -19,6 +25,7 @@ import (
 	"base-website/ent/user"
 	"base-website/ent/uservote"
 	"base-website/ent/vote"
+	"base-website/ent/votetemplate"
 	"context"
 	"errors"
 	"fmt"
@@ -88,22 +95,29 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			app.Table:              app.ValidColumn,
-			authcode.Table:         authcode.ValidColumn,
-			authrefreshtoken.Table: authrefreshtoken.ValidColumn,
-			authtoken.Table:        authtoken.ValidColumn,
-			component.Table:        component.ValidColumn,
-			consent.Table:          consent.ValidColumn,
-			invitation.Table:       invitation.ValidColumn,
-			notification.Table:     notification.ValidColumn,
-			rankgroup.Table:        rankgroup.ValidColumn,
-			team.Table:             team.ValidColumn,
-			teammember.Table:       teammember.ValidColumn,
-			tournament.Table:       tournament.ValidColumn,
-			tournamentadmin.Table:  tournamentadmin.ValidColumn,
-			user.Table:             user.ValidColumn,
-			uservote.Table:         uservote.ValidColumn,
-			vote.Table:             vote.ValidColumn,
+			app.Table:                 app.ValidColumn,
+			auditlog.Table:            auditlog.ValidColumn,
+			authcode.Table:            authcode.ValidColumn,
+			authrefreshtoken.Table:    authrefreshtoken.ValidColumn,
+			authrequest.Table:         authrequest.ValidColumn,
+			authtoken.Table:           authtoken.ValidColumn,
+			component.Table:           component.ValidColumn,
+			consent.Table:             consent.ValidColumn,
+			deviceauthorization.Table: deviceauthorization.ValidColumn,
+			invitation.Table:          invitation.ValidColumn,
+			notification.Table:        notification.ValidColumn,
+			rankgroup.Table:           rankgroup.ValidColumn,
+			rolegrant.Table:           rolegrant.ValidColumn,
+			sanction.Table:            sanction.ValidColumn,
+			signingkey.Table:          signingkey.ValidColumn,
+			team.Table:                team.ValidColumn,
+			teammember.Table:          teammember.ValidColumn,
+			tournament.Table:          tournament.ValidColumn,
+			tournamentadmin.Table:     tournamentadmin.ValidColumn,
+			user.Table:                user.ValidColumn,
+			uservote.Table:            uservote.ValidColumn,
+			vote.Table:                vote.ValidColumn,
+			votetemplate.Table:        votetemplate.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -0,0 +1,2 @@
+-- Modify "votes" table
+ALTER TABLE "votes" ADD COLUMN "results_reveal_at" timestamptz NULL, ADD COLUMN "results_published_at" timestamptz NULL;
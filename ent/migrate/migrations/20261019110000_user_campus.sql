@@ -0,0 +1,2 @@
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "campus" character varying NULL, ADD COLUMN "last_login_at" timestamptz NULL;
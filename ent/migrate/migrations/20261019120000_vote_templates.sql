@@ -0,0 +1,16 @@
+-- Create "vote_templates" table
+CREATE TABLE "vote_templates" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "name" character varying NOT NULL,
+  "title" character varying NOT NULL,
+  "description" character varying NULL,
+  "anonymous" boolean NOT NULL DEFAULT true,
+  "duration_minutes" bigint NOT NULL,
+  "results_delay_minutes" bigint NULL,
+  "components" jsonb NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "user_created_vote_templates" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "vote_templates_users_created_vote_templates" FOREIGN KEY ("user_created_vote_templates") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
@@ -0,0 +1,23 @@
+-- Create "auth_requests" table
+CREATE TABLE "auth_requests" (
+  "id" character varying NOT NULL,
+  "application_id" character varying NOT NULL,
+  "redirect_uri" character varying NOT NULL,
+  "state" character varying NULL,
+  "nonce" character varying NULL,
+  "scopes" jsonb NOT NULL,
+  "prompt" jsonb NULL,
+  "response_type" character varying NOT NULL,
+  "response_mode" character varying NULL,
+  "code_challenge" character varying NULL,
+  "code_challenge_method" character varying NULL,
+  "login_hint" character varying NULL,
+  "max_auth_age" bigint NULL,
+  "user_id" character varying NULL,
+  "done" boolean NOT NULL DEFAULT false,
+  "auth_time" timestamptz NULL,
+  "amr" jsonb NULL,
+  "created_at" timestamptz NOT NULL,
+  "expiration" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
@@ -0,0 +1,12 @@
+-- Create "signing_keys" table
+CREATE TABLE "signing_keys" (
+  "id" character varying NOT NULL,
+  "algorithm" character varying NOT NULL,
+  "private_key" bytea NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "active_at" timestamptz NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "signingkey_expires_at" to table: "signing_keys"
+CREATE INDEX "signingkey_expires_at" ON "signing_keys" ("expires_at");
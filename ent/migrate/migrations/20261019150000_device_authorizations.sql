@@ -0,0 +1,17 @@
+-- Create "device_authorizations" table
+CREATE TABLE "device_authorizations" (
+  "id" character varying NOT NULL,
+  "user_code" character varying NOT NULL,
+  "application_id" character varying NOT NULL,
+  "scopes" jsonb NOT NULL,
+  "done" boolean NOT NULL DEFAULT false,
+  "denied" boolean NOT NULL DEFAULT false,
+  "subject" character varying NULL,
+  "auth_time" timestamptz NULL,
+  "amr" jsonb NULL,
+  "created_at" timestamptz NOT NULL,
+  "expiration" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "device_authorizations_user_code_key" to table: "device_authorizations"
+CREATE UNIQUE INDEX "device_authorizations_user_code_key" ON "device_authorizations" ("user_code");
@@ -0,0 +1,6 @@
+-- Modify "auth_tokens" table
+ALTER TABLE "auth_tokens" ADD COLUMN "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE "auth_tokens" ALTER COLUMN "created_at" DROP DEFAULT;
+-- Modify "auth_refresh_tokens" table
+ALTER TABLE "auth_refresh_tokens" ADD COLUMN "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE "auth_refresh_tokens" ALTER COLUMN "created_at" DROP DEFAULT;
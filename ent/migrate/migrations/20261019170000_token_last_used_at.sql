@@ -0,0 +1,2 @@
+-- Modify "auth_tokens" table
+ALTER TABLE "auth_tokens" ADD COLUMN "last_used_at" timestamptz NULL;
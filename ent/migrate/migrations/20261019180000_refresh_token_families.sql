@@ -0,0 +1,9 @@
+-- Modify "apps" table
+ALTER TABLE "apps" ADD COLUMN "access_token_lifetime" bigint NULL, ADD COLUMN "refresh_token_lifetime" bigint NULL;
+-- Modify "auth_refresh_tokens" table
+ALTER TABLE "auth_refresh_tokens" ADD COLUMN "family_id" character varying NULL, ADD COLUMN "used" boolean NOT NULL DEFAULT false, ADD COLUMN "session_expiration" timestamptz NULL;
+-- Every existing refresh token starts its own family, under a random ID since the ID of a token is a usable refresh token
+UPDATE "auth_refresh_tokens" SET "family_id" = gen_random_uuid()::text, "session_expiration" = "expiration";
+ALTER TABLE "auth_refresh_tokens" ALTER COLUMN "family_id" SET NOT NULL, ALTER COLUMN "session_expiration" SET NOT NULL;
+-- Create index "authrefreshtoken_family_id" to table: "auth_refresh_tokens"
+CREATE INDEX "authrefreshtoken_family_id" ON "auth_refresh_tokens" ("family_id");
@@ -0,0 +1,23 @@
+-- Create "audit_logs" table
+CREATE TABLE "audit_logs" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "created_at" timestamptz NOT NULL,
+  "action" character varying NOT NULL,
+  "target_type" character varying NOT NULL,
+  "target_id" character varying NOT NULL,
+  "before" jsonb NULL,
+  "after" jsonb NULL,
+  "ip" character varying NULL,
+  "user_agent" character varying NULL,
+  "user_audit_logs" bigint NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "audit_logs_users_audit_logs" FOREIGN KEY ("user_audit_logs") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
+-- Create index "auditlog_action" to table: "audit_logs"
+CREATE INDEX "auditlog_action" ON "audit_logs" ("action");
+-- Create index "auditlog_created_at" to table: "audit_logs"
+CREATE INDEX "auditlog_created_at" ON "audit_logs" ("created_at");
+-- Create index "auditlog_target_type_target_id" to table: "audit_logs"
+CREATE INDEX "auditlog_target_type_target_id" ON "audit_logs" ("target_type", "target_id");
+-- Create index "auditlog_user_audit_logs" to table: "audit_logs"
+CREATE INDEX "auditlog_user_audit_logs" ON "audit_logs" ("user_audit_logs");
@@ -0,0 +1,4 @@
+-- Modify "auth_tokens" table
+ALTER TABLE "auth_tokens" ADD COLUMN "actor" character varying NULL;
+-- Modify "audit_logs" table
+ALTER TABLE "audit_logs" ADD COLUMN "user_impersonated_audit_logs" bigint NULL, ADD CONSTRAINT "audit_logs_users_impersonated_audit_logs" FOREIGN KEY ("user_impersonated_audit_logs") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
@@ -0,0 +1,17 @@
+-- Create "role_grants" table
+CREATE TABLE "role_grants" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "role" character varying NOT NULL,
+  "reason" character varying NULL,
+  "expires_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "user_role_grants" bigint NOT NULL,
+  "user_given_role_grants" bigint NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "role_grants_users_given_role_grants" FOREIGN KEY ("user_given_role_grants") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "role_grants_users_role_grants" FOREIGN KEY ("user_role_grants") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "rolegrant_expires_at" to table: "role_grants"
+CREATE INDEX "rolegrant_expires_at" ON "role_grants" ("expires_at");
+-- Create index "rolegrant_role_user_role_grants" to table: "role_grants"
+CREATE UNIQUE INDEX "rolegrant_role_user_role_grants" ON "role_grants" ("role", "user_role_grants");
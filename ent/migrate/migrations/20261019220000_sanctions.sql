@@ -0,0 +1,23 @@
+-- Create "sanctions" table
+CREATE TABLE "sanctions" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "type" character varying NOT NULL,
+  "reason" character varying NOT NULL,
+  "expires_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "lifted_at" timestamptz NULL,
+  "lift_reason" character varying NULL,
+  "tournament_sanctions" bigint NULL,
+  "user_sanctions" bigint NOT NULL,
+  "user_issued_sanctions" bigint NULL,
+  "user_lifted_sanctions" bigint NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "sanctions_tournaments_sanctions" FOREIGN KEY ("tournament_sanctions") REFERENCES "tournaments" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "sanctions_users_issued_sanctions" FOREIGN KEY ("user_issued_sanctions") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "sanctions_users_lifted_sanctions" FOREIGN KEY ("user_lifted_sanctions") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "sanctions_users_sanctions" FOREIGN KEY ("user_sanctions") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "sanction_created_at" to table: "sanctions"
+CREATE INDEX "sanction_created_at" ON "sanctions" ("created_at");
+-- Create index "sanction_user_sanctions" to table: "sanctions"
+CREATE INDEX "sanction_user_sanctions" ON "sanctions" ("user_sanctions");
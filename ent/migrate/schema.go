@@ -20,6 +20,8 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
 		{Name: "roles", Type: field.TypeJSON},
+		{Name: "access_token_lifetime", Type: field.TypeInt, Nullable: true},
+		{Name: "refresh_token_lifetime", Type: field.TypeInt, Nullable: true},
 		{Name: "owner_id", Type: field.TypeInt},
 	}
 	// AppsTable holds the schema information for the "apps" table.
@@ -30,12 +32,68 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "apps_users_apps",
-				Columns:    []*schema.Column{AppsColumns[10]},
+				Columns:    []*schema.Column{AppsColumns[12]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "action", Type: field.TypeString},
+		{Name: "target_type", Type: field.TypeString},
+		{Name: "target_id", Type: field.TypeString},
+		{Name: "before", Type: field.TypeJSON, Nullable: true},
+		{Name: "after", Type: field.TypeJSON, Nullable: true},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "user_audit_logs", Type: field.TypeInt, Nullable: true},
+		{Name: "user_impersonated_audit_logs", Type: field.TypeInt, Nullable: true},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "audit_logs_users_audit_logs",
+				Columns:    []*schema.Column{AuditLogsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "audit_logs_users_impersonated_audit_logs",
+				Columns:    []*schema.Column{AuditLogsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1]},
+			},
+			{
+				Name:    "auditlog_action",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[2]},
+			},
+			{
+				Name:    "auditlog_target_type_target_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[3], AuditLogsColumns[4]},
+			},
+			{
+				Name:    "auditlog_user_audit_logs",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[9]},
+			},
+		},
+	}
 	// AuthCodesColumns holds the columns for the "auth_codes" table.
 	AuthCodesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -60,12 +118,51 @@ var (
 		{Name: "application_id", Type: field.TypeString},
 		{Name: "expiration", Type: field.TypeTime},
 		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "family_id", Type: field.TypeString},
+		{Name: "used", Type: field.TypeBool, Default: false},
+		{Name: "session_expiration", Type: field.TypeTime},
 	}
 	// AuthRefreshTokensTable holds the schema information for the "auth_refresh_tokens" table.
 	AuthRefreshTokensTable = &schema.Table{
 		Name:       "auth_refresh_tokens",
 		Columns:    AuthRefreshTokensColumns,
 		PrimaryKey: []*schema.Column{AuthRefreshTokensColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "authrefreshtoken_family_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuthRefreshTokensColumns[11]},
+			},
+		},
+	}
+	// AuthRequestsColumns holds the columns for the "auth_requests" table.
+	AuthRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "application_id", Type: field.TypeString},
+		{Name: "redirect_uri", Type: field.TypeString},
+		{Name: "state", Type: field.TypeString, Nullable: true},
+		{Name: "nonce", Type: field.TypeString, Nullable: true},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "prompt", Type: field.TypeJSON, Nullable: true},
+		{Name: "response_type", Type: field.TypeString},
+		{Name: "response_mode", Type: field.TypeString, Nullable: true},
+		{Name: "code_challenge", Type: field.TypeString, Nullable: true},
+		{Name: "code_challenge_method", Type: field.TypeString, Nullable: true},
+		{Name: "login_hint", Type: field.TypeString, Nullable: true},
+		{Name: "max_auth_age", Type: field.TypeInt64, Nullable: true},
+		{Name: "user_id", Type: field.TypeString, Nullable: true},
+		{Name: "done", Type: field.TypeBool, Default: false},
+		{Name: "auth_time", Type: field.TypeTime, Nullable: true},
+		{Name: "amr", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expiration", Type: field.TypeTime},
+	}
+	// AuthRequestsTable holds the schema information for the "auth_requests" table.
+	AuthRequestsTable = &schema.Table{
+		Name:       "auth_requests",
+		Columns:    AuthRequestsColumns,
+		PrimaryKey: []*schema.Column{AuthRequestsColumns[0]},
 	}
 	// AuthTokensColumns holds the columns for the "auth_tokens" table.
 	AuthTokensColumns = []*schema.Column{
@@ -76,6 +173,9 @@ var (
 		{Name: "audience", Type: field.TypeJSON},
 		{Name: "expiration", Type: field.TypeTime},
 		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "actor", Type: field.TypeString, Nullable: true},
 	}
 	// AuthTokensTable holds the schema information for the "auth_tokens" table.
 	AuthTokensTable = &schema.Table{
@@ -136,6 +236,26 @@ var (
 			},
 		},
 	}
+	// DeviceAuthorizationsColumns holds the columns for the "device_authorizations" table.
+	DeviceAuthorizationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "user_code", Type: field.TypeString, Unique: true},
+		{Name: "application_id", Type: field.TypeString},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "done", Type: field.TypeBool, Default: false},
+		{Name: "denied", Type: field.TypeBool, Default: false},
+		{Name: "subject", Type: field.TypeString, Nullable: true},
+		{Name: "auth_time", Type: field.TypeTime, Nullable: true},
+		{Name: "amr", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expiration", Type: field.TypeTime},
+	}
+	// DeviceAuthorizationsTable holds the schema information for the "device_authorizations" table.
+	DeviceAuthorizationsTable = &schema.Table{
+		Name:       "device_authorizations",
+		Columns:    DeviceAuthorizationsColumns,
+		PrimaryKey: []*schema.Column{DeviceAuthorizationsColumns[0]},
+	}
 	// InvitationsColumns holds the columns for the "invitations" table.
 	InvitationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -245,6 +365,128 @@ var (
 			},
 		},
 	}
+	// RoleGrantsColumns holds the columns for the "role_grants" table.
+	RoleGrantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "role", Type: field.TypeString},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_role_grants", Type: field.TypeInt},
+		{Name: "user_given_role_grants", Type: field.TypeInt, Nullable: true},
+	}
+	// RoleGrantsTable holds the schema information for the "role_grants" table.
+	RoleGrantsTable = &schema.Table{
+		Name:       "role_grants",
+		Columns:    RoleGrantsColumns,
+		PrimaryKey: []*schema.Column{RoleGrantsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "role_grants_users_role_grants",
+				Columns:    []*schema.Column{RoleGrantsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "role_grants_users_given_role_grants",
+				Columns:    []*schema.Column{RoleGrantsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "rolegrant_role_user_role_grants",
+				Unique:  true,
+				Columns: []*schema.Column{RoleGrantsColumns[1], RoleGrantsColumns[5]},
+			},
+			{
+				Name:    "rolegrant_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{RoleGrantsColumns[3]},
+			},
+		},
+	}
+	// SanctionsColumns holds the columns for the "sanctions" table.
+	SanctionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"global_ban", "tournament_ban", "vote_ban", "warning"}},
+		{Name: "reason", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "lifted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "lift_reason", Type: field.TypeString, Nullable: true},
+		{Name: "tournament_sanctions", Type: field.TypeInt, Nullable: true},
+		{Name: "user_sanctions", Type: field.TypeInt},
+		{Name: "user_issued_sanctions", Type: field.TypeInt, Nullable: true},
+		{Name: "user_lifted_sanctions", Type: field.TypeInt, Nullable: true},
+	}
+	// SanctionsTable holds the schema information for the "sanctions" table.
+	SanctionsTable = &schema.Table{
+		Name:       "sanctions",
+		Columns:    SanctionsColumns,
+		PrimaryKey: []*schema.Column{SanctionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sanctions_tournaments_sanctions",
+				Columns:    []*schema.Column{SanctionsColumns[7]},
+				RefColumns: []*schema.Column{TournamentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "sanctions_users_sanctions",
+				Columns:    []*schema.Column{SanctionsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "sanctions_users_issued_sanctions",
+				Columns:    []*schema.Column{SanctionsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "sanctions_users_lifted_sanctions",
+				Columns:    []*schema.Column{SanctionsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sanction_user_sanctions",
+				Unique:  false,
+				Columns: []*schema.Column{SanctionsColumns[8]},
+			},
+			{
+				Name:    "sanction_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SanctionsColumns[4]},
+			},
+		},
+	}
+	// SigningKeysColumns holds the columns for the "signing_keys" table.
+	SigningKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "algorithm", Type: field.TypeString},
+		{Name: "private_key", Type: field.TypeBytes},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "active_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// SigningKeysTable holds the schema information for the "signing_keys" table.
+	SigningKeysTable = &schema.Table{
+		Name:       "signing_keys",
+		Columns:    SigningKeysColumns,
+		PrimaryKey: []*schema.Column{SigningKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "signingkey_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{SigningKeysColumns[5]},
+			},
+		},
+	}
 	// TeamsColumns holds the columns for the "teams" table.
 	TeamsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -424,6 +666,8 @@ var (
 		{Name: "kind", Type: field.TypeEnum, Enums: []string{"user", "admin"}, Default: "user"},
 		{Name: "roles", Type: field.TypeJSON},
 		{Name: "elo", Type: field.TypeInt, Default: 0},
+		{Name: "campus", Type: field.TypeString, Nullable: true},
+		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -471,8 +715,11 @@ var (
 		{Name: "title", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "visible", Type: field.TypeBool, Default: false},
+		{Name: "anonymous", Type: field.TypeBool, Default: true},
 		{Name: "start_at", Type: field.TypeTime},
 		{Name: "end_at", Type: field.TypeTime},
+		{Name: "results_reveal_at", Type: field.TypeTime, Nullable: true},
+		{Name: "results_published_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "user_created_votes", Type: field.TypeInt},
@@ -485,7 +732,35 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "votes_users_created_votes",
-				Columns:    []*schema.Column{VotesColumns[8]},
+				Columns:    []*schema.Column{VotesColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// VoteTemplatesColumns holds the columns for the "vote_templates" table.
+	VoteTemplatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "title", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "anonymous", Type: field.TypeBool, Default: true},
+		{Name: "duration_minutes", Type: field.TypeInt},
+		{Name: "results_delay_minutes", Type: field.TypeInt, Nullable: true},
+		{Name: "components", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_created_vote_templates", Type: field.TypeInt},
+	}
+	// VoteTemplatesTable holds the schema information for the "vote_templates" table.
+	VoteTemplatesTable = &schema.Table{
+		Name:       "vote_templates",
+		Columns:    VoteTemplatesColumns,
+		PrimaryKey: []*schema.Column{VoteTemplatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "vote_templates_users_created_vote_templates",
+				Columns:    []*schema.Column{VoteTemplatesColumns[10]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -494,14 +769,20 @@ var (
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AppsTable,
+		AuditLogsTable,
 		AuthCodesTable,
 		AuthRefreshTokensTable,
+		AuthRequestsTable,
 		AuthTokensTable,
 		ComponentsTable,
 		ConsentsTable,
+		DeviceAuthorizationsTable,
 		InvitationsTable,
 		NotificationsTable,
 		RankGroupsTable,
+		RoleGrantsTable,
+		SanctionsTable,
+		SigningKeysTable,
 		TeamsTable,
 		TeamMembersTable,
 		TournamentsTable,
@@ -509,11 +790,14 @@ var (
 		UsersTable,
 		UserVotesTable,
 		VotesTable,
+		VoteTemplatesTable,
 	}
 )
 
 func init() {
 	AppsTable.ForeignKeys[0].RefTable = UsersTable
+	AuditLogsTable.ForeignKeys[0].RefTable = UsersTable
+	AuditLogsTable.ForeignKeys[1].RefTable = UsersTable
 	ComponentsTable.ForeignKeys[0].RefTable = VotesTable
 	ConsentsTable.ForeignKeys[0].RefTable = AppsTable
 	ConsentsTable.ForeignKeys[1].RefTable = UsersTable
@@ -521,6 +805,12 @@ func init() {
 	InvitationsTable.ForeignKeys[1].RefTable = UsersTable
 	NotificationsTable.ForeignKeys[0].RefTable = UsersTable
 	RankGroupsTable.ForeignKeys[0].RefTable = TournamentsTable
+	RoleGrantsTable.ForeignKeys[0].RefTable = UsersTable
+	RoleGrantsTable.ForeignKeys[1].RefTable = UsersTable
+	SanctionsTable.ForeignKeys[0].RefTable = TournamentsTable
+	SanctionsTable.ForeignKeys[1].RefTable = UsersTable
+	SanctionsTable.ForeignKeys[2].RefTable = UsersTable
+	SanctionsTable.ForeignKeys[3].RefTable = UsersTable
 	TeamsTable.ForeignKeys[0].RefTable = RankGroupsTable
 	TeamsTable.ForeignKeys[1].RefTable = TournamentsTable
 	TeamsTable.ForeignKeys[2].RefTable = UsersTable
@@ -533,4 +823,5 @@ func init() {
 	UserVotesTable.ForeignKeys[0].RefTable = ComponentsTable
 	UserVotesTable.ForeignKeys[1].RefTable = UsersTable
 	VotesTable.ForeignKeys[0].RefTable = UsersTable
+	VoteTemplatesTable.ForeignKeys[0].RefTable = UsersTable
 }
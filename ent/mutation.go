@@ -4,15 +4,22 @@ package ent
 
 import (
 	"base-website/ent/app"
+	"base-website/ent/auditlog"
 	"base-website/ent/authcode"
 	"base-website/ent/authrefreshtoken"
+	"base-website/ent/authrequest"
 	"base-website/ent/authtoken"
 	"base-website/ent/component"
 	"base-website/ent/consent"
+	"base-website/ent/deviceauthorization"
 	"base-website/ent/invitation"
 	"base-website/ent/notification"
 	"base-website/ent/predicate"
 	"base-website/ent/rankgroup"
+	"base-website/ent/rolegrant"
+	"base-website/ent/sanction"
+	"base-website/ent/schema"
+	"base-website/ent/signingkey"
 	"base-website/ent/team"
 	"base-website/ent/teammember"
 	"base-website/ent/tournament"
@@ -20,6 +27,7 @@ import (
 	"base-website/ent/user"
 	"base-website/ent/uservote"
 	"base-website/ent/vote"
+	"base-website/ent/votetemplate"
 	"context"
 	"errors"
 	"fmt"
@@ -39,50 +47,61 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeApp              = "App"
-	TypeAuthCode         = "AuthCode"
-	TypeAuthRefreshToken = "AuthRefreshToken"
-	TypeAuthToken        = "AuthToken"
-	TypeComponent        = "Component"
-	TypeConsent          = "Consent"
-	TypeInvitation       = "Invitation"
-	TypeNotification     = "Notification"
-	TypeRankGroup        = "RankGroup"
-	TypeTeam             = "Team"
-	TypeTeamMember       = "TeamMember"
-	TypeTournament       = "Tournament"
-	TypeTournamentAdmin  = "TournamentAdmin"
-	TypeUser             = "User"
-	TypeUserVote         = "UserVote"
-	TypeVote             = "Vote"
+	TypeApp                 = "App"
+	TypeAuditLog            = "AuditLog"
+	TypeAuthCode            = "AuthCode"
+	TypeAuthRefreshToken    = "AuthRefreshToken"
+	TypeAuthRequest         = "AuthRequest"
+	TypeAuthToken           = "AuthToken"
+	TypeComponent           = "Component"
+	TypeConsent             = "Consent"
+	TypeDeviceAuthorization = "DeviceAuthorization"
+	TypeInvitation          = "Invitation"
+	TypeNotification        = "Notification"
+	TypeRankGroup           = "RankGroup"
+	TypeRoleGrant           = "RoleGrant"
+	TypeSanction            = "Sanction"
+	TypeSigningKey          = "SigningKey"
+	TypeTeam                = "Team"
+	TypeTeamMember          = "TeamMember"
+	TypeTournament          = "Tournament"
+	TypeTournamentAdmin     = "TournamentAdmin"
+	TypeUser                = "User"
+	TypeUserVote            = "UserVote"
+	TypeVote                = "Vote"
+	TypeVoteTemplate        = "VoteTemplate"
 )
 
 // AppMutation represents an operation that mutates the App nodes in the graph.
 type AppMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *string
-	secret              *string
-	name                *string
-	redirect_uris       *[]string
-	appendredirect_uris []string
-	implicit_consent    *bool
-	description         *string
-	created_at          *time.Time
-	updated_at          *time.Time
-	last_login_at       *time.Time
-	roles               *[]string
-	appendroles         []string
-	clearedFields       map[string]struct{}
-	owner               *int
-	clearedowner        bool
-	consents            map[int]struct{}
-	removedconsents     map[int]struct{}
-	clearedconsents     bool
-	done                bool
-	oldValue            func(context.Context) (*App, error)
-	predicates          []predicate.App
+	op                        Op
+	typ                       string
+	id                        *string
+	secret                    *string
+	name                      *string
+	redirect_uris             *[]string
+	appendredirect_uris       []string
+	implicit_consent          *bool
+	description               *string
+	created_at                *time.Time
+	updated_at                *time.Time
+	last_login_at             *time.Time
+	roles                     *[]string
+	appendroles               []string
+	access_token_lifetime     *int
+	addaccess_token_lifetime  *int
+	refresh_token_lifetime    *int
+	addrefresh_token_lifetime *int
+	clearedFields             map[string]struct{}
+	owner                     *int
+	clearedowner              bool
+	consents                  map[int]struct{}
+	removedconsents           map[int]struct{}
+	clearedconsents           bool
+	done                      bool
+	oldValue                  func(context.Context) (*App, error)
+	predicates                []predicate.App
 }
 
 var _ ent.Mutation = (*AppMutation)(nil)
@@ -592,6 +611,146 @@ func (m *AppMutation) ResetRoles() {
 	m.appendroles = nil
 }
 
+// SetAccessTokenLifetime sets the "access_token_lifetime" field.
+func (m *AppMutation) SetAccessTokenLifetime(i int) {
+	m.access_token_lifetime = &i
+	m.addaccess_token_lifetime = nil
+}
+
+// AccessTokenLifetime returns the value of the "access_token_lifetime" field in the mutation.
+func (m *AppMutation) AccessTokenLifetime() (r int, exists bool) {
+	v := m.access_token_lifetime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessTokenLifetime returns the old "access_token_lifetime" field's value of the App entity.
+// If the App object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AppMutation) OldAccessTokenLifetime(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessTokenLifetime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessTokenLifetime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessTokenLifetime: %w", err)
+	}
+	return oldValue.AccessTokenLifetime, nil
+}
+
+// AddAccessTokenLifetime adds i to the "access_token_lifetime" field.
+func (m *AppMutation) AddAccessTokenLifetime(i int) {
+	if m.addaccess_token_lifetime != nil {
+		*m.addaccess_token_lifetime += i
+	} else {
+		m.addaccess_token_lifetime = &i
+	}
+}
+
+// AddedAccessTokenLifetime returns the value that was added to the "access_token_lifetime" field in this mutation.
+func (m *AppMutation) AddedAccessTokenLifetime() (r int, exists bool) {
+	v := m.addaccess_token_lifetime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAccessTokenLifetime clears the value of the "access_token_lifetime" field.
+func (m *AppMutation) ClearAccessTokenLifetime() {
+	m.access_token_lifetime = nil
+	m.addaccess_token_lifetime = nil
+	m.clearedFields[app.FieldAccessTokenLifetime] = struct{}{}
+}
+
+// AccessTokenLifetimeCleared returns if the "access_token_lifetime" field was cleared in this mutation.
+func (m *AppMutation) AccessTokenLifetimeCleared() bool {
+	_, ok := m.clearedFields[app.FieldAccessTokenLifetime]
+	return ok
+}
+
+// ResetAccessTokenLifetime resets all changes to the "access_token_lifetime" field.
+func (m *AppMutation) ResetAccessTokenLifetime() {
+	m.access_token_lifetime = nil
+	m.addaccess_token_lifetime = nil
+	delete(m.clearedFields, app.FieldAccessTokenLifetime)
+}
+
+// SetRefreshTokenLifetime sets the "refresh_token_lifetime" field.
+func (m *AppMutation) SetRefreshTokenLifetime(i int) {
+	m.refresh_token_lifetime = &i
+	m.addrefresh_token_lifetime = nil
+}
+
+// RefreshTokenLifetime returns the value of the "refresh_token_lifetime" field in the mutation.
+func (m *AppMutation) RefreshTokenLifetime() (r int, exists bool) {
+	v := m.refresh_token_lifetime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRefreshTokenLifetime returns the old "refresh_token_lifetime" field's value of the App entity.
+// If the App object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AppMutation) OldRefreshTokenLifetime(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRefreshTokenLifetime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRefreshTokenLifetime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefreshTokenLifetime: %w", err)
+	}
+	return oldValue.RefreshTokenLifetime, nil
+}
+
+// AddRefreshTokenLifetime adds i to the "refresh_token_lifetime" field.
+func (m *AppMutation) AddRefreshTokenLifetime(i int) {
+	if m.addrefresh_token_lifetime != nil {
+		*m.addrefresh_token_lifetime += i
+	} else {
+		m.addrefresh_token_lifetime = &i
+	}
+}
+
+// AddedRefreshTokenLifetime returns the value that was added to the "refresh_token_lifetime" field in this mutation.
+func (m *AppMutation) AddedRefreshTokenLifetime() (r int, exists bool) {
+	v := m.addrefresh_token_lifetime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRefreshTokenLifetime clears the value of the "refresh_token_lifetime" field.
+func (m *AppMutation) ClearRefreshTokenLifetime() {
+	m.refresh_token_lifetime = nil
+	m.addrefresh_token_lifetime = nil
+	m.clearedFields[app.FieldRefreshTokenLifetime] = struct{}{}
+}
+
+// RefreshTokenLifetimeCleared returns if the "refresh_token_lifetime" field was cleared in this mutation.
+func (m *AppMutation) RefreshTokenLifetimeCleared() bool {
+	_, ok := m.clearedFields[app.FieldRefreshTokenLifetime]
+	return ok
+}
+
+// ResetRefreshTokenLifetime resets all changes to the "refresh_token_lifetime" field.
+func (m *AppMutation) ResetRefreshTokenLifetime() {
+	m.refresh_token_lifetime = nil
+	m.addrefresh_token_lifetime = nil
+	delete(m.clearedFields, app.FieldRefreshTokenLifetime)
+}
+
 // ClearOwner clears the "owner" edge to the User entity.
 func (m *AppMutation) ClearOwner() {
 	m.clearedowner = true
@@ -707,7 +866,7 @@ func (m *AppMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *AppMutation) Fields() []string {
-	fields := make([]string, 0, 10)
+	fields := make([]string, 0, 12)
 	if m.secret != nil {
 		fields = append(fields, app.FieldSecret)
 	}
@@ -738,6 +897,12 @@ func (m *AppMutation) Fields() []string {
 	if m.roles != nil {
 		fields = append(fields, app.FieldRoles)
 	}
+	if m.access_token_lifetime != nil {
+		fields = append(fields, app.FieldAccessTokenLifetime)
+	}
+	if m.refresh_token_lifetime != nil {
+		fields = append(fields, app.FieldRefreshTokenLifetime)
+	}
 	return fields
 }
 
@@ -766,6 +931,10 @@ func (m *AppMutation) Field(name string) (ent.Value, bool) {
 		return m.OwnerID()
 	case app.FieldRoles:
 		return m.Roles()
+	case app.FieldAccessTokenLifetime:
+		return m.AccessTokenLifetime()
+	case app.FieldRefreshTokenLifetime:
+		return m.RefreshTokenLifetime()
 	}
 	return nil, false
 }
@@ -795,6 +964,10 @@ func (m *AppMutation) OldField(ctx context.Context, name string) (ent.Value, err
 		return m.OldOwnerID(ctx)
 	case app.FieldRoles:
 		return m.OldRoles(ctx)
+	case app.FieldAccessTokenLifetime:
+		return m.OldAccessTokenLifetime(ctx)
+	case app.FieldRefreshTokenLifetime:
+		return m.OldRefreshTokenLifetime(ctx)
 	}
 	return nil, fmt.Errorf("unknown App field %s", name)
 }
@@ -874,6 +1047,20 @@ func (m *AppMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetRoles(v)
 		return nil
+	case app.FieldAccessTokenLifetime:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessTokenLifetime(v)
+		return nil
+	case app.FieldRefreshTokenLifetime:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefreshTokenLifetime(v)
+		return nil
 	}
 	return fmt.Errorf("unknown App field %s", name)
 }
@@ -882,6 +1069,12 @@ func (m *AppMutation) SetField(name string, value ent.Value) error {
 // this mutation.
 func (m *AppMutation) AddedFields() []string {
 	var fields []string
+	if m.addaccess_token_lifetime != nil {
+		fields = append(fields, app.FieldAccessTokenLifetime)
+	}
+	if m.addrefresh_token_lifetime != nil {
+		fields = append(fields, app.FieldRefreshTokenLifetime)
+	}
 	return fields
 }
 
@@ -890,6 +1083,10 @@ func (m *AppMutation) AddedFields() []string {
 // was not set, or was not defined in the schema.
 func (m *AppMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case app.FieldAccessTokenLifetime:
+		return m.AddedAccessTokenLifetime()
+	case app.FieldRefreshTokenLifetime:
+		return m.AddedRefreshTokenLifetime()
 	}
 	return nil, false
 }
@@ -899,6 +1096,20 @@ func (m *AppMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *AppMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case app.FieldAccessTokenLifetime:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAccessTokenLifetime(v)
+		return nil
+	case app.FieldRefreshTokenLifetime:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRefreshTokenLifetime(v)
+		return nil
 	}
 	return fmt.Errorf("unknown App numeric field %s", name)
 }
@@ -910,6 +1121,12 @@ func (m *AppMutation) ClearedFields() []string {
 	if m.FieldCleared(app.FieldLastLoginAt) {
 		fields = append(fields, app.FieldLastLoginAt)
 	}
+	if m.FieldCleared(app.FieldAccessTokenLifetime) {
+		fields = append(fields, app.FieldAccessTokenLifetime)
+	}
+	if m.FieldCleared(app.FieldRefreshTokenLifetime) {
+		fields = append(fields, app.FieldRefreshTokenLifetime)
+	}
 	return fields
 }
 
@@ -927,6 +1144,12 @@ func (m *AppMutation) ClearField(name string) error {
 	case app.FieldLastLoginAt:
 		m.ClearLastLoginAt()
 		return nil
+	case app.FieldAccessTokenLifetime:
+		m.ClearAccessTokenLifetime()
+		return nil
+	case app.FieldRefreshTokenLifetime:
+		m.ClearRefreshTokenLifetime()
+		return nil
 	}
 	return fmt.Errorf("unknown App nullable field %s", name)
 }
@@ -965,6 +1188,12 @@ func (m *AppMutation) ResetField(name string) error {
 	case app.FieldRoles:
 		m.ResetRoles()
 		return nil
+	case app.FieldAccessTokenLifetime:
+		m.ResetAccessTokenLifetime()
+		return nil
+	case app.FieldRefreshTokenLifetime:
+		m.ResetRefreshTokenLifetime()
+		return nil
 	}
 	return fmt.Errorf("unknown App field %s", name)
 }
@@ -1071,31 +1300,41 @@ func (m *AppMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown App edge %s", name)
 }
 
-// AuthCodeMutation represents an operation that mutates the AuthCode nodes in the graph.
-type AuthCodeMutation struct {
+// AuditLogMutation represents an operation that mutates the AuditLog nodes in the graph.
+type AuditLogMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *string
-	auth_request_id *string
-	expiration      *time.Time
-	clearedFields   map[string]struct{}
-	done            bool
-	oldValue        func(context.Context) (*AuthCode, error)
-	predicates      []predicate.AuthCode
+	op                  Op
+	typ                 string
+	id                  *int
+	created_at          *time.Time
+	action              *string
+	target_type         *string
+	target_id           *string
+	before              *map[string]interface{}
+	after               *map[string]interface{}
+	ip                  *string
+	user_agent          *string
+	clearedFields       map[string]struct{}
+	actor               *int
+	clearedactor        bool
+	impersonator        *int
+	clearedimpersonator bool
+	done                bool
+	oldValue            func(context.Context) (*AuditLog, error)
+	predicates          []predicate.AuditLog
 }
 
-var _ ent.Mutation = (*AuthCodeMutation)(nil)
+var _ ent.Mutation = (*AuditLogMutation)(nil)
 
-// authcodeOption allows management of the mutation configuration using functional options.
-type authcodeOption func(*AuthCodeMutation)
+// auditlogOption allows management of the mutation configuration using functional options.
+type auditlogOption func(*AuditLogMutation)
 
-// newAuthCodeMutation creates new mutation for the AuthCode entity.
-func newAuthCodeMutation(c config, op Op, opts ...authcodeOption) *AuthCodeMutation {
-	m := &AuthCodeMutation{
+// newAuditLogMutation creates new mutation for the AuditLog entity.
+func newAuditLogMutation(c config, op Op, opts ...auditlogOption) *AuditLogMutation {
+	m := &AuditLogMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeAuthCode,
+		typ:           TypeAuditLog,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1104,20 +1343,20 @@ func newAuthCodeMutation(c config, op Op, opts ...authcodeOption) *AuthCodeMutat
 	return m
 }
 
-// withAuthCodeID sets the ID field of the mutation.
-func withAuthCodeID(id string) authcodeOption {
-	return func(m *AuthCodeMutation) {
+// withAuditLogID sets the ID field of the mutation.
+func withAuditLogID(id int) auditlogOption {
+	return func(m *AuditLogMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *AuthCode
+			value *AuditLog
 		)
-		m.oldValue = func(ctx context.Context) (*AuthCode, error) {
+		m.oldValue = func(ctx context.Context) (*AuditLog, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().AuthCode.Get(ctx, id)
+					value, err = m.Client().AuditLog.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1126,10 +1365,10 @@ func withAuthCodeID(id string) authcodeOption {
 	}
 }
 
-// withAuthCode sets the old AuthCode of the mutation.
-func withAuthCode(node *AuthCode) authcodeOption {
-	return func(m *AuthCodeMutation) {
-		m.oldValue = func(context.Context) (*AuthCode, error) {
+// withAuditLog sets the old AuditLog of the mutation.
+func withAuditLog(node *AuditLog) auditlogOption {
+	return func(m *AuditLogMutation) {
+		m.oldValue = func(context.Context) (*AuditLog, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1138,7 +1377,7 @@ func withAuthCode(node *AuthCode) authcodeOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m AuthCodeMutation) Client() *Client {
+func (m AuditLogMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1146,7 +1385,7 @@ func (m AuthCodeMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m AuthCodeMutation) Tx() (*Tx, error) {
+func (m AuditLogMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1155,15 +1394,9 @@ func (m AuthCodeMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of AuthCode entities.
-func (m *AuthCodeMutation) SetID(id string) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *AuthCodeMutation) ID() (id string, exists bool) {
+func (m *AuditLogMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1174,706 +1407,969 @@ func (m *AuthCodeMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *AuthCodeMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *AuditLogMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []string{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().AuthCode.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuditLog.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetAuthRequestID sets the "auth_request_id" field.
-func (m *AuthCodeMutation) SetAuthRequestID(s string) {
-	m.auth_request_id = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *AuditLogMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// AuthRequestID returns the value of the "auth_request_id" field in the mutation.
-func (m *AuthCodeMutation) AuthRequestID() (r string, exists bool) {
-	v := m.auth_request_id
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuditLogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAuthRequestID returns the old "auth_request_id" field's value of the AuthCode entity.
-// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthCodeMutation) OldAuthRequestID(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuthRequestID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuthRequestID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuthRequestID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.AuthRequestID, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetAuthRequestID resets all changes to the "auth_request_id" field.
-func (m *AuthCodeMutation) ResetAuthRequestID() {
-	m.auth_request_id = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuditLogMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetExpiration sets the "expiration" field.
-func (m *AuthCodeMutation) SetExpiration(t time.Time) {
-	m.expiration = &t
+// SetAction sets the "action" field.
+func (m *AuditLogMutation) SetAction(s string) {
+	m.action = &s
 }
 
-// Expiration returns the value of the "expiration" field in the mutation.
-func (m *AuthCodeMutation) Expiration() (r time.Time, exists bool) {
-	v := m.expiration
+// Action returns the value of the "action" field in the mutation.
+func (m *AuditLogMutation) Action() (r string, exists bool) {
+	v := m.action
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiration returns the old "expiration" field's value of the AuthCode entity.
-// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
+// OldAction returns the old "action" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthCodeMutation) OldExpiration(ctx context.Context) (v time.Time, err error) {
+func (m *AuditLogMutation) OldAction(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiration is only allowed on UpdateOne operations")
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiration requires an ID field in the mutation")
+		return v, errors.New("OldAction requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiration: %w", err)
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
 	}
-	return oldValue.Expiration, nil
+	return oldValue.Action, nil
 }
 
-// ResetExpiration resets all changes to the "expiration" field.
-func (m *AuthCodeMutation) ResetExpiration() {
-	m.expiration = nil
+// ResetAction resets all changes to the "action" field.
+func (m *AuditLogMutation) ResetAction() {
+	m.action = nil
 }
 
-// Where appends a list predicates to the AuthCodeMutation builder.
-func (m *AuthCodeMutation) Where(ps ...predicate.AuthCode) {
-	m.predicates = append(m.predicates, ps...)
+// SetTargetType sets the "target_type" field.
+func (m *AuditLogMutation) SetTargetType(s string) {
+	m.target_type = &s
 }
 
-// WhereP appends storage-level predicates to the AuthCodeMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *AuthCodeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.AuthCode, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// TargetType returns the value of the "target_type" field in the mutation.
+func (m *AuditLogMutation) TargetType() (r string, exists bool) {
+	v := m.target_type
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *AuthCodeMutation) Op() Op {
-	return m.op
+// OldTargetType returns the old "target_type" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldTargetType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetType: %w", err)
+	}
+	return oldValue.TargetType, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *AuthCodeMutation) SetOp(op Op) {
-	m.op = op
+// ResetTargetType resets all changes to the "target_type" field.
+func (m *AuditLogMutation) ResetTargetType() {
+	m.target_type = nil
 }
 
-// Type returns the node type of this mutation (AuthCode).
-func (m *AuthCodeMutation) Type() string {
-	return m.typ
+// SetTargetID sets the "target_id" field.
+func (m *AuditLogMutation) SetTargetID(s string) {
+	m.target_id = &s
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *AuthCodeMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.auth_request_id != nil {
-		fields = append(fields, authcode.FieldAuthRequestID)
-	}
-	if m.expiration != nil {
-		fields = append(fields, authcode.FieldExpiration)
+// TargetID returns the value of the "target_id" field in the mutation.
+func (m *AuditLogMutation) TargetID() (r string, exists bool) {
+	v := m.target_id
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *AuthCodeMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case authcode.FieldAuthRequestID:
-		return m.AuthRequestID()
-	case authcode.FieldExpiration:
-		return m.Expiration()
+// OldTargetID returns the old "target_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldTargetID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
 	}
-	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *AuthCodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case authcode.FieldAuthRequestID:
-		return m.OldAuthRequestID(ctx)
-	case authcode.FieldExpiration:
-		return m.OldExpiration(ctx)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetID requires an ID field in the mutation")
 	}
-	return nil, fmt.Errorf("unknown AuthCode field %s", name)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
+	}
+	return oldValue.TargetID, nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *AuthCodeMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case authcode.FieldAuthRequestID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAuthRequestID(v)
-		return nil
-	case authcode.FieldExpiration:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExpiration(v)
-		return nil
-	}
-	return fmt.Errorf("unknown AuthCode field %s", name)
+// ResetTargetID resets all changes to the "target_id" field.
+func (m *AuditLogMutation) ResetTargetID() {
+	m.target_id = nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *AuthCodeMutation) AddedFields() []string {
-	return nil
+// SetBefore sets the "before" field.
+func (m *AuditLogMutation) SetBefore(value map[string]interface{}) {
+	m.before = &value
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *AuthCodeMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// Before returns the value of the "before" field in the mutation.
+func (m *AuditLogMutation) Before() (r map[string]interface{}, exists bool) {
+	v := m.before
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *AuthCodeMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// OldBefore returns the old "before" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldBefore(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBefore is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown AuthCode numeric field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBefore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBefore: %w", err)
+	}
+	return oldValue.Before, nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *AuthCodeMutation) ClearedFields() []string {
-	return nil
+// ClearBefore clears the value of the "before" field.
+func (m *AuditLogMutation) ClearBefore() {
+	m.before = nil
+	m.clearedFields[auditlog.FieldBefore] = struct{}{}
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *AuthCodeMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// BeforeCleared returns if the "before" field was cleared in this mutation.
+func (m *AuditLogMutation) BeforeCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldBefore]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *AuthCodeMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown AuthCode nullable field %s", name)
+// ResetBefore resets all changes to the "before" field.
+func (m *AuditLogMutation) ResetBefore() {
+	m.before = nil
+	delete(m.clearedFields, auditlog.FieldBefore)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *AuthCodeMutation) ResetField(name string) error {
-	switch name {
-	case authcode.FieldAuthRequestID:
-		m.ResetAuthRequestID()
-		return nil
-	case authcode.FieldExpiration:
-		m.ResetExpiration()
-		return nil
+// SetAfter sets the "after" field.
+func (m *AuditLogMutation) SetAfter(value map[string]interface{}) {
+	m.after = &value
+}
+
+// After returns the value of the "after" field in the mutation.
+func (m *AuditLogMutation) After() (r map[string]interface{}, exists bool) {
+	v := m.after
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown AuthCode field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *AuthCodeMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// OldAfter returns the old "after" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldAfter(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAfter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAfter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAfter: %w", err)
+	}
+	return oldValue.After, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *AuthCodeMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// ClearAfter clears the value of the "after" field.
+func (m *AuditLogMutation) ClearAfter() {
+	m.after = nil
+	m.clearedFields[auditlog.FieldAfter] = struct{}{}
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *AuthCodeMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// AfterCleared returns if the "after" field was cleared in this mutation.
+func (m *AuditLogMutation) AfterCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldAfter]
+	return ok
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *AuthCodeMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// ResetAfter resets all changes to the "after" field.
+func (m *AuditLogMutation) ResetAfter() {
+	m.after = nil
+	delete(m.clearedFields, auditlog.FieldAfter)
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *AuthCodeMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// SetIP sets the "ip" field.
+func (m *AuditLogMutation) SetIP(s string) {
+	m.ip = &s
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *AuthCodeMutation) EdgeCleared(name string) bool {
-	return false
+// IP returns the value of the "ip" field in the mutation.
+func (m *AuditLogMutation) IP() (r string, exists bool) {
+	v := m.ip
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *AuthCodeMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown AuthCode unique edge %s", name)
+// OldIP returns the old "ip" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
+	}
+	return oldValue.IP, nil
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *AuthCodeMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown AuthCode edge %s", name)
+// ClearIP clears the value of the "ip" field.
+func (m *AuditLogMutation) ClearIP() {
+	m.ip = nil
+	m.clearedFields[auditlog.FieldIP] = struct{}{}
 }
 
-// AuthRefreshTokenMutation represents an operation that mutates the AuthRefreshToken nodes in the graph.
-type AuthRefreshTokenMutation struct {
-	config
-	op             Op
-	typ            string
-	id             *string
-	_Token         *string
-	subject        *string
-	auth_time      *time.Time
-	amr            *[]string
-	appendamr      []string
-	audience       *[]string
-	appendaudience []string
-	user_id        *string
-	application_id *string
-	expiration     *time.Time
-	scopes         *[]string
-	appendscopes   []string
-	clearedFields  map[string]struct{}
-	done           bool
-	oldValue       func(context.Context) (*AuthRefreshToken, error)
-	predicates     []predicate.AuthRefreshToken
+// IPCleared returns if the "ip" field was cleared in this mutation.
+func (m *AuditLogMutation) IPCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldIP]
+	return ok
 }
 
-var _ ent.Mutation = (*AuthRefreshTokenMutation)(nil)
+// ResetIP resets all changes to the "ip" field.
+func (m *AuditLogMutation) ResetIP() {
+	m.ip = nil
+	delete(m.clearedFields, auditlog.FieldIP)
+}
 
-// authrefreshtokenOption allows management of the mutation configuration using functional options.
-type authrefreshtokenOption func(*AuthRefreshTokenMutation)
+// SetUserAgent sets the "user_agent" field.
+func (m *AuditLogMutation) SetUserAgent(s string) {
+	m.user_agent = &s
+}
 
-// newAuthRefreshTokenMutation creates new mutation for the AuthRefreshToken entity.
-func newAuthRefreshTokenMutation(c config, op Op, opts ...authrefreshtokenOption) *AuthRefreshTokenMutation {
-	m := &AuthRefreshTokenMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeAuthRefreshToken,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *AuditLogMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
+	if v == nil {
+		return
 	}
-	return m
+	return *v, true
 }
 
-// withAuthRefreshTokenID sets the ID field of the mutation.
-func withAuthRefreshTokenID(id string) authrefreshtokenOption {
-	return func(m *AuthRefreshTokenMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *AuthRefreshToken
-		)
-		m.oldValue = func(ctx context.Context) (*AuthRefreshToken, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().AuthRefreshToken.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldUserAgent returns the old "user_agent" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldUserAgent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
 	}
+	return oldValue.UserAgent, nil
 }
 
-// withAuthRefreshToken sets the old AuthRefreshToken of the mutation.
-func withAuthRefreshToken(node *AuthRefreshToken) authrefreshtokenOption {
-	return func(m *AuthRefreshTokenMutation) {
-		m.oldValue = func(context.Context) (*AuthRefreshToken, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// ClearUserAgent clears the value of the "user_agent" field.
+func (m *AuditLogMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[auditlog.FieldUserAgent] = struct{}{}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m AuthRefreshTokenMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
+func (m *AuditLogMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldUserAgent]
+	return ok
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m AuthRefreshTokenMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *AuditLogMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, auditlog.FieldUserAgent)
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of AuthRefreshToken entities.
-func (m *AuthRefreshTokenMutation) SetID(id string) {
-	m.id = &id
+// SetActorID sets the "actor" edge to the User entity by id.
+func (m *AuditLogMutation) SetActorID(id int) {
+	m.actor = &id
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *AuthRefreshTokenMutation) ID() (id string, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// ClearActor clears the "actor" edge to the User entity.
+func (m *AuditLogMutation) ClearActor() {
+	m.clearedactor = true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *AuthRefreshTokenMutation) IDs(ctx context.Context) ([]string, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []string{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().AuthRefreshToken.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// ActorCleared reports if the "actor" edge to the User entity was cleared.
+func (m *AuditLogMutation) ActorCleared() bool {
+	return m.clearedactor
+}
+
+// ActorID returns the "actor" edge ID in the mutation.
+func (m *AuditLogMutation) ActorID() (id int, exists bool) {
+	if m.actor != nil {
+		return *m.actor, true
 	}
+	return
 }
 
-// SetToken sets the "Token" field.
-func (m *AuthRefreshTokenMutation) SetToken(s string) {
-	m._Token = &s
+// ActorIDs returns the "actor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ActorID instead. It exists only for internal usage by the builders.
+func (m *AuditLogMutation) ActorIDs() (ids []int) {
+	if id := m.actor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// Token returns the value of the "Token" field in the mutation.
-func (m *AuthRefreshTokenMutation) Token() (r string, exists bool) {
-	v := m._Token
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetActor resets all changes to the "actor" edge.
+func (m *AuditLogMutation) ResetActor() {
+	m.actor = nil
+	m.clearedactor = false
 }
 
-// OldToken returns the old "Token" field's value of the AuthRefreshToken entity.
-// If the AuthRefreshToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRefreshTokenMutation) OldToken(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToken is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToken requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToken: %w", err)
-	}
-	return oldValue.Token, nil
+// SetImpersonatorID sets the "impersonator" edge to the User entity by id.
+func (m *AuditLogMutation) SetImpersonatorID(id int) {
+	m.impersonator = &id
 }
 
-// ResetToken resets all changes to the "Token" field.
-func (m *AuthRefreshTokenMutation) ResetToken() {
-	m._Token = nil
+// ClearImpersonator clears the "impersonator" edge to the User entity.
+func (m *AuditLogMutation) ClearImpersonator() {
+	m.clearedimpersonator = true
 }
 
-// SetSubject sets the "subject" field.
-func (m *AuthRefreshTokenMutation) SetSubject(s string) {
-	m.subject = &s
+// ImpersonatorCleared reports if the "impersonator" edge to the User entity was cleared.
+func (m *AuditLogMutation) ImpersonatorCleared() bool {
+	return m.clearedimpersonator
 }
 
-// Subject returns the value of the "subject" field in the mutation.
-func (m *AuthRefreshTokenMutation) Subject() (r string, exists bool) {
-	v := m.subject
-	if v == nil {
-		return
+// ImpersonatorID returns the "impersonator" edge ID in the mutation.
+func (m *AuditLogMutation) ImpersonatorID() (id int, exists bool) {
+	if m.impersonator != nil {
+		return *m.impersonator, true
 	}
-	return *v, true
+	return
 }
 
-// OldSubject returns the old "subject" field's value of the AuthRefreshToken entity.
-// If the AuthRefreshToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRefreshTokenMutation) OldSubject(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSubject requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
+// ImpersonatorIDs returns the "impersonator" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ImpersonatorID instead. It exists only for internal usage by the builders.
+func (m *AuditLogMutation) ImpersonatorIDs() (ids []int) {
+	if id := m.impersonator; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.Subject, nil
+	return
 }
 
-// ResetSubject resets all changes to the "subject" field.
-func (m *AuthRefreshTokenMutation) ResetSubject() {
-	m.subject = nil
+// ResetImpersonator resets all changes to the "impersonator" edge.
+func (m *AuditLogMutation) ResetImpersonator() {
+	m.impersonator = nil
+	m.clearedimpersonator = false
 }
 
-// SetAuthTime sets the "auth_time" field.
-func (m *AuthRefreshTokenMutation) SetAuthTime(t time.Time) {
-	m.auth_time = &t
+// Where appends a list predicates to the AuditLogMutation builder.
+func (m *AuditLogMutation) Where(ps ...predicate.AuditLog) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// AuthTime returns the value of the "auth_time" field in the mutation.
-func (m *AuthRefreshTokenMutation) AuthTime() (r time.Time, exists bool) {
-	v := m.auth_time
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the AuditLogMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuditLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditLog, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldAuthTime returns the old "auth_time" field's value of the AuthRefreshToken entity.
-// If the AuthRefreshToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRefreshTokenMutation) OldAuthTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuthTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuthTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuthTime: %w", err)
-	}
-	return oldValue.AuthTime, nil
+// Op returns the operation name.
+func (m *AuditLogMutation) Op() Op {
+	return m.op
 }
 
-// ResetAuthTime resets all changes to the "auth_time" field.
-func (m *AuthRefreshTokenMutation) ResetAuthTime() {
-	m.auth_time = nil
+// SetOp allows setting the mutation operation.
+func (m *AuditLogMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetAmr sets the "amr" field.
-func (m *AuthRefreshTokenMutation) SetAmr(s []string) {
-	m.amr = &s
-	m.appendamr = nil
+// Type returns the node type of this mutation (AuditLog).
+func (m *AuditLogMutation) Type() string {
+	return m.typ
 }
 
-// Amr returns the value of the "amr" field in the mutation.
-func (m *AuthRefreshTokenMutation) Amr() (r []string, exists bool) {
-	v := m.amr
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuditLogMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.created_at != nil {
+		fields = append(fields, auditlog.FieldCreatedAt)
 	}
-	return *v, true
-}
-
-// OldAmr returns the old "amr" field's value of the AuthRefreshToken entity.
-// If the AuthRefreshToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRefreshTokenMutation) OldAmr(ctx context.Context) (v []string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAmr is only allowed on UpdateOne operations")
+	if m.action != nil {
+		fields = append(fields, auditlog.FieldAction)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAmr requires an ID field in the mutation")
+	if m.target_type != nil {
+		fields = append(fields, auditlog.FieldTargetType)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAmr: %w", err)
+	if m.target_id != nil {
+		fields = append(fields, auditlog.FieldTargetID)
 	}
-	return oldValue.Amr, nil
+	if m.before != nil {
+		fields = append(fields, auditlog.FieldBefore)
+	}
+	if m.after != nil {
+		fields = append(fields, auditlog.FieldAfter)
+	}
+	if m.ip != nil {
+		fields = append(fields, auditlog.FieldIP)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, auditlog.FieldUserAgent)
+	}
+	return fields
 }
 
-// AppendAmr adds s to the "amr" field.
-func (m *AuthRefreshTokenMutation) AppendAmr(s []string) {
-	m.appendamr = append(m.appendamr, s...)
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuditLogMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case auditlog.FieldCreatedAt:
+		return m.CreatedAt()
+	case auditlog.FieldAction:
+		return m.Action()
+	case auditlog.FieldTargetType:
+		return m.TargetType()
+	case auditlog.FieldTargetID:
+		return m.TargetID()
+	case auditlog.FieldBefore:
+		return m.Before()
+	case auditlog.FieldAfter:
+		return m.After()
+	case auditlog.FieldIP:
+		return m.IP()
+	case auditlog.FieldUserAgent:
+		return m.UserAgent()
+	}
+	return nil, false
 }
 
-// AppendedAmr returns the list of values that were appended to the "amr" field in this mutation.
-func (m *AuthRefreshTokenMutation) AppendedAmr() ([]string, bool) {
-	if len(m.appendamr) == 0 {
-		return nil, false
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuditLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case auditlog.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case auditlog.FieldAction:
+		return m.OldAction(ctx)
+	case auditlog.FieldTargetType:
+		return m.OldTargetType(ctx)
+	case auditlog.FieldTargetID:
+		return m.OldTargetID(ctx)
+	case auditlog.FieldBefore:
+		return m.OldBefore(ctx)
+	case auditlog.FieldAfter:
+		return m.OldAfter(ctx)
+	case auditlog.FieldIP:
+		return m.OldIP(ctx)
+	case auditlog.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuditLog field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditLogMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case auditlog.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case auditlog.FieldAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case auditlog.FieldTargetType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetType(v)
+		return nil
+	case auditlog.FieldTargetID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetID(v)
+		return nil
+	case auditlog.FieldBefore:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBefore(v)
+		return nil
+	case auditlog.FieldAfter:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAfter(v)
+		return nil
+	case auditlog.FieldIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIP(v)
+		return nil
+	case auditlog.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
+		return nil
 	}
-	return m.appendamr, true
+	return fmt.Errorf("unknown AuditLog field %s", name)
 }
 
-// ResetAmr resets all changes to the "amr" field.
-func (m *AuthRefreshTokenMutation) ResetAmr() {
-	m.amr = nil
-	m.appendamr = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuditLogMutation) AddedFields() []string {
+	return nil
 }
 
-// SetAudience sets the "audience" field.
-func (m *AuthRefreshTokenMutation) SetAudience(s []string) {
-	m.audience = &s
-	m.appendaudience = nil
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuditLogMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// Audience returns the value of the "audience" field in the mutation.
-func (m *AuthRefreshTokenMutation) Audience() (r []string, exists bool) {
-	v := m.audience
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditLogMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown AuditLog numeric field %s", name)
 }
 
-// OldAudience returns the old "audience" field's value of the AuthRefreshToken entity.
-// If the AuthRefreshToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRefreshTokenMutation) OldAudience(ctx context.Context) (v []string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAudience is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuditLogMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(auditlog.FieldBefore) {
+		fields = append(fields, auditlog.FieldBefore)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAudience requires an ID field in the mutation")
+	if m.FieldCleared(auditlog.FieldAfter) {
+		fields = append(fields, auditlog.FieldAfter)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAudience: %w", err)
+	if m.FieldCleared(auditlog.FieldIP) {
+		fields = append(fields, auditlog.FieldIP)
 	}
-	return oldValue.Audience, nil
-}
-
-// AppendAudience adds s to the "audience" field.
-func (m *AuthRefreshTokenMutation) AppendAudience(s []string) {
-	m.appendaudience = append(m.appendaudience, s...)
+	if m.FieldCleared(auditlog.FieldUserAgent) {
+		fields = append(fields, auditlog.FieldUserAgent)
+	}
+	return fields
 }
 
-// AppendedAudience returns the list of values that were appended to the "audience" field in this mutation.
-func (m *AuthRefreshTokenMutation) AppendedAudience() ([]string, bool) {
-	if len(m.appendaudience) == 0 {
-		return nil, false
-	}
-	return m.appendaudience, true
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuditLogMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// ResetAudience resets all changes to the "audience" field.
-func (m *AuthRefreshTokenMutation) ResetAudience() {
-	m.audience = nil
-	m.appendaudience = nil
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuditLogMutation) ClearField(name string) error {
+	switch name {
+	case auditlog.FieldBefore:
+		m.ClearBefore()
+		return nil
+	case auditlog.FieldAfter:
+		m.ClearAfter()
+		return nil
+	case auditlog.FieldIP:
+		m.ClearIP()
+		return nil
+	case auditlog.FieldUserAgent:
+		m.ClearUserAgent()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog nullable field %s", name)
 }
 
-// SetUserID sets the "user_id" field.
-func (m *AuthRefreshTokenMutation) SetUserID(s string) {
-	m.user_id = &s
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuditLogMutation) ResetField(name string) error {
+	switch name {
+	case auditlog.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case auditlog.FieldAction:
+		m.ResetAction()
+		return nil
+	case auditlog.FieldTargetType:
+		m.ResetTargetType()
+		return nil
+	case auditlog.FieldTargetID:
+		m.ResetTargetID()
+		return nil
+	case auditlog.FieldBefore:
+		m.ResetBefore()
+		return nil
+	case auditlog.FieldAfter:
+		m.ResetAfter()
+		return nil
+	case auditlog.FieldIP:
+		m.ResetIP()
+		return nil
+	case auditlog.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog field %s", name)
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *AuthRefreshTokenMutation) UserID() (r string, exists bool) {
-	v := m.user_id
-	if v == nil {
-		return
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuditLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.actor != nil {
+		edges = append(edges, auditlog.EdgeActor)
 	}
-	return *v, true
+	if m.impersonator != nil {
+		edges = append(edges, auditlog.EdgeImpersonator)
+	}
+	return edges
 }
 
-// OldUserID returns the old "user_id" field's value of the AuthRefreshToken entity.
-// If the AuthRefreshToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRefreshTokenMutation) OldUserID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuditLogMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case auditlog.EdgeActor:
+		if id := m.actor; id != nil {
+			return []ent.Value{*id}
+		}
+	case auditlog.EdgeImpersonator:
+		if id := m.impersonator; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuditLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuditLogMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuditLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedactor {
+		edges = append(edges, auditlog.EdgeActor)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	if m.clearedimpersonator {
+		edges = append(edges, auditlog.EdgeImpersonator)
 	}
-	return oldValue.UserID, nil
+	return edges
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *AuthRefreshTokenMutation) ResetUserID() {
-	m.user_id = nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuditLogMutation) EdgeCleared(name string) bool {
+	switch name {
+	case auditlog.EdgeActor:
+		return m.clearedactor
+	case auditlog.EdgeImpersonator:
+		return m.clearedimpersonator
+	}
+	return false
 }
 
-// SetApplicationID sets the "application_id" field.
-func (m *AuthRefreshTokenMutation) SetApplicationID(s string) {
-	m.application_id = &s
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuditLogMutation) ClearEdge(name string) error {
+	switch name {
+	case auditlog.EdgeActor:
+		m.ClearActor()
+		return nil
+	case auditlog.EdgeImpersonator:
+		m.ClearImpersonator()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog unique edge %s", name)
 }
 
-// ApplicationID returns the value of the "application_id" field in the mutation.
-func (m *AuthRefreshTokenMutation) ApplicationID() (r string, exists bool) {
-	v := m.application_id
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuditLogMutation) ResetEdge(name string) error {
+	switch name {
+	case auditlog.EdgeActor:
+		m.ResetActor()
+		return nil
+	case auditlog.EdgeImpersonator:
+		m.ResetImpersonator()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog edge %s", name)
+}
+
+// AuthCodeMutation represents an operation that mutates the AuthCode nodes in the graph.
+type AuthCodeMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *string
+	auth_request_id *string
+	expiration      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*AuthCode, error)
+	predicates      []predicate.AuthCode
+}
+
+var _ ent.Mutation = (*AuthCodeMutation)(nil)
+
+// authcodeOption allows management of the mutation configuration using functional options.
+type authcodeOption func(*AuthCodeMutation)
+
+// newAuthCodeMutation creates new mutation for the AuthCode entity.
+func newAuthCodeMutation(c config, op Op, opts ...authcodeOption) *AuthCodeMutation {
+	m := &AuthCodeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAuthCode,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAuthCodeID sets the ID field of the mutation.
+func withAuthCodeID(id string) authcodeOption {
+	return func(m *AuthCodeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *AuthCode
+		)
+		m.oldValue = func(ctx context.Context) (*AuthCode, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().AuthCode.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAuthCode sets the old AuthCode of the mutation.
+func withAuthCode(node *AuthCode) authcodeOption {
+	return func(m *AuthCodeMutation) {
+		m.oldValue = func(context.Context) (*AuthCode, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AuthCodeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AuthCodeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of AuthCode entities.
+func (m *AuthCodeMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AuthCodeMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AuthCodeMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().AuthCode.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAuthRequestID sets the "auth_request_id" field.
+func (m *AuthCodeMutation) SetAuthRequestID(s string) {
+	m.auth_request_id = &s
+}
+
+// AuthRequestID returns the value of the "auth_request_id" field in the mutation.
+func (m *AuthCodeMutation) AuthRequestID() (r string, exists bool) {
+	v := m.auth_request_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldApplicationID returns the old "application_id" field's value of the AuthRefreshToken entity.
-// If the AuthRefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldAuthRequestID returns the old "auth_request_id" field's value of the AuthCode entity.
+// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRefreshTokenMutation) OldApplicationID(ctx context.Context) (v string, err error) {
+func (m *AuthCodeMutation) OldAuthRequestID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldApplicationID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAuthRequestID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldApplicationID requires an ID field in the mutation")
+		return v, errors.New("OldAuthRequestID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldApplicationID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAuthRequestID: %w", err)
 	}
-	return oldValue.ApplicationID, nil
+	return oldValue.AuthRequestID, nil
 }
 
-// ResetApplicationID resets all changes to the "application_id" field.
-func (m *AuthRefreshTokenMutation) ResetApplicationID() {
-	m.application_id = nil
+// ResetAuthRequestID resets all changes to the "auth_request_id" field.
+func (m *AuthCodeMutation) ResetAuthRequestID() {
+	m.auth_request_id = nil
 }
 
 // SetExpiration sets the "expiration" field.
-func (m *AuthRefreshTokenMutation) SetExpiration(t time.Time) {
+func (m *AuthCodeMutation) SetExpiration(t time.Time) {
 	m.expiration = &t
 }
 
 // Expiration returns the value of the "expiration" field in the mutation.
-func (m *AuthRefreshTokenMutation) Expiration() (r time.Time, exists bool) {
+func (m *AuthCodeMutation) Expiration() (r time.Time, exists bool) {
 	v := m.expiration
 	if v == nil {
 		return
@@ -1881,10 +2377,10 @@ func (m *AuthRefreshTokenMutation) Expiration() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldExpiration returns the old "expiration" field's value of the AuthRefreshToken entity.
-// If the AuthRefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiration returns the old "expiration" field's value of the AuthCode entity.
+// If the AuthCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRefreshTokenMutation) OldExpiration(ctx context.Context) (v time.Time, err error) {
+func (m *AuthCodeMutation) OldExpiration(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldExpiration is only allowed on UpdateOne operations")
 	}
@@ -1899,70 +2395,19 @@ func (m *AuthRefreshTokenMutation) OldExpiration(ctx context.Context) (v time.Ti
 }
 
 // ResetExpiration resets all changes to the "expiration" field.
-func (m *AuthRefreshTokenMutation) ResetExpiration() {
+func (m *AuthCodeMutation) ResetExpiration() {
 	m.expiration = nil
 }
 
-// SetScopes sets the "scopes" field.
-func (m *AuthRefreshTokenMutation) SetScopes(s []string) {
-	m.scopes = &s
-	m.appendscopes = nil
-}
-
-// Scopes returns the value of the "scopes" field in the mutation.
-func (m *AuthRefreshTokenMutation) Scopes() (r []string, exists bool) {
-	v := m.scopes
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldScopes returns the old "scopes" field's value of the AuthRefreshToken entity.
-// If the AuthRefreshToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthRefreshTokenMutation) OldScopes(ctx context.Context) (v []string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScopes requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
-	}
-	return oldValue.Scopes, nil
-}
-
-// AppendScopes adds s to the "scopes" field.
-func (m *AuthRefreshTokenMutation) AppendScopes(s []string) {
-	m.appendscopes = append(m.appendscopes, s...)
-}
-
-// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
-func (m *AuthRefreshTokenMutation) AppendedScopes() ([]string, bool) {
-	if len(m.appendscopes) == 0 {
-		return nil, false
-	}
-	return m.appendscopes, true
-}
-
-// ResetScopes resets all changes to the "scopes" field.
-func (m *AuthRefreshTokenMutation) ResetScopes() {
-	m.scopes = nil
-	m.appendscopes = nil
-}
-
-// Where appends a list predicates to the AuthRefreshTokenMutation builder.
-func (m *AuthRefreshTokenMutation) Where(ps ...predicate.AuthRefreshToken) {
+// Where appends a list predicates to the AuthCodeMutation builder.
+func (m *AuthCodeMutation) Where(ps ...predicate.AuthCode) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the AuthRefreshTokenMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AuthCodeMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *AuthRefreshTokenMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.AuthRefreshToken, len(ps))
+func (m *AuthCodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuthCode, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1970,51 +2415,30 @@ func (m *AuthRefreshTokenMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *AuthRefreshTokenMutation) Op() Op {
+func (m *AuthCodeMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *AuthRefreshTokenMutation) SetOp(op Op) {
+func (m *AuthCodeMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (AuthRefreshToken).
-func (m *AuthRefreshTokenMutation) Type() string {
+// Type returns the node type of this mutation (AuthCode).
+func (m *AuthCodeMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *AuthRefreshTokenMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m._Token != nil {
-		fields = append(fields, authrefreshtoken.FieldToken)
-	}
-	if m.subject != nil {
-		fields = append(fields, authrefreshtoken.FieldSubject)
-	}
-	if m.auth_time != nil {
-		fields = append(fields, authrefreshtoken.FieldAuthTime)
-	}
-	if m.amr != nil {
-		fields = append(fields, authrefreshtoken.FieldAmr)
-	}
-	if m.audience != nil {
-		fields = append(fields, authrefreshtoken.FieldAudience)
-	}
-	if m.user_id != nil {
-		fields = append(fields, authrefreshtoken.FieldUserID)
-	}
-	if m.application_id != nil {
-		fields = append(fields, authrefreshtoken.FieldApplicationID)
+func (m *AuthCodeMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.auth_request_id != nil {
+		fields = append(fields, authcode.FieldAuthRequestID)
 	}
 	if m.expiration != nil {
-		fields = append(fields, authrefreshtoken.FieldExpiration)
-	}
-	if m.scopes != nil {
-		fields = append(fields, authrefreshtoken.FieldScopes)
+		fields = append(fields, authcode.FieldExpiration)
 	}
 	return fields
 }
@@ -2022,26 +2446,12 @@ func (m *AuthRefreshTokenMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *AuthRefreshTokenMutation) Field(name string) (ent.Value, bool) {
+func (m *AuthCodeMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case authrefreshtoken.FieldToken:
-		return m.Token()
-	case authrefreshtoken.FieldSubject:
-		return m.Subject()
-	case authrefreshtoken.FieldAuthTime:
-		return m.AuthTime()
-	case authrefreshtoken.FieldAmr:
-		return m.Amr()
-	case authrefreshtoken.FieldAudience:
-		return m.Audience()
-	case authrefreshtoken.FieldUserID:
-		return m.UserID()
-	case authrefreshtoken.FieldApplicationID:
-		return m.ApplicationID()
-	case authrefreshtoken.FieldExpiration:
+	case authcode.FieldAuthRequestID:
+		return m.AuthRequestID()
+	case authcode.FieldExpiration:
 		return m.Expiration()
-	case authrefreshtoken.FieldScopes:
-		return m.Scopes()
 	}
 	return nil, false
 }
@@ -2049,257 +2459,181 @@ func (m *AuthRefreshTokenMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *AuthRefreshTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AuthCodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case authrefreshtoken.FieldToken:
-		return m.OldToken(ctx)
-	case authrefreshtoken.FieldSubject:
-		return m.OldSubject(ctx)
-	case authrefreshtoken.FieldAuthTime:
-		return m.OldAuthTime(ctx)
-	case authrefreshtoken.FieldAmr:
-		return m.OldAmr(ctx)
-	case authrefreshtoken.FieldAudience:
-		return m.OldAudience(ctx)
-	case authrefreshtoken.FieldUserID:
-		return m.OldUserID(ctx)
-	case authrefreshtoken.FieldApplicationID:
-		return m.OldApplicationID(ctx)
-	case authrefreshtoken.FieldExpiration:
+	case authcode.FieldAuthRequestID:
+		return m.OldAuthRequestID(ctx)
+	case authcode.FieldExpiration:
 		return m.OldExpiration(ctx)
-	case authrefreshtoken.FieldScopes:
-		return m.OldScopes(ctx)
 	}
-	return nil, fmt.Errorf("unknown AuthRefreshToken field %s", name)
+	return nil, fmt.Errorf("unknown AuthCode field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuthRefreshTokenMutation) SetField(name string, value ent.Value) error {
+func (m *AuthCodeMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case authrefreshtoken.FieldToken:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetToken(v)
-		return nil
-	case authrefreshtoken.FieldSubject:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSubject(v)
-		return nil
-	case authrefreshtoken.FieldAuthTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAuthTime(v)
-		return nil
-	case authrefreshtoken.FieldAmr:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAmr(v)
-		return nil
-	case authrefreshtoken.FieldAudience:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAudience(v)
-		return nil
-	case authrefreshtoken.FieldUserID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserID(v)
-		return nil
-	case authrefreshtoken.FieldApplicationID:
+	case authcode.FieldAuthRequestID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetApplicationID(v)
+		m.SetAuthRequestID(v)
 		return nil
-	case authrefreshtoken.FieldExpiration:
+	case authcode.FieldExpiration:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetExpiration(v)
 		return nil
-	case authrefreshtoken.FieldScopes:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetScopes(v)
-		return nil
 	}
-	return fmt.Errorf("unknown AuthRefreshToken field %s", name)
+	return fmt.Errorf("unknown AuthCode field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *AuthRefreshTokenMutation) AddedFields() []string {
+func (m *AuthCodeMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *AuthRefreshTokenMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AuthCodeMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuthRefreshTokenMutation) AddField(name string, value ent.Value) error {
+func (m *AuthCodeMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown AuthRefreshToken numeric field %s", name)
+	return fmt.Errorf("unknown AuthCode numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *AuthRefreshTokenMutation) ClearedFields() []string {
+func (m *AuthCodeMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *AuthRefreshTokenMutation) FieldCleared(name string) bool {
+func (m *AuthCodeMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *AuthRefreshTokenMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown AuthRefreshToken nullable field %s", name)
+func (m *AuthCodeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown AuthCode nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *AuthRefreshTokenMutation) ResetField(name string) error {
+func (m *AuthCodeMutation) ResetField(name string) error {
 	switch name {
-	case authrefreshtoken.FieldToken:
-		m.ResetToken()
-		return nil
-	case authrefreshtoken.FieldSubject:
-		m.ResetSubject()
-		return nil
-	case authrefreshtoken.FieldAuthTime:
-		m.ResetAuthTime()
-		return nil
-	case authrefreshtoken.FieldAmr:
-		m.ResetAmr()
-		return nil
-	case authrefreshtoken.FieldAudience:
-		m.ResetAudience()
-		return nil
-	case authrefreshtoken.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case authrefreshtoken.FieldApplicationID:
-		m.ResetApplicationID()
+	case authcode.FieldAuthRequestID:
+		m.ResetAuthRequestID()
 		return nil
-	case authrefreshtoken.FieldExpiration:
+	case authcode.FieldExpiration:
 		m.ResetExpiration()
 		return nil
-	case authrefreshtoken.FieldScopes:
-		m.ResetScopes()
-		return nil
 	}
-	return fmt.Errorf("unknown AuthRefreshToken field %s", name)
+	return fmt.Errorf("unknown AuthCode field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *AuthRefreshTokenMutation) AddedEdges() []string {
+func (m *AuthCodeMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *AuthRefreshTokenMutation) AddedIDs(name string) []ent.Value {
+func (m *AuthCodeMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *AuthRefreshTokenMutation) RemovedEdges() []string {
+func (m *AuthCodeMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *AuthRefreshTokenMutation) RemovedIDs(name string) []ent.Value {
+func (m *AuthCodeMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *AuthRefreshTokenMutation) ClearedEdges() []string {
+func (m *AuthCodeMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *AuthRefreshTokenMutation) EdgeCleared(name string) bool {
+func (m *AuthCodeMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *AuthRefreshTokenMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown AuthRefreshToken unique edge %s", name)
+func (m *AuthCodeMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuthCode unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *AuthRefreshTokenMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown AuthRefreshToken edge %s", name)
+func (m *AuthCodeMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuthCode edge %s", name)
 }
 
-// AuthTokenMutation represents an operation that mutates the AuthToken nodes in the graph.
-type AuthTokenMutation struct {
+// AuthRefreshTokenMutation represents an operation that mutates the AuthRefreshToken nodes in the graph.
+type AuthRefreshTokenMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *string
-	application_id   *string
-	subject          *string
-	refresh_token_id *string
-	audience         *[]string
-	appendaudience   []string
-	expiration       *time.Time
-	scopes           *[]string
-	appendscopes     []string
-	clearedFields    map[string]struct{}
-	done             bool
-	oldValue         func(context.Context) (*AuthToken, error)
-	predicates       []predicate.AuthToken
+	op                 Op
+	typ                string
+	id                 *string
+	_Token             *string
+	subject            *string
+	auth_time          *time.Time
+	amr                *[]string
+	appendamr          []string
+	audience           *[]string
+	appendaudience     []string
+	user_id            *string
+	application_id     *string
+	expiration         *time.Time
+	scopes             *[]string
+	appendscopes       []string
+	created_at         *time.Time
+	family_id          *string
+	used               *bool
+	session_expiration *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*AuthRefreshToken, error)
+	predicates         []predicate.AuthRefreshToken
 }
 
-var _ ent.Mutation = (*AuthTokenMutation)(nil)
+var _ ent.Mutation = (*AuthRefreshTokenMutation)(nil)
 
-// authtokenOption allows management of the mutation configuration using functional options.
-type authtokenOption func(*AuthTokenMutation)
+// authrefreshtokenOption allows management of the mutation configuration using functional options.
+type authrefreshtokenOption func(*AuthRefreshTokenMutation)
 
-// newAuthTokenMutation creates new mutation for the AuthToken entity.
-func newAuthTokenMutation(c config, op Op, opts ...authtokenOption) *AuthTokenMutation {
-	m := &AuthTokenMutation{
+// newAuthRefreshTokenMutation creates new mutation for the AuthRefreshToken entity.
+func newAuthRefreshTokenMutation(c config, op Op, opts ...authrefreshtokenOption) *AuthRefreshTokenMutation {
+	m := &AuthRefreshTokenMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeAuthToken,
+		typ:           TypeAuthRefreshToken,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2308,20 +2642,20 @@ func newAuthTokenMutation(c config, op Op, opts ...authtokenOption) *AuthTokenMu
 	return m
 }
 
-// withAuthTokenID sets the ID field of the mutation.
-func withAuthTokenID(id string) authtokenOption {
-	return func(m *AuthTokenMutation) {
+// withAuthRefreshTokenID sets the ID field of the mutation.
+func withAuthRefreshTokenID(id string) authrefreshtokenOption {
+	return func(m *AuthRefreshTokenMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *AuthToken
+			value *AuthRefreshToken
 		)
-		m.oldValue = func(ctx context.Context) (*AuthToken, error) {
+		m.oldValue = func(ctx context.Context) (*AuthRefreshToken, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().AuthToken.Get(ctx, id)
+					value, err = m.Client().AuthRefreshToken.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2330,10 +2664,10 @@ func withAuthTokenID(id string) authtokenOption {
 	}
 }
 
-// withAuthToken sets the old AuthToken of the mutation.
-func withAuthToken(node *AuthToken) authtokenOption {
-	return func(m *AuthTokenMutation) {
-		m.oldValue = func(context.Context) (*AuthToken, error) {
+// withAuthRefreshToken sets the old AuthRefreshToken of the mutation.
+func withAuthRefreshToken(node *AuthRefreshToken) authrefreshtokenOption {
+	return func(m *AuthRefreshTokenMutation) {
+		m.oldValue = func(context.Context) (*AuthRefreshToken, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2342,7 +2676,7 @@ func withAuthToken(node *AuthToken) authtokenOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m AuthTokenMutation) Client() *Client {
+func (m AuthRefreshTokenMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2350,7 +2684,7 @@ func (m AuthTokenMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m AuthTokenMutation) Tx() (*Tx, error) {
+func (m AuthRefreshTokenMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2360,14 +2694,14 @@ func (m AuthTokenMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of AuthToken entities.
-func (m *AuthTokenMutation) SetID(id string) {
+// operation is only accepted on creation of AuthRefreshToken entities.
+func (m *AuthRefreshTokenMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *AuthTokenMutation) ID() (id string, exists bool) {
+func (m *AuthRefreshTokenMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2378,7 +2712,7 @@ func (m *AuthTokenMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *AuthTokenMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *AuthRefreshTokenMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2387,55 +2721,55 @@ func (m *AuthTokenMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().AuthToken.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuthRefreshToken.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetApplicationID sets the "application_id" field.
-func (m *AuthTokenMutation) SetApplicationID(s string) {
-	m.application_id = &s
+// SetToken sets the "Token" field.
+func (m *AuthRefreshTokenMutation) SetToken(s string) {
+	m._Token = &s
 }
 
-// ApplicationID returns the value of the "application_id" field in the mutation.
-func (m *AuthTokenMutation) ApplicationID() (r string, exists bool) {
-	v := m.application_id
+// Token returns the value of the "Token" field in the mutation.
+func (m *AuthRefreshTokenMutation) Token() (r string, exists bool) {
+	v := m._Token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldApplicationID returns the old "application_id" field's value of the AuthToken entity.
-// If the AuthToken object wasn't provided to the builder, the object is fetched from the database.
+// OldToken returns the old "Token" field's value of the AuthRefreshToken entity.
+// If the AuthRefreshToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthTokenMutation) OldApplicationID(ctx context.Context) (v string, err error) {
+func (m *AuthRefreshTokenMutation) OldToken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldApplicationID is only allowed on UpdateOne operations")
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldApplicationID requires an ID field in the mutation")
+		return v, errors.New("OldToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldApplicationID: %w", err)
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
 	}
-	return oldValue.ApplicationID, nil
+	return oldValue.Token, nil
 }
 
-// ResetApplicationID resets all changes to the "application_id" field.
-func (m *AuthTokenMutation) ResetApplicationID() {
-	m.application_id = nil
+// ResetToken resets all changes to the "Token" field.
+func (m *AuthRefreshTokenMutation) ResetToken() {
+	m._Token = nil
 }
 
 // SetSubject sets the "subject" field.
-func (m *AuthTokenMutation) SetSubject(s string) {
+func (m *AuthRefreshTokenMutation) SetSubject(s string) {
 	m.subject = &s
 }
 
 // Subject returns the value of the "subject" field in the mutation.
-func (m *AuthTokenMutation) Subject() (r string, exists bool) {
+func (m *AuthRefreshTokenMutation) Subject() (r string, exists bool) {
 	v := m.subject
 	if v == nil {
 		return
@@ -2443,10 +2777,10 @@ func (m *AuthTokenMutation) Subject() (r string, exists bool) {
 	return *v, true
 }
 
-// OldSubject returns the old "subject" field's value of the AuthToken entity.
-// If the AuthToken object wasn't provided to the builder, the object is fetched from the database.
+// OldSubject returns the old "subject" field's value of the AuthRefreshToken entity.
+// If the AuthRefreshToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthTokenMutation) OldSubject(ctx context.Context) (v string, err error) {
+func (m *AuthRefreshTokenMutation) OldSubject(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
 	}
@@ -2461,54 +2795,105 @@ func (m *AuthTokenMutation) OldSubject(ctx context.Context) (v string, err error
 }
 
 // ResetSubject resets all changes to the "subject" field.
-func (m *AuthTokenMutation) ResetSubject() {
+func (m *AuthRefreshTokenMutation) ResetSubject() {
 	m.subject = nil
 }
 
-// SetRefreshTokenID sets the "refresh_token_id" field.
-func (m *AuthTokenMutation) SetRefreshTokenID(s string) {
-	m.refresh_token_id = &s
+// SetAuthTime sets the "auth_time" field.
+func (m *AuthRefreshTokenMutation) SetAuthTime(t time.Time) {
+	m.auth_time = &t
 }
 
-// RefreshTokenID returns the value of the "refresh_token_id" field in the mutation.
-func (m *AuthTokenMutation) RefreshTokenID() (r string, exists bool) {
-	v := m.refresh_token_id
+// AuthTime returns the value of the "auth_time" field in the mutation.
+func (m *AuthRefreshTokenMutation) AuthTime() (r time.Time, exists bool) {
+	v := m.auth_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRefreshTokenID returns the old "refresh_token_id" field's value of the AuthToken entity.
-// If the AuthToken object wasn't provided to the builder, the object is fetched from the database.
+// OldAuthTime returns the old "auth_time" field's value of the AuthRefreshToken entity.
+// If the AuthRefreshToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthTokenMutation) OldRefreshTokenID(ctx context.Context) (v string, err error) {
+func (m *AuthRefreshTokenMutation) OldAuthTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRefreshTokenID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAuthTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRefreshTokenID requires an ID field in the mutation")
+		return v, errors.New("OldAuthTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRefreshTokenID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAuthTime: %w", err)
 	}
-	return oldValue.RefreshTokenID, nil
+	return oldValue.AuthTime, nil
 }
 
-// ResetRefreshTokenID resets all changes to the "refresh_token_id" field.
-func (m *AuthTokenMutation) ResetRefreshTokenID() {
-	m.refresh_token_id = nil
+// ResetAuthTime resets all changes to the "auth_time" field.
+func (m *AuthRefreshTokenMutation) ResetAuthTime() {
+	m.auth_time = nil
+}
+
+// SetAmr sets the "amr" field.
+func (m *AuthRefreshTokenMutation) SetAmr(s []string) {
+	m.amr = &s
+	m.appendamr = nil
+}
+
+// Amr returns the value of the "amr" field in the mutation.
+func (m *AuthRefreshTokenMutation) Amr() (r []string, exists bool) {
+	v := m.amr
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmr returns the old "amr" field's value of the AuthRefreshToken entity.
+// If the AuthRefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRefreshTokenMutation) OldAmr(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmr is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmr requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmr: %w", err)
+	}
+	return oldValue.Amr, nil
+}
+
+// AppendAmr adds s to the "amr" field.
+func (m *AuthRefreshTokenMutation) AppendAmr(s []string) {
+	m.appendamr = append(m.appendamr, s...)
+}
+
+// AppendedAmr returns the list of values that were appended to the "amr" field in this mutation.
+func (m *AuthRefreshTokenMutation) AppendedAmr() ([]string, bool) {
+	if len(m.appendamr) == 0 {
+		return nil, false
+	}
+	return m.appendamr, true
+}
+
+// ResetAmr resets all changes to the "amr" field.
+func (m *AuthRefreshTokenMutation) ResetAmr() {
+	m.amr = nil
+	m.appendamr = nil
 }
 
 // SetAudience sets the "audience" field.
-func (m *AuthTokenMutation) SetAudience(s []string) {
+func (m *AuthRefreshTokenMutation) SetAudience(s []string) {
 	m.audience = &s
 	m.appendaudience = nil
 }
 
 // Audience returns the value of the "audience" field in the mutation.
-func (m *AuthTokenMutation) Audience() (r []string, exists bool) {
+func (m *AuthRefreshTokenMutation) Audience() (r []string, exists bool) {
 	v := m.audience
 	if v == nil {
 		return
@@ -2516,10 +2901,10 @@ func (m *AuthTokenMutation) Audience() (r []string, exists bool) {
 	return *v, true
 }
 
-// OldAudience returns the old "audience" field's value of the AuthToken entity.
-// If the AuthToken object wasn't provided to the builder, the object is fetched from the database.
+// OldAudience returns the old "audience" field's value of the AuthRefreshToken entity.
+// If the AuthRefreshToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthTokenMutation) OldAudience(ctx context.Context) (v []string, err error) {
+func (m *AuthRefreshTokenMutation) OldAudience(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldAudience is only allowed on UpdateOne operations")
 	}
@@ -2534,12 +2919,12 @@ func (m *AuthTokenMutation) OldAudience(ctx context.Context) (v []string, err er
 }
 
 // AppendAudience adds s to the "audience" field.
-func (m *AuthTokenMutation) AppendAudience(s []string) {
+func (m *AuthRefreshTokenMutation) AppendAudience(s []string) {
 	m.appendaudience = append(m.appendaudience, s...)
 }
 
 // AppendedAudience returns the list of values that were appended to the "audience" field in this mutation.
-func (m *AuthTokenMutation) AppendedAudience() ([]string, bool) {
+func (m *AuthRefreshTokenMutation) AppendedAudience() ([]string, bool) {
 	if len(m.appendaudience) == 0 {
 		return nil, false
 	}
@@ -2547,31 +2932,103 @@ func (m *AuthTokenMutation) AppendedAudience() ([]string, bool) {
 }
 
 // ResetAudience resets all changes to the "audience" field.
-func (m *AuthTokenMutation) ResetAudience() {
+func (m *AuthRefreshTokenMutation) ResetAudience() {
 	m.audience = nil
 	m.appendaudience = nil
 }
 
-// SetExpiration sets the "expiration" field.
-func (m *AuthTokenMutation) SetExpiration(t time.Time) {
-	m.expiration = &t
+// SetUserID sets the "user_id" field.
+func (m *AuthRefreshTokenMutation) SetUserID(s string) {
+	m.user_id = &s
 }
 
-// Expiration returns the value of the "expiration" field in the mutation.
-func (m *AuthTokenMutation) Expiration() (r time.Time, exists bool) {
-	v := m.expiration
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *AuthRefreshTokenMutation) UserID() (r string, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiration returns the old "expiration" field's value of the AuthToken entity.
-// If the AuthToken object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the AuthRefreshToken entity.
+// If the AuthRefreshToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthTokenMutation) OldExpiration(ctx context.Context) (v time.Time, err error) {
+func (m *AuthRefreshTokenMutation) OldUserID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiration is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *AuthRefreshTokenMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetApplicationID sets the "application_id" field.
+func (m *AuthRefreshTokenMutation) SetApplicationID(s string) {
+	m.application_id = &s
+}
+
+// ApplicationID returns the value of the "application_id" field in the mutation.
+func (m *AuthRefreshTokenMutation) ApplicationID() (r string, exists bool) {
+	v := m.application_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApplicationID returns the old "application_id" field's value of the AuthRefreshToken entity.
+// If the AuthRefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRefreshTokenMutation) OldApplicationID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApplicationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApplicationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApplicationID: %w", err)
+	}
+	return oldValue.ApplicationID, nil
+}
+
+// ResetApplicationID resets all changes to the "application_id" field.
+func (m *AuthRefreshTokenMutation) ResetApplicationID() {
+	m.application_id = nil
+}
+
+// SetExpiration sets the "expiration" field.
+func (m *AuthRefreshTokenMutation) SetExpiration(t time.Time) {
+	m.expiration = &t
+}
+
+// Expiration returns the value of the "expiration" field in the mutation.
+func (m *AuthRefreshTokenMutation) Expiration() (r time.Time, exists bool) {
+	v := m.expiration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiration returns the old "expiration" field's value of the AuthRefreshToken entity.
+// If the AuthRefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRefreshTokenMutation) OldExpiration(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiration is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
 		return v, errors.New("OldExpiration requires an ID field in the mutation")
@@ -2584,18 +3041,18 @@ func (m *AuthTokenMutation) OldExpiration(ctx context.Context) (v time.Time, err
 }
 
 // ResetExpiration resets all changes to the "expiration" field.
-func (m *AuthTokenMutation) ResetExpiration() {
+func (m *AuthRefreshTokenMutation) ResetExpiration() {
 	m.expiration = nil
 }
 
 // SetScopes sets the "scopes" field.
-func (m *AuthTokenMutation) SetScopes(s []string) {
+func (m *AuthRefreshTokenMutation) SetScopes(s []string) {
 	m.scopes = &s
 	m.appendscopes = nil
 }
 
 // Scopes returns the value of the "scopes" field in the mutation.
-func (m *AuthTokenMutation) Scopes() (r []string, exists bool) {
+func (m *AuthRefreshTokenMutation) Scopes() (r []string, exists bool) {
 	v := m.scopes
 	if v == nil {
 		return
@@ -2603,10 +3060,10 @@ func (m *AuthTokenMutation) Scopes() (r []string, exists bool) {
 	return *v, true
 }
 
-// OldScopes returns the old "scopes" field's value of the AuthToken entity.
-// If the AuthToken object wasn't provided to the builder, the object is fetched from the database.
+// OldScopes returns the old "scopes" field's value of the AuthRefreshToken entity.
+// If the AuthRefreshToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthTokenMutation) OldScopes(ctx context.Context) (v []string, err error) {
+func (m *AuthRefreshTokenMutation) OldScopes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
 	}
@@ -2621,12 +3078,12 @@ func (m *AuthTokenMutation) OldScopes(ctx context.Context) (v []string, err erro
 }
 
 // AppendScopes adds s to the "scopes" field.
-func (m *AuthTokenMutation) AppendScopes(s []string) {
+func (m *AuthRefreshTokenMutation) AppendScopes(s []string) {
 	m.appendscopes = append(m.appendscopes, s...)
 }
 
 // AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
-func (m *AuthTokenMutation) AppendedScopes() ([]string, bool) {
+func (m *AuthRefreshTokenMutation) AppendedScopes() ([]string, bool) {
 	if len(m.appendscopes) == 0 {
 		return nil, false
 	}
@@ -2634,20 +3091,164 @@ func (m *AuthTokenMutation) AppendedScopes() ([]string, bool) {
 }
 
 // ResetScopes resets all changes to the "scopes" field.
-func (m *AuthTokenMutation) ResetScopes() {
+func (m *AuthRefreshTokenMutation) ResetScopes() {
 	m.scopes = nil
 	m.appendscopes = nil
 }
 
-// Where appends a list predicates to the AuthTokenMutation builder.
-func (m *AuthTokenMutation) Where(ps ...predicate.AuthToken) {
+// SetCreatedAt sets the "created_at" field.
+func (m *AuthRefreshTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuthRefreshTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the AuthRefreshToken entity.
+// If the AuthRefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRefreshTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuthRefreshTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetFamilyID sets the "family_id" field.
+func (m *AuthRefreshTokenMutation) SetFamilyID(s string) {
+	m.family_id = &s
+}
+
+// FamilyID returns the value of the "family_id" field in the mutation.
+func (m *AuthRefreshTokenMutation) FamilyID() (r string, exists bool) {
+	v := m.family_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFamilyID returns the old "family_id" field's value of the AuthRefreshToken entity.
+// If the AuthRefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRefreshTokenMutation) OldFamilyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFamilyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFamilyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFamilyID: %w", err)
+	}
+	return oldValue.FamilyID, nil
+}
+
+// ResetFamilyID resets all changes to the "family_id" field.
+func (m *AuthRefreshTokenMutation) ResetFamilyID() {
+	m.family_id = nil
+}
+
+// SetUsed sets the "used" field.
+func (m *AuthRefreshTokenMutation) SetUsed(b bool) {
+	m.used = &b
+}
+
+// Used returns the value of the "used" field in the mutation.
+func (m *AuthRefreshTokenMutation) Used() (r bool, exists bool) {
+	v := m.used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsed returns the old "used" field's value of the AuthRefreshToken entity.
+// If the AuthRefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRefreshTokenMutation) OldUsed(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsed: %w", err)
+	}
+	return oldValue.Used, nil
+}
+
+// ResetUsed resets all changes to the "used" field.
+func (m *AuthRefreshTokenMutation) ResetUsed() {
+	m.used = nil
+}
+
+// SetSessionExpiration sets the "session_expiration" field.
+func (m *AuthRefreshTokenMutation) SetSessionExpiration(t time.Time) {
+	m.session_expiration = &t
+}
+
+// SessionExpiration returns the value of the "session_expiration" field in the mutation.
+func (m *AuthRefreshTokenMutation) SessionExpiration() (r time.Time, exists bool) {
+	v := m.session_expiration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSessionExpiration returns the old "session_expiration" field's value of the AuthRefreshToken entity.
+// If the AuthRefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRefreshTokenMutation) OldSessionExpiration(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionExpiration is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionExpiration requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionExpiration: %w", err)
+	}
+	return oldValue.SessionExpiration, nil
+}
+
+// ResetSessionExpiration resets all changes to the "session_expiration" field.
+func (m *AuthRefreshTokenMutation) ResetSessionExpiration() {
+	m.session_expiration = nil
+}
+
+// Where appends a list predicates to the AuthRefreshTokenMutation builder.
+func (m *AuthRefreshTokenMutation) Where(ps ...predicate.AuthRefreshToken) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the AuthTokenMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AuthRefreshTokenMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *AuthTokenMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.AuthToken, len(ps))
+func (m *AuthRefreshTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuthRefreshToken, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2655,42 +3256,63 @@ func (m *AuthTokenMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *AuthTokenMutation) Op() Op {
+func (m *AuthRefreshTokenMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *AuthTokenMutation) SetOp(op Op) {
+func (m *AuthRefreshTokenMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (AuthToken).
-func (m *AuthTokenMutation) Type() string {
+// Type returns the node type of this mutation (AuthRefreshToken).
+func (m *AuthRefreshTokenMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *AuthTokenMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.application_id != nil {
-		fields = append(fields, authtoken.FieldApplicationID)
+func (m *AuthRefreshTokenMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m._Token != nil {
+		fields = append(fields, authrefreshtoken.FieldToken)
 	}
 	if m.subject != nil {
-		fields = append(fields, authtoken.FieldSubject)
+		fields = append(fields, authrefreshtoken.FieldSubject)
 	}
-	if m.refresh_token_id != nil {
-		fields = append(fields, authtoken.FieldRefreshTokenID)
+	if m.auth_time != nil {
+		fields = append(fields, authrefreshtoken.FieldAuthTime)
+	}
+	if m.amr != nil {
+		fields = append(fields, authrefreshtoken.FieldAmr)
 	}
 	if m.audience != nil {
-		fields = append(fields, authtoken.FieldAudience)
+		fields = append(fields, authrefreshtoken.FieldAudience)
+	}
+	if m.user_id != nil {
+		fields = append(fields, authrefreshtoken.FieldUserID)
+	}
+	if m.application_id != nil {
+		fields = append(fields, authrefreshtoken.FieldApplicationID)
 	}
 	if m.expiration != nil {
-		fields = append(fields, authtoken.FieldExpiration)
+		fields = append(fields, authrefreshtoken.FieldExpiration)
 	}
 	if m.scopes != nil {
-		fields = append(fields, authtoken.FieldScopes)
+		fields = append(fields, authrefreshtoken.FieldScopes)
+	}
+	if m.created_at != nil {
+		fields = append(fields, authrefreshtoken.FieldCreatedAt)
+	}
+	if m.family_id != nil {
+		fields = append(fields, authrefreshtoken.FieldFamilyID)
+	}
+	if m.used != nil {
+		fields = append(fields, authrefreshtoken.FieldUsed)
+	}
+	if m.session_expiration != nil {
+		fields = append(fields, authrefreshtoken.FieldSessionExpiration)
 	}
 	return fields
 }
@@ -2698,243 +3320,354 @@ func (m *AuthTokenMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *AuthTokenMutation) Field(name string) (ent.Value, bool) {
+func (m *AuthRefreshTokenMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case authtoken.FieldApplicationID:
-		return m.ApplicationID()
-	case authtoken.FieldSubject:
+	case authrefreshtoken.FieldToken:
+		return m.Token()
+	case authrefreshtoken.FieldSubject:
 		return m.Subject()
-	case authtoken.FieldRefreshTokenID:
-		return m.RefreshTokenID()
-	case authtoken.FieldAudience:
+	case authrefreshtoken.FieldAuthTime:
+		return m.AuthTime()
+	case authrefreshtoken.FieldAmr:
+		return m.Amr()
+	case authrefreshtoken.FieldAudience:
 		return m.Audience()
-	case authtoken.FieldExpiration:
-		return m.Expiration()
-	case authtoken.FieldScopes:
-		return m.Scopes()
-	}
+	case authrefreshtoken.FieldUserID:
+		return m.UserID()
+	case authrefreshtoken.FieldApplicationID:
+		return m.ApplicationID()
+	case authrefreshtoken.FieldExpiration:
+		return m.Expiration()
+	case authrefreshtoken.FieldScopes:
+		return m.Scopes()
+	case authrefreshtoken.FieldCreatedAt:
+		return m.CreatedAt()
+	case authrefreshtoken.FieldFamilyID:
+		return m.FamilyID()
+	case authrefreshtoken.FieldUsed:
+		return m.Used()
+	case authrefreshtoken.FieldSessionExpiration:
+		return m.SessionExpiration()
+	}
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *AuthTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AuthRefreshTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case authtoken.FieldApplicationID:
-		return m.OldApplicationID(ctx)
-	case authtoken.FieldSubject:
+	case authrefreshtoken.FieldToken:
+		return m.OldToken(ctx)
+	case authrefreshtoken.FieldSubject:
 		return m.OldSubject(ctx)
-	case authtoken.FieldRefreshTokenID:
-		return m.OldRefreshTokenID(ctx)
-	case authtoken.FieldAudience:
+	case authrefreshtoken.FieldAuthTime:
+		return m.OldAuthTime(ctx)
+	case authrefreshtoken.FieldAmr:
+		return m.OldAmr(ctx)
+	case authrefreshtoken.FieldAudience:
 		return m.OldAudience(ctx)
-	case authtoken.FieldExpiration:
+	case authrefreshtoken.FieldUserID:
+		return m.OldUserID(ctx)
+	case authrefreshtoken.FieldApplicationID:
+		return m.OldApplicationID(ctx)
+	case authrefreshtoken.FieldExpiration:
 		return m.OldExpiration(ctx)
-	case authtoken.FieldScopes:
+	case authrefreshtoken.FieldScopes:
 		return m.OldScopes(ctx)
+	case authrefreshtoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case authrefreshtoken.FieldFamilyID:
+		return m.OldFamilyID(ctx)
+	case authrefreshtoken.FieldUsed:
+		return m.OldUsed(ctx)
+	case authrefreshtoken.FieldSessionExpiration:
+		return m.OldSessionExpiration(ctx)
 	}
-	return nil, fmt.Errorf("unknown AuthToken field %s", name)
+	return nil, fmt.Errorf("unknown AuthRefreshToken field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuthTokenMutation) SetField(name string, value ent.Value) error {
+func (m *AuthRefreshTokenMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case authtoken.FieldApplicationID:
+	case authrefreshtoken.FieldToken:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetApplicationID(v)
+		m.SetToken(v)
 		return nil
-	case authtoken.FieldSubject:
+	case authrefreshtoken.FieldSubject:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetSubject(v)
 		return nil
-	case authtoken.FieldRefreshTokenID:
-		v, ok := value.(string)
+	case authrefreshtoken.FieldAuthTime:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRefreshTokenID(v)
+		m.SetAuthTime(v)
 		return nil
-	case authtoken.FieldAudience:
+	case authrefreshtoken.FieldAmr:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmr(v)
+		return nil
+	case authrefreshtoken.FieldAudience:
 		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetAudience(v)
 		return nil
-	case authtoken.FieldExpiration:
+	case authrefreshtoken.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case authrefreshtoken.FieldApplicationID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApplicationID(v)
+		return nil
+	case authrefreshtoken.FieldExpiration:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetExpiration(v)
 		return nil
-	case authtoken.FieldScopes:
+	case authrefreshtoken.FieldScopes:
 		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetScopes(v)
 		return nil
+	case authrefreshtoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case authrefreshtoken.FieldFamilyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFamilyID(v)
+		return nil
+	case authrefreshtoken.FieldUsed:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsed(v)
+		return nil
+	case authrefreshtoken.FieldSessionExpiration:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionExpiration(v)
+		return nil
 	}
-	return fmt.Errorf("unknown AuthToken field %s", name)
+	return fmt.Errorf("unknown AuthRefreshToken field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *AuthTokenMutation) AddedFields() []string {
+func (m *AuthRefreshTokenMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *AuthTokenMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AuthRefreshTokenMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuthTokenMutation) AddField(name string, value ent.Value) error {
+func (m *AuthRefreshTokenMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown AuthToken numeric field %s", name)
+	return fmt.Errorf("unknown AuthRefreshToken numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *AuthTokenMutation) ClearedFields() []string {
+func (m *AuthRefreshTokenMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *AuthTokenMutation) FieldCleared(name string) bool {
+func (m *AuthRefreshTokenMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *AuthTokenMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown AuthToken nullable field %s", name)
+func (m *AuthRefreshTokenMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown AuthRefreshToken nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *AuthTokenMutation) ResetField(name string) error {
+func (m *AuthRefreshTokenMutation) ResetField(name string) error {
 	switch name {
-	case authtoken.FieldApplicationID:
-		m.ResetApplicationID()
+	case authrefreshtoken.FieldToken:
+		m.ResetToken()
 		return nil
-	case authtoken.FieldSubject:
+	case authrefreshtoken.FieldSubject:
 		m.ResetSubject()
 		return nil
-	case authtoken.FieldRefreshTokenID:
-		m.ResetRefreshTokenID()
+	case authrefreshtoken.FieldAuthTime:
+		m.ResetAuthTime()
 		return nil
-	case authtoken.FieldAudience:
+	case authrefreshtoken.FieldAmr:
+		m.ResetAmr()
+		return nil
+	case authrefreshtoken.FieldAudience:
 		m.ResetAudience()
 		return nil
-	case authtoken.FieldExpiration:
+	case authrefreshtoken.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case authrefreshtoken.FieldApplicationID:
+		m.ResetApplicationID()
+		return nil
+	case authrefreshtoken.FieldExpiration:
 		m.ResetExpiration()
 		return nil
-	case authtoken.FieldScopes:
+	case authrefreshtoken.FieldScopes:
 		m.ResetScopes()
 		return nil
+	case authrefreshtoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case authrefreshtoken.FieldFamilyID:
+		m.ResetFamilyID()
+		return nil
+	case authrefreshtoken.FieldUsed:
+		m.ResetUsed()
+		return nil
+	case authrefreshtoken.FieldSessionExpiration:
+		m.ResetSessionExpiration()
+		return nil
 	}
-	return fmt.Errorf("unknown AuthToken field %s", name)
+	return fmt.Errorf("unknown AuthRefreshToken field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *AuthTokenMutation) AddedEdges() []string {
+func (m *AuthRefreshTokenMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *AuthTokenMutation) AddedIDs(name string) []ent.Value {
+func (m *AuthRefreshTokenMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *AuthTokenMutation) RemovedEdges() []string {
+func (m *AuthRefreshTokenMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *AuthTokenMutation) RemovedIDs(name string) []ent.Value {
+func (m *AuthRefreshTokenMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *AuthTokenMutation) ClearedEdges() []string {
+func (m *AuthRefreshTokenMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *AuthTokenMutation) EdgeCleared(name string) bool {
+func (m *AuthRefreshTokenMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *AuthTokenMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown AuthToken unique edge %s", name)
+func (m *AuthRefreshTokenMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuthRefreshToken unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *AuthTokenMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown AuthToken edge %s", name)
+func (m *AuthRefreshTokenMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuthRefreshToken edge %s", name)
 }
 
-// ComponentMutation represents an operation that mutates the Component nodes in the graph.
-type ComponentMutation struct {
+// AuthRequestMutation represents an operation that mutates the AuthRequest nodes in the graph.
+type AuthRequestMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int
-	name              *string
-	description       *string
-	image_url         *string
-	color             *string
-	clearedFields     map[string]struct{}
-	vote              *int
-	clearedvote       bool
-	user_votes        map[int]struct{}
-	removeduser_votes map[int]struct{}
-	cleareduser_votes bool
-	done              bool
-	oldValue          func(context.Context) (*Component, error)
-	predicates        []predicate.Component
+	op                    Op
+	typ                   string
+	id                    *string
+	application_id        *string
+	redirect_uri          *string
+	state                 *string
+	nonce                 *string
+	scopes                *[]string
+	appendscopes          []string
+	prompt                *[]string
+	appendprompt          []string
+	response_type         *string
+	response_mode         *string
+	code_challenge        *string
+	code_challenge_method *string
+	login_hint            *string
+	max_auth_age          *int64
+	addmax_auth_age       *int64
+	user_id               *string
+	_done                 *bool
+	auth_time             *time.Time
+	amr                   *[]string
+	appendamr             []string
+	created_at            *time.Time
+	expiration            *time.Time
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*AuthRequest, error)
+	predicates            []predicate.AuthRequest
 }
 
-var _ ent.Mutation = (*ComponentMutation)(nil)
+var _ ent.Mutation = (*AuthRequestMutation)(nil)
 
-// componentOption allows management of the mutation configuration using functional options.
-type componentOption func(*ComponentMutation)
+// authrequestOption allows management of the mutation configuration using functional options.
+type authrequestOption func(*AuthRequestMutation)
 
-// newComponentMutation creates new mutation for the Component entity.
-func newComponentMutation(c config, op Op, opts ...componentOption) *ComponentMutation {
-	m := &ComponentMutation{
+// newAuthRequestMutation creates new mutation for the AuthRequest entity.
+func newAuthRequestMutation(c config, op Op, opts ...authrequestOption) *AuthRequestMutation {
+	m := &AuthRequestMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeComponent,
+		typ:           TypeAuthRequest,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2943,20 +3676,20 @@ func newComponentMutation(c config, op Op, opts ...componentOption) *ComponentMu
 	return m
 }
 
-// withComponentID sets the ID field of the mutation.
-func withComponentID(id int) componentOption {
-	return func(m *ComponentMutation) {
+// withAuthRequestID sets the ID field of the mutation.
+func withAuthRequestID(id string) authrequestOption {
+	return func(m *AuthRequestMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Component
+			value *AuthRequest
 		)
-		m.oldValue = func(ctx context.Context) (*Component, error) {
+		m.oldValue = func(ctx context.Context) (*AuthRequest, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Component.Get(ctx, id)
+					value, err = m.Client().AuthRequest.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2965,10 +3698,10 @@ func withComponentID(id int) componentOption {
 	}
 }
 
-// withComponent sets the old Component of the mutation.
-func withComponent(node *Component) componentOption {
-	return func(m *ComponentMutation) {
-		m.oldValue = func(context.Context) (*Component, error) {
+// withAuthRequest sets the old AuthRequest of the mutation.
+func withAuthRequest(node *AuthRequest) authrequestOption {
+	return func(m *AuthRequestMutation) {
+		m.oldValue = func(context.Context) (*AuthRequest, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2977,7 +3710,7 @@ func withComponent(node *Component) componentOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ComponentMutation) Client() *Client {
+func (m AuthRequestMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2985,7 +3718,7 @@ func (m ComponentMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ComponentMutation) Tx() (*Tx, error) {
+func (m AuthRequestMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2994,9 +3727,15 @@ func (m ComponentMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of AuthRequest entities.
+func (m *AuthRequestMutation) SetID(id string) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ComponentMutation) ID() (id int, exists bool) {
+func (m *AuthRequestMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3007,1021 +3746,889 @@ func (m *ComponentMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ComponentMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AuthRequestMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []string{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Component.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuthRequest.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *ComponentMutation) SetName(s string) {
-	m.name = &s
+// SetApplicationID sets the "application_id" field.
+func (m *AuthRequestMutation) SetApplicationID(s string) {
+	m.application_id = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ComponentMutation) Name() (r string, exists bool) {
-	v := m.name
+// ApplicationID returns the value of the "application_id" field in the mutation.
+func (m *AuthRequestMutation) ApplicationID() (r string, exists bool) {
+	v := m.application_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Component entity.
-// If the Component object wasn't provided to the builder, the object is fetched from the database.
+// OldApplicationID returns the old "application_id" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ComponentMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *AuthRequestMutation) OldApplicationID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldApplicationID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldApplicationID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldApplicationID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.ApplicationID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ComponentMutation) ResetName() {
-	m.name = nil
+// ResetApplicationID resets all changes to the "application_id" field.
+func (m *AuthRequestMutation) ResetApplicationID() {
+	m.application_id = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *ComponentMutation) SetDescription(s string) {
-	m.description = &s
+// SetRedirectURI sets the "redirect_uri" field.
+func (m *AuthRequestMutation) SetRedirectURI(s string) {
+	m.redirect_uri = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ComponentMutation) Description() (r string, exists bool) {
-	v := m.description
+// RedirectURI returns the value of the "redirect_uri" field in the mutation.
+func (m *AuthRequestMutation) RedirectURI() (r string, exists bool) {
+	v := m.redirect_uri
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Component entity.
-// If the Component object wasn't provided to the builder, the object is fetched from the database.
+// OldRedirectURI returns the old "redirect_uri" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ComponentMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *AuthRequestMutation) OldRedirectURI(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldRedirectURI is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldRedirectURI requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldRedirectURI: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.RedirectURI, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *ComponentMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[component.FieldDescription] = struct{}{}
+// ResetRedirectURI resets all changes to the "redirect_uri" field.
+func (m *AuthRequestMutation) ResetRedirectURI() {
+	m.redirect_uri = nil
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *ComponentMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[component.FieldDescription]
+// SetState sets the "state" field.
+func (m *AuthRequestMutation) SetState(s string) {
+	m.state = &s
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *AuthRequestMutation) State() (r string, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldState(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ClearState clears the value of the "state" field.
+func (m *AuthRequestMutation) ClearState() {
+	m.state = nil
+	m.clearedFields[authrequest.FieldState] = struct{}{}
+}
+
+// StateCleared returns if the "state" field was cleared in this mutation.
+func (m *AuthRequestMutation) StateCleared() bool {
+	_, ok := m.clearedFields[authrequest.FieldState]
 	return ok
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *ComponentMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, component.FieldDescription)
+// ResetState resets all changes to the "state" field.
+func (m *AuthRequestMutation) ResetState() {
+	m.state = nil
+	delete(m.clearedFields, authrequest.FieldState)
 }
 
-// SetImageURL sets the "image_url" field.
-func (m *ComponentMutation) SetImageURL(s string) {
-	m.image_url = &s
+// SetNonce sets the "nonce" field.
+func (m *AuthRequestMutation) SetNonce(s string) {
+	m.nonce = &s
 }
 
-// ImageURL returns the value of the "image_url" field in the mutation.
-func (m *ComponentMutation) ImageURL() (r string, exists bool) {
-	v := m.image_url
+// Nonce returns the value of the "nonce" field in the mutation.
+func (m *AuthRequestMutation) Nonce() (r string, exists bool) {
+	v := m.nonce
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldImageURL returns the old "image_url" field's value of the Component entity.
-// If the Component object wasn't provided to the builder, the object is fetched from the database.
+// OldNonce returns the old "nonce" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ComponentMutation) OldImageURL(ctx context.Context) (v *string, err error) {
+func (m *AuthRequestMutation) OldNonce(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImageURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldNonce is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImageURL requires an ID field in the mutation")
+		return v, errors.New("OldNonce requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImageURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldNonce: %w", err)
 	}
-	return oldValue.ImageURL, nil
+	return oldValue.Nonce, nil
 }
 
-// ClearImageURL clears the value of the "image_url" field.
-func (m *ComponentMutation) ClearImageURL() {
-	m.image_url = nil
-	m.clearedFields[component.FieldImageURL] = struct{}{}
+// ClearNonce clears the value of the "nonce" field.
+func (m *AuthRequestMutation) ClearNonce() {
+	m.nonce = nil
+	m.clearedFields[authrequest.FieldNonce] = struct{}{}
 }
 
-// ImageURLCleared returns if the "image_url" field was cleared in this mutation.
-func (m *ComponentMutation) ImageURLCleared() bool {
-	_, ok := m.clearedFields[component.FieldImageURL]
+// NonceCleared returns if the "nonce" field was cleared in this mutation.
+func (m *AuthRequestMutation) NonceCleared() bool {
+	_, ok := m.clearedFields[authrequest.FieldNonce]
 	return ok
 }
 
-// ResetImageURL resets all changes to the "image_url" field.
-func (m *ComponentMutation) ResetImageURL() {
-	m.image_url = nil
-	delete(m.clearedFields, component.FieldImageURL)
+// ResetNonce resets all changes to the "nonce" field.
+func (m *AuthRequestMutation) ResetNonce() {
+	m.nonce = nil
+	delete(m.clearedFields, authrequest.FieldNonce)
 }
 
-// SetColor sets the "color" field.
-func (m *ComponentMutation) SetColor(s string) {
-	m.color = &s
+// SetScopes sets the "scopes" field.
+func (m *AuthRequestMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
 }
 
-// Color returns the value of the "color" field in the mutation.
-func (m *ComponentMutation) Color() (r string, exists bool) {
-	v := m.color
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *AuthRequestMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldColor returns the old "color" field's value of the Component entity.
-// If the Component object wasn't provided to the builder, the object is fetched from the database.
+// OldScopes returns the old "scopes" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ComponentMutation) OldColor(ctx context.Context) (v string, err error) {
+func (m *AuthRequestMutation) OldScopes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldColor is only allowed on UpdateOne operations")
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldColor requires an ID field in the mutation")
+		return v, errors.New("OldScopes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldColor: %w", err)
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
 	}
-	return oldValue.Color, nil
+	return oldValue.Scopes, nil
 }
 
-// ClearColor clears the value of the "color" field.
-func (m *ComponentMutation) ClearColor() {
-	m.color = nil
-	m.clearedFields[component.FieldColor] = struct{}{}
+// AppendScopes adds s to the "scopes" field.
+func (m *AuthRequestMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
 }
 
-// ColorCleared returns if the "color" field was cleared in this mutation.
-func (m *ComponentMutation) ColorCleared() bool {
-	_, ok := m.clearedFields[component.FieldColor]
-	return ok
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *AuthRequestMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
 }
 
-// ResetColor resets all changes to the "color" field.
-func (m *ComponentMutation) ResetColor() {
-	m.color = nil
-	delete(m.clearedFields, component.FieldColor)
+// ResetScopes resets all changes to the "scopes" field.
+func (m *AuthRequestMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
 }
 
-// SetVoteID sets the "vote" edge to the Vote entity by id.
-func (m *ComponentMutation) SetVoteID(id int) {
-	m.vote = &id
+// SetPrompt sets the "prompt" field.
+func (m *AuthRequestMutation) SetPrompt(s []string) {
+	m.prompt = &s
+	m.appendprompt = nil
 }
 
-// ClearVote clears the "vote" edge to the Vote entity.
-func (m *ComponentMutation) ClearVote() {
-	m.clearedvote = true
+// Prompt returns the value of the "prompt" field in the mutation.
+func (m *AuthRequestMutation) Prompt() (r []string, exists bool) {
+	v := m.prompt
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// VoteCleared reports if the "vote" edge to the Vote entity was cleared.
-func (m *ComponentMutation) VoteCleared() bool {
-	return m.clearedvote
+// OldPrompt returns the old "prompt" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldPrompt(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrompt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrompt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrompt: %w", err)
+	}
+	return oldValue.Prompt, nil
 }
 
-// VoteID returns the "vote" edge ID in the mutation.
-func (m *ComponentMutation) VoteID() (id int, exists bool) {
-	if m.vote != nil {
-		return *m.vote, true
-	}
-	return
+// AppendPrompt adds s to the "prompt" field.
+func (m *AuthRequestMutation) AppendPrompt(s []string) {
+	m.appendprompt = append(m.appendprompt, s...)
 }
 
-// VoteIDs returns the "vote" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// VoteID instead. It exists only for internal usage by the builders.
-func (m *ComponentMutation) VoteIDs() (ids []int) {
-	if id := m.vote; id != nil {
-		ids = append(ids, *id)
+// AppendedPrompt returns the list of values that were appended to the "prompt" field in this mutation.
+func (m *AuthRequestMutation) AppendedPrompt() ([]string, bool) {
+	if len(m.appendprompt) == 0 {
+		return nil, false
 	}
-	return
+	return m.appendprompt, true
 }
 
-// ResetVote resets all changes to the "vote" edge.
-func (m *ComponentMutation) ResetVote() {
-	m.vote = nil
-	m.clearedvote = false
+// ClearPrompt clears the value of the "prompt" field.
+func (m *AuthRequestMutation) ClearPrompt() {
+	m.prompt = nil
+	m.appendprompt = nil
+	m.clearedFields[authrequest.FieldPrompt] = struct{}{}
 }
 
-// AddUserVoteIDs adds the "user_votes" edge to the UserVote entity by ids.
-func (m *ComponentMutation) AddUserVoteIDs(ids ...int) {
-	if m.user_votes == nil {
-		m.user_votes = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.user_votes[ids[i]] = struct{}{}
-	}
+// PromptCleared returns if the "prompt" field was cleared in this mutation.
+func (m *AuthRequestMutation) PromptCleared() bool {
+	_, ok := m.clearedFields[authrequest.FieldPrompt]
+	return ok
 }
 
-// ClearUserVotes clears the "user_votes" edge to the UserVote entity.
-func (m *ComponentMutation) ClearUserVotes() {
-	m.cleareduser_votes = true
+// ResetPrompt resets all changes to the "prompt" field.
+func (m *AuthRequestMutation) ResetPrompt() {
+	m.prompt = nil
+	m.appendprompt = nil
+	delete(m.clearedFields, authrequest.FieldPrompt)
 }
 
-// UserVotesCleared reports if the "user_votes" edge to the UserVote entity was cleared.
-func (m *ComponentMutation) UserVotesCleared() bool {
-	return m.cleareduser_votes
+// SetResponseType sets the "response_type" field.
+func (m *AuthRequestMutation) SetResponseType(s string) {
+	m.response_type = &s
 }
 
-// RemoveUserVoteIDs removes the "user_votes" edge to the UserVote entity by IDs.
-func (m *ComponentMutation) RemoveUserVoteIDs(ids ...int) {
-	if m.removeduser_votes == nil {
-		m.removeduser_votes = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.user_votes, ids[i])
-		m.removeduser_votes[ids[i]] = struct{}{}
+// ResponseType returns the value of the "response_type" field in the mutation.
+func (m *AuthRequestMutation) ResponseType() (r string, exists bool) {
+	v := m.response_type
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedUserVotes returns the removed IDs of the "user_votes" edge to the UserVote entity.
-func (m *ComponentMutation) RemovedUserVotesIDs() (ids []int) {
-	for id := range m.removeduser_votes {
-		ids = append(ids, id)
+// OldResponseType returns the old "response_type" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldResponseType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponseType is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponseType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponseType: %w", err)
+	}
+	return oldValue.ResponseType, nil
 }
 
-// UserVotesIDs returns the "user_votes" edge IDs in the mutation.
-func (m *ComponentMutation) UserVotesIDs() (ids []int) {
-	for id := range m.user_votes {
-		ids = append(ids, id)
-	}
-	return
+// ResetResponseType resets all changes to the "response_type" field.
+func (m *AuthRequestMutation) ResetResponseType() {
+	m.response_type = nil
 }
 
-// ResetUserVotes resets all changes to the "user_votes" edge.
-func (m *ComponentMutation) ResetUserVotes() {
-	m.user_votes = nil
-	m.cleareduser_votes = false
-	m.removeduser_votes = nil
+// SetResponseMode sets the "response_mode" field.
+func (m *AuthRequestMutation) SetResponseMode(s string) {
+	m.response_mode = &s
 }
 
-// Where appends a list predicates to the ComponentMutation builder.
-func (m *ComponentMutation) Where(ps ...predicate.Component) {
-	m.predicates = append(m.predicates, ps...)
+// ResponseMode returns the value of the "response_mode" field in the mutation.
+func (m *AuthRequestMutation) ResponseMode() (r string, exists bool) {
+	v := m.response_mode
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// WhereP appends storage-level predicates to the ComponentMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ComponentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Component, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// OldResponseMode returns the old "response_mode" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldResponseMode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponseMode is only allowed on UpdateOne operations")
 	}
-	m.Where(p...)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponseMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponseMode: %w", err)
+	}
+	return oldValue.ResponseMode, nil
 }
 
-// Op returns the operation name.
-func (m *ComponentMutation) Op() Op {
-	return m.op
+// ClearResponseMode clears the value of the "response_mode" field.
+func (m *AuthRequestMutation) ClearResponseMode() {
+	m.response_mode = nil
+	m.clearedFields[authrequest.FieldResponseMode] = struct{}{}
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ComponentMutation) SetOp(op Op) {
-	m.op = op
+// ResponseModeCleared returns if the "response_mode" field was cleared in this mutation.
+func (m *AuthRequestMutation) ResponseModeCleared() bool {
+	_, ok := m.clearedFields[authrequest.FieldResponseMode]
+	return ok
 }
 
-// Type returns the node type of this mutation (Component).
-func (m *ComponentMutation) Type() string {
-	return m.typ
+// ResetResponseMode resets all changes to the "response_mode" field.
+func (m *AuthRequestMutation) ResetResponseMode() {
+	m.response_mode = nil
+	delete(m.clearedFields, authrequest.FieldResponseMode)
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ComponentMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.name != nil {
-		fields = append(fields, component.FieldName)
+// SetCodeChallenge sets the "code_challenge" field.
+func (m *AuthRequestMutation) SetCodeChallenge(s string) {
+	m.code_challenge = &s
+}
+
+// CodeChallenge returns the value of the "code_challenge" field in the mutation.
+func (m *AuthRequestMutation) CodeChallenge() (r string, exists bool) {
+	v := m.code_challenge
+	if v == nil {
+		return
 	}
-	if m.description != nil {
-		fields = append(fields, component.FieldDescription)
+	return *v, true
+}
+
+// OldCodeChallenge returns the old "code_challenge" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldCodeChallenge(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCodeChallenge is only allowed on UpdateOne operations")
 	}
-	if m.image_url != nil {
-		fields = append(fields, component.FieldImageURL)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCodeChallenge requires an ID field in the mutation")
 	}
-	if m.color != nil {
-		fields = append(fields, component.FieldColor)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCodeChallenge: %w", err)
 	}
-	return fields
+	return oldValue.CodeChallenge, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ComponentMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case component.FieldName:
-		return m.Name()
-	case component.FieldDescription:
-		return m.Description()
-	case component.FieldImageURL:
-		return m.ImageURL()
-	case component.FieldColor:
-		return m.Color()
-	}
-	return nil, false
+// ClearCodeChallenge clears the value of the "code_challenge" field.
+func (m *AuthRequestMutation) ClearCodeChallenge() {
+	m.code_challenge = nil
+	m.clearedFields[authrequest.FieldCodeChallenge] = struct{}{}
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ComponentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case component.FieldName:
-		return m.OldName(ctx)
-	case component.FieldDescription:
-		return m.OldDescription(ctx)
-	case component.FieldImageURL:
-		return m.OldImageURL(ctx)
-	case component.FieldColor:
-		return m.OldColor(ctx)
+// CodeChallengeCleared returns if the "code_challenge" field was cleared in this mutation.
+func (m *AuthRequestMutation) CodeChallengeCleared() bool {
+	_, ok := m.clearedFields[authrequest.FieldCodeChallenge]
+	return ok
+}
+
+// ResetCodeChallenge resets all changes to the "code_challenge" field.
+func (m *AuthRequestMutation) ResetCodeChallenge() {
+	m.code_challenge = nil
+	delete(m.clearedFields, authrequest.FieldCodeChallenge)
+}
+
+// SetCodeChallengeMethod sets the "code_challenge_method" field.
+func (m *AuthRequestMutation) SetCodeChallengeMethod(s string) {
+	m.code_challenge_method = &s
+}
+
+// CodeChallengeMethod returns the value of the "code_challenge_method" field in the mutation.
+func (m *AuthRequestMutation) CodeChallengeMethod() (r string, exists bool) {
+	v := m.code_challenge_method
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Component field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ComponentMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case component.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case component.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case component.FieldImageURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetImageURL(v)
-		return nil
-	case component.FieldColor:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetColor(v)
-		return nil
+// OldCodeChallengeMethod returns the old "code_challenge_method" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldCodeChallengeMethod(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCodeChallengeMethod is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Component field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCodeChallengeMethod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCodeChallengeMethod: %w", err)
+	}
+	return oldValue.CodeChallengeMethod, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ComponentMutation) AddedFields() []string {
-	return nil
+// ClearCodeChallengeMethod clears the value of the "code_challenge_method" field.
+func (m *AuthRequestMutation) ClearCodeChallengeMethod() {
+	m.code_challenge_method = nil
+	m.clearedFields[authrequest.FieldCodeChallengeMethod] = struct{}{}
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ComponentMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// CodeChallengeMethodCleared returns if the "code_challenge_method" field was cleared in this mutation.
+func (m *AuthRequestMutation) CodeChallengeMethodCleared() bool {
+	_, ok := m.clearedFields[authrequest.FieldCodeChallengeMethod]
+	return ok
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ComponentMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// ResetCodeChallengeMethod resets all changes to the "code_challenge_method" field.
+func (m *AuthRequestMutation) ResetCodeChallengeMethod() {
+	m.code_challenge_method = nil
+	delete(m.clearedFields, authrequest.FieldCodeChallengeMethod)
+}
+
+// SetLoginHint sets the "login_hint" field.
+func (m *AuthRequestMutation) SetLoginHint(s string) {
+	m.login_hint = &s
+}
+
+// LoginHint returns the value of the "login_hint" field in the mutation.
+func (m *AuthRequestMutation) LoginHint() (r string, exists bool) {
+	v := m.login_hint
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Component numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ComponentMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(component.FieldDescription) {
-		fields = append(fields, component.FieldDescription)
+// OldLoginHint returns the old "login_hint" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldLoginHint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLoginHint is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(component.FieldImageURL) {
-		fields = append(fields, component.FieldImageURL)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLoginHint requires an ID field in the mutation")
 	}
-	if m.FieldCleared(component.FieldColor) {
-		fields = append(fields, component.FieldColor)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLoginHint: %w", err)
 	}
-	return fields
+	return oldValue.LoginHint, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ComponentMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearLoginHint clears the value of the "login_hint" field.
+func (m *AuthRequestMutation) ClearLoginHint() {
+	m.login_hint = nil
+	m.clearedFields[authrequest.FieldLoginHint] = struct{}{}
+}
+
+// LoginHintCleared returns if the "login_hint" field was cleared in this mutation.
+func (m *AuthRequestMutation) LoginHintCleared() bool {
+	_, ok := m.clearedFields[authrequest.FieldLoginHint]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ComponentMutation) ClearField(name string) error {
-	switch name {
-	case component.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case component.FieldImageURL:
-		m.ClearImageURL()
-		return nil
-	case component.FieldColor:
-		m.ClearColor()
-		return nil
-	}
-	return fmt.Errorf("unknown Component nullable field %s", name)
+// ResetLoginHint resets all changes to the "login_hint" field.
+func (m *AuthRequestMutation) ResetLoginHint() {
+	m.login_hint = nil
+	delete(m.clearedFields, authrequest.FieldLoginHint)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ComponentMutation) ResetField(name string) error {
-	switch name {
-	case component.FieldName:
-		m.ResetName()
-		return nil
-	case component.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case component.FieldImageURL:
-		m.ResetImageURL()
-		return nil
-	case component.FieldColor:
-		m.ResetColor()
-		return nil
+// SetMaxAuthAge sets the "max_auth_age" field.
+func (m *AuthRequestMutation) SetMaxAuthAge(i int64) {
+	m.max_auth_age = &i
+	m.addmax_auth_age = nil
+}
+
+// MaxAuthAge returns the value of the "max_auth_age" field in the mutation.
+func (m *AuthRequestMutation) MaxAuthAge() (r int64, exists bool) {
+	v := m.max_auth_age
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Component field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ComponentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.vote != nil {
-		edges = append(edges, component.EdgeVote)
+// OldMaxAuthAge returns the old "max_auth_age" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldMaxAuthAge(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxAuthAge is only allowed on UpdateOne operations")
 	}
-	if m.user_votes != nil {
-		edges = append(edges, component.EdgeUserVotes)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxAuthAge requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxAuthAge: %w", err)
+	}
+	return oldValue.MaxAuthAge, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ComponentMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case component.EdgeVote:
-		if id := m.vote; id != nil {
-			return []ent.Value{*id}
-		}
-	case component.EdgeUserVotes:
-		ids := make([]ent.Value, 0, len(m.user_votes))
-		for id := range m.user_votes {
-			ids = append(ids, id)
-		}
-		return ids
+// AddMaxAuthAge adds i to the "max_auth_age" field.
+func (m *AuthRequestMutation) AddMaxAuthAge(i int64) {
+	if m.addmax_auth_age != nil {
+		*m.addmax_auth_age += i
+	} else {
+		m.addmax_auth_age = &i
 	}
-	return nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ComponentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removeduser_votes != nil {
-		edges = append(edges, component.EdgeUserVotes)
+// AddedMaxAuthAge returns the value that was added to the "max_auth_age" field in this mutation.
+func (m *AuthRequestMutation) AddedMaxAuthAge() (r int64, exists bool) {
+	v := m.addmax_auth_age
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ComponentMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case component.EdgeUserVotes:
-		ids := make([]ent.Value, 0, len(m.removeduser_votes))
-		for id := range m.removeduser_votes {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ClearMaxAuthAge clears the value of the "max_auth_age" field.
+func (m *AuthRequestMutation) ClearMaxAuthAge() {
+	m.max_auth_age = nil
+	m.addmax_auth_age = nil
+	m.clearedFields[authrequest.FieldMaxAuthAge] = struct{}{}
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ComponentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedvote {
-		edges = append(edges, component.EdgeVote)
-	}
-	if m.cleareduser_votes {
-		edges = append(edges, component.EdgeUserVotes)
-	}
-	return edges
+// MaxAuthAgeCleared returns if the "max_auth_age" field was cleared in this mutation.
+func (m *AuthRequestMutation) MaxAuthAgeCleared() bool {
+	_, ok := m.clearedFields[authrequest.FieldMaxAuthAge]
+	return ok
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ComponentMutation) EdgeCleared(name string) bool {
-	switch name {
-	case component.EdgeVote:
-		return m.clearedvote
-	case component.EdgeUserVotes:
-		return m.cleareduser_votes
-	}
-	return false
+// ResetMaxAuthAge resets all changes to the "max_auth_age" field.
+func (m *AuthRequestMutation) ResetMaxAuthAge() {
+	m.max_auth_age = nil
+	m.addmax_auth_age = nil
+	delete(m.clearedFields, authrequest.FieldMaxAuthAge)
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ComponentMutation) ClearEdge(name string) error {
-	switch name {
-	case component.EdgeVote:
-		m.ClearVote()
-		return nil
-	}
-	return fmt.Errorf("unknown Component unique edge %s", name)
+// SetUserID sets the "user_id" field.
+func (m *AuthRequestMutation) SetUserID(s string) {
+	m.user_id = &s
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ComponentMutation) ResetEdge(name string) error {
-	switch name {
-	case component.EdgeVote:
-		m.ResetVote()
-		return nil
-	case component.EdgeUserVotes:
-		m.ResetUserVotes()
-		return nil
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *AuthRequestMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Component edge %s", name)
+	return *v, true
 }
 
-// ConsentMutation represents an operation that mutates the Consent nodes in the graph.
-type ConsentMutation struct {
-	config
-	op                 Op
-	typ                string
-	id                 *int
-	created_at         *time.Time
-	updated_at         *time.Time
-	scopes             *[]string
-	appendscopes       []string
-	expiration_date    *time.Time
-	clearedFields      map[string]struct{}
-	application        *string
-	clearedapplication bool
-	user               *int
-	cleareduser        bool
-	done               bool
-	oldValue           func(context.Context) (*Consent, error)
-	predicates         []predicate.Consent
-}
-
-var _ ent.Mutation = (*ConsentMutation)(nil)
-
-// consentOption allows management of the mutation configuration using functional options.
-type consentOption func(*ConsentMutation)
-
-// newConsentMutation creates new mutation for the Consent entity.
-func newConsentMutation(c config, op Op, opts ...consentOption) *ConsentMutation {
-	m := &ConsentMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeConsent,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
-}
-
-// withConsentID sets the ID field of the mutation.
-func withConsentID(id int) consentOption {
-	return func(m *ConsentMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Consent
-		)
-		m.oldValue = func(ctx context.Context) (*Consent, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Consent.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldUserID returns the old "user_id" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthRequestMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
-}
-
-// withConsent sets the old Consent of the mutation.
-func withConsent(node *Consent) consentOption {
-	return func(m *ConsentMutation) {
-		m.oldValue = func(context.Context) (*Consent, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
-}
-
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ConsentMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m ConsentMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return oldValue.UserID, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Consent entities.
-func (m *ConsentMutation) SetID(id int) {
-	m.id = &id
+// ClearUserID clears the value of the "user_id" field.
+func (m *AuthRequestMutation) ClearUserID() {
+	m.user_id = nil
+	m.clearedFields[authrequest.FieldUserID] = struct{}{}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *ConsentMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *AuthRequestMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[authrequest.FieldUserID]
+	return ok
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *ConsentMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Consent.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetUserID resets all changes to the "user_id" field.
+func (m *AuthRequestMutation) ResetUserID() {
+	m.user_id = nil
+	delete(m.clearedFields, authrequest.FieldUserID)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ConsentMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetDone sets the "done" field.
+func (m *AuthRequestMutation) SetDone(b bool) {
+	m._done = &b
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ConsentMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Done returns the value of the "done" field in the mutation.
+func (m *AuthRequestMutation) Done() (r bool, exists bool) {
+	v := m._done
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Consent entity.
-// If the Consent object wasn't provided to the builder, the object is fetched from the database.
+// OldDone returns the old "done" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConsentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuthRequestMutation) OldDone(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDone is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldDone requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDone: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Done, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ConsentMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetDone resets all changes to the "done" field.
+func (m *AuthRequestMutation) ResetDone() {
+	m._done = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ConsentMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetAuthTime sets the "auth_time" field.
+func (m *AuthRequestMutation) SetAuthTime(t time.Time) {
+	m.auth_time = &t
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ConsentMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// AuthTime returns the value of the "auth_time" field in the mutation.
+func (m *AuthRequestMutation) AuthTime() (r time.Time, exists bool) {
+	v := m.auth_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Consent entity.
-// If the Consent object wasn't provided to the builder, the object is fetched from the database.
+// OldAuthTime returns the old "auth_time" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConsentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuthRequestMutation) OldAuthTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldAuthTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldAuthTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldAuthTime: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.AuthTime, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ConsentMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearAuthTime clears the value of the "auth_time" field.
+func (m *AuthRequestMutation) ClearAuthTime() {
+	m.auth_time = nil
+	m.clearedFields[authrequest.FieldAuthTime] = struct{}{}
 }
 
-// SetApplicationID sets the "application_id" field.
-func (m *ConsentMutation) SetApplicationID(s string) {
-	m.application = &s
+// AuthTimeCleared returns if the "auth_time" field was cleared in this mutation.
+func (m *AuthRequestMutation) AuthTimeCleared() bool {
+	_, ok := m.clearedFields[authrequest.FieldAuthTime]
+	return ok
 }
 
-// ApplicationID returns the value of the "application_id" field in the mutation.
-func (m *ConsentMutation) ApplicationID() (r string, exists bool) {
-	v := m.application
+// ResetAuthTime resets all changes to the "auth_time" field.
+func (m *AuthRequestMutation) ResetAuthTime() {
+	m.auth_time = nil
+	delete(m.clearedFields, authrequest.FieldAuthTime)
+}
+
+// SetAmr sets the "amr" field.
+func (m *AuthRequestMutation) SetAmr(s []string) {
+	m.amr = &s
+	m.appendamr = nil
+}
+
+// Amr returns the value of the "amr" field in the mutation.
+func (m *AuthRequestMutation) Amr() (r []string, exists bool) {
+	v := m.amr
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldApplicationID returns the old "application_id" field's value of the Consent entity.
-// If the Consent object wasn't provided to the builder, the object is fetched from the database.
+// OldAmr returns the old "amr" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConsentMutation) OldApplicationID(ctx context.Context) (v string, err error) {
+func (m *AuthRequestMutation) OldAmr(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldApplicationID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAmr is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldApplicationID requires an ID field in the mutation")
+		return v, errors.New("OldAmr requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldApplicationID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAmr: %w", err)
 	}
-	return oldValue.ApplicationID, nil
+	return oldValue.Amr, nil
 }
 
-// ResetApplicationID resets all changes to the "application_id" field.
-func (m *ConsentMutation) ResetApplicationID() {
-	m.application = nil
+// AppendAmr adds s to the "amr" field.
+func (m *AuthRequestMutation) AppendAmr(s []string) {
+	m.appendamr = append(m.appendamr, s...)
 }
 
-// SetUserID sets the "user_id" field.
-func (m *ConsentMutation) SetUserID(i int) {
-	m.user = &i
+// AppendedAmr returns the list of values that were appended to the "amr" field in this mutation.
+func (m *AuthRequestMutation) AppendedAmr() ([]string, bool) {
+	if len(m.appendamr) == 0 {
+		return nil, false
+	}
+	return m.appendamr, true
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *ConsentMutation) UserID() (r int, exists bool) {
-	v := m.user
+// ClearAmr clears the value of the "amr" field.
+func (m *AuthRequestMutation) ClearAmr() {
+	m.amr = nil
+	m.appendamr = nil
+	m.clearedFields[authrequest.FieldAmr] = struct{}{}
+}
+
+// AmrCleared returns if the "amr" field was cleared in this mutation.
+func (m *AuthRequestMutation) AmrCleared() bool {
+	_, ok := m.clearedFields[authrequest.FieldAmr]
+	return ok
+}
+
+// ResetAmr resets all changes to the "amr" field.
+func (m *AuthRequestMutation) ResetAmr() {
+	m.amr = nil
+	m.appendamr = nil
+	delete(m.clearedFields, authrequest.FieldAmr)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AuthRequestMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuthRequestMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Consent entity.
-// If the Consent object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConsentMutation) OldUserID(ctx context.Context) (v int, err error) {
+func (m *AuthRequestMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *ConsentMutation) ResetUserID() {
-	m.user = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuthRequestMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetScopes sets the "scopes" field.
-func (m *ConsentMutation) SetScopes(s []string) {
-	m.scopes = &s
-	m.appendscopes = nil
+// SetExpiration sets the "expiration" field.
+func (m *AuthRequestMutation) SetExpiration(t time.Time) {
+	m.expiration = &t
 }
 
-// Scopes returns the value of the "scopes" field in the mutation.
-func (m *ConsentMutation) Scopes() (r []string, exists bool) {
-	v := m.scopes
+// Expiration returns the value of the "expiration" field in the mutation.
+func (m *AuthRequestMutation) Expiration() (r time.Time, exists bool) {
+	v := m.expiration
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScopes returns the old "scopes" field's value of the Consent entity.
-// If the Consent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConsentMutation) OldScopes(ctx context.Context) (v []string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScopes requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
-	}
-	return oldValue.Scopes, nil
-}
-
-// AppendScopes adds s to the "scopes" field.
-func (m *ConsentMutation) AppendScopes(s []string) {
-	m.appendscopes = append(m.appendscopes, s...)
-}
-
-// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
-func (m *ConsentMutation) AppendedScopes() ([]string, bool) {
-	if len(m.appendscopes) == 0 {
-		return nil, false
-	}
-	return m.appendscopes, true
-}
-
-// ResetScopes resets all changes to the "scopes" field.
-func (m *ConsentMutation) ResetScopes() {
-	m.scopes = nil
-	m.appendscopes = nil
-}
-
-// SetExpirationDate sets the "expiration_date" field.
-func (m *ConsentMutation) SetExpirationDate(t time.Time) {
-	m.expiration_date = &t
-}
-
-// ExpirationDate returns the value of the "expiration_date" field in the mutation.
-func (m *ConsentMutation) ExpirationDate() (r time.Time, exists bool) {
-	v := m.expiration_date
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldExpirationDate returns the old "expiration_date" field's value of the Consent entity.
-// If the Consent object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiration returns the old "expiration" field's value of the AuthRequest entity.
+// If the AuthRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConsentMutation) OldExpirationDate(ctx context.Context) (v time.Time, err error) {
+func (m *AuthRequestMutation) OldExpiration(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpirationDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiration is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpirationDate requires an ID field in the mutation")
+		return v, errors.New("OldExpiration requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpirationDate: %w", err)
-	}
-	return oldValue.ExpirationDate, nil
-}
-
-// ResetExpirationDate resets all changes to the "expiration_date" field.
-func (m *ConsentMutation) ResetExpirationDate() {
-	m.expiration_date = nil
-}
-
-// ClearApplication clears the "application" edge to the App entity.
-func (m *ConsentMutation) ClearApplication() {
-	m.clearedapplication = true
-	m.clearedFields[consent.FieldApplicationID] = struct{}{}
-}
-
-// ApplicationCleared reports if the "application" edge to the App entity was cleared.
-func (m *ConsentMutation) ApplicationCleared() bool {
-	return m.clearedapplication
-}
-
-// ApplicationIDs returns the "application" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ApplicationID instead. It exists only for internal usage by the builders.
-func (m *ConsentMutation) ApplicationIDs() (ids []string) {
-	if id := m.application; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetApplication resets all changes to the "application" edge.
-func (m *ConsentMutation) ResetApplication() {
-	m.application = nil
-	m.clearedapplication = false
-}
-
-// ClearUser clears the "user" edge to the User entity.
-func (m *ConsentMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[consent.FieldUserID] = struct{}{}
-}
-
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *ConsentMutation) UserCleared() bool {
-	return m.cleareduser
-}
-
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *ConsentMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+		return v, fmt.Errorf("querying old value for OldExpiration: %w", err)
 	}
-	return
+	return oldValue.Expiration, nil
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *ConsentMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetExpiration resets all changes to the "expiration" field.
+func (m *AuthRequestMutation) ResetExpiration() {
+	m.expiration = nil
 }
 
-// Where appends a list predicates to the ConsentMutation builder.
-func (m *ConsentMutation) Where(ps ...predicate.Consent) {
+// Where appends a list predicates to the AuthRequestMutation builder.
+func (m *AuthRequestMutation) Where(ps ...predicate.AuthRequest) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ConsentMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AuthRequestMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ConsentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Consent, len(ps))
+func (m *AuthRequestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuthRequest, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4029,42 +4636,78 @@ func (m *ConsentMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ConsentMutation) Op() Op {
+func (m *AuthRequestMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ConsentMutation) SetOp(op Op) {
+func (m *AuthRequestMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Consent).
-func (m *ConsentMutation) Type() string {
+// Type returns the node type of this mutation (AuthRequest).
+func (m *AuthRequestMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ConsentMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.created_at != nil {
-		fields = append(fields, consent.FieldCreatedAt)
+func (m *AuthRequestMutation) Fields() []string {
+	fields := make([]string, 0, 18)
+	if m.application_id != nil {
+		fields = append(fields, authrequest.FieldApplicationID)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, consent.FieldUpdatedAt)
+	if m.redirect_uri != nil {
+		fields = append(fields, authrequest.FieldRedirectURI)
 	}
-	if m.application != nil {
-		fields = append(fields, consent.FieldApplicationID)
+	if m.state != nil {
+		fields = append(fields, authrequest.FieldState)
 	}
-	if m.user != nil {
-		fields = append(fields, consent.FieldUserID)
+	if m.nonce != nil {
+		fields = append(fields, authrequest.FieldNonce)
 	}
 	if m.scopes != nil {
-		fields = append(fields, consent.FieldScopes)
+		fields = append(fields, authrequest.FieldScopes)
 	}
-	if m.expiration_date != nil {
-		fields = append(fields, consent.FieldExpirationDate)
+	if m.prompt != nil {
+		fields = append(fields, authrequest.FieldPrompt)
+	}
+	if m.response_type != nil {
+		fields = append(fields, authrequest.FieldResponseType)
+	}
+	if m.response_mode != nil {
+		fields = append(fields, authrequest.FieldResponseMode)
+	}
+	if m.code_challenge != nil {
+		fields = append(fields, authrequest.FieldCodeChallenge)
+	}
+	if m.code_challenge_method != nil {
+		fields = append(fields, authrequest.FieldCodeChallengeMethod)
+	}
+	if m.login_hint != nil {
+		fields = append(fields, authrequest.FieldLoginHint)
+	}
+	if m.max_auth_age != nil {
+		fields = append(fields, authrequest.FieldMaxAuthAge)
+	}
+	if m.user_id != nil {
+		fields = append(fields, authrequest.FieldUserID)
+	}
+	if m._done != nil {
+		fields = append(fields, authrequest.FieldDone)
+	}
+	if m.auth_time != nil {
+		fields = append(fields, authrequest.FieldAuthTime)
+	}
+	if m.amr != nil {
+		fields = append(fields, authrequest.FieldAmr)
+	}
+	if m.created_at != nil {
+		fields = append(fields, authrequest.FieldCreatedAt)
+	}
+	if m.expiration != nil {
+		fields = append(fields, authrequest.FieldExpiration)
 	}
 	return fields
 }
@@ -4072,20 +4715,44 @@ func (m *ConsentMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ConsentMutation) Field(name string) (ent.Value, bool) {
+func (m *AuthRequestMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case consent.FieldCreatedAt:
-		return m.CreatedAt()
-	case consent.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case consent.FieldApplicationID:
+	case authrequest.FieldApplicationID:
 		return m.ApplicationID()
-	case consent.FieldUserID:
-		return m.UserID()
-	case consent.FieldScopes:
+	case authrequest.FieldRedirectURI:
+		return m.RedirectURI()
+	case authrequest.FieldState:
+		return m.State()
+	case authrequest.FieldNonce:
+		return m.Nonce()
+	case authrequest.FieldScopes:
 		return m.Scopes()
-	case consent.FieldExpirationDate:
-		return m.ExpirationDate()
+	case authrequest.FieldPrompt:
+		return m.Prompt()
+	case authrequest.FieldResponseType:
+		return m.ResponseType()
+	case authrequest.FieldResponseMode:
+		return m.ResponseMode()
+	case authrequest.FieldCodeChallenge:
+		return m.CodeChallenge()
+	case authrequest.FieldCodeChallengeMethod:
+		return m.CodeChallengeMethod()
+	case authrequest.FieldLoginHint:
+		return m.LoginHint()
+	case authrequest.FieldMaxAuthAge:
+		return m.MaxAuthAge()
+	case authrequest.FieldUserID:
+		return m.UserID()
+	case authrequest.FieldDone:
+		return m.Done()
+	case authrequest.FieldAuthTime:
+		return m.AuthTime()
+	case authrequest.FieldAmr:
+		return m.Amr()
+	case authrequest.FieldCreatedAt:
+		return m.CreatedAt()
+	case authrequest.FieldExpiration:
+		return m.Expiration()
 	}
 	return nil, false
 }
@@ -4093,267 +4760,452 @@ func (m *ConsentMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ConsentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AuthRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case consent.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case consent.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case consent.FieldApplicationID:
+	case authrequest.FieldApplicationID:
 		return m.OldApplicationID(ctx)
-	case consent.FieldUserID:
-		return m.OldUserID(ctx)
-	case consent.FieldScopes:
+	case authrequest.FieldRedirectURI:
+		return m.OldRedirectURI(ctx)
+	case authrequest.FieldState:
+		return m.OldState(ctx)
+	case authrequest.FieldNonce:
+		return m.OldNonce(ctx)
+	case authrequest.FieldScopes:
 		return m.OldScopes(ctx)
-	case consent.FieldExpirationDate:
-		return m.OldExpirationDate(ctx)
+	case authrequest.FieldPrompt:
+		return m.OldPrompt(ctx)
+	case authrequest.FieldResponseType:
+		return m.OldResponseType(ctx)
+	case authrequest.FieldResponseMode:
+		return m.OldResponseMode(ctx)
+	case authrequest.FieldCodeChallenge:
+		return m.OldCodeChallenge(ctx)
+	case authrequest.FieldCodeChallengeMethod:
+		return m.OldCodeChallengeMethod(ctx)
+	case authrequest.FieldLoginHint:
+		return m.OldLoginHint(ctx)
+	case authrequest.FieldMaxAuthAge:
+		return m.OldMaxAuthAge(ctx)
+	case authrequest.FieldUserID:
+		return m.OldUserID(ctx)
+	case authrequest.FieldDone:
+		return m.OldDone(ctx)
+	case authrequest.FieldAuthTime:
+		return m.OldAuthTime(ctx)
+	case authrequest.FieldAmr:
+		return m.OldAmr(ctx)
+	case authrequest.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case authrequest.FieldExpiration:
+		return m.OldExpiration(ctx)
 	}
-	return nil, fmt.Errorf("unknown Consent field %s", name)
+	return nil, fmt.Errorf("unknown AuthRequest field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ConsentMutation) SetField(name string, value ent.Value) error {
+func (m *AuthRequestMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case consent.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case authrequest.FieldApplicationID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetApplicationID(v)
 		return nil
-	case consent.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case authrequest.FieldRedirectURI:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetRedirectURI(v)
 		return nil
-	case consent.FieldApplicationID:
+	case authrequest.FieldState:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetApplicationID(v)
+		m.SetState(v)
 		return nil
-	case consent.FieldUserID:
-		v, ok := value.(int)
+	case authrequest.FieldNonce:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetNonce(v)
 		return nil
-	case consent.FieldScopes:
+	case authrequest.FieldScopes:
 		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetScopes(v)
 		return nil
-	case consent.FieldExpirationDate:
-		v, ok := value.(time.Time)
+	case authrequest.FieldPrompt:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpirationDate(v)
+		m.SetPrompt(v)
 		return nil
-	}
-	return fmt.Errorf("unknown Consent field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ConsentMutation) AddedFields() []string {
-	var fields []string
-	return fields
-}
-
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ConsentMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	}
-	return nil, false
-}
-
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ConsentMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Consent numeric field %s", name)
-}
-
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ConsentMutation) ClearedFields() []string {
-	return nil
-}
-
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ConsentMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
-}
-
+	case authrequest.FieldResponseType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseType(v)
+		return nil
+	case authrequest.FieldResponseMode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseMode(v)
+		return nil
+	case authrequest.FieldCodeChallenge:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCodeChallenge(v)
+		return nil
+	case authrequest.FieldCodeChallengeMethod:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCodeChallengeMethod(v)
+		return nil
+	case authrequest.FieldLoginHint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLoginHint(v)
+		return nil
+	case authrequest.FieldMaxAuthAge:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxAuthAge(v)
+		return nil
+	case authrequest.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case authrequest.FieldDone:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDone(v)
+		return nil
+	case authrequest.FieldAuthTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthTime(v)
+		return nil
+	case authrequest.FieldAmr:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmr(v)
+		return nil
+	case authrequest.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case authrequest.FieldExpiration:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiration(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuthRequest field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuthRequestMutation) AddedFields() []string {
+	var fields []string
+	if m.addmax_auth_age != nil {
+		fields = append(fields, authrequest.FieldMaxAuthAge)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuthRequestMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case authrequest.FieldMaxAuthAge:
+		return m.AddedMaxAuthAge()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuthRequestMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case authrequest.FieldMaxAuthAge:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxAuthAge(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuthRequest numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuthRequestMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(authrequest.FieldState) {
+		fields = append(fields, authrequest.FieldState)
+	}
+	if m.FieldCleared(authrequest.FieldNonce) {
+		fields = append(fields, authrequest.FieldNonce)
+	}
+	if m.FieldCleared(authrequest.FieldPrompt) {
+		fields = append(fields, authrequest.FieldPrompt)
+	}
+	if m.FieldCleared(authrequest.FieldResponseMode) {
+		fields = append(fields, authrequest.FieldResponseMode)
+	}
+	if m.FieldCleared(authrequest.FieldCodeChallenge) {
+		fields = append(fields, authrequest.FieldCodeChallenge)
+	}
+	if m.FieldCleared(authrequest.FieldCodeChallengeMethod) {
+		fields = append(fields, authrequest.FieldCodeChallengeMethod)
+	}
+	if m.FieldCleared(authrequest.FieldLoginHint) {
+		fields = append(fields, authrequest.FieldLoginHint)
+	}
+	if m.FieldCleared(authrequest.FieldMaxAuthAge) {
+		fields = append(fields, authrequest.FieldMaxAuthAge)
+	}
+	if m.FieldCleared(authrequest.FieldUserID) {
+		fields = append(fields, authrequest.FieldUserID)
+	}
+	if m.FieldCleared(authrequest.FieldAuthTime) {
+		fields = append(fields, authrequest.FieldAuthTime)
+	}
+	if m.FieldCleared(authrequest.FieldAmr) {
+		fields = append(fields, authrequest.FieldAmr)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuthRequestMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ConsentMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Consent nullable field %s", name)
+func (m *AuthRequestMutation) ClearField(name string) error {
+	switch name {
+	case authrequest.FieldState:
+		m.ClearState()
+		return nil
+	case authrequest.FieldNonce:
+		m.ClearNonce()
+		return nil
+	case authrequest.FieldPrompt:
+		m.ClearPrompt()
+		return nil
+	case authrequest.FieldResponseMode:
+		m.ClearResponseMode()
+		return nil
+	case authrequest.FieldCodeChallenge:
+		m.ClearCodeChallenge()
+		return nil
+	case authrequest.FieldCodeChallengeMethod:
+		m.ClearCodeChallengeMethod()
+		return nil
+	case authrequest.FieldLoginHint:
+		m.ClearLoginHint()
+		return nil
+	case authrequest.FieldMaxAuthAge:
+		m.ClearMaxAuthAge()
+		return nil
+	case authrequest.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case authrequest.FieldAuthTime:
+		m.ClearAuthTime()
+		return nil
+	case authrequest.FieldAmr:
+		m.ClearAmr()
+		return nil
+	}
+	return fmt.Errorf("unknown AuthRequest nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ConsentMutation) ResetField(name string) error {
+func (m *AuthRequestMutation) ResetField(name string) error {
 	switch name {
-	case consent.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case authrequest.FieldApplicationID:
+		m.ResetApplicationID()
 		return nil
-	case consent.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case authrequest.FieldRedirectURI:
+		m.ResetRedirectURI()
 		return nil
-	case consent.FieldApplicationID:
-		m.ResetApplicationID()
+	case authrequest.FieldState:
+		m.ResetState()
 		return nil
-	case consent.FieldUserID:
-		m.ResetUserID()
+	case authrequest.FieldNonce:
+		m.ResetNonce()
 		return nil
-	case consent.FieldScopes:
+	case authrequest.FieldScopes:
 		m.ResetScopes()
 		return nil
-	case consent.FieldExpirationDate:
-		m.ResetExpirationDate()
+	case authrequest.FieldPrompt:
+		m.ResetPrompt()
+		return nil
+	case authrequest.FieldResponseType:
+		m.ResetResponseType()
+		return nil
+	case authrequest.FieldResponseMode:
+		m.ResetResponseMode()
+		return nil
+	case authrequest.FieldCodeChallenge:
+		m.ResetCodeChallenge()
+		return nil
+	case authrequest.FieldCodeChallengeMethod:
+		m.ResetCodeChallengeMethod()
+		return nil
+	case authrequest.FieldLoginHint:
+		m.ResetLoginHint()
+		return nil
+	case authrequest.FieldMaxAuthAge:
+		m.ResetMaxAuthAge()
+		return nil
+	case authrequest.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case authrequest.FieldDone:
+		m.ResetDone()
+		return nil
+	case authrequest.FieldAuthTime:
+		m.ResetAuthTime()
+		return nil
+	case authrequest.FieldAmr:
+		m.ResetAmr()
+		return nil
+	case authrequest.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case authrequest.FieldExpiration:
+		m.ResetExpiration()
 		return nil
 	}
-	return fmt.Errorf("unknown Consent field %s", name)
+	return fmt.Errorf("unknown AuthRequest field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ConsentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.application != nil {
-		edges = append(edges, consent.EdgeApplication)
-	}
-	if m.user != nil {
-		edges = append(edges, consent.EdgeUser)
-	}
+func (m *AuthRequestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ConsentMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case consent.EdgeApplication:
-		if id := m.application; id != nil {
-			return []ent.Value{*id}
-		}
-	case consent.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *AuthRequestMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ConsentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *AuthRequestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ConsentMutation) RemovedIDs(name string) []ent.Value {
+func (m *AuthRequestMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ConsentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedapplication {
-		edges = append(edges, consent.EdgeApplication)
-	}
-	if m.cleareduser {
-		edges = append(edges, consent.EdgeUser)
-	}
+func (m *AuthRequestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ConsentMutation) EdgeCleared(name string) bool {
-	switch name {
-	case consent.EdgeApplication:
-		return m.clearedapplication
-	case consent.EdgeUser:
-		return m.cleareduser
-	}
+func (m *AuthRequestMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ConsentMutation) ClearEdge(name string) error {
-	switch name {
-	case consent.EdgeApplication:
-		m.ClearApplication()
-		return nil
-	case consent.EdgeUser:
-		m.ClearUser()
-		return nil
-	}
-	return fmt.Errorf("unknown Consent unique edge %s", name)
+func (m *AuthRequestMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuthRequest unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ConsentMutation) ResetEdge(name string) error {
-	switch name {
-	case consent.EdgeApplication:
-		m.ResetApplication()
-		return nil
-	case consent.EdgeUser:
-		m.ResetUser()
-		return nil
-	}
-	return fmt.Errorf("unknown Consent edge %s", name)
+func (m *AuthRequestMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuthRequest edge %s", name)
 }
 
-// InvitationMutation represents an operation that mutates the Invitation nodes in the graph.
-type InvitationMutation struct {
+// AuthTokenMutation represents an operation that mutates the AuthToken nodes in the graph.
+type AuthTokenMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	created_at     *time.Time
-	message        *string
-	role           *string
-	clearedFields  map[string]struct{}
-	team           *int
-	clearedteam    bool
-	invitee        *int
-	clearedinvitee bool
-	done           bool
-	oldValue       func(context.Context) (*Invitation, error)
-	predicates     []predicate.Invitation
+	op               Op
+	typ              string
+	id               *string
+	application_id   *string
+	subject          *string
+	refresh_token_id *string
+	audience         *[]string
+	appendaudience   []string
+	expiration       *time.Time
+	scopes           *[]string
+	appendscopes     []string
+	created_at       *time.Time
+	last_used_at     *time.Time
+	actor            *string
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*AuthToken, error)
+	predicates       []predicate.AuthToken
 }
 
-var _ ent.Mutation = (*InvitationMutation)(nil)
+var _ ent.Mutation = (*AuthTokenMutation)(nil)
 
-// invitationOption allows management of the mutation configuration using functional options.
-type invitationOption func(*InvitationMutation)
+// authtokenOption allows management of the mutation configuration using functional options.
+type authtokenOption func(*AuthTokenMutation)
 
-// newInvitationMutation creates new mutation for the Invitation entity.
-func newInvitationMutation(c config, op Op, opts ...invitationOption) *InvitationMutation {
-	m := &InvitationMutation{
+// newAuthTokenMutation creates new mutation for the AuthToken entity.
+func newAuthTokenMutation(c config, op Op, opts ...authtokenOption) *AuthTokenMutation {
+	m := &AuthTokenMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeInvitation,
+		typ:           TypeAuthToken,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4362,20 +5214,20 @@ func newInvitationMutation(c config, op Op, opts ...invitationOption) *Invitatio
 	return m
 }
 
-// withInvitationID sets the ID field of the mutation.
-func withInvitationID(id int) invitationOption {
-	return func(m *InvitationMutation) {
+// withAuthTokenID sets the ID field of the mutation.
+func withAuthTokenID(id string) authtokenOption {
+	return func(m *AuthTokenMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Invitation
+			value *AuthToken
 		)
-		m.oldValue = func(ctx context.Context) (*Invitation, error) {
+		m.oldValue = func(ctx context.Context) (*AuthToken, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Invitation.Get(ctx, id)
+					value, err = m.Client().AuthToken.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4384,10 +5236,10 @@ func withInvitationID(id int) invitationOption {
 	}
 }
 
-// withInvitation sets the old Invitation of the mutation.
-func withInvitation(node *Invitation) invitationOption {
-	return func(m *InvitationMutation) {
-		m.oldValue = func(context.Context) (*Invitation, error) {
+// withAuthToken sets the old AuthToken of the mutation.
+func withAuthToken(node *AuthToken) authtokenOption {
+	return func(m *AuthTokenMutation) {
+		m.oldValue = func(context.Context) (*AuthToken, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4396,7 +5248,7 @@ func withInvitation(node *Invitation) invitationOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m InvitationMutation) Client() *Client {
+func (m AuthTokenMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4404,7 +5256,7 @@ func (m InvitationMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m InvitationMutation) Tx() (*Tx, error) {
+func (m AuthTokenMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4413,9 +5265,15 @@ func (m InvitationMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of AuthToken entities.
+func (m *AuthTokenMutation) SetID(id string) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *InvitationMutation) ID() (id int, exists bool) {
+func (m *AuthTokenMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4426,229 +5284,410 @@ func (m *InvitationMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *InvitationMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AuthTokenMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []string{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Invitation.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuthToken.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *InvitationMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetApplicationID sets the "application_id" field.
+func (m *AuthTokenMutation) SetApplicationID(s string) {
+	m.application_id = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *InvitationMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ApplicationID returns the value of the "application_id" field in the mutation.
+func (m *AuthTokenMutation) ApplicationID() (r string, exists bool) {
+	v := m.application_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Invitation entity.
-// If the Invitation object wasn't provided to the builder, the object is fetched from the database.
+// OldApplicationID returns the old "application_id" field's value of the AuthToken entity.
+// If the AuthToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InvitationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuthTokenMutation) OldApplicationID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldApplicationID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldApplicationID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldApplicationID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.ApplicationID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *InvitationMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetApplicationID resets all changes to the "application_id" field.
+func (m *AuthTokenMutation) ResetApplicationID() {
+	m.application_id = nil
 }
 
-// SetMessage sets the "message" field.
-func (m *InvitationMutation) SetMessage(s string) {
-	m.message = &s
+// SetSubject sets the "subject" field.
+func (m *AuthTokenMutation) SetSubject(s string) {
+	m.subject = &s
 }
 
-// Message returns the value of the "message" field in the mutation.
-func (m *InvitationMutation) Message() (r string, exists bool) {
-	v := m.message
+// Subject returns the value of the "subject" field in the mutation.
+func (m *AuthTokenMutation) Subject() (r string, exists bool) {
+	v := m.subject
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMessage returns the old "message" field's value of the Invitation entity.
-// If the Invitation object wasn't provided to the builder, the object is fetched from the database.
+// OldSubject returns the old "subject" field's value of the AuthToken entity.
+// If the AuthToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InvitationMutation) OldMessage(ctx context.Context) (v string, err error) {
+func (m *AuthTokenMutation) OldSubject(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMessage requires an ID field in the mutation")
+		return v, errors.New("OldSubject requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
 	}
-	return oldValue.Message, nil
-}
-
-// ClearMessage clears the value of the "message" field.
-func (m *InvitationMutation) ClearMessage() {
-	m.message = nil
-	m.clearedFields[invitation.FieldMessage] = struct{}{}
-}
-
-// MessageCleared returns if the "message" field was cleared in this mutation.
-func (m *InvitationMutation) MessageCleared() bool {
-	_, ok := m.clearedFields[invitation.FieldMessage]
-	return ok
+	return oldValue.Subject, nil
 }
 
-// ResetMessage resets all changes to the "message" field.
-func (m *InvitationMutation) ResetMessage() {
-	m.message = nil
-	delete(m.clearedFields, invitation.FieldMessage)
+// ResetSubject resets all changes to the "subject" field.
+func (m *AuthTokenMutation) ResetSubject() {
+	m.subject = nil
 }
 
-// SetRole sets the "role" field.
-func (m *InvitationMutation) SetRole(s string) {
-	m.role = &s
+// SetRefreshTokenID sets the "refresh_token_id" field.
+func (m *AuthTokenMutation) SetRefreshTokenID(s string) {
+	m.refresh_token_id = &s
 }
 
-// Role returns the value of the "role" field in the mutation.
-func (m *InvitationMutation) Role() (r string, exists bool) {
-	v := m.role
+// RefreshTokenID returns the value of the "refresh_token_id" field in the mutation.
+func (m *AuthTokenMutation) RefreshTokenID() (r string, exists bool) {
+	v := m.refresh_token_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRole returns the old "role" field's value of the Invitation entity.
-// If the Invitation object wasn't provided to the builder, the object is fetched from the database.
+// OldRefreshTokenID returns the old "refresh_token_id" field's value of the AuthToken entity.
+// If the AuthToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InvitationMutation) OldRole(ctx context.Context) (v string, err error) {
+func (m *AuthTokenMutation) OldRefreshTokenID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+		return v, errors.New("OldRefreshTokenID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRole requires an ID field in the mutation")
+		return v, errors.New("OldRefreshTokenID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+		return v, fmt.Errorf("querying old value for OldRefreshTokenID: %w", err)
 	}
-	return oldValue.Role, nil
-}
-
-// ResetRole resets all changes to the "role" field.
-func (m *InvitationMutation) ResetRole() {
-	m.role = nil
-}
-
-// SetTeamID sets the "team" edge to the Team entity by id.
-func (m *InvitationMutation) SetTeamID(id int) {
-	m.team = &id
+	return oldValue.RefreshTokenID, nil
 }
 
-// ClearTeam clears the "team" edge to the Team entity.
-func (m *InvitationMutation) ClearTeam() {
-	m.clearedteam = true
+// ResetRefreshTokenID resets all changes to the "refresh_token_id" field.
+func (m *AuthTokenMutation) ResetRefreshTokenID() {
+	m.refresh_token_id = nil
 }
 
-// TeamCleared reports if the "team" edge to the Team entity was cleared.
-func (m *InvitationMutation) TeamCleared() bool {
-	return m.clearedteam
+// SetAudience sets the "audience" field.
+func (m *AuthTokenMutation) SetAudience(s []string) {
+	m.audience = &s
+	m.appendaudience = nil
 }
 
-// TeamID returns the "team" edge ID in the mutation.
-func (m *InvitationMutation) TeamID() (id int, exists bool) {
-	if m.team != nil {
-		return *m.team, true
+// Audience returns the value of the "audience" field in the mutation.
+func (m *AuthTokenMutation) Audience() (r []string, exists bool) {
+	v := m.audience
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// TeamIDs returns the "team" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TeamID instead. It exists only for internal usage by the builders.
-func (m *InvitationMutation) TeamIDs() (ids []int) {
-	if id := m.team; id != nil {
-		ids = append(ids, *id)
+// OldAudience returns the old "audience" field's value of the AuthToken entity.
+// If the AuthToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthTokenMutation) OldAudience(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAudience is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAudience requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAudience: %w", err)
+	}
+	return oldValue.Audience, nil
 }
 
-// ResetTeam resets all changes to the "team" edge.
-func (m *InvitationMutation) ResetTeam() {
-	m.team = nil
-	m.clearedteam = false
+// AppendAudience adds s to the "audience" field.
+func (m *AuthTokenMutation) AppendAudience(s []string) {
+	m.appendaudience = append(m.appendaudience, s...)
 }
 
-// SetInviteeID sets the "invitee" edge to the User entity by id.
-func (m *InvitationMutation) SetInviteeID(id int) {
-	m.invitee = &id
+// AppendedAudience returns the list of values that were appended to the "audience" field in this mutation.
+func (m *AuthTokenMutation) AppendedAudience() ([]string, bool) {
+	if len(m.appendaudience) == 0 {
+		return nil, false
+	}
+	return m.appendaudience, true
 }
 
-// ClearInvitee clears the "invitee" edge to the User entity.
-func (m *InvitationMutation) ClearInvitee() {
-	m.clearedinvitee = true
+// ResetAudience resets all changes to the "audience" field.
+func (m *AuthTokenMutation) ResetAudience() {
+	m.audience = nil
+	m.appendaudience = nil
 }
 
-// InviteeCleared reports if the "invitee" edge to the User entity was cleared.
-func (m *InvitationMutation) InviteeCleared() bool {
-	return m.clearedinvitee
+// SetExpiration sets the "expiration" field.
+func (m *AuthTokenMutation) SetExpiration(t time.Time) {
+	m.expiration = &t
 }
 
-// InviteeID returns the "invitee" edge ID in the mutation.
-func (m *InvitationMutation) InviteeID() (id int, exists bool) {
-	if m.invitee != nil {
-		return *m.invitee, true
+// Expiration returns the value of the "expiration" field in the mutation.
+func (m *AuthTokenMutation) Expiration() (r time.Time, exists bool) {
+	v := m.expiration
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// InviteeIDs returns the "invitee" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// InviteeID instead. It exists only for internal usage by the builders.
-func (m *InvitationMutation) InviteeIDs() (ids []int) {
-	if id := m.invitee; id != nil {
-		ids = append(ids, *id)
+// OldExpiration returns the old "expiration" field's value of the AuthToken entity.
+// If the AuthToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthTokenMutation) OldExpiration(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiration is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiration requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiration: %w", err)
+	}
+	return oldValue.Expiration, nil
 }
 
-// ResetInvitee resets all changes to the "invitee" edge.
-func (m *InvitationMutation) ResetInvitee() {
-	m.invitee = nil
-	m.clearedinvitee = false
+// ResetExpiration resets all changes to the "expiration" field.
+func (m *AuthTokenMutation) ResetExpiration() {
+	m.expiration = nil
 }
 
-// Where appends a list predicates to the InvitationMutation builder.
-func (m *InvitationMutation) Where(ps ...predicate.Invitation) {
+// SetScopes sets the "scopes" field.
+func (m *AuthTokenMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
+}
+
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *AuthTokenMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScopes returns the old "scopes" field's value of the AuthToken entity.
+// If the AuthToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthTokenMutation) OldScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	}
+	return oldValue.Scopes, nil
+}
+
+// AppendScopes adds s to the "scopes" field.
+func (m *AuthTokenMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
+}
+
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *AuthTokenMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
+}
+
+// ResetScopes resets all changes to the "scopes" field.
+func (m *AuthTokenMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AuthTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuthTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the AuthToken entity.
+// If the AuthToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuthTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *AuthTokenMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
+}
+
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *AuthTokenMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedAt returns the old "last_used_at" field's value of the AuthToken entity.
+// If the AuthToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthTokenMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+	}
+	return oldValue.LastUsedAt, nil
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *AuthTokenMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[authtoken.FieldLastUsedAt] = struct{}{}
+}
+
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *AuthTokenMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[authtoken.FieldLastUsedAt]
+	return ok
+}
+
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *AuthTokenMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, authtoken.FieldLastUsedAt)
+}
+
+// SetActor sets the "actor" field.
+func (m *AuthTokenMutation) SetActor(s string) {
+	m.actor = &s
+}
+
+// Actor returns the value of the "actor" field in the mutation.
+func (m *AuthTokenMutation) Actor() (r string, exists bool) {
+	v := m.actor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActor returns the old "actor" field's value of the AuthToken entity.
+// If the AuthToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthTokenMutation) OldActor(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
+	}
+	return oldValue.Actor, nil
+}
+
+// ClearActor clears the value of the "actor" field.
+func (m *AuthTokenMutation) ClearActor() {
+	m.actor = nil
+	m.clearedFields[authtoken.FieldActor] = struct{}{}
+}
+
+// ActorCleared returns if the "actor" field was cleared in this mutation.
+func (m *AuthTokenMutation) ActorCleared() bool {
+	_, ok := m.clearedFields[authtoken.FieldActor]
+	return ok
+}
+
+// ResetActor resets all changes to the "actor" field.
+func (m *AuthTokenMutation) ResetActor() {
+	m.actor = nil
+	delete(m.clearedFields, authtoken.FieldActor)
+}
+
+// Where appends a list predicates to the AuthTokenMutation builder.
+func (m *AuthTokenMutation) Where(ps ...predicate.AuthToken) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the InvitationMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AuthTokenMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *InvitationMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Invitation, len(ps))
+func (m *AuthTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuthToken, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4656,33 +5695,51 @@ func (m *InvitationMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *InvitationMutation) Op() Op {
+func (m *AuthTokenMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *InvitationMutation) SetOp(op Op) {
+func (m *AuthTokenMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Invitation).
-func (m *InvitationMutation) Type() string {
+// Type returns the node type of this mutation (AuthToken).
+func (m *AuthTokenMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *InvitationMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+func (m *AuthTokenMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.application_id != nil {
+		fields = append(fields, authtoken.FieldApplicationID)
+	}
+	if m.subject != nil {
+		fields = append(fields, authtoken.FieldSubject)
+	}
+	if m.refresh_token_id != nil {
+		fields = append(fields, authtoken.FieldRefreshTokenID)
+	}
+	if m.audience != nil {
+		fields = append(fields, authtoken.FieldAudience)
+	}
+	if m.expiration != nil {
+		fields = append(fields, authtoken.FieldExpiration)
+	}
+	if m.scopes != nil {
+		fields = append(fields, authtoken.FieldScopes)
+	}
 	if m.created_at != nil {
-		fields = append(fields, invitation.FieldCreatedAt)
+		fields = append(fields, authtoken.FieldCreatedAt)
 	}
-	if m.message != nil {
-		fields = append(fields, invitation.FieldMessage)
+	if m.last_used_at != nil {
+		fields = append(fields, authtoken.FieldLastUsedAt)
 	}
-	if m.role != nil {
-		fields = append(fields, invitation.FieldRole)
+	if m.actor != nil {
+		fields = append(fields, authtoken.FieldActor)
 	}
 	return fields
 }
@@ -4690,14 +5747,26 @@ func (m *InvitationMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *InvitationMutation) Field(name string) (ent.Value, bool) {
+func (m *AuthTokenMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case invitation.FieldCreatedAt:
+	case authtoken.FieldApplicationID:
+		return m.ApplicationID()
+	case authtoken.FieldSubject:
+		return m.Subject()
+	case authtoken.FieldRefreshTokenID:
+		return m.RefreshTokenID()
+	case authtoken.FieldAudience:
+		return m.Audience()
+	case authtoken.FieldExpiration:
+		return m.Expiration()
+	case authtoken.FieldScopes:
+		return m.Scopes()
+	case authtoken.FieldCreatedAt:
 		return m.CreatedAt()
-	case invitation.FieldMessage:
-		return m.Message()
-	case invitation.FieldRole:
-		return m.Role()
+	case authtoken.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case authtoken.FieldActor:
+		return m.Actor()
 	}
 	return nil, false
 }
@@ -4705,239 +5774,273 @@ func (m *InvitationMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *InvitationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AuthTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case invitation.FieldCreatedAt:
+	case authtoken.FieldApplicationID:
+		return m.OldApplicationID(ctx)
+	case authtoken.FieldSubject:
+		return m.OldSubject(ctx)
+	case authtoken.FieldRefreshTokenID:
+		return m.OldRefreshTokenID(ctx)
+	case authtoken.FieldAudience:
+		return m.OldAudience(ctx)
+	case authtoken.FieldExpiration:
+		return m.OldExpiration(ctx)
+	case authtoken.FieldScopes:
+		return m.OldScopes(ctx)
+	case authtoken.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case invitation.FieldMessage:
-		return m.OldMessage(ctx)
-	case invitation.FieldRole:
-		return m.OldRole(ctx)
+	case authtoken.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case authtoken.FieldActor:
+		return m.OldActor(ctx)
 	}
-	return nil, fmt.Errorf("unknown Invitation field %s", name)
+	return nil, fmt.Errorf("unknown AuthToken field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *InvitationMutation) SetField(name string, value ent.Value) error {
+func (m *AuthTokenMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case invitation.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case authtoken.FieldApplicationID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetApplicationID(v)
 		return nil
-	case invitation.FieldMessage:
+	case authtoken.FieldSubject:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMessage(v)
+		m.SetSubject(v)
 		return nil
-	case invitation.FieldRole:
+	case authtoken.FieldRefreshTokenID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRole(v)
+		m.SetRefreshTokenID(v)
+		return nil
+	case authtoken.FieldAudience:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAudience(v)
+		return nil
+	case authtoken.FieldExpiration:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiration(v)
+		return nil
+	case authtoken.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	case authtoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case authtoken.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	case authtoken.FieldActor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActor(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Invitation field %s", name)
+	return fmt.Errorf("unknown AuthToken field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *InvitationMutation) AddedFields() []string {
+func (m *AuthTokenMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *InvitationMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AuthTokenMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *InvitationMutation) AddField(name string, value ent.Value) error {
+func (m *AuthTokenMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Invitation numeric field %s", name)
+	return fmt.Errorf("unknown AuthToken numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *InvitationMutation) ClearedFields() []string {
+func (m *AuthTokenMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(invitation.FieldMessage) {
-		fields = append(fields, invitation.FieldMessage)
+	if m.FieldCleared(authtoken.FieldLastUsedAt) {
+		fields = append(fields, authtoken.FieldLastUsedAt)
+	}
+	if m.FieldCleared(authtoken.FieldActor) {
+		fields = append(fields, authtoken.FieldActor)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *InvitationMutation) FieldCleared(name string) bool {
+func (m *AuthTokenMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *InvitationMutation) ClearField(name string) error {
+func (m *AuthTokenMutation) ClearField(name string) error {
 	switch name {
-	case invitation.FieldMessage:
-		m.ClearMessage()
+	case authtoken.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	case authtoken.FieldActor:
+		m.ClearActor()
 		return nil
 	}
-	return fmt.Errorf("unknown Invitation nullable field %s", name)
+	return fmt.Errorf("unknown AuthToken nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *InvitationMutation) ResetField(name string) error {
+func (m *AuthTokenMutation) ResetField(name string) error {
 	switch name {
-	case invitation.FieldCreatedAt:
+	case authtoken.FieldApplicationID:
+		m.ResetApplicationID()
+		return nil
+	case authtoken.FieldSubject:
+		m.ResetSubject()
+		return nil
+	case authtoken.FieldRefreshTokenID:
+		m.ResetRefreshTokenID()
+		return nil
+	case authtoken.FieldAudience:
+		m.ResetAudience()
+		return nil
+	case authtoken.FieldExpiration:
+		m.ResetExpiration()
+		return nil
+	case authtoken.FieldScopes:
+		m.ResetScopes()
+		return nil
+	case authtoken.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case invitation.FieldMessage:
-		m.ResetMessage()
+	case authtoken.FieldLastUsedAt:
+		m.ResetLastUsedAt()
 		return nil
-	case invitation.FieldRole:
-		m.ResetRole()
+	case authtoken.FieldActor:
+		m.ResetActor()
 		return nil
 	}
-	return fmt.Errorf("unknown Invitation field %s", name)
+	return fmt.Errorf("unknown AuthToken field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *InvitationMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.team != nil {
-		edges = append(edges, invitation.EdgeTeam)
-	}
-	if m.invitee != nil {
-		edges = append(edges, invitation.EdgeInvitee)
-	}
+func (m *AuthTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *InvitationMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case invitation.EdgeTeam:
-		if id := m.team; id != nil {
-			return []ent.Value{*id}
-		}
-	case invitation.EdgeInvitee:
-		if id := m.invitee; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *AuthTokenMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *InvitationMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *AuthTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *InvitationMutation) RemovedIDs(name string) []ent.Value {
+func (m *AuthTokenMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *InvitationMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedteam {
-		edges = append(edges, invitation.EdgeTeam)
-	}
-	if m.clearedinvitee {
-		edges = append(edges, invitation.EdgeInvitee)
-	}
+func (m *AuthTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *InvitationMutation) EdgeCleared(name string) bool {
-	switch name {
-	case invitation.EdgeTeam:
-		return m.clearedteam
-	case invitation.EdgeInvitee:
-		return m.clearedinvitee
-	}
+func (m *AuthTokenMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *InvitationMutation) ClearEdge(name string) error {
-	switch name {
-	case invitation.EdgeTeam:
-		m.ClearTeam()
-		return nil
-	case invitation.EdgeInvitee:
-		m.ClearInvitee()
-		return nil
-	}
-	return fmt.Errorf("unknown Invitation unique edge %s", name)
+func (m *AuthTokenMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuthToken unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *InvitationMutation) ResetEdge(name string) error {
-	switch name {
-	case invitation.EdgeTeam:
-		m.ResetTeam()
-		return nil
-	case invitation.EdgeInvitee:
-		m.ResetInvitee()
-		return nil
-	}
-	return fmt.Errorf("unknown Invitation edge %s", name)
+func (m *AuthTokenMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuthToken edge %s", name)
 }
 
-// NotificationMutation represents an operation that mutates the Notification nodes in the graph.
-type NotificationMutation struct {
+// ComponentMutation represents an operation that mutates the Component nodes in the graph.
+type ComponentMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	created_at    *time.Time
-	_type         *string
-	title         *string
-	message       *string
-	href          *string
-	read          *bool
-	read_at       *time.Time
-	clearedFields map[string]struct{}
-	user          *int
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*Notification, error)
-	predicates    []predicate.Notification
+	op                Op
+	typ               string
+	id                *int
+	name              *string
+	description       *string
+	image_url         *string
+	color             *string
+	clearedFields     map[string]struct{}
+	vote              *int
+	clearedvote       bool
+	user_votes        map[int]struct{}
+	removeduser_votes map[int]struct{}
+	cleareduser_votes bool
+	done              bool
+	oldValue          func(context.Context) (*Component, error)
+	predicates        []predicate.Component
 }
 
-var _ ent.Mutation = (*NotificationMutation)(nil)
+var _ ent.Mutation = (*ComponentMutation)(nil)
 
-// notificationOption allows management of the mutation configuration using functional options.
-type notificationOption func(*NotificationMutation)
+// componentOption allows management of the mutation configuration using functional options.
+type componentOption func(*ComponentMutation)
 
-// newNotificationMutation creates new mutation for the Notification entity.
-func newNotificationMutation(c config, op Op, opts ...notificationOption) *NotificationMutation {
-	m := &NotificationMutation{
+// newComponentMutation creates new mutation for the Component entity.
+func newComponentMutation(c config, op Op, opts ...componentOption) *ComponentMutation {
+	m := &ComponentMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeNotification,
+		typ:           TypeComponent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4946,20 +6049,20 @@ func newNotificationMutation(c config, op Op, opts ...notificationOption) *Notif
 	return m
 }
 
-// withNotificationID sets the ID field of the mutation.
-func withNotificationID(id int) notificationOption {
-	return func(m *NotificationMutation) {
+// withComponentID sets the ID field of the mutation.
+func withComponentID(id int) componentOption {
+	return func(m *ComponentMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Notification
+			value *Component
 		)
-		m.oldValue = func(ctx context.Context) (*Notification, error) {
+		m.oldValue = func(ctx context.Context) (*Component, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Notification.Get(ctx, id)
+					value, err = m.Client().Component.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4968,10 +6071,10 @@ func withNotificationID(id int) notificationOption {
 	}
 }
 
-// withNotification sets the old Notification of the mutation.
-func withNotification(node *Notification) notificationOption {
-	return func(m *NotificationMutation) {
-		m.oldValue = func(context.Context) (*Notification, error) {
+// withComponent sets the old Component of the mutation.
+func withComponent(node *Component) componentOption {
+	return func(m *ComponentMutation) {
+		m.oldValue = func(context.Context) (*Component, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4980,7 +6083,7 @@ func withNotification(node *Notification) notificationOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NotificationMutation) Client() *Client {
+func (m ComponentMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4988,7 +6091,7 @@ func (m NotificationMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m NotificationMutation) Tx() (*Tx, error) {
+func (m ComponentMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4999,7 +6102,7 @@ func (m NotificationMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *NotificationMutation) ID() (id int, exists bool) {
+func (m *ComponentMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5010,7 +6113,7 @@ func (m *NotificationMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *NotificationMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ComponentMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5019,351 +6122,297 @@ func (m *NotificationMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Notification.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Component.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *NotificationMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetName sets the "name" field.
+func (m *ComponentMutation) SetName(s string) {
+	m.name = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *NotificationMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Name returns the value of the "name" field in the mutation.
+func (m *ComponentMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Notification entity.
-// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Component entity.
+// If the Component object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ComponentMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Name, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *NotificationMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetName resets all changes to the "name" field.
+func (m *ComponentMutation) ResetName() {
+	m.name = nil
 }
 
-// SetType sets the "type" field.
-func (m *NotificationMutation) SetType(s string) {
-	m._type = &s
+// SetDescription sets the "description" field.
+func (m *ComponentMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *NotificationMutation) GetType() (r string, exists bool) {
-	v := m._type
+// Description returns the value of the "description" field in the mutation.
+func (m *ComponentMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Notification entity.
-// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Component entity.
+// If the Component object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *ComponentMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.Type, nil
-}
-
-// ResetType resets all changes to the "type" field.
-func (m *NotificationMutation) ResetType() {
-	m._type = nil
-}
-
-// SetTitle sets the "title" field.
-func (m *NotificationMutation) SetTitle(s string) {
-	m.title = &s
+	return oldValue.Description, nil
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *NotificationMutation) Title() (r string, exists bool) {
-	v := m.title
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearDescription clears the value of the "description" field.
+func (m *ComponentMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[component.FieldDescription] = struct{}{}
 }
 
-// OldTitle returns the old "title" field's value of the Notification entity.
-// If the Notification object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationMutation) OldTitle(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
-	}
-	return oldValue.Title, nil
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ComponentMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[component.FieldDescription]
+	return ok
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *NotificationMutation) ResetTitle() {
-	m.title = nil
+// ResetDescription resets all changes to the "description" field.
+func (m *ComponentMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, component.FieldDescription)
 }
 
-// SetMessage sets the "message" field.
-func (m *NotificationMutation) SetMessage(s string) {
-	m.message = &s
+// SetImageURL sets the "image_url" field.
+func (m *ComponentMutation) SetImageURL(s string) {
+	m.image_url = &s
 }
 
-// Message returns the value of the "message" field in the mutation.
-func (m *NotificationMutation) Message() (r string, exists bool) {
-	v := m.message
+// ImageURL returns the value of the "image_url" field in the mutation.
+func (m *ComponentMutation) ImageURL() (r string, exists bool) {
+	v := m.image_url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMessage returns the old "message" field's value of the Notification entity.
-// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// OldImageURL returns the old "image_url" field's value of the Component entity.
+// If the Component object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationMutation) OldMessage(ctx context.Context) (v string, err error) {
+func (m *ComponentMutation) OldImageURL(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldImageURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMessage requires an ID field in the mutation")
+		return v, errors.New("OldImageURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldImageURL: %w", err)
 	}
-	return oldValue.Message, nil
+	return oldValue.ImageURL, nil
 }
 
-// ClearMessage clears the value of the "message" field.
-func (m *NotificationMutation) ClearMessage() {
-	m.message = nil
-	m.clearedFields[notification.FieldMessage] = struct{}{}
+// ClearImageURL clears the value of the "image_url" field.
+func (m *ComponentMutation) ClearImageURL() {
+	m.image_url = nil
+	m.clearedFields[component.FieldImageURL] = struct{}{}
 }
 
-// MessageCleared returns if the "message" field was cleared in this mutation.
-func (m *NotificationMutation) MessageCleared() bool {
-	_, ok := m.clearedFields[notification.FieldMessage]
+// ImageURLCleared returns if the "image_url" field was cleared in this mutation.
+func (m *ComponentMutation) ImageURLCleared() bool {
+	_, ok := m.clearedFields[component.FieldImageURL]
 	return ok
 }
 
-// ResetMessage resets all changes to the "message" field.
-func (m *NotificationMutation) ResetMessage() {
-	m.message = nil
-	delete(m.clearedFields, notification.FieldMessage)
+// ResetImageURL resets all changes to the "image_url" field.
+func (m *ComponentMutation) ResetImageURL() {
+	m.image_url = nil
+	delete(m.clearedFields, component.FieldImageURL)
 }
 
-// SetHref sets the "href" field.
-func (m *NotificationMutation) SetHref(s string) {
-	m.href = &s
+// SetColor sets the "color" field.
+func (m *ComponentMutation) SetColor(s string) {
+	m.color = &s
 }
 
-// Href returns the value of the "href" field in the mutation.
-func (m *NotificationMutation) Href() (r string, exists bool) {
-	v := m.href
+// Color returns the value of the "color" field in the mutation.
+func (m *ComponentMutation) Color() (r string, exists bool) {
+	v := m.color
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHref returns the old "href" field's value of the Notification entity.
-// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// OldColor returns the old "color" field's value of the Component entity.
+// If the Component object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationMutation) OldHref(ctx context.Context) (v *string, err error) {
+func (m *ComponentMutation) OldColor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHref is only allowed on UpdateOne operations")
+		return v, errors.New("OldColor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHref requires an ID field in the mutation")
+		return v, errors.New("OldColor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHref: %w", err)
+		return v, fmt.Errorf("querying old value for OldColor: %w", err)
 	}
-	return oldValue.Href, nil
+	return oldValue.Color, nil
 }
 
-// ClearHref clears the value of the "href" field.
-func (m *NotificationMutation) ClearHref() {
-	m.href = nil
-	m.clearedFields[notification.FieldHref] = struct{}{}
+// ClearColor clears the value of the "color" field.
+func (m *ComponentMutation) ClearColor() {
+	m.color = nil
+	m.clearedFields[component.FieldColor] = struct{}{}
 }
 
-// HrefCleared returns if the "href" field was cleared in this mutation.
-func (m *NotificationMutation) HrefCleared() bool {
-	_, ok := m.clearedFields[notification.FieldHref]
+// ColorCleared returns if the "color" field was cleared in this mutation.
+func (m *ComponentMutation) ColorCleared() bool {
+	_, ok := m.clearedFields[component.FieldColor]
 	return ok
 }
 
-// ResetHref resets all changes to the "href" field.
-func (m *NotificationMutation) ResetHref() {
-	m.href = nil
-	delete(m.clearedFields, notification.FieldHref)
+// ResetColor resets all changes to the "color" field.
+func (m *ComponentMutation) ResetColor() {
+	m.color = nil
+	delete(m.clearedFields, component.FieldColor)
 }
 
-// SetRead sets the "read" field.
-func (m *NotificationMutation) SetRead(b bool) {
-	m.read = &b
+// SetVoteID sets the "vote" edge to the Vote entity by id.
+func (m *ComponentMutation) SetVoteID(id int) {
+	m.vote = &id
 }
 
-// Read returns the value of the "read" field in the mutation.
-func (m *NotificationMutation) Read() (r bool, exists bool) {
-	v := m.read
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearVote clears the "vote" edge to the Vote entity.
+func (m *ComponentMutation) ClearVote() {
+	m.clearedvote = true
 }
 
-// OldRead returns the old "read" field's value of the Notification entity.
-// If the Notification object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationMutation) OldRead(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRead is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRead requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRead: %w", err)
+// VoteCleared reports if the "vote" edge to the Vote entity was cleared.
+func (m *ComponentMutation) VoteCleared() bool {
+	return m.clearedvote
+}
+
+// VoteID returns the "vote" edge ID in the mutation.
+func (m *ComponentMutation) VoteID() (id int, exists bool) {
+	if m.vote != nil {
+		return *m.vote, true
 	}
-	return oldValue.Read, nil
+	return
 }
 
-// ResetRead resets all changes to the "read" field.
-func (m *NotificationMutation) ResetRead() {
-	m.read = nil
+// VoteIDs returns the "vote" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// VoteID instead. It exists only for internal usage by the builders.
+func (m *ComponentMutation) VoteIDs() (ids []int) {
+	if id := m.vote; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// SetReadAt sets the "read_at" field.
-func (m *NotificationMutation) SetReadAt(t time.Time) {
-	m.read_at = &t
+// ResetVote resets all changes to the "vote" edge.
+func (m *ComponentMutation) ResetVote() {
+	m.vote = nil
+	m.clearedvote = false
 }
 
-// ReadAt returns the value of the "read_at" field in the mutation.
-func (m *NotificationMutation) ReadAt() (r time.Time, exists bool) {
-	v := m.read_at
-	if v == nil {
-		return
+// AddUserVoteIDs adds the "user_votes" edge to the UserVote entity by ids.
+func (m *ComponentMutation) AddUserVoteIDs(ids ...int) {
+	if m.user_votes == nil {
+		m.user_votes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.user_votes[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldReadAt returns the old "read_at" field's value of the Notification entity.
-// If the Notification object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationMutation) OldReadAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReadAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReadAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReadAt: %w", err)
-	}
-	return oldValue.ReadAt, nil
-}
-
-// ClearReadAt clears the value of the "read_at" field.
-func (m *NotificationMutation) ClearReadAt() {
-	m.read_at = nil
-	m.clearedFields[notification.FieldReadAt] = struct{}{}
-}
-
-// ReadAtCleared returns if the "read_at" field was cleared in this mutation.
-func (m *NotificationMutation) ReadAtCleared() bool {
-	_, ok := m.clearedFields[notification.FieldReadAt]
-	return ok
-}
-
-// ResetReadAt resets all changes to the "read_at" field.
-func (m *NotificationMutation) ResetReadAt() {
-	m.read_at = nil
-	delete(m.clearedFields, notification.FieldReadAt)
-}
-
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *NotificationMutation) SetUserID(id int) {
-	m.user = &id
+// ClearUserVotes clears the "user_votes" edge to the UserVote entity.
+func (m *ComponentMutation) ClearUserVotes() {
+	m.cleareduser_votes = true
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *NotificationMutation) ClearUser() {
-	m.cleareduser = true
+// UserVotesCleared reports if the "user_votes" edge to the UserVote entity was cleared.
+func (m *ComponentMutation) UserVotesCleared() bool {
+	return m.cleareduser_votes
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *NotificationMutation) UserCleared() bool {
-	return m.cleareduser
+// RemoveUserVoteIDs removes the "user_votes" edge to the UserVote entity by IDs.
+func (m *ComponentMutation) RemoveUserVoteIDs(ids ...int) {
+	if m.removeduser_votes == nil {
+		m.removeduser_votes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.user_votes, ids[i])
+		m.removeduser_votes[ids[i]] = struct{}{}
+	}
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *NotificationMutation) UserID() (id int, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// RemovedUserVotes returns the removed IDs of the "user_votes" edge to the UserVote entity.
+func (m *ComponentMutation) RemovedUserVotesIDs() (ids []int) {
+	for id := range m.removeduser_votes {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *NotificationMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// UserVotesIDs returns the "user_votes" edge IDs in the mutation.
+func (m *ComponentMutation) UserVotesIDs() (ids []int) {
+	for id := range m.user_votes {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *NotificationMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetUserVotes resets all changes to the "user_votes" edge.
+func (m *ComponentMutation) ResetUserVotes() {
+	m.user_votes = nil
+	m.cleareduser_votes = false
+	m.removeduser_votes = nil
 }
 
-// Where appends a list predicates to the NotificationMutation builder.
-func (m *NotificationMutation) Where(ps ...predicate.Notification) {
+// Where appends a list predicates to the ComponentMutation builder.
+func (m *ComponentMutation) Where(ps ...predicate.Component) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the NotificationMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ComponentMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *NotificationMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Notification, len(ps))
+func (m *ComponentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Component, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5371,45 +6420,36 @@ func (m *NotificationMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *NotificationMutation) Op() Op {
+func (m *ComponentMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *NotificationMutation) SetOp(op Op) {
+func (m *ComponentMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Notification).
-func (m *NotificationMutation) Type() string {
+// Type returns the node type of this mutation (Component).
+func (m *ComponentMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *NotificationMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.created_at != nil {
-		fields = append(fields, notification.FieldCreatedAt)
-	}
-	if m._type != nil {
-		fields = append(fields, notification.FieldType)
-	}
-	if m.title != nil {
-		fields = append(fields, notification.FieldTitle)
-	}
-	if m.message != nil {
-		fields = append(fields, notification.FieldMessage)
+func (m *ComponentMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, component.FieldName)
 	}
-	if m.href != nil {
-		fields = append(fields, notification.FieldHref)
+	if m.description != nil {
+		fields = append(fields, component.FieldDescription)
 	}
-	if m.read != nil {
-		fields = append(fields, notification.FieldRead)
+	if m.image_url != nil {
+		fields = append(fields, component.FieldImageURL)
 	}
-	if m.read_at != nil {
-		fields = append(fields, notification.FieldReadAt)
+	if m.color != nil {
+		fields = append(fields, component.FieldColor)
 	}
 	return fields
 }
@@ -5417,22 +6457,16 @@ func (m *NotificationMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *NotificationMutation) Field(name string) (ent.Value, bool) {
+func (m *ComponentMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case notification.FieldCreatedAt:
-		return m.CreatedAt()
-	case notification.FieldType:
-		return m.GetType()
-	case notification.FieldTitle:
-		return m.Title()
-	case notification.FieldMessage:
-		return m.Message()
-	case notification.FieldHref:
-		return m.Href()
-	case notification.FieldRead:
-		return m.Read()
-	case notification.FieldReadAt:
-		return m.ReadAt()
+	case component.FieldName:
+		return m.Name()
+	case component.FieldDescription:
+		return m.Description()
+	case component.FieldImageURL:
+		return m.ImageURL()
+	case component.FieldColor:
+		return m.Color()
 	}
 	return nil, false
 }
@@ -5440,284 +6474,273 @@ func (m *NotificationMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *NotificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ComponentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case notification.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case notification.FieldType:
-		return m.OldType(ctx)
-	case notification.FieldTitle:
-		return m.OldTitle(ctx)
-	case notification.FieldMessage:
-		return m.OldMessage(ctx)
-	case notification.FieldHref:
-		return m.OldHref(ctx)
-	case notification.FieldRead:
-		return m.OldRead(ctx)
-	case notification.FieldReadAt:
-		return m.OldReadAt(ctx)
+	case component.FieldName:
+		return m.OldName(ctx)
+	case component.FieldDescription:
+		return m.OldDescription(ctx)
+	case component.FieldImageURL:
+		return m.OldImageURL(ctx)
+	case component.FieldColor:
+		return m.OldColor(ctx)
 	}
-	return nil, fmt.Errorf("unknown Notification field %s", name)
+	return nil, fmt.Errorf("unknown Component field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NotificationMutation) SetField(name string, value ent.Value) error {
+func (m *ComponentMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case notification.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case notification.FieldType:
+	case component.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetName(v)
 		return nil
-	case notification.FieldTitle:
+	case component.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetDescription(v)
 		return nil
-	case notification.FieldMessage:
+	case component.FieldImageURL:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMessage(v)
+		m.SetImageURL(v)
 		return nil
-	case notification.FieldHref:
+	case component.FieldColor:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHref(v)
-		return nil
-	case notification.FieldRead:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRead(v)
-		return nil
-	case notification.FieldReadAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetReadAt(v)
+		m.SetColor(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Notification field %s", name)
+	return fmt.Errorf("unknown Component field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *NotificationMutation) AddedFields() []string {
+func (m *ComponentMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *NotificationMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ComponentMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NotificationMutation) AddField(name string, value ent.Value) error {
+func (m *ComponentMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Notification numeric field %s", name)
+	return fmt.Errorf("unknown Component numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *NotificationMutation) ClearedFields() []string {
+func (m *ComponentMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(notification.FieldMessage) {
-		fields = append(fields, notification.FieldMessage)
+	if m.FieldCleared(component.FieldDescription) {
+		fields = append(fields, component.FieldDescription)
 	}
-	if m.FieldCleared(notification.FieldHref) {
-		fields = append(fields, notification.FieldHref)
+	if m.FieldCleared(component.FieldImageURL) {
+		fields = append(fields, component.FieldImageURL)
 	}
-	if m.FieldCleared(notification.FieldReadAt) {
-		fields = append(fields, notification.FieldReadAt)
+	if m.FieldCleared(component.FieldColor) {
+		fields = append(fields, component.FieldColor)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *NotificationMutation) FieldCleared(name string) bool {
+func (m *ComponentMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *NotificationMutation) ClearField(name string) error {
+func (m *ComponentMutation) ClearField(name string) error {
 	switch name {
-	case notification.FieldMessage:
-		m.ClearMessage()
-		return nil
-	case notification.FieldHref:
-		m.ClearHref()
+	case component.FieldDescription:
+		m.ClearDescription()
 		return nil
-	case notification.FieldReadAt:
-		m.ClearReadAt()
+	case component.FieldImageURL:
+		m.ClearImageURL()
+		return nil
+	case component.FieldColor:
+		m.ClearColor()
 		return nil
 	}
-	return fmt.Errorf("unknown Notification nullable field %s", name)
+	return fmt.Errorf("unknown Component nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *NotificationMutation) ResetField(name string) error {
+func (m *ComponentMutation) ResetField(name string) error {
 	switch name {
-	case notification.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case notification.FieldType:
-		m.ResetType()
-		return nil
-	case notification.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case notification.FieldMessage:
-		m.ResetMessage()
+	case component.FieldName:
+		m.ResetName()
 		return nil
-	case notification.FieldHref:
-		m.ResetHref()
+	case component.FieldDescription:
+		m.ResetDescription()
 		return nil
-	case notification.FieldRead:
-		m.ResetRead()
+	case component.FieldImageURL:
+		m.ResetImageURL()
 		return nil
-	case notification.FieldReadAt:
-		m.ResetReadAt()
+	case component.FieldColor:
+		m.ResetColor()
 		return nil
 	}
-	return fmt.Errorf("unknown Notification field %s", name)
+	return fmt.Errorf("unknown Component field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NotificationMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.user != nil {
-		edges = append(edges, notification.EdgeUser)
+func (m *ComponentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.vote != nil {
+		edges = append(edges, component.EdgeVote)
+	}
+	if m.user_votes != nil {
+		edges = append(edges, component.EdgeUserVotes)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *NotificationMutation) AddedIDs(name string) []ent.Value {
+func (m *ComponentMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case notification.EdgeUser:
-		if id := m.user; id != nil {
+	case component.EdgeVote:
+		if id := m.vote; id != nil {
 			return []ent.Value{*id}
 		}
+	case component.EdgeUserVotes:
+		ids := make([]ent.Value, 0, len(m.user_votes))
+		for id := range m.user_votes {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NotificationMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *ComponentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeduser_votes != nil {
+		edges = append(edges, component.EdgeUserVotes)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *NotificationMutation) RemovedIDs(name string) []ent.Value {
+func (m *ComponentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case component.EdgeUserVotes:
+		ids := make([]ent.Value, 0, len(m.removeduser_votes))
+		for id := range m.removeduser_votes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NotificationMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareduser {
-		edges = append(edges, notification.EdgeUser)
+func (m *ComponentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedvote {
+		edges = append(edges, component.EdgeVote)
+	}
+	if m.cleareduser_votes {
+		edges = append(edges, component.EdgeUserVotes)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *NotificationMutation) EdgeCleared(name string) bool {
+func (m *ComponentMutation) EdgeCleared(name string) bool {
 	switch name {
-	case notification.EdgeUser:
-		return m.cleareduser
+	case component.EdgeVote:
+		return m.clearedvote
+	case component.EdgeUserVotes:
+		return m.cleareduser_votes
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *NotificationMutation) ClearEdge(name string) error {
+func (m *ComponentMutation) ClearEdge(name string) error {
 	switch name {
-	case notification.EdgeUser:
-		m.ClearUser()
+	case component.EdgeVote:
+		m.ClearVote()
 		return nil
 	}
-	return fmt.Errorf("unknown Notification unique edge %s", name)
+	return fmt.Errorf("unknown Component unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *NotificationMutation) ResetEdge(name string) error {
+func (m *ComponentMutation) ResetEdge(name string) error {
 	switch name {
-	case notification.EdgeUser:
-		m.ResetUser()
+	case component.EdgeVote:
+		m.ResetVote()
+		return nil
+	case component.EdgeUserVotes:
+		m.ResetUserVotes()
 		return nil
 	}
-	return fmt.Errorf("unknown Notification edge %s", name)
+	return fmt.Errorf("unknown Component edge %s", name)
 }
 
-// RankGroupMutation represents an operation that mutates the RankGroup nodes in the graph.
-type RankGroupMutation struct {
+// ConsentMutation represents an operation that mutates the Consent nodes in the graph.
+type ConsentMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int
-	name              *string
-	rank_min          *int
-	addrank_min       *int
-	rank_max          *int
-	addrank_max       *int
-	position          *int
-	addposition       *int
-	clearedFields     map[string]struct{}
-	tournament        *int
-	clearedtournament bool
-	teams             map[int]struct{}
-	removedteams      map[int]struct{}
-	clearedteams      bool
-	done              bool
-	oldValue          func(context.Context) (*RankGroup, error)
-	predicates        []predicate.RankGroup
+	op                 Op
+	typ                string
+	id                 *int
+	created_at         *time.Time
+	updated_at         *time.Time
+	scopes             *[]string
+	appendscopes       []string
+	expiration_date    *time.Time
+	clearedFields      map[string]struct{}
+	application        *string
+	clearedapplication bool
+	user               *int
+	cleareduser        bool
+	done               bool
+	oldValue           func(context.Context) (*Consent, error)
+	predicates         []predicate.Consent
 }
 
-var _ ent.Mutation = (*RankGroupMutation)(nil)
+var _ ent.Mutation = (*ConsentMutation)(nil)
 
-// rankgroupOption allows management of the mutation configuration using functional options.
-type rankgroupOption func(*RankGroupMutation)
+// consentOption allows management of the mutation configuration using functional options.
+type consentOption func(*ConsentMutation)
 
-// newRankGroupMutation creates new mutation for the RankGroup entity.
-func newRankGroupMutation(c config, op Op, opts ...rankgroupOption) *RankGroupMutation {
-	m := &RankGroupMutation{
+// newConsentMutation creates new mutation for the Consent entity.
+func newConsentMutation(c config, op Op, opts ...consentOption) *ConsentMutation {
+	m := &ConsentMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRankGroup,
+		typ:           TypeConsent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5726,20 +6749,20 @@ func newRankGroupMutation(c config, op Op, opts ...rankgroupOption) *RankGroupMu
 	return m
 }
 
-// withRankGroupID sets the ID field of the mutation.
-func withRankGroupID(id int) rankgroupOption {
-	return func(m *RankGroupMutation) {
+// withConsentID sets the ID field of the mutation.
+func withConsentID(id int) consentOption {
+	return func(m *ConsentMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *RankGroup
+			value *Consent
 		)
-		m.oldValue = func(ctx context.Context) (*RankGroup, error) {
+		m.oldValue = func(ctx context.Context) (*Consent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().RankGroup.Get(ctx, id)
+					value, err = m.Client().Consent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5748,10 +6771,10 @@ func withRankGroupID(id int) rankgroupOption {
 	}
 }
 
-// withRankGroup sets the old RankGroup of the mutation.
-func withRankGroup(node *RankGroup) rankgroupOption {
-	return func(m *RankGroupMutation) {
-		m.oldValue = func(context.Context) (*RankGroup, error) {
+// withConsent sets the old Consent of the mutation.
+func withConsent(node *Consent) consentOption {
+	return func(m *ConsentMutation) {
+		m.oldValue = func(context.Context) (*Consent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5760,7 +6783,7 @@ func withRankGroup(node *RankGroup) rankgroupOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RankGroupMutation) Client() *Client {
+func (m ConsentMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5768,7 +6791,7 @@ func (m RankGroupMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RankGroupMutation) Tx() (*Tx, error) {
+func (m ConsentMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5777,9 +6800,15 @@ func (m RankGroupMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Consent entities.
+func (m *ConsentMutation) SetID(id int) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RankGroupMutation) ID() (id int, exists bool) {
+func (m *ConsentMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5790,7 +6819,7 @@ func (m *RankGroupMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RankGroupMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ConsentMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5799,318 +6828,306 @@ func (m *RankGroupMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().RankGroup.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Consent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *RankGroupMutation) SetName(s string) {
-	m.name = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *ConsentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *RankGroupMutation) Name() (r string, exists bool) {
-	v := m.name
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ConsentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the RankGroup entity.
-// If the RankGroup object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Consent entity.
+// If the Consent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RankGroupMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *ConsentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *RankGroupMutation) ResetName() {
-	m.name = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ConsentMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetRankMin sets the "rank_min" field.
-func (m *RankGroupMutation) SetRankMin(i int) {
-	m.rank_min = &i
-	m.addrank_min = nil
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ConsentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// RankMin returns the value of the "rank_min" field in the mutation.
-func (m *RankGroupMutation) RankMin() (r int, exists bool) {
-	v := m.rank_min
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ConsentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRankMin returns the old "rank_min" field's value of the RankGroup entity.
-// If the RankGroup object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Consent entity.
+// If the Consent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RankGroupMutation) OldRankMin(ctx context.Context) (v int, err error) {
+func (m *ConsentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRankMin is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRankMin requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRankMin: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.RankMin, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// AddRankMin adds i to the "rank_min" field.
-func (m *RankGroupMutation) AddRankMin(i int) {
-	if m.addrank_min != nil {
-		*m.addrank_min += i
-	} else {
-		m.addrank_min = &i
-	}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ConsentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// AddedRankMin returns the value that was added to the "rank_min" field in this mutation.
-func (m *RankGroupMutation) AddedRankMin() (r int, exists bool) {
-	v := m.addrank_min
+// SetApplicationID sets the "application_id" field.
+func (m *ConsentMutation) SetApplicationID(s string) {
+	m.application = &s
+}
+
+// ApplicationID returns the value of the "application_id" field in the mutation.
+func (m *ConsentMutation) ApplicationID() (r string, exists bool) {
+	v := m.application
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetRankMin resets all changes to the "rank_min" field.
-func (m *RankGroupMutation) ResetRankMin() {
-	m.rank_min = nil
-	m.addrank_min = nil
+// OldApplicationID returns the old "application_id" field's value of the Consent entity.
+// If the Consent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConsentMutation) OldApplicationID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApplicationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApplicationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApplicationID: %w", err)
+	}
+	return oldValue.ApplicationID, nil
 }
 
-// SetRankMax sets the "rank_max" field.
-func (m *RankGroupMutation) SetRankMax(i int) {
-	m.rank_max = &i
-	m.addrank_max = nil
+// ResetApplicationID resets all changes to the "application_id" field.
+func (m *ConsentMutation) ResetApplicationID() {
+	m.application = nil
 }
 
-// RankMax returns the value of the "rank_max" field in the mutation.
-func (m *RankGroupMutation) RankMax() (r int, exists bool) {
-	v := m.rank_max
+// SetUserID sets the "user_id" field.
+func (m *ConsentMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ConsentMutation) UserID() (r int, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRankMax returns the old "rank_max" field's value of the RankGroup entity.
-// If the RankGroup object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the Consent entity.
+// If the Consent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RankGroupMutation) OldRankMax(ctx context.Context) (v int, err error) {
+func (m *ConsentMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRankMax is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRankMax requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRankMax: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.RankMax, nil
+	return oldValue.UserID, nil
 }
 
-// AddRankMax adds i to the "rank_max" field.
-func (m *RankGroupMutation) AddRankMax(i int) {
-	if m.addrank_max != nil {
-		*m.addrank_max += i
-	} else {
-		m.addrank_max = &i
-	}
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ConsentMutation) ResetUserID() {
+	m.user = nil
 }
 
-// AddedRankMax returns the value that was added to the "rank_max" field in this mutation.
-func (m *RankGroupMutation) AddedRankMax() (r int, exists bool) {
-	v := m.addrank_max
+// SetScopes sets the "scopes" field.
+func (m *ConsentMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
+}
+
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *ConsentMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetRankMax resets all changes to the "rank_max" field.
-func (m *RankGroupMutation) ResetRankMax() {
-	m.rank_max = nil
-	m.addrank_max = nil
+// OldScopes returns the old "scopes" field's value of the Consent entity.
+// If the Consent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConsentMutation) OldScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	}
+	return oldValue.Scopes, nil
 }
 
-// SetPosition sets the "position" field.
-func (m *RankGroupMutation) SetPosition(i int) {
-	m.position = &i
-	m.addposition = nil
+// AppendScopes adds s to the "scopes" field.
+func (m *ConsentMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
 }
 
-// Position returns the value of the "position" field in the mutation.
-func (m *RankGroupMutation) Position() (r int, exists bool) {
-	v := m.position
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *ConsentMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
+}
+
+// ResetScopes resets all changes to the "scopes" field.
+func (m *ConsentMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+}
+
+// SetExpirationDate sets the "expiration_date" field.
+func (m *ConsentMutation) SetExpirationDate(t time.Time) {
+	m.expiration_date = &t
+}
+
+// ExpirationDate returns the value of the "expiration_date" field in the mutation.
+func (m *ConsentMutation) ExpirationDate() (r time.Time, exists bool) {
+	v := m.expiration_date
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPosition returns the old "position" field's value of the RankGroup entity.
-// If the RankGroup object wasn't provided to the builder, the object is fetched from the database.
+// OldExpirationDate returns the old "expiration_date" field's value of the Consent entity.
+// If the Consent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RankGroupMutation) OldPosition(ctx context.Context) (v int, err error) {
+func (m *ConsentMutation) OldExpirationDate(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpirationDate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPosition requires an ID field in the mutation")
+		return v, errors.New("OldExpirationDate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpirationDate: %w", err)
 	}
-	return oldValue.Position, nil
+	return oldValue.ExpirationDate, nil
 }
 
-// AddPosition adds i to the "position" field.
-func (m *RankGroupMutation) AddPosition(i int) {
-	if m.addposition != nil {
-		*m.addposition += i
-	} else {
-		m.addposition = &i
-	}
+// ResetExpirationDate resets all changes to the "expiration_date" field.
+func (m *ConsentMutation) ResetExpirationDate() {
+	m.expiration_date = nil
 }
 
-// AddedPosition returns the value that was added to the "position" field in this mutation.
-func (m *RankGroupMutation) AddedPosition() (r int, exists bool) {
-	v := m.addposition
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearApplication clears the "application" edge to the App entity.
+func (m *ConsentMutation) ClearApplication() {
+	m.clearedapplication = true
+	m.clearedFields[consent.FieldApplicationID] = struct{}{}
 }
 
-// ResetPosition resets all changes to the "position" field.
-func (m *RankGroupMutation) ResetPosition() {
-	m.position = nil
-	m.addposition = nil
+// ApplicationCleared reports if the "application" edge to the App entity was cleared.
+func (m *ConsentMutation) ApplicationCleared() bool {
+	return m.clearedapplication
 }
 
-// SetTournamentID sets the "tournament" edge to the Tournament entity by id.
-func (m *RankGroupMutation) SetTournamentID(id int) {
-	m.tournament = &id
+// ApplicationIDs returns the "application" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ApplicationID instead. It exists only for internal usage by the builders.
+func (m *ConsentMutation) ApplicationIDs() (ids []string) {
+	if id := m.application; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// ClearTournament clears the "tournament" edge to the Tournament entity.
-func (m *RankGroupMutation) ClearTournament() {
-	m.clearedtournament = true
+// ResetApplication resets all changes to the "application" edge.
+func (m *ConsentMutation) ResetApplication() {
+	m.application = nil
+	m.clearedapplication = false
 }
 
-// TournamentCleared reports if the "tournament" edge to the Tournament entity was cleared.
-func (m *RankGroupMutation) TournamentCleared() bool {
-	return m.clearedtournament
+// ClearUser clears the "user" edge to the User entity.
+func (m *ConsentMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[consent.FieldUserID] = struct{}{}
 }
 
-// TournamentID returns the "tournament" edge ID in the mutation.
-func (m *RankGroupMutation) TournamentID() (id int, exists bool) {
-	if m.tournament != nil {
-		return *m.tournament, true
-	}
-	return
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ConsentMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// TournamentIDs returns the "tournament" edge IDs in the mutation.
+// UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TournamentID instead. It exists only for internal usage by the builders.
-func (m *RankGroupMutation) TournamentIDs() (ids []int) {
-	if id := m.tournament; id != nil {
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ConsentMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTournament resets all changes to the "tournament" edge.
-func (m *RankGroupMutation) ResetTournament() {
-	m.tournament = nil
-	m.clearedtournament = false
+// ResetUser resets all changes to the "user" edge.
+func (m *ConsentMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// AddTeamIDs adds the "teams" edge to the Team entity by ids.
-func (m *RankGroupMutation) AddTeamIDs(ids ...int) {
-	if m.teams == nil {
-		m.teams = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.teams[ids[i]] = struct{}{}
-	}
+// Where appends a list predicates to the ConsentMutation builder.
+func (m *ConsentMutation) Where(ps ...predicate.Consent) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ClearTeams clears the "teams" edge to the Team entity.
-func (m *RankGroupMutation) ClearTeams() {
-	m.clearedteams = true
-}
-
-// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
-func (m *RankGroupMutation) TeamsCleared() bool {
-	return m.clearedteams
-}
-
-// RemoveTeamIDs removes the "teams" edge to the Team entity by IDs.
-func (m *RankGroupMutation) RemoveTeamIDs(ids ...int) {
-	if m.removedteams == nil {
-		m.removedteams = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.teams, ids[i])
-		m.removedteams[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedTeams returns the removed IDs of the "teams" edge to the Team entity.
-func (m *RankGroupMutation) RemovedTeamsIDs() (ids []int) {
-	for id := range m.removedteams {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// TeamsIDs returns the "teams" edge IDs in the mutation.
-func (m *RankGroupMutation) TeamsIDs() (ids []int) {
-	for id := range m.teams {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetTeams resets all changes to the "teams" edge.
-func (m *RankGroupMutation) ResetTeams() {
-	m.teams = nil
-	m.clearedteams = false
-	m.removedteams = nil
-}
-
-// Where appends a list predicates to the RankGroupMutation builder.
-func (m *RankGroupMutation) Where(ps ...predicate.RankGroup) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the RankGroupMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ConsentMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RankGroupMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.RankGroup, len(ps))
+func (m *ConsentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Consent, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6118,36 +7135,42 @@ func (m *RankGroupMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RankGroupMutation) Op() Op {
+func (m *ConsentMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RankGroupMutation) SetOp(op Op) {
+func (m *ConsentMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (RankGroup).
-func (m *RankGroupMutation) Type() string {
+// Type returns the node type of this mutation (Consent).
+func (m *ConsentMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RankGroupMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.name != nil {
-		fields = append(fields, rankgroup.FieldName)
+func (m *ConsentMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.created_at != nil {
+		fields = append(fields, consent.FieldCreatedAt)
 	}
-	if m.rank_min != nil {
-		fields = append(fields, rankgroup.FieldRankMin)
+	if m.updated_at != nil {
+		fields = append(fields, consent.FieldUpdatedAt)
 	}
-	if m.rank_max != nil {
-		fields = append(fields, rankgroup.FieldRankMax)
+	if m.application != nil {
+		fields = append(fields, consent.FieldApplicationID)
 	}
-	if m.position != nil {
-		fields = append(fields, rankgroup.FieldPosition)
+	if m.user != nil {
+		fields = append(fields, consent.FieldUserID)
+	}
+	if m.scopes != nil {
+		fields = append(fields, consent.FieldScopes)
+	}
+	if m.expiration_date != nil {
+		fields = append(fields, consent.FieldExpirationDate)
 	}
 	return fields
 }
@@ -6155,16 +7178,20 @@ func (m *RankGroupMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RankGroupMutation) Field(name string) (ent.Value, bool) {
+func (m *ConsentMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case rankgroup.FieldName:
-		return m.Name()
-	case rankgroup.FieldRankMin:
-		return m.RankMin()
-	case rankgroup.FieldRankMax:
-		return m.RankMax()
-	case rankgroup.FieldPosition:
-		return m.Position()
+	case consent.FieldCreatedAt:
+		return m.CreatedAt()
+	case consent.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case consent.FieldApplicationID:
+		return m.ApplicationID()
+	case consent.FieldUserID:
+		return m.UserID()
+	case consent.FieldScopes:
+		return m.Scopes()
+	case consent.FieldExpirationDate:
+		return m.ExpirationDate()
 	}
 	return nil, false
 }
@@ -6172,84 +7199,87 @@ func (m *RankGroupMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RankGroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ConsentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case rankgroup.FieldName:
-		return m.OldName(ctx)
-	case rankgroup.FieldRankMin:
-		return m.OldRankMin(ctx)
-	case rankgroup.FieldRankMax:
-		return m.OldRankMax(ctx)
-	case rankgroup.FieldPosition:
-		return m.OldPosition(ctx)
+	case consent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case consent.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case consent.FieldApplicationID:
+		return m.OldApplicationID(ctx)
+	case consent.FieldUserID:
+		return m.OldUserID(ctx)
+	case consent.FieldScopes:
+		return m.OldScopes(ctx)
+	case consent.FieldExpirationDate:
+		return m.OldExpirationDate(ctx)
 	}
-	return nil, fmt.Errorf("unknown RankGroup field %s", name)
+	return nil, fmt.Errorf("unknown Consent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RankGroupMutation) SetField(name string, value ent.Value) error {
+func (m *ConsentMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case rankgroup.FieldName:
+	case consent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case consent.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case consent.FieldApplicationID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetApplicationID(v)
 		return nil
-	case rankgroup.FieldRankMin:
+	case consent.FieldUserID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRankMin(v)
+		m.SetUserID(v)
 		return nil
-	case rankgroup.FieldRankMax:
-		v, ok := value.(int)
+	case consent.FieldScopes:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRankMax(v)
+		m.SetScopes(v)
 		return nil
-	case rankgroup.FieldPosition:
-		v, ok := value.(int)
+	case consent.FieldExpirationDate:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPosition(v)
+		m.SetExpirationDate(v)
 		return nil
 	}
-	return fmt.Errorf("unknown RankGroup field %s", name)
+	return fmt.Errorf("unknown Consent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RankGroupMutation) AddedFields() []string {
+func (m *ConsentMutation) AddedFields() []string {
 	var fields []string
-	if m.addrank_min != nil {
-		fields = append(fields, rankgroup.FieldRankMin)
-	}
-	if m.addrank_max != nil {
-		fields = append(fields, rankgroup.FieldRankMax)
-	}
-	if m.addposition != nil {
-		fields = append(fields, rankgroup.FieldPosition)
-	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RankGroupMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ConsentMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case rankgroup.FieldRankMin:
-		return m.AddedRankMin()
-	case rankgroup.FieldRankMax:
-		return m.AddedRankMax()
-	case rankgroup.FieldPosition:
-		return m.AddedPosition()
 	}
 	return nil, false
 }
@@ -6257,222 +7287,184 @@ func (m *RankGroupMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RankGroupMutation) AddField(name string, value ent.Value) error {
+func (m *ConsentMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case rankgroup.FieldRankMin:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddRankMin(v)
-		return nil
-	case rankgroup.FieldRankMax:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddRankMax(v)
-		return nil
-	case rankgroup.FieldPosition:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPosition(v)
-		return nil
 	}
-	return fmt.Errorf("unknown RankGroup numeric field %s", name)
+	return fmt.Errorf("unknown Consent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RankGroupMutation) ClearedFields() []string {
+func (m *ConsentMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RankGroupMutation) FieldCleared(name string) bool {
+func (m *ConsentMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RankGroupMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown RankGroup nullable field %s", name)
+func (m *ConsentMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Consent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RankGroupMutation) ResetField(name string) error {
+func (m *ConsentMutation) ResetField(name string) error {
 	switch name {
-	case rankgroup.FieldName:
-		m.ResetName()
+	case consent.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case rankgroup.FieldRankMin:
-		m.ResetRankMin()
+	case consent.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
-	case rankgroup.FieldRankMax:
-		m.ResetRankMax()
+	case consent.FieldApplicationID:
+		m.ResetApplicationID()
 		return nil
-	case rankgroup.FieldPosition:
-		m.ResetPosition()
+	case consent.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case consent.FieldScopes:
+		m.ResetScopes()
+		return nil
+	case consent.FieldExpirationDate:
+		m.ResetExpirationDate()
 		return nil
 	}
-	return fmt.Errorf("unknown RankGroup field %s", name)
+	return fmt.Errorf("unknown Consent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RankGroupMutation) AddedEdges() []string {
+func (m *ConsentMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.tournament != nil {
-		edges = append(edges, rankgroup.EdgeTournament)
-	}
-	if m.teams != nil {
-		edges = append(edges, rankgroup.EdgeTeams)
+	if m.application != nil {
+		edges = append(edges, consent.EdgeApplication)
+	}
+	if m.user != nil {
+		edges = append(edges, consent.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RankGroupMutation) AddedIDs(name string) []ent.Value {
+func (m *ConsentMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case rankgroup.EdgeTournament:
-		if id := m.tournament; id != nil {
+	case consent.EdgeApplication:
+		if id := m.application; id != nil {
 			return []ent.Value{*id}
 		}
-	case rankgroup.EdgeTeams:
-		ids := make([]ent.Value, 0, len(m.teams))
-		for id := range m.teams {
-			ids = append(ids, id)
+	case consent.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RankGroupMutation) RemovedEdges() []string {
+func (m *ConsentMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.removedteams != nil {
-		edges = append(edges, rankgroup.EdgeTeams)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RankGroupMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case rankgroup.EdgeTeams:
-		ids := make([]ent.Value, 0, len(m.removedteams))
-		for id := range m.removedteams {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ConsentMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RankGroupMutation) ClearedEdges() []string {
+func (m *ConsentMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.clearedtournament {
-		edges = append(edges, rankgroup.EdgeTournament)
+	if m.clearedapplication {
+		edges = append(edges, consent.EdgeApplication)
 	}
-	if m.clearedteams {
-		edges = append(edges, rankgroup.EdgeTeams)
+	if m.cleareduser {
+		edges = append(edges, consent.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RankGroupMutation) EdgeCleared(name string) bool {
+func (m *ConsentMutation) EdgeCleared(name string) bool {
 	switch name {
-	case rankgroup.EdgeTournament:
-		return m.clearedtournament
-	case rankgroup.EdgeTeams:
-		return m.clearedteams
+	case consent.EdgeApplication:
+		return m.clearedapplication
+	case consent.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RankGroupMutation) ClearEdge(name string) error {
+func (m *ConsentMutation) ClearEdge(name string) error {
 	switch name {
-	case rankgroup.EdgeTournament:
-		m.ClearTournament()
+	case consent.EdgeApplication:
+		m.ClearApplication()
+		return nil
+	case consent.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown RankGroup unique edge %s", name)
+	return fmt.Errorf("unknown Consent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RankGroupMutation) ResetEdge(name string) error {
+func (m *ConsentMutation) ResetEdge(name string) error {
 	switch name {
-	case rankgroup.EdgeTournament:
-		m.ResetTournament()
+	case consent.EdgeApplication:
+		m.ResetApplication()
 		return nil
-	case rankgroup.EdgeTeams:
-		m.ResetTeams()
+	case consent.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown RankGroup edge %s", name)
+	return fmt.Errorf("unknown Consent edge %s", name)
 }
 
-// TeamMutation represents an operation that mutates the Team nodes in the graph.
-type TeamMutation struct {
+// DeviceAuthorizationMutation represents an operation that mutates the DeviceAuthorization nodes in the graph.
+type DeviceAuthorizationMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *int
-	name                 *string
-	image_url            *string
-	is_locked            *bool
-	is_registered        *bool
-	is_waitlisted        *bool
-	waitlist_position    *int
-	addwaitlist_position *int
-	score                *int
-	addscore             *int
-	elo                  *int
-	addelo               *int
-	created_at           *time.Time
-	updated_at           *time.Time
-	clearedFields        map[string]struct{}
-	tournament           *int
-	clearedtournament    bool
-	creator              *int
-	clearedcreator       bool
-	members              map[int]struct{}
-	removedmembers       map[int]struct{}
-	clearedmembers       bool
-	rank_group           *int
-	clearedrank_group    bool
-	invitations          map[int]struct{}
-	removedinvitations   map[int]struct{}
-	clearedinvitations   bool
-	done                 bool
-	oldValue             func(context.Context) (*Team, error)
-	predicates           []predicate.Team
+	op             Op
+	typ            string
+	id             *string
+	user_code      *string
+	application_id *string
+	scopes         *[]string
+	appendscopes   []string
+	_done          *bool
+	denied         *bool
+	subject        *string
+	auth_time      *time.Time
+	amr            *[]string
+	appendamr      []string
+	created_at     *time.Time
+	expiration     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*DeviceAuthorization, error)
+	predicates     []predicate.DeviceAuthorization
 }
 
-var _ ent.Mutation = (*TeamMutation)(nil)
+var _ ent.Mutation = (*DeviceAuthorizationMutation)(nil)
 
-// teamOption allows management of the mutation configuration using functional options.
-type teamOption func(*TeamMutation)
+// deviceauthorizationOption allows management of the mutation configuration using functional options.
+type deviceauthorizationOption func(*DeviceAuthorizationMutation)
 
-// newTeamMutation creates new mutation for the Team entity.
-func newTeamMutation(c config, op Op, opts ...teamOption) *TeamMutation {
-	m := &TeamMutation{
+// newDeviceAuthorizationMutation creates new mutation for the DeviceAuthorization entity.
+func newDeviceAuthorizationMutation(c config, op Op, opts ...deviceauthorizationOption) *DeviceAuthorizationMutation {
+	m := &DeviceAuthorizationMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTeam,
+		typ:           TypeDeviceAuthorization,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6481,20 +7473,20 @@ func newTeamMutation(c config, op Op, opts ...teamOption) *TeamMutation {
 	return m
 }
 
-// withTeamID sets the ID field of the mutation.
-func withTeamID(id int) teamOption {
-	return func(m *TeamMutation) {
+// withDeviceAuthorizationID sets the ID field of the mutation.
+func withDeviceAuthorizationID(id string) deviceauthorizationOption {
+	return func(m *DeviceAuthorizationMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Team
+			value *DeviceAuthorization
 		)
-		m.oldValue = func(ctx context.Context) (*Team, error) {
+		m.oldValue = func(ctx context.Context) (*DeviceAuthorization, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Team.Get(ctx, id)
+					value, err = m.Client().DeviceAuthorization.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6503,10 +7495,10 @@ func withTeamID(id int) teamOption {
 	}
 }
 
-// withTeam sets the old Team of the mutation.
-func withTeam(node *Team) teamOption {
-	return func(m *TeamMutation) {
-		m.oldValue = func(context.Context) (*Team, error) {
+// withDeviceAuthorization sets the old DeviceAuthorization of the mutation.
+func withDeviceAuthorization(node *DeviceAuthorization) deviceauthorizationOption {
+	return func(m *DeviceAuthorizationMutation) {
+		m.oldValue = func(context.Context) (*DeviceAuthorization, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6515,7 +7507,7 @@ func withTeam(node *Team) teamOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TeamMutation) Client() *Client {
+func (m DeviceAuthorizationMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6523,7 +7515,7 @@ func (m TeamMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TeamMutation) Tx() (*Tx, error) {
+func (m DeviceAuthorizationMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6532,9 +7524,15 @@ func (m TeamMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DeviceAuthorization entities.
+func (m *DeviceAuthorizationMutation) SetID(id string) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TeamMutation) ID() (id int, exists bool) {
+func (m *DeviceAuthorizationMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6545,431 +7543,386 @@ func (m *TeamMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TeamMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DeviceAuthorizationMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []string{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Team.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DeviceAuthorization.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *TeamMutation) SetName(s string) {
-	m.name = &s
+// SetUserCode sets the "user_code" field.
+func (m *DeviceAuthorizationMutation) SetUserCode(s string) {
+	m.user_code = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *TeamMutation) Name() (r string, exists bool) {
-	v := m.name
+// UserCode returns the value of the "user_code" field in the mutation.
+func (m *DeviceAuthorizationMutation) UserCode() (r string, exists bool) {
+	v := m.user_code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldUserCode returns the old "user_code" field's value of the DeviceAuthorization entity.
+// If the DeviceAuthorization object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *DeviceAuthorizationMutation) OldUserCode(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserCode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldUserCode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserCode: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.UserCode, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *TeamMutation) ResetName() {
-	m.name = nil
+// ResetUserCode resets all changes to the "user_code" field.
+func (m *DeviceAuthorizationMutation) ResetUserCode() {
+	m.user_code = nil
 }
 
-// SetImageURL sets the "image_url" field.
-func (m *TeamMutation) SetImageURL(s string) {
-	m.image_url = &s
+// SetApplicationID sets the "application_id" field.
+func (m *DeviceAuthorizationMutation) SetApplicationID(s string) {
+	m.application_id = &s
 }
 
-// ImageURL returns the value of the "image_url" field in the mutation.
-func (m *TeamMutation) ImageURL() (r string, exists bool) {
-	v := m.image_url
+// ApplicationID returns the value of the "application_id" field in the mutation.
+func (m *DeviceAuthorizationMutation) ApplicationID() (r string, exists bool) {
+	v := m.application_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldImageURL returns the old "image_url" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldApplicationID returns the old "application_id" field's value of the DeviceAuthorization entity.
+// If the DeviceAuthorization object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldImageURL(ctx context.Context) (v *string, err error) {
+func (m *DeviceAuthorizationMutation) OldApplicationID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImageURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldApplicationID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImageURL requires an ID field in the mutation")
+		return v, errors.New("OldApplicationID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImageURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldApplicationID: %w", err)
 	}
-	return oldValue.ImageURL, nil
-}
-
-// ClearImageURL clears the value of the "image_url" field.
-func (m *TeamMutation) ClearImageURL() {
-	m.image_url = nil
-	m.clearedFields[team.FieldImageURL] = struct{}{}
-}
-
-// ImageURLCleared returns if the "image_url" field was cleared in this mutation.
-func (m *TeamMutation) ImageURLCleared() bool {
-	_, ok := m.clearedFields[team.FieldImageURL]
-	return ok
+	return oldValue.ApplicationID, nil
 }
 
-// ResetImageURL resets all changes to the "image_url" field.
-func (m *TeamMutation) ResetImageURL() {
-	m.image_url = nil
-	delete(m.clearedFields, team.FieldImageURL)
+// ResetApplicationID resets all changes to the "application_id" field.
+func (m *DeviceAuthorizationMutation) ResetApplicationID() {
+	m.application_id = nil
 }
 
-// SetIsLocked sets the "is_locked" field.
-func (m *TeamMutation) SetIsLocked(b bool) {
-	m.is_locked = &b
+// SetScopes sets the "scopes" field.
+func (m *DeviceAuthorizationMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
 }
 
-// IsLocked returns the value of the "is_locked" field in the mutation.
-func (m *TeamMutation) IsLocked() (r bool, exists bool) {
-	v := m.is_locked
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *DeviceAuthorizationMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsLocked returns the old "is_locked" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldScopes returns the old "scopes" field's value of the DeviceAuthorization entity.
+// If the DeviceAuthorization object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldIsLocked(ctx context.Context) (v bool, err error) {
+func (m *DeviceAuthorizationMutation) OldScopes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsLocked is only allowed on UpdateOne operations")
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsLocked requires an ID field in the mutation")
+		return v, errors.New("OldScopes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsLocked: %w", err)
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
 	}
-	return oldValue.IsLocked, nil
+	return oldValue.Scopes, nil
 }
 
-// ResetIsLocked resets all changes to the "is_locked" field.
-func (m *TeamMutation) ResetIsLocked() {
-	m.is_locked = nil
+// AppendScopes adds s to the "scopes" field.
+func (m *DeviceAuthorizationMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
 }
 
-// SetIsRegistered sets the "is_registered" field.
-func (m *TeamMutation) SetIsRegistered(b bool) {
-	m.is_registered = &b
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *DeviceAuthorizationMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
 }
 
-// IsRegistered returns the value of the "is_registered" field in the mutation.
-func (m *TeamMutation) IsRegistered() (r bool, exists bool) {
-	v := m.is_registered
+// ResetScopes resets all changes to the "scopes" field.
+func (m *DeviceAuthorizationMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+}
+
+// SetDone sets the "done" field.
+func (m *DeviceAuthorizationMutation) SetDone(b bool) {
+	m._done = &b
+}
+
+// Done returns the value of the "done" field in the mutation.
+func (m *DeviceAuthorizationMutation) Done() (r bool, exists bool) {
+	v := m._done
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsRegistered returns the old "is_registered" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldDone returns the old "done" field's value of the DeviceAuthorization entity.
+// If the DeviceAuthorization object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldIsRegistered(ctx context.Context) (v bool, err error) {
+func (m *DeviceAuthorizationMutation) OldDone(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsRegistered is only allowed on UpdateOne operations")
+		return v, errors.New("OldDone is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsRegistered requires an ID field in the mutation")
+		return v, errors.New("OldDone requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsRegistered: %w", err)
+		return v, fmt.Errorf("querying old value for OldDone: %w", err)
 	}
-	return oldValue.IsRegistered, nil
+	return oldValue.Done, nil
 }
 
-// ResetIsRegistered resets all changes to the "is_registered" field.
-func (m *TeamMutation) ResetIsRegistered() {
-	m.is_registered = nil
+// ResetDone resets all changes to the "done" field.
+func (m *DeviceAuthorizationMutation) ResetDone() {
+	m._done = nil
 }
 
-// SetIsWaitlisted sets the "is_waitlisted" field.
-func (m *TeamMutation) SetIsWaitlisted(b bool) {
-	m.is_waitlisted = &b
+// SetDenied sets the "denied" field.
+func (m *DeviceAuthorizationMutation) SetDenied(b bool) {
+	m.denied = &b
 }
 
-// IsWaitlisted returns the value of the "is_waitlisted" field in the mutation.
-func (m *TeamMutation) IsWaitlisted() (r bool, exists bool) {
-	v := m.is_waitlisted
+// Denied returns the value of the "denied" field in the mutation.
+func (m *DeviceAuthorizationMutation) Denied() (r bool, exists bool) {
+	v := m.denied
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsWaitlisted returns the old "is_waitlisted" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldDenied returns the old "denied" field's value of the DeviceAuthorization entity.
+// If the DeviceAuthorization object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldIsWaitlisted(ctx context.Context) (v bool, err error) {
+func (m *DeviceAuthorizationMutation) OldDenied(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsWaitlisted is only allowed on UpdateOne operations")
+		return v, errors.New("OldDenied is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsWaitlisted requires an ID field in the mutation")
+		return v, errors.New("OldDenied requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsWaitlisted: %w", err)
+		return v, fmt.Errorf("querying old value for OldDenied: %w", err)
 	}
-	return oldValue.IsWaitlisted, nil
+	return oldValue.Denied, nil
 }
 
-// ResetIsWaitlisted resets all changes to the "is_waitlisted" field.
-func (m *TeamMutation) ResetIsWaitlisted() {
-	m.is_waitlisted = nil
+// ResetDenied resets all changes to the "denied" field.
+func (m *DeviceAuthorizationMutation) ResetDenied() {
+	m.denied = nil
 }
 
-// SetWaitlistPosition sets the "waitlist_position" field.
-func (m *TeamMutation) SetWaitlistPosition(i int) {
-	m.waitlist_position = &i
-	m.addwaitlist_position = nil
+// SetSubject sets the "subject" field.
+func (m *DeviceAuthorizationMutation) SetSubject(s string) {
+	m.subject = &s
 }
 
-// WaitlistPosition returns the value of the "waitlist_position" field in the mutation.
-func (m *TeamMutation) WaitlistPosition() (r int, exists bool) {
-	v := m.waitlist_position
+// Subject returns the value of the "subject" field in the mutation.
+func (m *DeviceAuthorizationMutation) Subject() (r string, exists bool) {
+	v := m.subject
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWaitlistPosition returns the old "waitlist_position" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldSubject returns the old "subject" field's value of the DeviceAuthorization entity.
+// If the DeviceAuthorization object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldWaitlistPosition(ctx context.Context) (v *int, err error) {
+func (m *DeviceAuthorizationMutation) OldSubject(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWaitlistPosition is only allowed on UpdateOne operations")
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWaitlistPosition requires an ID field in the mutation")
+		return v, errors.New("OldSubject requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWaitlistPosition: %w", err)
-	}
-	return oldValue.WaitlistPosition, nil
-}
-
-// AddWaitlistPosition adds i to the "waitlist_position" field.
-func (m *TeamMutation) AddWaitlistPosition(i int) {
-	if m.addwaitlist_position != nil {
-		*m.addwaitlist_position += i
-	} else {
-		m.addwaitlist_position = &i
-	}
-}
-
-// AddedWaitlistPosition returns the value that was added to the "waitlist_position" field in this mutation.
-func (m *TeamMutation) AddedWaitlistPosition() (r int, exists bool) {
-	v := m.addwaitlist_position
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
 	}
-	return *v, true
+	return oldValue.Subject, nil
 }
 
-// ClearWaitlistPosition clears the value of the "waitlist_position" field.
-func (m *TeamMutation) ClearWaitlistPosition() {
-	m.waitlist_position = nil
-	m.addwaitlist_position = nil
-	m.clearedFields[team.FieldWaitlistPosition] = struct{}{}
+// ClearSubject clears the value of the "subject" field.
+func (m *DeviceAuthorizationMutation) ClearSubject() {
+	m.subject = nil
+	m.clearedFields[deviceauthorization.FieldSubject] = struct{}{}
 }
 
-// WaitlistPositionCleared returns if the "waitlist_position" field was cleared in this mutation.
-func (m *TeamMutation) WaitlistPositionCleared() bool {
-	_, ok := m.clearedFields[team.FieldWaitlistPosition]
+// SubjectCleared returns if the "subject" field was cleared in this mutation.
+func (m *DeviceAuthorizationMutation) SubjectCleared() bool {
+	_, ok := m.clearedFields[deviceauthorization.FieldSubject]
 	return ok
 }
 
-// ResetWaitlistPosition resets all changes to the "waitlist_position" field.
-func (m *TeamMutation) ResetWaitlistPosition() {
-	m.waitlist_position = nil
-	m.addwaitlist_position = nil
-	delete(m.clearedFields, team.FieldWaitlistPosition)
+// ResetSubject resets all changes to the "subject" field.
+func (m *DeviceAuthorizationMutation) ResetSubject() {
+	m.subject = nil
+	delete(m.clearedFields, deviceauthorization.FieldSubject)
 }
 
-// SetScore sets the "score" field.
-func (m *TeamMutation) SetScore(i int) {
-	m.score = &i
-	m.addscore = nil
+// SetAuthTime sets the "auth_time" field.
+func (m *DeviceAuthorizationMutation) SetAuthTime(t time.Time) {
+	m.auth_time = &t
 }
 
-// Score returns the value of the "score" field in the mutation.
-func (m *TeamMutation) Score() (r int, exists bool) {
-	v := m.score
+// AuthTime returns the value of the "auth_time" field in the mutation.
+func (m *DeviceAuthorizationMutation) AuthTime() (r time.Time, exists bool) {
+	v := m.auth_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScore returns the old "score" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldAuthTime returns the old "auth_time" field's value of the DeviceAuthorization entity.
+// If the DeviceAuthorization object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldScore(ctx context.Context) (v int, err error) {
+func (m *DeviceAuthorizationMutation) OldAuthTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+		return v, errors.New("OldAuthTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScore requires an ID field in the mutation")
+		return v, errors.New("OldAuthTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScore: %w", err)
-	}
-	return oldValue.Score, nil
-}
-
-// AddScore adds i to the "score" field.
-func (m *TeamMutation) AddScore(i int) {
-	if m.addscore != nil {
-		*m.addscore += i
-	} else {
-		m.addscore = &i
-	}
-}
-
-// AddedScore returns the value that was added to the "score" field in this mutation.
-func (m *TeamMutation) AddedScore() (r int, exists bool) {
-	v := m.addscore
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldAuthTime: %w", err)
 	}
-	return *v, true
+	return oldValue.AuthTime, nil
 }
 
-// ClearScore clears the value of the "score" field.
-func (m *TeamMutation) ClearScore() {
-	m.score = nil
-	m.addscore = nil
-	m.clearedFields[team.FieldScore] = struct{}{}
+// ClearAuthTime clears the value of the "auth_time" field.
+func (m *DeviceAuthorizationMutation) ClearAuthTime() {
+	m.auth_time = nil
+	m.clearedFields[deviceauthorization.FieldAuthTime] = struct{}{}
 }
 
-// ScoreCleared returns if the "score" field was cleared in this mutation.
-func (m *TeamMutation) ScoreCleared() bool {
-	_, ok := m.clearedFields[team.FieldScore]
+// AuthTimeCleared returns if the "auth_time" field was cleared in this mutation.
+func (m *DeviceAuthorizationMutation) AuthTimeCleared() bool {
+	_, ok := m.clearedFields[deviceauthorization.FieldAuthTime]
 	return ok
 }
 
-// ResetScore resets all changes to the "score" field.
-func (m *TeamMutation) ResetScore() {
-	m.score = nil
-	m.addscore = nil
-	delete(m.clearedFields, team.FieldScore)
+// ResetAuthTime resets all changes to the "auth_time" field.
+func (m *DeviceAuthorizationMutation) ResetAuthTime() {
+	m.auth_time = nil
+	delete(m.clearedFields, deviceauthorization.FieldAuthTime)
 }
 
-// SetElo sets the "elo" field.
-func (m *TeamMutation) SetElo(i int) {
-	m.elo = &i
-	m.addelo = nil
+// SetAmr sets the "amr" field.
+func (m *DeviceAuthorizationMutation) SetAmr(s []string) {
+	m.amr = &s
+	m.appendamr = nil
 }
 
-// Elo returns the value of the "elo" field in the mutation.
-func (m *TeamMutation) Elo() (r int, exists bool) {
-	v := m.elo
+// Amr returns the value of the "amr" field in the mutation.
+func (m *DeviceAuthorizationMutation) Amr() (r []string, exists bool) {
+	v := m.amr
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldElo returns the old "elo" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldAmr returns the old "amr" field's value of the DeviceAuthorization entity.
+// If the DeviceAuthorization object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldElo(ctx context.Context) (v *int, err error) {
+func (m *DeviceAuthorizationMutation) OldAmr(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldElo is only allowed on UpdateOne operations")
+		return v, errors.New("OldAmr is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldElo requires an ID field in the mutation")
+		return v, errors.New("OldAmr requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldElo: %w", err)
+		return v, fmt.Errorf("querying old value for OldAmr: %w", err)
 	}
-	return oldValue.Elo, nil
+	return oldValue.Amr, nil
 }
 
-// AddElo adds i to the "elo" field.
-func (m *TeamMutation) AddElo(i int) {
-	if m.addelo != nil {
-		*m.addelo += i
-	} else {
-		m.addelo = &i
-	}
+// AppendAmr adds s to the "amr" field.
+func (m *DeviceAuthorizationMutation) AppendAmr(s []string) {
+	m.appendamr = append(m.appendamr, s...)
 }
 
-// AddedElo returns the value that was added to the "elo" field in this mutation.
-func (m *TeamMutation) AddedElo() (r int, exists bool) {
-	v := m.addelo
-	if v == nil {
-		return
+// AppendedAmr returns the list of values that were appended to the "amr" field in this mutation.
+func (m *DeviceAuthorizationMutation) AppendedAmr() ([]string, bool) {
+	if len(m.appendamr) == 0 {
+		return nil, false
 	}
-	return *v, true
+	return m.appendamr, true
 }
 
-// ClearElo clears the value of the "elo" field.
-func (m *TeamMutation) ClearElo() {
-	m.elo = nil
-	m.addelo = nil
-	m.clearedFields[team.FieldElo] = struct{}{}
+// ClearAmr clears the value of the "amr" field.
+func (m *DeviceAuthorizationMutation) ClearAmr() {
+	m.amr = nil
+	m.appendamr = nil
+	m.clearedFields[deviceauthorization.FieldAmr] = struct{}{}
 }
 
-// EloCleared returns if the "elo" field was cleared in this mutation.
-func (m *TeamMutation) EloCleared() bool {
-	_, ok := m.clearedFields[team.FieldElo]
+// AmrCleared returns if the "amr" field was cleared in this mutation.
+func (m *DeviceAuthorizationMutation) AmrCleared() bool {
+	_, ok := m.clearedFields[deviceauthorization.FieldAmr]
 	return ok
 }
 
-// ResetElo resets all changes to the "elo" field.
-func (m *TeamMutation) ResetElo() {
-	m.elo = nil
-	m.addelo = nil
-	delete(m.clearedFields, team.FieldElo)
+// ResetAmr resets all changes to the "amr" field.
+func (m *DeviceAuthorizationMutation) ResetAmr() {
+	m.amr = nil
+	m.appendamr = nil
+	delete(m.clearedFields, deviceauthorization.FieldAmr)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *TeamMutation) SetCreatedAt(t time.Time) {
+func (m *DeviceAuthorizationMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TeamMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *DeviceAuthorizationMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -6977,10 +7930,10 @@ func (m *TeamMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the DeviceAuthorization entity.
+// If the DeviceAuthorization object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DeviceAuthorizationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -6995,335 +7948,758 @@ func (m *TeamMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TeamMutation) ResetCreatedAt() {
+func (m *DeviceAuthorizationMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *TeamMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetExpiration sets the "expiration" field.
+func (m *DeviceAuthorizationMutation) SetExpiration(t time.Time) {
+	m.expiration = &t
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *TeamMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Expiration returns the value of the "expiration" field in the mutation.
+func (m *DeviceAuthorizationMutation) Expiration() (r time.Time, exists bool) {
+	v := m.expiration
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiration returns the old "expiration" field's value of the DeviceAuthorization entity.
+// If the DeviceAuthorization object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DeviceAuthorizationMutation) OldExpiration(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiration is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldExpiration requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiration: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Expiration, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *TeamMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetExpiration resets all changes to the "expiration" field.
+func (m *DeviceAuthorizationMutation) ResetExpiration() {
+	m.expiration = nil
 }
 
-// SetTournamentID sets the "tournament" edge to the Tournament entity by id.
-func (m *TeamMutation) SetTournamentID(id int) {
-	m.tournament = &id
+// Where appends a list predicates to the DeviceAuthorizationMutation builder.
+func (m *DeviceAuthorizationMutation) Where(ps ...predicate.DeviceAuthorization) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ClearTournament clears the "tournament" edge to the Tournament entity.
-func (m *TeamMutation) ClearTournament() {
-	m.clearedtournament = true
+// WhereP appends storage-level predicates to the DeviceAuthorizationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeviceAuthorizationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeviceAuthorization, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// TournamentCleared reports if the "tournament" edge to the Tournament entity was cleared.
-func (m *TeamMutation) TournamentCleared() bool {
-	return m.clearedtournament
+// Op returns the operation name.
+func (m *DeviceAuthorizationMutation) Op() Op {
+	return m.op
 }
 
-// TournamentID returns the "tournament" edge ID in the mutation.
-func (m *TeamMutation) TournamentID() (id int, exists bool) {
-	if m.tournament != nil {
-		return *m.tournament, true
-	}
-	return
+// SetOp allows setting the mutation operation.
+func (m *DeviceAuthorizationMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// TournamentIDs returns the "tournament" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TournamentID instead. It exists only for internal usage by the builders.
-func (m *TeamMutation) TournamentIDs() (ids []int) {
-	if id := m.tournament; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetTournament resets all changes to the "tournament" edge.
-func (m *TeamMutation) ResetTournament() {
-	m.tournament = nil
-	m.clearedtournament = false
+// Type returns the node type of this mutation (DeviceAuthorization).
+func (m *DeviceAuthorizationMutation) Type() string {
+	return m.typ
 }
 
-// SetCreatorID sets the "creator" edge to the User entity by id.
-func (m *TeamMutation) SetCreatorID(id int) {
-	m.creator = &id
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeviceAuthorizationMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.user_code != nil {
+		fields = append(fields, deviceauthorization.FieldUserCode)
+	}
+	if m.application_id != nil {
+		fields = append(fields, deviceauthorization.FieldApplicationID)
+	}
+	if m.scopes != nil {
+		fields = append(fields, deviceauthorization.FieldScopes)
+	}
+	if m._done != nil {
+		fields = append(fields, deviceauthorization.FieldDone)
+	}
+	if m.denied != nil {
+		fields = append(fields, deviceauthorization.FieldDenied)
+	}
+	if m.subject != nil {
+		fields = append(fields, deviceauthorization.FieldSubject)
+	}
+	if m.auth_time != nil {
+		fields = append(fields, deviceauthorization.FieldAuthTime)
+	}
+	if m.amr != nil {
+		fields = append(fields, deviceauthorization.FieldAmr)
+	}
+	if m.created_at != nil {
+		fields = append(fields, deviceauthorization.FieldCreatedAt)
+	}
+	if m.expiration != nil {
+		fields = append(fields, deviceauthorization.FieldExpiration)
+	}
+	return fields
 }
 
-// ClearCreator clears the "creator" edge to the User entity.
-func (m *TeamMutation) ClearCreator() {
-	m.clearedcreator = true
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeviceAuthorizationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case deviceauthorization.FieldUserCode:
+		return m.UserCode()
+	case deviceauthorization.FieldApplicationID:
+		return m.ApplicationID()
+	case deviceauthorization.FieldScopes:
+		return m.Scopes()
+	case deviceauthorization.FieldDone:
+		return m.Done()
+	case deviceauthorization.FieldDenied:
+		return m.Denied()
+	case deviceauthorization.FieldSubject:
+		return m.Subject()
+	case deviceauthorization.FieldAuthTime:
+		return m.AuthTime()
+	case deviceauthorization.FieldAmr:
+		return m.Amr()
+	case deviceauthorization.FieldCreatedAt:
+		return m.CreatedAt()
+	case deviceauthorization.FieldExpiration:
+		return m.Expiration()
+	}
+	return nil, false
 }
 
-// CreatorCleared reports if the "creator" edge to the User entity was cleared.
-func (m *TeamMutation) CreatorCleared() bool {
-	return m.clearedcreator
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeviceAuthorizationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case deviceauthorization.FieldUserCode:
+		return m.OldUserCode(ctx)
+	case deviceauthorization.FieldApplicationID:
+		return m.OldApplicationID(ctx)
+	case deviceauthorization.FieldScopes:
+		return m.OldScopes(ctx)
+	case deviceauthorization.FieldDone:
+		return m.OldDone(ctx)
+	case deviceauthorization.FieldDenied:
+		return m.OldDenied(ctx)
+	case deviceauthorization.FieldSubject:
+		return m.OldSubject(ctx)
+	case deviceauthorization.FieldAuthTime:
+		return m.OldAuthTime(ctx)
+	case deviceauthorization.FieldAmr:
+		return m.OldAmr(ctx)
+	case deviceauthorization.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case deviceauthorization.FieldExpiration:
+		return m.OldExpiration(ctx)
+	}
+	return nil, fmt.Errorf("unknown DeviceAuthorization field %s", name)
 }
 
-// CreatorID returns the "creator" edge ID in the mutation.
-func (m *TeamMutation) CreatorID() (id int, exists bool) {
-	if m.creator != nil {
-		return *m.creator, true
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeviceAuthorizationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case deviceauthorization.FieldUserCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserCode(v)
+		return nil
+	case deviceauthorization.FieldApplicationID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApplicationID(v)
+		return nil
+	case deviceauthorization.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	case deviceauthorization.FieldDone:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDone(v)
+		return nil
+	case deviceauthorization.FieldDenied:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDenied(v)
+		return nil
+	case deviceauthorization.FieldSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubject(v)
+		return nil
+	case deviceauthorization.FieldAuthTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthTime(v)
+		return nil
+	case deviceauthorization.FieldAmr:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmr(v)
+		return nil
+	case deviceauthorization.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case deviceauthorization.FieldExpiration:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiration(v)
+		return nil
 	}
-	return
+	return fmt.Errorf("unknown DeviceAuthorization field %s", name)
 }
 
-// CreatorIDs returns the "creator" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CreatorID instead. It exists only for internal usage by the builders.
-func (m *TeamMutation) CreatorIDs() (ids []int) {
-	if id := m.creator; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeviceAuthorizationMutation) AddedFields() []string {
+	return nil
 }
 
-// ResetCreator resets all changes to the "creator" edge.
-func (m *TeamMutation) ResetCreator() {
-	m.creator = nil
-	m.clearedcreator = false
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeviceAuthorizationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// AddMemberIDs adds the "members" edge to the TeamMember entity by ids.
-func (m *TeamMutation) AddMemberIDs(ids ...int) {
-	if m.members == nil {
-		m.members = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.members[ids[i]] = struct{}{}
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeviceAuthorizationMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
+	return fmt.Errorf("unknown DeviceAuthorization numeric field %s", name)
 }
 
-// ClearMembers clears the "members" edge to the TeamMember entity.
-func (m *TeamMutation) ClearMembers() {
-	m.clearedmembers = true
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeviceAuthorizationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(deviceauthorization.FieldSubject) {
+		fields = append(fields, deviceauthorization.FieldSubject)
+	}
+	if m.FieldCleared(deviceauthorization.FieldAuthTime) {
+		fields = append(fields, deviceauthorization.FieldAuthTime)
+	}
+	if m.FieldCleared(deviceauthorization.FieldAmr) {
+		fields = append(fields, deviceauthorization.FieldAmr)
+	}
+	return fields
 }
 
-// MembersCleared reports if the "members" edge to the TeamMember entity was cleared.
-func (m *TeamMutation) MembersCleared() bool {
-	return m.clearedmembers
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeviceAuthorizationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// RemoveMemberIDs removes the "members" edge to the TeamMember entity by IDs.
-func (m *TeamMutation) RemoveMemberIDs(ids ...int) {
-	if m.removedmembers == nil {
-		m.removedmembers = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.members, ids[i])
-		m.removedmembers[ids[i]] = struct{}{}
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeviceAuthorizationMutation) ClearField(name string) error {
+	switch name {
+	case deviceauthorization.FieldSubject:
+		m.ClearSubject()
+		return nil
+	case deviceauthorization.FieldAuthTime:
+		m.ClearAuthTime()
+		return nil
+	case deviceauthorization.FieldAmr:
+		m.ClearAmr()
+		return nil
 	}
+	return fmt.Errorf("unknown DeviceAuthorization nullable field %s", name)
 }
 
-// RemovedMembers returns the removed IDs of the "members" edge to the TeamMember entity.
-func (m *TeamMutation) RemovedMembersIDs() (ids []int) {
-	for id := range m.removedmembers {
-		ids = append(ids, id)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeviceAuthorizationMutation) ResetField(name string) error {
+	switch name {
+	case deviceauthorization.FieldUserCode:
+		m.ResetUserCode()
+		return nil
+	case deviceauthorization.FieldApplicationID:
+		m.ResetApplicationID()
+		return nil
+	case deviceauthorization.FieldScopes:
+		m.ResetScopes()
+		return nil
+	case deviceauthorization.FieldDone:
+		m.ResetDone()
+		return nil
+	case deviceauthorization.FieldDenied:
+		m.ResetDenied()
+		return nil
+	case deviceauthorization.FieldSubject:
+		m.ResetSubject()
+		return nil
+	case deviceauthorization.FieldAuthTime:
+		m.ResetAuthTime()
+		return nil
+	case deviceauthorization.FieldAmr:
+		m.ResetAmr()
+		return nil
+	case deviceauthorization.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case deviceauthorization.FieldExpiration:
+		m.ResetExpiration()
+		return nil
 	}
-	return
+	return fmt.Errorf("unknown DeviceAuthorization field %s", name)
 }
 
-// MembersIDs returns the "members" edge IDs in the mutation.
-func (m *TeamMutation) MembersIDs() (ids []int) {
-	for id := range m.members {
-		ids = append(ids, id)
-	}
-	return
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeviceAuthorizationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetMembers resets all changes to the "members" edge.
-func (m *TeamMutation) ResetMembers() {
-	m.members = nil
-	m.clearedmembers = false
-	m.removedmembers = nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeviceAuthorizationMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// SetRankGroupID sets the "rank_group" edge to the RankGroup entity by id.
-func (m *TeamMutation) SetRankGroupID(id int) {
-	m.rank_group = &id
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeviceAuthorizationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ClearRankGroup clears the "rank_group" edge to the RankGroup entity.
-func (m *TeamMutation) ClearRankGroup() {
-	m.clearedrank_group = true
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeviceAuthorizationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// RankGroupCleared reports if the "rank_group" edge to the RankGroup entity was cleared.
-func (m *TeamMutation) RankGroupCleared() bool {
-	return m.clearedrank_group
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeviceAuthorizationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// RankGroupID returns the "rank_group" edge ID in the mutation.
-func (m *TeamMutation) RankGroupID() (id int, exists bool) {
-	if m.rank_group != nil {
-		return *m.rank_group, true
-	}
-	return
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeviceAuthorizationMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// RankGroupIDs returns the "rank_group" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RankGroupID instead. It exists only for internal usage by the builders.
-func (m *TeamMutation) RankGroupIDs() (ids []int) {
-	if id := m.rank_group; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeviceAuthorizationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DeviceAuthorization unique edge %s", name)
 }
 
-// ResetRankGroup resets all changes to the "rank_group" edge.
-func (m *TeamMutation) ResetRankGroup() {
-	m.rank_group = nil
-	m.clearedrank_group = false
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeviceAuthorizationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DeviceAuthorization edge %s", name)
 }
 
-// AddInvitationIDs adds the "invitations" edge to the Invitation entity by ids.
-func (m *TeamMutation) AddInvitationIDs(ids ...int) {
-	if m.invitations == nil {
-		m.invitations = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.invitations[ids[i]] = struct{}{}
-	}
+// InvitationMutation represents an operation that mutates the Invitation nodes in the graph.
+type InvitationMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	created_at     *time.Time
+	message        *string
+	role           *string
+	clearedFields  map[string]struct{}
+	team           *int
+	clearedteam    bool
+	invitee        *int
+	clearedinvitee bool
+	done           bool
+	oldValue       func(context.Context) (*Invitation, error)
+	predicates     []predicate.Invitation
 }
 
-// ClearInvitations clears the "invitations" edge to the Invitation entity.
-func (m *TeamMutation) ClearInvitations() {
-	m.clearedinvitations = true
-}
+var _ ent.Mutation = (*InvitationMutation)(nil)
 
-// InvitationsCleared reports if the "invitations" edge to the Invitation entity was cleared.
-func (m *TeamMutation) InvitationsCleared() bool {
-	return m.clearedinvitations
-}
+// invitationOption allows management of the mutation configuration using functional options.
+type invitationOption func(*InvitationMutation)
 
-// RemoveInvitationIDs removes the "invitations" edge to the Invitation entity by IDs.
-func (m *TeamMutation) RemoveInvitationIDs(ids ...int) {
-	if m.removedinvitations == nil {
-		m.removedinvitations = make(map[int]struct{})
+// newInvitationMutation creates new mutation for the Invitation entity.
+func newInvitationMutation(c config, op Op, opts ...invitationOption) *InvitationMutation {
+	m := &InvitationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeInvitation,
+		clearedFields: make(map[string]struct{}),
 	}
-	for i := range ids {
-		delete(m.invitations, ids[i])
-		m.removedinvitations[ids[i]] = struct{}{}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
-// RemovedInvitations returns the removed IDs of the "invitations" edge to the Invitation entity.
-func (m *TeamMutation) RemovedInvitationsIDs() (ids []int) {
-	for id := range m.removedinvitations {
-		ids = append(ids, id)
+// withInvitationID sets the ID field of the mutation.
+func withInvitationID(id int) invitationOption {
+	return func(m *InvitationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Invitation
+		)
+		m.oldValue = func(ctx context.Context) (*Invitation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Invitation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return
 }
 
-// InvitationsIDs returns the "invitations" edge IDs in the mutation.
-func (m *TeamMutation) InvitationsIDs() (ids []int) {
-	for id := range m.invitations {
-		ids = append(ids, id)
+// withInvitation sets the old Invitation of the mutation.
+func withInvitation(node *Invitation) invitationOption {
+	return func(m *InvitationMutation) {
+		m.oldValue = func(context.Context) (*Invitation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return
 }
 
-// ResetInvitations resets all changes to the "invitations" edge.
-func (m *TeamMutation) ResetInvitations() {
-	m.invitations = nil
-	m.clearedinvitations = false
-	m.removedinvitations = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m InvitationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// Where appends a list predicates to the TeamMutation builder.
-func (m *TeamMutation) Where(ps ...predicate.Team) {
-	m.predicates = append(m.predicates, ps...)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m InvitationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// WhereP appends storage-level predicates to the TeamMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TeamMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Team, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *InvitationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
-	m.Where(p...)
+	return *m.id, true
 }
 
-// Op returns the operation name.
-func (m *TeamMutation) Op() Op {
-	return m.op
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *InvitationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Invitation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetOp allows setting the mutation operation.
-func (m *TeamMutation) SetOp(op Op) {
-	m.op = op
+// SetCreatedAt sets the "created_at" field.
+func (m *InvitationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Type returns the node type of this mutation (Team).
-func (m *TeamMutation) Type() string {
-	return m.typ
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *InvitationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *TeamMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.name != nil {
-		fields = append(fields, team.FieldName)
-	}
-	if m.image_url != nil {
-		fields = append(fields, team.FieldImageURL)
-	}
-	if m.is_locked != nil {
-		fields = append(fields, team.FieldIsLocked)
+// OldCreatedAt returns the old "created_at" field's value of the Invitation entity.
+// If the Invitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvitationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	if m.is_registered != nil {
-		fields = append(fields, team.FieldIsRegistered)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	if m.is_waitlisted != nil {
-		fields = append(fields, team.FieldIsWaitlisted)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	if m.waitlist_position != nil {
-		fields = append(fields, team.FieldWaitlistPosition)
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *InvitationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetMessage sets the "message" field.
+func (m *InvitationMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *InvitationMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
 	}
-	if m.score != nil {
-		fields = append(fields, team.FieldScore)
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the Invitation entity.
+// If the Invitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvitationMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
 	}
-	if m.elo != nil {
-		fields = append(fields, team.FieldElo)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
 	}
-	if m.created_at != nil {
-		fields = append(fields, team.FieldCreatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, team.FieldUpdatedAt)
+	return oldValue.Message, nil
+}
+
+// ClearMessage clears the value of the "message" field.
+func (m *InvitationMutation) ClearMessage() {
+	m.message = nil
+	m.clearedFields[invitation.FieldMessage] = struct{}{}
+}
+
+// MessageCleared returns if the "message" field was cleared in this mutation.
+func (m *InvitationMutation) MessageCleared() bool {
+	_, ok := m.clearedFields[invitation.FieldMessage]
+	return ok
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *InvitationMutation) ResetMessage() {
+	m.message = nil
+	delete(m.clearedFields, invitation.FieldMessage)
+}
+
+// SetRole sets the "role" field.
+func (m *InvitationMutation) SetRole(s string) {
+	m.role = &s
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *InvitationMutation) Role() (r string, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the Invitation entity.
+// If the Invitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvitationMutation) OldRole(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *InvitationMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetTeamID sets the "team" edge to the Team entity by id.
+func (m *InvitationMutation) SetTeamID(id int) {
+	m.team = &id
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (m *InvitationMutation) ClearTeam() {
+	m.clearedteam = true
+}
+
+// TeamCleared reports if the "team" edge to the Team entity was cleared.
+func (m *InvitationMutation) TeamCleared() bool {
+	return m.clearedteam
+}
+
+// TeamID returns the "team" edge ID in the mutation.
+func (m *InvitationMutation) TeamID() (id int, exists bool) {
+	if m.team != nil {
+		return *m.team, true
+	}
+	return
+}
+
+// TeamIDs returns the "team" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamID instead. It exists only for internal usage by the builders.
+func (m *InvitationMutation) TeamIDs() (ids []int) {
+	if id := m.team; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTeam resets all changes to the "team" edge.
+func (m *InvitationMutation) ResetTeam() {
+	m.team = nil
+	m.clearedteam = false
+}
+
+// SetInviteeID sets the "invitee" edge to the User entity by id.
+func (m *InvitationMutation) SetInviteeID(id int) {
+	m.invitee = &id
+}
+
+// ClearInvitee clears the "invitee" edge to the User entity.
+func (m *InvitationMutation) ClearInvitee() {
+	m.clearedinvitee = true
+}
+
+// InviteeCleared reports if the "invitee" edge to the User entity was cleared.
+func (m *InvitationMutation) InviteeCleared() bool {
+	return m.clearedinvitee
+}
+
+// InviteeID returns the "invitee" edge ID in the mutation.
+func (m *InvitationMutation) InviteeID() (id int, exists bool) {
+	if m.invitee != nil {
+		return *m.invitee, true
+	}
+	return
+}
+
+// InviteeIDs returns the "invitee" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// InviteeID instead. It exists only for internal usage by the builders.
+func (m *InvitationMutation) InviteeIDs() (ids []int) {
+	if id := m.invitee; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetInvitee resets all changes to the "invitee" edge.
+func (m *InvitationMutation) ResetInvitee() {
+	m.invitee = nil
+	m.clearedinvitee = false
+}
+
+// Where appends a list predicates to the InvitationMutation builder.
+func (m *InvitationMutation) Where(ps ...predicate.Invitation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the InvitationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *InvitationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Invitation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *InvitationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *InvitationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Invitation).
+func (m *InvitationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *InvitationMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.created_at != nil {
+		fields = append(fields, invitation.FieldCreatedAt)
+	}
+	if m.message != nil {
+		fields = append(fields, invitation.FieldMessage)
+	}
+	if m.role != nil {
+		fields = append(fields, invitation.FieldRole)
 	}
 	return fields
 }
@@ -7331,28 +8707,14 @@ func (m *TeamMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TeamMutation) Field(name string) (ent.Value, bool) {
+func (m *InvitationMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case team.FieldName:
-		return m.Name()
-	case team.FieldImageURL:
-		return m.ImageURL()
-	case team.FieldIsLocked:
-		return m.IsLocked()
-	case team.FieldIsRegistered:
-		return m.IsRegistered()
-	case team.FieldIsWaitlisted:
-		return m.IsWaitlisted()
-	case team.FieldWaitlistPosition:
-		return m.WaitlistPosition()
-	case team.FieldScore:
-		return m.Score()
-	case team.FieldElo:
-		return m.Elo()
-	case team.FieldCreatedAt:
+	case invitation.FieldCreatedAt:
 		return m.CreatedAt()
-	case team.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case invitation.FieldMessage:
+		return m.Message()
+	case invitation.FieldRole:
+		return m.Role()
 	}
 	return nil, false
 }
@@ -7360,451 +8722,239 @@ func (m *TeamMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TeamMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *InvitationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case team.FieldName:
-		return m.OldName(ctx)
-	case team.FieldImageURL:
-		return m.OldImageURL(ctx)
-	case team.FieldIsLocked:
-		return m.OldIsLocked(ctx)
-	case team.FieldIsRegistered:
-		return m.OldIsRegistered(ctx)
-	case team.FieldIsWaitlisted:
-		return m.OldIsWaitlisted(ctx)
-	case team.FieldWaitlistPosition:
-		return m.OldWaitlistPosition(ctx)
-	case team.FieldScore:
-		return m.OldScore(ctx)
-	case team.FieldElo:
-		return m.OldElo(ctx)
-	case team.FieldCreatedAt:
+	case invitation.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case team.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case invitation.FieldMessage:
+		return m.OldMessage(ctx)
+	case invitation.FieldRole:
+		return m.OldRole(ctx)
 	}
-	return nil, fmt.Errorf("unknown Team field %s", name)
+	return nil, fmt.Errorf("unknown Invitation field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TeamMutation) SetField(name string, value ent.Value) error {
+func (m *InvitationMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case team.FieldName:
-		v, ok := value.(string)
+	case invitation.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetCreatedAt(v)
 		return nil
-	case team.FieldImageURL:
+	case invitation.FieldMessage:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetImageURL(v)
+		m.SetMessage(v)
 		return nil
-	case team.FieldIsLocked:
-		v, ok := value.(bool)
+	case invitation.FieldRole:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsLocked(v)
-		return nil
-	case team.FieldIsRegistered:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsRegistered(v)
-		return nil
-	case team.FieldIsWaitlisted:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsWaitlisted(v)
-		return nil
-	case team.FieldWaitlistPosition:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWaitlistPosition(v)
-		return nil
-	case team.FieldScore:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetScore(v)
-		return nil
-	case team.FieldElo:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetElo(v)
-		return nil
-	case team.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case team.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
+		m.SetRole(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Team field %s", name)
+	return fmt.Errorf("unknown Invitation field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TeamMutation) AddedFields() []string {
-	var fields []string
-	if m.addwaitlist_position != nil {
-		fields = append(fields, team.FieldWaitlistPosition)
-	}
-	if m.addscore != nil {
-		fields = append(fields, team.FieldScore)
-	}
-	if m.addelo != nil {
-		fields = append(fields, team.FieldElo)
-	}
-	return fields
+func (m *InvitationMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TeamMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case team.FieldWaitlistPosition:
-		return m.AddedWaitlistPosition()
-	case team.FieldScore:
-		return m.AddedScore()
-	case team.FieldElo:
-		return m.AddedElo()
-	}
+func (m *InvitationMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TeamMutation) AddField(name string, value ent.Value) error {
+func (m *InvitationMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case team.FieldWaitlistPosition:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddWaitlistPosition(v)
-		return nil
-	case team.FieldScore:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddScore(v)
-		return nil
-	case team.FieldElo:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddElo(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Team numeric field %s", name)
+	return fmt.Errorf("unknown Invitation numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TeamMutation) ClearedFields() []string {
+func (m *InvitationMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(team.FieldImageURL) {
-		fields = append(fields, team.FieldImageURL)
-	}
-	if m.FieldCleared(team.FieldWaitlistPosition) {
-		fields = append(fields, team.FieldWaitlistPosition)
-	}
-	if m.FieldCleared(team.FieldScore) {
-		fields = append(fields, team.FieldScore)
-	}
-	if m.FieldCleared(team.FieldElo) {
-		fields = append(fields, team.FieldElo)
+	if m.FieldCleared(invitation.FieldMessage) {
+		fields = append(fields, invitation.FieldMessage)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TeamMutation) FieldCleared(name string) bool {
+func (m *InvitationMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TeamMutation) ClearField(name string) error {
+func (m *InvitationMutation) ClearField(name string) error {
 	switch name {
-	case team.FieldImageURL:
-		m.ClearImageURL()
-		return nil
-	case team.FieldWaitlistPosition:
-		m.ClearWaitlistPosition()
-		return nil
-	case team.FieldScore:
-		m.ClearScore()
-		return nil
-	case team.FieldElo:
-		m.ClearElo()
+	case invitation.FieldMessage:
+		m.ClearMessage()
 		return nil
 	}
-	return fmt.Errorf("unknown Team nullable field %s", name)
+	return fmt.Errorf("unknown Invitation nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TeamMutation) ResetField(name string) error {
+func (m *InvitationMutation) ResetField(name string) error {
 	switch name {
-	case team.FieldName:
-		m.ResetName()
-		return nil
-	case team.FieldImageURL:
-		m.ResetImageURL()
-		return nil
-	case team.FieldIsLocked:
-		m.ResetIsLocked()
-		return nil
-	case team.FieldIsRegistered:
-		m.ResetIsRegistered()
-		return nil
-	case team.FieldIsWaitlisted:
-		m.ResetIsWaitlisted()
-		return nil
-	case team.FieldWaitlistPosition:
-		m.ResetWaitlistPosition()
-		return nil
-	case team.FieldScore:
-		m.ResetScore()
-		return nil
-	case team.FieldElo:
-		m.ResetElo()
-		return nil
-	case team.FieldCreatedAt:
+	case invitation.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case team.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case invitation.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case invitation.FieldRole:
+		m.ResetRole()
 		return nil
 	}
-	return fmt.Errorf("unknown Team field %s", name)
+	return fmt.Errorf("unknown Invitation field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TeamMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.tournament != nil {
-		edges = append(edges, team.EdgeTournament)
-	}
-	if m.creator != nil {
-		edges = append(edges, team.EdgeCreator)
-	}
-	if m.members != nil {
-		edges = append(edges, team.EdgeMembers)
-	}
-	if m.rank_group != nil {
-		edges = append(edges, team.EdgeRankGroup)
+func (m *InvitationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.team != nil {
+		edges = append(edges, invitation.EdgeTeam)
 	}
-	if m.invitations != nil {
-		edges = append(edges, team.EdgeInvitations)
+	if m.invitee != nil {
+		edges = append(edges, invitation.EdgeInvitee)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TeamMutation) AddedIDs(name string) []ent.Value {
+func (m *InvitationMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case team.EdgeTournament:
-		if id := m.tournament; id != nil {
-			return []ent.Value{*id}
-		}
-	case team.EdgeCreator:
-		if id := m.creator; id != nil {
+	case invitation.EdgeTeam:
+		if id := m.team; id != nil {
 			return []ent.Value{*id}
 		}
-	case team.EdgeMembers:
-		ids := make([]ent.Value, 0, len(m.members))
-		for id := range m.members {
-			ids = append(ids, id)
-		}
-		return ids
-	case team.EdgeRankGroup:
-		if id := m.rank_group; id != nil {
+	case invitation.EdgeInvitee:
+		if id := m.invitee; id != nil {
 			return []ent.Value{*id}
 		}
-	case team.EdgeInvitations:
-		ids := make([]ent.Value, 0, len(m.invitations))
-		for id := range m.invitations {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TeamMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedmembers != nil {
-		edges = append(edges, team.EdgeMembers)
-	}
-	if m.removedinvitations != nil {
-		edges = append(edges, team.EdgeInvitations)
-	}
+func (m *InvitationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TeamMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case team.EdgeMembers:
-		ids := make([]ent.Value, 0, len(m.removedmembers))
-		for id := range m.removedmembers {
-			ids = append(ids, id)
-		}
-		return ids
-	case team.EdgeInvitations:
-		ids := make([]ent.Value, 0, len(m.removedinvitations))
-		for id := range m.removedinvitations {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *InvitationMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TeamMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.clearedtournament {
-		edges = append(edges, team.EdgeTournament)
-	}
-	if m.clearedcreator {
-		edges = append(edges, team.EdgeCreator)
-	}
-	if m.clearedmembers {
-		edges = append(edges, team.EdgeMembers)
+func (m *InvitationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedteam {
+		edges = append(edges, invitation.EdgeTeam)
 	}
-	if m.clearedrank_group {
-		edges = append(edges, team.EdgeRankGroup)
-	}
-	if m.clearedinvitations {
-		edges = append(edges, team.EdgeInvitations)
+	if m.clearedinvitee {
+		edges = append(edges, invitation.EdgeInvitee)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TeamMutation) EdgeCleared(name string) bool {
+func (m *InvitationMutation) EdgeCleared(name string) bool {
 	switch name {
-	case team.EdgeTournament:
-		return m.clearedtournament
-	case team.EdgeCreator:
-		return m.clearedcreator
-	case team.EdgeMembers:
-		return m.clearedmembers
-	case team.EdgeRankGroup:
-		return m.clearedrank_group
-	case team.EdgeInvitations:
-		return m.clearedinvitations
+	case invitation.EdgeTeam:
+		return m.clearedteam
+	case invitation.EdgeInvitee:
+		return m.clearedinvitee
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TeamMutation) ClearEdge(name string) error {
+func (m *InvitationMutation) ClearEdge(name string) error {
 	switch name {
-	case team.EdgeTournament:
-		m.ClearTournament()
-		return nil
-	case team.EdgeCreator:
-		m.ClearCreator()
+	case invitation.EdgeTeam:
+		m.ClearTeam()
 		return nil
-	case team.EdgeRankGroup:
-		m.ClearRankGroup()
+	case invitation.EdgeInvitee:
+		m.ClearInvitee()
 		return nil
 	}
-	return fmt.Errorf("unknown Team unique edge %s", name)
+	return fmt.Errorf("unknown Invitation unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TeamMutation) ResetEdge(name string) error {
+func (m *InvitationMutation) ResetEdge(name string) error {
 	switch name {
-	case team.EdgeTournament:
-		m.ResetTournament()
-		return nil
-	case team.EdgeCreator:
-		m.ResetCreator()
-		return nil
-	case team.EdgeMembers:
-		m.ResetMembers()
-		return nil
-	case team.EdgeRankGroup:
-		m.ResetRankGroup()
+	case invitation.EdgeTeam:
+		m.ResetTeam()
 		return nil
-	case team.EdgeInvitations:
-		m.ResetInvitations()
+	case invitation.EdgeInvitee:
+		m.ResetInvitee()
 		return nil
 	}
-	return fmt.Errorf("unknown Team edge %s", name)
+	return fmt.Errorf("unknown Invitation edge %s", name)
 }
 
-// TeamMemberMutation represents an operation that mutates the TeamMember nodes in the graph.
-type TeamMemberMutation struct {
+// NotificationMutation represents an operation that mutates the Notification nodes in the graph.
+type NotificationMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *int
-	role                 *string
-	can_receive_team_elo *bool
-	clearedFields        map[string]struct{}
-	user                 *int
-	cleareduser          bool
-	team                 *int
-	clearedteam          bool
-	tournament           *int
-	clearedtournament    bool
-	done                 bool
-	oldValue             func(context.Context) (*TeamMember, error)
-	predicates           []predicate.TeamMember
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	_type         *string
+	title         *string
+	message       *string
+	href          *string
+	read          *bool
+	read_at       *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*Notification, error)
+	predicates    []predicate.Notification
 }
 
-var _ ent.Mutation = (*TeamMemberMutation)(nil)
+var _ ent.Mutation = (*NotificationMutation)(nil)
 
-// teammemberOption allows management of the mutation configuration using functional options.
-type teammemberOption func(*TeamMemberMutation)
+// notificationOption allows management of the mutation configuration using functional options.
+type notificationOption func(*NotificationMutation)
 
-// newTeamMemberMutation creates new mutation for the TeamMember entity.
-func newTeamMemberMutation(c config, op Op, opts ...teammemberOption) *TeamMemberMutation {
-	m := &TeamMemberMutation{
+// newNotificationMutation creates new mutation for the Notification entity.
+func newNotificationMutation(c config, op Op, opts ...notificationOption) *NotificationMutation {
+	m := &NotificationMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTeamMember,
+		typ:           TypeNotification,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7813,20 +8963,20 @@ func newTeamMemberMutation(c config, op Op, opts ...teammemberOption) *TeamMembe
 	return m
 }
 
-// withTeamMemberID sets the ID field of the mutation.
-func withTeamMemberID(id int) teammemberOption {
-	return func(m *TeamMemberMutation) {
+// withNotificationID sets the ID field of the mutation.
+func withNotificationID(id int) notificationOption {
+	return func(m *NotificationMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *TeamMember
+			value *Notification
 		)
-		m.oldValue = func(ctx context.Context) (*TeamMember, error) {
+		m.oldValue = func(ctx context.Context) (*Notification, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().TeamMember.Get(ctx, id)
+					value, err = m.Client().Notification.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7835,10 +8985,10 @@ func withTeamMemberID(id int) teammemberOption {
 	}
 }
 
-// withTeamMember sets the old TeamMember of the mutation.
-func withTeamMember(node *TeamMember) teammemberOption {
-	return func(m *TeamMemberMutation) {
-		m.oldValue = func(context.Context) (*TeamMember, error) {
+// withNotification sets the old Notification of the mutation.
+func withNotification(node *Notification) notificationOption {
+	return func(m *NotificationMutation) {
+		m.oldValue = func(context.Context) (*Notification, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7847,7 +8997,7 @@ func withTeamMember(node *TeamMember) teammemberOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TeamMemberMutation) Client() *Client {
+func (m NotificationMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7855,7 +9005,7 @@ func (m TeamMemberMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TeamMemberMutation) Tx() (*Tx, error) {
+func (m NotificationMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -7866,7 +9016,7 @@ func (m TeamMemberMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TeamMemberMutation) ID() (id int, exists bool) {
+func (m *NotificationMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7877,7 +9027,7 @@ func (m *TeamMemberMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TeamMemberMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *NotificationMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -7886,210 +9036,351 @@ func (m *TeamMemberMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TeamMember.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Notification.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetRole sets the "role" field.
-func (m *TeamMemberMutation) SetRole(s string) {
-	m.role = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *NotificationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Role returns the value of the "role" field in the mutation.
-func (m *TeamMemberMutation) Role() (r string, exists bool) {
-	v := m.role
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NotificationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRole returns the old "role" field's value of the TeamMember entity.
-// If the TeamMember object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMemberMutation) OldRole(ctx context.Context) (v string, err error) {
+func (m *NotificationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRole requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Role, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetRole resets all changes to the "role" field.
-func (m *TeamMemberMutation) ResetRole() {
-	m.role = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NotificationMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetCanReceiveTeamElo sets the "can_receive_team_elo" field.
-func (m *TeamMemberMutation) SetCanReceiveTeamElo(b bool) {
-	m.can_receive_team_elo = &b
+// SetType sets the "type" field.
+func (m *NotificationMutation) SetType(s string) {
+	m._type = &s
 }
 
-// CanReceiveTeamElo returns the value of the "can_receive_team_elo" field in the mutation.
-func (m *TeamMemberMutation) CanReceiveTeamElo() (r bool, exists bool) {
-	v := m.can_receive_team_elo
+// GetType returns the value of the "type" field in the mutation.
+func (m *NotificationMutation) GetType() (r string, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCanReceiveTeamElo returns the old "can_receive_team_elo" field's value of the TeamMember entity.
-// If the TeamMember object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMemberMutation) OldCanReceiveTeamElo(ctx context.Context) (v bool, err error) {
+func (m *NotificationMutation) OldType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCanReceiveTeamElo is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCanReceiveTeamElo requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCanReceiveTeamElo: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.CanReceiveTeamElo, nil
-}
-
-// ResetCanReceiveTeamElo resets all changes to the "can_receive_team_elo" field.
-func (m *TeamMemberMutation) ResetCanReceiveTeamElo() {
-	m.can_receive_team_elo = nil
+	return oldValue.Type, nil
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *TeamMemberMutation) SetUserID(id int) {
-	m.user = &id
+// ResetType resets all changes to the "type" field.
+func (m *NotificationMutation) ResetType() {
+	m._type = nil
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *TeamMemberMutation) ClearUser() {
-	m.cleareduser = true
+// SetTitle sets the "title" field.
+func (m *NotificationMutation) SetTitle(s string) {
+	m.title = &s
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *TeamMemberMutation) UserCleared() bool {
-	return m.cleareduser
+// Title returns the value of the "title" field in the mutation.
+func (m *NotificationMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *TeamMemberMutation) UserID() (id int, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// OldTitle returns the old "title" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *TeamMemberMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ResetTitle resets all changes to the "title" field.
+func (m *NotificationMutation) ResetTitle() {
+	m.title = nil
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *TeamMemberMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// SetMessage sets the "message" field.
+func (m *NotificationMutation) SetMessage(s string) {
+	m.message = &s
 }
 
-// SetTeamID sets the "team" edge to the Team entity by id.
-func (m *TeamMemberMutation) SetTeamID(id int) {
-	m.team = &id
+// Message returns the value of the "message" field in the mutation.
+func (m *NotificationMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearTeam clears the "team" edge to the Team entity.
-func (m *TeamMemberMutation) ClearTeam() {
-	m.clearedteam = true
+// OldMessage returns the old "message" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
 }
 
-// TeamCleared reports if the "team" edge to the Team entity was cleared.
-func (m *TeamMemberMutation) TeamCleared() bool {
-	return m.clearedteam
+// ClearMessage clears the value of the "message" field.
+func (m *NotificationMutation) ClearMessage() {
+	m.message = nil
+	m.clearedFields[notification.FieldMessage] = struct{}{}
 }
 
-// TeamID returns the "team" edge ID in the mutation.
-func (m *TeamMemberMutation) TeamID() (id int, exists bool) {
-	if m.team != nil {
-		return *m.team, true
+// MessageCleared returns if the "message" field was cleared in this mutation.
+func (m *NotificationMutation) MessageCleared() bool {
+	_, ok := m.clearedFields[notification.FieldMessage]
+	return ok
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *NotificationMutation) ResetMessage() {
+	m.message = nil
+	delete(m.clearedFields, notification.FieldMessage)
+}
+
+// SetHref sets the "href" field.
+func (m *NotificationMutation) SetHref(s string) {
+	m.href = &s
+}
+
+// Href returns the value of the "href" field in the mutation.
+func (m *NotificationMutation) Href() (r string, exists bool) {
+	v := m.href
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// TeamIDs returns the "team" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TeamID instead. It exists only for internal usage by the builders.
-func (m *TeamMemberMutation) TeamIDs() (ids []int) {
-	if id := m.team; id != nil {
-		ids = append(ids, *id)
+// OldHref returns the old "href" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldHref(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHref is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHref requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHref: %w", err)
+	}
+	return oldValue.Href, nil
 }
 
-// ResetTeam resets all changes to the "team" edge.
-func (m *TeamMemberMutation) ResetTeam() {
-	m.team = nil
-	m.clearedteam = false
+// ClearHref clears the value of the "href" field.
+func (m *NotificationMutation) ClearHref() {
+	m.href = nil
+	m.clearedFields[notification.FieldHref] = struct{}{}
 }
 
-// SetTournamentID sets the "tournament" edge to the Tournament entity by id.
-func (m *TeamMemberMutation) SetTournamentID(id int) {
-	m.tournament = &id
+// HrefCleared returns if the "href" field was cleared in this mutation.
+func (m *NotificationMutation) HrefCleared() bool {
+	_, ok := m.clearedFields[notification.FieldHref]
+	return ok
 }
 
-// ClearTournament clears the "tournament" edge to the Tournament entity.
-func (m *TeamMemberMutation) ClearTournament() {
-	m.clearedtournament = true
+// ResetHref resets all changes to the "href" field.
+func (m *NotificationMutation) ResetHref() {
+	m.href = nil
+	delete(m.clearedFields, notification.FieldHref)
 }
 
-// TournamentCleared reports if the "tournament" edge to the Tournament entity was cleared.
-func (m *TeamMemberMutation) TournamentCleared() bool {
-	return m.clearedtournament
+// SetRead sets the "read" field.
+func (m *NotificationMutation) SetRead(b bool) {
+	m.read = &b
 }
 
-// TournamentID returns the "tournament" edge ID in the mutation.
-func (m *TeamMemberMutation) TournamentID() (id int, exists bool) {
-	if m.tournament != nil {
-		return *m.tournament, true
+// Read returns the value of the "read" field in the mutation.
+func (m *NotificationMutation) Read() (r bool, exists bool) {
+	v := m.read
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRead returns the old "read" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldRead(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRead is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRead requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRead: %w", err)
+	}
+	return oldValue.Read, nil
+}
+
+// ResetRead resets all changes to the "read" field.
+func (m *NotificationMutation) ResetRead() {
+	m.read = nil
+}
+
+// SetReadAt sets the "read_at" field.
+func (m *NotificationMutation) SetReadAt(t time.Time) {
+	m.read_at = &t
+}
+
+// ReadAt returns the value of the "read_at" field in the mutation.
+func (m *NotificationMutation) ReadAt() (r time.Time, exists bool) {
+	v := m.read_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReadAt returns the old "read_at" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldReadAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReadAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReadAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReadAt: %w", err)
+	}
+	return oldValue.ReadAt, nil
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (m *NotificationMutation) ClearReadAt() {
+	m.read_at = nil
+	m.clearedFields[notification.FieldReadAt] = struct{}{}
+}
+
+// ReadAtCleared returns if the "read_at" field was cleared in this mutation.
+func (m *NotificationMutation) ReadAtCleared() bool {
+	_, ok := m.clearedFields[notification.FieldReadAt]
+	return ok
+}
+
+// ResetReadAt resets all changes to the "read_at" field.
+func (m *NotificationMutation) ResetReadAt() {
+	m.read_at = nil
+	delete(m.clearedFields, notification.FieldReadAt)
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *NotificationMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *NotificationMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *NotificationMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *NotificationMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
 	return
 }
 
-// TournamentIDs returns the "tournament" edge IDs in the mutation.
+// UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TournamentID instead. It exists only for internal usage by the builders.
-func (m *TeamMemberMutation) TournamentIDs() (ids []int) {
-	if id := m.tournament; id != nil {
+// UserID instead. It exists only for internal usage by the builders.
+func (m *NotificationMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTournament resets all changes to the "tournament" edge.
-func (m *TeamMemberMutation) ResetTournament() {
-	m.tournament = nil
-	m.clearedtournament = false
+// ResetUser resets all changes to the "user" edge.
+func (m *NotificationMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the TeamMemberMutation builder.
-func (m *TeamMemberMutation) Where(ps ...predicate.TeamMember) {
+// Where appends a list predicates to the NotificationMutation builder.
+func (m *NotificationMutation) Where(ps ...predicate.Notification) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TeamMemberMutation builder. Using this method,
+// WhereP appends storage-level predicates to the NotificationMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TeamMemberMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TeamMember, len(ps))
+func (m *NotificationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Notification, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -8097,30 +9388,45 @@ func (m *TeamMemberMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TeamMemberMutation) Op() Op {
+func (m *NotificationMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TeamMemberMutation) SetOp(op Op) {
+func (m *NotificationMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (TeamMember).
-func (m *TeamMemberMutation) Type() string {
+// Type returns the node type of this mutation (Notification).
+func (m *NotificationMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TeamMemberMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.role != nil {
-		fields = append(fields, teammember.FieldRole)
-	}
-	if m.can_receive_team_elo != nil {
-		fields = append(fields, teammember.FieldCanReceiveTeamElo)
+func (m *NotificationMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, notification.FieldCreatedAt)
+	}
+	if m._type != nil {
+		fields = append(fields, notification.FieldType)
+	}
+	if m.title != nil {
+		fields = append(fields, notification.FieldTitle)
+	}
+	if m.message != nil {
+		fields = append(fields, notification.FieldMessage)
+	}
+	if m.href != nil {
+		fields = append(fields, notification.FieldHref)
+	}
+	if m.read != nil {
+		fields = append(fields, notification.FieldRead)
+	}
+	if m.read_at != nil {
+		fields = append(fields, notification.FieldReadAt)
 	}
 	return fields
 }
@@ -8128,12 +9434,22 @@ func (m *TeamMemberMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TeamMemberMutation) Field(name string) (ent.Value, bool) {
+func (m *NotificationMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case teammember.FieldRole:
-		return m.Role()
-	case teammember.FieldCanReceiveTeamElo:
-		return m.CanReceiveTeamElo()
+	case notification.FieldCreatedAt:
+		return m.CreatedAt()
+	case notification.FieldType:
+		return m.GetType()
+	case notification.FieldTitle:
+		return m.Title()
+	case notification.FieldMessage:
+		return m.Message()
+	case notification.FieldHref:
+		return m.Href()
+	case notification.FieldRead:
+		return m.Read()
+	case notification.FieldReadAt:
+		return m.ReadAt()
 	}
 	return nil, false
 }
@@ -8141,258 +9457,284 @@ func (m *TeamMemberMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TeamMemberMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *NotificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case teammember.FieldRole:
-		return m.OldRole(ctx)
-	case teammember.FieldCanReceiveTeamElo:
-		return m.OldCanReceiveTeamElo(ctx)
+	case notification.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case notification.FieldType:
+		return m.OldType(ctx)
+	case notification.FieldTitle:
+		return m.OldTitle(ctx)
+	case notification.FieldMessage:
+		return m.OldMessage(ctx)
+	case notification.FieldHref:
+		return m.OldHref(ctx)
+	case notification.FieldRead:
+		return m.OldRead(ctx)
+	case notification.FieldReadAt:
+		return m.OldReadAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown TeamMember field %s", name)
+	return nil, fmt.Errorf("unknown Notification field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TeamMemberMutation) SetField(name string, value ent.Value) error {
+func (m *NotificationMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case teammember.FieldRole:
+	case notification.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case notification.FieldType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRole(v)
+		m.SetType(v)
 		return nil
-	case teammember.FieldCanReceiveTeamElo:
+	case notification.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case notification.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	case notification.FieldHref:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHref(v)
+		return nil
+	case notification.FieldRead:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCanReceiveTeamElo(v)
+		m.SetRead(v)
+		return nil
+	case notification.FieldReadAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReadAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TeamMember field %s", name)
+	return fmt.Errorf("unknown Notification field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TeamMemberMutation) AddedFields() []string {
+func (m *NotificationMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TeamMemberMutation) AddedField(name string) (ent.Value, bool) {
+func (m *NotificationMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TeamMemberMutation) AddField(name string, value ent.Value) error {
+func (m *NotificationMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown TeamMember numeric field %s", name)
+	return fmt.Errorf("unknown Notification numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TeamMemberMutation) ClearedFields() []string {
-	return nil
+func (m *NotificationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(notification.FieldMessage) {
+		fields = append(fields, notification.FieldMessage)
+	}
+	if m.FieldCleared(notification.FieldHref) {
+		fields = append(fields, notification.FieldHref)
+	}
+	if m.FieldCleared(notification.FieldReadAt) {
+		fields = append(fields, notification.FieldReadAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TeamMemberMutation) FieldCleared(name string) bool {
+func (m *NotificationMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TeamMemberMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown TeamMember nullable field %s", name)
+func (m *NotificationMutation) ClearField(name string) error {
+	switch name {
+	case notification.FieldMessage:
+		m.ClearMessage()
+		return nil
+	case notification.FieldHref:
+		m.ClearHref()
+		return nil
+	case notification.FieldReadAt:
+		m.ClearReadAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TeamMemberMutation) ResetField(name string) error {
+func (m *NotificationMutation) ResetField(name string) error {
 	switch name {
-	case teammember.FieldRole:
-		m.ResetRole()
+	case notification.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case teammember.FieldCanReceiveTeamElo:
-		m.ResetCanReceiveTeamElo()
+	case notification.FieldType:
+		m.ResetType()
+		return nil
+	case notification.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case notification.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case notification.FieldHref:
+		m.ResetHref()
+		return nil
+	case notification.FieldRead:
+		m.ResetRead()
+		return nil
+	case notification.FieldReadAt:
+		m.ResetReadAt()
 		return nil
 	}
-	return fmt.Errorf("unknown TeamMember field %s", name)
+	return fmt.Errorf("unknown Notification field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TeamMemberMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *NotificationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.user != nil {
-		edges = append(edges, teammember.EdgeUser)
-	}
-	if m.team != nil {
-		edges = append(edges, teammember.EdgeTeam)
-	}
-	if m.tournament != nil {
-		edges = append(edges, teammember.EdgeTournament)
+		edges = append(edges, notification.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TeamMemberMutation) AddedIDs(name string) []ent.Value {
+func (m *NotificationMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case teammember.EdgeUser:
+	case notification.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case teammember.EdgeTeam:
-		if id := m.team; id != nil {
-			return []ent.Value{*id}
-		}
-	case teammember.EdgeTournament:
-		if id := m.tournament; id != nil {
-			return []ent.Value{*id}
-		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TeamMemberMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *NotificationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TeamMemberMutation) RemovedIDs(name string) []ent.Value {
+func (m *NotificationMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TeamMemberMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *NotificationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.cleareduser {
-		edges = append(edges, teammember.EdgeUser)
-	}
-	if m.clearedteam {
-		edges = append(edges, teammember.EdgeTeam)
-	}
-	if m.clearedtournament {
-		edges = append(edges, teammember.EdgeTournament)
+		edges = append(edges, notification.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TeamMemberMutation) EdgeCleared(name string) bool {
+func (m *NotificationMutation) EdgeCleared(name string) bool {
 	switch name {
-	case teammember.EdgeUser:
+	case notification.EdgeUser:
 		return m.cleareduser
-	case teammember.EdgeTeam:
-		return m.clearedteam
-	case teammember.EdgeTournament:
-		return m.clearedtournament
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TeamMemberMutation) ClearEdge(name string) error {
+func (m *NotificationMutation) ClearEdge(name string) error {
 	switch name {
-	case teammember.EdgeUser:
+	case notification.EdgeUser:
 		m.ClearUser()
 		return nil
-	case teammember.EdgeTeam:
-		m.ClearTeam()
-		return nil
-	case teammember.EdgeTournament:
-		m.ClearTournament()
-		return nil
 	}
-	return fmt.Errorf("unknown TeamMember unique edge %s", name)
+	return fmt.Errorf("unknown Notification unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TeamMemberMutation) ResetEdge(name string) error {
+func (m *NotificationMutation) ResetEdge(name string) error {
 	switch name {
-	case teammember.EdgeUser:
+	case notification.EdgeUser:
 		m.ResetUser()
 		return nil
-	case teammember.EdgeTeam:
-		m.ResetTeam()
-		return nil
-	case teammember.EdgeTournament:
-		m.ResetTournament()
-		return nil
 	}
-	return fmt.Errorf("unknown TeamMember edge %s", name)
+	return fmt.Errorf("unknown Notification edge %s", name)
 }
 
-// TournamentMutation represents an operation that mutates the Tournament nodes in the graph.
-type TournamentMutation struct {
+// RankGroupMutation represents an operation that mutates the RankGroup nodes in the graph.
+type RankGroupMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *int
-	slug                  *string
-	name                  *string
-	description           *string
-	image_url             *string
-	is_visible            *bool
-	registration_start    *time.Time
-	registration_end      *time.Time
-	tournament_start      *time.Time
-	tournament_end        *time.Time
-	max_teams             *int
-	addmax_teams          *int
-	team_structure        *map[string]interface{}
-	custom_page_component *string
-	external_links        *map[string]string
-	tier                  *tournament.Tier
-	created_at            *time.Time
-	updated_at            *time.Time
-	clearedFields         map[string]struct{}
-	creator               *int
-	clearedcreator        bool
-	admins                map[int]struct{}
-	removedadmins         map[int]struct{}
-	clearedadmins         bool
-	teams                 map[int]struct{}
-	removedteams          map[int]struct{}
-	clearedteams          bool
-	rank_groups           map[int]struct{}
-	removedrank_groups    map[int]struct{}
-	clearedrank_groups    bool
-	team_members          map[int]struct{}
-	removedteam_members   map[int]struct{}
-	clearedteam_members   bool
-	done                  bool
-	oldValue              func(context.Context) (*Tournament, error)
-	predicates            []predicate.Tournament
+	op                Op
+	typ               string
+	id                *int
+	name              *string
+	rank_min          *int
+	addrank_min       *int
+	rank_max          *int
+	addrank_max       *int
+	position          *int
+	addposition       *int
+	clearedFields     map[string]struct{}
+	tournament        *int
+	clearedtournament bool
+	teams             map[int]struct{}
+	removedteams      map[int]struct{}
+	clearedteams      bool
+	done              bool
+	oldValue          func(context.Context) (*RankGroup, error)
+	predicates        []predicate.RankGroup
 }
 
-var _ ent.Mutation = (*TournamentMutation)(nil)
+var _ ent.Mutation = (*RankGroupMutation)(nil)
 
-// tournamentOption allows management of the mutation configuration using functional options.
-type tournamentOption func(*TournamentMutation)
+// rankgroupOption allows management of the mutation configuration using functional options.
+type rankgroupOption func(*RankGroupMutation)
 
-// newTournamentMutation creates new mutation for the Tournament entity.
-func newTournamentMutation(c config, op Op, opts ...tournamentOption) *TournamentMutation {
-	m := &TournamentMutation{
+// newRankGroupMutation creates new mutation for the RankGroup entity.
+func newRankGroupMutation(c config, op Op, opts ...rankgroupOption) *RankGroupMutation {
+	m := &RankGroupMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTournament,
+		typ:           TypeRankGroup,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -8401,20 +9743,20 @@ func newTournamentMutation(c config, op Op, opts ...tournamentOption) *Tournamen
 	return m
 }
 
-// withTournamentID sets the ID field of the mutation.
-func withTournamentID(id int) tournamentOption {
-	return func(m *TournamentMutation) {
+// withRankGroupID sets the ID field of the mutation.
+func withRankGroupID(id int) rankgroupOption {
+	return func(m *RankGroupMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Tournament
+			value *RankGroup
 		)
-		m.oldValue = func(ctx context.Context) (*Tournament, error) {
+		m.oldValue = func(ctx context.Context) (*RankGroup, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Tournament.Get(ctx, id)
+					value, err = m.Client().RankGroup.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -8423,10 +9765,10 @@ func withTournamentID(id int) tournamentOption {
 	}
 }
 
-// withTournament sets the old Tournament of the mutation.
-func withTournament(node *Tournament) tournamentOption {
-	return func(m *TournamentMutation) {
-		m.oldValue = func(context.Context) (*Tournament, error) {
+// withRankGroup sets the old RankGroup of the mutation.
+func withRankGroup(node *RankGroup) rankgroupOption {
+	return func(m *RankGroupMutation) {
+		m.oldValue = func(context.Context) (*RankGroup, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -8435,7 +9777,7 @@ func withTournament(node *Tournament) tournamentOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TournamentMutation) Client() *Client {
+func (m RankGroupMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -8443,7 +9785,7 @@ func (m TournamentMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TournamentMutation) Tx() (*Tx, error) {
+func (m RankGroupMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -8454,7 +9796,7 @@ func (m TournamentMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TournamentMutation) ID() (id int, exists bool) {
+func (m *RankGroupMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -8465,7 +9807,7 @@ func (m *TournamentMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TournamentMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *RankGroupMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -8474,55 +9816,19 @@ func (m *TournamentMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Tournament.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().RankGroup.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSlug sets the "slug" field.
-func (m *TournamentMutation) SetSlug(s string) {
-	m.slug = &s
-}
-
-// Slug returns the value of the "slug" field in the mutation.
-func (m *TournamentMutation) Slug() (r string, exists bool) {
-	v := m.slug
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldSlug returns the old "slug" field's value of the Tournament entity.
-// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TournamentMutation) OldSlug(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSlug requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
-	}
-	return oldValue.Slug, nil
-}
-
-// ResetSlug resets all changes to the "slug" field.
-func (m *TournamentMutation) ResetSlug() {
-	m.slug = nil
-}
-
 // SetName sets the "name" field.
-func (m *TournamentMutation) SetName(s string) {
+func (m *RankGroupMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *TournamentMutation) Name() (r string, exists bool) {
+func (m *RankGroupMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -8530,10 +9836,10 @@ func (m *TournamentMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Tournament entity.
-// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the RankGroup entity.
+// If the RankGroup object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TournamentMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *RankGroupMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -8548,850 +9854,969 @@ func (m *TournamentMutation) OldName(ctx context.Context) (v string, err error)
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *TournamentMutation) ResetName() {
+func (m *RankGroupMutation) ResetName() {
 	m.name = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *TournamentMutation) SetDescription(s string) {
-	m.description = &s
+// SetRankMin sets the "rank_min" field.
+func (m *RankGroupMutation) SetRankMin(i int) {
+	m.rank_min = &i
+	m.addrank_min = nil
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *TournamentMutation) Description() (r string, exists bool) {
-	v := m.description
+// RankMin returns the value of the "rank_min" field in the mutation.
+func (m *RankGroupMutation) RankMin() (r int, exists bool) {
+	v := m.rank_min
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Tournament entity.
-// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
+// OldRankMin returns the old "rank_min" field's value of the RankGroup entity.
+// If the RankGroup object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TournamentMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *RankGroupMutation) OldRankMin(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldRankMin is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldRankMin requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldRankMin: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ResetDescription resets all changes to the "description" field.
-func (m *TournamentMutation) ResetDescription() {
-	m.description = nil
+	return oldValue.RankMin, nil
 }
 
-// SetImageURL sets the "image_url" field.
-func (m *TournamentMutation) SetImageURL(s string) {
-	m.image_url = &s
+// AddRankMin adds i to the "rank_min" field.
+func (m *RankGroupMutation) AddRankMin(i int) {
+	if m.addrank_min != nil {
+		*m.addrank_min += i
+	} else {
+		m.addrank_min = &i
+	}
 }
 
-// ImageURL returns the value of the "image_url" field in the mutation.
-func (m *TournamentMutation) ImageURL() (r string, exists bool) {
-	v := m.image_url
+// AddedRankMin returns the value that was added to the "rank_min" field in this mutation.
+func (m *RankGroupMutation) AddedRankMin() (r int, exists bool) {
+	v := m.addrank_min
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldImageURL returns the old "image_url" field's value of the Tournament entity.
-// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TournamentMutation) OldImageURL(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImageURL is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImageURL requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImageURL: %w", err)
-	}
-	return oldValue.ImageURL, nil
-}
-
-// ClearImageURL clears the value of the "image_url" field.
-func (m *TournamentMutation) ClearImageURL() {
-	m.image_url = nil
-	m.clearedFields[tournament.FieldImageURL] = struct{}{}
-}
-
-// ImageURLCleared returns if the "image_url" field was cleared in this mutation.
-func (m *TournamentMutation) ImageURLCleared() bool {
-	_, ok := m.clearedFields[tournament.FieldImageURL]
-	return ok
-}
-
-// ResetImageURL resets all changes to the "image_url" field.
-func (m *TournamentMutation) ResetImageURL() {
-	m.image_url = nil
-	delete(m.clearedFields, tournament.FieldImageURL)
+// ResetRankMin resets all changes to the "rank_min" field.
+func (m *RankGroupMutation) ResetRankMin() {
+	m.rank_min = nil
+	m.addrank_min = nil
 }
 
-// SetIsVisible sets the "is_visible" field.
-func (m *TournamentMutation) SetIsVisible(b bool) {
-	m.is_visible = &b
+// SetRankMax sets the "rank_max" field.
+func (m *RankGroupMutation) SetRankMax(i int) {
+	m.rank_max = &i
+	m.addrank_max = nil
 }
 
-// IsVisible returns the value of the "is_visible" field in the mutation.
-func (m *TournamentMutation) IsVisible() (r bool, exists bool) {
-	v := m.is_visible
+// RankMax returns the value of the "rank_max" field in the mutation.
+func (m *RankGroupMutation) RankMax() (r int, exists bool) {
+	v := m.rank_max
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsVisible returns the old "is_visible" field's value of the Tournament entity.
-// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
+// OldRankMax returns the old "rank_max" field's value of the RankGroup entity.
+// If the RankGroup object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TournamentMutation) OldIsVisible(ctx context.Context) (v bool, err error) {
+func (m *RankGroupMutation) OldRankMax(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsVisible is only allowed on UpdateOne operations")
+		return v, errors.New("OldRankMax is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsVisible requires an ID field in the mutation")
+		return v, errors.New("OldRankMax requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsVisible: %w", err)
+		return v, fmt.Errorf("querying old value for OldRankMax: %w", err)
 	}
-	return oldValue.IsVisible, nil
-}
-
-// ResetIsVisible resets all changes to the "is_visible" field.
-func (m *TournamentMutation) ResetIsVisible() {
-	m.is_visible = nil
+	return oldValue.RankMax, nil
 }
 
-// SetRegistrationStart sets the "registration_start" field.
-func (m *TournamentMutation) SetRegistrationStart(t time.Time) {
-	m.registration_start = &t
+// AddRankMax adds i to the "rank_max" field.
+func (m *RankGroupMutation) AddRankMax(i int) {
+	if m.addrank_max != nil {
+		*m.addrank_max += i
+	} else {
+		m.addrank_max = &i
+	}
 }
 
-// RegistrationStart returns the value of the "registration_start" field in the mutation.
-func (m *TournamentMutation) RegistrationStart() (r time.Time, exists bool) {
-	v := m.registration_start
+// AddedRankMax returns the value that was added to the "rank_max" field in this mutation.
+func (m *RankGroupMutation) AddedRankMax() (r int, exists bool) {
+	v := m.addrank_max
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRegistrationStart returns the old "registration_start" field's value of the Tournament entity.
-// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TournamentMutation) OldRegistrationStart(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRegistrationStart is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRegistrationStart requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRegistrationStart: %w", err)
-	}
-	return oldValue.RegistrationStart, nil
-}
-
-// ResetRegistrationStart resets all changes to the "registration_start" field.
-func (m *TournamentMutation) ResetRegistrationStart() {
-	m.registration_start = nil
+// ResetRankMax resets all changes to the "rank_max" field.
+func (m *RankGroupMutation) ResetRankMax() {
+	m.rank_max = nil
+	m.addrank_max = nil
 }
 
-// SetRegistrationEnd sets the "registration_end" field.
-func (m *TournamentMutation) SetRegistrationEnd(t time.Time) {
-	m.registration_end = &t
+// SetPosition sets the "position" field.
+func (m *RankGroupMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
 }
 
-// RegistrationEnd returns the value of the "registration_end" field in the mutation.
-func (m *TournamentMutation) RegistrationEnd() (r time.Time, exists bool) {
-	v := m.registration_end
+// Position returns the value of the "position" field in the mutation.
+func (m *RankGroupMutation) Position() (r int, exists bool) {
+	v := m.position
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRegistrationEnd returns the old "registration_end" field's value of the Tournament entity.
-// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
+// OldPosition returns the old "position" field's value of the RankGroup entity.
+// If the RankGroup object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TournamentMutation) OldRegistrationEnd(ctx context.Context) (v time.Time, err error) {
+func (m *RankGroupMutation) OldPosition(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRegistrationEnd is only allowed on UpdateOne operations")
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRegistrationEnd requires an ID field in the mutation")
+		return v, errors.New("OldPosition requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRegistrationEnd: %w", err)
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
 	}
-	return oldValue.RegistrationEnd, nil
-}
-
-// ResetRegistrationEnd resets all changes to the "registration_end" field.
-func (m *TournamentMutation) ResetRegistrationEnd() {
-	m.registration_end = nil
+	return oldValue.Position, nil
 }
 
-// SetTournamentStart sets the "tournament_start" field.
-func (m *TournamentMutation) SetTournamentStart(t time.Time) {
-	m.tournament_start = &t
+// AddPosition adds i to the "position" field.
+func (m *RankGroupMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
 }
 
-// TournamentStart returns the value of the "tournament_start" field in the mutation.
-func (m *TournamentMutation) TournamentStart() (r time.Time, exists bool) {
-	v := m.tournament_start
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *RankGroupMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTournamentStart returns the old "tournament_start" field's value of the Tournament entity.
-// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TournamentMutation) OldTournamentStart(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTournamentStart is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTournamentStart requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTournamentStart: %w", err)
-	}
-	return oldValue.TournamentStart, nil
+// ResetPosition resets all changes to the "position" field.
+func (m *RankGroupMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
 }
 
-// ResetTournamentStart resets all changes to the "tournament_start" field.
-func (m *TournamentMutation) ResetTournamentStart() {
-	m.tournament_start = nil
+// SetTournamentID sets the "tournament" edge to the Tournament entity by id.
+func (m *RankGroupMutation) SetTournamentID(id int) {
+	m.tournament = &id
 }
 
-// SetTournamentEnd sets the "tournament_end" field.
-func (m *TournamentMutation) SetTournamentEnd(t time.Time) {
-	m.tournament_end = &t
+// ClearTournament clears the "tournament" edge to the Tournament entity.
+func (m *RankGroupMutation) ClearTournament() {
+	m.clearedtournament = true
 }
 
-// TournamentEnd returns the value of the "tournament_end" field in the mutation.
-func (m *TournamentMutation) TournamentEnd() (r time.Time, exists bool) {
-	v := m.tournament_end
-	if v == nil {
-		return
-	}
-	return *v, true
+// TournamentCleared reports if the "tournament" edge to the Tournament entity was cleared.
+func (m *RankGroupMutation) TournamentCleared() bool {
+	return m.clearedtournament
 }
 
-// OldTournamentEnd returns the old "tournament_end" field's value of the Tournament entity.
-// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TournamentMutation) OldTournamentEnd(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTournamentEnd is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTournamentEnd requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTournamentEnd: %w", err)
+// TournamentID returns the "tournament" edge ID in the mutation.
+func (m *RankGroupMutation) TournamentID() (id int, exists bool) {
+	if m.tournament != nil {
+		return *m.tournament, true
 	}
-	return oldValue.TournamentEnd, nil
+	return
 }
 
-// ClearTournamentEnd clears the value of the "tournament_end" field.
-func (m *TournamentMutation) ClearTournamentEnd() {
-	m.tournament_end = nil
-	m.clearedFields[tournament.FieldTournamentEnd] = struct{}{}
+// TournamentIDs returns the "tournament" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TournamentID instead. It exists only for internal usage by the builders.
+func (m *RankGroupMutation) TournamentIDs() (ids []int) {
+	if id := m.tournament; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// TournamentEndCleared returns if the "tournament_end" field was cleared in this mutation.
-func (m *TournamentMutation) TournamentEndCleared() bool {
-	_, ok := m.clearedFields[tournament.FieldTournamentEnd]
-	return ok
+// ResetTournament resets all changes to the "tournament" edge.
+func (m *RankGroupMutation) ResetTournament() {
+	m.tournament = nil
+	m.clearedtournament = false
 }
 
-// ResetTournamentEnd resets all changes to the "tournament_end" field.
-func (m *TournamentMutation) ResetTournamentEnd() {
-	m.tournament_end = nil
-	delete(m.clearedFields, tournament.FieldTournamentEnd)
+// AddTeamIDs adds the "teams" edge to the Team entity by ids.
+func (m *RankGroupMutation) AddTeamIDs(ids ...int) {
+	if m.teams == nil {
+		m.teams = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.teams[ids[i]] = struct{}{}
+	}
 }
 
-// SetMaxTeams sets the "max_teams" field.
-func (m *TournamentMutation) SetMaxTeams(i int) {
-	m.max_teams = &i
-	m.addmax_teams = nil
+// ClearTeams clears the "teams" edge to the Team entity.
+func (m *RankGroupMutation) ClearTeams() {
+	m.clearedteams = true
 }
 
-// MaxTeams returns the value of the "max_teams" field in the mutation.
-func (m *TournamentMutation) MaxTeams() (r int, exists bool) {
-	v := m.max_teams
-	if v == nil {
-		return
-	}
-	return *v, true
+// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
+func (m *RankGroupMutation) TeamsCleared() bool {
+	return m.clearedteams
 }
 
-// OldMaxTeams returns the old "max_teams" field's value of the Tournament entity.
-// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TournamentMutation) OldMaxTeams(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMaxTeams is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMaxTeams requires an ID field in the mutation")
+// RemoveTeamIDs removes the "teams" edge to the Team entity by IDs.
+func (m *RankGroupMutation) RemoveTeamIDs(ids ...int) {
+	if m.removedteams == nil {
+		m.removedteams = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMaxTeams: %w", err)
+	for i := range ids {
+		delete(m.teams, ids[i])
+		m.removedteams[ids[i]] = struct{}{}
 	}
-	return oldValue.MaxTeams, nil
 }
 
-// AddMaxTeams adds i to the "max_teams" field.
-func (m *TournamentMutation) AddMaxTeams(i int) {
-	if m.addmax_teams != nil {
-		*m.addmax_teams += i
-	} else {
-		m.addmax_teams = &i
+// RemovedTeams returns the removed IDs of the "teams" edge to the Team entity.
+func (m *RankGroupMutation) RemovedTeamsIDs() (ids []int) {
+	for id := range m.removedteams {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedMaxTeams returns the value that was added to the "max_teams" field in this mutation.
-func (m *TournamentMutation) AddedMaxTeams() (r int, exists bool) {
-	v := m.addmax_teams
-	if v == nil {
-		return
+// TeamsIDs returns the "teams" edge IDs in the mutation.
+func (m *RankGroupMutation) TeamsIDs() (ids []int) {
+	for id := range m.teams {
+		ids = append(ids, id)
 	}
-	return *v, true
-}
-
-// ResetMaxTeams resets all changes to the "max_teams" field.
-func (m *TournamentMutation) ResetMaxTeams() {
-	m.max_teams = nil
-	m.addmax_teams = nil
+	return
 }
 
-// SetTeamStructure sets the "team_structure" field.
-func (m *TournamentMutation) SetTeamStructure(value map[string]interface{}) {
-	m.team_structure = &value
+// ResetTeams resets all changes to the "teams" edge.
+func (m *RankGroupMutation) ResetTeams() {
+	m.teams = nil
+	m.clearedteams = false
+	m.removedteams = nil
 }
 
-// TeamStructure returns the value of the "team_structure" field in the mutation.
-func (m *TournamentMutation) TeamStructure() (r map[string]interface{}, exists bool) {
-	v := m.team_structure
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the RankGroupMutation builder.
+func (m *RankGroupMutation) Where(ps ...predicate.RankGroup) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OldTeamStructure returns the old "team_structure" field's value of the Tournament entity.
-// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TournamentMutation) OldTeamStructure(ctx context.Context) (v map[string]interface{}, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTeamStructure is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTeamStructure requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTeamStructure: %w", err)
+// WhereP appends storage-level predicates to the RankGroupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RankGroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RankGroup, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.TeamStructure, nil
-}
-
-// ClearTeamStructure clears the value of the "team_structure" field.
-func (m *TournamentMutation) ClearTeamStructure() {
-	m.team_structure = nil
-	m.clearedFields[tournament.FieldTeamStructure] = struct{}{}
+	m.Where(p...)
 }
 
-// TeamStructureCleared returns if the "team_structure" field was cleared in this mutation.
-func (m *TournamentMutation) TeamStructureCleared() bool {
-	_, ok := m.clearedFields[tournament.FieldTeamStructure]
-	return ok
+// Op returns the operation name.
+func (m *RankGroupMutation) Op() Op {
+	return m.op
 }
 
-// ResetTeamStructure resets all changes to the "team_structure" field.
-func (m *TournamentMutation) ResetTeamStructure() {
-	m.team_structure = nil
-	delete(m.clearedFields, tournament.FieldTeamStructure)
+// SetOp allows setting the mutation operation.
+func (m *RankGroupMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetCustomPageComponent sets the "custom_page_component" field.
-func (m *TournamentMutation) SetCustomPageComponent(s string) {
-	m.custom_page_component = &s
+// Type returns the node type of this mutation (RankGroup).
+func (m *RankGroupMutation) Type() string {
+	return m.typ
 }
 
-// CustomPageComponent returns the value of the "custom_page_component" field in the mutation.
-func (m *TournamentMutation) CustomPageComponent() (r string, exists bool) {
-	v := m.custom_page_component
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RankGroupMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, rankgroup.FieldName)
 	}
-	return *v, true
-}
-
-// OldCustomPageComponent returns the old "custom_page_component" field's value of the Tournament entity.
-// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TournamentMutation) OldCustomPageComponent(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCustomPageComponent is only allowed on UpdateOne operations")
+	if m.rank_min != nil {
+		fields = append(fields, rankgroup.FieldRankMin)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCustomPageComponent requires an ID field in the mutation")
+	if m.rank_max != nil {
+		fields = append(fields, rankgroup.FieldRankMax)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCustomPageComponent: %w", err)
+	if m.position != nil {
+		fields = append(fields, rankgroup.FieldPosition)
 	}
-	return oldValue.CustomPageComponent, nil
+	return fields
 }
 
-// ResetCustomPageComponent resets all changes to the "custom_page_component" field.
-func (m *TournamentMutation) ResetCustomPageComponent() {
-	m.custom_page_component = nil
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RankGroupMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case rankgroup.FieldName:
+		return m.Name()
+	case rankgroup.FieldRankMin:
+		return m.RankMin()
+	case rankgroup.FieldRankMax:
+		return m.RankMax()
+	case rankgroup.FieldPosition:
+		return m.Position()
+	}
+	return nil, false
 }
 
-// SetExternalLinks sets the "external_links" field.
-func (m *TournamentMutation) SetExternalLinks(value map[string]string) {
-	m.external_links = &value
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RankGroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case rankgroup.FieldName:
+		return m.OldName(ctx)
+	case rankgroup.FieldRankMin:
+		return m.OldRankMin(ctx)
+	case rankgroup.FieldRankMax:
+		return m.OldRankMax(ctx)
+	case rankgroup.FieldPosition:
+		return m.OldPosition(ctx)
+	}
+	return nil, fmt.Errorf("unknown RankGroup field %s", name)
 }
 
-// ExternalLinks returns the value of the "external_links" field in the mutation.
-func (m *TournamentMutation) ExternalLinks() (r map[string]string, exists bool) {
-	v := m.external_links
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RankGroupMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case rankgroup.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case rankgroup.FieldRankMin:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRankMin(v)
+		return nil
+	case rankgroup.FieldRankMax:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRankMax(v)
+		return nil
+	case rankgroup.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown RankGroup field %s", name)
 }
 
-// OldExternalLinks returns the old "external_links" field's value of the Tournament entity.
-// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TournamentMutation) OldExternalLinks(ctx context.Context) (v map[string]string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExternalLinks is only allowed on UpdateOne operations")
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RankGroupMutation) AddedFields() []string {
+	var fields []string
+	if m.addrank_min != nil {
+		fields = append(fields, rankgroup.FieldRankMin)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExternalLinks requires an ID field in the mutation")
+	if m.addrank_max != nil {
+		fields = append(fields, rankgroup.FieldRankMax)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExternalLinks: %w", err)
+	if m.addposition != nil {
+		fields = append(fields, rankgroup.FieldPosition)
 	}
-	return oldValue.ExternalLinks, nil
+	return fields
 }
 
-// ClearExternalLinks clears the value of the "external_links" field.
-func (m *TournamentMutation) ClearExternalLinks() {
-	m.external_links = nil
-	m.clearedFields[tournament.FieldExternalLinks] = struct{}{}
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RankGroupMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case rankgroup.FieldRankMin:
+		return m.AddedRankMin()
+	case rankgroup.FieldRankMax:
+		return m.AddedRankMax()
+	case rankgroup.FieldPosition:
+		return m.AddedPosition()
+	}
+	return nil, false
 }
 
-// ExternalLinksCleared returns if the "external_links" field was cleared in this mutation.
-func (m *TournamentMutation) ExternalLinksCleared() bool {
-	_, ok := m.clearedFields[tournament.FieldExternalLinks]
-	return ok
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RankGroupMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case rankgroup.FieldRankMin:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRankMin(v)
+		return nil
+	case rankgroup.FieldRankMax:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRankMax(v)
+		return nil
+	case rankgroup.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RankGroup numeric field %s", name)
 }
 
-// ResetExternalLinks resets all changes to the "external_links" field.
-func (m *TournamentMutation) ResetExternalLinks() {
-	m.external_links = nil
-	delete(m.clearedFields, tournament.FieldExternalLinks)
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RankGroupMutation) ClearedFields() []string {
+	return nil
 }
 
-// SetTier sets the "tier" field.
-func (m *TournamentMutation) SetTier(t tournament.Tier) {
-	m.tier = &t
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RankGroupMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// Tier returns the value of the "tier" field in the mutation.
-func (m *TournamentMutation) Tier() (r tournament.Tier, exists bool) {
-	v := m.tier
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RankGroupMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RankGroup nullable field %s", name)
 }
 
-// OldTier returns the old "tier" field's value of the Tournament entity.
-// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TournamentMutation) OldTier(ctx context.Context) (v tournament.Tier, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTier is only allowed on UpdateOne operations")
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RankGroupMutation) ResetField(name string) error {
+	switch name {
+	case rankgroup.FieldName:
+		m.ResetName()
+		return nil
+	case rankgroup.FieldRankMin:
+		m.ResetRankMin()
+		return nil
+	case rankgroup.FieldRankMax:
+		m.ResetRankMax()
+		return nil
+	case rankgroup.FieldPosition:
+		m.ResetPosition()
+		return nil
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTier requires an ID field in the mutation")
+	return fmt.Errorf("unknown RankGroup field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RankGroupMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.tournament != nil {
+		edges = append(edges, rankgroup.EdgeTournament)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTier: %w", err)
+	if m.teams != nil {
+		edges = append(edges, rankgroup.EdgeTeams)
 	}
-	return oldValue.Tier, nil
+	return edges
 }
 
-// ResetTier resets all changes to the "tier" field.
-func (m *TournamentMutation) ResetTier() {
-	m.tier = nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RankGroupMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case rankgroup.EdgeTournament:
+		if id := m.tournament; id != nil {
+			return []ent.Value{*id}
+		}
+	case rankgroup.EdgeTeams:
+		ids := make([]ent.Value, 0, len(m.teams))
+		for id := range m.teams {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *TournamentMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RankGroupMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedteams != nil {
+		edges = append(edges, rankgroup.EdgeTeams)
+	}
+	return edges
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TournamentMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RankGroupMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case rankgroup.EdgeTeams:
+		ids := make([]ent.Value, 0, len(m.removedteams))
+		for id := range m.removedteams {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return *v, true
+	return nil
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Tournament entity.
-// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TournamentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RankGroupMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtournament {
+		edges = append(edges, rankgroup.EdgeTournament)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	if m.clearedteams {
+		edges = append(edges, rankgroup.EdgeTeams)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TournamentMutation) ResetCreatedAt() {
-	m.created_at = nil
+	return edges
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *TournamentMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RankGroupMutation) EdgeCleared(name string) bool {
+	switch name {
+	case rankgroup.EdgeTournament:
+		return m.clearedtournament
+	case rankgroup.EdgeTeams:
+		return m.clearedteams
+	}
+	return false
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *TournamentMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RankGroupMutation) ClearEdge(name string) error {
+	switch name {
+	case rankgroup.EdgeTournament:
+		m.ClearTournament()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown RankGroup unique edge %s", name)
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Tournament entity.
-// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TournamentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RankGroupMutation) ResetEdge(name string) error {
+	switch name {
+	case rankgroup.EdgeTournament:
+		m.ResetTournament()
+		return nil
+	case rankgroup.EdgeTeams:
+		m.ResetTeams()
+		return nil
 	}
-	return oldValue.UpdatedAt, nil
+	return fmt.Errorf("unknown RankGroup edge %s", name)
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *TournamentMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// RoleGrantMutation represents an operation that mutates the RoleGrant nodes in the graph.
+type RoleGrantMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	role              *string
+	reason            *string
+	expires_at        *time.Time
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	user              *int
+	cleareduser       bool
+	granted_by        *int
+	clearedgranted_by bool
+	done              bool
+	oldValue          func(context.Context) (*RoleGrant, error)
+	predicates        []predicate.RoleGrant
 }
 
-// SetCreatorID sets the "creator" edge to the User entity by id.
-func (m *TournamentMutation) SetCreatorID(id int) {
-	m.creator = &id
-}
+var _ ent.Mutation = (*RoleGrantMutation)(nil)
 
-// ClearCreator clears the "creator" edge to the User entity.
-func (m *TournamentMutation) ClearCreator() {
-	m.clearedcreator = true
-}
+// rolegrantOption allows management of the mutation configuration using functional options.
+type rolegrantOption func(*RoleGrantMutation)
 
-// CreatorCleared reports if the "creator" edge to the User entity was cleared.
-func (m *TournamentMutation) CreatorCleared() bool {
-	return m.clearedcreator
+// newRoleGrantMutation creates new mutation for the RoleGrant entity.
+func newRoleGrantMutation(c config, op Op, opts ...rolegrantOption) *RoleGrantMutation {
+	m := &RoleGrantMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRoleGrant,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// CreatorID returns the "creator" edge ID in the mutation.
-func (m *TournamentMutation) CreatorID() (id int, exists bool) {
-	if m.creator != nil {
-		return *m.creator, true
+// withRoleGrantID sets the ID field of the mutation.
+func withRoleGrantID(id int) rolegrantOption {
+	return func(m *RoleGrantMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RoleGrant
+		)
+		m.oldValue = func(ctx context.Context) (*RoleGrant, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RoleGrant.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return
 }
 
-// CreatorIDs returns the "creator" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CreatorID instead. It exists only for internal usage by the builders.
-func (m *TournamentMutation) CreatorIDs() (ids []int) {
-	if id := m.creator; id != nil {
-		ids = append(ids, *id)
+// withRoleGrant sets the old RoleGrant of the mutation.
+func withRoleGrant(node *RoleGrant) rolegrantOption {
+	return func(m *RoleGrantMutation) {
+		m.oldValue = func(context.Context) (*RoleGrant, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return
 }
 
-// ResetCreator resets all changes to the "creator" edge.
-func (m *TournamentMutation) ResetCreator() {
-	m.creator = nil
-	m.clearedcreator = false
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoleGrantMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AddAdminIDs adds the "admins" edge to the TournamentAdmin entity by ids.
-func (m *TournamentMutation) AddAdminIDs(ids ...int) {
-	if m.admins == nil {
-		m.admins = make(map[int]struct{})
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoleGrantMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	for i := range ids {
-		m.admins[ids[i]] = struct{}{}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoleGrantMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
+	return *m.id, true
 }
 
-// ClearAdmins clears the "admins" edge to the TournamentAdmin entity.
-func (m *TournamentMutation) ClearAdmins() {
-	m.clearedadmins = true
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoleGrantMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RoleGrant.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// AdminsCleared reports if the "admins" edge to the TournamentAdmin entity was cleared.
-func (m *TournamentMutation) AdminsCleared() bool {
-	return m.clearedadmins
+// SetRole sets the "role" field.
+func (m *RoleGrantMutation) SetRole(s string) {
+	m.role = &s
 }
 
-// RemoveAdminIDs removes the "admins" edge to the TournamentAdmin entity by IDs.
-func (m *TournamentMutation) RemoveAdminIDs(ids ...int) {
-	if m.removedadmins == nil {
-		m.removedadmins = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.admins, ids[i])
-		m.removedadmins[ids[i]] = struct{}{}
+// Role returns the value of the "role" field in the mutation.
+func (m *RoleGrantMutation) Role() (r string, exists bool) {
+	v := m.role
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedAdmins returns the removed IDs of the "admins" edge to the TournamentAdmin entity.
-func (m *TournamentMutation) RemovedAdminsIDs() (ids []int) {
-	for id := range m.removedadmins {
-		ids = append(ids, id)
+// OldRole returns the old "role" field's value of the RoleGrant entity.
+// If the RoleGrant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleGrantMutation) OldRole(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
 }
 
-// AdminsIDs returns the "admins" edge IDs in the mutation.
-func (m *TournamentMutation) AdminsIDs() (ids []int) {
-	for id := range m.admins {
-		ids = append(ids, id)
-	}
-	return
+// ResetRole resets all changes to the "role" field.
+func (m *RoleGrantMutation) ResetRole() {
+	m.role = nil
 }
 
-// ResetAdmins resets all changes to the "admins" edge.
-func (m *TournamentMutation) ResetAdmins() {
-	m.admins = nil
-	m.clearedadmins = false
-	m.removedadmins = nil
+// SetReason sets the "reason" field.
+func (m *RoleGrantMutation) SetReason(s string) {
+	m.reason = &s
 }
 
-// AddTeamIDs adds the "teams" edge to the Team entity by ids.
-func (m *TournamentMutation) AddTeamIDs(ids ...int) {
-	if m.teams == nil {
-		m.teams = make(map[int]struct{})
+// Reason returns the value of the "reason" field in the mutation.
+func (m *RoleGrantMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.teams[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the RoleGrant entity.
+// If the RoleGrant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleGrantMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
 	}
+	return oldValue.Reason, nil
 }
 
-// ClearTeams clears the "teams" edge to the Team entity.
-func (m *TournamentMutation) ClearTeams() {
-	m.clearedteams = true
+// ClearReason clears the value of the "reason" field.
+func (m *RoleGrantMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[rolegrant.FieldReason] = struct{}{}
 }
 
-// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
-func (m *TournamentMutation) TeamsCleared() bool {
-	return m.clearedteams
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *RoleGrantMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[rolegrant.FieldReason]
+	return ok
 }
 
-// RemoveTeamIDs removes the "teams" edge to the Team entity by IDs.
-func (m *TournamentMutation) RemoveTeamIDs(ids ...int) {
-	if m.removedteams == nil {
-		m.removedteams = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.teams, ids[i])
-		m.removedteams[ids[i]] = struct{}{}
-	}
+// ResetReason resets all changes to the "reason" field.
+func (m *RoleGrantMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, rolegrant.FieldReason)
 }
 
-// RemovedTeams returns the removed IDs of the "teams" edge to the Team entity.
-func (m *TournamentMutation) RemovedTeamsIDs() (ids []int) {
-	for id := range m.removedteams {
-		ids = append(ids, id)
+// SetExpiresAt sets the "expires_at" field.
+func (m *RoleGrantMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *RoleGrantMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// TeamsIDs returns the "teams" edge IDs in the mutation.
-func (m *TournamentMutation) TeamsIDs() (ids []int) {
-	for id := range m.teams {
-		ids = append(ids, id)
+// OldExpiresAt returns the old "expires_at" field's value of the RoleGrant entity.
+// If the RoleGrant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleGrantMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetTeams resets all changes to the "teams" edge.
-func (m *TournamentMutation) ResetTeams() {
-	m.teams = nil
-	m.clearedteams = false
-	m.removedteams = nil
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *RoleGrantMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[rolegrant.FieldExpiresAt] = struct{}{}
 }
 
-// AddRankGroupIDs adds the "rank_groups" edge to the RankGroup entity by ids.
-func (m *TournamentMutation) AddRankGroupIDs(ids ...int) {
-	if m.rank_groups == nil {
-		m.rank_groups = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.rank_groups[ids[i]] = struct{}{}
-	}
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *RoleGrantMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[rolegrant.FieldExpiresAt]
+	return ok
 }
 
-// ClearRankGroups clears the "rank_groups" edge to the RankGroup entity.
-func (m *TournamentMutation) ClearRankGroups() {
-	m.clearedrank_groups = true
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *RoleGrantMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, rolegrant.FieldExpiresAt)
 }
 
-// RankGroupsCleared reports if the "rank_groups" edge to the RankGroup entity was cleared.
-func (m *TournamentMutation) RankGroupsCleared() bool {
-	return m.clearedrank_groups
+// SetCreatedAt sets the "created_at" field.
+func (m *RoleGrantMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// RemoveRankGroupIDs removes the "rank_groups" edge to the RankGroup entity by IDs.
-func (m *TournamentMutation) RemoveRankGroupIDs(ids ...int) {
-	if m.removedrank_groups == nil {
-		m.removedrank_groups = make(map[int]struct{})
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RoleGrantMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.rank_groups, ids[i])
-		m.removedrank_groups[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RoleGrant entity.
+// If the RoleGrant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleGrantMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
+	return oldValue.CreatedAt, nil
 }
 
-// RemovedRankGroups returns the removed IDs of the "rank_groups" edge to the RankGroup entity.
-func (m *TournamentMutation) RemovedRankGroupsIDs() (ids []int) {
-	for id := range m.removedrank_groups {
-		ids = append(ids, id)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RoleGrantMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *RoleGrantMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *RoleGrantMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *RoleGrantMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *RoleGrantMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
 	return
 }
 
-// RankGroupsIDs returns the "rank_groups" edge IDs in the mutation.
-func (m *TournamentMutation) RankGroupsIDs() (ids []int) {
-	for id := range m.rank_groups {
-		ids = append(ids, id)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *RoleGrantMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetRankGroups resets all changes to the "rank_groups" edge.
-func (m *TournamentMutation) ResetRankGroups() {
-	m.rank_groups = nil
-	m.clearedrank_groups = false
-	m.removedrank_groups = nil
+// ResetUser resets all changes to the "user" edge.
+func (m *RoleGrantMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// AddTeamMemberIDs adds the "team_members" edge to the TeamMember entity by ids.
-func (m *TournamentMutation) AddTeamMemberIDs(ids ...int) {
-	if m.team_members == nil {
-		m.team_members = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.team_members[ids[i]] = struct{}{}
-	}
+// SetGrantedByID sets the "granted_by" edge to the User entity by id.
+func (m *RoleGrantMutation) SetGrantedByID(id int) {
+	m.granted_by = &id
 }
 
-// ClearTeamMembers clears the "team_members" edge to the TeamMember entity.
-func (m *TournamentMutation) ClearTeamMembers() {
-	m.clearedteam_members = true
+// ClearGrantedBy clears the "granted_by" edge to the User entity.
+func (m *RoleGrantMutation) ClearGrantedBy() {
+	m.clearedgranted_by = true
 }
 
-// TeamMembersCleared reports if the "team_members" edge to the TeamMember entity was cleared.
-func (m *TournamentMutation) TeamMembersCleared() bool {
-	return m.clearedteam_members
+// GrantedByCleared reports if the "granted_by" edge to the User entity was cleared.
+func (m *RoleGrantMutation) GrantedByCleared() bool {
+	return m.clearedgranted_by
 }
 
-// RemoveTeamMemberIDs removes the "team_members" edge to the TeamMember entity by IDs.
-func (m *TournamentMutation) RemoveTeamMemberIDs(ids ...int) {
-	if m.removedteam_members == nil {
-		m.removedteam_members = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.team_members, ids[i])
-		m.removedteam_members[ids[i]] = struct{}{}
+// GrantedByID returns the "granted_by" edge ID in the mutation.
+func (m *RoleGrantMutation) GrantedByID() (id int, exists bool) {
+	if m.granted_by != nil {
+		return *m.granted_by, true
 	}
+	return
 }
 
-// RemovedTeamMembers returns the removed IDs of the "team_members" edge to the TeamMember entity.
-func (m *TournamentMutation) RemovedTeamMembersIDs() (ids []int) {
-	for id := range m.removedteam_members {
-		ids = append(ids, id)
+// GrantedByIDs returns the "granted_by" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GrantedByID instead. It exists only for internal usage by the builders.
+func (m *RoleGrantMutation) GrantedByIDs() (ids []int) {
+	if id := m.granted_by; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// TeamMembersIDs returns the "team_members" edge IDs in the mutation.
-func (m *TournamentMutation) TeamMembersIDs() (ids []int) {
-	for id := range m.team_members {
-		ids = append(ids, id)
-	}
-	return
+// ResetGrantedBy resets all changes to the "granted_by" edge.
+func (m *RoleGrantMutation) ResetGrantedBy() {
+	m.granted_by = nil
+	m.clearedgranted_by = false
 }
 
-// ResetTeamMembers resets all changes to the "team_members" edge.
-func (m *TournamentMutation) ResetTeamMembers() {
-	m.team_members = nil
-	m.clearedteam_members = false
-	m.removedteam_members = nil
-}
-
-// Where appends a list predicates to the TournamentMutation builder.
-func (m *TournamentMutation) Where(ps ...predicate.Tournament) {
+// Where appends a list predicates to the RoleGrantMutation builder.
+func (m *RoleGrantMutation) Where(ps ...predicate.RoleGrant) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TournamentMutation builder. Using this method,
+// WhereP appends storage-level predicates to the RoleGrantMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TournamentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Tournament, len(ps))
+func (m *RoleGrantMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RoleGrant, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -9399,72 +10824,36 @@ func (m *TournamentMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TournamentMutation) Op() Op {
+func (m *RoleGrantMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TournamentMutation) SetOp(op Op) {
+func (m *RoleGrantMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Tournament).
-func (m *TournamentMutation) Type() string {
+// Type returns the node type of this mutation (RoleGrant).
+func (m *RoleGrantMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TournamentMutation) Fields() []string {
-	fields := make([]string, 0, 16)
-	if m.slug != nil {
-		fields = append(fields, tournament.FieldSlug)
-	}
-	if m.name != nil {
-		fields = append(fields, tournament.FieldName)
-	}
-	if m.description != nil {
-		fields = append(fields, tournament.FieldDescription)
-	}
-	if m.image_url != nil {
-		fields = append(fields, tournament.FieldImageURL)
-	}
-	if m.is_visible != nil {
-		fields = append(fields, tournament.FieldIsVisible)
-	}
-	if m.registration_start != nil {
-		fields = append(fields, tournament.FieldRegistrationStart)
-	}
-	if m.registration_end != nil {
-		fields = append(fields, tournament.FieldRegistrationEnd)
-	}
-	if m.tournament_start != nil {
-		fields = append(fields, tournament.FieldTournamentStart)
-	}
-	if m.tournament_end != nil {
-		fields = append(fields, tournament.FieldTournamentEnd)
-	}
-	if m.max_teams != nil {
-		fields = append(fields, tournament.FieldMaxTeams)
-	}
-	if m.team_structure != nil {
-		fields = append(fields, tournament.FieldTeamStructure)
-	}
-	if m.custom_page_component != nil {
-		fields = append(fields, tournament.FieldCustomPageComponent)
+func (m *RoleGrantMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.role != nil {
+		fields = append(fields, rolegrant.FieldRole)
 	}
-	if m.external_links != nil {
-		fields = append(fields, tournament.FieldExternalLinks)
+	if m.reason != nil {
+		fields = append(fields, rolegrant.FieldReason)
 	}
-	if m.tier != nil {
-		fields = append(fields, tournament.FieldTier)
+	if m.expires_at != nil {
+		fields = append(fields, rolegrant.FieldExpiresAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, tournament.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, tournament.FieldUpdatedAt)
+		fields = append(fields, rolegrant.FieldCreatedAt)
 	}
 	return fields
 }
@@ -9472,40 +10861,16 @@ func (m *TournamentMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TournamentMutation) Field(name string) (ent.Value, bool) {
+func (m *RoleGrantMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case tournament.FieldSlug:
-		return m.Slug()
-	case tournament.FieldName:
-		return m.Name()
-	case tournament.FieldDescription:
-		return m.Description()
-	case tournament.FieldImageURL:
-		return m.ImageURL()
-	case tournament.FieldIsVisible:
-		return m.IsVisible()
-	case tournament.FieldRegistrationStart:
-		return m.RegistrationStart()
-	case tournament.FieldRegistrationEnd:
-		return m.RegistrationEnd()
-	case tournament.FieldTournamentStart:
-		return m.TournamentStart()
-	case tournament.FieldTournamentEnd:
-		return m.TournamentEnd()
-	case tournament.FieldMaxTeams:
-		return m.MaxTeams()
-	case tournament.FieldTeamStructure:
-		return m.TeamStructure()
-	case tournament.FieldCustomPageComponent:
-		return m.CustomPageComponent()
-	case tournament.FieldExternalLinks:
-		return m.ExternalLinks()
-	case tournament.FieldTier:
-		return m.Tier()
-	case tournament.FieldCreatedAt:
+	case rolegrant.FieldRole:
+		return m.Role()
+	case rolegrant.FieldReason:
+		return m.Reason()
+	case rolegrant.FieldExpiresAt:
+		return m.ExpiresAt()
+	case rolegrant.FieldCreatedAt:
 		return m.CreatedAt()
-	case tournament.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -9513,512 +10878,262 @@ func (m *TournamentMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TournamentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RoleGrantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case tournament.FieldSlug:
-		return m.OldSlug(ctx)
-	case tournament.FieldName:
-		return m.OldName(ctx)
-	case tournament.FieldDescription:
-		return m.OldDescription(ctx)
-	case tournament.FieldImageURL:
-		return m.OldImageURL(ctx)
-	case tournament.FieldIsVisible:
-		return m.OldIsVisible(ctx)
-	case tournament.FieldRegistrationStart:
-		return m.OldRegistrationStart(ctx)
-	case tournament.FieldRegistrationEnd:
-		return m.OldRegistrationEnd(ctx)
-	case tournament.FieldTournamentStart:
-		return m.OldTournamentStart(ctx)
-	case tournament.FieldTournamentEnd:
-		return m.OldTournamentEnd(ctx)
-	case tournament.FieldMaxTeams:
-		return m.OldMaxTeams(ctx)
-	case tournament.FieldTeamStructure:
-		return m.OldTeamStructure(ctx)
-	case tournament.FieldCustomPageComponent:
-		return m.OldCustomPageComponent(ctx)
-	case tournament.FieldExternalLinks:
-		return m.OldExternalLinks(ctx)
-	case tournament.FieldTier:
-		return m.OldTier(ctx)
-	case tournament.FieldCreatedAt:
+	case rolegrant.FieldRole:
+		return m.OldRole(ctx)
+	case rolegrant.FieldReason:
+		return m.OldReason(ctx)
+	case rolegrant.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case rolegrant.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case tournament.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Tournament field %s", name)
+	return nil, fmt.Errorf("unknown RoleGrant field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TournamentMutation) SetField(name string, value ent.Value) error {
+func (m *RoleGrantMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case tournament.FieldSlug:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSlug(v)
-		return nil
-	case tournament.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case tournament.FieldDescription:
+	case rolegrant.FieldRole:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetRole(v)
 		return nil
-	case tournament.FieldImageURL:
+	case rolegrant.FieldReason:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetImageURL(v)
-		return nil
-	case tournament.FieldIsVisible:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsVisible(v)
-		return nil
-	case tournament.FieldRegistrationStart:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRegistrationStart(v)
-		return nil
-	case tournament.FieldRegistrationEnd:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRegistrationEnd(v)
-		return nil
-	case tournament.FieldTournamentStart:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTournamentStart(v)
+		m.SetReason(v)
 		return nil
-	case tournament.FieldTournamentEnd:
+	case rolegrant.FieldExpiresAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTournamentEnd(v)
-		return nil
-	case tournament.FieldMaxTeams:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMaxTeams(v)
-		return nil
-	case tournament.FieldTeamStructure:
-		v, ok := value.(map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTeamStructure(v)
-		return nil
-	case tournament.FieldCustomPageComponent:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCustomPageComponent(v)
-		return nil
-	case tournament.FieldExternalLinks:
-		v, ok := value.(map[string]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExternalLinks(v)
-		return nil
-	case tournament.FieldTier:
-		v, ok := value.(tournament.Tier)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTier(v)
+		m.SetExpiresAt(v)
 		return nil
-	case tournament.FieldCreatedAt:
+	case rolegrant.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case tournament.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Tournament field %s", name)
+	return fmt.Errorf("unknown RoleGrant field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TournamentMutation) AddedFields() []string {
-	var fields []string
-	if m.addmax_teams != nil {
-		fields = append(fields, tournament.FieldMaxTeams)
-	}
-	return fields
+func (m *RoleGrantMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TournamentMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case tournament.FieldMaxTeams:
-		return m.AddedMaxTeams()
-	}
+func (m *RoleGrantMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TournamentMutation) AddField(name string, value ent.Value) error {
+func (m *RoleGrantMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case tournament.FieldMaxTeams:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMaxTeams(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Tournament numeric field %s", name)
+	return fmt.Errorf("unknown RoleGrant numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TournamentMutation) ClearedFields() []string {
+func (m *RoleGrantMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(tournament.FieldImageURL) {
-		fields = append(fields, tournament.FieldImageURL)
-	}
-	if m.FieldCleared(tournament.FieldTournamentEnd) {
-		fields = append(fields, tournament.FieldTournamentEnd)
-	}
-	if m.FieldCleared(tournament.FieldTeamStructure) {
-		fields = append(fields, tournament.FieldTeamStructure)
+	if m.FieldCleared(rolegrant.FieldReason) {
+		fields = append(fields, rolegrant.FieldReason)
 	}
-	if m.FieldCleared(tournament.FieldExternalLinks) {
-		fields = append(fields, tournament.FieldExternalLinks)
+	if m.FieldCleared(rolegrant.FieldExpiresAt) {
+		fields = append(fields, rolegrant.FieldExpiresAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TournamentMutation) FieldCleared(name string) bool {
+func (m *RoleGrantMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TournamentMutation) ClearField(name string) error {
+func (m *RoleGrantMutation) ClearField(name string) error {
 	switch name {
-	case tournament.FieldImageURL:
-		m.ClearImageURL()
-		return nil
-	case tournament.FieldTournamentEnd:
-		m.ClearTournamentEnd()
-		return nil
-	case tournament.FieldTeamStructure:
-		m.ClearTeamStructure()
+	case rolegrant.FieldReason:
+		m.ClearReason()
 		return nil
-	case tournament.FieldExternalLinks:
-		m.ClearExternalLinks()
+	case rolegrant.FieldExpiresAt:
+		m.ClearExpiresAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Tournament nullable field %s", name)
+	return fmt.Errorf("unknown RoleGrant nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TournamentMutation) ResetField(name string) error {
+func (m *RoleGrantMutation) ResetField(name string) error {
 	switch name {
-	case tournament.FieldSlug:
-		m.ResetSlug()
-		return nil
-	case tournament.FieldName:
-		m.ResetName()
-		return nil
-	case tournament.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case tournament.FieldImageURL:
-		m.ResetImageURL()
-		return nil
-	case tournament.FieldIsVisible:
-		m.ResetIsVisible()
-		return nil
-	case tournament.FieldRegistrationStart:
-		m.ResetRegistrationStart()
-		return nil
-	case tournament.FieldRegistrationEnd:
-		m.ResetRegistrationEnd()
-		return nil
-	case tournament.FieldTournamentStart:
-		m.ResetTournamentStart()
-		return nil
-	case tournament.FieldTournamentEnd:
-		m.ResetTournamentEnd()
-		return nil
-	case tournament.FieldMaxTeams:
-		m.ResetMaxTeams()
-		return nil
-	case tournament.FieldTeamStructure:
-		m.ResetTeamStructure()
-		return nil
-	case tournament.FieldCustomPageComponent:
-		m.ResetCustomPageComponent()
+	case rolegrant.FieldRole:
+		m.ResetRole()
 		return nil
-	case tournament.FieldExternalLinks:
-		m.ResetExternalLinks()
+	case rolegrant.FieldReason:
+		m.ResetReason()
 		return nil
-	case tournament.FieldTier:
-		m.ResetTier()
+	case rolegrant.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
-	case tournament.FieldCreatedAt:
+	case rolegrant.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case tournament.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown Tournament field %s", name)
+	return fmt.Errorf("unknown RoleGrant field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TournamentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.creator != nil {
-		edges = append(edges, tournament.EdgeCreator)
-	}
-	if m.admins != nil {
-		edges = append(edges, tournament.EdgeAdmins)
-	}
-	if m.teams != nil {
-		edges = append(edges, tournament.EdgeTeams)
-	}
-	if m.rank_groups != nil {
-		edges = append(edges, tournament.EdgeRankGroups)
+func (m *RoleGrantMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, rolegrant.EdgeUser)
 	}
-	if m.team_members != nil {
-		edges = append(edges, tournament.EdgeTeamMembers)
+	if m.granted_by != nil {
+		edges = append(edges, rolegrant.EdgeGrantedBy)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TournamentMutation) AddedIDs(name string) []ent.Value {
+func (m *RoleGrantMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case tournament.EdgeCreator:
-		if id := m.creator; id != nil {
+	case rolegrant.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case tournament.EdgeAdmins:
-		ids := make([]ent.Value, 0, len(m.admins))
-		for id := range m.admins {
-			ids = append(ids, id)
-		}
-		return ids
-	case tournament.EdgeTeams:
-		ids := make([]ent.Value, 0, len(m.teams))
-		for id := range m.teams {
-			ids = append(ids, id)
-		}
-		return ids
-	case tournament.EdgeRankGroups:
-		ids := make([]ent.Value, 0, len(m.rank_groups))
-		for id := range m.rank_groups {
-			ids = append(ids, id)
-		}
-		return ids
-	case tournament.EdgeTeamMembers:
-		ids := make([]ent.Value, 0, len(m.team_members))
-		for id := range m.team_members {
-			ids = append(ids, id)
+	case rolegrant.EdgeGrantedBy:
+		if id := m.granted_by; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TournamentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedadmins != nil {
-		edges = append(edges, tournament.EdgeAdmins)
-	}
-	if m.removedteams != nil {
-		edges = append(edges, tournament.EdgeTeams)
-	}
-	if m.removedrank_groups != nil {
-		edges = append(edges, tournament.EdgeRankGroups)
-	}
-	if m.removedteam_members != nil {
-		edges = append(edges, tournament.EdgeTeamMembers)
-	}
+func (m *RoleGrantMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TournamentMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case tournament.EdgeAdmins:
-		ids := make([]ent.Value, 0, len(m.removedadmins))
-		for id := range m.removedadmins {
-			ids = append(ids, id)
-		}
-		return ids
-	case tournament.EdgeTeams:
-		ids := make([]ent.Value, 0, len(m.removedteams))
-		for id := range m.removedteams {
-			ids = append(ids, id)
-		}
-		return ids
-	case tournament.EdgeRankGroups:
-		ids := make([]ent.Value, 0, len(m.removedrank_groups))
-		for id := range m.removedrank_groups {
-			ids = append(ids, id)
-		}
-		return ids
-	case tournament.EdgeTeamMembers:
-		ids := make([]ent.Value, 0, len(m.removedteam_members))
-		for id := range m.removedteam_members {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *RoleGrantMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TournamentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.clearedcreator {
-		edges = append(edges, tournament.EdgeCreator)
-	}
-	if m.clearedadmins {
-		edges = append(edges, tournament.EdgeAdmins)
-	}
-	if m.clearedteams {
-		edges = append(edges, tournament.EdgeTeams)
-	}
-	if m.clearedrank_groups {
-		edges = append(edges, tournament.EdgeRankGroups)
+func (m *RoleGrantMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, rolegrant.EdgeUser)
 	}
-	if m.clearedteam_members {
-		edges = append(edges, tournament.EdgeTeamMembers)
+	if m.clearedgranted_by {
+		edges = append(edges, rolegrant.EdgeGrantedBy)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TournamentMutation) EdgeCleared(name string) bool {
+func (m *RoleGrantMutation) EdgeCleared(name string) bool {
 	switch name {
-	case tournament.EdgeCreator:
-		return m.clearedcreator
-	case tournament.EdgeAdmins:
-		return m.clearedadmins
-	case tournament.EdgeTeams:
-		return m.clearedteams
-	case tournament.EdgeRankGroups:
-		return m.clearedrank_groups
-	case tournament.EdgeTeamMembers:
-		return m.clearedteam_members
+	case rolegrant.EdgeUser:
+		return m.cleareduser
+	case rolegrant.EdgeGrantedBy:
+		return m.clearedgranted_by
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TournamentMutation) ClearEdge(name string) error {
+func (m *RoleGrantMutation) ClearEdge(name string) error {
 	switch name {
-	case tournament.EdgeCreator:
-		m.ClearCreator()
+	case rolegrant.EdgeUser:
+		m.ClearUser()
+		return nil
+	case rolegrant.EdgeGrantedBy:
+		m.ClearGrantedBy()
 		return nil
 	}
-	return fmt.Errorf("unknown Tournament unique edge %s", name)
+	return fmt.Errorf("unknown RoleGrant unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TournamentMutation) ResetEdge(name string) error {
+func (m *RoleGrantMutation) ResetEdge(name string) error {
 	switch name {
-	case tournament.EdgeCreator:
-		m.ResetCreator()
-		return nil
-	case tournament.EdgeAdmins:
-		m.ResetAdmins()
-		return nil
-	case tournament.EdgeTeams:
-		m.ResetTeams()
-		return nil
-	case tournament.EdgeRankGroups:
-		m.ResetRankGroups()
+	case rolegrant.EdgeUser:
+		m.ResetUser()
 		return nil
-	case tournament.EdgeTeamMembers:
-		m.ResetTeamMembers()
+	case rolegrant.EdgeGrantedBy:
+		m.ResetGrantedBy()
 		return nil
 	}
-	return fmt.Errorf("unknown Tournament edge %s", name)
+	return fmt.Errorf("unknown RoleGrant edge %s", name)
 }
 
-// TournamentAdminMutation represents an operation that mutates the TournamentAdmin nodes in the graph.
-type TournamentAdminMutation struct {
+// SanctionMutation represents an operation that mutates the Sanction nodes in the graph.
+type SanctionMutation struct {
 	config
 	op                Op
 	typ               string
 	id                *int
-	role              *tournamentadmin.Role
+	_type             *sanction.Type
+	reason            *string
+	expires_at        *time.Time
+	created_at        *time.Time
+	lifted_at         *time.Time
+	lift_reason       *string
 	clearedFields     map[string]struct{}
 	user              *int
 	cleareduser       bool
+	issuer            *int
+	clearedissuer     bool
+	lifted_by         *int
+	clearedlifted_by  bool
 	tournament        *int
 	clearedtournament bool
 	done              bool
-	oldValue          func(context.Context) (*TournamentAdmin, error)
-	predicates        []predicate.TournamentAdmin
+	oldValue          func(context.Context) (*Sanction, error)
+	predicates        []predicate.Sanction
 }
 
-var _ ent.Mutation = (*TournamentAdminMutation)(nil)
+var _ ent.Mutation = (*SanctionMutation)(nil)
 
-// tournamentadminOption allows management of the mutation configuration using functional options.
-type tournamentadminOption func(*TournamentAdminMutation)
+// sanctionOption allows management of the mutation configuration using functional options.
+type sanctionOption func(*SanctionMutation)
 
-// newTournamentAdminMutation creates new mutation for the TournamentAdmin entity.
-func newTournamentAdminMutation(c config, op Op, opts ...tournamentadminOption) *TournamentAdminMutation {
-	m := &TournamentAdminMutation{
+// newSanctionMutation creates new mutation for the Sanction entity.
+func newSanctionMutation(c config, op Op, opts ...sanctionOption) *SanctionMutation {
+	m := &SanctionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTournamentAdmin,
+		typ:           TypeSanction,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -10027,20 +11142,20 @@ func newTournamentAdminMutation(c config, op Op, opts ...tournamentadminOption)
 	return m
 }
 
-// withTournamentAdminID sets the ID field of the mutation.
-func withTournamentAdminID(id int) tournamentadminOption {
-	return func(m *TournamentAdminMutation) {
+// withSanctionID sets the ID field of the mutation.
+func withSanctionID(id int) sanctionOption {
+	return func(m *SanctionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *TournamentAdmin
+			value *Sanction
 		)
-		m.oldValue = func(ctx context.Context) (*TournamentAdmin, error) {
+		m.oldValue = func(ctx context.Context) (*Sanction, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().TournamentAdmin.Get(ctx, id)
+					value, err = m.Client().Sanction.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -10049,10 +11164,10 @@ func withTournamentAdminID(id int) tournamentadminOption {
 	}
 }
 
-// withTournamentAdmin sets the old TournamentAdmin of the mutation.
-func withTournamentAdmin(node *TournamentAdmin) tournamentadminOption {
-	return func(m *TournamentAdminMutation) {
-		m.oldValue = func(context.Context) (*TournamentAdmin, error) {
+// withSanction sets the old Sanction of the mutation.
+func withSanction(node *Sanction) sanctionOption {
+	return func(m *SanctionMutation) {
+		m.oldValue = func(context.Context) (*Sanction, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -10061,7 +11176,7 @@ func withTournamentAdmin(node *TournamentAdmin) tournamentadminOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TournamentAdminMutation) Client() *Client {
+func (m SanctionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -10069,7 +11184,7 @@ func (m TournamentAdminMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TournamentAdminMutation) Tx() (*Tx, error) {
+func (m SanctionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -10080,7 +11195,7 @@ func (m TournamentAdminMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TournamentAdminMutation) ID() (id int, exists bool) {
+func (m *SanctionMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -10091,7 +11206,7 @@ func (m *TournamentAdminMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TournamentAdminMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *SanctionMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -10100,65 +11215,284 @@ func (m *TournamentAdminMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TournamentAdmin.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Sanction.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetRole sets the "role" field.
-func (m *TournamentAdminMutation) SetRole(t tournamentadmin.Role) {
-	m.role = &t
+// SetType sets the "type" field.
+func (m *SanctionMutation) SetType(s sanction.Type) {
+	m._type = &s
 }
 
-// Role returns the value of the "role" field in the mutation.
-func (m *TournamentAdminMutation) Role() (r tournamentadmin.Role, exists bool) {
-	v := m.role
+// GetType returns the value of the "type" field in the mutation.
+func (m *SanctionMutation) GetType() (r sanction.Type, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRole returns the old "role" field's value of the TournamentAdmin entity.
-// If the TournamentAdmin object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the Sanction entity.
+// If the Sanction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TournamentAdminMutation) OldRole(ctx context.Context) (v tournamentadmin.Role, err error) {
+func (m *SanctionMutation) OldType(ctx context.Context) (v sanction.Type, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRole requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.Role, nil
+	return oldValue.Type, nil
 }
 
-// ResetRole resets all changes to the "role" field.
-func (m *TournamentAdminMutation) ResetRole() {
-	m.role = nil
+// ResetType resets all changes to the "type" field.
+func (m *SanctionMutation) ResetType() {
+	m._type = nil
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *TournamentAdminMutation) SetUserID(id int) {
-	m.user = &id
+// SetReason sets the "reason" field.
+func (m *SanctionMutation) SetReason(s string) {
+	m.reason = &s
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *TournamentAdminMutation) ClearUser() {
-	m.cleareduser = true
-}
+// Reason returns the value of the "reason" field in the mutation.
+func (m *SanctionMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the Sanction entity.
+// If the Sanction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SanctionMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *SanctionMutation) ResetReason() {
+	m.reason = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *SanctionMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *SanctionMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Sanction entity.
+// If the Sanction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SanctionMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *SanctionMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[sanction.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *SanctionMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[sanction.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *SanctionMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, sanction.FieldExpiresAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SanctionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SanctionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Sanction entity.
+// If the Sanction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SanctionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SanctionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetLiftedAt sets the "lifted_at" field.
+func (m *SanctionMutation) SetLiftedAt(t time.Time) {
+	m.lifted_at = &t
+}
+
+// LiftedAt returns the value of the "lifted_at" field in the mutation.
+func (m *SanctionMutation) LiftedAt() (r time.Time, exists bool) {
+	v := m.lifted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLiftedAt returns the old "lifted_at" field's value of the Sanction entity.
+// If the Sanction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SanctionMutation) OldLiftedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLiftedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLiftedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLiftedAt: %w", err)
+	}
+	return oldValue.LiftedAt, nil
+}
+
+// ClearLiftedAt clears the value of the "lifted_at" field.
+func (m *SanctionMutation) ClearLiftedAt() {
+	m.lifted_at = nil
+	m.clearedFields[sanction.FieldLiftedAt] = struct{}{}
+}
+
+// LiftedAtCleared returns if the "lifted_at" field was cleared in this mutation.
+func (m *SanctionMutation) LiftedAtCleared() bool {
+	_, ok := m.clearedFields[sanction.FieldLiftedAt]
+	return ok
+}
+
+// ResetLiftedAt resets all changes to the "lifted_at" field.
+func (m *SanctionMutation) ResetLiftedAt() {
+	m.lifted_at = nil
+	delete(m.clearedFields, sanction.FieldLiftedAt)
+}
+
+// SetLiftReason sets the "lift_reason" field.
+func (m *SanctionMutation) SetLiftReason(s string) {
+	m.lift_reason = &s
+}
+
+// LiftReason returns the value of the "lift_reason" field in the mutation.
+func (m *SanctionMutation) LiftReason() (r string, exists bool) {
+	v := m.lift_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLiftReason returns the old "lift_reason" field's value of the Sanction entity.
+// If the Sanction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SanctionMutation) OldLiftReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLiftReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLiftReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLiftReason: %w", err)
+	}
+	return oldValue.LiftReason, nil
+}
+
+// ClearLiftReason clears the value of the "lift_reason" field.
+func (m *SanctionMutation) ClearLiftReason() {
+	m.lift_reason = nil
+	m.clearedFields[sanction.FieldLiftReason] = struct{}{}
+}
+
+// LiftReasonCleared returns if the "lift_reason" field was cleared in this mutation.
+func (m *SanctionMutation) LiftReasonCleared() bool {
+	_, ok := m.clearedFields[sanction.FieldLiftReason]
+	return ok
+}
+
+// ResetLiftReason resets all changes to the "lift_reason" field.
+func (m *SanctionMutation) ResetLiftReason() {
+	m.lift_reason = nil
+	delete(m.clearedFields, sanction.FieldLiftReason)
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *SanctionMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *SanctionMutation) ClearUser() {
+	m.cleareduser = true
+}
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *TournamentAdminMutation) UserCleared() bool {
+func (m *SanctionMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
 // UserID returns the "user" edge ID in the mutation.
-func (m *TournamentAdminMutation) UserID() (id int, exists bool) {
+func (m *SanctionMutation) UserID() (id int, exists bool) {
 	if m.user != nil {
 		return *m.user, true
 	}
@@ -10168,7 +11502,7 @@ func (m *TournamentAdminMutation) UserID() (id int, exists bool) {
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *TournamentAdminMutation) UserIDs() (ids []int) {
+func (m *SanctionMutation) UserIDs() (ids []int) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -10176,28 +11510,106 @@ func (m *TournamentAdminMutation) UserIDs() (ids []int) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *TournamentAdminMutation) ResetUser() {
+func (m *SanctionMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
+// SetIssuerID sets the "issuer" edge to the User entity by id.
+func (m *SanctionMutation) SetIssuerID(id int) {
+	m.issuer = &id
+}
+
+// ClearIssuer clears the "issuer" edge to the User entity.
+func (m *SanctionMutation) ClearIssuer() {
+	m.clearedissuer = true
+}
+
+// IssuerCleared reports if the "issuer" edge to the User entity was cleared.
+func (m *SanctionMutation) IssuerCleared() bool {
+	return m.clearedissuer
+}
+
+// IssuerID returns the "issuer" edge ID in the mutation.
+func (m *SanctionMutation) IssuerID() (id int, exists bool) {
+	if m.issuer != nil {
+		return *m.issuer, true
+	}
+	return
+}
+
+// IssuerIDs returns the "issuer" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// IssuerID instead. It exists only for internal usage by the builders.
+func (m *SanctionMutation) IssuerIDs() (ids []int) {
+	if id := m.issuer; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetIssuer resets all changes to the "issuer" edge.
+func (m *SanctionMutation) ResetIssuer() {
+	m.issuer = nil
+	m.clearedissuer = false
+}
+
+// SetLiftedByID sets the "lifted_by" edge to the User entity by id.
+func (m *SanctionMutation) SetLiftedByID(id int) {
+	m.lifted_by = &id
+}
+
+// ClearLiftedBy clears the "lifted_by" edge to the User entity.
+func (m *SanctionMutation) ClearLiftedBy() {
+	m.clearedlifted_by = true
+}
+
+// LiftedByCleared reports if the "lifted_by" edge to the User entity was cleared.
+func (m *SanctionMutation) LiftedByCleared() bool {
+	return m.clearedlifted_by
+}
+
+// LiftedByID returns the "lifted_by" edge ID in the mutation.
+func (m *SanctionMutation) LiftedByID() (id int, exists bool) {
+	if m.lifted_by != nil {
+		return *m.lifted_by, true
+	}
+	return
+}
+
+// LiftedByIDs returns the "lifted_by" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LiftedByID instead. It exists only for internal usage by the builders.
+func (m *SanctionMutation) LiftedByIDs() (ids []int) {
+	if id := m.lifted_by; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLiftedBy resets all changes to the "lifted_by" edge.
+func (m *SanctionMutation) ResetLiftedBy() {
+	m.lifted_by = nil
+	m.clearedlifted_by = false
+}
+
 // SetTournamentID sets the "tournament" edge to the Tournament entity by id.
-func (m *TournamentAdminMutation) SetTournamentID(id int) {
+func (m *SanctionMutation) SetTournamentID(id int) {
 	m.tournament = &id
 }
 
 // ClearTournament clears the "tournament" edge to the Tournament entity.
-func (m *TournamentAdminMutation) ClearTournament() {
+func (m *SanctionMutation) ClearTournament() {
 	m.clearedtournament = true
 }
 
 // TournamentCleared reports if the "tournament" edge to the Tournament entity was cleared.
-func (m *TournamentAdminMutation) TournamentCleared() bool {
+func (m *SanctionMutation) TournamentCleared() bool {
 	return m.clearedtournament
 }
 
 // TournamentID returns the "tournament" edge ID in the mutation.
-func (m *TournamentAdminMutation) TournamentID() (id int, exists bool) {
+func (m *SanctionMutation) TournamentID() (id int, exists bool) {
 	if m.tournament != nil {
 		return *m.tournament, true
 	}
@@ -10207,7 +11619,7 @@ func (m *TournamentAdminMutation) TournamentID() (id int, exists bool) {
 // TournamentIDs returns the "tournament" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // TournamentID instead. It exists only for internal usage by the builders.
-func (m *TournamentAdminMutation) TournamentIDs() (ids []int) {
+func (m *SanctionMutation) TournamentIDs() (ids []int) {
 	if id := m.tournament; id != nil {
 		ids = append(ids, *id)
 	}
@@ -10215,20 +11627,20 @@ func (m *TournamentAdminMutation) TournamentIDs() (ids []int) {
 }
 
 // ResetTournament resets all changes to the "tournament" edge.
-func (m *TournamentAdminMutation) ResetTournament() {
+func (m *SanctionMutation) ResetTournament() {
 	m.tournament = nil
 	m.clearedtournament = false
 }
 
-// Where appends a list predicates to the TournamentAdminMutation builder.
-func (m *TournamentAdminMutation) Where(ps ...predicate.TournamentAdmin) {
+// Where appends a list predicates to the SanctionMutation builder.
+func (m *SanctionMutation) Where(ps ...predicate.Sanction) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TournamentAdminMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SanctionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TournamentAdminMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TournamentAdmin, len(ps))
+func (m *SanctionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Sanction, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -10236,27 +11648,42 @@ func (m *TournamentAdminMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TournamentAdminMutation) Op() Op {
+func (m *SanctionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TournamentAdminMutation) SetOp(op Op) {
+func (m *SanctionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (TournamentAdmin).
-func (m *TournamentAdminMutation) Type() string {
+// Type returns the node type of this mutation (Sanction).
+func (m *SanctionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TournamentAdminMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.role != nil {
-		fields = append(fields, tournamentadmin.FieldRole)
+func (m *SanctionMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m._type != nil {
+		fields = append(fields, sanction.FieldType)
+	}
+	if m.reason != nil {
+		fields = append(fields, sanction.FieldReason)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, sanction.FieldExpiresAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, sanction.FieldCreatedAt)
+	}
+	if m.lifted_at != nil {
+		fields = append(fields, sanction.FieldLiftedAt)
+	}
+	if m.lift_reason != nil {
+		fields = append(fields, sanction.FieldLiftReason)
 	}
 	return fields
 }
@@ -10264,10 +11691,20 @@ func (m *TournamentAdminMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TournamentAdminMutation) Field(name string) (ent.Value, bool) {
+func (m *SanctionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case tournamentadmin.FieldRole:
-		return m.Role()
+	case sanction.FieldType:
+		return m.GetType()
+	case sanction.FieldReason:
+		return m.Reason()
+	case sanction.FieldExpiresAt:
+		return m.ExpiresAt()
+	case sanction.FieldCreatedAt:
+		return m.CreatedAt()
+	case sanction.FieldLiftedAt:
+		return m.LiftedAt()
+	case sanction.FieldLiftReason:
+		return m.LiftReason()
 	}
 	return nil, false
 }
@@ -10275,103 +11712,198 @@ func (m *TournamentAdminMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TournamentAdminMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SanctionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case tournamentadmin.FieldRole:
-		return m.OldRole(ctx)
+	case sanction.FieldType:
+		return m.OldType(ctx)
+	case sanction.FieldReason:
+		return m.OldReason(ctx)
+	case sanction.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case sanction.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case sanction.FieldLiftedAt:
+		return m.OldLiftedAt(ctx)
+	case sanction.FieldLiftReason:
+		return m.OldLiftReason(ctx)
 	}
-	return nil, fmt.Errorf("unknown TournamentAdmin field %s", name)
+	return nil, fmt.Errorf("unknown Sanction field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TournamentAdminMutation) SetField(name string, value ent.Value) error {
+func (m *SanctionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case tournamentadmin.FieldRole:
-		v, ok := value.(tournamentadmin.Role)
+	case sanction.FieldType:
+		v, ok := value.(sanction.Type)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRole(v)
+		m.SetType(v)
+		return nil
+	case sanction.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case sanction.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case sanction.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case sanction.FieldLiftedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLiftedAt(v)
+		return nil
+	case sanction.FieldLiftReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLiftReason(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TournamentAdmin field %s", name)
+	return fmt.Errorf("unknown Sanction field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TournamentAdminMutation) AddedFields() []string {
+func (m *SanctionMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TournamentAdminMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SanctionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TournamentAdminMutation) AddField(name string, value ent.Value) error {
+func (m *SanctionMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown TournamentAdmin numeric field %s", name)
+	return fmt.Errorf("unknown Sanction numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TournamentAdminMutation) ClearedFields() []string {
-	return nil
+func (m *SanctionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(sanction.FieldExpiresAt) {
+		fields = append(fields, sanction.FieldExpiresAt)
+	}
+	if m.FieldCleared(sanction.FieldLiftedAt) {
+		fields = append(fields, sanction.FieldLiftedAt)
+	}
+	if m.FieldCleared(sanction.FieldLiftReason) {
+		fields = append(fields, sanction.FieldLiftReason)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TournamentAdminMutation) FieldCleared(name string) bool {
+func (m *SanctionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TournamentAdminMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown TournamentAdmin nullable field %s", name)
-}
-
+func (m *SanctionMutation) ClearField(name string) error {
+	switch name {
+	case sanction.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case sanction.FieldLiftedAt:
+		m.ClearLiftedAt()
+		return nil
+	case sanction.FieldLiftReason:
+		m.ClearLiftReason()
+		return nil
+	}
+	return fmt.Errorf("unknown Sanction nullable field %s", name)
+}
+
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TournamentAdminMutation) ResetField(name string) error {
+func (m *SanctionMutation) ResetField(name string) error {
 	switch name {
-	case tournamentadmin.FieldRole:
-		m.ResetRole()
+	case sanction.FieldType:
+		m.ResetType()
+		return nil
+	case sanction.FieldReason:
+		m.ResetReason()
+		return nil
+	case sanction.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case sanction.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case sanction.FieldLiftedAt:
+		m.ResetLiftedAt()
+		return nil
+	case sanction.FieldLiftReason:
+		m.ResetLiftReason()
 		return nil
 	}
-	return fmt.Errorf("unknown TournamentAdmin field %s", name)
+	return fmt.Errorf("unknown Sanction field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TournamentAdminMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *SanctionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
 	if m.user != nil {
-		edges = append(edges, tournamentadmin.EdgeUser)
+		edges = append(edges, sanction.EdgeUser)
+	}
+	if m.issuer != nil {
+		edges = append(edges, sanction.EdgeIssuer)
+	}
+	if m.lifted_by != nil {
+		edges = append(edges, sanction.EdgeLiftedBy)
 	}
 	if m.tournament != nil {
-		edges = append(edges, tournamentadmin.EdgeTournament)
+		edges = append(edges, sanction.EdgeTournament)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TournamentAdminMutation) AddedIDs(name string) []ent.Value {
+func (m *SanctionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case tournamentadmin.EdgeUser:
+	case sanction.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case tournamentadmin.EdgeTournament:
+	case sanction.EdgeIssuer:
+		if id := m.issuer; id != nil {
+			return []ent.Value{*id}
+		}
+	case sanction.EdgeLiftedBy:
+		if id := m.lifted_by; id != nil {
+			return []ent.Value{*id}
+		}
+	case sanction.EdgeTournament:
 		if id := m.tournament; id != nil {
 			return []ent.Value{*id}
 		}
@@ -10380,36 +11912,46 @@ func (m *TournamentAdminMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TournamentAdminMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *SanctionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TournamentAdminMutation) RemovedIDs(name string) []ent.Value {
+func (m *SanctionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TournamentAdminMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *SanctionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
 	if m.cleareduser {
-		edges = append(edges, tournamentadmin.EdgeUser)
+		edges = append(edges, sanction.EdgeUser)
+	}
+	if m.clearedissuer {
+		edges = append(edges, sanction.EdgeIssuer)
+	}
+	if m.clearedlifted_by {
+		edges = append(edges, sanction.EdgeLiftedBy)
 	}
 	if m.clearedtournament {
-		edges = append(edges, tournamentadmin.EdgeTournament)
+		edges = append(edges, sanction.EdgeTournament)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TournamentAdminMutation) EdgeCleared(name string) bool {
+func (m *SanctionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case tournamentadmin.EdgeUser:
+	case sanction.EdgeUser:
 		return m.cleareduser
-	case tournamentadmin.EdgeTournament:
+	case sanction.EdgeIssuer:
+		return m.clearedissuer
+	case sanction.EdgeLiftedBy:
+		return m.clearedlifted_by
+	case sanction.EdgeTournament:
 		return m.clearedtournament
 	}
 	return false
@@ -10417,98 +11959,72 @@ func (m *TournamentAdminMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TournamentAdminMutation) ClearEdge(name string) error {
+func (m *SanctionMutation) ClearEdge(name string) error {
 	switch name {
-	case tournamentadmin.EdgeUser:
+	case sanction.EdgeUser:
 		m.ClearUser()
 		return nil
-	case tournamentadmin.EdgeTournament:
+	case sanction.EdgeIssuer:
+		m.ClearIssuer()
+		return nil
+	case sanction.EdgeLiftedBy:
+		m.ClearLiftedBy()
+		return nil
+	case sanction.EdgeTournament:
 		m.ClearTournament()
 		return nil
 	}
-	return fmt.Errorf("unknown TournamentAdmin unique edge %s", name)
+	return fmt.Errorf("unknown Sanction unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TournamentAdminMutation) ResetEdge(name string) error {
+func (m *SanctionMutation) ResetEdge(name string) error {
 	switch name {
-	case tournamentadmin.EdgeUser:
+	case sanction.EdgeUser:
 		m.ResetUser()
 		return nil
-	case tournamentadmin.EdgeTournament:
+	case sanction.EdgeIssuer:
+		m.ResetIssuer()
+		return nil
+	case sanction.EdgeLiftedBy:
+		m.ResetLiftedBy()
+		return nil
+	case sanction.EdgeTournament:
 		m.ResetTournament()
 		return nil
 	}
-	return fmt.Errorf("unknown TournamentAdmin edge %s", name)
+	return fmt.Errorf("unknown Sanction edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// SigningKeyMutation represents an operation that mutates the SigningKey nodes in the graph.
+type SigningKeyMutation struct {
 	config
-	op                          Op
-	typ                         string
-	id                          *int
-	username                    *string
-	email                       *string
-	created_at                  *time.Time
-	updated_at                  *time.Time
-	anonymized_at               *time.Time
-	intra_id                    *int
-	addintra_id                 *int
-	picture                     *string
-	kind                        *user.Kind
-	roles                       *[]string
-	appendroles                 []string
-	elo                         *int
-	addelo                      *int
-	clearedFields               map[string]struct{}
-	user_votes                  map[int]struct{}
-	removeduser_votes           map[int]struct{}
-	cleareduser_votes           bool
-	created_votes               map[int]struct{}
-	removedcreated_votes        map[int]struct{}
-	clearedcreated_votes        bool
-	apps                        map[string]struct{}
-	removedapps                 map[string]struct{}
-	clearedapps                 bool
-	consents                    map[int]struct{}
-	removedconsents             map[int]struct{}
-	clearedconsents             bool
-	team_memberships            map[int]struct{}
-	removedteam_memberships     map[int]struct{}
-	clearedteam_memberships     bool
-	received_invitations        map[int]struct{}
-	removedreceived_invitations map[int]struct{}
-	clearedreceived_invitations bool
-	created_teams               map[int]struct{}
-	removedcreated_teams        map[int]struct{}
-	clearedcreated_teams        bool
-	created_tournaments         map[int]struct{}
-	removedcreated_tournaments  map[int]struct{}
-	clearedcreated_tournaments  bool
-	tournament_admins           map[int]struct{}
-	removedtournament_admins    map[int]struct{}
-	clearedtournament_admins    bool
-	notifications               map[int]struct{}
-	removednotifications        map[int]struct{}
-	clearednotifications        bool
-	done                        bool
-	oldValue                    func(context.Context) (*User, error)
-	predicates                  []predicate.User
+	op            Op
+	typ           string
+	id            *string
+	algorithm     *string
+	private_key   *[]byte
+	created_at    *time.Time
+	active_at     *time.Time
+	expires_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SigningKey, error)
+	predicates    []predicate.SigningKey
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+var _ ent.Mutation = (*SigningKeyMutation)(nil)
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// signingkeyOption allows management of the mutation configuration using functional options.
+type signingkeyOption func(*SigningKeyMutation)
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+// newSigningKeyMutation creates new mutation for the SigningKey entity.
+func newSigningKeyMutation(c config, op Op, opts ...signingkeyOption) *SigningKeyMutation {
+	m := &SigningKeyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeSigningKey,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -10517,20 +12033,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id int) userOption {
-	return func(m *UserMutation) {
+// withSigningKeyID sets the ID field of the mutation.
+func withSigningKeyID(id string) signingkeyOption {
+	return func(m *SigningKeyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *SigningKey
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*SigningKey, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().SigningKey.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -10539,10 +12055,10 @@ func withUserID(id int) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withSigningKey sets the old SigningKey of the mutation.
+func withSigningKey(node *SigningKey) signingkeyOption {
+	return func(m *SigningKeyMutation) {
+		m.oldValue = func(context.Context) (*SigningKey, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -10551,7 +12067,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m SigningKeyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -10559,7 +12075,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m SigningKeyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -10569,14 +12085,14 @@ func (m UserMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id int) {
+// operation is only accepted on creation of SigningKey entities.
+func (m *SigningKeyMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id int, exists bool) {
+func (m *SigningKeyMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -10587,100 +12103,100 @@ func (m *UserMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *SigningKeyMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []string{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SigningKey.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUsername sets the "username" field.
-func (m *UserMutation) SetUsername(s string) {
-	m.username = &s
+// SetAlgorithm sets the "algorithm" field.
+func (m *SigningKeyMutation) SetAlgorithm(s string) {
+	m.algorithm = &s
 }
 
-// Username returns the value of the "username" field in the mutation.
-func (m *UserMutation) Username() (r string, exists bool) {
-	v := m.username
+// Algorithm returns the value of the "algorithm" field in the mutation.
+func (m *SigningKeyMutation) Algorithm() (r string, exists bool) {
+	v := m.algorithm
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsername returns the old "username" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldAlgorithm returns the old "algorithm" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
+func (m *SigningKeyMutation) OldAlgorithm(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+		return v, errors.New("OldAlgorithm is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsername requires an ID field in the mutation")
+		return v, errors.New("OldAlgorithm requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+		return v, fmt.Errorf("querying old value for OldAlgorithm: %w", err)
 	}
-	return oldValue.Username, nil
+	return oldValue.Algorithm, nil
 }
 
-// ResetUsername resets all changes to the "username" field.
-func (m *UserMutation) ResetUsername() {
-	m.username = nil
+// ResetAlgorithm resets all changes to the "algorithm" field.
+func (m *SigningKeyMutation) ResetAlgorithm() {
+	m.algorithm = nil
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// SetPrivateKey sets the "private_key" field.
+func (m *SigningKeyMutation) SetPrivateKey(b []byte) {
+	m.private_key = &b
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
+// PrivateKey returns the value of the "private_key" field in the mutation.
+func (m *SigningKeyMutation) PrivateKey() (r []byte, exists bool) {
+	v := m.private_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldPrivateKey returns the old "private_key" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *SigningKeyMutation) OldPrivateKey(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldPrivateKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldPrivateKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldPrivateKey: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.PrivateKey, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
+// ResetPrivateKey resets all changes to the "private_key" field.
+func (m *SigningKeyMutation) ResetPrivateKey() {
+	m.private_key = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
+func (m *SigningKeyMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *SigningKeyMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -10688,10 +12204,10 @@ func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SigningKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -10706,906 +12222,1173 @@ func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
+func (m *SigningKeyMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetActiveAt sets the "active_at" field.
+func (m *SigningKeyMutation) SetActiveAt(t time.Time) {
+	m.active_at = &t
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ActiveAt returns the value of the "active_at" field in the mutation.
+func (m *SigningKeyMutation) ActiveAt() (r time.Time, exists bool) {
+	v := m.active_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldActiveAt returns the old "active_at" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SigningKeyMutation) OldActiveAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldActiveAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldActiveAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldActiveAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.ActiveAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetActiveAt resets all changes to the "active_at" field.
+func (m *SigningKeyMutation) ResetActiveAt() {
+	m.active_at = nil
 }
 
-// SetAnonymizedAt sets the "anonymized_at" field.
-func (m *UserMutation) SetAnonymizedAt(t time.Time) {
-	m.anonymized_at = &t
+// SetExpiresAt sets the "expires_at" field.
+func (m *SigningKeyMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// AnonymizedAt returns the value of the "anonymized_at" field in the mutation.
-func (m *UserMutation) AnonymizedAt() (r time.Time, exists bool) {
-	v := m.anonymized_at
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *SigningKeyMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAnonymizedAt returns the old "anonymized_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldAnonymizedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *SigningKeyMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAnonymizedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAnonymizedAt requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAnonymizedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.AnonymizedAt, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ClearAnonymizedAt clears the value of the "anonymized_at" field.
-func (m *UserMutation) ClearAnonymizedAt() {
-	m.anonymized_at = nil
-	m.clearedFields[user.FieldAnonymizedAt] = struct{}{}
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *SigningKeyMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// AnonymizedAtCleared returns if the "anonymized_at" field was cleared in this mutation.
-func (m *UserMutation) AnonymizedAtCleared() bool {
-	_, ok := m.clearedFields[user.FieldAnonymizedAt]
-	return ok
+// Where appends a list predicates to the SigningKeyMutation builder.
+func (m *SigningKeyMutation) Where(ps ...predicate.SigningKey) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetAnonymizedAt resets all changes to the "anonymized_at" field.
-func (m *UserMutation) ResetAnonymizedAt() {
-	m.anonymized_at = nil
-	delete(m.clearedFields, user.FieldAnonymizedAt)
+// WhereP appends storage-level predicates to the SigningKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SigningKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SigningKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// SetIntraID sets the "intra_id" field.
-func (m *UserMutation) SetIntraID(i int) {
-	m.intra_id = &i
-	m.addintra_id = nil
+// Op returns the operation name.
+func (m *SigningKeyMutation) Op() Op {
+	return m.op
 }
 
-// IntraID returns the value of the "intra_id" field in the mutation.
-func (m *UserMutation) IntraID() (r int, exists bool) {
-	v := m.intra_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetOp allows setting the mutation operation.
+func (m *SigningKeyMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// OldIntraID returns the old "intra_id" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldIntraID(ctx context.Context) (v *int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIntraID is only allowed on UpdateOne operations")
+// Type returns the node type of this mutation (SigningKey).
+func (m *SigningKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SigningKeyMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.algorithm != nil {
+		fields = append(fields, signingkey.FieldAlgorithm)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIntraID requires an ID field in the mutation")
+	if m.private_key != nil {
+		fields = append(fields, signingkey.FieldPrivateKey)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIntraID: %w", err)
+	if m.created_at != nil {
+		fields = append(fields, signingkey.FieldCreatedAt)
 	}
-	return oldValue.IntraID, nil
-}
-
-// AddIntraID adds i to the "intra_id" field.
-func (m *UserMutation) AddIntraID(i int) {
-	if m.addintra_id != nil {
-		*m.addintra_id += i
-	} else {
-		m.addintra_id = &i
+	if m.active_at != nil {
+		fields = append(fields, signingkey.FieldActiveAt)
 	}
-}
-
-// AddedIntraID returns the value that was added to the "intra_id" field in this mutation.
-func (m *UserMutation) AddedIntraID() (r int, exists bool) {
-	v := m.addintra_id
-	if v == nil {
-		return
+	if m.expires_at != nil {
+		fields = append(fields, signingkey.FieldExpiresAt)
 	}
-	return *v, true
-}
-
-// ClearIntraID clears the value of the "intra_id" field.
-func (m *UserMutation) ClearIntraID() {
-	m.intra_id = nil
-	m.addintra_id = nil
-	m.clearedFields[user.FieldIntraID] = struct{}{}
+	return fields
 }
 
-// IntraIDCleared returns if the "intra_id" field was cleared in this mutation.
-func (m *UserMutation) IntraIDCleared() bool {
-	_, ok := m.clearedFields[user.FieldIntraID]
-	return ok
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SigningKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case signingkey.FieldAlgorithm:
+		return m.Algorithm()
+	case signingkey.FieldPrivateKey:
+		return m.PrivateKey()
+	case signingkey.FieldCreatedAt:
+		return m.CreatedAt()
+	case signingkey.FieldActiveAt:
+		return m.ActiveAt()
+	case signingkey.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
 }
 
-// ResetIntraID resets all changes to the "intra_id" field.
-func (m *UserMutation) ResetIntraID() {
-	m.intra_id = nil
-	m.addintra_id = nil
-	delete(m.clearedFields, user.FieldIntraID)
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SigningKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case signingkey.FieldAlgorithm:
+		return m.OldAlgorithm(ctx)
+	case signingkey.FieldPrivateKey:
+		return m.OldPrivateKey(ctx)
+	case signingkey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case signingkey.FieldActiveAt:
+		return m.OldActiveAt(ctx)
+	case signingkey.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SigningKey field %s", name)
 }
 
-// SetPicture sets the "picture" field.
-func (m *UserMutation) SetPicture(s string) {
-	m.picture = &s
-}
-
-// Picture returns the value of the "picture" field in the mutation.
-func (m *UserMutation) Picture() (r string, exists bool) {
-	v := m.picture
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SigningKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case signingkey.FieldAlgorithm:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlgorithm(v)
+		return nil
+	case signingkey.FieldPrivateKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrivateKey(v)
+		return nil
+	case signingkey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case signingkey.FieldActiveAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActiveAt(v)
+		return nil
+	case signingkey.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown SigningKey field %s", name)
 }
 
-// OldPicture returns the old "picture" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPicture(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPicture is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPicture requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPicture: %w", err)
-	}
-	return oldValue.Picture, nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SigningKeyMutation) AddedFields() []string {
+	return nil
 }
 
-// ClearPicture clears the value of the "picture" field.
-func (m *UserMutation) ClearPicture() {
-	m.picture = nil
-	m.clearedFields[user.FieldPicture] = struct{}{}
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SigningKeyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// PictureCleared returns if the "picture" field was cleared in this mutation.
-func (m *UserMutation) PictureCleared() bool {
-	_, ok := m.clearedFields[user.FieldPicture]
-	return ok
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SigningKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SigningKey numeric field %s", name)
 }
 
-// ResetPicture resets all changes to the "picture" field.
-func (m *UserMutation) ResetPicture() {
-	m.picture = nil
-	delete(m.clearedFields, user.FieldPicture)
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SigningKeyMutation) ClearedFields() []string {
+	return nil
 }
 
-// SetKind sets the "kind" field.
-func (m *UserMutation) SetKind(u user.Kind) {
-	m.kind = &u
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SigningKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// Kind returns the value of the "kind" field in the mutation.
-func (m *UserMutation) Kind() (r user.Kind, exists bool) {
-	v := m.kind
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SigningKeyMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SigningKey nullable field %s", name)
 }
 
-// OldKind returns the old "kind" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldKind(ctx context.Context) (v user.Kind, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKind is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKind requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SigningKeyMutation) ResetField(name string) error {
+	switch name {
+	case signingkey.FieldAlgorithm:
+		m.ResetAlgorithm()
+		return nil
+	case signingkey.FieldPrivateKey:
+		m.ResetPrivateKey()
+		return nil
+	case signingkey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case signingkey.FieldActiveAt:
+		m.ResetActiveAt()
+		return nil
+	case signingkey.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
 	}
-	return oldValue.Kind, nil
+	return fmt.Errorf("unknown SigningKey field %s", name)
 }
 
-// ResetKind resets all changes to the "kind" field.
-func (m *UserMutation) ResetKind() {
-	m.kind = nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SigningKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetRoles sets the "roles" field.
-func (m *UserMutation) SetRoles(s []string) {
-	m.roles = &s
-	m.appendroles = nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SigningKeyMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// Roles returns the value of the "roles" field in the mutation.
-func (m *UserMutation) Roles() (r []string, exists bool) {
-	v := m.roles
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SigningKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldRoles returns the old "roles" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldRoles(ctx context.Context) (v []string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRoles is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRoles requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRoles: %w", err)
-	}
-	return oldValue.Roles, nil
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SigningKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// AppendRoles adds s to the "roles" field.
-func (m *UserMutation) AppendRoles(s []string) {
-	m.appendroles = append(m.appendroles, s...)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SigningKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// AppendedRoles returns the list of values that were appended to the "roles" field in this mutation.
-func (m *UserMutation) AppendedRoles() ([]string, bool) {
-	if len(m.appendroles) == 0 {
-		return nil, false
-	}
-	return m.appendroles, true
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SigningKeyMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// ResetRoles resets all changes to the "roles" field.
-func (m *UserMutation) ResetRoles() {
-	m.roles = nil
-	m.appendroles = nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SigningKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SigningKey unique edge %s", name)
 }
 
-// SetElo sets the "elo" field.
-func (m *UserMutation) SetElo(i int) {
-	m.elo = &i
-	m.addelo = nil
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SigningKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SigningKey edge %s", name)
 }
 
-// Elo returns the value of the "elo" field in the mutation.
-func (m *UserMutation) Elo() (r int, exists bool) {
-	v := m.elo
-	if v == nil {
-		return
-	}
-	return *v, true
+// TeamMutation represents an operation that mutates the Team nodes in the graph.
+type TeamMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	name                 *string
+	image_url            *string
+	is_locked            *bool
+	is_registered        *bool
+	is_waitlisted        *bool
+	waitlist_position    *int
+	addwaitlist_position *int
+	score                *int
+	addscore             *int
+	elo                  *int
+	addelo               *int
+	created_at           *time.Time
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	tournament           *int
+	clearedtournament    bool
+	creator              *int
+	clearedcreator       bool
+	members              map[int]struct{}
+	removedmembers       map[int]struct{}
+	clearedmembers       bool
+	rank_group           *int
+	clearedrank_group    bool
+	invitations          map[int]struct{}
+	removedinvitations   map[int]struct{}
+	clearedinvitations   bool
+	done                 bool
+	oldValue             func(context.Context) (*Team, error)
+	predicates           []predicate.Team
 }
 
-// OldElo returns the old "elo" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldElo(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldElo is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldElo requires an ID field in the mutation")
+var _ ent.Mutation = (*TeamMutation)(nil)
+
+// teamOption allows management of the mutation configuration using functional options.
+type teamOption func(*TeamMutation)
+
+// newTeamMutation creates new mutation for the Team entity.
+func newTeamMutation(c config, op Op, opts ...teamOption) *TeamMutation {
+	m := &TeamMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTeam,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldElo: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.Elo, nil
+	return m
 }
 
-// AddElo adds i to the "elo" field.
-func (m *UserMutation) AddElo(i int) {
-	if m.addelo != nil {
-		*m.addelo += i
-	} else {
-		m.addelo = &i
+// withTeamID sets the ID field of the mutation.
+func withTeamID(id int) teamOption {
+	return func(m *TeamMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Team
+		)
+		m.oldValue = func(ctx context.Context) (*Team, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Team.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
 }
 
-// AddedElo returns the value that was added to the "elo" field in this mutation.
-func (m *UserMutation) AddedElo() (r int, exists bool) {
-	v := m.addelo
-	if v == nil {
-		return
+// withTeam sets the old Team of the mutation.
+func withTeam(node *Team) teamOption {
+	return func(m *TeamMutation) {
+		m.oldValue = func(context.Context) (*Team, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return *v, true
 }
 
-// ResetElo resets all changes to the "elo" field.
-func (m *UserMutation) ResetElo() {
-	m.elo = nil
-	m.addelo = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TeamMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AddUserVoteIDs adds the "user_votes" edge to the UserVote entity by ids.
-func (m *UserMutation) AddUserVoteIDs(ids ...int) {
-	if m.user_votes == nil {
-		m.user_votes = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.user_votes[ids[i]] = struct{}{}
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TeamMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearUserVotes clears the "user_votes" edge to the UserVote entity.
-func (m *UserMutation) ClearUserVotes() {
-	m.cleareduser_votes = true
-}
-
-// UserVotesCleared reports if the "user_votes" edge to the UserVote entity was cleared.
-func (m *UserMutation) UserVotesCleared() bool {
-	return m.cleareduser_votes
-}
-
-// RemoveUserVoteIDs removes the "user_votes" edge to the UserVote entity by IDs.
-func (m *UserMutation) RemoveUserVoteIDs(ids ...int) {
-	if m.removeduser_votes == nil {
-		m.removeduser_votes = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.user_votes, ids[i])
-		m.removeduser_votes[ids[i]] = struct{}{}
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TeamMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
+	return *m.id, true
 }
 
-// RemovedUserVotes returns the removed IDs of the "user_votes" edge to the UserVote entity.
-func (m *UserMutation) RemovedUserVotesIDs() (ids []int) {
-	for id := range m.removeduser_votes {
-		ids = append(ids, id)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TeamMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Team.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return
 }
 
-// UserVotesIDs returns the "user_votes" edge IDs in the mutation.
-func (m *UserMutation) UserVotesIDs() (ids []int) {
-	for id := range m.user_votes {
-		ids = append(ids, id)
-	}
-	return
+// SetName sets the "name" field.
+func (m *TeamMutation) SetName(s string) {
+	m.name = &s
 }
 
-// ResetUserVotes resets all changes to the "user_votes" edge.
-func (m *UserMutation) ResetUserVotes() {
-	m.user_votes = nil
-	m.cleareduser_votes = false
-	m.removeduser_votes = nil
+// Name returns the value of the "name" field in the mutation.
+func (m *TeamMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddCreatedVoteIDs adds the "created_votes" edge to the Vote entity by ids.
-func (m *UserMutation) AddCreatedVoteIDs(ids ...int) {
-	if m.created_votes == nil {
-		m.created_votes = make(map[int]struct{})
+// OldName returns the old "name" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.created_votes[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
+	return oldValue.Name, nil
 }
 
-// ClearCreatedVotes clears the "created_votes" edge to the Vote entity.
-func (m *UserMutation) ClearCreatedVotes() {
-	m.clearedcreated_votes = true
+// ResetName resets all changes to the "name" field.
+func (m *TeamMutation) ResetName() {
+	m.name = nil
 }
 
-// CreatedVotesCleared reports if the "created_votes" edge to the Vote entity was cleared.
-func (m *UserMutation) CreatedVotesCleared() bool {
-	return m.clearedcreated_votes
+// SetImageURL sets the "image_url" field.
+func (m *TeamMutation) SetImageURL(s string) {
+	m.image_url = &s
 }
 
-// RemoveCreatedVoteIDs removes the "created_votes" edge to the Vote entity by IDs.
-func (m *UserMutation) RemoveCreatedVoteIDs(ids ...int) {
-	if m.removedcreated_votes == nil {
-		m.removedcreated_votes = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.created_votes, ids[i])
-		m.removedcreated_votes[ids[i]] = struct{}{}
+// ImageURL returns the value of the "image_url" field in the mutation.
+func (m *TeamMutation) ImageURL() (r string, exists bool) {
+	v := m.image_url
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedCreatedVotes returns the removed IDs of the "created_votes" edge to the Vote entity.
-func (m *UserMutation) RemovedCreatedVotesIDs() (ids []int) {
-	for id := range m.removedcreated_votes {
-		ids = append(ids, id)
+// OldImageURL returns the old "image_url" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldImageURL(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImageURL is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// CreatedVotesIDs returns the "created_votes" edge IDs in the mutation.
-func (m *UserMutation) CreatedVotesIDs() (ids []int) {
-	for id := range m.created_votes {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImageURL requires an ID field in the mutation")
 	}
-	return
-}
-
-// ResetCreatedVotes resets all changes to the "created_votes" edge.
-func (m *UserMutation) ResetCreatedVotes() {
-	m.created_votes = nil
-	m.clearedcreated_votes = false
-	m.removedcreated_votes = nil
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImageURL: %w", err)
+	}
+	return oldValue.ImageURL, nil
 }
 
-// AddAppIDs adds the "apps" edge to the App entity by ids.
-func (m *UserMutation) AddAppIDs(ids ...string) {
-	if m.apps == nil {
-		m.apps = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.apps[ids[i]] = struct{}{}
-	}
+// ClearImageURL clears the value of the "image_url" field.
+func (m *TeamMutation) ClearImageURL() {
+	m.image_url = nil
+	m.clearedFields[team.FieldImageURL] = struct{}{}
 }
 
-// ClearApps clears the "apps" edge to the App entity.
-func (m *UserMutation) ClearApps() {
-	m.clearedapps = true
+// ImageURLCleared returns if the "image_url" field was cleared in this mutation.
+func (m *TeamMutation) ImageURLCleared() bool {
+	_, ok := m.clearedFields[team.FieldImageURL]
+	return ok
 }
 
-// AppsCleared reports if the "apps" edge to the App entity was cleared.
-func (m *UserMutation) AppsCleared() bool {
-	return m.clearedapps
+// ResetImageURL resets all changes to the "image_url" field.
+func (m *TeamMutation) ResetImageURL() {
+	m.image_url = nil
+	delete(m.clearedFields, team.FieldImageURL)
 }
 
-// RemoveAppIDs removes the "apps" edge to the App entity by IDs.
-func (m *UserMutation) RemoveAppIDs(ids ...string) {
-	if m.removedapps == nil {
-		m.removedapps = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.apps, ids[i])
-		m.removedapps[ids[i]] = struct{}{}
-	}
+// SetIsLocked sets the "is_locked" field.
+func (m *TeamMutation) SetIsLocked(b bool) {
+	m.is_locked = &b
 }
 
-// RemovedApps returns the removed IDs of the "apps" edge to the App entity.
-func (m *UserMutation) RemovedAppsIDs() (ids []string) {
-	for id := range m.removedapps {
-		ids = append(ids, id)
+// IsLocked returns the value of the "is_locked" field in the mutation.
+func (m *TeamMutation) IsLocked() (r bool, exists bool) {
+	v := m.is_locked
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// AppsIDs returns the "apps" edge IDs in the mutation.
-func (m *UserMutation) AppsIDs() (ids []string) {
-	for id := range m.apps {
-		ids = append(ids, id)
+// OldIsLocked returns the old "is_locked" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldIsLocked(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsLocked is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetApps resets all changes to the "apps" edge.
-func (m *UserMutation) ResetApps() {
-	m.apps = nil
-	m.clearedapps = false
-	m.removedapps = nil
-}
-
-// AddConsentIDs adds the "consents" edge to the Consent entity by ids.
-func (m *UserMutation) AddConsentIDs(ids ...int) {
-	if m.consents == nil {
-		m.consents = make(map[int]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsLocked requires an ID field in the mutation")
 	}
-	for i := range ids {
-		m.consents[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsLocked: %w", err)
 	}
+	return oldValue.IsLocked, nil
 }
 
-// ClearConsents clears the "consents" edge to the Consent entity.
-func (m *UserMutation) ClearConsents() {
-	m.clearedconsents = true
+// ResetIsLocked resets all changes to the "is_locked" field.
+func (m *TeamMutation) ResetIsLocked() {
+	m.is_locked = nil
 }
 
-// ConsentsCleared reports if the "consents" edge to the Consent entity was cleared.
-func (m *UserMutation) ConsentsCleared() bool {
-	return m.clearedconsents
+// SetIsRegistered sets the "is_registered" field.
+func (m *TeamMutation) SetIsRegistered(b bool) {
+	m.is_registered = &b
 }
 
-// RemoveConsentIDs removes the "consents" edge to the Consent entity by IDs.
-func (m *UserMutation) RemoveConsentIDs(ids ...int) {
-	if m.removedconsents == nil {
-		m.removedconsents = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.consents, ids[i])
-		m.removedconsents[ids[i]] = struct{}{}
+// IsRegistered returns the value of the "is_registered" field in the mutation.
+func (m *TeamMutation) IsRegistered() (r bool, exists bool) {
+	v := m.is_registered
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedConsents returns the removed IDs of the "consents" edge to the Consent entity.
-func (m *UserMutation) RemovedConsentsIDs() (ids []int) {
-	for id := range m.removedconsents {
-		ids = append(ids, id)
+// OldIsRegistered returns the old "is_registered" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldIsRegistered(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsRegistered is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsRegistered requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsRegistered: %w", err)
+	}
+	return oldValue.IsRegistered, nil
 }
 
-// ConsentsIDs returns the "consents" edge IDs in the mutation.
-func (m *UserMutation) ConsentsIDs() (ids []int) {
-	for id := range m.consents {
-		ids = append(ids, id)
-	}
-	return
+// ResetIsRegistered resets all changes to the "is_registered" field.
+func (m *TeamMutation) ResetIsRegistered() {
+	m.is_registered = nil
 }
 
-// ResetConsents resets all changes to the "consents" edge.
-func (m *UserMutation) ResetConsents() {
-	m.consents = nil
-	m.clearedconsents = false
-	m.removedconsents = nil
+// SetIsWaitlisted sets the "is_waitlisted" field.
+func (m *TeamMutation) SetIsWaitlisted(b bool) {
+	m.is_waitlisted = &b
 }
 
-// AddTeamMembershipIDs adds the "team_memberships" edge to the TeamMember entity by ids.
-func (m *UserMutation) AddTeamMembershipIDs(ids ...int) {
-	if m.team_memberships == nil {
-		m.team_memberships = make(map[int]struct{})
+// IsWaitlisted returns the value of the "is_waitlisted" field in the mutation.
+func (m *TeamMutation) IsWaitlisted() (r bool, exists bool) {
+	v := m.is_waitlisted
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.team_memberships[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldIsWaitlisted returns the old "is_waitlisted" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldIsWaitlisted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsWaitlisted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsWaitlisted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsWaitlisted: %w", err)
 	}
+	return oldValue.IsWaitlisted, nil
 }
 
-// ClearTeamMemberships clears the "team_memberships" edge to the TeamMember entity.
-func (m *UserMutation) ClearTeamMemberships() {
-	m.clearedteam_memberships = true
+// ResetIsWaitlisted resets all changes to the "is_waitlisted" field.
+func (m *TeamMutation) ResetIsWaitlisted() {
+	m.is_waitlisted = nil
 }
 
-// TeamMembershipsCleared reports if the "team_memberships" edge to the TeamMember entity was cleared.
-func (m *UserMutation) TeamMembershipsCleared() bool {
-	return m.clearedteam_memberships
+// SetWaitlistPosition sets the "waitlist_position" field.
+func (m *TeamMutation) SetWaitlistPosition(i int) {
+	m.waitlist_position = &i
+	m.addwaitlist_position = nil
 }
 
-// RemoveTeamMembershipIDs removes the "team_memberships" edge to the TeamMember entity by IDs.
-func (m *UserMutation) RemoveTeamMembershipIDs(ids ...int) {
-	if m.removedteam_memberships == nil {
-		m.removedteam_memberships = make(map[int]struct{})
+// WaitlistPosition returns the value of the "waitlist_position" field in the mutation.
+func (m *TeamMutation) WaitlistPosition() (r int, exists bool) {
+	v := m.waitlist_position
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.team_memberships, ids[i])
-		m.removedteam_memberships[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldWaitlistPosition returns the old "waitlist_position" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldWaitlistPosition(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWaitlistPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWaitlistPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWaitlistPosition: %w", err)
 	}
+	return oldValue.WaitlistPosition, nil
 }
 
-// RemovedTeamMemberships returns the removed IDs of the "team_memberships" edge to the TeamMember entity.
-func (m *UserMutation) RemovedTeamMembershipsIDs() (ids []int) {
-	for id := range m.removedteam_memberships {
-		ids = append(ids, id)
+// AddWaitlistPosition adds i to the "waitlist_position" field.
+func (m *TeamMutation) AddWaitlistPosition(i int) {
+	if m.addwaitlist_position != nil {
+		*m.addwaitlist_position += i
+	} else {
+		m.addwaitlist_position = &i
 	}
-	return
 }
 
-// TeamMembershipsIDs returns the "team_memberships" edge IDs in the mutation.
-func (m *UserMutation) TeamMembershipsIDs() (ids []int) {
-	for id := range m.team_memberships {
-		ids = append(ids, id)
+// AddedWaitlistPosition returns the value that was added to the "waitlist_position" field in this mutation.
+func (m *TeamMutation) AddedWaitlistPosition() (r int, exists bool) {
+	v := m.addwaitlist_position
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetTeamMemberships resets all changes to the "team_memberships" edge.
-func (m *UserMutation) ResetTeamMemberships() {
-	m.team_memberships = nil
-	m.clearedteam_memberships = false
-	m.removedteam_memberships = nil
+// ClearWaitlistPosition clears the value of the "waitlist_position" field.
+func (m *TeamMutation) ClearWaitlistPosition() {
+	m.waitlist_position = nil
+	m.addwaitlist_position = nil
+	m.clearedFields[team.FieldWaitlistPosition] = struct{}{}
 }
 
-// AddReceivedInvitationIDs adds the "received_invitations" edge to the Invitation entity by ids.
-func (m *UserMutation) AddReceivedInvitationIDs(ids ...int) {
-	if m.received_invitations == nil {
-		m.received_invitations = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.received_invitations[ids[i]] = struct{}{}
-	}
+// WaitlistPositionCleared returns if the "waitlist_position" field was cleared in this mutation.
+func (m *TeamMutation) WaitlistPositionCleared() bool {
+	_, ok := m.clearedFields[team.FieldWaitlistPosition]
+	return ok
 }
 
-// ClearReceivedInvitations clears the "received_invitations" edge to the Invitation entity.
-func (m *UserMutation) ClearReceivedInvitations() {
-	m.clearedreceived_invitations = true
+// ResetWaitlistPosition resets all changes to the "waitlist_position" field.
+func (m *TeamMutation) ResetWaitlistPosition() {
+	m.waitlist_position = nil
+	m.addwaitlist_position = nil
+	delete(m.clearedFields, team.FieldWaitlistPosition)
 }
 
-// ReceivedInvitationsCleared reports if the "received_invitations" edge to the Invitation entity was cleared.
-func (m *UserMutation) ReceivedInvitationsCleared() bool {
-	return m.clearedreceived_invitations
+// SetScore sets the "score" field.
+func (m *TeamMutation) SetScore(i int) {
+	m.score = &i
+	m.addscore = nil
 }
 
-// RemoveReceivedInvitationIDs removes the "received_invitations" edge to the Invitation entity by IDs.
-func (m *UserMutation) RemoveReceivedInvitationIDs(ids ...int) {
-	if m.removedreceived_invitations == nil {
-		m.removedreceived_invitations = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.received_invitations, ids[i])
-		m.removedreceived_invitations[ids[i]] = struct{}{}
+// Score returns the value of the "score" field in the mutation.
+func (m *TeamMutation) Score() (r int, exists bool) {
+	v := m.score
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedReceivedInvitations returns the removed IDs of the "received_invitations" edge to the Invitation entity.
-func (m *UserMutation) RemovedReceivedInvitationsIDs() (ids []int) {
-	for id := range m.removedreceived_invitations {
-		ids = append(ids, id)
+// OldScore returns the old "score" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+	}
+	return oldValue.Score, nil
 }
 
-// ReceivedInvitationsIDs returns the "received_invitations" edge IDs in the mutation.
-func (m *UserMutation) ReceivedInvitationsIDs() (ids []int) {
-	for id := range m.received_invitations {
-		ids = append(ids, id)
+// AddScore adds i to the "score" field.
+func (m *TeamMutation) AddScore(i int) {
+	if m.addscore != nil {
+		*m.addscore += i
+	} else {
+		m.addscore = &i
 	}
-	return
 }
 
-// ResetReceivedInvitations resets all changes to the "received_invitations" edge.
-func (m *UserMutation) ResetReceivedInvitations() {
-	m.received_invitations = nil
-	m.clearedreceived_invitations = false
-	m.removedreceived_invitations = nil
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *TeamMutation) AddedScore() (r int, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddCreatedTeamIDs adds the "created_teams" edge to the Team entity by ids.
-func (m *UserMutation) AddCreatedTeamIDs(ids ...int) {
-	if m.created_teams == nil {
-		m.created_teams = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.created_teams[ids[i]] = struct{}{}
-	}
+// ClearScore clears the value of the "score" field.
+func (m *TeamMutation) ClearScore() {
+	m.score = nil
+	m.addscore = nil
+	m.clearedFields[team.FieldScore] = struct{}{}
 }
 
-// ClearCreatedTeams clears the "created_teams" edge to the Team entity.
-func (m *UserMutation) ClearCreatedTeams() {
-	m.clearedcreated_teams = true
+// ScoreCleared returns if the "score" field was cleared in this mutation.
+func (m *TeamMutation) ScoreCleared() bool {
+	_, ok := m.clearedFields[team.FieldScore]
+	return ok
 }
 
-// CreatedTeamsCleared reports if the "created_teams" edge to the Team entity was cleared.
-func (m *UserMutation) CreatedTeamsCleared() bool {
-	return m.clearedcreated_teams
+// ResetScore resets all changes to the "score" field.
+func (m *TeamMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
+	delete(m.clearedFields, team.FieldScore)
 }
 
-// RemoveCreatedTeamIDs removes the "created_teams" edge to the Team entity by IDs.
-func (m *UserMutation) RemoveCreatedTeamIDs(ids ...int) {
-	if m.removedcreated_teams == nil {
-		m.removedcreated_teams = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.created_teams, ids[i])
-		m.removedcreated_teams[ids[i]] = struct{}{}
-	}
+// SetElo sets the "elo" field.
+func (m *TeamMutation) SetElo(i int) {
+	m.elo = &i
+	m.addelo = nil
 }
 
-// RemovedCreatedTeams returns the removed IDs of the "created_teams" edge to the Team entity.
-func (m *UserMutation) RemovedCreatedTeamsIDs() (ids []int) {
-	for id := range m.removedcreated_teams {
-		ids = append(ids, id)
+// Elo returns the value of the "elo" field in the mutation.
+func (m *TeamMutation) Elo() (r int, exists bool) {
+	v := m.elo
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// CreatedTeamsIDs returns the "created_teams" edge IDs in the mutation.
-func (m *UserMutation) CreatedTeamsIDs() (ids []int) {
-	for id := range m.created_teams {
-		ids = append(ids, id)
+// OldElo returns the old "elo" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldElo(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldElo is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldElo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldElo: %w", err)
+	}
+	return oldValue.Elo, nil
 }
 
-// ResetCreatedTeams resets all changes to the "created_teams" edge.
-func (m *UserMutation) ResetCreatedTeams() {
-	m.created_teams = nil
-	m.clearedcreated_teams = false
-	m.removedcreated_teams = nil
+// AddElo adds i to the "elo" field.
+func (m *TeamMutation) AddElo(i int) {
+	if m.addelo != nil {
+		*m.addelo += i
+	} else {
+		m.addelo = &i
+	}
 }
 
-// AddCreatedTournamentIDs adds the "created_tournaments" edge to the Tournament entity by ids.
-func (m *UserMutation) AddCreatedTournamentIDs(ids ...int) {
-	if m.created_tournaments == nil {
-		m.created_tournaments = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.created_tournaments[ids[i]] = struct{}{}
+// AddedElo returns the value that was added to the "elo" field in this mutation.
+func (m *TeamMutation) AddedElo() (r int, exists bool) {
+	v := m.addelo
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearCreatedTournaments clears the "created_tournaments" edge to the Tournament entity.
-func (m *UserMutation) ClearCreatedTournaments() {
-	m.clearedcreated_tournaments = true
+// ClearElo clears the value of the "elo" field.
+func (m *TeamMutation) ClearElo() {
+	m.elo = nil
+	m.addelo = nil
+	m.clearedFields[team.FieldElo] = struct{}{}
 }
 
-// CreatedTournamentsCleared reports if the "created_tournaments" edge to the Tournament entity was cleared.
-func (m *UserMutation) CreatedTournamentsCleared() bool {
-	return m.clearedcreated_tournaments
+// EloCleared returns if the "elo" field was cleared in this mutation.
+func (m *TeamMutation) EloCleared() bool {
+	_, ok := m.clearedFields[team.FieldElo]
+	return ok
 }
 
-// RemoveCreatedTournamentIDs removes the "created_tournaments" edge to the Tournament entity by IDs.
-func (m *UserMutation) RemoveCreatedTournamentIDs(ids ...int) {
-	if m.removedcreated_tournaments == nil {
-		m.removedcreated_tournaments = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.created_tournaments, ids[i])
-		m.removedcreated_tournaments[ids[i]] = struct{}{}
-	}
+// ResetElo resets all changes to the "elo" field.
+func (m *TeamMutation) ResetElo() {
+	m.elo = nil
+	m.addelo = nil
+	delete(m.clearedFields, team.FieldElo)
 }
 
-// RemovedCreatedTournaments returns the removed IDs of the "created_tournaments" edge to the Tournament entity.
-func (m *UserMutation) RemovedCreatedTournamentsIDs() (ids []int) {
-	for id := range m.removedcreated_tournaments {
-		ids = append(ids, id)
-	}
-	return
+// SetCreatedAt sets the "created_at" field.
+func (m *TeamMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// CreatedTournamentsIDs returns the "created_tournaments" edge IDs in the mutation.
-func (m *UserMutation) CreatedTournamentsIDs() (ids []int) {
-	for id := range m.created_tournaments {
-		ids = append(ids, id)
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TeamMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetCreatedTournaments resets all changes to the "created_tournaments" edge.
-func (m *UserMutation) ResetCreatedTournaments() {
-	m.created_tournaments = nil
-	m.clearedcreated_tournaments = false
-	m.removedcreated_tournaments = nil
+	return *v, true
 }
 
-// AddTournamentAdminIDs adds the "tournament_admins" edge to the TournamentAdmin entity by ids.
-func (m *UserMutation) AddTournamentAdminIDs(ids ...int) {
-	if m.tournament_admins == nil {
-		m.tournament_admins = make(map[int]struct{})
+// OldCreatedAt returns the old "created_at" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.tournament_admins[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
+	return oldValue.CreatedAt, nil
 }
 
-// ClearTournamentAdmins clears the "tournament_admins" edge to the TournamentAdmin entity.
-func (m *UserMutation) ClearTournamentAdmins() {
-	m.clearedtournament_admins = true
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TeamMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// TournamentAdminsCleared reports if the "tournament_admins" edge to the TournamentAdmin entity was cleared.
-func (m *UserMutation) TournamentAdminsCleared() bool {
-	return m.clearedtournament_admins
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TeamMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// RemoveTournamentAdminIDs removes the "tournament_admins" edge to the TournamentAdmin entity by IDs.
-func (m *UserMutation) RemoveTournamentAdminIDs(ids ...int) {
-	if m.removedtournament_admins == nil {
-		m.removedtournament_admins = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.tournament_admins, ids[i])
-		m.removedtournament_admins[ids[i]] = struct{}{}
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TeamMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedTournamentAdmins returns the removed IDs of the "tournament_admins" edge to the TournamentAdmin entity.
-func (m *UserMutation) RemovedTournamentAdminsIDs() (ids []int) {
-	for id := range m.removedtournament_admins {
-		ids = append(ids, id)
+// OldUpdatedAt returns the old "updated_at" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// TournamentAdminsIDs returns the "tournament_admins" edge IDs in the mutation.
-func (m *UserMutation) TournamentAdminsIDs() (ids []int) {
-	for id := range m.tournament_admins {
-		ids = append(ids, id)
-	}
-	return
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TeamMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// ResetTournamentAdmins resets all changes to the "tournament_admins" edge.
-func (m *UserMutation) ResetTournamentAdmins() {
-	m.tournament_admins = nil
-	m.clearedtournament_admins = false
-	m.removedtournament_admins = nil
+// SetTournamentID sets the "tournament" edge to the Tournament entity by id.
+func (m *TeamMutation) SetTournamentID(id int) {
+	m.tournament = &id
 }
 
-// AddNotificationIDs adds the "notifications" edge to the Notification entity by ids.
-func (m *UserMutation) AddNotificationIDs(ids ...int) {
-	if m.notifications == nil {
-		m.notifications = make(map[int]struct{})
+// ClearTournament clears the "tournament" edge to the Tournament entity.
+func (m *TeamMutation) ClearTournament() {
+	m.clearedtournament = true
+}
+
+// TournamentCleared reports if the "tournament" edge to the Tournament entity was cleared.
+func (m *TeamMutation) TournamentCleared() bool {
+	return m.clearedtournament
+}
+
+// TournamentID returns the "tournament" edge ID in the mutation.
+func (m *TeamMutation) TournamentID() (id int, exists bool) {
+	if m.tournament != nil {
+		return *m.tournament, true
+	}
+	return
+}
+
+// TournamentIDs returns the "tournament" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TournamentID instead. It exists only for internal usage by the builders.
+func (m *TeamMutation) TournamentIDs() (ids []int) {
+	if id := m.tournament; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTournament resets all changes to the "tournament" edge.
+func (m *TeamMutation) ResetTournament() {
+	m.tournament = nil
+	m.clearedtournament = false
+}
+
+// SetCreatorID sets the "creator" edge to the User entity by id.
+func (m *TeamMutation) SetCreatorID(id int) {
+	m.creator = &id
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (m *TeamMutation) ClearCreator() {
+	m.clearedcreator = true
+}
+
+// CreatorCleared reports if the "creator" edge to the User entity was cleared.
+func (m *TeamMutation) CreatorCleared() bool {
+	return m.clearedcreator
+}
+
+// CreatorID returns the "creator" edge ID in the mutation.
+func (m *TeamMutation) CreatorID() (id int, exists bool) {
+	if m.creator != nil {
+		return *m.creator, true
+	}
+	return
+}
+
+// CreatorIDs returns the "creator" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CreatorID instead. It exists only for internal usage by the builders.
+func (m *TeamMutation) CreatorIDs() (ids []int) {
+	if id := m.creator; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCreator resets all changes to the "creator" edge.
+func (m *TeamMutation) ResetCreator() {
+	m.creator = nil
+	m.clearedcreator = false
+}
+
+// AddMemberIDs adds the "members" edge to the TeamMember entity by ids.
+func (m *TeamMutation) AddMemberIDs(ids ...int) {
+	if m.members == nil {
+		m.members = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.notifications[ids[i]] = struct{}{}
+		m.members[ids[i]] = struct{}{}
 	}
 }
 
-// ClearNotifications clears the "notifications" edge to the Notification entity.
-func (m *UserMutation) ClearNotifications() {
-	m.clearednotifications = true
+// ClearMembers clears the "members" edge to the TeamMember entity.
+func (m *TeamMutation) ClearMembers() {
+	m.clearedmembers = true
 }
 
-// NotificationsCleared reports if the "notifications" edge to the Notification entity was cleared.
-func (m *UserMutation) NotificationsCleared() bool {
-	return m.clearednotifications
+// MembersCleared reports if the "members" edge to the TeamMember entity was cleared.
+func (m *TeamMutation) MembersCleared() bool {
+	return m.clearedmembers
 }
 
-// RemoveNotificationIDs removes the "notifications" edge to the Notification entity by IDs.
-func (m *UserMutation) RemoveNotificationIDs(ids ...int) {
-	if m.removednotifications == nil {
-		m.removednotifications = make(map[int]struct{})
+// RemoveMemberIDs removes the "members" edge to the TeamMember entity by IDs.
+func (m *TeamMutation) RemoveMemberIDs(ids ...int) {
+	if m.removedmembers == nil {
+		m.removedmembers = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.notifications, ids[i])
-		m.removednotifications[ids[i]] = struct{}{}
+		delete(m.members, ids[i])
+		m.removedmembers[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedNotifications returns the removed IDs of the "notifications" edge to the Notification entity.
-func (m *UserMutation) RemovedNotificationsIDs() (ids []int) {
-	for id := range m.removednotifications {
+// RemovedMembers returns the removed IDs of the "members" edge to the TeamMember entity.
+func (m *TeamMutation) RemovedMembersIDs() (ids []int) {
+	for id := range m.removedmembers {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// NotificationsIDs returns the "notifications" edge IDs in the mutation.
-func (m *UserMutation) NotificationsIDs() (ids []int) {
-	for id := range m.notifications {
+// MembersIDs returns the "members" edge IDs in the mutation.
+func (m *TeamMutation) MembersIDs() (ids []int) {
+	for id := range m.members {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetNotifications resets all changes to the "notifications" edge.
-func (m *UserMutation) ResetNotifications() {
-	m.notifications = nil
-	m.clearednotifications = false
-	m.removednotifications = nil
+// ResetMembers resets all changes to the "members" edge.
+func (m *TeamMutation) ResetMembers() {
+	m.members = nil
+	m.clearedmembers = false
+	m.removedmembers = nil
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// SetRankGroupID sets the "rank_group" edge to the RankGroup entity by id.
+func (m *TeamMutation) SetRankGroupID(id int) {
+	m.rank_group = &id
+}
+
+// ClearRankGroup clears the "rank_group" edge to the RankGroup entity.
+func (m *TeamMutation) ClearRankGroup() {
+	m.clearedrank_group = true
+}
+
+// RankGroupCleared reports if the "rank_group" edge to the RankGroup entity was cleared.
+func (m *TeamMutation) RankGroupCleared() bool {
+	return m.clearedrank_group
+}
+
+// RankGroupID returns the "rank_group" edge ID in the mutation.
+func (m *TeamMutation) RankGroupID() (id int, exists bool) {
+	if m.rank_group != nil {
+		return *m.rank_group, true
+	}
+	return
+}
+
+// RankGroupIDs returns the "rank_group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RankGroupID instead. It exists only for internal usage by the builders.
+func (m *TeamMutation) RankGroupIDs() (ids []int) {
+	if id := m.rank_group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRankGroup resets all changes to the "rank_group" edge.
+func (m *TeamMutation) ResetRankGroup() {
+	m.rank_group = nil
+	m.clearedrank_group = false
+}
+
+// AddInvitationIDs adds the "invitations" edge to the Invitation entity by ids.
+func (m *TeamMutation) AddInvitationIDs(ids ...int) {
+	if m.invitations == nil {
+		m.invitations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.invitations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearInvitations clears the "invitations" edge to the Invitation entity.
+func (m *TeamMutation) ClearInvitations() {
+	m.clearedinvitations = true
+}
+
+// InvitationsCleared reports if the "invitations" edge to the Invitation entity was cleared.
+func (m *TeamMutation) InvitationsCleared() bool {
+	return m.clearedinvitations
+}
+
+// RemoveInvitationIDs removes the "invitations" edge to the Invitation entity by IDs.
+func (m *TeamMutation) RemoveInvitationIDs(ids ...int) {
+	if m.removedinvitations == nil {
+		m.removedinvitations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.invitations, ids[i])
+		m.removedinvitations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedInvitations returns the removed IDs of the "invitations" edge to the Invitation entity.
+func (m *TeamMutation) RemovedInvitationsIDs() (ids []int) {
+	for id := range m.removedinvitations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// InvitationsIDs returns the "invitations" edge IDs in the mutation.
+func (m *TeamMutation) InvitationsIDs() (ids []int) {
+	for id := range m.invitations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetInvitations resets all changes to the "invitations" edge.
+func (m *TeamMutation) ResetInvitations() {
+	m.invitations = nil
+	m.clearedinvitations = false
+	m.removedinvitations = nil
+}
+
+// Where appends a list predicates to the TeamMutation builder.
+func (m *TeamMutation) Where(ps ...predicate.Team) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TeamMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *TeamMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Team, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -11613,54 +13396,54 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *TeamMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *TeamMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (Team).
+func (m *TeamMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
+func (m *TeamMutation) Fields() []string {
 	fields := make([]string, 0, 10)
-	if m.username != nil {
-		fields = append(fields, user.FieldUsername)
-	}
-	if m.email != nil {
-		fields = append(fields, user.FieldEmail)
+	if m.name != nil {
+		fields = append(fields, team.FieldName)
 	}
-	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
+	if m.image_url != nil {
+		fields = append(fields, team.FieldImageURL)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, user.FieldUpdatedAt)
+	if m.is_locked != nil {
+		fields = append(fields, team.FieldIsLocked)
 	}
-	if m.anonymized_at != nil {
-		fields = append(fields, user.FieldAnonymizedAt)
-	}
-	if m.intra_id != nil {
-		fields = append(fields, user.FieldIntraID)
+	if m.is_registered != nil {
+		fields = append(fields, team.FieldIsRegistered)
 	}
-	if m.picture != nil {
-		fields = append(fields, user.FieldPicture)
+	if m.is_waitlisted != nil {
+		fields = append(fields, team.FieldIsWaitlisted)
 	}
-	if m.kind != nil {
-		fields = append(fields, user.FieldKind)
+	if m.waitlist_position != nil {
+		fields = append(fields, team.FieldWaitlistPosition)
 	}
-	if m.roles != nil {
-		fields = append(fields, user.FieldRoles)
+	if m.score != nil {
+		fields = append(fields, team.FieldScore)
 	}
 	if m.elo != nil {
-		fields = append(fields, user.FieldElo)
+		fields = append(fields, team.FieldElo)
+	}
+	if m.created_at != nil {
+		fields = append(fields, team.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, team.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -11668,28 +13451,28 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *TeamMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldUsername:
-		return m.Username()
-	case user.FieldEmail:
-		return m.Email()
-	case user.FieldCreatedAt:
+	case team.FieldName:
+		return m.Name()
+	case team.FieldImageURL:
+		return m.ImageURL()
+	case team.FieldIsLocked:
+		return m.IsLocked()
+	case team.FieldIsRegistered:
+		return m.IsRegistered()
+	case team.FieldIsWaitlisted:
+		return m.IsWaitlisted()
+	case team.FieldWaitlistPosition:
+		return m.WaitlistPosition()
+	case team.FieldScore:
+		return m.Score()
+	case team.FieldElo:
+		return m.Elo()
+	case team.FieldCreatedAt:
 		return m.CreatedAt()
-	case user.FieldUpdatedAt:
+	case team.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case user.FieldAnonymizedAt:
-		return m.AnonymizedAt()
-	case user.FieldIntraID:
-		return m.IntraID()
-	case user.FieldPicture:
-		return m.Picture()
-	case user.FieldKind:
-		return m.Kind()
-	case user.FieldRoles:
-		return m.Roles()
-	case user.FieldElo:
-		return m.Elo()
 	}
 	return nil, false
 }
@@ -11697,120 +13480,123 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TeamMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldUsername:
-		return m.OldUsername(ctx)
-	case user.FieldEmail:
-		return m.OldEmail(ctx)
-	case user.FieldCreatedAt:
+	case team.FieldName:
+		return m.OldName(ctx)
+	case team.FieldImageURL:
+		return m.OldImageURL(ctx)
+	case team.FieldIsLocked:
+		return m.OldIsLocked(ctx)
+	case team.FieldIsRegistered:
+		return m.OldIsRegistered(ctx)
+	case team.FieldIsWaitlisted:
+		return m.OldIsWaitlisted(ctx)
+	case team.FieldWaitlistPosition:
+		return m.OldWaitlistPosition(ctx)
+	case team.FieldScore:
+		return m.OldScore(ctx)
+	case team.FieldElo:
+		return m.OldElo(ctx)
+	case team.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case user.FieldUpdatedAt:
+	case team.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case user.FieldAnonymizedAt:
-		return m.OldAnonymizedAt(ctx)
-	case user.FieldIntraID:
-		return m.OldIntraID(ctx)
-	case user.FieldPicture:
-		return m.OldPicture(ctx)
-	case user.FieldKind:
-		return m.OldKind(ctx)
-	case user.FieldRoles:
-		return m.OldRoles(ctx)
-	case user.FieldElo:
-		return m.OldElo(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown Team field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *TeamMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldUsername:
+	case team.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUsername(v)
+		m.SetName(v)
 		return nil
-	case user.FieldEmail:
+	case team.FieldImageURL:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetImageURL(v)
 		return nil
-	case user.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case team.FieldIsLocked:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetIsLocked(v)
 		return nil
-	case user.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case team.FieldIsRegistered:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetIsRegistered(v)
 		return nil
-	case user.FieldAnonymizedAt:
-		v, ok := value.(time.Time)
+	case team.FieldIsWaitlisted:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAnonymizedAt(v)
+		m.SetIsWaitlisted(v)
 		return nil
-	case user.FieldIntraID:
+	case team.FieldWaitlistPosition:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIntraID(v)
+		m.SetWaitlistPosition(v)
 		return nil
-	case user.FieldPicture:
-		v, ok := value.(string)
+	case team.FieldScore:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPicture(v)
+		m.SetScore(v)
 		return nil
-	case user.FieldKind:
-		v, ok := value.(user.Kind)
+	case team.FieldElo:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKind(v)
+		m.SetElo(v)
 		return nil
-	case user.FieldRoles:
-		v, ok := value.([]string)
+	case team.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRoles(v)
+		m.SetCreatedAt(v)
 		return nil
-	case user.FieldElo:
-		v, ok := value.(int)
+	case team.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetElo(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown Team field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
+func (m *TeamMutation) AddedFields() []string {
 	var fields []string
-	if m.addintra_id != nil {
-		fields = append(fields, user.FieldIntraID)
+	if m.addwaitlist_position != nil {
+		fields = append(fields, team.FieldWaitlistPosition)
+	}
+	if m.addscore != nil {
+		fields = append(fields, team.FieldScore)
 	}
 	if m.addelo != nil {
-		fields = append(fields, user.FieldElo)
+		fields = append(fields, team.FieldElo)
 	}
 	return fields
 }
@@ -11818,11 +13604,13 @@ func (m *UserMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TeamMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldIntraID:
-		return m.AddedIntraID()
-	case user.FieldElo:
+	case team.FieldWaitlistPosition:
+		return m.AddedWaitlistPosition()
+	case team.FieldScore:
+		return m.AddedScore()
+	case team.FieldElo:
 		return m.AddedElo()
 	}
 	return nil, false
@@ -11831,16 +13619,23 @@ func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *TeamMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldIntraID:
+	case team.FieldWaitlistPosition:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddIntraID(v)
+		m.AddWaitlistPosition(v)
 		return nil
-	case user.FieldElo:
+	case team.FieldScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScore(v)
+		return nil
+	case team.FieldElo:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -11848,184 +13643,139 @@ func (m *UserMutation) AddField(name string, value ent.Value) error {
 		m.AddElo(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown Team numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
+func (m *TeamMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(user.FieldAnonymizedAt) {
-		fields = append(fields, user.FieldAnonymizedAt)
+	if m.FieldCleared(team.FieldImageURL) {
+		fields = append(fields, team.FieldImageURL)
 	}
-	if m.FieldCleared(user.FieldIntraID) {
-		fields = append(fields, user.FieldIntraID)
+	if m.FieldCleared(team.FieldWaitlistPosition) {
+		fields = append(fields, team.FieldWaitlistPosition)
 	}
-	if m.FieldCleared(user.FieldPicture) {
-		fields = append(fields, user.FieldPicture)
+	if m.FieldCleared(team.FieldScore) {
+		fields = append(fields, team.FieldScore)
+	}
+	if m.FieldCleared(team.FieldElo) {
+		fields = append(fields, team.FieldElo)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *TeamMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
+func (m *TeamMutation) ClearField(name string) error {
 	switch name {
-	case user.FieldAnonymizedAt:
-		m.ClearAnonymizedAt()
+	case team.FieldImageURL:
+		m.ClearImageURL()
 		return nil
-	case user.FieldIntraID:
-		m.ClearIntraID()
+	case team.FieldWaitlistPosition:
+		m.ClearWaitlistPosition()
 		return nil
-	case user.FieldPicture:
-		m.ClearPicture()
+	case team.FieldScore:
+		m.ClearScore()
+		return nil
+	case team.FieldElo:
+		m.ClearElo()
 		return nil
 	}
-	return fmt.Errorf("unknown User nullable field %s", name)
+	return fmt.Errorf("unknown Team nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *TeamMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldUsername:
-		m.ResetUsername()
-		return nil
-	case user.FieldEmail:
-		m.ResetEmail()
+	case team.FieldName:
+		m.ResetName()
 		return nil
-	case user.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case team.FieldImageURL:
+		m.ResetImageURL()
 		return nil
-	case user.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case team.FieldIsLocked:
+		m.ResetIsLocked()
 		return nil
-	case user.FieldAnonymizedAt:
-		m.ResetAnonymizedAt()
-		return nil
-	case user.FieldIntraID:
-		m.ResetIntraID()
+	case team.FieldIsRegistered:
+		m.ResetIsRegistered()
 		return nil
-	case user.FieldPicture:
-		m.ResetPicture()
+	case team.FieldIsWaitlisted:
+		m.ResetIsWaitlisted()
 		return nil
-	case user.FieldKind:
-		m.ResetKind()
+	case team.FieldWaitlistPosition:
+		m.ResetWaitlistPosition()
 		return nil
-	case user.FieldRoles:
-		m.ResetRoles()
+	case team.FieldScore:
+		m.ResetScore()
 		return nil
-	case user.FieldElo:
+	case team.FieldElo:
 		m.ResetElo()
 		return nil
+	case team.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case team.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown Team field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 10)
-	if m.user_votes != nil {
-		edges = append(edges, user.EdgeUserVotes)
-	}
-	if m.created_votes != nil {
-		edges = append(edges, user.EdgeCreatedVotes)
-	}
-	if m.apps != nil {
-		edges = append(edges, user.EdgeApps)
-	}
-	if m.consents != nil {
-		edges = append(edges, user.EdgeConsents)
-	}
-	if m.team_memberships != nil {
-		edges = append(edges, user.EdgeTeamMemberships)
-	}
-	if m.received_invitations != nil {
-		edges = append(edges, user.EdgeReceivedInvitations)
+func (m *TeamMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.tournament != nil {
+		edges = append(edges, team.EdgeTournament)
 	}
-	if m.created_teams != nil {
-		edges = append(edges, user.EdgeCreatedTeams)
+	if m.creator != nil {
+		edges = append(edges, team.EdgeCreator)
 	}
-	if m.created_tournaments != nil {
-		edges = append(edges, user.EdgeCreatedTournaments)
+	if m.members != nil {
+		edges = append(edges, team.EdgeMembers)
 	}
-	if m.tournament_admins != nil {
-		edges = append(edges, user.EdgeTournamentAdmins)
+	if m.rank_group != nil {
+		edges = append(edges, team.EdgeRankGroup)
 	}
-	if m.notifications != nil {
-		edges = append(edges, user.EdgeNotifications)
+	if m.invitations != nil {
+		edges = append(edges, team.EdgeInvitations)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *TeamMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeUserVotes:
-		ids := make([]ent.Value, 0, len(m.user_votes))
-		for id := range m.user_votes {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeCreatedVotes:
-		ids := make([]ent.Value, 0, len(m.created_votes))
-		for id := range m.created_votes {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeApps:
-		ids := make([]ent.Value, 0, len(m.apps))
-		for id := range m.apps {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeConsents:
-		ids := make([]ent.Value, 0, len(m.consents))
-		for id := range m.consents {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeTeamMemberships:
-		ids := make([]ent.Value, 0, len(m.team_memberships))
-		for id := range m.team_memberships {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeReceivedInvitations:
-		ids := make([]ent.Value, 0, len(m.received_invitations))
-		for id := range m.received_invitations {
-			ids = append(ids, id)
+	case team.EdgeTournament:
+		if id := m.tournament; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case user.EdgeCreatedTeams:
-		ids := make([]ent.Value, 0, len(m.created_teams))
-		for id := range m.created_teams {
-			ids = append(ids, id)
+	case team.EdgeCreator:
+		if id := m.creator; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case user.EdgeCreatedTournaments:
-		ids := make([]ent.Value, 0, len(m.created_tournaments))
-		for id := range m.created_tournaments {
+	case team.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.members))
+		for id := range m.members {
 			ids = append(ids, id)
 		}
 		return ids
-	case user.EdgeTournamentAdmins:
-		ids := make([]ent.Value, 0, len(m.tournament_admins))
-		for id := range m.tournament_admins {
-			ids = append(ids, id)
+	case team.EdgeRankGroup:
+		if id := m.rank_group; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case user.EdgeNotifications:
-		ids := make([]ent.Value, 0, len(m.notifications))
-		for id := range m.notifications {
+	case team.EdgeInvitations:
+		ids := make([]ent.Value, 0, len(m.invitations))
+		for id := range m.invitations {
 			ids = append(ids, id)
 		}
 		return ids
@@ -12034,102 +13784,30 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 10)
-	if m.removeduser_votes != nil {
-		edges = append(edges, user.EdgeUserVotes)
-	}
-	if m.removedcreated_votes != nil {
-		edges = append(edges, user.EdgeCreatedVotes)
-	}
-	if m.removedapps != nil {
-		edges = append(edges, user.EdgeApps)
-	}
-	if m.removedconsents != nil {
-		edges = append(edges, user.EdgeConsents)
-	}
-	if m.removedteam_memberships != nil {
-		edges = append(edges, user.EdgeTeamMemberships)
-	}
-	if m.removedreceived_invitations != nil {
-		edges = append(edges, user.EdgeReceivedInvitations)
-	}
-	if m.removedcreated_teams != nil {
-		edges = append(edges, user.EdgeCreatedTeams)
-	}
-	if m.removedcreated_tournaments != nil {
-		edges = append(edges, user.EdgeCreatedTournaments)
-	}
-	if m.removedtournament_admins != nil {
-		edges = append(edges, user.EdgeTournamentAdmins)
+func (m *TeamMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedmembers != nil {
+		edges = append(edges, team.EdgeMembers)
 	}
-	if m.removednotifications != nil {
-		edges = append(edges, user.EdgeNotifications)
+	if m.removedinvitations != nil {
+		edges = append(edges, team.EdgeInvitations)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+func (m *TeamMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeUserVotes:
-		ids := make([]ent.Value, 0, len(m.removeduser_votes))
-		for id := range m.removeduser_votes {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeCreatedVotes:
-		ids := make([]ent.Value, 0, len(m.removedcreated_votes))
-		for id := range m.removedcreated_votes {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeApps:
-		ids := make([]ent.Value, 0, len(m.removedapps))
-		for id := range m.removedapps {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeConsents:
-		ids := make([]ent.Value, 0, len(m.removedconsents))
-		for id := range m.removedconsents {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeTeamMemberships:
-		ids := make([]ent.Value, 0, len(m.removedteam_memberships))
-		for id := range m.removedteam_memberships {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeReceivedInvitations:
-		ids := make([]ent.Value, 0, len(m.removedreceived_invitations))
-		for id := range m.removedreceived_invitations {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeCreatedTeams:
-		ids := make([]ent.Value, 0, len(m.removedcreated_teams))
-		for id := range m.removedcreated_teams {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeCreatedTournaments:
-		ids := make([]ent.Value, 0, len(m.removedcreated_tournaments))
-		for id := range m.removedcreated_tournaments {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeTournamentAdmins:
-		ids := make([]ent.Value, 0, len(m.removedtournament_admins))
-		for id := range m.removedtournament_admins {
+	case team.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.removedmembers))
+		for id := range m.removedmembers {
 			ids = append(ids, id)
 		}
 		return ids
-	case user.EdgeNotifications:
-		ids := make([]ent.Value, 0, len(m.removednotifications))
-		for id := range m.removednotifications {
+	case team.EdgeInvitations:
+		ids := make([]ent.Value, 0, len(m.removedinvitations))
+		for id := range m.removedinvitations {
 			ids = append(ids, id)
 		}
 		return ids
@@ -12138,237 +13816,6361 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 10)
-	if m.cleareduser_votes {
-		edges = append(edges, user.EdgeUserVotes)
+func (m *TeamMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedtournament {
+		edges = append(edges, team.EdgeTournament)
 	}
-	if m.clearedcreated_votes {
-		edges = append(edges, user.EdgeCreatedVotes)
+	if m.clearedcreator {
+		edges = append(edges, team.EdgeCreator)
 	}
-	if m.clearedapps {
-		edges = append(edges, user.EdgeApps)
+	if m.clearedmembers {
+		edges = append(edges, team.EdgeMembers)
 	}
-	if m.clearedconsents {
-		edges = append(edges, user.EdgeConsents)
+	if m.clearedrank_group {
+		edges = append(edges, team.EdgeRankGroup)
 	}
-	if m.clearedteam_memberships {
-		edges = append(edges, user.EdgeTeamMemberships)
-	}
-	if m.clearedreceived_invitations {
-		edges = append(edges, user.EdgeReceivedInvitations)
-	}
-	if m.clearedcreated_teams {
-		edges = append(edges, user.EdgeCreatedTeams)
-	}
-	if m.clearedcreated_tournaments {
-		edges = append(edges, user.EdgeCreatedTournaments)
-	}
-	if m.clearedtournament_admins {
-		edges = append(edges, user.EdgeTournamentAdmins)
-	}
-	if m.clearednotifications {
-		edges = append(edges, user.EdgeNotifications)
+	if m.clearedinvitations {
+		edges = append(edges, team.EdgeInvitations)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *TeamMutation) EdgeCleared(name string) bool {
 	switch name {
-	case user.EdgeUserVotes:
-		return m.cleareduser_votes
-	case user.EdgeCreatedVotes:
-		return m.clearedcreated_votes
-	case user.EdgeApps:
-		return m.clearedapps
-	case user.EdgeConsents:
-		return m.clearedconsents
-	case user.EdgeTeamMemberships:
-		return m.clearedteam_memberships
-	case user.EdgeReceivedInvitations:
-		return m.clearedreceived_invitations
-	case user.EdgeCreatedTeams:
-		return m.clearedcreated_teams
-	case user.EdgeCreatedTournaments:
-		return m.clearedcreated_tournaments
-	case user.EdgeTournamentAdmins:
-		return m.clearedtournament_admins
-	case user.EdgeNotifications:
-		return m.clearednotifications
+	case team.EdgeTournament:
+		return m.clearedtournament
+	case team.EdgeCreator:
+		return m.clearedcreator
+	case team.EdgeMembers:
+		return m.clearedmembers
+	case team.EdgeRankGroup:
+		return m.clearedrank_group
+	case team.EdgeInvitations:
+		return m.clearedinvitations
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+func (m *TeamMutation) ClearEdge(name string) error {
 	switch name {
+	case team.EdgeTournament:
+		m.ClearTournament()
+		return nil
+	case team.EdgeCreator:
+		m.ClearCreator()
+		return nil
+	case team.EdgeRankGroup:
+		m.ClearRankGroup()
+		return nil
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return fmt.Errorf("unknown Team unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+func (m *TeamMutation) ResetEdge(name string) error {
 	switch name {
-	case user.EdgeUserVotes:
-		m.ResetUserVotes()
-		return nil
-	case user.EdgeCreatedVotes:
-		m.ResetCreatedVotes()
-		return nil
-	case user.EdgeApps:
-		m.ResetApps()
-		return nil
-	case user.EdgeConsents:
-		m.ResetConsents()
-		return nil
-	case user.EdgeTeamMemberships:
-		m.ResetTeamMemberships()
-		return nil
-	case user.EdgeReceivedInvitations:
-		m.ResetReceivedInvitations()
+	case team.EdgeTournament:
+		m.ResetTournament()
 		return nil
-	case user.EdgeCreatedTeams:
-		m.ResetCreatedTeams()
+	case team.EdgeCreator:
+		m.ResetCreator()
 		return nil
-	case user.EdgeCreatedTournaments:
-		m.ResetCreatedTournaments()
+	case team.EdgeMembers:
+		m.ResetMembers()
 		return nil
-	case user.EdgeTournamentAdmins:
-		m.ResetTournamentAdmins()
+	case team.EdgeRankGroup:
+		m.ResetRankGroup()
 		return nil
-	case user.EdgeNotifications:
-		m.ResetNotifications()
+	case team.EdgeInvitations:
+		m.ResetInvitations()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown Team edge %s", name)
+}
+
+// TeamMemberMutation represents an operation that mutates the TeamMember nodes in the graph.
+type TeamMemberMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	role                 *string
+	can_receive_team_elo *bool
+	clearedFields        map[string]struct{}
+	user                 *int
+	cleareduser          bool
+	team                 *int
+	clearedteam          bool
+	tournament           *int
+	clearedtournament    bool
+	done                 bool
+	oldValue             func(context.Context) (*TeamMember, error)
+	predicates           []predicate.TeamMember
+}
+
+var _ ent.Mutation = (*TeamMemberMutation)(nil)
+
+// teammemberOption allows management of the mutation configuration using functional options.
+type teammemberOption func(*TeamMemberMutation)
+
+// newTeamMemberMutation creates new mutation for the TeamMember entity.
+func newTeamMemberMutation(c config, op Op, opts ...teammemberOption) *TeamMemberMutation {
+	m := &TeamMemberMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTeamMember,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTeamMemberID sets the ID field of the mutation.
+func withTeamMemberID(id int) teammemberOption {
+	return func(m *TeamMemberMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TeamMember
+		)
+		m.oldValue = func(ctx context.Context) (*TeamMember, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TeamMember.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTeamMember sets the old TeamMember of the mutation.
+func withTeamMember(node *TeamMember) teammemberOption {
+	return func(m *TeamMemberMutation) {
+		m.oldValue = func(context.Context) (*TeamMember, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TeamMemberMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TeamMemberMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TeamMemberMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TeamMemberMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TeamMember.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRole sets the "role" field.
+func (m *TeamMemberMutation) SetRole(s string) {
+	m.role = &s
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *TeamMemberMutation) Role() (r string, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the TeamMember entity.
+// If the TeamMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMemberMutation) OldRole(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *TeamMemberMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetCanReceiveTeamElo sets the "can_receive_team_elo" field.
+func (m *TeamMemberMutation) SetCanReceiveTeamElo(b bool) {
+	m.can_receive_team_elo = &b
+}
+
+// CanReceiveTeamElo returns the value of the "can_receive_team_elo" field in the mutation.
+func (m *TeamMemberMutation) CanReceiveTeamElo() (r bool, exists bool) {
+	v := m.can_receive_team_elo
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCanReceiveTeamElo returns the old "can_receive_team_elo" field's value of the TeamMember entity.
+// If the TeamMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMemberMutation) OldCanReceiveTeamElo(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCanReceiveTeamElo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCanReceiveTeamElo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCanReceiveTeamElo: %w", err)
+	}
+	return oldValue.CanReceiveTeamElo, nil
+}
+
+// ResetCanReceiveTeamElo resets all changes to the "can_receive_team_elo" field.
+func (m *TeamMemberMutation) ResetCanReceiveTeamElo() {
+	m.can_receive_team_elo = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *TeamMemberMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *TeamMemberMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *TeamMemberMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *TeamMemberMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *TeamMemberMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *TeamMemberMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetTeamID sets the "team" edge to the Team entity by id.
+func (m *TeamMemberMutation) SetTeamID(id int) {
+	m.team = &id
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (m *TeamMemberMutation) ClearTeam() {
+	m.clearedteam = true
+}
+
+// TeamCleared reports if the "team" edge to the Team entity was cleared.
+func (m *TeamMemberMutation) TeamCleared() bool {
+	return m.clearedteam
+}
+
+// TeamID returns the "team" edge ID in the mutation.
+func (m *TeamMemberMutation) TeamID() (id int, exists bool) {
+	if m.team != nil {
+		return *m.team, true
+	}
+	return
+}
+
+// TeamIDs returns the "team" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamID instead. It exists only for internal usage by the builders.
+func (m *TeamMemberMutation) TeamIDs() (ids []int) {
+	if id := m.team; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTeam resets all changes to the "team" edge.
+func (m *TeamMemberMutation) ResetTeam() {
+	m.team = nil
+	m.clearedteam = false
+}
+
+// SetTournamentID sets the "tournament" edge to the Tournament entity by id.
+func (m *TeamMemberMutation) SetTournamentID(id int) {
+	m.tournament = &id
+}
+
+// ClearTournament clears the "tournament" edge to the Tournament entity.
+func (m *TeamMemberMutation) ClearTournament() {
+	m.clearedtournament = true
+}
+
+// TournamentCleared reports if the "tournament" edge to the Tournament entity was cleared.
+func (m *TeamMemberMutation) TournamentCleared() bool {
+	return m.clearedtournament
+}
+
+// TournamentID returns the "tournament" edge ID in the mutation.
+func (m *TeamMemberMutation) TournamentID() (id int, exists bool) {
+	if m.tournament != nil {
+		return *m.tournament, true
+	}
+	return
+}
+
+// TournamentIDs returns the "tournament" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TournamentID instead. It exists only for internal usage by the builders.
+func (m *TeamMemberMutation) TournamentIDs() (ids []int) {
+	if id := m.tournament; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTournament resets all changes to the "tournament" edge.
+func (m *TeamMemberMutation) ResetTournament() {
+	m.tournament = nil
+	m.clearedtournament = false
+}
+
+// Where appends a list predicates to the TeamMemberMutation builder.
+func (m *TeamMemberMutation) Where(ps ...predicate.TeamMember) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TeamMemberMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TeamMemberMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TeamMember, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TeamMemberMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TeamMemberMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TeamMember).
+func (m *TeamMemberMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TeamMemberMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.role != nil {
+		fields = append(fields, teammember.FieldRole)
+	}
+	if m.can_receive_team_elo != nil {
+		fields = append(fields, teammember.FieldCanReceiveTeamElo)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TeamMemberMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case teammember.FieldRole:
+		return m.Role()
+	case teammember.FieldCanReceiveTeamElo:
+		return m.CanReceiveTeamElo()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TeamMemberMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case teammember.FieldRole:
+		return m.OldRole(ctx)
+	case teammember.FieldCanReceiveTeamElo:
+		return m.OldCanReceiveTeamElo(ctx)
+	}
+	return nil, fmt.Errorf("unknown TeamMember field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamMemberMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case teammember.FieldRole:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case teammember.FieldCanReceiveTeamElo:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCanReceiveTeamElo(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TeamMember field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TeamMemberMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TeamMemberMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamMemberMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TeamMember numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TeamMemberMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TeamMemberMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TeamMemberMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TeamMember nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TeamMemberMutation) ResetField(name string) error {
+	switch name {
+	case teammember.FieldRole:
+		m.ResetRole()
+		return nil
+	case teammember.FieldCanReceiveTeamElo:
+		m.ResetCanReceiveTeamElo()
+		return nil
+	}
+	return fmt.Errorf("unknown TeamMember field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TeamMemberMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.user != nil {
+		edges = append(edges, teammember.EdgeUser)
+	}
+	if m.team != nil {
+		edges = append(edges, teammember.EdgeTeam)
+	}
+	if m.tournament != nil {
+		edges = append(edges, teammember.EdgeTournament)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TeamMemberMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case teammember.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case teammember.EdgeTeam:
+		if id := m.team; id != nil {
+			return []ent.Value{*id}
+		}
+	case teammember.EdgeTournament:
+		if id := m.tournament; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TeamMemberMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TeamMemberMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TeamMemberMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.cleareduser {
+		edges = append(edges, teammember.EdgeUser)
+	}
+	if m.clearedteam {
+		edges = append(edges, teammember.EdgeTeam)
+	}
+	if m.clearedtournament {
+		edges = append(edges, teammember.EdgeTournament)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TeamMemberMutation) EdgeCleared(name string) bool {
+	switch name {
+	case teammember.EdgeUser:
+		return m.cleareduser
+	case teammember.EdgeTeam:
+		return m.clearedteam
+	case teammember.EdgeTournament:
+		return m.clearedtournament
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TeamMemberMutation) ClearEdge(name string) error {
+	switch name {
+	case teammember.EdgeUser:
+		m.ClearUser()
+		return nil
+	case teammember.EdgeTeam:
+		m.ClearTeam()
+		return nil
+	case teammember.EdgeTournament:
+		m.ClearTournament()
+		return nil
+	}
+	return fmt.Errorf("unknown TeamMember unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TeamMemberMutation) ResetEdge(name string) error {
+	switch name {
+	case teammember.EdgeUser:
+		m.ResetUser()
+		return nil
+	case teammember.EdgeTeam:
+		m.ResetTeam()
+		return nil
+	case teammember.EdgeTournament:
+		m.ResetTournament()
+		return nil
+	}
+	return fmt.Errorf("unknown TeamMember edge %s", name)
+}
+
+// TournamentMutation represents an operation that mutates the Tournament nodes in the graph.
+type TournamentMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	slug                  *string
+	name                  *string
+	description           *string
+	image_url             *string
+	is_visible            *bool
+	registration_start    *time.Time
+	registration_end      *time.Time
+	tournament_start      *time.Time
+	tournament_end        *time.Time
+	max_teams             *int
+	addmax_teams          *int
+	team_structure        *map[string]interface{}
+	custom_page_component *string
+	external_links        *map[string]string
+	tier                  *tournament.Tier
+	created_at            *time.Time
+	updated_at            *time.Time
+	clearedFields         map[string]struct{}
+	creator               *int
+	clearedcreator        bool
+	admins                map[int]struct{}
+	removedadmins         map[int]struct{}
+	clearedadmins         bool
+	teams                 map[int]struct{}
+	removedteams          map[int]struct{}
+	clearedteams          bool
+	rank_groups           map[int]struct{}
+	removedrank_groups    map[int]struct{}
+	clearedrank_groups    bool
+	team_members          map[int]struct{}
+	removedteam_members   map[int]struct{}
+	clearedteam_members   bool
+	sanctions             map[int]struct{}
+	removedsanctions      map[int]struct{}
+	clearedsanctions      bool
+	done                  bool
+	oldValue              func(context.Context) (*Tournament, error)
+	predicates            []predicate.Tournament
+}
+
+var _ ent.Mutation = (*TournamentMutation)(nil)
+
+// tournamentOption allows management of the mutation configuration using functional options.
+type tournamentOption func(*TournamentMutation)
+
+// newTournamentMutation creates new mutation for the Tournament entity.
+func newTournamentMutation(c config, op Op, opts ...tournamentOption) *TournamentMutation {
+	m := &TournamentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTournament,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTournamentID sets the ID field of the mutation.
+func withTournamentID(id int) tournamentOption {
+	return func(m *TournamentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tournament
+		)
+		m.oldValue = func(ctx context.Context) (*Tournament, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tournament.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTournament sets the old Tournament of the mutation.
+func withTournament(node *Tournament) tournamentOption {
+	return func(m *TournamentMutation) {
+		m.oldValue = func(context.Context) (*Tournament, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TournamentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TournamentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TournamentMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TournamentMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tournament.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSlug sets the "slug" field.
+func (m *TournamentMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the value of the "slug" field in the mutation.
+func (m *TournamentMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlug returns the old "slug" field's value of the Tournament entity.
+// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *TournamentMutation) ResetSlug() {
+	m.slug = nil
+}
+
+// SetName sets the "name" field.
+func (m *TournamentMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TournamentMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Tournament entity.
+// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TournamentMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *TournamentMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *TournamentMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Tournament entity.
+// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *TournamentMutation) ResetDescription() {
+	m.description = nil
+}
+
+// SetImageURL sets the "image_url" field.
+func (m *TournamentMutation) SetImageURL(s string) {
+	m.image_url = &s
+}
+
+// ImageURL returns the value of the "image_url" field in the mutation.
+func (m *TournamentMutation) ImageURL() (r string, exists bool) {
+	v := m.image_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImageURL returns the old "image_url" field's value of the Tournament entity.
+// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMutation) OldImageURL(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImageURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImageURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImageURL: %w", err)
+	}
+	return oldValue.ImageURL, nil
+}
+
+// ClearImageURL clears the value of the "image_url" field.
+func (m *TournamentMutation) ClearImageURL() {
+	m.image_url = nil
+	m.clearedFields[tournament.FieldImageURL] = struct{}{}
+}
+
+// ImageURLCleared returns if the "image_url" field was cleared in this mutation.
+func (m *TournamentMutation) ImageURLCleared() bool {
+	_, ok := m.clearedFields[tournament.FieldImageURL]
+	return ok
+}
+
+// ResetImageURL resets all changes to the "image_url" field.
+func (m *TournamentMutation) ResetImageURL() {
+	m.image_url = nil
+	delete(m.clearedFields, tournament.FieldImageURL)
+}
+
+// SetIsVisible sets the "is_visible" field.
+func (m *TournamentMutation) SetIsVisible(b bool) {
+	m.is_visible = &b
+}
+
+// IsVisible returns the value of the "is_visible" field in the mutation.
+func (m *TournamentMutation) IsVisible() (r bool, exists bool) {
+	v := m.is_visible
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsVisible returns the old "is_visible" field's value of the Tournament entity.
+// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMutation) OldIsVisible(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsVisible is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsVisible requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsVisible: %w", err)
+	}
+	return oldValue.IsVisible, nil
+}
+
+// ResetIsVisible resets all changes to the "is_visible" field.
+func (m *TournamentMutation) ResetIsVisible() {
+	m.is_visible = nil
+}
+
+// SetRegistrationStart sets the "registration_start" field.
+func (m *TournamentMutation) SetRegistrationStart(t time.Time) {
+	m.registration_start = &t
+}
+
+// RegistrationStart returns the value of the "registration_start" field in the mutation.
+func (m *TournamentMutation) RegistrationStart() (r time.Time, exists bool) {
+	v := m.registration_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegistrationStart returns the old "registration_start" field's value of the Tournament entity.
+// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMutation) OldRegistrationStart(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegistrationStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegistrationStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegistrationStart: %w", err)
+	}
+	return oldValue.RegistrationStart, nil
+}
+
+// ResetRegistrationStart resets all changes to the "registration_start" field.
+func (m *TournamentMutation) ResetRegistrationStart() {
+	m.registration_start = nil
+}
+
+// SetRegistrationEnd sets the "registration_end" field.
+func (m *TournamentMutation) SetRegistrationEnd(t time.Time) {
+	m.registration_end = &t
+}
+
+// RegistrationEnd returns the value of the "registration_end" field in the mutation.
+func (m *TournamentMutation) RegistrationEnd() (r time.Time, exists bool) {
+	v := m.registration_end
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegistrationEnd returns the old "registration_end" field's value of the Tournament entity.
+// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMutation) OldRegistrationEnd(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegistrationEnd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegistrationEnd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegistrationEnd: %w", err)
+	}
+	return oldValue.RegistrationEnd, nil
+}
+
+// ResetRegistrationEnd resets all changes to the "registration_end" field.
+func (m *TournamentMutation) ResetRegistrationEnd() {
+	m.registration_end = nil
+}
+
+// SetTournamentStart sets the "tournament_start" field.
+func (m *TournamentMutation) SetTournamentStart(t time.Time) {
+	m.tournament_start = &t
+}
+
+// TournamentStart returns the value of the "tournament_start" field in the mutation.
+func (m *TournamentMutation) TournamentStart() (r time.Time, exists bool) {
+	v := m.tournament_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTournamentStart returns the old "tournament_start" field's value of the Tournament entity.
+// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMutation) OldTournamentStart(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTournamentStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTournamentStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTournamentStart: %w", err)
+	}
+	return oldValue.TournamentStart, nil
+}
+
+// ResetTournamentStart resets all changes to the "tournament_start" field.
+func (m *TournamentMutation) ResetTournamentStart() {
+	m.tournament_start = nil
+}
+
+// SetTournamentEnd sets the "tournament_end" field.
+func (m *TournamentMutation) SetTournamentEnd(t time.Time) {
+	m.tournament_end = &t
+}
+
+// TournamentEnd returns the value of the "tournament_end" field in the mutation.
+func (m *TournamentMutation) TournamentEnd() (r time.Time, exists bool) {
+	v := m.tournament_end
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTournamentEnd returns the old "tournament_end" field's value of the Tournament entity.
+// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMutation) OldTournamentEnd(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTournamentEnd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTournamentEnd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTournamentEnd: %w", err)
+	}
+	return oldValue.TournamentEnd, nil
+}
+
+// ClearTournamentEnd clears the value of the "tournament_end" field.
+func (m *TournamentMutation) ClearTournamentEnd() {
+	m.tournament_end = nil
+	m.clearedFields[tournament.FieldTournamentEnd] = struct{}{}
+}
+
+// TournamentEndCleared returns if the "tournament_end" field was cleared in this mutation.
+func (m *TournamentMutation) TournamentEndCleared() bool {
+	_, ok := m.clearedFields[tournament.FieldTournamentEnd]
+	return ok
+}
+
+// ResetTournamentEnd resets all changes to the "tournament_end" field.
+func (m *TournamentMutation) ResetTournamentEnd() {
+	m.tournament_end = nil
+	delete(m.clearedFields, tournament.FieldTournamentEnd)
+}
+
+// SetMaxTeams sets the "max_teams" field.
+func (m *TournamentMutation) SetMaxTeams(i int) {
+	m.max_teams = &i
+	m.addmax_teams = nil
+}
+
+// MaxTeams returns the value of the "max_teams" field in the mutation.
+func (m *TournamentMutation) MaxTeams() (r int, exists bool) {
+	v := m.max_teams
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxTeams returns the old "max_teams" field's value of the Tournament entity.
+// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMutation) OldMaxTeams(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxTeams is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxTeams requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxTeams: %w", err)
+	}
+	return oldValue.MaxTeams, nil
+}
+
+// AddMaxTeams adds i to the "max_teams" field.
+func (m *TournamentMutation) AddMaxTeams(i int) {
+	if m.addmax_teams != nil {
+		*m.addmax_teams += i
+	} else {
+		m.addmax_teams = &i
+	}
+}
+
+// AddedMaxTeams returns the value that was added to the "max_teams" field in this mutation.
+func (m *TournamentMutation) AddedMaxTeams() (r int, exists bool) {
+	v := m.addmax_teams
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxTeams resets all changes to the "max_teams" field.
+func (m *TournamentMutation) ResetMaxTeams() {
+	m.max_teams = nil
+	m.addmax_teams = nil
+}
+
+// SetTeamStructure sets the "team_structure" field.
+func (m *TournamentMutation) SetTeamStructure(value map[string]interface{}) {
+	m.team_structure = &value
+}
+
+// TeamStructure returns the value of the "team_structure" field in the mutation.
+func (m *TournamentMutation) TeamStructure() (r map[string]interface{}, exists bool) {
+	v := m.team_structure
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeamStructure returns the old "team_structure" field's value of the Tournament entity.
+// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMutation) OldTeamStructure(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamStructure is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamStructure requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamStructure: %w", err)
+	}
+	return oldValue.TeamStructure, nil
+}
+
+// ClearTeamStructure clears the value of the "team_structure" field.
+func (m *TournamentMutation) ClearTeamStructure() {
+	m.team_structure = nil
+	m.clearedFields[tournament.FieldTeamStructure] = struct{}{}
+}
+
+// TeamStructureCleared returns if the "team_structure" field was cleared in this mutation.
+func (m *TournamentMutation) TeamStructureCleared() bool {
+	_, ok := m.clearedFields[tournament.FieldTeamStructure]
+	return ok
+}
+
+// ResetTeamStructure resets all changes to the "team_structure" field.
+func (m *TournamentMutation) ResetTeamStructure() {
+	m.team_structure = nil
+	delete(m.clearedFields, tournament.FieldTeamStructure)
+}
+
+// SetCustomPageComponent sets the "custom_page_component" field.
+func (m *TournamentMutation) SetCustomPageComponent(s string) {
+	m.custom_page_component = &s
+}
+
+// CustomPageComponent returns the value of the "custom_page_component" field in the mutation.
+func (m *TournamentMutation) CustomPageComponent() (r string, exists bool) {
+	v := m.custom_page_component
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCustomPageComponent returns the old "custom_page_component" field's value of the Tournament entity.
+// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMutation) OldCustomPageComponent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCustomPageComponent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCustomPageComponent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCustomPageComponent: %w", err)
+	}
+	return oldValue.CustomPageComponent, nil
+}
+
+// ResetCustomPageComponent resets all changes to the "custom_page_component" field.
+func (m *TournamentMutation) ResetCustomPageComponent() {
+	m.custom_page_component = nil
+}
+
+// SetExternalLinks sets the "external_links" field.
+func (m *TournamentMutation) SetExternalLinks(value map[string]string) {
+	m.external_links = &value
+}
+
+// ExternalLinks returns the value of the "external_links" field in the mutation.
+func (m *TournamentMutation) ExternalLinks() (r map[string]string, exists bool) {
+	v := m.external_links
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExternalLinks returns the old "external_links" field's value of the Tournament entity.
+// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMutation) OldExternalLinks(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExternalLinks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExternalLinks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalLinks: %w", err)
+	}
+	return oldValue.ExternalLinks, nil
+}
+
+// ClearExternalLinks clears the value of the "external_links" field.
+func (m *TournamentMutation) ClearExternalLinks() {
+	m.external_links = nil
+	m.clearedFields[tournament.FieldExternalLinks] = struct{}{}
+}
+
+// ExternalLinksCleared returns if the "external_links" field was cleared in this mutation.
+func (m *TournamentMutation) ExternalLinksCleared() bool {
+	_, ok := m.clearedFields[tournament.FieldExternalLinks]
+	return ok
+}
+
+// ResetExternalLinks resets all changes to the "external_links" field.
+func (m *TournamentMutation) ResetExternalLinks() {
+	m.external_links = nil
+	delete(m.clearedFields, tournament.FieldExternalLinks)
+}
+
+// SetTier sets the "tier" field.
+func (m *TournamentMutation) SetTier(t tournament.Tier) {
+	m.tier = &t
+}
+
+// Tier returns the value of the "tier" field in the mutation.
+func (m *TournamentMutation) Tier() (r tournament.Tier, exists bool) {
+	v := m.tier
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTier returns the old "tier" field's value of the Tournament entity.
+// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMutation) OldTier(ctx context.Context) (v tournament.Tier, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTier is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTier requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTier: %w", err)
+	}
+	return oldValue.Tier, nil
+}
+
+// ResetTier resets all changes to the "tier" field.
+func (m *TournamentMutation) ResetTier() {
+	m.tier = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TournamentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TournamentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Tournament entity.
+// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TournamentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TournamentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TournamentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Tournament entity.
+// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TournamentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCreatorID sets the "creator" edge to the User entity by id.
+func (m *TournamentMutation) SetCreatorID(id int) {
+	m.creator = &id
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (m *TournamentMutation) ClearCreator() {
+	m.clearedcreator = true
+}
+
+// CreatorCleared reports if the "creator" edge to the User entity was cleared.
+func (m *TournamentMutation) CreatorCleared() bool {
+	return m.clearedcreator
+}
+
+// CreatorID returns the "creator" edge ID in the mutation.
+func (m *TournamentMutation) CreatorID() (id int, exists bool) {
+	if m.creator != nil {
+		return *m.creator, true
+	}
+	return
+}
+
+// CreatorIDs returns the "creator" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CreatorID instead. It exists only for internal usage by the builders.
+func (m *TournamentMutation) CreatorIDs() (ids []int) {
+	if id := m.creator; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCreator resets all changes to the "creator" edge.
+func (m *TournamentMutation) ResetCreator() {
+	m.creator = nil
+	m.clearedcreator = false
+}
+
+// AddAdminIDs adds the "admins" edge to the TournamentAdmin entity by ids.
+func (m *TournamentMutation) AddAdminIDs(ids ...int) {
+	if m.admins == nil {
+		m.admins = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.admins[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAdmins clears the "admins" edge to the TournamentAdmin entity.
+func (m *TournamentMutation) ClearAdmins() {
+	m.clearedadmins = true
+}
+
+// AdminsCleared reports if the "admins" edge to the TournamentAdmin entity was cleared.
+func (m *TournamentMutation) AdminsCleared() bool {
+	return m.clearedadmins
+}
+
+// RemoveAdminIDs removes the "admins" edge to the TournamentAdmin entity by IDs.
+func (m *TournamentMutation) RemoveAdminIDs(ids ...int) {
+	if m.removedadmins == nil {
+		m.removedadmins = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.admins, ids[i])
+		m.removedadmins[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAdmins returns the removed IDs of the "admins" edge to the TournamentAdmin entity.
+func (m *TournamentMutation) RemovedAdminsIDs() (ids []int) {
+	for id := range m.removedadmins {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AdminsIDs returns the "admins" edge IDs in the mutation.
+func (m *TournamentMutation) AdminsIDs() (ids []int) {
+	for id := range m.admins {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAdmins resets all changes to the "admins" edge.
+func (m *TournamentMutation) ResetAdmins() {
+	m.admins = nil
+	m.clearedadmins = false
+	m.removedadmins = nil
+}
+
+// AddTeamIDs adds the "teams" edge to the Team entity by ids.
+func (m *TournamentMutation) AddTeamIDs(ids ...int) {
+	if m.teams == nil {
+		m.teams = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.teams[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTeams clears the "teams" edge to the Team entity.
+func (m *TournamentMutation) ClearTeams() {
+	m.clearedteams = true
+}
+
+// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
+func (m *TournamentMutation) TeamsCleared() bool {
+	return m.clearedteams
+}
+
+// RemoveTeamIDs removes the "teams" edge to the Team entity by IDs.
+func (m *TournamentMutation) RemoveTeamIDs(ids ...int) {
+	if m.removedteams == nil {
+		m.removedteams = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.teams, ids[i])
+		m.removedteams[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTeams returns the removed IDs of the "teams" edge to the Team entity.
+func (m *TournamentMutation) RemovedTeamsIDs() (ids []int) {
+	for id := range m.removedteams {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TeamsIDs returns the "teams" edge IDs in the mutation.
+func (m *TournamentMutation) TeamsIDs() (ids []int) {
+	for id := range m.teams {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTeams resets all changes to the "teams" edge.
+func (m *TournamentMutation) ResetTeams() {
+	m.teams = nil
+	m.clearedteams = false
+	m.removedteams = nil
+}
+
+// AddRankGroupIDs adds the "rank_groups" edge to the RankGroup entity by ids.
+func (m *TournamentMutation) AddRankGroupIDs(ids ...int) {
+	if m.rank_groups == nil {
+		m.rank_groups = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.rank_groups[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRankGroups clears the "rank_groups" edge to the RankGroup entity.
+func (m *TournamentMutation) ClearRankGroups() {
+	m.clearedrank_groups = true
+}
+
+// RankGroupsCleared reports if the "rank_groups" edge to the RankGroup entity was cleared.
+func (m *TournamentMutation) RankGroupsCleared() bool {
+	return m.clearedrank_groups
+}
+
+// RemoveRankGroupIDs removes the "rank_groups" edge to the RankGroup entity by IDs.
+func (m *TournamentMutation) RemoveRankGroupIDs(ids ...int) {
+	if m.removedrank_groups == nil {
+		m.removedrank_groups = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.rank_groups, ids[i])
+		m.removedrank_groups[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRankGroups returns the removed IDs of the "rank_groups" edge to the RankGroup entity.
+func (m *TournamentMutation) RemovedRankGroupsIDs() (ids []int) {
+	for id := range m.removedrank_groups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RankGroupsIDs returns the "rank_groups" edge IDs in the mutation.
+func (m *TournamentMutation) RankGroupsIDs() (ids []int) {
+	for id := range m.rank_groups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRankGroups resets all changes to the "rank_groups" edge.
+func (m *TournamentMutation) ResetRankGroups() {
+	m.rank_groups = nil
+	m.clearedrank_groups = false
+	m.removedrank_groups = nil
+}
+
+// AddTeamMemberIDs adds the "team_members" edge to the TeamMember entity by ids.
+func (m *TournamentMutation) AddTeamMemberIDs(ids ...int) {
+	if m.team_members == nil {
+		m.team_members = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.team_members[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTeamMembers clears the "team_members" edge to the TeamMember entity.
+func (m *TournamentMutation) ClearTeamMembers() {
+	m.clearedteam_members = true
+}
+
+// TeamMembersCleared reports if the "team_members" edge to the TeamMember entity was cleared.
+func (m *TournamentMutation) TeamMembersCleared() bool {
+	return m.clearedteam_members
+}
+
+// RemoveTeamMemberIDs removes the "team_members" edge to the TeamMember entity by IDs.
+func (m *TournamentMutation) RemoveTeamMemberIDs(ids ...int) {
+	if m.removedteam_members == nil {
+		m.removedteam_members = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.team_members, ids[i])
+		m.removedteam_members[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTeamMembers returns the removed IDs of the "team_members" edge to the TeamMember entity.
+func (m *TournamentMutation) RemovedTeamMembersIDs() (ids []int) {
+	for id := range m.removedteam_members {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TeamMembersIDs returns the "team_members" edge IDs in the mutation.
+func (m *TournamentMutation) TeamMembersIDs() (ids []int) {
+	for id := range m.team_members {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTeamMembers resets all changes to the "team_members" edge.
+func (m *TournamentMutation) ResetTeamMembers() {
+	m.team_members = nil
+	m.clearedteam_members = false
+	m.removedteam_members = nil
+}
+
+// AddSanctionIDs adds the "sanctions" edge to the Sanction entity by ids.
+func (m *TournamentMutation) AddSanctionIDs(ids ...int) {
+	if m.sanctions == nil {
+		m.sanctions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.sanctions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSanctions clears the "sanctions" edge to the Sanction entity.
+func (m *TournamentMutation) ClearSanctions() {
+	m.clearedsanctions = true
+}
+
+// SanctionsCleared reports if the "sanctions" edge to the Sanction entity was cleared.
+func (m *TournamentMutation) SanctionsCleared() bool {
+	return m.clearedsanctions
+}
+
+// RemoveSanctionIDs removes the "sanctions" edge to the Sanction entity by IDs.
+func (m *TournamentMutation) RemoveSanctionIDs(ids ...int) {
+	if m.removedsanctions == nil {
+		m.removedsanctions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.sanctions, ids[i])
+		m.removedsanctions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSanctions returns the removed IDs of the "sanctions" edge to the Sanction entity.
+func (m *TournamentMutation) RemovedSanctionsIDs() (ids []int) {
+	for id := range m.removedsanctions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SanctionsIDs returns the "sanctions" edge IDs in the mutation.
+func (m *TournamentMutation) SanctionsIDs() (ids []int) {
+	for id := range m.sanctions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSanctions resets all changes to the "sanctions" edge.
+func (m *TournamentMutation) ResetSanctions() {
+	m.sanctions = nil
+	m.clearedsanctions = false
+	m.removedsanctions = nil
+}
+
+// Where appends a list predicates to the TournamentMutation builder.
+func (m *TournamentMutation) Where(ps ...predicate.Tournament) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TournamentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TournamentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tournament, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TournamentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TournamentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Tournament).
+func (m *TournamentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TournamentMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.slug != nil {
+		fields = append(fields, tournament.FieldSlug)
+	}
+	if m.name != nil {
+		fields = append(fields, tournament.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, tournament.FieldDescription)
+	}
+	if m.image_url != nil {
+		fields = append(fields, tournament.FieldImageURL)
+	}
+	if m.is_visible != nil {
+		fields = append(fields, tournament.FieldIsVisible)
+	}
+	if m.registration_start != nil {
+		fields = append(fields, tournament.FieldRegistrationStart)
+	}
+	if m.registration_end != nil {
+		fields = append(fields, tournament.FieldRegistrationEnd)
+	}
+	if m.tournament_start != nil {
+		fields = append(fields, tournament.FieldTournamentStart)
+	}
+	if m.tournament_end != nil {
+		fields = append(fields, tournament.FieldTournamentEnd)
+	}
+	if m.max_teams != nil {
+		fields = append(fields, tournament.FieldMaxTeams)
+	}
+	if m.team_structure != nil {
+		fields = append(fields, tournament.FieldTeamStructure)
+	}
+	if m.custom_page_component != nil {
+		fields = append(fields, tournament.FieldCustomPageComponent)
+	}
+	if m.external_links != nil {
+		fields = append(fields, tournament.FieldExternalLinks)
+	}
+	if m.tier != nil {
+		fields = append(fields, tournament.FieldTier)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tournament.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, tournament.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TournamentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tournament.FieldSlug:
+		return m.Slug()
+	case tournament.FieldName:
+		return m.Name()
+	case tournament.FieldDescription:
+		return m.Description()
+	case tournament.FieldImageURL:
+		return m.ImageURL()
+	case tournament.FieldIsVisible:
+		return m.IsVisible()
+	case tournament.FieldRegistrationStart:
+		return m.RegistrationStart()
+	case tournament.FieldRegistrationEnd:
+		return m.RegistrationEnd()
+	case tournament.FieldTournamentStart:
+		return m.TournamentStart()
+	case tournament.FieldTournamentEnd:
+		return m.TournamentEnd()
+	case tournament.FieldMaxTeams:
+		return m.MaxTeams()
+	case tournament.FieldTeamStructure:
+		return m.TeamStructure()
+	case tournament.FieldCustomPageComponent:
+		return m.CustomPageComponent()
+	case tournament.FieldExternalLinks:
+		return m.ExternalLinks()
+	case tournament.FieldTier:
+		return m.Tier()
+	case tournament.FieldCreatedAt:
+		return m.CreatedAt()
+	case tournament.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TournamentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tournament.FieldSlug:
+		return m.OldSlug(ctx)
+	case tournament.FieldName:
+		return m.OldName(ctx)
+	case tournament.FieldDescription:
+		return m.OldDescription(ctx)
+	case tournament.FieldImageURL:
+		return m.OldImageURL(ctx)
+	case tournament.FieldIsVisible:
+		return m.OldIsVisible(ctx)
+	case tournament.FieldRegistrationStart:
+		return m.OldRegistrationStart(ctx)
+	case tournament.FieldRegistrationEnd:
+		return m.OldRegistrationEnd(ctx)
+	case tournament.FieldTournamentStart:
+		return m.OldTournamentStart(ctx)
+	case tournament.FieldTournamentEnd:
+		return m.OldTournamentEnd(ctx)
+	case tournament.FieldMaxTeams:
+		return m.OldMaxTeams(ctx)
+	case tournament.FieldTeamStructure:
+		return m.OldTeamStructure(ctx)
+	case tournament.FieldCustomPageComponent:
+		return m.OldCustomPageComponent(ctx)
+	case tournament.FieldExternalLinks:
+		return m.OldExternalLinks(ctx)
+	case tournament.FieldTier:
+		return m.OldTier(ctx)
+	case tournament.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case tournament.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Tournament field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TournamentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tournament.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
+	case tournament.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case tournament.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case tournament.FieldImageURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImageURL(v)
+		return nil
+	case tournament.FieldIsVisible:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsVisible(v)
+		return nil
+	case tournament.FieldRegistrationStart:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegistrationStart(v)
+		return nil
+	case tournament.FieldRegistrationEnd:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegistrationEnd(v)
+		return nil
+	case tournament.FieldTournamentStart:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTournamentStart(v)
+		return nil
+	case tournament.FieldTournamentEnd:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTournamentEnd(v)
+		return nil
+	case tournament.FieldMaxTeams:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxTeams(v)
+		return nil
+	case tournament.FieldTeamStructure:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeamStructure(v)
+		return nil
+	case tournament.FieldCustomPageComponent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCustomPageComponent(v)
+		return nil
+	case tournament.FieldExternalLinks:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExternalLinks(v)
+		return nil
+	case tournament.FieldTier:
+		v, ok := value.(tournament.Tier)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTier(v)
+		return nil
+	case tournament.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case tournament.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tournament field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TournamentMutation) AddedFields() []string {
+	var fields []string
+	if m.addmax_teams != nil {
+		fields = append(fields, tournament.FieldMaxTeams)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TournamentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tournament.FieldMaxTeams:
+		return m.AddedMaxTeams()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TournamentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case tournament.FieldMaxTeams:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxTeams(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tournament numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TournamentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tournament.FieldImageURL) {
+		fields = append(fields, tournament.FieldImageURL)
+	}
+	if m.FieldCleared(tournament.FieldTournamentEnd) {
+		fields = append(fields, tournament.FieldTournamentEnd)
+	}
+	if m.FieldCleared(tournament.FieldTeamStructure) {
+		fields = append(fields, tournament.FieldTeamStructure)
+	}
+	if m.FieldCleared(tournament.FieldExternalLinks) {
+		fields = append(fields, tournament.FieldExternalLinks)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TournamentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TournamentMutation) ClearField(name string) error {
+	switch name {
+	case tournament.FieldImageURL:
+		m.ClearImageURL()
+		return nil
+	case tournament.FieldTournamentEnd:
+		m.ClearTournamentEnd()
+		return nil
+	case tournament.FieldTeamStructure:
+		m.ClearTeamStructure()
+		return nil
+	case tournament.FieldExternalLinks:
+		m.ClearExternalLinks()
+		return nil
+	}
+	return fmt.Errorf("unknown Tournament nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TournamentMutation) ResetField(name string) error {
+	switch name {
+	case tournament.FieldSlug:
+		m.ResetSlug()
+		return nil
+	case tournament.FieldName:
+		m.ResetName()
+		return nil
+	case tournament.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case tournament.FieldImageURL:
+		m.ResetImageURL()
+		return nil
+	case tournament.FieldIsVisible:
+		m.ResetIsVisible()
+		return nil
+	case tournament.FieldRegistrationStart:
+		m.ResetRegistrationStart()
+		return nil
+	case tournament.FieldRegistrationEnd:
+		m.ResetRegistrationEnd()
+		return nil
+	case tournament.FieldTournamentStart:
+		m.ResetTournamentStart()
+		return nil
+	case tournament.FieldTournamentEnd:
+		m.ResetTournamentEnd()
+		return nil
+	case tournament.FieldMaxTeams:
+		m.ResetMaxTeams()
+		return nil
+	case tournament.FieldTeamStructure:
+		m.ResetTeamStructure()
+		return nil
+	case tournament.FieldCustomPageComponent:
+		m.ResetCustomPageComponent()
+		return nil
+	case tournament.FieldExternalLinks:
+		m.ResetExternalLinks()
+		return nil
+	case tournament.FieldTier:
+		m.ResetTier()
+		return nil
+	case tournament.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case tournament.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Tournament field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TournamentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.creator != nil {
+		edges = append(edges, tournament.EdgeCreator)
+	}
+	if m.admins != nil {
+		edges = append(edges, tournament.EdgeAdmins)
+	}
+	if m.teams != nil {
+		edges = append(edges, tournament.EdgeTeams)
+	}
+	if m.rank_groups != nil {
+		edges = append(edges, tournament.EdgeRankGroups)
+	}
+	if m.team_members != nil {
+		edges = append(edges, tournament.EdgeTeamMembers)
+	}
+	if m.sanctions != nil {
+		edges = append(edges, tournament.EdgeSanctions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TournamentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tournament.EdgeCreator:
+		if id := m.creator; id != nil {
+			return []ent.Value{*id}
+		}
+	case tournament.EdgeAdmins:
+		ids := make([]ent.Value, 0, len(m.admins))
+		for id := range m.admins {
+			ids = append(ids, id)
+		}
+		return ids
+	case tournament.EdgeTeams:
+		ids := make([]ent.Value, 0, len(m.teams))
+		for id := range m.teams {
+			ids = append(ids, id)
+		}
+		return ids
+	case tournament.EdgeRankGroups:
+		ids := make([]ent.Value, 0, len(m.rank_groups))
+		for id := range m.rank_groups {
+			ids = append(ids, id)
+		}
+		return ids
+	case tournament.EdgeTeamMembers:
+		ids := make([]ent.Value, 0, len(m.team_members))
+		for id := range m.team_members {
+			ids = append(ids, id)
+		}
+		return ids
+	case tournament.EdgeSanctions:
+		ids := make([]ent.Value, 0, len(m.sanctions))
+		for id := range m.sanctions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TournamentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedadmins != nil {
+		edges = append(edges, tournament.EdgeAdmins)
+	}
+	if m.removedteams != nil {
+		edges = append(edges, tournament.EdgeTeams)
+	}
+	if m.removedrank_groups != nil {
+		edges = append(edges, tournament.EdgeRankGroups)
+	}
+	if m.removedteam_members != nil {
+		edges = append(edges, tournament.EdgeTeamMembers)
+	}
+	if m.removedsanctions != nil {
+		edges = append(edges, tournament.EdgeSanctions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TournamentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tournament.EdgeAdmins:
+		ids := make([]ent.Value, 0, len(m.removedadmins))
+		for id := range m.removedadmins {
+			ids = append(ids, id)
+		}
+		return ids
+	case tournament.EdgeTeams:
+		ids := make([]ent.Value, 0, len(m.removedteams))
+		for id := range m.removedteams {
+			ids = append(ids, id)
+		}
+		return ids
+	case tournament.EdgeRankGroups:
+		ids := make([]ent.Value, 0, len(m.removedrank_groups))
+		for id := range m.removedrank_groups {
+			ids = append(ids, id)
+		}
+		return ids
+	case tournament.EdgeTeamMembers:
+		ids := make([]ent.Value, 0, len(m.removedteam_members))
+		for id := range m.removedteam_members {
+			ids = append(ids, id)
+		}
+		return ids
+	case tournament.EdgeSanctions:
+		ids := make([]ent.Value, 0, len(m.removedsanctions))
+		for id := range m.removedsanctions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TournamentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedcreator {
+		edges = append(edges, tournament.EdgeCreator)
+	}
+	if m.clearedadmins {
+		edges = append(edges, tournament.EdgeAdmins)
+	}
+	if m.clearedteams {
+		edges = append(edges, tournament.EdgeTeams)
+	}
+	if m.clearedrank_groups {
+		edges = append(edges, tournament.EdgeRankGroups)
+	}
+	if m.clearedteam_members {
+		edges = append(edges, tournament.EdgeTeamMembers)
+	}
+	if m.clearedsanctions {
+		edges = append(edges, tournament.EdgeSanctions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TournamentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tournament.EdgeCreator:
+		return m.clearedcreator
+	case tournament.EdgeAdmins:
+		return m.clearedadmins
+	case tournament.EdgeTeams:
+		return m.clearedteams
+	case tournament.EdgeRankGroups:
+		return m.clearedrank_groups
+	case tournament.EdgeTeamMembers:
+		return m.clearedteam_members
+	case tournament.EdgeSanctions:
+		return m.clearedsanctions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TournamentMutation) ClearEdge(name string) error {
+	switch name {
+	case tournament.EdgeCreator:
+		m.ClearCreator()
+		return nil
+	}
+	return fmt.Errorf("unknown Tournament unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TournamentMutation) ResetEdge(name string) error {
+	switch name {
+	case tournament.EdgeCreator:
+		m.ResetCreator()
+		return nil
+	case tournament.EdgeAdmins:
+		m.ResetAdmins()
+		return nil
+	case tournament.EdgeTeams:
+		m.ResetTeams()
+		return nil
+	case tournament.EdgeRankGroups:
+		m.ResetRankGroups()
+		return nil
+	case tournament.EdgeTeamMembers:
+		m.ResetTeamMembers()
+		return nil
+	case tournament.EdgeSanctions:
+		m.ResetSanctions()
+		return nil
+	}
+	return fmt.Errorf("unknown Tournament edge %s", name)
+}
+
+// TournamentAdminMutation represents an operation that mutates the TournamentAdmin nodes in the graph.
+type TournamentAdminMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	role              *tournamentadmin.Role
+	clearedFields     map[string]struct{}
+	user              *int
+	cleareduser       bool
+	tournament        *int
+	clearedtournament bool
+	done              bool
+	oldValue          func(context.Context) (*TournamentAdmin, error)
+	predicates        []predicate.TournamentAdmin
+}
+
+var _ ent.Mutation = (*TournamentAdminMutation)(nil)
+
+// tournamentadminOption allows management of the mutation configuration using functional options.
+type tournamentadminOption func(*TournamentAdminMutation)
+
+// newTournamentAdminMutation creates new mutation for the TournamentAdmin entity.
+func newTournamentAdminMutation(c config, op Op, opts ...tournamentadminOption) *TournamentAdminMutation {
+	m := &TournamentAdminMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTournamentAdmin,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTournamentAdminID sets the ID field of the mutation.
+func withTournamentAdminID(id int) tournamentadminOption {
+	return func(m *TournamentAdminMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TournamentAdmin
+		)
+		m.oldValue = func(ctx context.Context) (*TournamentAdmin, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TournamentAdmin.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTournamentAdmin sets the old TournamentAdmin of the mutation.
+func withTournamentAdmin(node *TournamentAdmin) tournamentadminOption {
+	return func(m *TournamentAdminMutation) {
+		m.oldValue = func(context.Context) (*TournamentAdmin, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TournamentAdminMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TournamentAdminMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TournamentAdminMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TournamentAdminMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TournamentAdmin.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRole sets the "role" field.
+func (m *TournamentAdminMutation) SetRole(t tournamentadmin.Role) {
+	m.role = &t
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *TournamentAdminMutation) Role() (r tournamentadmin.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the TournamentAdmin entity.
+// If the TournamentAdmin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentAdminMutation) OldRole(ctx context.Context) (v tournamentadmin.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *TournamentAdminMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *TournamentAdminMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *TournamentAdminMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *TournamentAdminMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *TournamentAdminMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *TournamentAdminMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *TournamentAdminMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetTournamentID sets the "tournament" edge to the Tournament entity by id.
+func (m *TournamentAdminMutation) SetTournamentID(id int) {
+	m.tournament = &id
+}
+
+// ClearTournament clears the "tournament" edge to the Tournament entity.
+func (m *TournamentAdminMutation) ClearTournament() {
+	m.clearedtournament = true
+}
+
+// TournamentCleared reports if the "tournament" edge to the Tournament entity was cleared.
+func (m *TournamentAdminMutation) TournamentCleared() bool {
+	return m.clearedtournament
+}
+
+// TournamentID returns the "tournament" edge ID in the mutation.
+func (m *TournamentAdminMutation) TournamentID() (id int, exists bool) {
+	if m.tournament != nil {
+		return *m.tournament, true
+	}
+	return
+}
+
+// TournamentIDs returns the "tournament" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TournamentID instead. It exists only for internal usage by the builders.
+func (m *TournamentAdminMutation) TournamentIDs() (ids []int) {
+	if id := m.tournament; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTournament resets all changes to the "tournament" edge.
+func (m *TournamentAdminMutation) ResetTournament() {
+	m.tournament = nil
+	m.clearedtournament = false
+}
+
+// Where appends a list predicates to the TournamentAdminMutation builder.
+func (m *TournamentAdminMutation) Where(ps ...predicate.TournamentAdmin) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TournamentAdminMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TournamentAdminMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TournamentAdmin, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TournamentAdminMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TournamentAdminMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TournamentAdmin).
+func (m *TournamentAdminMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TournamentAdminMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.role != nil {
+		fields = append(fields, tournamentadmin.FieldRole)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TournamentAdminMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tournamentadmin.FieldRole:
+		return m.Role()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TournamentAdminMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tournamentadmin.FieldRole:
+		return m.OldRole(ctx)
+	}
+	return nil, fmt.Errorf("unknown TournamentAdmin field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TournamentAdminMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tournamentadmin.FieldRole:
+		v, ok := value.(tournamentadmin.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TournamentAdmin field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TournamentAdminMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TournamentAdminMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TournamentAdminMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TournamentAdmin numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TournamentAdminMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TournamentAdminMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TournamentAdminMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TournamentAdmin nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TournamentAdminMutation) ResetField(name string) error {
+	switch name {
+	case tournamentadmin.FieldRole:
+		m.ResetRole()
+		return nil
+	}
+	return fmt.Errorf("unknown TournamentAdmin field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TournamentAdminMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, tournamentadmin.EdgeUser)
+	}
+	if m.tournament != nil {
+		edges = append(edges, tournamentadmin.EdgeTournament)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TournamentAdminMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tournamentadmin.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case tournamentadmin.EdgeTournament:
+		if id := m.tournament; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TournamentAdminMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TournamentAdminMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TournamentAdminMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, tournamentadmin.EdgeUser)
+	}
+	if m.clearedtournament {
+		edges = append(edges, tournamentadmin.EdgeTournament)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TournamentAdminMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tournamentadmin.EdgeUser:
+		return m.cleareduser
+	case tournamentadmin.EdgeTournament:
+		return m.clearedtournament
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TournamentAdminMutation) ClearEdge(name string) error {
+	switch name {
+	case tournamentadmin.EdgeUser:
+		m.ClearUser()
+		return nil
+	case tournamentadmin.EdgeTournament:
+		m.ClearTournament()
+		return nil
+	}
+	return fmt.Errorf("unknown TournamentAdmin unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TournamentAdminMutation) ResetEdge(name string) error {
+	switch name {
+	case tournamentadmin.EdgeUser:
+		m.ResetUser()
+		return nil
+	case tournamentadmin.EdgeTournament:
+		m.ResetTournament()
+		return nil
+	}
+	return fmt.Errorf("unknown TournamentAdmin edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                             Op
+	typ                            string
+	id                             *int
+	username                       *string
+	email                          *string
+	created_at                     *time.Time
+	updated_at                     *time.Time
+	anonymized_at                  *time.Time
+	intra_id                       *int
+	addintra_id                    *int
+	picture                        *string
+	kind                           *user.Kind
+	roles                          *[]string
+	appendroles                    []string
+	elo                            *int
+	addelo                         *int
+	campus                         *string
+	last_login_at                  *time.Time
+	clearedFields                  map[string]struct{}
+	user_votes                     map[int]struct{}
+	removeduser_votes              map[int]struct{}
+	cleareduser_votes              bool
+	created_votes                  map[int]struct{}
+	removedcreated_votes           map[int]struct{}
+	clearedcreated_votes           bool
+	created_vote_templates         map[int]struct{}
+	removedcreated_vote_templates  map[int]struct{}
+	clearedcreated_vote_templates  bool
+	apps                           map[string]struct{}
+	removedapps                    map[string]struct{}
+	clearedapps                    bool
+	consents                       map[int]struct{}
+	removedconsents                map[int]struct{}
+	clearedconsents                bool
+	team_memberships               map[int]struct{}
+	removedteam_memberships        map[int]struct{}
+	clearedteam_memberships        bool
+	received_invitations           map[int]struct{}
+	removedreceived_invitations    map[int]struct{}
+	clearedreceived_invitations    bool
+	created_teams                  map[int]struct{}
+	removedcreated_teams           map[int]struct{}
+	clearedcreated_teams           bool
+	created_tournaments            map[int]struct{}
+	removedcreated_tournaments     map[int]struct{}
+	clearedcreated_tournaments     bool
+	tournament_admins              map[int]struct{}
+	removedtournament_admins       map[int]struct{}
+	clearedtournament_admins       bool
+	notifications                  map[int]struct{}
+	removednotifications           map[int]struct{}
+	clearednotifications           bool
+	audit_logs                     map[int]struct{}
+	removedaudit_logs              map[int]struct{}
+	clearedaudit_logs              bool
+	impersonated_audit_logs        map[int]struct{}
+	removedimpersonated_audit_logs map[int]struct{}
+	clearedimpersonated_audit_logs bool
+	role_grants                    map[int]struct{}
+	removedrole_grants             map[int]struct{}
+	clearedrole_grants             bool
+	given_role_grants              map[int]struct{}
+	removedgiven_role_grants       map[int]struct{}
+	clearedgiven_role_grants       bool
+	sanctions                      map[int]struct{}
+	removedsanctions               map[int]struct{}
+	clearedsanctions               bool
+	issued_sanctions               map[int]struct{}
+	removedissued_sanctions        map[int]struct{}
+	clearedissued_sanctions        bool
+	lifted_sanctions               map[int]struct{}
+	removedlifted_sanctions        map[int]struct{}
+	clearedlifted_sanctions        bool
+	done                           bool
+	oldValue                       func(context.Context) (*User, error)
+	predicates                     []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id int) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUsername sets the "username" field.
+func (m *UserMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *UserMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *UserMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetAnonymizedAt sets the "anonymized_at" field.
+func (m *UserMutation) SetAnonymizedAt(t time.Time) {
+	m.anonymized_at = &t
+}
+
+// AnonymizedAt returns the value of the "anonymized_at" field in the mutation.
+func (m *UserMutation) AnonymizedAt() (r time.Time, exists bool) {
+	v := m.anonymized_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnonymizedAt returns the old "anonymized_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAnonymizedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnonymizedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnonymizedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnonymizedAt: %w", err)
+	}
+	return oldValue.AnonymizedAt, nil
+}
+
+// ClearAnonymizedAt clears the value of the "anonymized_at" field.
+func (m *UserMutation) ClearAnonymizedAt() {
+	m.anonymized_at = nil
+	m.clearedFields[user.FieldAnonymizedAt] = struct{}{}
+}
+
+// AnonymizedAtCleared returns if the "anonymized_at" field was cleared in this mutation.
+func (m *UserMutation) AnonymizedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldAnonymizedAt]
+	return ok
+}
+
+// ResetAnonymizedAt resets all changes to the "anonymized_at" field.
+func (m *UserMutation) ResetAnonymizedAt() {
+	m.anonymized_at = nil
+	delete(m.clearedFields, user.FieldAnonymizedAt)
+}
+
+// SetIntraID sets the "intra_id" field.
+func (m *UserMutation) SetIntraID(i int) {
+	m.intra_id = &i
+	m.addintra_id = nil
+}
+
+// IntraID returns the value of the "intra_id" field in the mutation.
+func (m *UserMutation) IntraID() (r int, exists bool) {
+	v := m.intra_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIntraID returns the old "intra_id" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldIntraID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIntraID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIntraID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIntraID: %w", err)
+	}
+	return oldValue.IntraID, nil
+}
+
+// AddIntraID adds i to the "intra_id" field.
+func (m *UserMutation) AddIntraID(i int) {
+	if m.addintra_id != nil {
+		*m.addintra_id += i
+	} else {
+		m.addintra_id = &i
+	}
+}
+
+// AddedIntraID returns the value that was added to the "intra_id" field in this mutation.
+func (m *UserMutation) AddedIntraID() (r int, exists bool) {
+	v := m.addintra_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearIntraID clears the value of the "intra_id" field.
+func (m *UserMutation) ClearIntraID() {
+	m.intra_id = nil
+	m.addintra_id = nil
+	m.clearedFields[user.FieldIntraID] = struct{}{}
+}
+
+// IntraIDCleared returns if the "intra_id" field was cleared in this mutation.
+func (m *UserMutation) IntraIDCleared() bool {
+	_, ok := m.clearedFields[user.FieldIntraID]
+	return ok
+}
+
+// ResetIntraID resets all changes to the "intra_id" field.
+func (m *UserMutation) ResetIntraID() {
+	m.intra_id = nil
+	m.addintra_id = nil
+	delete(m.clearedFields, user.FieldIntraID)
+}
+
+// SetPicture sets the "picture" field.
+func (m *UserMutation) SetPicture(s string) {
+	m.picture = &s
+}
+
+// Picture returns the value of the "picture" field in the mutation.
+func (m *UserMutation) Picture() (r string, exists bool) {
+	v := m.picture
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPicture returns the old "picture" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPicture(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPicture is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPicture requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPicture: %w", err)
+	}
+	return oldValue.Picture, nil
+}
+
+// ClearPicture clears the value of the "picture" field.
+func (m *UserMutation) ClearPicture() {
+	m.picture = nil
+	m.clearedFields[user.FieldPicture] = struct{}{}
+}
+
+// PictureCleared returns if the "picture" field was cleared in this mutation.
+func (m *UserMutation) PictureCleared() bool {
+	_, ok := m.clearedFields[user.FieldPicture]
+	return ok
+}
+
+// ResetPicture resets all changes to the "picture" field.
+func (m *UserMutation) ResetPicture() {
+	m.picture = nil
+	delete(m.clearedFields, user.FieldPicture)
+}
+
+// SetKind sets the "kind" field.
+func (m *UserMutation) SetKind(u user.Kind) {
+	m.kind = &u
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *UserMutation) Kind() (r user.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldKind(ctx context.Context) (v user.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *UserMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetRoles sets the "roles" field.
+func (m *UserMutation) SetRoles(s []string) {
+	m.roles = &s
+	m.appendroles = nil
+}
+
+// Roles returns the value of the "roles" field in the mutation.
+func (m *UserMutation) Roles() (r []string, exists bool) {
+	v := m.roles
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoles returns the old "roles" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldRoles(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoles: %w", err)
+	}
+	return oldValue.Roles, nil
+}
+
+// AppendRoles adds s to the "roles" field.
+func (m *UserMutation) AppendRoles(s []string) {
+	m.appendroles = append(m.appendroles, s...)
+}
+
+// AppendedRoles returns the list of values that were appended to the "roles" field in this mutation.
+func (m *UserMutation) AppendedRoles() ([]string, bool) {
+	if len(m.appendroles) == 0 {
+		return nil, false
+	}
+	return m.appendroles, true
+}
+
+// ResetRoles resets all changes to the "roles" field.
+func (m *UserMutation) ResetRoles() {
+	m.roles = nil
+	m.appendroles = nil
+}
+
+// SetElo sets the "elo" field.
+func (m *UserMutation) SetElo(i int) {
+	m.elo = &i
+	m.addelo = nil
+}
+
+// Elo returns the value of the "elo" field in the mutation.
+func (m *UserMutation) Elo() (r int, exists bool) {
+	v := m.elo
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldElo returns the old "elo" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldElo(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldElo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldElo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldElo: %w", err)
+	}
+	return oldValue.Elo, nil
+}
+
+// AddElo adds i to the "elo" field.
+func (m *UserMutation) AddElo(i int) {
+	if m.addelo != nil {
+		*m.addelo += i
+	} else {
+		m.addelo = &i
+	}
+}
+
+// AddedElo returns the value that was added to the "elo" field in this mutation.
+func (m *UserMutation) AddedElo() (r int, exists bool) {
+	v := m.addelo
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetElo resets all changes to the "elo" field.
+func (m *UserMutation) ResetElo() {
+	m.elo = nil
+	m.addelo = nil
+}
+
+// SetCampus sets the "campus" field.
+func (m *UserMutation) SetCampus(s string) {
+	m.campus = &s
+}
+
+// Campus returns the value of the "campus" field in the mutation.
+func (m *UserMutation) Campus() (r string, exists bool) {
+	v := m.campus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCampus returns the old "campus" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCampus(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCampus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCampus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCampus: %w", err)
+	}
+	return oldValue.Campus, nil
+}
+
+// ClearCampus clears the value of the "campus" field.
+func (m *UserMutation) ClearCampus() {
+	m.campus = nil
+	m.clearedFields[user.FieldCampus] = struct{}{}
+}
+
+// CampusCleared returns if the "campus" field was cleared in this mutation.
+func (m *UserMutation) CampusCleared() bool {
+	_, ok := m.clearedFields[user.FieldCampus]
+	return ok
+}
+
+// ResetCampus resets all changes to the "campus" field.
+func (m *UserMutation) ResetCampus() {
+	m.campus = nil
+	delete(m.clearedFields, user.FieldCampus)
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (m *UserMutation) SetLastLoginAt(t time.Time) {
+	m.last_login_at = &t
+}
+
+// LastLoginAt returns the value of the "last_login_at" field in the mutation.
+func (m *UserMutation) LastLoginAt() (r time.Time, exists bool) {
+	v := m.last_login_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastLoginAt returns the old "last_login_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLastLoginAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastLoginAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastLoginAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastLoginAt: %w", err)
+	}
+	return oldValue.LastLoginAt, nil
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (m *UserMutation) ClearLastLoginAt() {
+	m.last_login_at = nil
+	m.clearedFields[user.FieldLastLoginAt] = struct{}{}
+}
+
+// LastLoginAtCleared returns if the "last_login_at" field was cleared in this mutation.
+func (m *UserMutation) LastLoginAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldLastLoginAt]
+	return ok
+}
+
+// ResetLastLoginAt resets all changes to the "last_login_at" field.
+func (m *UserMutation) ResetLastLoginAt() {
+	m.last_login_at = nil
+	delete(m.clearedFields, user.FieldLastLoginAt)
+}
+
+// AddUserVoteIDs adds the "user_votes" edge to the UserVote entity by ids.
+func (m *UserMutation) AddUserVoteIDs(ids ...int) {
+	if m.user_votes == nil {
+		m.user_votes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.user_votes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUserVotes clears the "user_votes" edge to the UserVote entity.
+func (m *UserMutation) ClearUserVotes() {
+	m.cleareduser_votes = true
+}
+
+// UserVotesCleared reports if the "user_votes" edge to the UserVote entity was cleared.
+func (m *UserMutation) UserVotesCleared() bool {
+	return m.cleareduser_votes
+}
+
+// RemoveUserVoteIDs removes the "user_votes" edge to the UserVote entity by IDs.
+func (m *UserMutation) RemoveUserVoteIDs(ids ...int) {
+	if m.removeduser_votes == nil {
+		m.removeduser_votes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.user_votes, ids[i])
+		m.removeduser_votes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUserVotes returns the removed IDs of the "user_votes" edge to the UserVote entity.
+func (m *UserMutation) RemovedUserVotesIDs() (ids []int) {
+	for id := range m.removeduser_votes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UserVotesIDs returns the "user_votes" edge IDs in the mutation.
+func (m *UserMutation) UserVotesIDs() (ids []int) {
+	for id := range m.user_votes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUserVotes resets all changes to the "user_votes" edge.
+func (m *UserMutation) ResetUserVotes() {
+	m.user_votes = nil
+	m.cleareduser_votes = false
+	m.removeduser_votes = nil
+}
+
+// AddCreatedVoteIDs adds the "created_votes" edge to the Vote entity by ids.
+func (m *UserMutation) AddCreatedVoteIDs(ids ...int) {
+	if m.created_votes == nil {
+		m.created_votes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.created_votes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCreatedVotes clears the "created_votes" edge to the Vote entity.
+func (m *UserMutation) ClearCreatedVotes() {
+	m.clearedcreated_votes = true
+}
+
+// CreatedVotesCleared reports if the "created_votes" edge to the Vote entity was cleared.
+func (m *UserMutation) CreatedVotesCleared() bool {
+	return m.clearedcreated_votes
+}
+
+// RemoveCreatedVoteIDs removes the "created_votes" edge to the Vote entity by IDs.
+func (m *UserMutation) RemoveCreatedVoteIDs(ids ...int) {
+	if m.removedcreated_votes == nil {
+		m.removedcreated_votes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.created_votes, ids[i])
+		m.removedcreated_votes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCreatedVotes returns the removed IDs of the "created_votes" edge to the Vote entity.
+func (m *UserMutation) RemovedCreatedVotesIDs() (ids []int) {
+	for id := range m.removedcreated_votes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CreatedVotesIDs returns the "created_votes" edge IDs in the mutation.
+func (m *UserMutation) CreatedVotesIDs() (ids []int) {
+	for id := range m.created_votes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCreatedVotes resets all changes to the "created_votes" edge.
+func (m *UserMutation) ResetCreatedVotes() {
+	m.created_votes = nil
+	m.clearedcreated_votes = false
+	m.removedcreated_votes = nil
+}
+
+// AddCreatedVoteTemplateIDs adds the "created_vote_templates" edge to the VoteTemplate entity by ids.
+func (m *UserMutation) AddCreatedVoteTemplateIDs(ids ...int) {
+	if m.created_vote_templates == nil {
+		m.created_vote_templates = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.created_vote_templates[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCreatedVoteTemplates clears the "created_vote_templates" edge to the VoteTemplate entity.
+func (m *UserMutation) ClearCreatedVoteTemplates() {
+	m.clearedcreated_vote_templates = true
+}
+
+// CreatedVoteTemplatesCleared reports if the "created_vote_templates" edge to the VoteTemplate entity was cleared.
+func (m *UserMutation) CreatedVoteTemplatesCleared() bool {
+	return m.clearedcreated_vote_templates
+}
+
+// RemoveCreatedVoteTemplateIDs removes the "created_vote_templates" edge to the VoteTemplate entity by IDs.
+func (m *UserMutation) RemoveCreatedVoteTemplateIDs(ids ...int) {
+	if m.removedcreated_vote_templates == nil {
+		m.removedcreated_vote_templates = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.created_vote_templates, ids[i])
+		m.removedcreated_vote_templates[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCreatedVoteTemplates returns the removed IDs of the "created_vote_templates" edge to the VoteTemplate entity.
+func (m *UserMutation) RemovedCreatedVoteTemplatesIDs() (ids []int) {
+	for id := range m.removedcreated_vote_templates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CreatedVoteTemplatesIDs returns the "created_vote_templates" edge IDs in the mutation.
+func (m *UserMutation) CreatedVoteTemplatesIDs() (ids []int) {
+	for id := range m.created_vote_templates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCreatedVoteTemplates resets all changes to the "created_vote_templates" edge.
+func (m *UserMutation) ResetCreatedVoteTemplates() {
+	m.created_vote_templates = nil
+	m.clearedcreated_vote_templates = false
+	m.removedcreated_vote_templates = nil
+}
+
+// AddAppIDs adds the "apps" edge to the App entity by ids.
+func (m *UserMutation) AddAppIDs(ids ...string) {
+	if m.apps == nil {
+		m.apps = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.apps[ids[i]] = struct{}{}
+	}
+}
+
+// ClearApps clears the "apps" edge to the App entity.
+func (m *UserMutation) ClearApps() {
+	m.clearedapps = true
+}
+
+// AppsCleared reports if the "apps" edge to the App entity was cleared.
+func (m *UserMutation) AppsCleared() bool {
+	return m.clearedapps
+}
+
+// RemoveAppIDs removes the "apps" edge to the App entity by IDs.
+func (m *UserMutation) RemoveAppIDs(ids ...string) {
+	if m.removedapps == nil {
+		m.removedapps = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.apps, ids[i])
+		m.removedapps[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedApps returns the removed IDs of the "apps" edge to the App entity.
+func (m *UserMutation) RemovedAppsIDs() (ids []string) {
+	for id := range m.removedapps {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AppsIDs returns the "apps" edge IDs in the mutation.
+func (m *UserMutation) AppsIDs() (ids []string) {
+	for id := range m.apps {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetApps resets all changes to the "apps" edge.
+func (m *UserMutation) ResetApps() {
+	m.apps = nil
+	m.clearedapps = false
+	m.removedapps = nil
+}
+
+// AddConsentIDs adds the "consents" edge to the Consent entity by ids.
+func (m *UserMutation) AddConsentIDs(ids ...int) {
+	if m.consents == nil {
+		m.consents = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.consents[ids[i]] = struct{}{}
+	}
+}
+
+// ClearConsents clears the "consents" edge to the Consent entity.
+func (m *UserMutation) ClearConsents() {
+	m.clearedconsents = true
+}
+
+// ConsentsCleared reports if the "consents" edge to the Consent entity was cleared.
+func (m *UserMutation) ConsentsCleared() bool {
+	return m.clearedconsents
+}
+
+// RemoveConsentIDs removes the "consents" edge to the Consent entity by IDs.
+func (m *UserMutation) RemoveConsentIDs(ids ...int) {
+	if m.removedconsents == nil {
+		m.removedconsents = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.consents, ids[i])
+		m.removedconsents[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedConsents returns the removed IDs of the "consents" edge to the Consent entity.
+func (m *UserMutation) RemovedConsentsIDs() (ids []int) {
+	for id := range m.removedconsents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ConsentsIDs returns the "consents" edge IDs in the mutation.
+func (m *UserMutation) ConsentsIDs() (ids []int) {
+	for id := range m.consents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetConsents resets all changes to the "consents" edge.
+func (m *UserMutation) ResetConsents() {
+	m.consents = nil
+	m.clearedconsents = false
+	m.removedconsents = nil
+}
+
+// AddTeamMembershipIDs adds the "team_memberships" edge to the TeamMember entity by ids.
+func (m *UserMutation) AddTeamMembershipIDs(ids ...int) {
+	if m.team_memberships == nil {
+		m.team_memberships = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.team_memberships[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTeamMemberships clears the "team_memberships" edge to the TeamMember entity.
+func (m *UserMutation) ClearTeamMemberships() {
+	m.clearedteam_memberships = true
+}
+
+// TeamMembershipsCleared reports if the "team_memberships" edge to the TeamMember entity was cleared.
+func (m *UserMutation) TeamMembershipsCleared() bool {
+	return m.clearedteam_memberships
+}
+
+// RemoveTeamMembershipIDs removes the "team_memberships" edge to the TeamMember entity by IDs.
+func (m *UserMutation) RemoveTeamMembershipIDs(ids ...int) {
+	if m.removedteam_memberships == nil {
+		m.removedteam_memberships = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.team_memberships, ids[i])
+		m.removedteam_memberships[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTeamMemberships returns the removed IDs of the "team_memberships" edge to the TeamMember entity.
+func (m *UserMutation) RemovedTeamMembershipsIDs() (ids []int) {
+	for id := range m.removedteam_memberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TeamMembershipsIDs returns the "team_memberships" edge IDs in the mutation.
+func (m *UserMutation) TeamMembershipsIDs() (ids []int) {
+	for id := range m.team_memberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTeamMemberships resets all changes to the "team_memberships" edge.
+func (m *UserMutation) ResetTeamMemberships() {
+	m.team_memberships = nil
+	m.clearedteam_memberships = false
+	m.removedteam_memberships = nil
+}
+
+// AddReceivedInvitationIDs adds the "received_invitations" edge to the Invitation entity by ids.
+func (m *UserMutation) AddReceivedInvitationIDs(ids ...int) {
+	if m.received_invitations == nil {
+		m.received_invitations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.received_invitations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReceivedInvitations clears the "received_invitations" edge to the Invitation entity.
+func (m *UserMutation) ClearReceivedInvitations() {
+	m.clearedreceived_invitations = true
+}
+
+// ReceivedInvitationsCleared reports if the "received_invitations" edge to the Invitation entity was cleared.
+func (m *UserMutation) ReceivedInvitationsCleared() bool {
+	return m.clearedreceived_invitations
+}
+
+// RemoveReceivedInvitationIDs removes the "received_invitations" edge to the Invitation entity by IDs.
+func (m *UserMutation) RemoveReceivedInvitationIDs(ids ...int) {
+	if m.removedreceived_invitations == nil {
+		m.removedreceived_invitations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.received_invitations, ids[i])
+		m.removedreceived_invitations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReceivedInvitations returns the removed IDs of the "received_invitations" edge to the Invitation entity.
+func (m *UserMutation) RemovedReceivedInvitationsIDs() (ids []int) {
+	for id := range m.removedreceived_invitations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReceivedInvitationsIDs returns the "received_invitations" edge IDs in the mutation.
+func (m *UserMutation) ReceivedInvitationsIDs() (ids []int) {
+	for id := range m.received_invitations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReceivedInvitations resets all changes to the "received_invitations" edge.
+func (m *UserMutation) ResetReceivedInvitations() {
+	m.received_invitations = nil
+	m.clearedreceived_invitations = false
+	m.removedreceived_invitations = nil
+}
+
+// AddCreatedTeamIDs adds the "created_teams" edge to the Team entity by ids.
+func (m *UserMutation) AddCreatedTeamIDs(ids ...int) {
+	if m.created_teams == nil {
+		m.created_teams = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.created_teams[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCreatedTeams clears the "created_teams" edge to the Team entity.
+func (m *UserMutation) ClearCreatedTeams() {
+	m.clearedcreated_teams = true
+}
+
+// CreatedTeamsCleared reports if the "created_teams" edge to the Team entity was cleared.
+func (m *UserMutation) CreatedTeamsCleared() bool {
+	return m.clearedcreated_teams
+}
+
+// RemoveCreatedTeamIDs removes the "created_teams" edge to the Team entity by IDs.
+func (m *UserMutation) RemoveCreatedTeamIDs(ids ...int) {
+	if m.removedcreated_teams == nil {
+		m.removedcreated_teams = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.created_teams, ids[i])
+		m.removedcreated_teams[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCreatedTeams returns the removed IDs of the "created_teams" edge to the Team entity.
+func (m *UserMutation) RemovedCreatedTeamsIDs() (ids []int) {
+	for id := range m.removedcreated_teams {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CreatedTeamsIDs returns the "created_teams" edge IDs in the mutation.
+func (m *UserMutation) CreatedTeamsIDs() (ids []int) {
+	for id := range m.created_teams {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCreatedTeams resets all changes to the "created_teams" edge.
+func (m *UserMutation) ResetCreatedTeams() {
+	m.created_teams = nil
+	m.clearedcreated_teams = false
+	m.removedcreated_teams = nil
+}
+
+// AddCreatedTournamentIDs adds the "created_tournaments" edge to the Tournament entity by ids.
+func (m *UserMutation) AddCreatedTournamentIDs(ids ...int) {
+	if m.created_tournaments == nil {
+		m.created_tournaments = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.created_tournaments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCreatedTournaments clears the "created_tournaments" edge to the Tournament entity.
+func (m *UserMutation) ClearCreatedTournaments() {
+	m.clearedcreated_tournaments = true
+}
+
+// CreatedTournamentsCleared reports if the "created_tournaments" edge to the Tournament entity was cleared.
+func (m *UserMutation) CreatedTournamentsCleared() bool {
+	return m.clearedcreated_tournaments
+}
+
+// RemoveCreatedTournamentIDs removes the "created_tournaments" edge to the Tournament entity by IDs.
+func (m *UserMutation) RemoveCreatedTournamentIDs(ids ...int) {
+	if m.removedcreated_tournaments == nil {
+		m.removedcreated_tournaments = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.created_tournaments, ids[i])
+		m.removedcreated_tournaments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCreatedTournaments returns the removed IDs of the "created_tournaments" edge to the Tournament entity.
+func (m *UserMutation) RemovedCreatedTournamentsIDs() (ids []int) {
+	for id := range m.removedcreated_tournaments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CreatedTournamentsIDs returns the "created_tournaments" edge IDs in the mutation.
+func (m *UserMutation) CreatedTournamentsIDs() (ids []int) {
+	for id := range m.created_tournaments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCreatedTournaments resets all changes to the "created_tournaments" edge.
+func (m *UserMutation) ResetCreatedTournaments() {
+	m.created_tournaments = nil
+	m.clearedcreated_tournaments = false
+	m.removedcreated_tournaments = nil
+}
+
+// AddTournamentAdminIDs adds the "tournament_admins" edge to the TournamentAdmin entity by ids.
+func (m *UserMutation) AddTournamentAdminIDs(ids ...int) {
+	if m.tournament_admins == nil {
+		m.tournament_admins = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.tournament_admins[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTournamentAdmins clears the "tournament_admins" edge to the TournamentAdmin entity.
+func (m *UserMutation) ClearTournamentAdmins() {
+	m.clearedtournament_admins = true
+}
+
+// TournamentAdminsCleared reports if the "tournament_admins" edge to the TournamentAdmin entity was cleared.
+func (m *UserMutation) TournamentAdminsCleared() bool {
+	return m.clearedtournament_admins
+}
+
+// RemoveTournamentAdminIDs removes the "tournament_admins" edge to the TournamentAdmin entity by IDs.
+func (m *UserMutation) RemoveTournamentAdminIDs(ids ...int) {
+	if m.removedtournament_admins == nil {
+		m.removedtournament_admins = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.tournament_admins, ids[i])
+		m.removedtournament_admins[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTournamentAdmins returns the removed IDs of the "tournament_admins" edge to the TournamentAdmin entity.
+func (m *UserMutation) RemovedTournamentAdminsIDs() (ids []int) {
+	for id := range m.removedtournament_admins {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TournamentAdminsIDs returns the "tournament_admins" edge IDs in the mutation.
+func (m *UserMutation) TournamentAdminsIDs() (ids []int) {
+	for id := range m.tournament_admins {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTournamentAdmins resets all changes to the "tournament_admins" edge.
+func (m *UserMutation) ResetTournamentAdmins() {
+	m.tournament_admins = nil
+	m.clearedtournament_admins = false
+	m.removedtournament_admins = nil
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by ids.
+func (m *UserMutation) AddNotificationIDs(ids ...int) {
+	if m.notifications == nil {
+		m.notifications = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.notifications[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNotifications clears the "notifications" edge to the Notification entity.
+func (m *UserMutation) ClearNotifications() {
+	m.clearednotifications = true
+}
+
+// NotificationsCleared reports if the "notifications" edge to the Notification entity was cleared.
+func (m *UserMutation) NotificationsCleared() bool {
+	return m.clearednotifications
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to the Notification entity by IDs.
+func (m *UserMutation) RemoveNotificationIDs(ids ...int) {
+	if m.removednotifications == nil {
+		m.removednotifications = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.notifications, ids[i])
+		m.removednotifications[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNotifications returns the removed IDs of the "notifications" edge to the Notification entity.
+func (m *UserMutation) RemovedNotificationsIDs() (ids []int) {
+	for id := range m.removednotifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NotificationsIDs returns the "notifications" edge IDs in the mutation.
+func (m *UserMutation) NotificationsIDs() (ids []int) {
+	for id := range m.notifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNotifications resets all changes to the "notifications" edge.
+func (m *UserMutation) ResetNotifications() {
+	m.notifications = nil
+	m.clearednotifications = false
+	m.removednotifications = nil
+}
+
+// AddAuditLogIDs adds the "audit_logs" edge to the AuditLog entity by ids.
+func (m *UserMutation) AddAuditLogIDs(ids ...int) {
+	if m.audit_logs == nil {
+		m.audit_logs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.audit_logs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAuditLogs clears the "audit_logs" edge to the AuditLog entity.
+func (m *UserMutation) ClearAuditLogs() {
+	m.clearedaudit_logs = true
+}
+
+// AuditLogsCleared reports if the "audit_logs" edge to the AuditLog entity was cleared.
+func (m *UserMutation) AuditLogsCleared() bool {
+	return m.clearedaudit_logs
+}
+
+// RemoveAuditLogIDs removes the "audit_logs" edge to the AuditLog entity by IDs.
+func (m *UserMutation) RemoveAuditLogIDs(ids ...int) {
+	if m.removedaudit_logs == nil {
+		m.removedaudit_logs = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.audit_logs, ids[i])
+		m.removedaudit_logs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAuditLogs returns the removed IDs of the "audit_logs" edge to the AuditLog entity.
+func (m *UserMutation) RemovedAuditLogsIDs() (ids []int) {
+	for id := range m.removedaudit_logs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AuditLogsIDs returns the "audit_logs" edge IDs in the mutation.
+func (m *UserMutation) AuditLogsIDs() (ids []int) {
+	for id := range m.audit_logs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAuditLogs resets all changes to the "audit_logs" edge.
+func (m *UserMutation) ResetAuditLogs() {
+	m.audit_logs = nil
+	m.clearedaudit_logs = false
+	m.removedaudit_logs = nil
+}
+
+// AddImpersonatedAuditLogIDs adds the "impersonated_audit_logs" edge to the AuditLog entity by ids.
+func (m *UserMutation) AddImpersonatedAuditLogIDs(ids ...int) {
+	if m.impersonated_audit_logs == nil {
+		m.impersonated_audit_logs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.impersonated_audit_logs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearImpersonatedAuditLogs clears the "impersonated_audit_logs" edge to the AuditLog entity.
+func (m *UserMutation) ClearImpersonatedAuditLogs() {
+	m.clearedimpersonated_audit_logs = true
+}
+
+// ImpersonatedAuditLogsCleared reports if the "impersonated_audit_logs" edge to the AuditLog entity was cleared.
+func (m *UserMutation) ImpersonatedAuditLogsCleared() bool {
+	return m.clearedimpersonated_audit_logs
+}
+
+// RemoveImpersonatedAuditLogIDs removes the "impersonated_audit_logs" edge to the AuditLog entity by IDs.
+func (m *UserMutation) RemoveImpersonatedAuditLogIDs(ids ...int) {
+	if m.removedimpersonated_audit_logs == nil {
+		m.removedimpersonated_audit_logs = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.impersonated_audit_logs, ids[i])
+		m.removedimpersonated_audit_logs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedImpersonatedAuditLogs returns the removed IDs of the "impersonated_audit_logs" edge to the AuditLog entity.
+func (m *UserMutation) RemovedImpersonatedAuditLogsIDs() (ids []int) {
+	for id := range m.removedimpersonated_audit_logs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ImpersonatedAuditLogsIDs returns the "impersonated_audit_logs" edge IDs in the mutation.
+func (m *UserMutation) ImpersonatedAuditLogsIDs() (ids []int) {
+	for id := range m.impersonated_audit_logs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetImpersonatedAuditLogs resets all changes to the "impersonated_audit_logs" edge.
+func (m *UserMutation) ResetImpersonatedAuditLogs() {
+	m.impersonated_audit_logs = nil
+	m.clearedimpersonated_audit_logs = false
+	m.removedimpersonated_audit_logs = nil
+}
+
+// AddRoleGrantIDs adds the "role_grants" edge to the RoleGrant entity by ids.
+func (m *UserMutation) AddRoleGrantIDs(ids ...int) {
+	if m.role_grants == nil {
+		m.role_grants = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.role_grants[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRoleGrants clears the "role_grants" edge to the RoleGrant entity.
+func (m *UserMutation) ClearRoleGrants() {
+	m.clearedrole_grants = true
+}
+
+// RoleGrantsCleared reports if the "role_grants" edge to the RoleGrant entity was cleared.
+func (m *UserMutation) RoleGrantsCleared() bool {
+	return m.clearedrole_grants
+}
+
+// RemoveRoleGrantIDs removes the "role_grants" edge to the RoleGrant entity by IDs.
+func (m *UserMutation) RemoveRoleGrantIDs(ids ...int) {
+	if m.removedrole_grants == nil {
+		m.removedrole_grants = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.role_grants, ids[i])
+		m.removedrole_grants[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRoleGrants returns the removed IDs of the "role_grants" edge to the RoleGrant entity.
+func (m *UserMutation) RemovedRoleGrantsIDs() (ids []int) {
+	for id := range m.removedrole_grants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RoleGrantsIDs returns the "role_grants" edge IDs in the mutation.
+func (m *UserMutation) RoleGrantsIDs() (ids []int) {
+	for id := range m.role_grants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRoleGrants resets all changes to the "role_grants" edge.
+func (m *UserMutation) ResetRoleGrants() {
+	m.role_grants = nil
+	m.clearedrole_grants = false
+	m.removedrole_grants = nil
+}
+
+// AddGivenRoleGrantIDs adds the "given_role_grants" edge to the RoleGrant entity by ids.
+func (m *UserMutation) AddGivenRoleGrantIDs(ids ...int) {
+	if m.given_role_grants == nil {
+		m.given_role_grants = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.given_role_grants[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGivenRoleGrants clears the "given_role_grants" edge to the RoleGrant entity.
+func (m *UserMutation) ClearGivenRoleGrants() {
+	m.clearedgiven_role_grants = true
+}
+
+// GivenRoleGrantsCleared reports if the "given_role_grants" edge to the RoleGrant entity was cleared.
+func (m *UserMutation) GivenRoleGrantsCleared() bool {
+	return m.clearedgiven_role_grants
+}
+
+// RemoveGivenRoleGrantIDs removes the "given_role_grants" edge to the RoleGrant entity by IDs.
+func (m *UserMutation) RemoveGivenRoleGrantIDs(ids ...int) {
+	if m.removedgiven_role_grants == nil {
+		m.removedgiven_role_grants = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.given_role_grants, ids[i])
+		m.removedgiven_role_grants[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGivenRoleGrants returns the removed IDs of the "given_role_grants" edge to the RoleGrant entity.
+func (m *UserMutation) RemovedGivenRoleGrantsIDs() (ids []int) {
+	for id := range m.removedgiven_role_grants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GivenRoleGrantsIDs returns the "given_role_grants" edge IDs in the mutation.
+func (m *UserMutation) GivenRoleGrantsIDs() (ids []int) {
+	for id := range m.given_role_grants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGivenRoleGrants resets all changes to the "given_role_grants" edge.
+func (m *UserMutation) ResetGivenRoleGrants() {
+	m.given_role_grants = nil
+	m.clearedgiven_role_grants = false
+	m.removedgiven_role_grants = nil
+}
+
+// AddSanctionIDs adds the "sanctions" edge to the Sanction entity by ids.
+func (m *UserMutation) AddSanctionIDs(ids ...int) {
+	if m.sanctions == nil {
+		m.sanctions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.sanctions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSanctions clears the "sanctions" edge to the Sanction entity.
+func (m *UserMutation) ClearSanctions() {
+	m.clearedsanctions = true
+}
+
+// SanctionsCleared reports if the "sanctions" edge to the Sanction entity was cleared.
+func (m *UserMutation) SanctionsCleared() bool {
+	return m.clearedsanctions
+}
+
+// RemoveSanctionIDs removes the "sanctions" edge to the Sanction entity by IDs.
+func (m *UserMutation) RemoveSanctionIDs(ids ...int) {
+	if m.removedsanctions == nil {
+		m.removedsanctions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.sanctions, ids[i])
+		m.removedsanctions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSanctions returns the removed IDs of the "sanctions" edge to the Sanction entity.
+func (m *UserMutation) RemovedSanctionsIDs() (ids []int) {
+	for id := range m.removedsanctions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SanctionsIDs returns the "sanctions" edge IDs in the mutation.
+func (m *UserMutation) SanctionsIDs() (ids []int) {
+	for id := range m.sanctions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSanctions resets all changes to the "sanctions" edge.
+func (m *UserMutation) ResetSanctions() {
+	m.sanctions = nil
+	m.clearedsanctions = false
+	m.removedsanctions = nil
+}
+
+// AddIssuedSanctionIDs adds the "issued_sanctions" edge to the Sanction entity by ids.
+func (m *UserMutation) AddIssuedSanctionIDs(ids ...int) {
+	if m.issued_sanctions == nil {
+		m.issued_sanctions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.issued_sanctions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearIssuedSanctions clears the "issued_sanctions" edge to the Sanction entity.
+func (m *UserMutation) ClearIssuedSanctions() {
+	m.clearedissued_sanctions = true
+}
+
+// IssuedSanctionsCleared reports if the "issued_sanctions" edge to the Sanction entity was cleared.
+func (m *UserMutation) IssuedSanctionsCleared() bool {
+	return m.clearedissued_sanctions
+}
+
+// RemoveIssuedSanctionIDs removes the "issued_sanctions" edge to the Sanction entity by IDs.
+func (m *UserMutation) RemoveIssuedSanctionIDs(ids ...int) {
+	if m.removedissued_sanctions == nil {
+		m.removedissued_sanctions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.issued_sanctions, ids[i])
+		m.removedissued_sanctions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedIssuedSanctions returns the removed IDs of the "issued_sanctions" edge to the Sanction entity.
+func (m *UserMutation) RemovedIssuedSanctionsIDs() (ids []int) {
+	for id := range m.removedissued_sanctions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// IssuedSanctionsIDs returns the "issued_sanctions" edge IDs in the mutation.
+func (m *UserMutation) IssuedSanctionsIDs() (ids []int) {
+	for id := range m.issued_sanctions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetIssuedSanctions resets all changes to the "issued_sanctions" edge.
+func (m *UserMutation) ResetIssuedSanctions() {
+	m.issued_sanctions = nil
+	m.clearedissued_sanctions = false
+	m.removedissued_sanctions = nil
+}
+
+// AddLiftedSanctionIDs adds the "lifted_sanctions" edge to the Sanction entity by ids.
+func (m *UserMutation) AddLiftedSanctionIDs(ids ...int) {
+	if m.lifted_sanctions == nil {
+		m.lifted_sanctions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.lifted_sanctions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLiftedSanctions clears the "lifted_sanctions" edge to the Sanction entity.
+func (m *UserMutation) ClearLiftedSanctions() {
+	m.clearedlifted_sanctions = true
+}
+
+// LiftedSanctionsCleared reports if the "lifted_sanctions" edge to the Sanction entity was cleared.
+func (m *UserMutation) LiftedSanctionsCleared() bool {
+	return m.clearedlifted_sanctions
+}
+
+// RemoveLiftedSanctionIDs removes the "lifted_sanctions" edge to the Sanction entity by IDs.
+func (m *UserMutation) RemoveLiftedSanctionIDs(ids ...int) {
+	if m.removedlifted_sanctions == nil {
+		m.removedlifted_sanctions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.lifted_sanctions, ids[i])
+		m.removedlifted_sanctions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLiftedSanctions returns the removed IDs of the "lifted_sanctions" edge to the Sanction entity.
+func (m *UserMutation) RemovedLiftedSanctionsIDs() (ids []int) {
+	for id := range m.removedlifted_sanctions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LiftedSanctionsIDs returns the "lifted_sanctions" edge IDs in the mutation.
+func (m *UserMutation) LiftedSanctionsIDs() (ids []int) {
+	for id := range m.lifted_sanctions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLiftedSanctions resets all changes to the "lifted_sanctions" edge.
+func (m *UserMutation) ResetLiftedSanctions() {
+	m.lifted_sanctions = nil
+	m.clearedlifted_sanctions = false
+	m.removedlifted_sanctions = nil
+}
+
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.username != nil {
+		fields = append(fields, user.FieldUsername)
+	}
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
+	}
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, user.FieldUpdatedAt)
+	}
+	if m.anonymized_at != nil {
+		fields = append(fields, user.FieldAnonymizedAt)
+	}
+	if m.intra_id != nil {
+		fields = append(fields, user.FieldIntraID)
+	}
+	if m.picture != nil {
+		fields = append(fields, user.FieldPicture)
+	}
+	if m.kind != nil {
+		fields = append(fields, user.FieldKind)
+	}
+	if m.roles != nil {
+		fields = append(fields, user.FieldRoles)
+	}
+	if m.elo != nil {
+		fields = append(fields, user.FieldElo)
+	}
+	if m.campus != nil {
+		fields = append(fields, user.FieldCampus)
+	}
+	if m.last_login_at != nil {
+		fields = append(fields, user.FieldLastLoginAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldUsername:
+		return m.Username()
+	case user.FieldEmail:
+		return m.Email()
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
+	case user.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case user.FieldAnonymizedAt:
+		return m.AnonymizedAt()
+	case user.FieldIntraID:
+		return m.IntraID()
+	case user.FieldPicture:
+		return m.Picture()
+	case user.FieldKind:
+		return m.Kind()
+	case user.FieldRoles:
+		return m.Roles()
+	case user.FieldElo:
+		return m.Elo()
+	case user.FieldCampus:
+		return m.Campus()
+	case user.FieldLastLoginAt:
+		return m.LastLoginAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldUsername:
+		return m.OldUsername(ctx)
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case user.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case user.FieldAnonymizedAt:
+		return m.OldAnonymizedAt(ctx)
+	case user.FieldIntraID:
+		return m.OldIntraID(ctx)
+	case user.FieldPicture:
+		return m.OldPicture(ctx)
+	case user.FieldKind:
+		return m.OldKind(ctx)
+	case user.FieldRoles:
+		return m.OldRoles(ctx)
+	case user.FieldElo:
+		return m.OldElo(ctx)
+	case user.FieldCampus:
+		return m.OldCampus(ctx)
+	case user.FieldLastLoginAt:
+		return m.OldLastLoginAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case user.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case user.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case user.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case user.FieldAnonymizedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnonymizedAt(v)
+		return nil
+	case user.FieldIntraID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIntraID(v)
+		return nil
+	case user.FieldPicture:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPicture(v)
+		return nil
+	case user.FieldKind:
+		v, ok := value.(user.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case user.FieldRoles:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoles(v)
+		return nil
+	case user.FieldElo:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetElo(v)
+		return nil
+	case user.FieldCampus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCampus(v)
+		return nil
+	case user.FieldLastLoginAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastLoginAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserMutation) AddedFields() []string {
+	var fields []string
+	if m.addintra_id != nil {
+		fields = append(fields, user.FieldIntraID)
+	}
+	if m.addelo != nil {
+		fields = append(fields, user.FieldElo)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldIntraID:
+		return m.AddedIntraID()
+	case user.FieldElo:
+		return m.AddedElo()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldIntraID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIntraID(v)
+		return nil
+	case user.FieldElo:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddElo(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(user.FieldAnonymizedAt) {
+		fields = append(fields, user.FieldAnonymizedAt)
+	}
+	if m.FieldCleared(user.FieldIntraID) {
+		fields = append(fields, user.FieldIntraID)
+	}
+	if m.FieldCleared(user.FieldPicture) {
+		fields = append(fields, user.FieldPicture)
+	}
+	if m.FieldCleared(user.FieldCampus) {
+		fields = append(fields, user.FieldCampus)
+	}
+	if m.FieldCleared(user.FieldLastLoginAt) {
+		fields = append(fields, user.FieldLastLoginAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldAnonymizedAt:
+		m.ClearAnonymizedAt()
+		return nil
+	case user.FieldIntraID:
+		m.ClearIntraID()
+		return nil
+	case user.FieldPicture:
+		m.ClearPicture()
+		return nil
+	case user.FieldCampus:
+		m.ClearCampus()
+		return nil
+	case user.FieldLastLoginAt:
+		m.ClearLastLoginAt()
+		return nil
+	}
+	return fmt.Errorf("unknown User nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserMutation) ResetField(name string) error {
+	switch name {
+	case user.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case user.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case user.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case user.FieldAnonymizedAt:
+		m.ResetAnonymizedAt()
+		return nil
+	case user.FieldIntraID:
+		m.ResetIntraID()
+		return nil
+	case user.FieldPicture:
+		m.ResetPicture()
+		return nil
+	case user.FieldKind:
+		m.ResetKind()
+		return nil
+	case user.FieldRoles:
+		m.ResetRoles()
+		return nil
+	case user.FieldElo:
+		m.ResetElo()
+		return nil
+	case user.FieldCampus:
+		m.ResetCampus()
+		return nil
+	case user.FieldLastLoginAt:
+		m.ResetLastLoginAt()
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 18)
+	if m.user_votes != nil {
+		edges = append(edges, user.EdgeUserVotes)
+	}
+	if m.created_votes != nil {
+		edges = append(edges, user.EdgeCreatedVotes)
+	}
+	if m.created_vote_templates != nil {
+		edges = append(edges, user.EdgeCreatedVoteTemplates)
+	}
+	if m.apps != nil {
+		edges = append(edges, user.EdgeApps)
+	}
+	if m.consents != nil {
+		edges = append(edges, user.EdgeConsents)
+	}
+	if m.team_memberships != nil {
+		edges = append(edges, user.EdgeTeamMemberships)
+	}
+	if m.received_invitations != nil {
+		edges = append(edges, user.EdgeReceivedInvitations)
+	}
+	if m.created_teams != nil {
+		edges = append(edges, user.EdgeCreatedTeams)
+	}
+	if m.created_tournaments != nil {
+		edges = append(edges, user.EdgeCreatedTournaments)
+	}
+	if m.tournament_admins != nil {
+		edges = append(edges, user.EdgeTournamentAdmins)
+	}
+	if m.notifications != nil {
+		edges = append(edges, user.EdgeNotifications)
+	}
+	if m.audit_logs != nil {
+		edges = append(edges, user.EdgeAuditLogs)
+	}
+	if m.impersonated_audit_logs != nil {
+		edges = append(edges, user.EdgeImpersonatedAuditLogs)
+	}
+	if m.role_grants != nil {
+		edges = append(edges, user.EdgeRoleGrants)
+	}
+	if m.given_role_grants != nil {
+		edges = append(edges, user.EdgeGivenRoleGrants)
+	}
+	if m.sanctions != nil {
+		edges = append(edges, user.EdgeSanctions)
+	}
+	if m.issued_sanctions != nil {
+		edges = append(edges, user.EdgeIssuedSanctions)
+	}
+	if m.lifted_sanctions != nil {
+		edges = append(edges, user.EdgeLiftedSanctions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeUserVotes:
+		ids := make([]ent.Value, 0, len(m.user_votes))
+		for id := range m.user_votes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCreatedVotes:
+		ids := make([]ent.Value, 0, len(m.created_votes))
+		for id := range m.created_votes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCreatedVoteTemplates:
+		ids := make([]ent.Value, 0, len(m.created_vote_templates))
+		for id := range m.created_vote_templates {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeApps:
+		ids := make([]ent.Value, 0, len(m.apps))
+		for id := range m.apps {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeConsents:
+		ids := make([]ent.Value, 0, len(m.consents))
+		for id := range m.consents {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTeamMemberships:
+		ids := make([]ent.Value, 0, len(m.team_memberships))
+		for id := range m.team_memberships {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeReceivedInvitations:
+		ids := make([]ent.Value, 0, len(m.received_invitations))
+		for id := range m.received_invitations {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCreatedTeams:
+		ids := make([]ent.Value, 0, len(m.created_teams))
+		for id := range m.created_teams {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCreatedTournaments:
+		ids := make([]ent.Value, 0, len(m.created_tournaments))
+		for id := range m.created_tournaments {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTournamentAdmins:
+		ids := make([]ent.Value, 0, len(m.tournament_admins))
+		for id := range m.tournament_admins {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeNotifications:
+		ids := make([]ent.Value, 0, len(m.notifications))
+		for id := range m.notifications {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAuditLogs:
+		ids := make([]ent.Value, 0, len(m.audit_logs))
+		for id := range m.audit_logs {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeImpersonatedAuditLogs:
+		ids := make([]ent.Value, 0, len(m.impersonated_audit_logs))
+		for id := range m.impersonated_audit_logs {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRoleGrants:
+		ids := make([]ent.Value, 0, len(m.role_grants))
+		for id := range m.role_grants {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeGivenRoleGrants:
+		ids := make([]ent.Value, 0, len(m.given_role_grants))
+		for id := range m.given_role_grants {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSanctions:
+		ids := make([]ent.Value, 0, len(m.sanctions))
+		for id := range m.sanctions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeIssuedSanctions:
+		ids := make([]ent.Value, 0, len(m.issued_sanctions))
+		for id := range m.issued_sanctions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeLiftedSanctions:
+		ids := make([]ent.Value, 0, len(m.lifted_sanctions))
+		for id := range m.lifted_sanctions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 18)
+	if m.removeduser_votes != nil {
+		edges = append(edges, user.EdgeUserVotes)
+	}
+	if m.removedcreated_votes != nil {
+		edges = append(edges, user.EdgeCreatedVotes)
+	}
+	if m.removedcreated_vote_templates != nil {
+		edges = append(edges, user.EdgeCreatedVoteTemplates)
+	}
+	if m.removedapps != nil {
+		edges = append(edges, user.EdgeApps)
+	}
+	if m.removedconsents != nil {
+		edges = append(edges, user.EdgeConsents)
+	}
+	if m.removedteam_memberships != nil {
+		edges = append(edges, user.EdgeTeamMemberships)
+	}
+	if m.removedreceived_invitations != nil {
+		edges = append(edges, user.EdgeReceivedInvitations)
+	}
+	if m.removedcreated_teams != nil {
+		edges = append(edges, user.EdgeCreatedTeams)
+	}
+	if m.removedcreated_tournaments != nil {
+		edges = append(edges, user.EdgeCreatedTournaments)
+	}
+	if m.removedtournament_admins != nil {
+		edges = append(edges, user.EdgeTournamentAdmins)
+	}
+	if m.removednotifications != nil {
+		edges = append(edges, user.EdgeNotifications)
+	}
+	if m.removedaudit_logs != nil {
+		edges = append(edges, user.EdgeAuditLogs)
+	}
+	if m.removedimpersonated_audit_logs != nil {
+		edges = append(edges, user.EdgeImpersonatedAuditLogs)
+	}
+	if m.removedrole_grants != nil {
+		edges = append(edges, user.EdgeRoleGrants)
+	}
+	if m.removedgiven_role_grants != nil {
+		edges = append(edges, user.EdgeGivenRoleGrants)
+	}
+	if m.removedsanctions != nil {
+		edges = append(edges, user.EdgeSanctions)
+	}
+	if m.removedissued_sanctions != nil {
+		edges = append(edges, user.EdgeIssuedSanctions)
+	}
+	if m.removedlifted_sanctions != nil {
+		edges = append(edges, user.EdgeLiftedSanctions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeUserVotes:
+		ids := make([]ent.Value, 0, len(m.removeduser_votes))
+		for id := range m.removeduser_votes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCreatedVotes:
+		ids := make([]ent.Value, 0, len(m.removedcreated_votes))
+		for id := range m.removedcreated_votes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCreatedVoteTemplates:
+		ids := make([]ent.Value, 0, len(m.removedcreated_vote_templates))
+		for id := range m.removedcreated_vote_templates {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeApps:
+		ids := make([]ent.Value, 0, len(m.removedapps))
+		for id := range m.removedapps {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeConsents:
+		ids := make([]ent.Value, 0, len(m.removedconsents))
+		for id := range m.removedconsents {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTeamMemberships:
+		ids := make([]ent.Value, 0, len(m.removedteam_memberships))
+		for id := range m.removedteam_memberships {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeReceivedInvitations:
+		ids := make([]ent.Value, 0, len(m.removedreceived_invitations))
+		for id := range m.removedreceived_invitations {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCreatedTeams:
+		ids := make([]ent.Value, 0, len(m.removedcreated_teams))
+		for id := range m.removedcreated_teams {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCreatedTournaments:
+		ids := make([]ent.Value, 0, len(m.removedcreated_tournaments))
+		for id := range m.removedcreated_tournaments {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTournamentAdmins:
+		ids := make([]ent.Value, 0, len(m.removedtournament_admins))
+		for id := range m.removedtournament_admins {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeNotifications:
+		ids := make([]ent.Value, 0, len(m.removednotifications))
+		for id := range m.removednotifications {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAuditLogs:
+		ids := make([]ent.Value, 0, len(m.removedaudit_logs))
+		for id := range m.removedaudit_logs {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeImpersonatedAuditLogs:
+		ids := make([]ent.Value, 0, len(m.removedimpersonated_audit_logs))
+		for id := range m.removedimpersonated_audit_logs {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRoleGrants:
+		ids := make([]ent.Value, 0, len(m.removedrole_grants))
+		for id := range m.removedrole_grants {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeGivenRoleGrants:
+		ids := make([]ent.Value, 0, len(m.removedgiven_role_grants))
+		for id := range m.removedgiven_role_grants {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSanctions:
+		ids := make([]ent.Value, 0, len(m.removedsanctions))
+		for id := range m.removedsanctions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeIssuedSanctions:
+		ids := make([]ent.Value, 0, len(m.removedissued_sanctions))
+		for id := range m.removedissued_sanctions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeLiftedSanctions:
+		ids := make([]ent.Value, 0, len(m.removedlifted_sanctions))
+		for id := range m.removedlifted_sanctions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 18)
+	if m.cleareduser_votes {
+		edges = append(edges, user.EdgeUserVotes)
+	}
+	if m.clearedcreated_votes {
+		edges = append(edges, user.EdgeCreatedVotes)
+	}
+	if m.clearedcreated_vote_templates {
+		edges = append(edges, user.EdgeCreatedVoteTemplates)
+	}
+	if m.clearedapps {
+		edges = append(edges, user.EdgeApps)
+	}
+	if m.clearedconsents {
+		edges = append(edges, user.EdgeConsents)
+	}
+	if m.clearedteam_memberships {
+		edges = append(edges, user.EdgeTeamMemberships)
+	}
+	if m.clearedreceived_invitations {
+		edges = append(edges, user.EdgeReceivedInvitations)
+	}
+	if m.clearedcreated_teams {
+		edges = append(edges, user.EdgeCreatedTeams)
+	}
+	if m.clearedcreated_tournaments {
+		edges = append(edges, user.EdgeCreatedTournaments)
+	}
+	if m.clearedtournament_admins {
+		edges = append(edges, user.EdgeTournamentAdmins)
+	}
+	if m.clearednotifications {
+		edges = append(edges, user.EdgeNotifications)
+	}
+	if m.clearedaudit_logs {
+		edges = append(edges, user.EdgeAuditLogs)
+	}
+	if m.clearedimpersonated_audit_logs {
+		edges = append(edges, user.EdgeImpersonatedAuditLogs)
+	}
+	if m.clearedrole_grants {
+		edges = append(edges, user.EdgeRoleGrants)
+	}
+	if m.clearedgiven_role_grants {
+		edges = append(edges, user.EdgeGivenRoleGrants)
+	}
+	if m.clearedsanctions {
+		edges = append(edges, user.EdgeSanctions)
+	}
+	if m.clearedissued_sanctions {
+		edges = append(edges, user.EdgeIssuedSanctions)
+	}
+	if m.clearedlifted_sanctions {
+		edges = append(edges, user.EdgeLiftedSanctions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgeUserVotes:
+		return m.cleareduser_votes
+	case user.EdgeCreatedVotes:
+		return m.clearedcreated_votes
+	case user.EdgeCreatedVoteTemplates:
+		return m.clearedcreated_vote_templates
+	case user.EdgeApps:
+		return m.clearedapps
+	case user.EdgeConsents:
+		return m.clearedconsents
+	case user.EdgeTeamMemberships:
+		return m.clearedteam_memberships
+	case user.EdgeReceivedInvitations:
+		return m.clearedreceived_invitations
+	case user.EdgeCreatedTeams:
+		return m.clearedcreated_teams
+	case user.EdgeCreatedTournaments:
+		return m.clearedcreated_tournaments
+	case user.EdgeTournamentAdmins:
+		return m.clearedtournament_admins
+	case user.EdgeNotifications:
+		return m.clearednotifications
+	case user.EdgeAuditLogs:
+		return m.clearedaudit_logs
+	case user.EdgeImpersonatedAuditLogs:
+		return m.clearedimpersonated_audit_logs
+	case user.EdgeRoleGrants:
+		return m.clearedrole_grants
+	case user.EdgeGivenRoleGrants:
+		return m.clearedgiven_role_grants
+	case user.EdgeSanctions:
+		return m.clearedsanctions
+	case user.EdgeIssuedSanctions:
+		return m.clearedissued_sanctions
+	case user.EdgeLiftedSanctions:
+		return m.clearedlifted_sanctions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown User unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgeUserVotes:
+		m.ResetUserVotes()
+		return nil
+	case user.EdgeCreatedVotes:
+		m.ResetCreatedVotes()
+		return nil
+	case user.EdgeCreatedVoteTemplates:
+		m.ResetCreatedVoteTemplates()
+		return nil
+	case user.EdgeApps:
+		m.ResetApps()
+		return nil
+	case user.EdgeConsents:
+		m.ResetConsents()
+		return nil
+	case user.EdgeTeamMemberships:
+		m.ResetTeamMemberships()
+		return nil
+	case user.EdgeReceivedInvitations:
+		m.ResetReceivedInvitations()
+		return nil
+	case user.EdgeCreatedTeams:
+		m.ResetCreatedTeams()
+		return nil
+	case user.EdgeCreatedTournaments:
+		m.ResetCreatedTournaments()
+		return nil
+	case user.EdgeTournamentAdmins:
+		m.ResetTournamentAdmins()
+		return nil
+	case user.EdgeNotifications:
+		m.ResetNotifications()
+		return nil
+	case user.EdgeAuditLogs:
+		m.ResetAuditLogs()
+		return nil
+	case user.EdgeImpersonatedAuditLogs:
+		m.ResetImpersonatedAuditLogs()
+		return nil
+	case user.EdgeRoleGrants:
+		m.ResetRoleGrants()
+		return nil
+	case user.EdgeGivenRoleGrants:
+		m.ResetGivenRoleGrants()
+		return nil
+	case user.EdgeSanctions:
+		m.ResetSanctions()
+		return nil
+	case user.EdgeIssuedSanctions:
+		m.ResetIssuedSanctions()
+		return nil
+	case user.EdgeLiftedSanctions:
+		m.ResetLiftedSanctions()
+		return nil
+	}
+	return fmt.Errorf("unknown User edge %s", name)
+}
+
+// UserVoteMutation represents an operation that mutates the UserVote nodes in the graph.
+type UserVoteMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	user             *int
+	cleareduser      bool
+	component        *int
+	clearedcomponent bool
+	done             bool
+	oldValue         func(context.Context) (*UserVote, error)
+	predicates       []predicate.UserVote
+}
+
+var _ ent.Mutation = (*UserVoteMutation)(nil)
+
+// uservoteOption allows management of the mutation configuration using functional options.
+type uservoteOption func(*UserVoteMutation)
+
+// newUserVoteMutation creates new mutation for the UserVote entity.
+func newUserVoteMutation(c config, op Op, opts ...uservoteOption) *UserVoteMutation {
+	m := &UserVoteMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUserVote,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserVoteID sets the ID field of the mutation.
+func withUserVoteID(id int) uservoteOption {
+	return func(m *UserVoteMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UserVote
+		)
+		m.oldValue = func(ctx context.Context) (*UserVote, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UserVote.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUserVote sets the old UserVote of the mutation.
+func withUserVote(node *UserVote) uservoteOption {
+	return func(m *UserVoteMutation) {
+		m.oldValue = func(context.Context) (*UserVote, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserVoteMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserVoteMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserVoteMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserVoteMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UserVote.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserVoteMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserVoteMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the UserVote entity.
+// If the UserVote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserVoteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserVoteMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *UserVoteMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *UserVoteMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *UserVoteMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *UserVoteMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *UserVoteMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *UserVoteMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetComponentID sets the "component" edge to the Component entity by id.
+func (m *UserVoteMutation) SetComponentID(id int) {
+	m.component = &id
+}
+
+// ClearComponent clears the "component" edge to the Component entity.
+func (m *UserVoteMutation) ClearComponent() {
+	m.clearedcomponent = true
+}
+
+// ComponentCleared reports if the "component" edge to the Component entity was cleared.
+func (m *UserVoteMutation) ComponentCleared() bool {
+	return m.clearedcomponent
+}
+
+// ComponentID returns the "component" edge ID in the mutation.
+func (m *UserVoteMutation) ComponentID() (id int, exists bool) {
+	if m.component != nil {
+		return *m.component, true
+	}
+	return
+}
+
+// ComponentIDs returns the "component" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ComponentID instead. It exists only for internal usage by the builders.
+func (m *UserVoteMutation) ComponentIDs() (ids []int) {
+	if id := m.component; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetComponent resets all changes to the "component" edge.
+func (m *UserVoteMutation) ResetComponent() {
+	m.component = nil
+	m.clearedcomponent = false
+}
+
+// Where appends a list predicates to the UserVoteMutation builder.
+func (m *UserVoteMutation) Where(ps ...predicate.UserVote) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserVoteMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserVoteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserVote, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserVoteMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserVoteMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (UserVote).
+func (m *UserVoteMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserVoteMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.created_at != nil {
+		fields = append(fields, uservote.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserVoteMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case uservote.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserVoteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case uservote.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown UserVote field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserVoteMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case uservote.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown UserVote field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserVoteMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserVoteMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserVoteMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown UserVote numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserVoteMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserVoteMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserVoteMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UserVote nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserVoteMutation) ResetField(name string) error {
+	switch name {
+	case uservote.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown UserVote field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserVoteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, uservote.EdgeUser)
+	}
+	if m.component != nil {
+		edges = append(edges, uservote.EdgeComponent)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserVoteMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case uservote.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case uservote.EdgeComponent:
+		if id := m.component; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserVoteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserVoteMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserVoteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, uservote.EdgeUser)
+	}
+	if m.clearedcomponent {
+		edges = append(edges, uservote.EdgeComponent)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserVoteMutation) EdgeCleared(name string) bool {
+	switch name {
+	case uservote.EdgeUser:
+		return m.cleareduser
+	case uservote.EdgeComponent:
+		return m.clearedcomponent
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserVoteMutation) ClearEdge(name string) error {
+	switch name {
+	case uservote.EdgeUser:
+		m.ClearUser()
+		return nil
+	case uservote.EdgeComponent:
+		m.ClearComponent()
+		return nil
+	}
+	return fmt.Errorf("unknown UserVote unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserVoteMutation) ResetEdge(name string) error {
+	switch name {
+	case uservote.EdgeUser:
+		m.ResetUser()
+		return nil
+	case uservote.EdgeComponent:
+		m.ResetComponent()
+		return nil
+	}
+	return fmt.Errorf("unknown UserVote edge %s", name)
+}
+
+// VoteMutation represents an operation that mutates the Vote nodes in the graph.
+type VoteMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	title                *string
+	description          *string
+	visible              *bool
+	anonymous            *bool
+	start_at             *time.Time
+	end_at               *time.Time
+	results_reveal_at    *time.Time
+	results_published_at *time.Time
+	created_at           *time.Time
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	components           map[int]struct{}
+	removedcomponents    map[int]struct{}
+	clearedcomponents    bool
+	creator              *int
+	clearedcreator       bool
+	done                 bool
+	oldValue             func(context.Context) (*Vote, error)
+	predicates           []predicate.Vote
+}
+
+var _ ent.Mutation = (*VoteMutation)(nil)
+
+// voteOption allows management of the mutation configuration using functional options.
+type voteOption func(*VoteMutation)
+
+// newVoteMutation creates new mutation for the Vote entity.
+func newVoteMutation(c config, op Op, opts ...voteOption) *VoteMutation {
+	m := &VoteMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeVote,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withVoteID sets the ID field of the mutation.
+func withVoteID(id int) voteOption {
+	return func(m *VoteMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Vote
+		)
+		m.oldValue = func(ctx context.Context) (*Vote, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Vote.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withVote sets the old Vote of the mutation.
+func withVote(node *Vote) voteOption {
+	return func(m *VoteMutation) {
+		m.oldValue = func(context.Context) (*Vote, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m VoteMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m VoteMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *VoteMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *VoteMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Vote.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTitle sets the "title" field.
+func (m *VoteMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *VoteMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Vote entity.
+// If the Vote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoteMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *VoteMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *VoteMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *VoteMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Vote entity.
+// If the Vote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoteMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *VoteMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[vote.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *VoteMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[vote.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *VoteMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, vote.FieldDescription)
+}
+
+// SetVisible sets the "visible" field.
+func (m *VoteMutation) SetVisible(b bool) {
+	m.visible = &b
+}
+
+// Visible returns the value of the "visible" field in the mutation.
+func (m *VoteMutation) Visible() (r bool, exists bool) {
+	v := m.visible
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVisible returns the old "visible" field's value of the Vote entity.
+// If the Vote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoteMutation) OldVisible(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVisible is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVisible requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVisible: %w", err)
+	}
+	return oldValue.Visible, nil
+}
+
+// ResetVisible resets all changes to the "visible" field.
+func (m *VoteMutation) ResetVisible() {
+	m.visible = nil
+}
+
+// SetAnonymous sets the "anonymous" field.
+func (m *VoteMutation) SetAnonymous(b bool) {
+	m.anonymous = &b
+}
+
+// Anonymous returns the value of the "anonymous" field in the mutation.
+func (m *VoteMutation) Anonymous() (r bool, exists bool) {
+	v := m.anonymous
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnonymous returns the old "anonymous" field's value of the Vote entity.
+// If the Vote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoteMutation) OldAnonymous(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnonymous is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnonymous requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnonymous: %w", err)
+	}
+	return oldValue.Anonymous, nil
+}
+
+// ResetAnonymous resets all changes to the "anonymous" field.
+func (m *VoteMutation) ResetAnonymous() {
+	m.anonymous = nil
+}
+
+// SetStartAt sets the "start_at" field.
+func (m *VoteMutation) SetStartAt(t time.Time) {
+	m.start_at = &t
+}
+
+// StartAt returns the value of the "start_at" field in the mutation.
+func (m *VoteMutation) StartAt() (r time.Time, exists bool) {
+	v := m.start_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartAt returns the old "start_at" field's value of the Vote entity.
+// If the Vote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoteMutation) OldStartAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartAt: %w", err)
+	}
+	return oldValue.StartAt, nil
 }
 
-// UserVoteMutation represents an operation that mutates the UserVote nodes in the graph.
-type UserVoteMutation struct {
-	config
-	op               Op
-	typ              string
-	id               *int
-	created_at       *time.Time
-	clearedFields    map[string]struct{}
-	user             *int
-	cleareduser      bool
-	component        *int
-	clearedcomponent bool
-	done             bool
-	oldValue         func(context.Context) (*UserVote, error)
-	predicates       []predicate.UserVote
+// ResetStartAt resets all changes to the "start_at" field.
+func (m *VoteMutation) ResetStartAt() {
+	m.start_at = nil
 }
 
-var _ ent.Mutation = (*UserVoteMutation)(nil)
+// SetEndAt sets the "end_at" field.
+func (m *VoteMutation) SetEndAt(t time.Time) {
+	m.end_at = &t
+}
 
-// uservoteOption allows management of the mutation configuration using functional options.
-type uservoteOption func(*UserVoteMutation)
+// EndAt returns the value of the "end_at" field in the mutation.
+func (m *VoteMutation) EndAt() (r time.Time, exists bool) {
+	v := m.end_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newUserVoteMutation creates new mutation for the UserVote entity.
-func newUserVoteMutation(c config, op Op, opts ...uservoteOption) *UserVoteMutation {
-	m := &UserVoteMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeUserVote,
-		clearedFields: make(map[string]struct{}),
+// OldEndAt returns the old "end_at" field's value of the Vote entity.
+// If the Vote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoteMutation) OldEndAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndAt is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndAt requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndAt: %w", err)
+	}
+	return oldValue.EndAt, nil
 }
 
-// withUserVoteID sets the ID field of the mutation.
-func withUserVoteID(id int) uservoteOption {
-	return func(m *UserVoteMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *UserVote
-		)
-		m.oldValue = func(ctx context.Context) (*UserVote, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().UserVote.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// ResetEndAt resets all changes to the "end_at" field.
+func (m *VoteMutation) ResetEndAt() {
+	m.end_at = nil
+}
+
+// SetResultsRevealAt sets the "results_reveal_at" field.
+func (m *VoteMutation) SetResultsRevealAt(t time.Time) {
+	m.results_reveal_at = &t
+}
+
+// ResultsRevealAt returns the value of the "results_reveal_at" field in the mutation.
+func (m *VoteMutation) ResultsRevealAt() (r time.Time, exists bool) {
+	v := m.results_reveal_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// withUserVote sets the old UserVote of the mutation.
-func withUserVote(node *UserVote) uservoteOption {
-	return func(m *UserVoteMutation) {
-		m.oldValue = func(context.Context) (*UserVote, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OldResultsRevealAt returns the old "results_reveal_at" field's value of the Vote entity.
+// If the Vote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoteMutation) OldResultsRevealAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResultsRevealAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResultsRevealAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResultsRevealAt: %w", err)
 	}
+	return oldValue.ResultsRevealAt, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserVoteMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ClearResultsRevealAt clears the value of the "results_reveal_at" field.
+func (m *VoteMutation) ClearResultsRevealAt() {
+	m.results_reveal_at = nil
+	m.clearedFields[vote.FieldResultsRevealAt] = struct{}{}
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m UserVoteMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ResultsRevealAtCleared returns if the "results_reveal_at" field was cleared in this mutation.
+func (m *VoteMutation) ResultsRevealAtCleared() bool {
+	_, ok := m.clearedFields[vote.FieldResultsRevealAt]
+	return ok
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *UserVoteMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// ResetResultsRevealAt resets all changes to the "results_reveal_at" field.
+func (m *VoteMutation) ResetResultsRevealAt() {
+	m.results_reveal_at = nil
+	delete(m.clearedFields, vote.FieldResultsRevealAt)
+}
+
+// SetResultsPublishedAt sets the "results_published_at" field.
+func (m *VoteMutation) SetResultsPublishedAt(t time.Time) {
+	m.results_published_at = &t
+}
+
+// ResultsPublishedAt returns the value of the "results_published_at" field in the mutation.
+func (m *VoteMutation) ResultsPublishedAt() (r time.Time, exists bool) {
+	v := m.results_published_at
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *UserVoteMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().UserVote.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldResultsPublishedAt returns the old "results_published_at" field's value of the Vote entity.
+// If the Vote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoteMutation) OldResultsPublishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResultsPublishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResultsPublishedAt requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResultsPublishedAt: %w", err)
+	}
+	return oldValue.ResultsPublishedAt, nil
+}
+
+// ClearResultsPublishedAt clears the value of the "results_published_at" field.
+func (m *VoteMutation) ClearResultsPublishedAt() {
+	m.results_published_at = nil
+	m.clearedFields[vote.FieldResultsPublishedAt] = struct{}{}
+}
+
+// ResultsPublishedAtCleared returns if the "results_published_at" field was cleared in this mutation.
+func (m *VoteMutation) ResultsPublishedAtCleared() bool {
+	_, ok := m.clearedFields[vote.FieldResultsPublishedAt]
+	return ok
+}
+
+// ResetResultsPublishedAt resets all changes to the "results_published_at" field.
+func (m *VoteMutation) ResetResultsPublishedAt() {
+	m.results_published_at = nil
+	delete(m.clearedFields, vote.FieldResultsPublishedAt)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *UserVoteMutation) SetCreatedAt(t time.Time) {
+func (m *VoteMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserVoteMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *VoteMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -12376,10 +20178,10 @@ func (m *UserVoteMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the UserVote entity.
-// If the UserVote object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Vote entity.
+// If the Vote object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserVoteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *VoteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -12394,97 +20196,148 @@ func (m *UserVoteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserVoteMutation) ResetCreatedAt() {
+func (m *VoteMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *UserVoteMutation) SetUserID(id int) {
-	m.user = &id
+// SetUpdatedAt sets the "updated_at" field.
+func (m *VoteMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *UserVoteMutation) ClearUser() {
-	m.cleareduser = true
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *VoteMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *UserVoteMutation) UserCleared() bool {
-	return m.cleareduser
+// OldUpdatedAt returns the old "updated_at" field's value of the Vote entity.
+// If the Vote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoteMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *UserVoteMutation) UserID() (id int, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *VoteMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddComponentIDs adds the "components" edge to the Component entity by ids.
+func (m *VoteMutation) AddComponentIDs(ids ...int) {
+	if m.components == nil {
+		m.components = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.components[ids[i]] = struct{}{}
 	}
-	return
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *UserVoteMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// ClearComponents clears the "components" edge to the Component entity.
+func (m *VoteMutation) ClearComponents() {
+	m.clearedcomponents = true
+}
+
+// ComponentsCleared reports if the "components" edge to the Component entity was cleared.
+func (m *VoteMutation) ComponentsCleared() bool {
+	return m.clearedcomponents
+}
+
+// RemoveComponentIDs removes the "components" edge to the Component entity by IDs.
+func (m *VoteMutation) RemoveComponentIDs(ids ...int) {
+	if m.removedcomponents == nil {
+		m.removedcomponents = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.components, ids[i])
+		m.removedcomponents[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedComponents returns the removed IDs of the "components" edge to the Component entity.
+func (m *VoteMutation) RemovedComponentsIDs() (ids []int) {
+	for id := range m.removedcomponents {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *UserVoteMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ComponentsIDs returns the "components" edge IDs in the mutation.
+func (m *VoteMutation) ComponentsIDs() (ids []int) {
+	for id := range m.components {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetComponentID sets the "component" edge to the Component entity by id.
-func (m *UserVoteMutation) SetComponentID(id int) {
-	m.component = &id
+// ResetComponents resets all changes to the "components" edge.
+func (m *VoteMutation) ResetComponents() {
+	m.components = nil
+	m.clearedcomponents = false
+	m.removedcomponents = nil
 }
 
-// ClearComponent clears the "component" edge to the Component entity.
-func (m *UserVoteMutation) ClearComponent() {
-	m.clearedcomponent = true
+// SetCreatorID sets the "creator" edge to the User entity by id.
+func (m *VoteMutation) SetCreatorID(id int) {
+	m.creator = &id
 }
 
-// ComponentCleared reports if the "component" edge to the Component entity was cleared.
-func (m *UserVoteMutation) ComponentCleared() bool {
-	return m.clearedcomponent
+// ClearCreator clears the "creator" edge to the User entity.
+func (m *VoteMutation) ClearCreator() {
+	m.clearedcreator = true
 }
 
-// ComponentID returns the "component" edge ID in the mutation.
-func (m *UserVoteMutation) ComponentID() (id int, exists bool) {
-	if m.component != nil {
-		return *m.component, true
+// CreatorCleared reports if the "creator" edge to the User entity was cleared.
+func (m *VoteMutation) CreatorCleared() bool {
+	return m.clearedcreator
+}
+
+// CreatorID returns the "creator" edge ID in the mutation.
+func (m *VoteMutation) CreatorID() (id int, exists bool) {
+	if m.creator != nil {
+		return *m.creator, true
 	}
 	return
 }
 
-// ComponentIDs returns the "component" edge IDs in the mutation.
+// CreatorIDs returns the "creator" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ComponentID instead. It exists only for internal usage by the builders.
-func (m *UserVoteMutation) ComponentIDs() (ids []int) {
-	if id := m.component; id != nil {
+// CreatorID instead. It exists only for internal usage by the builders.
+func (m *VoteMutation) CreatorIDs() (ids []int) {
+	if id := m.creator; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetComponent resets all changes to the "component" edge.
-func (m *UserVoteMutation) ResetComponent() {
-	m.component = nil
-	m.clearedcomponent = false
+// ResetCreator resets all changes to the "creator" edge.
+func (m *VoteMutation) ResetCreator() {
+	m.creator = nil
+	m.clearedcreator = false
 }
 
-// Where appends a list predicates to the UserVoteMutation builder.
-func (m *UserVoteMutation) Where(ps ...predicate.UserVote) {
+// Where appends a list predicates to the VoteMutation builder.
+func (m *VoteMutation) Where(ps ...predicate.Vote) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserVoteMutation builder. Using this method,
+// WhereP appends storage-level predicates to the VoteMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserVoteMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.UserVote, len(ps))
+func (m *VoteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Vote, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -12492,27 +20345,54 @@ func (m *UserVoteMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserVoteMutation) Op() Op {
+func (m *VoteMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserVoteMutation) SetOp(op Op) {
+func (m *VoteMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (UserVote).
-func (m *UserVoteMutation) Type() string {
+// Type returns the node type of this mutation (Vote).
+func (m *VoteMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserVoteMutation) Fields() []string {
-	fields := make([]string, 0, 1)
+func (m *VoteMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.title != nil {
+		fields = append(fields, vote.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, vote.FieldDescription)
+	}
+	if m.visible != nil {
+		fields = append(fields, vote.FieldVisible)
+	}
+	if m.anonymous != nil {
+		fields = append(fields, vote.FieldAnonymous)
+	}
+	if m.start_at != nil {
+		fields = append(fields, vote.FieldStartAt)
+	}
+	if m.end_at != nil {
+		fields = append(fields, vote.FieldEndAt)
+	}
+	if m.results_reveal_at != nil {
+		fields = append(fields, vote.FieldResultsRevealAt)
+	}
+	if m.results_published_at != nil {
+		fields = append(fields, vote.FieldResultsPublishedAt)
+	}
 	if m.created_at != nil {
-		fields = append(fields, uservote.FieldCreatedAt)
+		fields = append(fields, vote.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, vote.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -12520,10 +20400,28 @@ func (m *UserVoteMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserVoteMutation) Field(name string) (ent.Value, bool) {
+func (m *VoteMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case uservote.FieldCreatedAt:
+	case vote.FieldTitle:
+		return m.Title()
+	case vote.FieldDescription:
+		return m.Description()
+	case vote.FieldVisible:
+		return m.Visible()
+	case vote.FieldAnonymous:
+		return m.Anonymous()
+	case vote.FieldStartAt:
+		return m.StartAt()
+	case vote.FieldEndAt:
+		return m.EndAt()
+	case vote.FieldResultsRevealAt:
+		return m.ResultsRevealAt()
+	case vote.FieldResultsPublishedAt:
+		return m.ResultsPublishedAt()
+	case vote.FieldCreatedAt:
 		return m.CreatedAt()
+	case vote.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -12531,104 +20429,235 @@ func (m *UserVoteMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserVoteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *VoteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case uservote.FieldCreatedAt:
+	case vote.FieldTitle:
+		return m.OldTitle(ctx)
+	case vote.FieldDescription:
+		return m.OldDescription(ctx)
+	case vote.FieldVisible:
+		return m.OldVisible(ctx)
+	case vote.FieldAnonymous:
+		return m.OldAnonymous(ctx)
+	case vote.FieldStartAt:
+		return m.OldStartAt(ctx)
+	case vote.FieldEndAt:
+		return m.OldEndAt(ctx)
+	case vote.FieldResultsRevealAt:
+		return m.OldResultsRevealAt(ctx)
+	case vote.FieldResultsPublishedAt:
+		return m.OldResultsPublishedAt(ctx)
+	case vote.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case vote.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown UserVote field %s", name)
+	return nil, fmt.Errorf("unknown Vote field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserVoteMutation) SetField(name string, value ent.Value) error {
+func (m *VoteMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case uservote.FieldCreatedAt:
+	case vote.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case vote.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case vote.FieldVisible:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVisible(v)
+		return nil
+	case vote.FieldAnonymous:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnonymous(v)
+		return nil
+	case vote.FieldStartAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartAt(v)
+		return nil
+	case vote.FieldEndAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndAt(v)
+		return nil
+	case vote.FieldResultsRevealAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResultsRevealAt(v)
+		return nil
+	case vote.FieldResultsPublishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResultsPublishedAt(v)
+		return nil
+	case vote.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
+	case vote.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown UserVote field %s", name)
+	return fmt.Errorf("unknown Vote field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserVoteMutation) AddedFields() []string {
+func (m *VoteMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserVoteMutation) AddedField(name string) (ent.Value, bool) {
+func (m *VoteMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserVoteMutation) AddField(name string, value ent.Value) error {
+func (m *VoteMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown UserVote numeric field %s", name)
+	return fmt.Errorf("unknown Vote numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserVoteMutation) ClearedFields() []string {
-	return nil
+func (m *VoteMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(vote.FieldDescription) {
+		fields = append(fields, vote.FieldDescription)
+	}
+	if m.FieldCleared(vote.FieldResultsRevealAt) {
+		fields = append(fields, vote.FieldResultsRevealAt)
+	}
+	if m.FieldCleared(vote.FieldResultsPublishedAt) {
+		fields = append(fields, vote.FieldResultsPublishedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserVoteMutation) FieldCleared(name string) bool {
+func (m *VoteMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserVoteMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown UserVote nullable field %s", name)
+func (m *VoteMutation) ClearField(name string) error {
+	switch name {
+	case vote.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case vote.FieldResultsRevealAt:
+		m.ClearResultsRevealAt()
+		return nil
+	case vote.FieldResultsPublishedAt:
+		m.ClearResultsPublishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Vote nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserVoteMutation) ResetField(name string) error {
+func (m *VoteMutation) ResetField(name string) error {
 	switch name {
-	case uservote.FieldCreatedAt:
+	case vote.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case vote.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case vote.FieldVisible:
+		m.ResetVisible()
+		return nil
+	case vote.FieldAnonymous:
+		m.ResetAnonymous()
+		return nil
+	case vote.FieldStartAt:
+		m.ResetStartAt()
+		return nil
+	case vote.FieldEndAt:
+		m.ResetEndAt()
+		return nil
+	case vote.FieldResultsRevealAt:
+		m.ResetResultsRevealAt()
+		return nil
+	case vote.FieldResultsPublishedAt:
+		m.ResetResultsPublishedAt()
+		return nil
+	case vote.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
+	case vote.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown UserVote field %s", name)
+	return fmt.Errorf("unknown Vote field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserVoteMutation) AddedEdges() []string {
+func (m *VoteMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.user != nil {
-		edges = append(edges, uservote.EdgeUser)
+	if m.components != nil {
+		edges = append(edges, vote.EdgeComponents)
 	}
-	if m.component != nil {
-		edges = append(edges, uservote.EdgeComponent)
+	if m.creator != nil {
+		edges = append(edges, vote.EdgeCreator)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserVoteMutation) AddedIDs(name string) []ent.Value {
+func (m *VoteMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case uservote.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
+	case vote.EdgeComponents:
+		ids := make([]ent.Value, 0, len(m.components))
+		for id := range m.components {
+			ids = append(ids, id)
 		}
-	case uservote.EdgeComponent:
-		if id := m.component; id != nil {
+		return ids
+	case vote.EdgeCreator:
+		if id := m.creator; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -12636,104 +20665,114 @@ func (m *UserVoteMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserVoteMutation) RemovedEdges() []string {
+func (m *VoteMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
+	if m.removedcomponents != nil {
+		edges = append(edges, vote.EdgeComponents)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserVoteMutation) RemovedIDs(name string) []ent.Value {
+func (m *VoteMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case vote.EdgeComponents:
+		ids := make([]ent.Value, 0, len(m.removedcomponents))
+		for id := range m.removedcomponents {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserVoteMutation) ClearedEdges() []string {
+func (m *VoteMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.cleareduser {
-		edges = append(edges, uservote.EdgeUser)
+	if m.clearedcomponents {
+		edges = append(edges, vote.EdgeComponents)
 	}
-	if m.clearedcomponent {
-		edges = append(edges, uservote.EdgeComponent)
+	if m.clearedcreator {
+		edges = append(edges, vote.EdgeCreator)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserVoteMutation) EdgeCleared(name string) bool {
+func (m *VoteMutation) EdgeCleared(name string) bool {
 	switch name {
-	case uservote.EdgeUser:
-		return m.cleareduser
-	case uservote.EdgeComponent:
-		return m.clearedcomponent
+	case vote.EdgeComponents:
+		return m.clearedcomponents
+	case vote.EdgeCreator:
+		return m.clearedcreator
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserVoteMutation) ClearEdge(name string) error {
+func (m *VoteMutation) ClearEdge(name string) error {
 	switch name {
-	case uservote.EdgeUser:
-		m.ClearUser()
-		return nil
-	case uservote.EdgeComponent:
-		m.ClearComponent()
+	case vote.EdgeCreator:
+		m.ClearCreator()
 		return nil
 	}
-	return fmt.Errorf("unknown UserVote unique edge %s", name)
+	return fmt.Errorf("unknown Vote unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserVoteMutation) ResetEdge(name string) error {
+func (m *VoteMutation) ResetEdge(name string) error {
 	switch name {
-	case uservote.EdgeUser:
-		m.ResetUser()
+	case vote.EdgeComponents:
+		m.ResetComponents()
 		return nil
-	case uservote.EdgeComponent:
-		m.ResetComponent()
+	case vote.EdgeCreator:
+		m.ResetCreator()
 		return nil
 	}
-	return fmt.Errorf("unknown UserVote edge %s", name)
+	return fmt.Errorf("unknown Vote edge %s", name)
 }
 
-// VoteMutation represents an operation that mutates the Vote nodes in the graph.
-type VoteMutation struct {
+// VoteTemplateMutation represents an operation that mutates the VoteTemplate nodes in the graph.
+type VoteTemplateMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int
-	title             *string
-	description       *string
-	visible           *bool
-	start_at          *time.Time
-	end_at            *time.Time
-	created_at        *time.Time
-	updated_at        *time.Time
-	clearedFields     map[string]struct{}
-	components        map[int]struct{}
-	removedcomponents map[int]struct{}
-	clearedcomponents bool
-	creator           *int
-	clearedcreator    bool
-	done              bool
-	oldValue          func(context.Context) (*Vote, error)
-	predicates        []predicate.Vote
-}
-
-var _ ent.Mutation = (*VoteMutation)(nil)
-
-// voteOption allows management of the mutation configuration using functional options.
-type voteOption func(*VoteMutation)
-
-// newVoteMutation creates new mutation for the Vote entity.
-func newVoteMutation(c config, op Op, opts ...voteOption) *VoteMutation {
-	m := &VoteMutation{
+	op                       Op
+	typ                      string
+	id                       *int
+	name                     *string
+	title                    *string
+	description              *string
+	anonymous                *bool
+	duration_minutes         *int
+	addduration_minutes      *int
+	results_delay_minutes    *int
+	addresults_delay_minutes *int
+	components               *[]schema.VoteTemplateComponent
+	appendcomponents         []schema.VoteTemplateComponent
+	created_at               *time.Time
+	updated_at               *time.Time
+	clearedFields            map[string]struct{}
+	creator                  *int
+	clearedcreator           bool
+	done                     bool
+	oldValue                 func(context.Context) (*VoteTemplate, error)
+	predicates               []predicate.VoteTemplate
+}
+
+var _ ent.Mutation = (*VoteTemplateMutation)(nil)
+
+// votetemplateOption allows management of the mutation configuration using functional options.
+type votetemplateOption func(*VoteTemplateMutation)
+
+// newVoteTemplateMutation creates new mutation for the VoteTemplate entity.
+func newVoteTemplateMutation(c config, op Op, opts ...votetemplateOption) *VoteTemplateMutation {
+	m := &VoteTemplateMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeVote,
+		typ:           TypeVoteTemplate,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -12742,20 +20781,20 @@ func newVoteMutation(c config, op Op, opts ...voteOption) *VoteMutation {
 	return m
 }
 
-// withVoteID sets the ID field of the mutation.
-func withVoteID(id int) voteOption {
-	return func(m *VoteMutation) {
+// withVoteTemplateID sets the ID field of the mutation.
+func withVoteTemplateID(id int) votetemplateOption {
+	return func(m *VoteTemplateMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Vote
+			value *VoteTemplate
 		)
-		m.oldValue = func(ctx context.Context) (*Vote, error) {
+		m.oldValue = func(ctx context.Context) (*VoteTemplate, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Vote.Get(ctx, id)
+					value, err = m.Client().VoteTemplate.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -12764,10 +20803,10 @@ func withVoteID(id int) voteOption {
 	}
 }
 
-// withVote sets the old Vote of the mutation.
-func withVote(node *Vote) voteOption {
-	return func(m *VoteMutation) {
-		m.oldValue = func(context.Context) (*Vote, error) {
+// withVoteTemplate sets the old VoteTemplate of the mutation.
+func withVoteTemplate(node *VoteTemplate) votetemplateOption {
+	return func(m *VoteTemplateMutation) {
+		m.oldValue = func(context.Context) (*VoteTemplate, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -12776,7 +20815,7 @@ func withVote(node *Vote) voteOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m VoteMutation) Client() *Client {
+func (m VoteTemplateMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -12784,7 +20823,7 @@ func (m VoteMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m VoteMutation) Tx() (*Tx, error) {
+func (m VoteTemplateMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -12795,7 +20834,7 @@ func (m VoteMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *VoteMutation) ID() (id int, exists bool) {
+func (m *VoteTemplateMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -12806,7 +20845,7 @@ func (m *VoteMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *VoteMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *VoteTemplateMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -12815,19 +20854,55 @@ func (m *VoteMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Vote.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().VoteTemplate.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
+// SetName sets the "name" field.
+func (m *VoteTemplateMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *VoteTemplateMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the VoteTemplate entity.
+// If the VoteTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoteTemplateMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *VoteTemplateMutation) ResetName() {
+	m.name = nil
+}
+
 // SetTitle sets the "title" field.
-func (m *VoteMutation) SetTitle(s string) {
+func (m *VoteTemplateMutation) SetTitle(s string) {
 	m.title = &s
 }
 
 // Title returns the value of the "title" field in the mutation.
-func (m *VoteMutation) Title() (r string, exists bool) {
+func (m *VoteTemplateMutation) Title() (r string, exists bool) {
 	v := m.title
 	if v == nil {
 		return
@@ -12835,10 +20910,10 @@ func (m *VoteMutation) Title() (r string, exists bool) {
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Vote entity.
-// If the Vote object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the VoteTemplate entity.
+// If the VoteTemplate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VoteMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *VoteTemplateMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
@@ -12853,17 +20928,17 @@ func (m *VoteMutation) OldTitle(ctx context.Context) (v string, err error) {
 }
 
 // ResetTitle resets all changes to the "title" field.
-func (m *VoteMutation) ResetTitle() {
+func (m *VoteTemplateMutation) ResetTitle() {
 	m.title = nil
 }
 
 // SetDescription sets the "description" field.
-func (m *VoteMutation) SetDescription(s string) {
+func (m *VoteTemplateMutation) SetDescription(s string) {
 	m.description = &s
 }
 
 // Description returns the value of the "description" field in the mutation.
-func (m *VoteMutation) Description() (r string, exists bool) {
+func (m *VoteTemplateMutation) Description() (r string, exists bool) {
 	v := m.description
 	if v == nil {
 		return
@@ -12871,10 +20946,10 @@ func (m *VoteMutation) Description() (r string, exists bool) {
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Vote entity.
-// If the Vote object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the VoteTemplate entity.
+// If the VoteTemplate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VoteMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *VoteTemplateMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
@@ -12889,138 +20964,243 @@ func (m *VoteMutation) OldDescription(ctx context.Context) (v string, err error)
 }
 
 // ClearDescription clears the value of the "description" field.
-func (m *VoteMutation) ClearDescription() {
+func (m *VoteTemplateMutation) ClearDescription() {
 	m.description = nil
-	m.clearedFields[vote.FieldDescription] = struct{}{}
+	m.clearedFields[votetemplate.FieldDescription] = struct{}{}
 }
 
 // DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *VoteMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[vote.FieldDescription]
+func (m *VoteTemplateMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[votetemplate.FieldDescription]
 	return ok
 }
 
 // ResetDescription resets all changes to the "description" field.
-func (m *VoteMutation) ResetDescription() {
+func (m *VoteTemplateMutation) ResetDescription() {
 	m.description = nil
-	delete(m.clearedFields, vote.FieldDescription)
+	delete(m.clearedFields, votetemplate.FieldDescription)
 }
 
-// SetVisible sets the "visible" field.
-func (m *VoteMutation) SetVisible(b bool) {
-	m.visible = &b
+// SetAnonymous sets the "anonymous" field.
+func (m *VoteTemplateMutation) SetAnonymous(b bool) {
+	m.anonymous = &b
 }
 
-// Visible returns the value of the "visible" field in the mutation.
-func (m *VoteMutation) Visible() (r bool, exists bool) {
-	v := m.visible
+// Anonymous returns the value of the "anonymous" field in the mutation.
+func (m *VoteTemplateMutation) Anonymous() (r bool, exists bool) {
+	v := m.anonymous
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVisible returns the old "visible" field's value of the Vote entity.
-// If the Vote object wasn't provided to the builder, the object is fetched from the database.
+// OldAnonymous returns the old "anonymous" field's value of the VoteTemplate entity.
+// If the VoteTemplate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VoteMutation) OldVisible(ctx context.Context) (v bool, err error) {
+func (m *VoteTemplateMutation) OldAnonymous(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVisible is only allowed on UpdateOne operations")
+		return v, errors.New("OldAnonymous is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVisible requires an ID field in the mutation")
+		return v, errors.New("OldAnonymous requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVisible: %w", err)
+		return v, fmt.Errorf("querying old value for OldAnonymous: %w", err)
 	}
-	return oldValue.Visible, nil
+	return oldValue.Anonymous, nil
 }
 
-// ResetVisible resets all changes to the "visible" field.
-func (m *VoteMutation) ResetVisible() {
-	m.visible = nil
+// ResetAnonymous resets all changes to the "anonymous" field.
+func (m *VoteTemplateMutation) ResetAnonymous() {
+	m.anonymous = nil
 }
 
-// SetStartAt sets the "start_at" field.
-func (m *VoteMutation) SetStartAt(t time.Time) {
-	m.start_at = &t
+// SetDurationMinutes sets the "duration_minutes" field.
+func (m *VoteTemplateMutation) SetDurationMinutes(i int) {
+	m.duration_minutes = &i
+	m.addduration_minutes = nil
 }
 
-// StartAt returns the value of the "start_at" field in the mutation.
-func (m *VoteMutation) StartAt() (r time.Time, exists bool) {
-	v := m.start_at
+// DurationMinutes returns the value of the "duration_minutes" field in the mutation.
+func (m *VoteTemplateMutation) DurationMinutes() (r int, exists bool) {
+	v := m.duration_minutes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartAt returns the old "start_at" field's value of the Vote entity.
-// If the Vote object wasn't provided to the builder, the object is fetched from the database.
+// OldDurationMinutes returns the old "duration_minutes" field's value of the VoteTemplate entity.
+// If the VoteTemplate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VoteMutation) OldStartAt(ctx context.Context) (v time.Time, err error) {
+func (m *VoteTemplateMutation) OldDurationMinutes(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDurationMinutes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartAt requires an ID field in the mutation")
+		return v, errors.New("OldDurationMinutes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDurationMinutes: %w", err)
 	}
-	return oldValue.StartAt, nil
+	return oldValue.DurationMinutes, nil
 }
 
-// ResetStartAt resets all changes to the "start_at" field.
-func (m *VoteMutation) ResetStartAt() {
-	m.start_at = nil
+// AddDurationMinutes adds i to the "duration_minutes" field.
+func (m *VoteTemplateMutation) AddDurationMinutes(i int) {
+	if m.addduration_minutes != nil {
+		*m.addduration_minutes += i
+	} else {
+		m.addduration_minutes = &i
+	}
 }
 
-// SetEndAt sets the "end_at" field.
-func (m *VoteMutation) SetEndAt(t time.Time) {
-	m.end_at = &t
+// AddedDurationMinutes returns the value that was added to the "duration_minutes" field in this mutation.
+func (m *VoteTemplateMutation) AddedDurationMinutes() (r int, exists bool) {
+	v := m.addduration_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// EndAt returns the value of the "end_at" field in the mutation.
-func (m *VoteMutation) EndAt() (r time.Time, exists bool) {
-	v := m.end_at
+// ResetDurationMinutes resets all changes to the "duration_minutes" field.
+func (m *VoteTemplateMutation) ResetDurationMinutes() {
+	m.duration_minutes = nil
+	m.addduration_minutes = nil
+}
+
+// SetResultsDelayMinutes sets the "results_delay_minutes" field.
+func (m *VoteTemplateMutation) SetResultsDelayMinutes(i int) {
+	m.results_delay_minutes = &i
+	m.addresults_delay_minutes = nil
+}
+
+// ResultsDelayMinutes returns the value of the "results_delay_minutes" field in the mutation.
+func (m *VoteTemplateMutation) ResultsDelayMinutes() (r int, exists bool) {
+	v := m.results_delay_minutes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEndAt returns the old "end_at" field's value of the Vote entity.
-// If the Vote object wasn't provided to the builder, the object is fetched from the database.
+// OldResultsDelayMinutes returns the old "results_delay_minutes" field's value of the VoteTemplate entity.
+// If the VoteTemplate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VoteMutation) OldEndAt(ctx context.Context) (v time.Time, err error) {
+func (m *VoteTemplateMutation) OldResultsDelayMinutes(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldResultsDelayMinutes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndAt requires an ID field in the mutation")
+		return v, errors.New("OldResultsDelayMinutes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldResultsDelayMinutes: %w", err)
+	}
+	return oldValue.ResultsDelayMinutes, nil
+}
+
+// AddResultsDelayMinutes adds i to the "results_delay_minutes" field.
+func (m *VoteTemplateMutation) AddResultsDelayMinutes(i int) {
+	if m.addresults_delay_minutes != nil {
+		*m.addresults_delay_minutes += i
+	} else {
+		m.addresults_delay_minutes = &i
+	}
+}
+
+// AddedResultsDelayMinutes returns the value that was added to the "results_delay_minutes" field in this mutation.
+func (m *VoteTemplateMutation) AddedResultsDelayMinutes() (r int, exists bool) {
+	v := m.addresults_delay_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearResultsDelayMinutes clears the value of the "results_delay_minutes" field.
+func (m *VoteTemplateMutation) ClearResultsDelayMinutes() {
+	m.results_delay_minutes = nil
+	m.addresults_delay_minutes = nil
+	m.clearedFields[votetemplate.FieldResultsDelayMinutes] = struct{}{}
+}
+
+// ResultsDelayMinutesCleared returns if the "results_delay_minutes" field was cleared in this mutation.
+func (m *VoteTemplateMutation) ResultsDelayMinutesCleared() bool {
+	_, ok := m.clearedFields[votetemplate.FieldResultsDelayMinutes]
+	return ok
+}
+
+// ResetResultsDelayMinutes resets all changes to the "results_delay_minutes" field.
+func (m *VoteTemplateMutation) ResetResultsDelayMinutes() {
+	m.results_delay_minutes = nil
+	m.addresults_delay_minutes = nil
+	delete(m.clearedFields, votetemplate.FieldResultsDelayMinutes)
+}
+
+// SetComponents sets the "components" field.
+func (m *VoteTemplateMutation) SetComponents(stc []schema.VoteTemplateComponent) {
+	m.components = &stc
+	m.appendcomponents = nil
+}
+
+// Components returns the value of the "components" field in the mutation.
+func (m *VoteTemplateMutation) Components() (r []schema.VoteTemplateComponent, exists bool) {
+	v := m.components
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldComponents returns the old "components" field's value of the VoteTemplate entity.
+// If the VoteTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoteTemplateMutation) OldComponents(ctx context.Context) (v []schema.VoteTemplateComponent, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldComponents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldComponents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldComponents: %w", err)
 	}
-	return oldValue.EndAt, nil
+	return oldValue.Components, nil
 }
 
-// ResetEndAt resets all changes to the "end_at" field.
-func (m *VoteMutation) ResetEndAt() {
-	m.end_at = nil
+// AppendComponents adds stc to the "components" field.
+func (m *VoteTemplateMutation) AppendComponents(stc []schema.VoteTemplateComponent) {
+	m.appendcomponents = append(m.appendcomponents, stc...)
+}
+
+// AppendedComponents returns the list of values that were appended to the "components" field in this mutation.
+func (m *VoteTemplateMutation) AppendedComponents() ([]schema.VoteTemplateComponent, bool) {
+	if len(m.appendcomponents) == 0 {
+		return nil, false
+	}
+	return m.appendcomponents, true
+}
+
+// ResetComponents resets all changes to the "components" field.
+func (m *VoteTemplateMutation) ResetComponents() {
+	m.components = nil
+	m.appendcomponents = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *VoteMutation) SetCreatedAt(t time.Time) {
+func (m *VoteTemplateMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *VoteMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *VoteTemplateMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -13028,10 +21208,10 @@ func (m *VoteMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Vote entity.
-// If the Vote object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the VoteTemplate entity.
+// If the VoteTemplate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VoteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *VoteTemplateMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -13046,17 +21226,17 @@ func (m *VoteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *VoteMutation) ResetCreatedAt() {
+func (m *VoteTemplateMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *VoteMutation) SetUpdatedAt(t time.Time) {
+func (m *VoteTemplateMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *VoteMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *VoteTemplateMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -13064,10 +21244,10 @@ func (m *VoteMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Vote entity.
-// If the Vote object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the VoteTemplate entity.
+// If the VoteTemplate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VoteMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *VoteTemplateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -13082,81 +21262,27 @@ func (m *VoteMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *VoteMutation) ResetUpdatedAt() {
+func (m *VoteTemplateMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// AddComponentIDs adds the "components" edge to the Component entity by ids.
-func (m *VoteMutation) AddComponentIDs(ids ...int) {
-	if m.components == nil {
-		m.components = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.components[ids[i]] = struct{}{}
-	}
-}
-
-// ClearComponents clears the "components" edge to the Component entity.
-func (m *VoteMutation) ClearComponents() {
-	m.clearedcomponents = true
-}
-
-// ComponentsCleared reports if the "components" edge to the Component entity was cleared.
-func (m *VoteMutation) ComponentsCleared() bool {
-	return m.clearedcomponents
-}
-
-// RemoveComponentIDs removes the "components" edge to the Component entity by IDs.
-func (m *VoteMutation) RemoveComponentIDs(ids ...int) {
-	if m.removedcomponents == nil {
-		m.removedcomponents = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.components, ids[i])
-		m.removedcomponents[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedComponents returns the removed IDs of the "components" edge to the Component entity.
-func (m *VoteMutation) RemovedComponentsIDs() (ids []int) {
-	for id := range m.removedcomponents {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ComponentsIDs returns the "components" edge IDs in the mutation.
-func (m *VoteMutation) ComponentsIDs() (ids []int) {
-	for id := range m.components {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetComponents resets all changes to the "components" edge.
-func (m *VoteMutation) ResetComponents() {
-	m.components = nil
-	m.clearedcomponents = false
-	m.removedcomponents = nil
-}
-
 // SetCreatorID sets the "creator" edge to the User entity by id.
-func (m *VoteMutation) SetCreatorID(id int) {
+func (m *VoteTemplateMutation) SetCreatorID(id int) {
 	m.creator = &id
 }
 
 // ClearCreator clears the "creator" edge to the User entity.
-func (m *VoteMutation) ClearCreator() {
+func (m *VoteTemplateMutation) ClearCreator() {
 	m.clearedcreator = true
 }
 
 // CreatorCleared reports if the "creator" edge to the User entity was cleared.
-func (m *VoteMutation) CreatorCleared() bool {
+func (m *VoteTemplateMutation) CreatorCleared() bool {
 	return m.clearedcreator
 }
 
 // CreatorID returns the "creator" edge ID in the mutation.
-func (m *VoteMutation) CreatorID() (id int, exists bool) {
+func (m *VoteTemplateMutation) CreatorID() (id int, exists bool) {
 	if m.creator != nil {
 		return *m.creator, true
 	}
@@ -13166,7 +21292,7 @@ func (m *VoteMutation) CreatorID() (id int, exists bool) {
 // CreatorIDs returns the "creator" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // CreatorID instead. It exists only for internal usage by the builders.
-func (m *VoteMutation) CreatorIDs() (ids []int) {
+func (m *VoteTemplateMutation) CreatorIDs() (ids []int) {
 	if id := m.creator; id != nil {
 		ids = append(ids, *id)
 	}
@@ -13174,20 +21300,20 @@ func (m *VoteMutation) CreatorIDs() (ids []int) {
 }
 
 // ResetCreator resets all changes to the "creator" edge.
-func (m *VoteMutation) ResetCreator() {
+func (m *VoteTemplateMutation) ResetCreator() {
 	m.creator = nil
 	m.clearedcreator = false
 }
 
-// Where appends a list predicates to the VoteMutation builder.
-func (m *VoteMutation) Where(ps ...predicate.Vote) {
+// Where appends a list predicates to the VoteTemplateMutation builder.
+func (m *VoteTemplateMutation) Where(ps ...predicate.VoteTemplate) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the VoteMutation builder. Using this method,
+// WhereP appends storage-level predicates to the VoteTemplateMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *VoteMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Vote, len(ps))
+func (m *VoteTemplateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.VoteTemplate, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -13195,45 +21321,51 @@ func (m *VoteMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *VoteMutation) Op() Op {
+func (m *VoteTemplateMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *VoteMutation) SetOp(op Op) {
+func (m *VoteTemplateMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Vote).
-func (m *VoteMutation) Type() string {
+// Type returns the node type of this mutation (VoteTemplate).
+func (m *VoteTemplateMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *VoteMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *VoteTemplateMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.name != nil {
+		fields = append(fields, votetemplate.FieldName)
+	}
 	if m.title != nil {
-		fields = append(fields, vote.FieldTitle)
+		fields = append(fields, votetemplate.FieldTitle)
 	}
 	if m.description != nil {
-		fields = append(fields, vote.FieldDescription)
+		fields = append(fields, votetemplate.FieldDescription)
 	}
-	if m.visible != nil {
-		fields = append(fields, vote.FieldVisible)
+	if m.anonymous != nil {
+		fields = append(fields, votetemplate.FieldAnonymous)
 	}
-	if m.start_at != nil {
-		fields = append(fields, vote.FieldStartAt)
+	if m.duration_minutes != nil {
+		fields = append(fields, votetemplate.FieldDurationMinutes)
 	}
-	if m.end_at != nil {
-		fields = append(fields, vote.FieldEndAt)
+	if m.results_delay_minutes != nil {
+		fields = append(fields, votetemplate.FieldResultsDelayMinutes)
+	}
+	if m.components != nil {
+		fields = append(fields, votetemplate.FieldComponents)
 	}
 	if m.created_at != nil {
-		fields = append(fields, vote.FieldCreatedAt)
+		fields = append(fields, votetemplate.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, vote.FieldUpdatedAt)
+		fields = append(fields, votetemplate.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -13241,21 +21373,25 @@ func (m *VoteMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *VoteMutation) Field(name string) (ent.Value, bool) {
+func (m *VoteTemplateMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case vote.FieldTitle:
+	case votetemplate.FieldName:
+		return m.Name()
+	case votetemplate.FieldTitle:
 		return m.Title()
-	case vote.FieldDescription:
+	case votetemplate.FieldDescription:
 		return m.Description()
-	case vote.FieldVisible:
-		return m.Visible()
-	case vote.FieldStartAt:
-		return m.StartAt()
-	case vote.FieldEndAt:
-		return m.EndAt()
-	case vote.FieldCreatedAt:
+	case votetemplate.FieldAnonymous:
+		return m.Anonymous()
+	case votetemplate.FieldDurationMinutes:
+		return m.DurationMinutes()
+	case votetemplate.FieldResultsDelayMinutes:
+		return m.ResultsDelayMinutes()
+	case votetemplate.FieldComponents:
+		return m.Components()
+	case votetemplate.FieldCreatedAt:
 		return m.CreatedAt()
-	case vote.FieldUpdatedAt:
+	case votetemplate.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -13264,74 +21400,92 @@ func (m *VoteMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *VoteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *VoteTemplateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case vote.FieldTitle:
+	case votetemplate.FieldName:
+		return m.OldName(ctx)
+	case votetemplate.FieldTitle:
 		return m.OldTitle(ctx)
-	case vote.FieldDescription:
+	case votetemplate.FieldDescription:
 		return m.OldDescription(ctx)
-	case vote.FieldVisible:
-		return m.OldVisible(ctx)
-	case vote.FieldStartAt:
-		return m.OldStartAt(ctx)
-	case vote.FieldEndAt:
-		return m.OldEndAt(ctx)
-	case vote.FieldCreatedAt:
+	case votetemplate.FieldAnonymous:
+		return m.OldAnonymous(ctx)
+	case votetemplate.FieldDurationMinutes:
+		return m.OldDurationMinutes(ctx)
+	case votetemplate.FieldResultsDelayMinutes:
+		return m.OldResultsDelayMinutes(ctx)
+	case votetemplate.FieldComponents:
+		return m.OldComponents(ctx)
+	case votetemplate.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case vote.FieldUpdatedAt:
+	case votetemplate.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Vote field %s", name)
+	return nil, fmt.Errorf("unknown VoteTemplate field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *VoteMutation) SetField(name string, value ent.Value) error {
+func (m *VoteTemplateMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case vote.FieldTitle:
+	case votetemplate.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case votetemplate.FieldTitle:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTitle(v)
 		return nil
-	case vote.FieldDescription:
+	case votetemplate.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDescription(v)
 		return nil
-	case vote.FieldVisible:
+	case votetemplate.FieldAnonymous:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVisible(v)
+		m.SetAnonymous(v)
 		return nil
-	case vote.FieldStartAt:
-		v, ok := value.(time.Time)
+	case votetemplate.FieldDurationMinutes:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStartAt(v)
+		m.SetDurationMinutes(v)
 		return nil
-	case vote.FieldEndAt:
-		v, ok := value.(time.Time)
+	case votetemplate.FieldResultsDelayMinutes:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEndAt(v)
+		m.SetResultsDelayMinutes(v)
 		return nil
-	case vote.FieldCreatedAt:
+	case votetemplate.FieldComponents:
+		v, ok := value.([]schema.VoteTemplateComponent)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetComponents(v)
+		return nil
+	case votetemplate.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case vote.FieldUpdatedAt:
+	case votetemplate.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -13339,111 +21493,141 @@ func (m *VoteMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Vote field %s", name)
+	return fmt.Errorf("unknown VoteTemplate field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *VoteMutation) AddedFields() []string {
-	return nil
+func (m *VoteTemplateMutation) AddedFields() []string {
+	var fields []string
+	if m.addduration_minutes != nil {
+		fields = append(fields, votetemplate.FieldDurationMinutes)
+	}
+	if m.addresults_delay_minutes != nil {
+		fields = append(fields, votetemplate.FieldResultsDelayMinutes)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *VoteMutation) AddedField(name string) (ent.Value, bool) {
+func (m *VoteTemplateMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case votetemplate.FieldDurationMinutes:
+		return m.AddedDurationMinutes()
+	case votetemplate.FieldResultsDelayMinutes:
+		return m.AddedResultsDelayMinutes()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *VoteMutation) AddField(name string, value ent.Value) error {
+func (m *VoteTemplateMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case votetemplate.FieldDurationMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMinutes(v)
+		return nil
+	case votetemplate.FieldResultsDelayMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResultsDelayMinutes(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Vote numeric field %s", name)
+	return fmt.Errorf("unknown VoteTemplate numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *VoteMutation) ClearedFields() []string {
+func (m *VoteTemplateMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(vote.FieldDescription) {
-		fields = append(fields, vote.FieldDescription)
+	if m.FieldCleared(votetemplate.FieldDescription) {
+		fields = append(fields, votetemplate.FieldDescription)
+	}
+	if m.FieldCleared(votetemplate.FieldResultsDelayMinutes) {
+		fields = append(fields, votetemplate.FieldResultsDelayMinutes)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *VoteMutation) FieldCleared(name string) bool {
+func (m *VoteTemplateMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *VoteMutation) ClearField(name string) error {
+func (m *VoteTemplateMutation) ClearField(name string) error {
 	switch name {
-	case vote.FieldDescription:
+	case votetemplate.FieldDescription:
 		m.ClearDescription()
 		return nil
+	case votetemplate.FieldResultsDelayMinutes:
+		m.ClearResultsDelayMinutes()
+		return nil
 	}
-	return fmt.Errorf("unknown Vote nullable field %s", name)
+	return fmt.Errorf("unknown VoteTemplate nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *VoteMutation) ResetField(name string) error {
+func (m *VoteTemplateMutation) ResetField(name string) error {
 	switch name {
-	case vote.FieldTitle:
+	case votetemplate.FieldName:
+		m.ResetName()
+		return nil
+	case votetemplate.FieldTitle:
 		m.ResetTitle()
 		return nil
-	case vote.FieldDescription:
+	case votetemplate.FieldDescription:
 		m.ResetDescription()
 		return nil
-	case vote.FieldVisible:
-		m.ResetVisible()
+	case votetemplate.FieldAnonymous:
+		m.ResetAnonymous()
 		return nil
-	case vote.FieldStartAt:
-		m.ResetStartAt()
+	case votetemplate.FieldDurationMinutes:
+		m.ResetDurationMinutes()
 		return nil
-	case vote.FieldEndAt:
-		m.ResetEndAt()
+	case votetemplate.FieldResultsDelayMinutes:
+		m.ResetResultsDelayMinutes()
 		return nil
-	case vote.FieldCreatedAt:
+	case votetemplate.FieldComponents:
+		m.ResetComponents()
+		return nil
+	case votetemplate.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case vote.FieldUpdatedAt:
+	case votetemplate.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Vote field %s", name)
+	return fmt.Errorf("unknown VoteTemplate field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *VoteMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.components != nil {
-		edges = append(edges, vote.EdgeComponents)
-	}
+func (m *VoteTemplateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.creator != nil {
-		edges = append(edges, vote.EdgeCreator)
+		edges = append(edges, votetemplate.EdgeCreator)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *VoteMutation) AddedIDs(name string) []ent.Value {
+func (m *VoteTemplateMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case vote.EdgeComponents:
-		ids := make([]ent.Value, 0, len(m.components))
-		for id := range m.components {
-			ids = append(ids, id)
-		}
-		return ids
-	case vote.EdgeCreator:
+	case votetemplate.EdgeCreator:
 		if id := m.creator; id != nil {
 			return []ent.Value{*id}
 		}
@@ -13452,47 +21636,31 @@ func (m *VoteMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *VoteMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedcomponents != nil {
-		edges = append(edges, vote.EdgeComponents)
-	}
+func (m *VoteTemplateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *VoteMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case vote.EdgeComponents:
-		ids := make([]ent.Value, 0, len(m.removedcomponents))
-		for id := range m.removedcomponents {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *VoteTemplateMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *VoteMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedcomponents {
-		edges = append(edges, vote.EdgeComponents)
-	}
+func (m *VoteTemplateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.clearedcreator {
-		edges = append(edges, vote.EdgeCreator)
+		edges = append(edges, votetemplate.EdgeCreator)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *VoteMutation) EdgeCleared(name string) bool {
+func (m *VoteTemplateMutation) EdgeCleared(name string) bool {
 	switch name {
-	case vote.EdgeComponents:
-		return m.clearedcomponents
-	case vote.EdgeCreator:
+	case votetemplate.EdgeCreator:
 		return m.clearedcreator
 	}
 	return false
@@ -13500,25 +21668,22 @@ func (m *VoteMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *VoteMutation) ClearEdge(name string) error {
+func (m *VoteTemplateMutation) ClearEdge(name string) error {
 	switch name {
-	case vote.EdgeCreator:
+	case votetemplate.EdgeCreator:
 		m.ClearCreator()
 		return nil
 	}
-	return fmt.Errorf("unknown Vote unique edge %s", name)
+	return fmt.Errorf("unknown VoteTemplate unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *VoteMutation) ResetEdge(name string) error {
+func (m *VoteTemplateMutation) ResetEdge(name string) error {
 	switch name {
-	case vote.EdgeComponents:
-		m.ResetComponents()
-		return nil
-	case vote.EdgeCreator:
+	case votetemplate.EdgeCreator:
 		m.ResetCreator()
 		return nil
 	}
-	return fmt.Errorf("unknown Vote edge %s", name)
+	return fmt.Errorf("unknown VoteTemplate edge %s", name)
 }
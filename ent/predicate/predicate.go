@@ -9,12 +9,18 @@ import (
 // App is the predicate function for app builders.
 type App func(*sql.Selector)
 
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
 // AuthCode is the predicate function for authcode builders.
 type AuthCode func(*sql.Selector)
 
 // AuthRefreshToken is the predicate function for authrefreshtoken builders.
 type AuthRefreshToken func(*sql.Selector)
 
+// AuthRequest is the predicate function for authrequest builders.
+type AuthRequest func(*sql.Selector)
+
 // AuthToken is the predicate function for authtoken builders.
 type AuthToken func(*sql.Selector)
 
@@ -24,6 +30,9 @@ type Component func(*sql.Selector)
 // Consent is the predicate function for consent builders.
 type Consent func(*sql.Selector)
 
+// DeviceAuthorization is the predicate function for deviceauthorization builders.
+type DeviceAuthorization func(*sql.Selector)
+
 // Invitation is the predicate function for invitation builders.
 type Invitation func(*sql.Selector)
 
@@ -33,6 +42,15 @@ type Notification func(*sql.Selector)
 // RankGroup is the predicate function for rankgroup builders.
 type RankGroup func(*sql.Selector)
 
+// RoleGrant is the predicate function for rolegrant builders.
+type RoleGrant func(*sql.Selector)
+
+// Sanction is the predicate function for sanction builders.
+type Sanction func(*sql.Selector)
+
+// SigningKey is the predicate function for signingkey builders.
+type SigningKey func(*sql.Selector)
+
 // Team is the predicate function for team builders.
 type Team func(*sql.Selector)
 
@@ -53,3 +71,6 @@ type UserVote func(*sql.Selector)
 
 // Vote is the predicate function for vote builders.
 type Vote func(*sql.Selector)
+
+// VoteTemplate is the predicate function for votetemplate builders.
+type VoteTemplate func(*sql.Selector)
@@ -19,8 +19,9 @@ import (
 // RankGroupUpdate is the builder for updating RankGroup entities.
 type RankGroupUpdate struct {
 	config
-	hooks    []Hook
-	mutation *RankGroupMutation
+	hooks     []Hook
+	mutation  *RankGroupMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the RankGroupUpdate builder.
@@ -199,6 +200,12 @@ func (_u *RankGroupUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RankGroupUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RankGroupUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *RankGroupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -306,6 +313,7 @@ func (_u *RankGroupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{rankgroup.Label}
@@ -321,9 +329,10 @@ func (_u *RankGroupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // RankGroupUpdateOne is the builder for updating a single RankGroup entity.
 type RankGroupUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *RankGroupMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *RankGroupMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetName sets the "name" field.
@@ -509,6 +518,12 @@ func (_u *RankGroupUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RankGroupUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RankGroupUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *RankGroupUpdateOne) sqlSave(ctx context.Context) (_node *RankGroup, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -633,6 +648,7 @@ func (_u *RankGroupUpdateOne) sqlSave(ctx context.Context) (_node *RankGroup, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &RankGroup{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
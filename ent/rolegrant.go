@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"base-website/ent/rolegrant"
+	"base-website/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// RoleGrant is the model entity for the RoleGrant schema.
+type RoleGrant struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Role holds the value of the "role" field.
+	Role string `json:"role,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RoleGrantQuery when eager-loading is set.
+	Edges                  RoleGrantEdges `json:"edges"`
+	user_role_grants       *int
+	user_given_role_grants *int
+	selectValues           sql.SelectValues
+}
+
+// RoleGrantEdges holds the relations/edges for other nodes in the graph.
+type RoleGrantEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// GrantedBy holds the value of the granted_by edge.
+	GrantedBy *User `json:"granted_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RoleGrantEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// GrantedByOrErr returns the GrantedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RoleGrantEdges) GrantedByOrErr() (*User, error) {
+	if e.GrantedBy != nil {
+		return e.GrantedBy, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "granted_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RoleGrant) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case rolegrant.FieldID:
+			values[i] = new(sql.NullInt64)
+		case rolegrant.FieldRole, rolegrant.FieldReason:
+			values[i] = new(sql.NullString)
+		case rolegrant.FieldExpiresAt, rolegrant.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case rolegrant.ForeignKeys[0]: // user_role_grants
+			values[i] = new(sql.NullInt64)
+		case rolegrant.ForeignKeys[1]: // user_given_role_grants
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RoleGrant fields.
+func (_m *RoleGrant) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case rolegrant.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case rolegrant.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = value.String
+			}
+		case rolegrant.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case rolegrant.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = new(time.Time)
+				*_m.ExpiresAt = value.Time
+			}
+		case rolegrant.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case rolegrant.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_role_grants", value)
+			} else if value.Valid {
+				_m.user_role_grants = new(int)
+				*_m.user_role_grants = int(value.Int64)
+			}
+		case rolegrant.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_given_role_grants", value)
+			} else if value.Valid {
+				_m.user_given_role_grants = new(int)
+				*_m.user_given_role_grants = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RoleGrant.
+// This includes values selected through modifiers, order, etc.
+func (_m *RoleGrant) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the RoleGrant entity.
+func (_m *RoleGrant) QueryUser() *UserQuery {
+	return NewRoleGrantClient(_m.config).QueryUser(_m)
+}
+
+// QueryGrantedBy queries the "granted_by" edge of the RoleGrant entity.
+func (_m *RoleGrant) QueryGrantedBy() *UserQuery {
+	return NewRoleGrantClient(_m.config).QueryGrantedBy(_m)
+}
+
+// Update returns a builder for updating this RoleGrant.
+// Note that you need to call RoleGrant.Unwrap() before calling this method if this RoleGrant
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RoleGrant) Update() *RoleGrantUpdateOne {
+	return NewRoleGrantClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RoleGrant entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RoleGrant) Unwrap() *RoleGrant {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RoleGrant is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RoleGrant) String() string {
+	var builder strings.Builder
+	builder.WriteString("RoleGrant(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("role=")
+	builder.WriteString(_m.Role)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	if v := _m.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RoleGrants is a parsable slice of RoleGrant.
+type RoleGrants []*RoleGrant
@@ -0,0 +1,427 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"base-website/ent/predicate"
+	"base-website/ent/rolegrant"
+	"base-website/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RoleGrantUpdate is the builder for updating RoleGrant entities.
+type RoleGrantUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *RoleGrantMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the RoleGrantUpdate builder.
+func (_u *RoleGrantUpdate) Where(ps ...predicate.RoleGrant) *RoleGrantUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *RoleGrantUpdate) SetReason(v string) *RoleGrantUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *RoleGrantUpdate) SetNillableReason(v *string) *RoleGrantUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *RoleGrantUpdate) ClearReason() *RoleGrantUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *RoleGrantUpdate) SetExpiresAt(v time.Time) *RoleGrantUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *RoleGrantUpdate) SetNillableExpiresAt(v *time.Time) *RoleGrantUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *RoleGrantUpdate) ClearExpiresAt() *RoleGrantUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetGrantedByID sets the "granted_by" edge to the User entity by ID.
+func (_u *RoleGrantUpdate) SetGrantedByID(id int) *RoleGrantUpdate {
+	_u.mutation.SetGrantedByID(id)
+	return _u
+}
+
+// SetNillableGrantedByID sets the "granted_by" edge to the User entity by ID if the given value is not nil.
+func (_u *RoleGrantUpdate) SetNillableGrantedByID(id *int) *RoleGrantUpdate {
+	if id != nil {
+		_u = _u.SetGrantedByID(*id)
+	}
+	return _u
+}
+
+// SetGrantedBy sets the "granted_by" edge to the User entity.
+func (_u *RoleGrantUpdate) SetGrantedBy(v *User) *RoleGrantUpdate {
+	return _u.SetGrantedByID(v.ID)
+}
+
+// Mutation returns the RoleGrantMutation object of the builder.
+func (_u *RoleGrantUpdate) Mutation() *RoleGrantMutation {
+	return _u.mutation
+}
+
+// ClearGrantedBy clears the "granted_by" edge to the User entity.
+func (_u *RoleGrantUpdate) ClearGrantedBy() *RoleGrantUpdate {
+	_u.mutation.ClearGrantedBy()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RoleGrantUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RoleGrantUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RoleGrantUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RoleGrantUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RoleGrantUpdate) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RoleGrant.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RoleGrantUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RoleGrantUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RoleGrantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rolegrant.Table, rolegrant.Columns, sqlgraph.NewFieldSpec(rolegrant.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(rolegrant.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(rolegrant.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(rolegrant.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(rolegrant.FieldExpiresAt, field.TypeTime)
+	}
+	if _u.mutation.GrantedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rolegrant.GrantedByTable,
+			Columns: []string{rolegrant.GrantedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GrantedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rolegrant.GrantedByTable,
+			Columns: []string{rolegrant.GrantedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rolegrant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RoleGrantUpdateOne is the builder for updating a single RoleGrant entity.
+type RoleGrantUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *RoleGrantMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetReason sets the "reason" field.
+func (_u *RoleGrantUpdateOne) SetReason(v string) *RoleGrantUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *RoleGrantUpdateOne) SetNillableReason(v *string) *RoleGrantUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *RoleGrantUpdateOne) ClearReason() *RoleGrantUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *RoleGrantUpdateOne) SetExpiresAt(v time.Time) *RoleGrantUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *RoleGrantUpdateOne) SetNillableExpiresAt(v *time.Time) *RoleGrantUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *RoleGrantUpdateOne) ClearExpiresAt() *RoleGrantUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetGrantedByID sets the "granted_by" edge to the User entity by ID.
+func (_u *RoleGrantUpdateOne) SetGrantedByID(id int) *RoleGrantUpdateOne {
+	_u.mutation.SetGrantedByID(id)
+	return _u
+}
+
+// SetNillableGrantedByID sets the "granted_by" edge to the User entity by ID if the given value is not nil.
+func (_u *RoleGrantUpdateOne) SetNillableGrantedByID(id *int) *RoleGrantUpdateOne {
+	if id != nil {
+		_u = _u.SetGrantedByID(*id)
+	}
+	return _u
+}
+
+// SetGrantedBy sets the "granted_by" edge to the User entity.
+func (_u *RoleGrantUpdateOne) SetGrantedBy(v *User) *RoleGrantUpdateOne {
+	return _u.SetGrantedByID(v.ID)
+}
+
+// Mutation returns the RoleGrantMutation object of the builder.
+func (_u *RoleGrantUpdateOne) Mutation() *RoleGrantMutation {
+	return _u.mutation
+}
+
+// ClearGrantedBy clears the "granted_by" edge to the User entity.
+func (_u *RoleGrantUpdateOne) ClearGrantedBy() *RoleGrantUpdateOne {
+	_u.mutation.ClearGrantedBy()
+	return _u
+}
+
+// Where appends a list predicates to the RoleGrantUpdate builder.
+func (_u *RoleGrantUpdateOne) Where(ps ...predicate.RoleGrant) *RoleGrantUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RoleGrantUpdateOne) Select(field string, fields ...string) *RoleGrantUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RoleGrant entity.
+func (_u *RoleGrantUpdateOne) Save(ctx context.Context) (*RoleGrant, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RoleGrantUpdateOne) SaveX(ctx context.Context) *RoleGrant {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RoleGrantUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RoleGrantUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RoleGrantUpdateOne) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RoleGrant.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RoleGrantUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RoleGrantUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RoleGrantUpdateOne) sqlSave(ctx context.Context) (_node *RoleGrant, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rolegrant.Table, rolegrant.Columns, sqlgraph.NewFieldSpec(rolegrant.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RoleGrant.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rolegrant.FieldID)
+		for _, f := range fields {
+			if !rolegrant.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != rolegrant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(rolegrant.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(rolegrant.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(rolegrant.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(rolegrant.FieldExpiresAt, field.TypeTime)
+	}
+	if _u.mutation.GrantedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rolegrant.GrantedByTable,
+			Columns: []string{rolegrant.GrantedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GrantedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rolegrant.GrantedByTable,
+			Columns: []string{rolegrant.GrantedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &RoleGrant{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rolegrant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
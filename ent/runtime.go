@@ -4,17 +4,26 @@ package ent
 
 import (
 	"base-website/ent/app"
+	"base-website/ent/auditlog"
 	"base-website/ent/authcode"
+	"base-website/ent/authrefreshtoken"
+	"base-website/ent/authrequest"
+	"base-website/ent/authtoken"
 	"base-website/ent/consent"
+	"base-website/ent/deviceauthorization"
 	"base-website/ent/invitation"
 	"base-website/ent/notification"
+	"base-website/ent/rolegrant"
+	"base-website/ent/sanction"
 	"base-website/ent/schema"
+	"base-website/ent/signingkey"
 	"base-website/ent/team"
 	"base-website/ent/teammember"
 	"base-website/ent/tournament"
 	"base-website/ent/user"
 	"base-website/ent/uservote"
 	"base-website/ent/vote"
+	"base-website/ent/votetemplate"
 	"time"
 )
 
@@ -38,12 +47,60 @@ func init() {
 	appDescRoles := appFields[10].Descriptor()
 	// app.DefaultRoles holds the default value on creation for the roles field.
 	app.DefaultRoles = appDescRoles.Default.([]string)
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[0].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	// auditlogDescAction is the schema descriptor for action field.
+	auditlogDescAction := auditlogFields[1].Descriptor()
+	// auditlog.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditlog.ActionValidator = auditlogDescAction.Validators[0].(func(string) error)
+	// auditlogDescTargetType is the schema descriptor for target_type field.
+	auditlogDescTargetType := auditlogFields[2].Descriptor()
+	// auditlog.TargetTypeValidator is a validator for the "target_type" field. It is called by the builders before save.
+	auditlog.TargetTypeValidator = auditlogDescTargetType.Validators[0].(func(string) error)
+	// auditlogDescTargetID is the schema descriptor for target_id field.
+	auditlogDescTargetID := auditlogFields[3].Descriptor()
+	// auditlog.TargetIDValidator is a validator for the "target_id" field. It is called by the builders before save.
+	auditlog.TargetIDValidator = auditlogDescTargetID.Validators[0].(func(string) error)
 	authcodeFields := schema.AuthCode{}.Fields()
 	_ = authcodeFields
 	// authcodeDescExpiration is the schema descriptor for expiration field.
 	authcodeDescExpiration := authcodeFields[2].Descriptor()
 	// authcode.DefaultExpiration holds the default value on creation for the expiration field.
 	authcode.DefaultExpiration = authcodeDescExpiration.Default.(func() time.Time)
+	authrefreshtokenFields := schema.AuthRefreshToken{}.Fields()
+	_ = authrefreshtokenFields
+	// authrefreshtokenDescCreatedAt is the schema descriptor for created_at field.
+	authrefreshtokenDescCreatedAt := authrefreshtokenFields[10].Descriptor()
+	// authrefreshtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	authrefreshtoken.DefaultCreatedAt = authrefreshtokenDescCreatedAt.Default.(func() time.Time)
+	// authrefreshtokenDescUsed is the schema descriptor for used field.
+	authrefreshtokenDescUsed := authrefreshtokenFields[12].Descriptor()
+	// authrefreshtoken.DefaultUsed holds the default value on creation for the used field.
+	authrefreshtoken.DefaultUsed = authrefreshtokenDescUsed.Default.(bool)
+	authrequestFields := schema.AuthRequest{}.Fields()
+	_ = authrequestFields
+	// authrequestDescDone is the schema descriptor for done field.
+	authrequestDescDone := authrequestFields[14].Descriptor()
+	// authrequest.DefaultDone holds the default value on creation for the done field.
+	authrequest.DefaultDone = authrequestDescDone.Default.(bool)
+	// authrequestDescCreatedAt is the schema descriptor for created_at field.
+	authrequestDescCreatedAt := authrequestFields[17].Descriptor()
+	// authrequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	authrequest.DefaultCreatedAt = authrequestDescCreatedAt.Default.(func() time.Time)
+	// authrequestDescExpiration is the schema descriptor for expiration field.
+	authrequestDescExpiration := authrequestFields[18].Descriptor()
+	// authrequest.DefaultExpiration holds the default value on creation for the expiration field.
+	authrequest.DefaultExpiration = authrequestDescExpiration.Default.(func() time.Time)
+	authtokenFields := schema.AuthToken{}.Fields()
+	_ = authtokenFields
+	// authtokenDescCreatedAt is the schema descriptor for created_at field.
+	authtokenDescCreatedAt := authtokenFields[7].Descriptor()
+	// authtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	authtoken.DefaultCreatedAt = authtokenDescCreatedAt.Default.(func() time.Time)
 	consentFields := schema.Consent{}.Fields()
 	_ = consentFields
 	// consentDescCreatedAt is the schema descriptor for created_at field.
@@ -56,6 +113,20 @@ func init() {
 	consent.DefaultUpdatedAt = consentDescUpdatedAt.Default.(func() time.Time)
 	// consent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	consent.UpdateDefaultUpdatedAt = consentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	deviceauthorizationFields := schema.DeviceAuthorization{}.Fields()
+	_ = deviceauthorizationFields
+	// deviceauthorizationDescDone is the schema descriptor for done field.
+	deviceauthorizationDescDone := deviceauthorizationFields[4].Descriptor()
+	// deviceauthorization.DefaultDone holds the default value on creation for the done field.
+	deviceauthorization.DefaultDone = deviceauthorizationDescDone.Default.(bool)
+	// deviceauthorizationDescDenied is the schema descriptor for denied field.
+	deviceauthorizationDescDenied := deviceauthorizationFields[5].Descriptor()
+	// deviceauthorization.DefaultDenied holds the default value on creation for the denied field.
+	deviceauthorization.DefaultDenied = deviceauthorizationDescDenied.Default.(bool)
+	// deviceauthorizationDescCreatedAt is the schema descriptor for created_at field.
+	deviceauthorizationDescCreatedAt := deviceauthorizationFields[9].Descriptor()
+	// deviceauthorization.DefaultCreatedAt holds the default value on creation for the created_at field.
+	deviceauthorization.DefaultCreatedAt = deviceauthorizationDescCreatedAt.Default.(func() time.Time)
 	invitationFields := schema.Invitation{}.Fields()
 	_ = invitationFields
 	// invitationDescCreatedAt is the schema descriptor for created_at field.
@@ -80,6 +151,32 @@ func init() {
 	notificationDescRead := notificationFields[5].Descriptor()
 	// notification.DefaultRead holds the default value on creation for the read field.
 	notification.DefaultRead = notificationDescRead.Default.(bool)
+	rolegrantFields := schema.RoleGrant{}.Fields()
+	_ = rolegrantFields
+	// rolegrantDescRole is the schema descriptor for role field.
+	rolegrantDescRole := rolegrantFields[0].Descriptor()
+	// rolegrant.RoleValidator is a validator for the "role" field. It is called by the builders before save.
+	rolegrant.RoleValidator = rolegrantDescRole.Validators[0].(func(string) error)
+	// rolegrantDescCreatedAt is the schema descriptor for created_at field.
+	rolegrantDescCreatedAt := rolegrantFields[3].Descriptor()
+	// rolegrant.DefaultCreatedAt holds the default value on creation for the created_at field.
+	rolegrant.DefaultCreatedAt = rolegrantDescCreatedAt.Default.(func() time.Time)
+	sanctionFields := schema.Sanction{}.Fields()
+	_ = sanctionFields
+	// sanctionDescReason is the schema descriptor for reason field.
+	sanctionDescReason := sanctionFields[1].Descriptor()
+	// sanction.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	sanction.ReasonValidator = sanctionDescReason.Validators[0].(func(string) error)
+	// sanctionDescCreatedAt is the schema descriptor for created_at field.
+	sanctionDescCreatedAt := sanctionFields[3].Descriptor()
+	// sanction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sanction.DefaultCreatedAt = sanctionDescCreatedAt.Default.(func() time.Time)
+	signingkeyFields := schema.SigningKey{}.Fields()
+	_ = signingkeyFields
+	// signingkeyDescCreatedAt is the schema descriptor for created_at field.
+	signingkeyDescCreatedAt := signingkeyFields[3].Descriptor()
+	// signingkey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	signingkey.DefaultCreatedAt = signingkeyDescCreatedAt.Default.(func() time.Time)
 	teamFields := schema.Team{}.Fields()
 	_ = teamFields
 	// teamDescIsLocked is the schema descriptor for is_locked field.
@@ -164,14 +261,38 @@ func init() {
 	voteDescVisible := voteFields[2].Descriptor()
 	// vote.DefaultVisible holds the default value on creation for the visible field.
 	vote.DefaultVisible = voteDescVisible.Default.(bool)
+	// voteDescAnonymous is the schema descriptor for anonymous field.
+	voteDescAnonymous := voteFields[3].Descriptor()
+	// vote.DefaultAnonymous holds the default value on creation for the anonymous field.
+	vote.DefaultAnonymous = voteDescAnonymous.Default.(bool)
 	// voteDescCreatedAt is the schema descriptor for created_at field.
-	voteDescCreatedAt := voteFields[5].Descriptor()
+	voteDescCreatedAt := voteFields[8].Descriptor()
 	// vote.DefaultCreatedAt holds the default value on creation for the created_at field.
 	vote.DefaultCreatedAt = voteDescCreatedAt.Default.(func() time.Time)
 	// voteDescUpdatedAt is the schema descriptor for updated_at field.
-	voteDescUpdatedAt := voteFields[6].Descriptor()
+	voteDescUpdatedAt := voteFields[9].Descriptor()
 	// vote.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	vote.DefaultUpdatedAt = voteDescUpdatedAt.Default.(func() time.Time)
 	// vote.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	vote.UpdateDefaultUpdatedAt = voteDescUpdatedAt.UpdateDefault.(func() time.Time)
+	votetemplateFields := schema.VoteTemplate{}.Fields()
+	_ = votetemplateFields
+	// votetemplateDescAnonymous is the schema descriptor for anonymous field.
+	votetemplateDescAnonymous := votetemplateFields[3].Descriptor()
+	// votetemplate.DefaultAnonymous holds the default value on creation for the anonymous field.
+	votetemplate.DefaultAnonymous = votetemplateDescAnonymous.Default.(bool)
+	// votetemplateDescDurationMinutes is the schema descriptor for duration_minutes field.
+	votetemplateDescDurationMinutes := votetemplateFields[4].Descriptor()
+	// votetemplate.DurationMinutesValidator is a validator for the "duration_minutes" field. It is called by the builders before save.
+	votetemplate.DurationMinutesValidator = votetemplateDescDurationMinutes.Validators[0].(func(int) error)
+	// votetemplateDescCreatedAt is the schema descriptor for created_at field.
+	votetemplateDescCreatedAt := votetemplateFields[7].Descriptor()
+	// votetemplate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	votetemplate.DefaultCreatedAt = votetemplateDescCreatedAt.Default.(func() time.Time)
+	// votetemplateDescUpdatedAt is the schema descriptor for updated_at field.
+	votetemplateDescUpdatedAt := votetemplateFields[8].Descriptor()
+	// votetemplate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	votetemplate.DefaultUpdatedAt = votetemplateDescUpdatedAt.Default.(func() time.Time)
+	// votetemplate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	votetemplate.UpdateDefaultUpdatedAt = votetemplateDescUpdatedAt.UpdateDefault.(func() time.Time)
 }
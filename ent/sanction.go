@@ -0,0 +1,291 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"base-website/ent/sanction"
+	"base-website/ent/tournament"
+	"base-website/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Sanction is the model entity for the Sanction schema.
+type Sanction struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type sanction.Type `json:"type,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// LiftedAt holds the value of the "lifted_at" field.
+	LiftedAt *time.Time `json:"lifted_at,omitempty"`
+	// LiftReason holds the value of the "lift_reason" field.
+	LiftReason string `json:"lift_reason,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SanctionQuery when eager-loading is set.
+	Edges                 SanctionEdges `json:"edges"`
+	tournament_sanctions  *int
+	user_sanctions        *int
+	user_issued_sanctions *int
+	user_lifted_sanctions *int
+	selectValues          sql.SelectValues
+}
+
+// SanctionEdges holds the relations/edges for other nodes in the graph.
+type SanctionEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Issuer holds the value of the issuer edge.
+	Issuer *User `json:"issuer,omitempty"`
+	// LiftedBy holds the value of the lifted_by edge.
+	LiftedBy *User `json:"lifted_by,omitempty"`
+	// Tournament holds the value of the tournament edge.
+	Tournament *Tournament `json:"tournament,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SanctionEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// IssuerOrErr returns the Issuer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SanctionEdges) IssuerOrErr() (*User, error) {
+	if e.Issuer != nil {
+		return e.Issuer, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "issuer"}
+}
+
+// LiftedByOrErr returns the LiftedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SanctionEdges) LiftedByOrErr() (*User, error) {
+	if e.LiftedBy != nil {
+		return e.LiftedBy, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "lifted_by"}
+}
+
+// TournamentOrErr returns the Tournament value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SanctionEdges) TournamentOrErr() (*Tournament, error) {
+	if e.Tournament != nil {
+		return e.Tournament, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: tournament.Label}
+	}
+	return nil, &NotLoadedError{edge: "tournament"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Sanction) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sanction.FieldID:
+			values[i] = new(sql.NullInt64)
+		case sanction.FieldType, sanction.FieldReason, sanction.FieldLiftReason:
+			values[i] = new(sql.NullString)
+		case sanction.FieldExpiresAt, sanction.FieldCreatedAt, sanction.FieldLiftedAt:
+			values[i] = new(sql.NullTime)
+		case sanction.ForeignKeys[0]: // tournament_sanctions
+			values[i] = new(sql.NullInt64)
+		case sanction.ForeignKeys[1]: // user_sanctions
+			values[i] = new(sql.NullInt64)
+		case sanction.ForeignKeys[2]: // user_issued_sanctions
+			values[i] = new(sql.NullInt64)
+		case sanction.ForeignKeys[3]: // user_lifted_sanctions
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Sanction fields.
+func (_m *Sanction) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sanction.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case sanction.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = sanction.Type(value.String)
+			}
+		case sanction.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case sanction.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = new(time.Time)
+				*_m.ExpiresAt = value.Time
+			}
+		case sanction.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case sanction.FieldLiftedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field lifted_at", values[i])
+			} else if value.Valid {
+				_m.LiftedAt = new(time.Time)
+				*_m.LiftedAt = value.Time
+			}
+		case sanction.FieldLiftReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field lift_reason", values[i])
+			} else if value.Valid {
+				_m.LiftReason = value.String
+			}
+		case sanction.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tournament_sanctions", value)
+			} else if value.Valid {
+				_m.tournament_sanctions = new(int)
+				*_m.tournament_sanctions = int(value.Int64)
+			}
+		case sanction.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_sanctions", value)
+			} else if value.Valid {
+				_m.user_sanctions = new(int)
+				*_m.user_sanctions = int(value.Int64)
+			}
+		case sanction.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_issued_sanctions", value)
+			} else if value.Valid {
+				_m.user_issued_sanctions = new(int)
+				*_m.user_issued_sanctions = int(value.Int64)
+			}
+		case sanction.ForeignKeys[3]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_lifted_sanctions", value)
+			} else if value.Valid {
+				_m.user_lifted_sanctions = new(int)
+				*_m.user_lifted_sanctions = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Sanction.
+// This includes values selected through modifiers, order, etc.
+func (_m *Sanction) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Sanction entity.
+func (_m *Sanction) QueryUser() *UserQuery {
+	return NewSanctionClient(_m.config).QueryUser(_m)
+}
+
+// QueryIssuer queries the "issuer" edge of the Sanction entity.
+func (_m *Sanction) QueryIssuer() *UserQuery {
+	return NewSanctionClient(_m.config).QueryIssuer(_m)
+}
+
+// QueryLiftedBy queries the "lifted_by" edge of the Sanction entity.
+func (_m *Sanction) QueryLiftedBy() *UserQuery {
+	return NewSanctionClient(_m.config).QueryLiftedBy(_m)
+}
+
+// QueryTournament queries the "tournament" edge of the Sanction entity.
+func (_m *Sanction) QueryTournament() *TournamentQuery {
+	return NewSanctionClient(_m.config).QueryTournament(_m)
+}
+
+// Update returns a builder for updating this Sanction.
+// Note that you need to call Sanction.Unwrap() before calling this method if this Sanction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Sanction) Update() *SanctionUpdateOne {
+	return NewSanctionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Sanction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Sanction) Unwrap() *Sanction {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Sanction is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Sanction) String() string {
+	var builder strings.Builder
+	builder.WriteString("Sanction(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Type))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	if v := _m.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.LiftedAt; v != nil {
+		builder.WriteString("lifted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("lift_reason=")
+	builder.WriteString(_m.LiftReason)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Sanctions is a parsable slice of Sanction.
+type Sanctions []*Sanction
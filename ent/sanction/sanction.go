@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sanction
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the sanction type in the database.
+	Label = "sanction"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldLiftedAt holds the string denoting the lifted_at field in the database.
+	FieldLiftedAt = "lifted_at"
+	// FieldLiftReason holds the string denoting the lift_reason field in the database.
+	FieldLiftReason = "lift_reason"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeIssuer holds the string denoting the issuer edge name in mutations.
+	EdgeIssuer = "issuer"
+	// EdgeLiftedBy holds the string denoting the lifted_by edge name in mutations.
+	EdgeLiftedBy = "lifted_by"
+	// EdgeTournament holds the string denoting the tournament edge name in mutations.
+	EdgeTournament = "tournament"
+	// Table holds the table name of the sanction in the database.
+	Table = "sanctions"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "sanctions"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_sanctions"
+	// IssuerTable is the table that holds the issuer relation/edge.
+	IssuerTable = "sanctions"
+	// IssuerInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	IssuerInverseTable = "users"
+	// IssuerColumn is the table column denoting the issuer relation/edge.
+	IssuerColumn = "user_issued_sanctions"
+	// LiftedByTable is the table that holds the lifted_by relation/edge.
+	LiftedByTable = "sanctions"
+	// LiftedByInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	LiftedByInverseTable = "users"
+	// LiftedByColumn is the table column denoting the lifted_by relation/edge.
+	LiftedByColumn = "user_lifted_sanctions"
+	// TournamentTable is the table that holds the tournament relation/edge.
+	TournamentTable = "sanctions"
+	// TournamentInverseTable is the table name for the Tournament entity.
+	// It exists in this package in order to avoid circular dependency with the "tournament" package.
+	TournamentInverseTable = "tournaments"
+	// TournamentColumn is the table column denoting the tournament relation/edge.
+	TournamentColumn = "tournament_sanctions"
+)
+
+// Columns holds all SQL columns for sanction fields.
+var Columns = []string{
+	FieldID,
+	FieldType,
+	FieldReason,
+	FieldExpiresAt,
+	FieldCreatedAt,
+	FieldLiftedAt,
+	FieldLiftReason,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "sanctions"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"tournament_sanctions",
+	"user_sanctions",
+	"user_issued_sanctions",
+	"user_lifted_sanctions",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	ReasonValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// Type values.
+const (
+	TypeGlobalBan     Type = "global_ban"
+	TypeTournamentBan Type = "tournament_ban"
+	TypeVoteBan       Type = "vote_ban"
+	TypeWarning       Type = "warning"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeGlobalBan, TypeTournamentBan, TypeVoteBan, TypeWarning:
+		return nil
+	default:
+		return fmt.Errorf("sanction: invalid enum value for type field: %q", _type)
+	}
+}
+
+// OrderOption defines the ordering options for the Sanction queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByLiftedAt orders the results by the lifted_at field.
+func ByLiftedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLiftedAt, opts...).ToFunc()
+}
+
+// ByLiftReason orders the results by the lift_reason field.
+func ByLiftReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLiftReason, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByIssuerField orders the results by issuer field.
+func ByIssuerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIssuerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByLiftedByField orders the results by lifted_by field.
+func ByLiftedByField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLiftedByStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTournamentField orders the results by tournament field.
+func ByTournamentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTournamentStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
+func newIssuerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IssuerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, IssuerTable, IssuerColumn),
+	)
+}
+func newLiftedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LiftedByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, LiftedByTable, LiftedByColumn),
+	)
+}
+func newTournamentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TournamentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TournamentTable, TournamentColumn),
+	)
+}
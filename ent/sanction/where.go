@@ -0,0 +1,488 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sanction
+
+import (
+	"base-website/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLTE(FieldID, id))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldReason, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// LiftedAt applies equality check predicate on the "lifted_at" field. It's identical to LiftedAtEQ.
+func LiftedAt(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldLiftedAt, v))
+}
+
+// LiftReason applies equality check predicate on the "lift_reason" field. It's identical to LiftReasonEQ.
+func LiftReason(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldLiftReason, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.Sanction {
+	return predicate.Sanction(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotIn(FieldType, vs...))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldContainsFold(FieldReason, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.Sanction {
+	return predicate.Sanction(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// LiftedAtEQ applies the EQ predicate on the "lifted_at" field.
+func LiftedAtEQ(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldLiftedAt, v))
+}
+
+// LiftedAtNEQ applies the NEQ predicate on the "lifted_at" field.
+func LiftedAtNEQ(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNEQ(FieldLiftedAt, v))
+}
+
+// LiftedAtIn applies the In predicate on the "lifted_at" field.
+func LiftedAtIn(vs ...time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldIn(FieldLiftedAt, vs...))
+}
+
+// LiftedAtNotIn applies the NotIn predicate on the "lifted_at" field.
+func LiftedAtNotIn(vs ...time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotIn(FieldLiftedAt, vs...))
+}
+
+// LiftedAtGT applies the GT predicate on the "lifted_at" field.
+func LiftedAtGT(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGT(FieldLiftedAt, v))
+}
+
+// LiftedAtGTE applies the GTE predicate on the "lifted_at" field.
+func LiftedAtGTE(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGTE(FieldLiftedAt, v))
+}
+
+// LiftedAtLT applies the LT predicate on the "lifted_at" field.
+func LiftedAtLT(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLT(FieldLiftedAt, v))
+}
+
+// LiftedAtLTE applies the LTE predicate on the "lifted_at" field.
+func LiftedAtLTE(v time.Time) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLTE(FieldLiftedAt, v))
+}
+
+// LiftedAtIsNil applies the IsNil predicate on the "lifted_at" field.
+func LiftedAtIsNil() predicate.Sanction {
+	return predicate.Sanction(sql.FieldIsNull(FieldLiftedAt))
+}
+
+// LiftedAtNotNil applies the NotNil predicate on the "lifted_at" field.
+func LiftedAtNotNil() predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotNull(FieldLiftedAt))
+}
+
+// LiftReasonEQ applies the EQ predicate on the "lift_reason" field.
+func LiftReasonEQ(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEQ(FieldLiftReason, v))
+}
+
+// LiftReasonNEQ applies the NEQ predicate on the "lift_reason" field.
+func LiftReasonNEQ(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNEQ(FieldLiftReason, v))
+}
+
+// LiftReasonIn applies the In predicate on the "lift_reason" field.
+func LiftReasonIn(vs ...string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldIn(FieldLiftReason, vs...))
+}
+
+// LiftReasonNotIn applies the NotIn predicate on the "lift_reason" field.
+func LiftReasonNotIn(vs ...string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotIn(FieldLiftReason, vs...))
+}
+
+// LiftReasonGT applies the GT predicate on the "lift_reason" field.
+func LiftReasonGT(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGT(FieldLiftReason, v))
+}
+
+// LiftReasonGTE applies the GTE predicate on the "lift_reason" field.
+func LiftReasonGTE(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldGTE(FieldLiftReason, v))
+}
+
+// LiftReasonLT applies the LT predicate on the "lift_reason" field.
+func LiftReasonLT(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLT(FieldLiftReason, v))
+}
+
+// LiftReasonLTE applies the LTE predicate on the "lift_reason" field.
+func LiftReasonLTE(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldLTE(FieldLiftReason, v))
+}
+
+// LiftReasonContains applies the Contains predicate on the "lift_reason" field.
+func LiftReasonContains(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldContains(FieldLiftReason, v))
+}
+
+// LiftReasonHasPrefix applies the HasPrefix predicate on the "lift_reason" field.
+func LiftReasonHasPrefix(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldHasPrefix(FieldLiftReason, v))
+}
+
+// LiftReasonHasSuffix applies the HasSuffix predicate on the "lift_reason" field.
+func LiftReasonHasSuffix(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldHasSuffix(FieldLiftReason, v))
+}
+
+// LiftReasonIsNil applies the IsNil predicate on the "lift_reason" field.
+func LiftReasonIsNil() predicate.Sanction {
+	return predicate.Sanction(sql.FieldIsNull(FieldLiftReason))
+}
+
+// LiftReasonNotNil applies the NotNil predicate on the "lift_reason" field.
+func LiftReasonNotNil() predicate.Sanction {
+	return predicate.Sanction(sql.FieldNotNull(FieldLiftReason))
+}
+
+// LiftReasonEqualFold applies the EqualFold predicate on the "lift_reason" field.
+func LiftReasonEqualFold(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldEqualFold(FieldLiftReason, v))
+}
+
+// LiftReasonContainsFold applies the ContainsFold predicate on the "lift_reason" field.
+func LiftReasonContainsFold(v string) predicate.Sanction {
+	return predicate.Sanction(sql.FieldContainsFold(FieldLiftReason, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Sanction {
+	return predicate.Sanction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Sanction {
+	return predicate.Sanction(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasIssuer applies the HasEdge predicate on the "issuer" edge.
+func HasIssuer() predicate.Sanction {
+	return predicate.Sanction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, IssuerTable, IssuerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIssuerWith applies the HasEdge predicate on the "issuer" edge with a given conditions (other predicates).
+func HasIssuerWith(preds ...predicate.User) predicate.Sanction {
+	return predicate.Sanction(func(s *sql.Selector) {
+		step := newIssuerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLiftedBy applies the HasEdge predicate on the "lifted_by" edge.
+func HasLiftedBy() predicate.Sanction {
+	return predicate.Sanction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LiftedByTable, LiftedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLiftedByWith applies the HasEdge predicate on the "lifted_by" edge with a given conditions (other predicates).
+func HasLiftedByWith(preds ...predicate.User) predicate.Sanction {
+	return predicate.Sanction(func(s *sql.Selector) {
+		step := newLiftedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTournament applies the HasEdge predicate on the "tournament" edge.
+func HasTournament() predicate.Sanction {
+	return predicate.Sanction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TournamentTable, TournamentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTournamentWith applies the HasEdge predicate on the "tournament" edge with a given conditions (other predicates).
+func HasTournamentWith(preds ...predicate.Tournament) predicate.Sanction {
+	return predicate.Sanction(func(s *sql.Selector) {
+		step := newTournamentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Sanction) predicate.Sanction {
+	return predicate.Sanction(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Sanction) predicate.Sanction {
+	return predicate.Sanction(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Sanction) predicate.Sanction {
+	return predicate.Sanction(sql.NotPredicates(p))
+}
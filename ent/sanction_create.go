@@ -0,0 +1,433 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"base-website/ent/sanction"
+	"base-website/ent/tournament"
+	"base-website/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SanctionCreate is the builder for creating a Sanction entity.
+type SanctionCreate struct {
+	config
+	mutation *SanctionMutation
+	hooks    []Hook
+}
+
+// SetType sets the "type" field.
+func (_c *SanctionCreate) SetType(v sanction.Type) *SanctionCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *SanctionCreate) SetReason(v string) *SanctionCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *SanctionCreate) SetExpiresAt(v time.Time) *SanctionCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *SanctionCreate) SetNillableExpiresAt(v *time.Time) *SanctionCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SanctionCreate) SetCreatedAt(v time.Time) *SanctionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SanctionCreate) SetNillableCreatedAt(v *time.Time) *SanctionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetLiftedAt sets the "lifted_at" field.
+func (_c *SanctionCreate) SetLiftedAt(v time.Time) *SanctionCreate {
+	_c.mutation.SetLiftedAt(v)
+	return _c
+}
+
+// SetNillableLiftedAt sets the "lifted_at" field if the given value is not nil.
+func (_c *SanctionCreate) SetNillableLiftedAt(v *time.Time) *SanctionCreate {
+	if v != nil {
+		_c.SetLiftedAt(*v)
+	}
+	return _c
+}
+
+// SetLiftReason sets the "lift_reason" field.
+func (_c *SanctionCreate) SetLiftReason(v string) *SanctionCreate {
+	_c.mutation.SetLiftReason(v)
+	return _c
+}
+
+// SetNillableLiftReason sets the "lift_reason" field if the given value is not nil.
+func (_c *SanctionCreate) SetNillableLiftReason(v *string) *SanctionCreate {
+	if v != nil {
+		_c.SetLiftReason(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *SanctionCreate) SetUserID(id int) *SanctionCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *SanctionCreate) SetUser(v *User) *SanctionCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetIssuerID sets the "issuer" edge to the User entity by ID.
+func (_c *SanctionCreate) SetIssuerID(id int) *SanctionCreate {
+	_c.mutation.SetIssuerID(id)
+	return _c
+}
+
+// SetNillableIssuerID sets the "issuer" edge to the User entity by ID if the given value is not nil.
+func (_c *SanctionCreate) SetNillableIssuerID(id *int) *SanctionCreate {
+	if id != nil {
+		_c = _c.SetIssuerID(*id)
+	}
+	return _c
+}
+
+// SetIssuer sets the "issuer" edge to the User entity.
+func (_c *SanctionCreate) SetIssuer(v *User) *SanctionCreate {
+	return _c.SetIssuerID(v.ID)
+}
+
+// SetLiftedByID sets the "lifted_by" edge to the User entity by ID.
+func (_c *SanctionCreate) SetLiftedByID(id int) *SanctionCreate {
+	_c.mutation.SetLiftedByID(id)
+	return _c
+}
+
+// SetNillableLiftedByID sets the "lifted_by" edge to the User entity by ID if the given value is not nil.
+func (_c *SanctionCreate) SetNillableLiftedByID(id *int) *SanctionCreate {
+	if id != nil {
+		_c = _c.SetLiftedByID(*id)
+	}
+	return _c
+}
+
+// SetLiftedBy sets the "lifted_by" edge to the User entity.
+func (_c *SanctionCreate) SetLiftedBy(v *User) *SanctionCreate {
+	return _c.SetLiftedByID(v.ID)
+}
+
+// SetTournamentID sets the "tournament" edge to the Tournament entity by ID.
+func (_c *SanctionCreate) SetTournamentID(id int) *SanctionCreate {
+	_c.mutation.SetTournamentID(id)
+	return _c
+}
+
+// SetNillableTournamentID sets the "tournament" edge to the Tournament entity by ID if the given value is not nil.
+func (_c *SanctionCreate) SetNillableTournamentID(id *int) *SanctionCreate {
+	if id != nil {
+		_c = _c.SetTournamentID(*id)
+	}
+	return _c
+}
+
+// SetTournament sets the "tournament" edge to the Tournament entity.
+func (_c *SanctionCreate) SetTournament(v *Tournament) *SanctionCreate {
+	return _c.SetTournamentID(v.ID)
+}
+
+// Mutation returns the SanctionMutation object of the builder.
+func (_c *SanctionCreate) Mutation() *SanctionMutation {
+	return _c.mutation
+}
+
+// Save creates the Sanction in the database.
+func (_c *SanctionCreate) Save(ctx context.Context) (*Sanction, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SanctionCreate) SaveX(ctx context.Context) *Sanction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SanctionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SanctionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SanctionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := sanction.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SanctionCreate) check() error {
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Sanction.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := sanction.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Sanction.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "Sanction.reason"`)}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := sanction.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Sanction.reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Sanction.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Sanction.user"`)}
+	}
+	return nil
+}
+
+func (_c *SanctionCreate) sqlSave(ctx context.Context) (*Sanction, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SanctionCreate) createSpec() (*Sanction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Sanction{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(sanction.Table, sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(sanction.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(sanction.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(sanction.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(sanction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.LiftedAt(); ok {
+		_spec.SetField(sanction.FieldLiftedAt, field.TypeTime, value)
+		_node.LiftedAt = &value
+	}
+	if value, ok := _c.mutation.LiftReason(); ok {
+		_spec.SetField(sanction.FieldLiftReason, field.TypeString, value)
+		_node.LiftReason = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sanction.UserTable,
+			Columns: []string{sanction.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_sanctions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.IssuerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sanction.IssuerTable,
+			Columns: []string{sanction.IssuerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_issued_sanctions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LiftedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sanction.LiftedByTable,
+			Columns: []string{sanction.LiftedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_lifted_sanctions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TournamentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sanction.TournamentTable,
+			Columns: []string{sanction.TournamentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournament.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tournament_sanctions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SanctionCreateBulk is the builder for creating many Sanction entities in bulk.
+type SanctionCreateBulk struct {
+	config
+	err      error
+	builders []*SanctionCreate
+}
+
+// Save creates the Sanction entities in the database.
+func (_c *SanctionCreateBulk) Save(ctx context.Context) ([]*Sanction, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Sanction, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SanctionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SanctionCreateBulk) SaveX(ctx context.Context) []*Sanction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SanctionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SanctionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
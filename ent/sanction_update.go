@@ -0,0 +1,523 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"base-website/ent/predicate"
+	"base-website/ent/sanction"
+	"base-website/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SanctionUpdate is the builder for updating Sanction entities.
+type SanctionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SanctionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SanctionUpdate builder.
+func (_u *SanctionUpdate) Where(ps ...predicate.Sanction) *SanctionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *SanctionUpdate) SetReason(v string) *SanctionUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *SanctionUpdate) SetNillableReason(v *string) *SanctionUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *SanctionUpdate) SetExpiresAt(v time.Time) *SanctionUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *SanctionUpdate) SetNillableExpiresAt(v *time.Time) *SanctionUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *SanctionUpdate) ClearExpiresAt() *SanctionUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetLiftedAt sets the "lifted_at" field.
+func (_u *SanctionUpdate) SetLiftedAt(v time.Time) *SanctionUpdate {
+	_u.mutation.SetLiftedAt(v)
+	return _u
+}
+
+// SetNillableLiftedAt sets the "lifted_at" field if the given value is not nil.
+func (_u *SanctionUpdate) SetNillableLiftedAt(v *time.Time) *SanctionUpdate {
+	if v != nil {
+		_u.SetLiftedAt(*v)
+	}
+	return _u
+}
+
+// ClearLiftedAt clears the value of the "lifted_at" field.
+func (_u *SanctionUpdate) ClearLiftedAt() *SanctionUpdate {
+	_u.mutation.ClearLiftedAt()
+	return _u
+}
+
+// SetLiftReason sets the "lift_reason" field.
+func (_u *SanctionUpdate) SetLiftReason(v string) *SanctionUpdate {
+	_u.mutation.SetLiftReason(v)
+	return _u
+}
+
+// SetNillableLiftReason sets the "lift_reason" field if the given value is not nil.
+func (_u *SanctionUpdate) SetNillableLiftReason(v *string) *SanctionUpdate {
+	if v != nil {
+		_u.SetLiftReason(*v)
+	}
+	return _u
+}
+
+// ClearLiftReason clears the value of the "lift_reason" field.
+func (_u *SanctionUpdate) ClearLiftReason() *SanctionUpdate {
+	_u.mutation.ClearLiftReason()
+	return _u
+}
+
+// SetLiftedByID sets the "lifted_by" edge to the User entity by ID.
+func (_u *SanctionUpdate) SetLiftedByID(id int) *SanctionUpdate {
+	_u.mutation.SetLiftedByID(id)
+	return _u
+}
+
+// SetNillableLiftedByID sets the "lifted_by" edge to the User entity by ID if the given value is not nil.
+func (_u *SanctionUpdate) SetNillableLiftedByID(id *int) *SanctionUpdate {
+	if id != nil {
+		_u = _u.SetLiftedByID(*id)
+	}
+	return _u
+}
+
+// SetLiftedBy sets the "lifted_by" edge to the User entity.
+func (_u *SanctionUpdate) SetLiftedBy(v *User) *SanctionUpdate {
+	return _u.SetLiftedByID(v.ID)
+}
+
+// Mutation returns the SanctionMutation object of the builder.
+func (_u *SanctionUpdate) Mutation() *SanctionMutation {
+	return _u.mutation
+}
+
+// ClearLiftedBy clears the "lifted_by" edge to the User entity.
+func (_u *SanctionUpdate) ClearLiftedBy() *SanctionUpdate {
+	_u.mutation.ClearLiftedBy()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SanctionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SanctionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SanctionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SanctionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SanctionUpdate) check() error {
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := sanction.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Sanction.reason": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Sanction.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SanctionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SanctionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SanctionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sanction.Table, sanction.Columns, sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(sanction.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(sanction.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(sanction.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LiftedAt(); ok {
+		_spec.SetField(sanction.FieldLiftedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LiftedAtCleared() {
+		_spec.ClearField(sanction.FieldLiftedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LiftReason(); ok {
+		_spec.SetField(sanction.FieldLiftReason, field.TypeString, value)
+	}
+	if _u.mutation.LiftReasonCleared() {
+		_spec.ClearField(sanction.FieldLiftReason, field.TypeString)
+	}
+	if _u.mutation.LiftedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sanction.LiftedByTable,
+			Columns: []string{sanction.LiftedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LiftedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sanction.LiftedByTable,
+			Columns: []string{sanction.LiftedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sanction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SanctionUpdateOne is the builder for updating a single Sanction entity.
+type SanctionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SanctionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetReason sets the "reason" field.
+func (_u *SanctionUpdateOne) SetReason(v string) *SanctionUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *SanctionUpdateOne) SetNillableReason(v *string) *SanctionUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *SanctionUpdateOne) SetExpiresAt(v time.Time) *SanctionUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *SanctionUpdateOne) SetNillableExpiresAt(v *time.Time) *SanctionUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *SanctionUpdateOne) ClearExpiresAt() *SanctionUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetLiftedAt sets the "lifted_at" field.
+func (_u *SanctionUpdateOne) SetLiftedAt(v time.Time) *SanctionUpdateOne {
+	_u.mutation.SetLiftedAt(v)
+	return _u
+}
+
+// SetNillableLiftedAt sets the "lifted_at" field if the given value is not nil.
+func (_u *SanctionUpdateOne) SetNillableLiftedAt(v *time.Time) *SanctionUpdateOne {
+	if v != nil {
+		_u.SetLiftedAt(*v)
+	}
+	return _u
+}
+
+// ClearLiftedAt clears the value of the "lifted_at" field.
+func (_u *SanctionUpdateOne) ClearLiftedAt() *SanctionUpdateOne {
+	_u.mutation.ClearLiftedAt()
+	return _u
+}
+
+// SetLiftReason sets the "lift_reason" field.
+func (_u *SanctionUpdateOne) SetLiftReason(v string) *SanctionUpdateOne {
+	_u.mutation.SetLiftReason(v)
+	return _u
+}
+
+// SetNillableLiftReason sets the "lift_reason" field if the given value is not nil.
+func (_u *SanctionUpdateOne) SetNillableLiftReason(v *string) *SanctionUpdateOne {
+	if v != nil {
+		_u.SetLiftReason(*v)
+	}
+	return _u
+}
+
+// ClearLiftReason clears the value of the "lift_reason" field.
+func (_u *SanctionUpdateOne) ClearLiftReason() *SanctionUpdateOne {
+	_u.mutation.ClearLiftReason()
+	return _u
+}
+
+// SetLiftedByID sets the "lifted_by" edge to the User entity by ID.
+func (_u *SanctionUpdateOne) SetLiftedByID(id int) *SanctionUpdateOne {
+	_u.mutation.SetLiftedByID(id)
+	return _u
+}
+
+// SetNillableLiftedByID sets the "lifted_by" edge to the User entity by ID if the given value is not nil.
+func (_u *SanctionUpdateOne) SetNillableLiftedByID(id *int) *SanctionUpdateOne {
+	if id != nil {
+		_u = _u.SetLiftedByID(*id)
+	}
+	return _u
+}
+
+// SetLiftedBy sets the "lifted_by" edge to the User entity.
+func (_u *SanctionUpdateOne) SetLiftedBy(v *User) *SanctionUpdateOne {
+	return _u.SetLiftedByID(v.ID)
+}
+
+// Mutation returns the SanctionMutation object of the builder.
+func (_u *SanctionUpdateOne) Mutation() *SanctionMutation {
+	return _u.mutation
+}
+
+// ClearLiftedBy clears the "lifted_by" edge to the User entity.
+func (_u *SanctionUpdateOne) ClearLiftedBy() *SanctionUpdateOne {
+	_u.mutation.ClearLiftedBy()
+	return _u
+}
+
+// Where appends a list predicates to the SanctionUpdate builder.
+func (_u *SanctionUpdateOne) Where(ps ...predicate.Sanction) *SanctionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SanctionUpdateOne) Select(field string, fields ...string) *SanctionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Sanction entity.
+func (_u *SanctionUpdateOne) Save(ctx context.Context) (*Sanction, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SanctionUpdateOne) SaveX(ctx context.Context) *Sanction {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SanctionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SanctionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SanctionUpdateOne) check() error {
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := sanction.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Sanction.reason": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Sanction.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SanctionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SanctionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SanctionUpdateOne) sqlSave(ctx context.Context) (_node *Sanction, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sanction.Table, sanction.Columns, sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Sanction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sanction.FieldID)
+		for _, f := range fields {
+			if !sanction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sanction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(sanction.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(sanction.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(sanction.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LiftedAt(); ok {
+		_spec.SetField(sanction.FieldLiftedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LiftedAtCleared() {
+		_spec.ClearField(sanction.FieldLiftedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LiftReason(); ok {
+		_spec.SetField(sanction.FieldLiftReason, field.TypeString, value)
+	}
+	if _u.mutation.LiftReasonCleared() {
+		_spec.ClearField(sanction.FieldLiftReason, field.TypeString)
+	}
+	if _u.mutation.LiftedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sanction.LiftedByTable,
+			Columns: []string{sanction.LiftedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LiftedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sanction.LiftedByTable,
+			Columns: []string{sanction.LiftedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &Sanction{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sanction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -28,6 +28,9 @@ func (App) Fields() []ent.Field {
 		field.Time("last_login_at").Optional().Nillable(),
 		field.Int("owner_id"),
 		field.Strings("roles").Default([]string{}),
+		// Token lifetimes in seconds, the config defaults apply when unset
+		field.Int("access_token_lifetime").Optional().Nillable(),
+		field.Int("refresh_token_lifetime").Optional().Nillable(),
 	}
 }
 
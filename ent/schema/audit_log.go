@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type AuditLog struct {
+	ent.Schema
+}
+
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.String("action").
+			NotEmpty().
+			Immutable(),
+		field.String("target_type").
+			NotEmpty().
+			Immutable(),
+		field.String("target_id").
+			NotEmpty().
+			Immutable(),
+		// Only the fields changed by the action are kept
+		field.JSON("before", map[string]any{}).
+			Optional().
+			Immutable(),
+		field.JSON("after", map[string]any{}).
+			Optional().
+			Immutable(),
+		field.String("ip").
+			Optional().
+			Immutable(),
+		field.String("user_agent").
+			Optional().
+			Immutable(),
+	}
+}
+
+func (AuditLog) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("actor", User.Type).
+			Ref("audit_logs").
+			Unique().
+			Immutable(),
+		// impersonator is the super admin who did the action as the actor
+		edge.From("impersonator", User.Type).
+			Ref("impersonated_audit_logs").
+			Unique().
+			Immutable(),
+	}
+}
+
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("action"),
+		index.Fields("target_type", "target_id"),
+		index.Edges("actor"),
+	}
+}
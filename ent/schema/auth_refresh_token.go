@@ -1,8 +1,11 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // AuthRefreshToken holds the schema definition for the AuthRefreshToken entity.
@@ -23,9 +26,24 @@ func (AuthRefreshToken) Fields() []ent.Field {
 		field.String("application_id"),
 		field.Time("expiration"),
 		field.JSON("scopes", []string{}),
+		field.Time("created_at").Default(time.Now),
+		// Every refresh token rotated from the same login shares the family of the first one,
+		// it is a random ID distinct from the tokens since it is exposed as the session ID
+		field.String("family_id"),
+		// A used refresh token was rotated, presenting it again revokes the whole family
+		field.Bool("used").Default(false),
+		// The absolute end of the session, refreshing cannot go past it
+		field.Time("session_expiration"),
 	}
 }
 
 func (AuthRefreshToken) Edges() []ent.Edge {
 	return nil
 }
+
+// Indexes of the AuthRefreshToken.
+func (AuthRefreshToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("family_id"),
+	}
+}
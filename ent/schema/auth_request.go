@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// AuthRequest holds the schema definition for the AuthRequest entity.
+type AuthRequest struct {
+	ent.Schema
+}
+
+// Fields of the AuthRequest.
+func (AuthRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id"),
+		field.String("application_id"),
+		field.String("redirect_uri"),
+		field.String("state").Optional(),
+		field.String("nonce").Optional(),
+		field.JSON("scopes", []string{}),
+		field.JSON("prompt", []string{}).Optional(),
+		field.String("response_type"),
+		field.String("response_mode").Optional(),
+		field.String("code_challenge").Optional(),
+		field.String("code_challenge_method").Optional(),
+		field.String("login_hint").Optional(),
+		field.Int64("max_auth_age").Optional().Nillable(),
+		field.String("user_id").Optional(),
+		field.Bool("done").Default(false),
+		field.Time("auth_time").Optional().Nillable(),
+		field.JSON("amr", []string{}).Optional(),
+		field.Time("created_at").Default(time.Now),
+		field.Time("expiration").Default(func() time.Time {
+			return time.Now().Add(30 * time.Minute)
+		}),
+	}
+}
+
+// Edges of the AuthRequest.
+func (AuthRequest) Edges() []ent.Edge {
+	return nil
+}
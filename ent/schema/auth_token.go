@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
 )
@@ -20,6 +22,10 @@ func (AuthToken) Fields() []ent.Field {
 		field.JSON("audience", []string{}),
 		field.Time("expiration"),
 		field.JSON("scopes", []string{}),
+		field.Time("created_at").Default(time.Now),
+		field.Time("last_used_at").Optional().Nillable(),
+		// actor is the subject acting as the token subject, set on impersonation tokens
+		field.String("actor").Optional().Nillable(),
 	}
 }
 
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// DeviceAuthorization holds the schema definition for the DeviceAuthorization entity.
+type DeviceAuthorization struct {
+	ent.Schema
+}
+
+// Fields of the DeviceAuthorization.
+func (DeviceAuthorization) Fields() []ent.Field {
+	return []ent.Field{
+		// The device code polled by the device
+		field.String("id"),
+		// The code typed by the user to approve the device
+		field.String("user_code").Unique(),
+		field.String("application_id"),
+		field.JSON("scopes", []string{}),
+		field.Bool("done").Default(false),
+		field.Bool("denied").Default(false),
+		field.String("subject").Optional(),
+		field.Time("auth_time").Optional().Nillable(),
+		field.JSON("amr", []string{}).Optional(),
+		field.Time("created_at").Default(time.Now),
+		field.Time("expiration"),
+	}
+}
+
+// Edges of the DeviceAuthorization.
+func (DeviceAuthorization) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RoleGrant holds the schema definition for the RoleGrant entity.
+// A role listed on a user without a grant is a permanent role.
+type RoleGrant struct {
+	ent.Schema
+}
+
+func (RoleGrant) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("role").
+			NotEmpty().
+			Immutable(),
+		field.String("reason").
+			Optional(),
+		// The grant is revoked by the scheduler once expired, a nil value never expires
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (RoleGrant) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("role_grants").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("granted_by", User.Type).
+			Ref("given_role_grants").
+			Unique(),
+	}
+}
+
+func (RoleGrant) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("role").Edges("user").Unique(),
+		index.Fields("expires_at"),
+	}
+}
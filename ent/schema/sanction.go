@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Sanction holds the schema definition for the Sanction entity.
+// A sanction is active until it expires or is lifted.
+type Sanction struct {
+	ent.Schema
+}
+
+func (Sanction) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("type").
+			Values("global_ban", "tournament_ban", "vote_ban", "warning").
+			Immutable(),
+		field.String("reason").
+			NotEmpty(),
+		// A nil value never expires
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("lifted_at").
+			Optional().
+			Nillable(),
+		field.String("lift_reason").
+			Optional(),
+	}
+}
+
+func (Sanction) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("sanctions").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("issuer", User.Type).
+			Ref("issued_sanctions").
+			Unique().
+			Immutable(),
+		edge.From("lifted_by", User.Type).
+			Ref("lifted_sanctions").
+			Unique(),
+		// A tournament ban without a tournament bans the user from every tournament
+		edge.From("tournament", Tournament.Type).
+			Ref("sanctions").
+			Unique().
+			Immutable(),
+	}
+}
+
+func (Sanction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("user"),
+		index.Fields("created_at"),
+	}
+}
@@ -48,5 +48,8 @@ func (Tournament) Edges() []ent.Edge {
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("team_members", TeamMember.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// The bans from the tournament are dropped with it, they would otherwise ban from every tournament
+		edge.To("sanctions", Sanction.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -28,6 +28,8 @@ func (User) Fields() []ent.Field {
 		field.Enum("kind").Values("user", "admin").Default("user"),
 		field.Strings("roles").Default([]string{"user"}),
 		field.Int("elo").Default(0),
+		field.String("campus").Optional().Nillable(),
+		field.Time("last_login_at").Optional().Nillable(),
 	}
 }
 
@@ -37,6 +39,7 @@ func (User) Edges() []ent.Edge {
 		edge.To("user_votes", UserVote.Type).
 			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
 		edge.To("created_votes", Vote.Type),
+		edge.To("created_vote_templates", VoteTemplate.Type),
 		edge.To("apps", App.Type).
 			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
 		edge.To("consents", Consent.Type).
@@ -52,5 +55,14 @@ func (User) Edges() []ent.Edge {
 			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
 		edge.To("notifications", Notification.Type).
 			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+		edge.To("audit_logs", AuditLog.Type),
+		edge.To("impersonated_audit_logs", AuditLog.Type),
+		edge.To("role_grants", RoleGrant.Type).
+			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+		edge.To("given_role_grants", RoleGrant.Type),
+		edge.To("sanctions", Sanction.Type).
+			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+		edge.To("issued_sanctions", Sanction.Type),
+		edge.To("lifted_sanctions", Sanction.Type),
 	}
 }
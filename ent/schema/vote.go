@@ -18,8 +18,11 @@ func (Vote) Fields() []ent.Field {
 		field.String("title"),
 		field.String("description").Optional(),
 		field.Bool("visible").Default(false),
+		field.Bool("anonymous").Default(true),
 		field.Time("start_at"),
 		field.Time("end_at"),
+		field.Time("results_reveal_at").Optional().Nillable(),
+		field.Time("results_published_at").Optional().Nillable(),
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
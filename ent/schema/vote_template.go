@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+type VoteTemplate struct {
+	ent.Schema
+}
+
+// VoteTemplateComponent is a component recreated in every vote created from a template.
+type VoteTemplateComponent struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description,omitempty"`
+	Color       string  `json:"color,omitempty"`
+	ImageURL    *string `json:"image_url,omitempty"`
+}
+
+func (VoteTemplate) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.String("title"),
+		field.String("description").Optional(),
+		field.Bool("anonymous").Default(true),
+		field.Int("duration_minutes").Positive(),
+		field.Int("results_delay_minutes").Optional().Nillable(),
+		field.JSON("components", []VoteTemplateComponent{}),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (VoteTemplate) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("creator", User.Type).
+			Ref("created_vote_templates").
+			Unique().
+			Required(),
+	}
+}
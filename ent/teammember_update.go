@@ -20,8 +20,9 @@ import (
 // TeamMemberUpdate is the builder for updating TeamMember entities.
 type TeamMemberUpdate struct {
 	config
-	hooks    []Hook
-	mutation *TeamMemberMutation
+	hooks     []Hook
+	mutation  *TeamMemberMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the TeamMemberUpdate builder.
@@ -155,6 +156,12 @@ func (_u *TeamMemberUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TeamMemberUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TeamMemberUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *TeamMemberUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -260,6 +267,7 @@ func (_u *TeamMemberUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{teammember.Label}
@@ -275,9 +283,10 @@ func (_u *TeamMemberUpdate) sqlSave(ctx context.Context) (_node int, err error)
 // TeamMemberUpdateOne is the builder for updating a single TeamMember entity.
 type TeamMemberUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *TeamMemberMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *TeamMemberMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetRole sets the "role" field.
@@ -418,6 +427,12 @@ func (_u *TeamMemberUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TeamMemberUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TeamMemberUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *TeamMemberUpdateOne) sqlSave(ctx context.Context) (_node *TeamMember, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -540,6 +555,7 @@ func (_u *TeamMemberUpdateOne) sqlSave(ctx context.Context) (_node *TeamMember,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &TeamMember{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
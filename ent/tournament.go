@@ -70,9 +70,11 @@ type TournamentEdges struct {
 	RankGroups []*RankGroup `json:"rank_groups,omitempty"`
 	// TeamMembers holds the value of the team_members edge.
 	TeamMembers []*TeamMember `json:"team_members,omitempty"`
+	// Sanctions holds the value of the sanctions edge.
+	Sanctions []*Sanction `json:"sanctions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [6]bool
 }
 
 // CreatorOrErr returns the Creator value or an error if the edge
@@ -122,6 +124,15 @@ func (e TournamentEdges) TeamMembersOrErr() ([]*TeamMember, error) {
 	return nil, &NotLoadedError{edge: "team_members"}
 }
 
+// SanctionsOrErr returns the Sanctions value or an error if the edge
+// was not loaded in eager-loading.
+func (e TournamentEdges) SanctionsOrErr() ([]*Sanction, error) {
+	if e.loadedTypes[5] {
+		return e.Sanctions, nil
+	}
+	return nil, &NotLoadedError{edge: "sanctions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Tournament) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -307,6 +318,11 @@ func (_m *Tournament) QueryTeamMembers() *TeamMemberQuery {
 	return NewTournamentClient(_m.config).QueryTeamMembers(_m)
 }
 
+// QuerySanctions queries the "sanctions" edge of the Tournament entity.
+func (_m *Tournament) QuerySanctions() *SanctionQuery {
+	return NewTournamentClient(_m.config).QuerySanctions(_m)
+}
+
 // Update returns a builder for updating this Tournament.
 // Note that you need to call Tournament.Unwrap() before calling this method if this Tournament
 // was returned from a transaction, and the transaction was committed or rolled back.
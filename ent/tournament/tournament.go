@@ -57,6 +57,8 @@ const (
 	EdgeRankGroups = "rank_groups"
 	// EdgeTeamMembers holds the string denoting the team_members edge name in mutations.
 	EdgeTeamMembers = "team_members"
+	// EdgeSanctions holds the string denoting the sanctions edge name in mutations.
+	EdgeSanctions = "sanctions"
 	// Table holds the table name of the tournament in the database.
 	Table = "tournaments"
 	// CreatorTable is the table that holds the creator relation/edge.
@@ -94,6 +96,13 @@ const (
 	TeamMembersInverseTable = "team_members"
 	// TeamMembersColumn is the table column denoting the team_members relation/edge.
 	TeamMembersColumn = "tournament_team_members"
+	// SanctionsTable is the table that holds the sanctions relation/edge.
+	SanctionsTable = "sanctions"
+	// SanctionsInverseTable is the table name for the Sanction entity.
+	// It exists in this package in order to avoid circular dependency with the "sanction" package.
+	SanctionsInverseTable = "sanctions"
+	// SanctionsColumn is the table column denoting the sanctions relation/edge.
+	SanctionsColumn = "tournament_sanctions"
 )
 
 // Columns holds all SQL columns for tournament fields.
@@ -322,6 +331,20 @@ func ByTeamMembers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTeamMembersStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// BySanctionsCount orders the results by sanctions count.
+func BySanctionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSanctionsStep(), opts...)
+	}
+}
+
+// BySanctions orders the results by sanctions terms.
+func BySanctions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSanctionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newCreatorStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -357,3 +380,10 @@ func newTeamMembersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, TeamMembersTable, TeamMembersColumn),
 	)
 }
+func newSanctionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SanctionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SanctionsTable, SanctionsColumn),
+	)
+}
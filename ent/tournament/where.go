@@ -910,6 +910,29 @@ func HasTeamMembersWith(preds ...predicate.TeamMember) predicate.Tournament {
 	})
 }
 
+// HasSanctions applies the HasEdge predicate on the "sanctions" edge.
+func HasSanctions() predicate.Tournament {
+	return predicate.Tournament(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SanctionsTable, SanctionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSanctionsWith applies the HasEdge predicate on the "sanctions" edge with a given conditions (other predicates).
+func HasSanctionsWith(preds ...predicate.Sanction) predicate.Tournament {
+	return predicate.Tournament(func(s *sql.Selector) {
+		step := newSanctionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Tournament) predicate.Tournament {
 	return predicate.Tournament(sql.AndPredicates(predicates...))
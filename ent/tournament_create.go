@@ -4,6 +4,7 @@ package ent
 
 import (
 	"base-website/ent/rankgroup"
+	"base-website/ent/sanction"
 	"base-website/ent/team"
 	"base-website/ent/teammember"
 	"base-website/ent/tournament"
@@ -248,6 +249,21 @@ func (_c *TournamentCreate) AddTeamMembers(v ...*TeamMember) *TournamentCreate {
 	return _c.AddTeamMemberIDs(ids...)
 }
 
+// AddSanctionIDs adds the "sanctions" edge to the Sanction entity by IDs.
+func (_c *TournamentCreate) AddSanctionIDs(ids ...int) *TournamentCreate {
+	_c.mutation.AddSanctionIDs(ids...)
+	return _c
+}
+
+// AddSanctions adds the "sanctions" edges to the Sanction entity.
+func (_c *TournamentCreate) AddSanctions(v ...*Sanction) *TournamentCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSanctionIDs(ids...)
+}
+
 // Mutation returns the TournamentMutation object of the builder.
 func (_c *TournamentCreate) Mutation() *TournamentMutation {
 	return _c.mutation
@@ -522,6 +538,22 @@ func (_c *TournamentCreate) createSpec() (*Tournament, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.SanctionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tournament.SanctionsTable,
+			Columns: []string{tournament.SanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -5,6 +5,7 @@ package ent
 import (
 	"base-website/ent/predicate"
 	"base-website/ent/rankgroup"
+	"base-website/ent/sanction"
 	"base-website/ent/team"
 	"base-website/ent/teammember"
 	"base-website/ent/tournament"
@@ -16,6 +17,7 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -33,7 +35,9 @@ type TournamentQuery struct {
 	withTeams       *TeamQuery
 	withRankGroups  *RankGroupQuery
 	withTeamMembers *TeamMemberQuery
+	withSanctions   *SanctionQuery
 	withFKs         bool
+	modifiers       []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -180,6 +184,28 @@ func (_q *TournamentQuery) QueryTeamMembers() *TeamMemberQuery {
 	return query
 }
 
+// QuerySanctions chains the current query on the "sanctions" edge.
+func (_q *TournamentQuery) QuerySanctions() *SanctionQuery {
+	query := (&SanctionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tournament.Table, tournament.FieldID, selector),
+			sqlgraph.To(sanction.Table, sanction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tournament.SanctionsTable, tournament.SanctionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Tournament entity from the query.
 // Returns a *NotFoundError when no Tournament was found.
 func (_q *TournamentQuery) First(ctx context.Context) (*Tournament, error) {
@@ -377,9 +403,11 @@ func (_q *TournamentQuery) Clone() *TournamentQuery {
 		withTeams:       _q.withTeams.Clone(),
 		withRankGroups:  _q.withRankGroups.Clone(),
 		withTeamMembers: _q.withTeamMembers.Clone(),
+		withSanctions:   _q.withSanctions.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -438,6 +466,17 @@ func (_q *TournamentQuery) WithTeamMembers(opts ...func(*TeamMemberQuery)) *Tour
 	return _q
 }
 
+// WithSanctions tells the query-builder to eager-load the nodes that are connected to
+// the "sanctions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TournamentQuery) WithSanctions(opts ...func(*SanctionQuery)) *TournamentQuery {
+	query := (&SanctionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSanctions = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -517,12 +556,13 @@ func (_q *TournamentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*T
 		nodes       = []*Tournament{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [6]bool{
 			_q.withCreator != nil,
 			_q.withAdmins != nil,
 			_q.withTeams != nil,
 			_q.withRankGroups != nil,
 			_q.withTeamMembers != nil,
+			_q.withSanctions != nil,
 		}
 	)
 	if _q.withCreator != nil {
@@ -540,6 +580,9 @@ func (_q *TournamentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*T
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -583,6 +626,13 @@ func (_q *TournamentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*T
 			return nil, err
 		}
 	}
+	if query := _q.withSanctions; query != nil {
+		if err := _q.loadSanctions(ctx, query, nodes,
+			func(n *Tournament) { n.Edges.Sanctions = []*Sanction{} },
+			func(n *Tournament, e *Sanction) { n.Edges.Sanctions = append(n.Edges.Sanctions, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -742,9 +792,43 @@ func (_q *TournamentQuery) loadTeamMembers(ctx context.Context, query *TeamMembe
 	}
 	return nil
 }
+func (_q *TournamentQuery) loadSanctions(ctx context.Context, query *SanctionQuery, nodes []*Tournament, init func(*Tournament), assign func(*Tournament, *Sanction)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Tournament)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Sanction(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tournament.SanctionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.tournament_sanctions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tournament_sanctions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tournament_sanctions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *TournamentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -807,6 +891,9 @@ func (_q *TournamentQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -824,6 +911,38 @@ func (_q *TournamentQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *TournamentQuery) ForUpdate(opts ...sql.LockOption) *TournamentQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *TournamentQuery) ForShare(opts ...sql.LockOption) *TournamentQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *TournamentQuery) Modify(modifiers ...func(s *sql.Selector)) *TournamentSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // TournamentGroupBy is the group-by builder for Tournament entities.
 type TournamentGroupBy struct {
 	selector
@@ -913,3 +1032,9 @@ func (_s *TournamentSelect) sqlScan(ctx context.Context, root *TournamentQuery,
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *TournamentSelect) Modify(modifiers ...func(s *sql.Selector)) *TournamentSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
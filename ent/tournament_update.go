@@ -5,6 +5,7 @@ package ent
 import (
 	"base-website/ent/predicate"
 	"base-website/ent/rankgroup"
+	"base-website/ent/sanction"
 	"base-website/ent/team"
 	"base-website/ent/teammember"
 	"base-website/ent/tournament"
@@ -23,8 +24,9 @@ import (
 // TournamentUpdate is the builder for updating Tournament entities.
 type TournamentUpdate struct {
 	config
-	hooks    []Hook
-	mutation *TournamentMutation
+	hooks     []Hook
+	mutation  *TournamentMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the TournamentUpdate builder.
@@ -335,6 +337,21 @@ func (_u *TournamentUpdate) AddTeamMembers(v ...*TeamMember) *TournamentUpdate {
 	return _u.AddTeamMemberIDs(ids...)
 }
 
+// AddSanctionIDs adds the "sanctions" edge to the Sanction entity by IDs.
+func (_u *TournamentUpdate) AddSanctionIDs(ids ...int) *TournamentUpdate {
+	_u.mutation.AddSanctionIDs(ids...)
+	return _u
+}
+
+// AddSanctions adds the "sanctions" edges to the Sanction entity.
+func (_u *TournamentUpdate) AddSanctions(v ...*Sanction) *TournamentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSanctionIDs(ids...)
+}
+
 // Mutation returns the TournamentMutation object of the builder.
 func (_u *TournamentUpdate) Mutation() *TournamentMutation {
 	return _u.mutation
@@ -430,6 +447,27 @@ func (_u *TournamentUpdate) RemoveTeamMembers(v ...*TeamMember) *TournamentUpdat
 	return _u.RemoveTeamMemberIDs(ids...)
 }
 
+// ClearSanctions clears all "sanctions" edges to the Sanction entity.
+func (_u *TournamentUpdate) ClearSanctions() *TournamentUpdate {
+	_u.mutation.ClearSanctions()
+	return _u
+}
+
+// RemoveSanctionIDs removes the "sanctions" edge to Sanction entities by IDs.
+func (_u *TournamentUpdate) RemoveSanctionIDs(ids ...int) *TournamentUpdate {
+	_u.mutation.RemoveSanctionIDs(ids...)
+	return _u
+}
+
+// RemoveSanctions removes "sanctions" edges to Sanction entities.
+func (_u *TournamentUpdate) RemoveSanctions(v ...*Sanction) *TournamentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSanctionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *TournamentUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -479,6 +517,12 @@ func (_u *TournamentUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TournamentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TournamentUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *TournamentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -763,6 +807,52 @@ func (_u *TournamentUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SanctionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tournament.SanctionsTable,
+			Columns: []string{tournament.SanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSanctionsIDs(); len(nodes) > 0 && !_u.mutation.SanctionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tournament.SanctionsTable,
+			Columns: []string{tournament.SanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SanctionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tournament.SanctionsTable,
+			Columns: []string{tournament.SanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{tournament.Label}
@@ -778,9 +868,10 @@ func (_u *TournamentUpdate) sqlSave(ctx context.Context) (_node int, err error)
 // TournamentUpdateOne is the builder for updating a single Tournament entity.
 type TournamentUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *TournamentMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *TournamentMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetSlug sets the "slug" field.
@@ -1085,6 +1176,21 @@ func (_u *TournamentUpdateOne) AddTeamMembers(v ...*TeamMember) *TournamentUpdat
 	return _u.AddTeamMemberIDs(ids...)
 }
 
+// AddSanctionIDs adds the "sanctions" edge to the Sanction entity by IDs.
+func (_u *TournamentUpdateOne) AddSanctionIDs(ids ...int) *TournamentUpdateOne {
+	_u.mutation.AddSanctionIDs(ids...)
+	return _u
+}
+
+// AddSanctions adds the "sanctions" edges to the Sanction entity.
+func (_u *TournamentUpdateOne) AddSanctions(v ...*Sanction) *TournamentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSanctionIDs(ids...)
+}
+
 // Mutation returns the TournamentMutation object of the builder.
 func (_u *TournamentUpdateOne) Mutation() *TournamentMutation {
 	return _u.mutation
@@ -1180,6 +1286,27 @@ func (_u *TournamentUpdateOne) RemoveTeamMembers(v ...*TeamMember) *TournamentUp
 	return _u.RemoveTeamMemberIDs(ids...)
 }
 
+// ClearSanctions clears all "sanctions" edges to the Sanction entity.
+func (_u *TournamentUpdateOne) ClearSanctions() *TournamentUpdateOne {
+	_u.mutation.ClearSanctions()
+	return _u
+}
+
+// RemoveSanctionIDs removes the "sanctions" edge to Sanction entities by IDs.
+func (_u *TournamentUpdateOne) RemoveSanctionIDs(ids ...int) *TournamentUpdateOne {
+	_u.mutation.RemoveSanctionIDs(ids...)
+	return _u
+}
+
+// RemoveSanctions removes "sanctions" edges to Sanction entities.
+func (_u *TournamentUpdateOne) RemoveSanctions(v ...*Sanction) *TournamentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSanctionIDs(ids...)
+}
+
 // Where appends a list predicates to the TournamentUpdate builder.
 func (_u *TournamentUpdateOne) Where(ps ...predicate.Tournament) *TournamentUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1242,6 +1369,12 @@ func (_u *TournamentUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TournamentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TournamentUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *TournamentUpdateOne) sqlSave(ctx context.Context) (_node *Tournament, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -1543,6 +1676,52 @@ func (_u *TournamentUpdateOne) sqlSave(ctx context.Context) (_node *Tournament,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SanctionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tournament.SanctionsTable,
+			Columns: []string{tournament.SanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSanctionsIDs(); len(nodes) > 0 && !_u.mutation.SanctionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tournament.SanctionsTable,
+			Columns: []string{tournament.SanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SanctionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tournament.SanctionsTable,
+			Columns: []string{tournament.SanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Tournament{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
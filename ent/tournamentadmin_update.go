@@ -19,8 +19,9 @@ import (
 // TournamentAdminUpdate is the builder for updating TournamentAdmin entities.
 type TournamentAdminUpdate struct {
 	config
-	hooks    []Hook
-	mutation *TournamentAdminMutation
+	hooks     []Hook
+	mutation  *TournamentAdminMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the TournamentAdminUpdate builder.
@@ -125,6 +126,12 @@ func (_u *TournamentAdminUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TournamentAdminUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TournamentAdminUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *TournamentAdminUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -198,6 +205,7 @@ func (_u *TournamentAdminUpdate) sqlSave(ctx context.Context) (_node int, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{tournamentadmin.Label}
@@ -213,9 +221,10 @@ func (_u *TournamentAdminUpdate) sqlSave(ctx context.Context) (_node int, err er
 // TournamentAdminUpdateOne is the builder for updating a single TournamentAdmin entity.
 type TournamentAdminUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *TournamentAdminMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *TournamentAdminMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetRole sets the "role" field.
@@ -327,6 +336,12 @@ func (_u *TournamentAdminUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TournamentAdminUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TournamentAdminUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *TournamentAdminUpdateOne) sqlSave(ctx context.Context) (_node *TournamentAdmin, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -417,6 +432,7 @@ func (_u *TournamentAdminUpdateOne) sqlSave(ctx context.Context) (_node *Tournam
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &TournamentAdmin{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
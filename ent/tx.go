@@ -14,22 +14,34 @@ type Tx struct {
 	config
 	// App is the client for interacting with the App builders.
 	App *AppClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
 	// AuthCode is the client for interacting with the AuthCode builders.
 	AuthCode *AuthCodeClient
 	// AuthRefreshToken is the client for interacting with the AuthRefreshToken builders.
 	AuthRefreshToken *AuthRefreshTokenClient
+	// AuthRequest is the client for interacting with the AuthRequest builders.
+	AuthRequest *AuthRequestClient
 	// AuthToken is the client for interacting with the AuthToken builders.
 	AuthToken *AuthTokenClient
 	// Component is the client for interacting with the Component builders.
 	Component *ComponentClient
 	// Consent is the client for interacting with the Consent builders.
 	Consent *ConsentClient
+	// DeviceAuthorization is the client for interacting with the DeviceAuthorization builders.
+	DeviceAuthorization *DeviceAuthorizationClient
 	// Invitation is the client for interacting with the Invitation builders.
 	Invitation *InvitationClient
 	// Notification is the client for interacting with the Notification builders.
 	Notification *NotificationClient
 	// RankGroup is the client for interacting with the RankGroup builders.
 	RankGroup *RankGroupClient
+	// RoleGrant is the client for interacting with the RoleGrant builders.
+	RoleGrant *RoleGrantClient
+	// Sanction is the client for interacting with the Sanction builders.
+	Sanction *SanctionClient
+	// SigningKey is the client for interacting with the SigningKey builders.
+	SigningKey *SigningKeyClient
 	// Team is the client for interacting with the Team builders.
 	Team *TeamClient
 	// TeamMember is the client for interacting with the TeamMember builders.
@@ -44,6 +56,8 @@ type Tx struct {
 	UserVote *UserVoteClient
 	// Vote is the client for interacting with the Vote builders.
 	Vote *VoteClient
+	// VoteTemplate is the client for interacting with the VoteTemplate builders.
+	VoteTemplate *VoteTemplateClient
 
 	// lazily loaded.
 	client     *Client
@@ -176,14 +190,20 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.App = NewAppClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
 	tx.AuthCode = NewAuthCodeClient(tx.config)
 	tx.AuthRefreshToken = NewAuthRefreshTokenClient(tx.config)
+	tx.AuthRequest = NewAuthRequestClient(tx.config)
 	tx.AuthToken = NewAuthTokenClient(tx.config)
 	tx.Component = NewComponentClient(tx.config)
 	tx.Consent = NewConsentClient(tx.config)
+	tx.DeviceAuthorization = NewDeviceAuthorizationClient(tx.config)
 	tx.Invitation = NewInvitationClient(tx.config)
 	tx.Notification = NewNotificationClient(tx.config)
 	tx.RankGroup = NewRankGroupClient(tx.config)
+	tx.RoleGrant = NewRoleGrantClient(tx.config)
+	tx.Sanction = NewSanctionClient(tx.config)
+	tx.SigningKey = NewSigningKeyClient(tx.config)
 	tx.Team = NewTeamClient(tx.config)
 	tx.TeamMember = NewTeamMemberClient(tx.config)
 	tx.Tournament = NewTournamentClient(tx.config)
@@ -191,6 +211,7 @@ func (tx *Tx) init() {
 	tx.User = NewUserClient(tx.config)
 	tx.UserVote = NewUserVoteClient(tx.config)
 	tx.Vote = NewVoteClient(tx.config)
+	tx.VoteTemplate = NewVoteTemplateClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -38,6 +38,10 @@ type User struct {
 	Roles []string `json:"roles,omitempty"`
 	// Elo holds the value of the "elo" field.
 	Elo int `json:"elo,omitempty"`
+	// Campus holds the value of the "campus" field.
+	Campus *string `json:"campus,omitempty"`
+	// LastLoginAt holds the value of the "last_login_at" field.
+	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -50,6 +54,8 @@ type UserEdges struct {
 	UserVotes []*UserVote `json:"user_votes,omitempty"`
 	// CreatedVotes holds the value of the created_votes edge.
 	CreatedVotes []*Vote `json:"created_votes,omitempty"`
+	// CreatedVoteTemplates holds the value of the created_vote_templates edge.
+	CreatedVoteTemplates []*VoteTemplate `json:"created_vote_templates,omitempty"`
 	// Apps holds the value of the apps edge.
 	Apps []*App `json:"apps,omitempty"`
 	// Consents holds the value of the consents edge.
@@ -66,9 +72,23 @@ type UserEdges struct {
 	TournamentAdmins []*TournamentAdmin `json:"tournament_admins,omitempty"`
 	// Notifications holds the value of the notifications edge.
 	Notifications []*Notification `json:"notifications,omitempty"`
+	// AuditLogs holds the value of the audit_logs edge.
+	AuditLogs []*AuditLog `json:"audit_logs,omitempty"`
+	// ImpersonatedAuditLogs holds the value of the impersonated_audit_logs edge.
+	ImpersonatedAuditLogs []*AuditLog `json:"impersonated_audit_logs,omitempty"`
+	// RoleGrants holds the value of the role_grants edge.
+	RoleGrants []*RoleGrant `json:"role_grants,omitempty"`
+	// GivenRoleGrants holds the value of the given_role_grants edge.
+	GivenRoleGrants []*RoleGrant `json:"given_role_grants,omitempty"`
+	// Sanctions holds the value of the sanctions edge.
+	Sanctions []*Sanction `json:"sanctions,omitempty"`
+	// IssuedSanctions holds the value of the issued_sanctions edge.
+	IssuedSanctions []*Sanction `json:"issued_sanctions,omitempty"`
+	// LiftedSanctions holds the value of the lifted_sanctions edge.
+	LiftedSanctions []*Sanction `json:"lifted_sanctions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [10]bool
+	loadedTypes [18]bool
 }
 
 // UserVotesOrErr returns the UserVotes value or an error if the edge
@@ -89,10 +109,19 @@ func (e UserEdges) CreatedVotesOrErr() ([]*Vote, error) {
 	return nil, &NotLoadedError{edge: "created_votes"}
 }
 
+// CreatedVoteTemplatesOrErr returns the CreatedVoteTemplates value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) CreatedVoteTemplatesOrErr() ([]*VoteTemplate, error) {
+	if e.loadedTypes[2] {
+		return e.CreatedVoteTemplates, nil
+	}
+	return nil, &NotLoadedError{edge: "created_vote_templates"}
+}
+
 // AppsOrErr returns the Apps value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) AppsOrErr() ([]*App, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[3] {
 		return e.Apps, nil
 	}
 	return nil, &NotLoadedError{edge: "apps"}
@@ -101,7 +130,7 @@ func (e UserEdges) AppsOrErr() ([]*App, error) {
 // ConsentsOrErr returns the Consents value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) ConsentsOrErr() ([]*Consent, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[4] {
 		return e.Consents, nil
 	}
 	return nil, &NotLoadedError{edge: "consents"}
@@ -110,7 +139,7 @@ func (e UserEdges) ConsentsOrErr() ([]*Consent, error) {
 // TeamMembershipsOrErr returns the TeamMemberships value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) TeamMembershipsOrErr() ([]*TeamMember, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[5] {
 		return e.TeamMemberships, nil
 	}
 	return nil, &NotLoadedError{edge: "team_memberships"}
@@ -119,7 +148,7 @@ func (e UserEdges) TeamMembershipsOrErr() ([]*TeamMember, error) {
 // ReceivedInvitationsOrErr returns the ReceivedInvitations value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) ReceivedInvitationsOrErr() ([]*Invitation, error) {
-	if e.loadedTypes[5] {
+	if e.loadedTypes[6] {
 		return e.ReceivedInvitations, nil
 	}
 	return nil, &NotLoadedError{edge: "received_invitations"}
@@ -128,7 +157,7 @@ func (e UserEdges) ReceivedInvitationsOrErr() ([]*Invitation, error) {
 // CreatedTeamsOrErr returns the CreatedTeams value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) CreatedTeamsOrErr() ([]*Team, error) {
-	if e.loadedTypes[6] {
+	if e.loadedTypes[7] {
 		return e.CreatedTeams, nil
 	}
 	return nil, &NotLoadedError{edge: "created_teams"}
@@ -137,7 +166,7 @@ func (e UserEdges) CreatedTeamsOrErr() ([]*Team, error) {
 // CreatedTournamentsOrErr returns the CreatedTournaments value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) CreatedTournamentsOrErr() ([]*Tournament, error) {
-	if e.loadedTypes[7] {
+	if e.loadedTypes[8] {
 		return e.CreatedTournaments, nil
 	}
 	return nil, &NotLoadedError{edge: "created_tournaments"}
@@ -146,7 +175,7 @@ func (e UserEdges) CreatedTournamentsOrErr() ([]*Tournament, error) {
 // TournamentAdminsOrErr returns the TournamentAdmins value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) TournamentAdminsOrErr() ([]*TournamentAdmin, error) {
-	if e.loadedTypes[8] {
+	if e.loadedTypes[9] {
 		return e.TournamentAdmins, nil
 	}
 	return nil, &NotLoadedError{edge: "tournament_admins"}
@@ -155,12 +184,75 @@ func (e UserEdges) TournamentAdminsOrErr() ([]*TournamentAdmin, error) {
 // NotificationsOrErr returns the Notifications value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) NotificationsOrErr() ([]*Notification, error) {
-	if e.loadedTypes[9] {
+	if e.loadedTypes[10] {
 		return e.Notifications, nil
 	}
 	return nil, &NotLoadedError{edge: "notifications"}
 }
 
+// AuditLogsOrErr returns the AuditLogs value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AuditLogsOrErr() ([]*AuditLog, error) {
+	if e.loadedTypes[11] {
+		return e.AuditLogs, nil
+	}
+	return nil, &NotLoadedError{edge: "audit_logs"}
+}
+
+// ImpersonatedAuditLogsOrErr returns the ImpersonatedAuditLogs value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ImpersonatedAuditLogsOrErr() ([]*AuditLog, error) {
+	if e.loadedTypes[12] {
+		return e.ImpersonatedAuditLogs, nil
+	}
+	return nil, &NotLoadedError{edge: "impersonated_audit_logs"}
+}
+
+// RoleGrantsOrErr returns the RoleGrants value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RoleGrantsOrErr() ([]*RoleGrant, error) {
+	if e.loadedTypes[13] {
+		return e.RoleGrants, nil
+	}
+	return nil, &NotLoadedError{edge: "role_grants"}
+}
+
+// GivenRoleGrantsOrErr returns the GivenRoleGrants value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) GivenRoleGrantsOrErr() ([]*RoleGrant, error) {
+	if e.loadedTypes[14] {
+		return e.GivenRoleGrants, nil
+	}
+	return nil, &NotLoadedError{edge: "given_role_grants"}
+}
+
+// SanctionsOrErr returns the Sanctions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SanctionsOrErr() ([]*Sanction, error) {
+	if e.loadedTypes[15] {
+		return e.Sanctions, nil
+	}
+	return nil, &NotLoadedError{edge: "sanctions"}
+}
+
+// IssuedSanctionsOrErr returns the IssuedSanctions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) IssuedSanctionsOrErr() ([]*Sanction, error) {
+	if e.loadedTypes[16] {
+		return e.IssuedSanctions, nil
+	}
+	return nil, &NotLoadedError{edge: "issued_sanctions"}
+}
+
+// LiftedSanctionsOrErr returns the LiftedSanctions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) LiftedSanctionsOrErr() ([]*Sanction, error) {
+	if e.loadedTypes[17] {
+		return e.LiftedSanctions, nil
+	}
+	return nil, &NotLoadedError{edge: "lifted_sanctions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -170,9 +262,9 @@ func (*User) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case user.FieldID, user.FieldIntraID, user.FieldElo:
 			values[i] = new(sql.NullInt64)
-		case user.FieldUsername, user.FieldEmail, user.FieldPicture, user.FieldKind:
+		case user.FieldUsername, user.FieldEmail, user.FieldPicture, user.FieldKind, user.FieldCampus:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldAnonymizedAt:
+		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldAnonymizedAt, user.FieldLastLoginAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -260,6 +352,20 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Elo = int(value.Int64)
 			}
+		case user.FieldCampus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field campus", values[i])
+			} else if value.Valid {
+				_m.Campus = new(string)
+				*_m.Campus = value.String
+			}
+		case user.FieldLastLoginAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_login_at", values[i])
+			} else if value.Valid {
+				_m.LastLoginAt = new(time.Time)
+				*_m.LastLoginAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -283,6 +389,11 @@ func (_m *User) QueryCreatedVotes() *VoteQuery {
 	return NewUserClient(_m.config).QueryCreatedVotes(_m)
 }
 
+// QueryCreatedVoteTemplates queries the "created_vote_templates" edge of the User entity.
+func (_m *User) QueryCreatedVoteTemplates() *VoteTemplateQuery {
+	return NewUserClient(_m.config).QueryCreatedVoteTemplates(_m)
+}
+
 // QueryApps queries the "apps" edge of the User entity.
 func (_m *User) QueryApps() *AppQuery {
 	return NewUserClient(_m.config).QueryApps(_m)
@@ -323,6 +434,41 @@ func (_m *User) QueryNotifications() *NotificationQuery {
 	return NewUserClient(_m.config).QueryNotifications(_m)
 }
 
+// QueryAuditLogs queries the "audit_logs" edge of the User entity.
+func (_m *User) QueryAuditLogs() *AuditLogQuery {
+	return NewUserClient(_m.config).QueryAuditLogs(_m)
+}
+
+// QueryImpersonatedAuditLogs queries the "impersonated_audit_logs" edge of the User entity.
+func (_m *User) QueryImpersonatedAuditLogs() *AuditLogQuery {
+	return NewUserClient(_m.config).QueryImpersonatedAuditLogs(_m)
+}
+
+// QueryRoleGrants queries the "role_grants" edge of the User entity.
+func (_m *User) QueryRoleGrants() *RoleGrantQuery {
+	return NewUserClient(_m.config).QueryRoleGrants(_m)
+}
+
+// QueryGivenRoleGrants queries the "given_role_grants" edge of the User entity.
+func (_m *User) QueryGivenRoleGrants() *RoleGrantQuery {
+	return NewUserClient(_m.config).QueryGivenRoleGrants(_m)
+}
+
+// QuerySanctions queries the "sanctions" edge of the User entity.
+func (_m *User) QuerySanctions() *SanctionQuery {
+	return NewUserClient(_m.config).QuerySanctions(_m)
+}
+
+// QueryIssuedSanctions queries the "issued_sanctions" edge of the User entity.
+func (_m *User) QueryIssuedSanctions() *SanctionQuery {
+	return NewUserClient(_m.config).QueryIssuedSanctions(_m)
+}
+
+// QueryLiftedSanctions queries the "lifted_sanctions" edge of the User entity.
+func (_m *User) QueryLiftedSanctions() *SanctionQuery {
+	return NewUserClient(_m.config).QueryLiftedSanctions(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -381,6 +527,16 @@ func (_m *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("elo=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Elo))
+	builder.WriteString(", ")
+	if v := _m.Campus; v != nil {
+		builder.WriteString("campus=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.LastLoginAt; v != nil {
+		builder.WriteString("last_login_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -35,10 +35,16 @@ const (
 	FieldRoles = "roles"
 	// FieldElo holds the string denoting the elo field in the database.
 	FieldElo = "elo"
+	// FieldCampus holds the string denoting the campus field in the database.
+	FieldCampus = "campus"
+	// FieldLastLoginAt holds the string denoting the last_login_at field in the database.
+	FieldLastLoginAt = "last_login_at"
 	// EdgeUserVotes holds the string denoting the user_votes edge name in mutations.
 	EdgeUserVotes = "user_votes"
 	// EdgeCreatedVotes holds the string denoting the created_votes edge name in mutations.
 	EdgeCreatedVotes = "created_votes"
+	// EdgeCreatedVoteTemplates holds the string denoting the created_vote_templates edge name in mutations.
+	EdgeCreatedVoteTemplates = "created_vote_templates"
 	// EdgeApps holds the string denoting the apps edge name in mutations.
 	EdgeApps = "apps"
 	// EdgeConsents holds the string denoting the consents edge name in mutations.
@@ -55,6 +61,20 @@ const (
 	EdgeTournamentAdmins = "tournament_admins"
 	// EdgeNotifications holds the string denoting the notifications edge name in mutations.
 	EdgeNotifications = "notifications"
+	// EdgeAuditLogs holds the string denoting the audit_logs edge name in mutations.
+	EdgeAuditLogs = "audit_logs"
+	// EdgeImpersonatedAuditLogs holds the string denoting the impersonated_audit_logs edge name in mutations.
+	EdgeImpersonatedAuditLogs = "impersonated_audit_logs"
+	// EdgeRoleGrants holds the string denoting the role_grants edge name in mutations.
+	EdgeRoleGrants = "role_grants"
+	// EdgeGivenRoleGrants holds the string denoting the given_role_grants edge name in mutations.
+	EdgeGivenRoleGrants = "given_role_grants"
+	// EdgeSanctions holds the string denoting the sanctions edge name in mutations.
+	EdgeSanctions = "sanctions"
+	// EdgeIssuedSanctions holds the string denoting the issued_sanctions edge name in mutations.
+	EdgeIssuedSanctions = "issued_sanctions"
+	// EdgeLiftedSanctions holds the string denoting the lifted_sanctions edge name in mutations.
+	EdgeLiftedSanctions = "lifted_sanctions"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// UserVotesTable is the table that holds the user_votes relation/edge.
@@ -71,6 +91,13 @@ const (
 	CreatedVotesInverseTable = "votes"
 	// CreatedVotesColumn is the table column denoting the created_votes relation/edge.
 	CreatedVotesColumn = "user_created_votes"
+	// CreatedVoteTemplatesTable is the table that holds the created_vote_templates relation/edge.
+	CreatedVoteTemplatesTable = "vote_templates"
+	// CreatedVoteTemplatesInverseTable is the table name for the VoteTemplate entity.
+	// It exists in this package in order to avoid circular dependency with the "votetemplate" package.
+	CreatedVoteTemplatesInverseTable = "vote_templates"
+	// CreatedVoteTemplatesColumn is the table column denoting the created_vote_templates relation/edge.
+	CreatedVoteTemplatesColumn = "user_created_vote_templates"
 	// AppsTable is the table that holds the apps relation/edge.
 	AppsTable = "apps"
 	// AppsInverseTable is the table name for the App entity.
@@ -127,6 +154,55 @@ const (
 	NotificationsInverseTable = "notifications"
 	// NotificationsColumn is the table column denoting the notifications relation/edge.
 	NotificationsColumn = "user_notifications"
+	// AuditLogsTable is the table that holds the audit_logs relation/edge.
+	AuditLogsTable = "audit_logs"
+	// AuditLogsInverseTable is the table name for the AuditLog entity.
+	// It exists in this package in order to avoid circular dependency with the "auditlog" package.
+	AuditLogsInverseTable = "audit_logs"
+	// AuditLogsColumn is the table column denoting the audit_logs relation/edge.
+	AuditLogsColumn = "user_audit_logs"
+	// ImpersonatedAuditLogsTable is the table that holds the impersonated_audit_logs relation/edge.
+	ImpersonatedAuditLogsTable = "audit_logs"
+	// ImpersonatedAuditLogsInverseTable is the table name for the AuditLog entity.
+	// It exists in this package in order to avoid circular dependency with the "auditlog" package.
+	ImpersonatedAuditLogsInverseTable = "audit_logs"
+	// ImpersonatedAuditLogsColumn is the table column denoting the impersonated_audit_logs relation/edge.
+	ImpersonatedAuditLogsColumn = "user_impersonated_audit_logs"
+	// RoleGrantsTable is the table that holds the role_grants relation/edge.
+	RoleGrantsTable = "role_grants"
+	// RoleGrantsInverseTable is the table name for the RoleGrant entity.
+	// It exists in this package in order to avoid circular dependency with the "rolegrant" package.
+	RoleGrantsInverseTable = "role_grants"
+	// RoleGrantsColumn is the table column denoting the role_grants relation/edge.
+	RoleGrantsColumn = "user_role_grants"
+	// GivenRoleGrantsTable is the table that holds the given_role_grants relation/edge.
+	GivenRoleGrantsTable = "role_grants"
+	// GivenRoleGrantsInverseTable is the table name for the RoleGrant entity.
+	// It exists in this package in order to avoid circular dependency with the "rolegrant" package.
+	GivenRoleGrantsInverseTable = "role_grants"
+	// GivenRoleGrantsColumn is the table column denoting the given_role_grants relation/edge.
+	GivenRoleGrantsColumn = "user_given_role_grants"
+	// SanctionsTable is the table that holds the sanctions relation/edge.
+	SanctionsTable = "sanctions"
+	// SanctionsInverseTable is the table name for the Sanction entity.
+	// It exists in this package in order to avoid circular dependency with the "sanction" package.
+	SanctionsInverseTable = "sanctions"
+	// SanctionsColumn is the table column denoting the sanctions relation/edge.
+	SanctionsColumn = "user_sanctions"
+	// IssuedSanctionsTable is the table that holds the issued_sanctions relation/edge.
+	IssuedSanctionsTable = "sanctions"
+	// IssuedSanctionsInverseTable is the table name for the Sanction entity.
+	// It exists in this package in order to avoid circular dependency with the "sanction" package.
+	IssuedSanctionsInverseTable = "sanctions"
+	// IssuedSanctionsColumn is the table column denoting the issued_sanctions relation/edge.
+	IssuedSanctionsColumn = "user_issued_sanctions"
+	// LiftedSanctionsTable is the table that holds the lifted_sanctions relation/edge.
+	LiftedSanctionsTable = "sanctions"
+	// LiftedSanctionsInverseTable is the table name for the Sanction entity.
+	// It exists in this package in order to avoid circular dependency with the "sanction" package.
+	LiftedSanctionsInverseTable = "sanctions"
+	// LiftedSanctionsColumn is the table column denoting the lifted_sanctions relation/edge.
+	LiftedSanctionsColumn = "user_lifted_sanctions"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -142,6 +218,8 @@ var Columns = []string{
 	FieldKind,
 	FieldRoles,
 	FieldElo,
+	FieldCampus,
+	FieldLastLoginAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -246,6 +324,16 @@ func ByElo(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldElo, opts...).ToFunc()
 }
 
+// ByCampus orders the results by the campus field.
+func ByCampus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCampus, opts...).ToFunc()
+}
+
+// ByLastLoginAt orders the results by the last_login_at field.
+func ByLastLoginAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastLoginAt, opts...).ToFunc()
+}
+
 // ByUserVotesCount orders the results by user_votes count.
 func ByUserVotesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -274,6 +362,20 @@ func ByCreatedVotes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByCreatedVoteTemplatesCount orders the results by created_vote_templates count.
+func ByCreatedVoteTemplatesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCreatedVoteTemplatesStep(), opts...)
+	}
+}
+
+// ByCreatedVoteTemplates orders the results by created_vote_templates terms.
+func ByCreatedVoteTemplates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCreatedVoteTemplatesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByAppsCount orders the results by apps count.
 func ByAppsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -385,6 +487,104 @@ func ByNotifications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newNotificationsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByAuditLogsCount orders the results by audit_logs count.
+func ByAuditLogsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAuditLogsStep(), opts...)
+	}
+}
+
+// ByAuditLogs orders the results by audit_logs terms.
+func ByAuditLogs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAuditLogsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByImpersonatedAuditLogsCount orders the results by impersonated_audit_logs count.
+func ByImpersonatedAuditLogsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newImpersonatedAuditLogsStep(), opts...)
+	}
+}
+
+// ByImpersonatedAuditLogs orders the results by impersonated_audit_logs terms.
+func ByImpersonatedAuditLogs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newImpersonatedAuditLogsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRoleGrantsCount orders the results by role_grants count.
+func ByRoleGrantsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRoleGrantsStep(), opts...)
+	}
+}
+
+// ByRoleGrants orders the results by role_grants terms.
+func ByRoleGrants(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRoleGrantsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByGivenRoleGrantsCount orders the results by given_role_grants count.
+func ByGivenRoleGrantsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newGivenRoleGrantsStep(), opts...)
+	}
+}
+
+// ByGivenRoleGrants orders the results by given_role_grants terms.
+func ByGivenRoleGrants(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGivenRoleGrantsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySanctionsCount orders the results by sanctions count.
+func BySanctionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSanctionsStep(), opts...)
+	}
+}
+
+// BySanctions orders the results by sanctions terms.
+func BySanctions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSanctionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByIssuedSanctionsCount orders the results by issued_sanctions count.
+func ByIssuedSanctionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newIssuedSanctionsStep(), opts...)
+	}
+}
+
+// ByIssuedSanctions orders the results by issued_sanctions terms.
+func ByIssuedSanctions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIssuedSanctionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLiftedSanctionsCount orders the results by lifted_sanctions count.
+func ByLiftedSanctionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLiftedSanctionsStep(), opts...)
+	}
+}
+
+// ByLiftedSanctions orders the results by lifted_sanctions terms.
+func ByLiftedSanctions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLiftedSanctionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserVotesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -399,6 +599,13 @@ func newCreatedVotesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, CreatedVotesTable, CreatedVotesColumn),
 	)
 }
+func newCreatedVoteTemplatesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CreatedVoteTemplatesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CreatedVoteTemplatesTable, CreatedVoteTemplatesColumn),
+	)
+}
 func newAppsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -455,3 +662,52 @@ func newNotificationsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, NotificationsTable, NotificationsColumn),
 	)
 }
+func newAuditLogsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AuditLogsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AuditLogsTable, AuditLogsColumn),
+	)
+}
+func newImpersonatedAuditLogsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ImpersonatedAuditLogsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ImpersonatedAuditLogsTable, ImpersonatedAuditLogsColumn),
+	)
+}
+func newRoleGrantsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RoleGrantsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RoleGrantsTable, RoleGrantsColumn),
+	)
+}
+func newGivenRoleGrantsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GivenRoleGrantsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, GivenRoleGrantsTable, GivenRoleGrantsColumn),
+	)
+}
+func newSanctionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SanctionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SanctionsTable, SanctionsColumn),
+	)
+}
+func newIssuedSanctionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IssuedSanctionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, IssuedSanctionsTable, IssuedSanctionsColumn),
+	)
+}
+func newLiftedSanctionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LiftedSanctionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LiftedSanctionsTable, LiftedSanctionsColumn),
+	)
+}
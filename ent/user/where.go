@@ -95,6 +95,16 @@ func Elo(v int) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldElo, v))
 }
 
+// Campus applies equality check predicate on the "campus" field. It's identical to CampusEQ.
+func Campus(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldCampus, v))
+}
+
+// LastLoginAt applies equality check predicate on the "last_login_at" field. It's identical to LastLoginAtEQ.
+func LastLoginAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldLastLoginAt, v))
+}
+
 // UsernameEQ applies the EQ predicate on the "username" field.
 func UsernameEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldUsername, v))
@@ -540,6 +550,131 @@ func EloLTE(v int) predicate.User {
 	return predicate.User(sql.FieldLTE(FieldElo, v))
 }
 
+// CampusEQ applies the EQ predicate on the "campus" field.
+func CampusEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldCampus, v))
+}
+
+// CampusNEQ applies the NEQ predicate on the "campus" field.
+func CampusNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldCampus, v))
+}
+
+// CampusIn applies the In predicate on the "campus" field.
+func CampusIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldCampus, vs...))
+}
+
+// CampusNotIn applies the NotIn predicate on the "campus" field.
+func CampusNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldCampus, vs...))
+}
+
+// CampusGT applies the GT predicate on the "campus" field.
+func CampusGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldCampus, v))
+}
+
+// CampusGTE applies the GTE predicate on the "campus" field.
+func CampusGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldCampus, v))
+}
+
+// CampusLT applies the LT predicate on the "campus" field.
+func CampusLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldCampus, v))
+}
+
+// CampusLTE applies the LTE predicate on the "campus" field.
+func CampusLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldCampus, v))
+}
+
+// CampusContains applies the Contains predicate on the "campus" field.
+func CampusContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldCampus, v))
+}
+
+// CampusHasPrefix applies the HasPrefix predicate on the "campus" field.
+func CampusHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldCampus, v))
+}
+
+// CampusHasSuffix applies the HasSuffix predicate on the "campus" field.
+func CampusHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldCampus, v))
+}
+
+// CampusIsNil applies the IsNil predicate on the "campus" field.
+func CampusIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldCampus))
+}
+
+// CampusNotNil applies the NotNil predicate on the "campus" field.
+func CampusNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldCampus))
+}
+
+// CampusEqualFold applies the EqualFold predicate on the "campus" field.
+func CampusEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldCampus, v))
+}
+
+// CampusContainsFold applies the ContainsFold predicate on the "campus" field.
+func CampusContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldCampus, v))
+}
+
+// LastLoginAtEQ applies the EQ predicate on the "last_login_at" field.
+func LastLoginAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldLastLoginAt, v))
+}
+
+// LastLoginAtNEQ applies the NEQ predicate on the "last_login_at" field.
+func LastLoginAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldLastLoginAt, v))
+}
+
+// LastLoginAtIn applies the In predicate on the "last_login_at" field.
+func LastLoginAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldLastLoginAt, vs...))
+}
+
+// LastLoginAtNotIn applies the NotIn predicate on the "last_login_at" field.
+func LastLoginAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldLastLoginAt, vs...))
+}
+
+// LastLoginAtGT applies the GT predicate on the "last_login_at" field.
+func LastLoginAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldLastLoginAt, v))
+}
+
+// LastLoginAtGTE applies the GTE predicate on the "last_login_at" field.
+func LastLoginAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldLastLoginAt, v))
+}
+
+// LastLoginAtLT applies the LT predicate on the "last_login_at" field.
+func LastLoginAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldLastLoginAt, v))
+}
+
+// LastLoginAtLTE applies the LTE predicate on the "last_login_at" field.
+func LastLoginAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldLastLoginAt, v))
+}
+
+// LastLoginAtIsNil applies the IsNil predicate on the "last_login_at" field.
+func LastLoginAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldLastLoginAt))
+}
+
+// LastLoginAtNotNil applies the NotNil predicate on the "last_login_at" field.
+func LastLoginAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldLastLoginAt))
+}
+
 // HasUserVotes applies the HasEdge predicate on the "user_votes" edge.
 func HasUserVotes() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -586,6 +721,29 @@ func HasCreatedVotesWith(preds ...predicate.Vote) predicate.User {
 	})
 }
 
+// HasCreatedVoteTemplates applies the HasEdge predicate on the "created_vote_templates" edge.
+func HasCreatedVoteTemplates() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CreatedVoteTemplatesTable, CreatedVoteTemplatesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCreatedVoteTemplatesWith applies the HasEdge predicate on the "created_vote_templates" edge with a given conditions (other predicates).
+func HasCreatedVoteTemplatesWith(preds ...predicate.VoteTemplate) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newCreatedVoteTemplatesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasApps applies the HasEdge predicate on the "apps" edge.
 func HasApps() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -770,6 +928,167 @@ func HasNotificationsWith(preds ...predicate.Notification) predicate.User {
 	})
 }
 
+// HasAuditLogs applies the HasEdge predicate on the "audit_logs" edge.
+func HasAuditLogs() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AuditLogsTable, AuditLogsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAuditLogsWith applies the HasEdge predicate on the "audit_logs" edge with a given conditions (other predicates).
+func HasAuditLogsWith(preds ...predicate.AuditLog) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newAuditLogsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasImpersonatedAuditLogs applies the HasEdge predicate on the "impersonated_audit_logs" edge.
+func HasImpersonatedAuditLogs() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ImpersonatedAuditLogsTable, ImpersonatedAuditLogsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasImpersonatedAuditLogsWith applies the HasEdge predicate on the "impersonated_audit_logs" edge with a given conditions (other predicates).
+func HasImpersonatedAuditLogsWith(preds ...predicate.AuditLog) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newImpersonatedAuditLogsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRoleGrants applies the HasEdge predicate on the "role_grants" edge.
+func HasRoleGrants() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RoleGrantsTable, RoleGrantsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRoleGrantsWith applies the HasEdge predicate on the "role_grants" edge with a given conditions (other predicates).
+func HasRoleGrantsWith(preds ...predicate.RoleGrant) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newRoleGrantsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGivenRoleGrants applies the HasEdge predicate on the "given_role_grants" edge.
+func HasGivenRoleGrants() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, GivenRoleGrantsTable, GivenRoleGrantsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGivenRoleGrantsWith applies the HasEdge predicate on the "given_role_grants" edge with a given conditions (other predicates).
+func HasGivenRoleGrantsWith(preds ...predicate.RoleGrant) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newGivenRoleGrantsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSanctions applies the HasEdge predicate on the "sanctions" edge.
+func HasSanctions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SanctionsTable, SanctionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSanctionsWith applies the HasEdge predicate on the "sanctions" edge with a given conditions (other predicates).
+func HasSanctionsWith(preds ...predicate.Sanction) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSanctionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasIssuedSanctions applies the HasEdge predicate on the "issued_sanctions" edge.
+func HasIssuedSanctions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, IssuedSanctionsTable, IssuedSanctionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIssuedSanctionsWith applies the HasEdge predicate on the "issued_sanctions" edge with a given conditions (other predicates).
+func HasIssuedSanctionsWith(preds ...predicate.Sanction) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newIssuedSanctionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLiftedSanctions applies the HasEdge predicate on the "lifted_sanctions" edge.
+func HasLiftedSanctions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LiftedSanctionsTable, LiftedSanctionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLiftedSanctionsWith applies the HasEdge predicate on the "lifted_sanctions" edge with a given conditions (other predicates).
+func HasLiftedSanctionsWith(preds ...predicate.Sanction) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newLiftedSanctionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
@@ -4,9 +4,12 @@ package ent
 
 import (
 	"base-website/ent/app"
+	"base-website/ent/auditlog"
 	"base-website/ent/consent"
 	"base-website/ent/invitation"
 	"base-website/ent/notification"
+	"base-website/ent/rolegrant"
+	"base-website/ent/sanction"
 	"base-website/ent/team"
 	"base-website/ent/teammember"
 	"base-website/ent/tournament"
@@ -14,6 +17,7 @@ import (
 	"base-website/ent/user"
 	"base-website/ent/uservote"
 	"base-website/ent/vote"
+	"base-website/ent/votetemplate"
 	"context"
 	"errors"
 	"fmt"
@@ -146,6 +150,34 @@ func (_c *UserCreate) SetNillableElo(v *int) *UserCreate {
 	return _c
 }
 
+// SetCampus sets the "campus" field.
+func (_c *UserCreate) SetCampus(v string) *UserCreate {
+	_c.mutation.SetCampus(v)
+	return _c
+}
+
+// SetNillableCampus sets the "campus" field if the given value is not nil.
+func (_c *UserCreate) SetNillableCampus(v *string) *UserCreate {
+	if v != nil {
+		_c.SetCampus(*v)
+	}
+	return _c
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (_c *UserCreate) SetLastLoginAt(v time.Time) *UserCreate {
+	_c.mutation.SetLastLoginAt(v)
+	return _c
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (_c *UserCreate) SetNillableLastLoginAt(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetLastLoginAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *UserCreate) SetID(v int) *UserCreate {
 	_c.mutation.SetID(v)
@@ -182,6 +214,21 @@ func (_c *UserCreate) AddCreatedVotes(v ...*Vote) *UserCreate {
 	return _c.AddCreatedVoteIDs(ids...)
 }
 
+// AddCreatedVoteTemplateIDs adds the "created_vote_templates" edge to the VoteTemplate entity by IDs.
+func (_c *UserCreate) AddCreatedVoteTemplateIDs(ids ...int) *UserCreate {
+	_c.mutation.AddCreatedVoteTemplateIDs(ids...)
+	return _c
+}
+
+// AddCreatedVoteTemplates adds the "created_vote_templates" edges to the VoteTemplate entity.
+func (_c *UserCreate) AddCreatedVoteTemplates(v ...*VoteTemplate) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCreatedVoteTemplateIDs(ids...)
+}
+
 // AddAppIDs adds the "apps" edge to the App entity by IDs.
 func (_c *UserCreate) AddAppIDs(ids ...string) *UserCreate {
 	_c.mutation.AddAppIDs(ids...)
@@ -302,6 +349,111 @@ func (_c *UserCreate) AddNotifications(v ...*Notification) *UserCreate {
 	return _c.AddNotificationIDs(ids...)
 }
 
+// AddAuditLogIDs adds the "audit_logs" edge to the AuditLog entity by IDs.
+func (_c *UserCreate) AddAuditLogIDs(ids ...int) *UserCreate {
+	_c.mutation.AddAuditLogIDs(ids...)
+	return _c
+}
+
+// AddAuditLogs adds the "audit_logs" edges to the AuditLog entity.
+func (_c *UserCreate) AddAuditLogs(v ...*AuditLog) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAuditLogIDs(ids...)
+}
+
+// AddImpersonatedAuditLogIDs adds the "impersonated_audit_logs" edge to the AuditLog entity by IDs.
+func (_c *UserCreate) AddImpersonatedAuditLogIDs(ids ...int) *UserCreate {
+	_c.mutation.AddImpersonatedAuditLogIDs(ids...)
+	return _c
+}
+
+// AddImpersonatedAuditLogs adds the "impersonated_audit_logs" edges to the AuditLog entity.
+func (_c *UserCreate) AddImpersonatedAuditLogs(v ...*AuditLog) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddImpersonatedAuditLogIDs(ids...)
+}
+
+// AddRoleGrantIDs adds the "role_grants" edge to the RoleGrant entity by IDs.
+func (_c *UserCreate) AddRoleGrantIDs(ids ...int) *UserCreate {
+	_c.mutation.AddRoleGrantIDs(ids...)
+	return _c
+}
+
+// AddRoleGrants adds the "role_grants" edges to the RoleGrant entity.
+func (_c *UserCreate) AddRoleGrants(v ...*RoleGrant) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRoleGrantIDs(ids...)
+}
+
+// AddGivenRoleGrantIDs adds the "given_role_grants" edge to the RoleGrant entity by IDs.
+func (_c *UserCreate) AddGivenRoleGrantIDs(ids ...int) *UserCreate {
+	_c.mutation.AddGivenRoleGrantIDs(ids...)
+	return _c
+}
+
+// AddGivenRoleGrants adds the "given_role_grants" edges to the RoleGrant entity.
+func (_c *UserCreate) AddGivenRoleGrants(v ...*RoleGrant) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddGivenRoleGrantIDs(ids...)
+}
+
+// AddSanctionIDs adds the "sanctions" edge to the Sanction entity by IDs.
+func (_c *UserCreate) AddSanctionIDs(ids ...int) *UserCreate {
+	_c.mutation.AddSanctionIDs(ids...)
+	return _c
+}
+
+// AddSanctions adds the "sanctions" edges to the Sanction entity.
+func (_c *UserCreate) AddSanctions(v ...*Sanction) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSanctionIDs(ids...)
+}
+
+// AddIssuedSanctionIDs adds the "issued_sanctions" edge to the Sanction entity by IDs.
+func (_c *UserCreate) AddIssuedSanctionIDs(ids ...int) *UserCreate {
+	_c.mutation.AddIssuedSanctionIDs(ids...)
+	return _c
+}
+
+// AddIssuedSanctions adds the "issued_sanctions" edges to the Sanction entity.
+func (_c *UserCreate) AddIssuedSanctions(v ...*Sanction) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddIssuedSanctionIDs(ids...)
+}
+
+// AddLiftedSanctionIDs adds the "lifted_sanctions" edge to the Sanction entity by IDs.
+func (_c *UserCreate) AddLiftedSanctionIDs(ids ...int) *UserCreate {
+	_c.mutation.AddLiftedSanctionIDs(ids...)
+	return _c
+}
+
+// AddLiftedSanctions adds the "lifted_sanctions" edges to the Sanction entity.
+func (_c *UserCreate) AddLiftedSanctions(v ...*Sanction) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLiftedSanctionIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_c *UserCreate) Mutation() *UserMutation {
 	return _c.mutation
@@ -459,6 +611,14 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldElo, field.TypeInt, value)
 		_node.Elo = value
 	}
+	if value, ok := _c.mutation.Campus(); ok {
+		_spec.SetField(user.FieldCampus, field.TypeString, value)
+		_node.Campus = &value
+	}
+	if value, ok := _c.mutation.LastLoginAt(); ok {
+		_spec.SetField(user.FieldLastLoginAt, field.TypeTime, value)
+		_node.LastLoginAt = &value
+	}
 	if nodes := _c.mutation.UserVotesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -491,6 +651,22 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.CreatedVoteTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreatedVoteTemplatesTable,
+			Columns: []string{user.CreatedVoteTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(votetemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.AppsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -619,6 +795,118 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.AuditLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditLogsTable,
+			Columns: []string{user.AuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ImpersonatedAuditLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ImpersonatedAuditLogsTable,
+			Columns: []string{user.ImpersonatedAuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RoleGrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RoleGrantsTable,
+			Columns: []string{user.RoleGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolegrant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.GivenRoleGrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GivenRoleGrantsTable,
+			Columns: []string{user.GivenRoleGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolegrant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SanctionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SanctionsTable,
+			Columns: []string{user.SanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.IssuedSanctionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IssuedSanctionsTable,
+			Columns: []string{user.IssuedSanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LiftedSanctionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LiftedSanctionsTable,
+			Columns: []string{user.LiftedSanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -4,10 +4,13 @@ package ent
 
 import (
 	"base-website/ent/app"
+	"base-website/ent/auditlog"
 	"base-website/ent/consent"
 	"base-website/ent/invitation"
 	"base-website/ent/notification"
 	"base-website/ent/predicate"
+	"base-website/ent/rolegrant"
+	"base-website/ent/sanction"
 	"base-website/ent/team"
 	"base-website/ent/teammember"
 	"base-website/ent/tournament"
@@ -15,12 +18,14 @@ import (
 	"base-website/ent/user"
 	"base-website/ent/uservote"
 	"base-website/ent/vote"
+	"base-website/ent/votetemplate"
 	"context"
 	"database/sql/driver"
 	"fmt"
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -29,20 +34,29 @@ import (
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	ctx                     *QueryContext
-	order                   []user.OrderOption
-	inters                  []Interceptor
-	predicates              []predicate.User
-	withUserVotes           *UserVoteQuery
-	withCreatedVotes        *VoteQuery
-	withApps                *AppQuery
-	withConsents            *ConsentQuery
-	withTeamMemberships     *TeamMemberQuery
-	withReceivedInvitations *InvitationQuery
-	withCreatedTeams        *TeamQuery
-	withCreatedTournaments  *TournamentQuery
-	withTournamentAdmins    *TournamentAdminQuery
-	withNotifications       *NotificationQuery
+	ctx                       *QueryContext
+	order                     []user.OrderOption
+	inters                    []Interceptor
+	predicates                []predicate.User
+	withUserVotes             *UserVoteQuery
+	withCreatedVotes          *VoteQuery
+	withCreatedVoteTemplates  *VoteTemplateQuery
+	withApps                  *AppQuery
+	withConsents              *ConsentQuery
+	withTeamMemberships       *TeamMemberQuery
+	withReceivedInvitations   *InvitationQuery
+	withCreatedTeams          *TeamQuery
+	withCreatedTournaments    *TournamentQuery
+	withTournamentAdmins      *TournamentAdminQuery
+	withNotifications         *NotificationQuery
+	withAuditLogs             *AuditLogQuery
+	withImpersonatedAuditLogs *AuditLogQuery
+	withRoleGrants            *RoleGrantQuery
+	withGivenRoleGrants       *RoleGrantQuery
+	withSanctions             *SanctionQuery
+	withIssuedSanctions       *SanctionQuery
+	withLiftedSanctions       *SanctionQuery
+	modifiers                 []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -123,6 +137,28 @@ func (_q *UserQuery) QueryCreatedVotes() *VoteQuery {
 	return query
 }
 
+// QueryCreatedVoteTemplates chains the current query on the "created_vote_templates" edge.
+func (_q *UserQuery) QueryCreatedVoteTemplates() *VoteTemplateQuery {
+	query := (&VoteTemplateClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(votetemplate.Table, votetemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.CreatedVoteTemplatesTable, user.CreatedVoteTemplatesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryApps chains the current query on the "apps" edge.
 func (_q *UserQuery) QueryApps() *AppQuery {
 	query := (&AppClient{config: _q.config}).Query()
@@ -299,6 +335,160 @@ func (_q *UserQuery) QueryNotifications() *NotificationQuery {
 	return query
 }
 
+// QueryAuditLogs chains the current query on the "audit_logs" edge.
+func (_q *UserQuery) QueryAuditLogs() *AuditLogQuery {
+	query := (&AuditLogClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(auditlog.Table, auditlog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AuditLogsTable, user.AuditLogsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryImpersonatedAuditLogs chains the current query on the "impersonated_audit_logs" edge.
+func (_q *UserQuery) QueryImpersonatedAuditLogs() *AuditLogQuery {
+	query := (&AuditLogClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(auditlog.Table, auditlog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ImpersonatedAuditLogsTable, user.ImpersonatedAuditLogsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRoleGrants chains the current query on the "role_grants" edge.
+func (_q *UserQuery) QueryRoleGrants() *RoleGrantQuery {
+	query := (&RoleGrantClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(rolegrant.Table, rolegrant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RoleGrantsTable, user.RoleGrantsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGivenRoleGrants chains the current query on the "given_role_grants" edge.
+func (_q *UserQuery) QueryGivenRoleGrants() *RoleGrantQuery {
+	query := (&RoleGrantClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(rolegrant.Table, rolegrant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.GivenRoleGrantsTable, user.GivenRoleGrantsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySanctions chains the current query on the "sanctions" edge.
+func (_q *UserQuery) QuerySanctions() *SanctionQuery {
+	query := (&SanctionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(sanction.Table, sanction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SanctionsTable, user.SanctionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryIssuedSanctions chains the current query on the "issued_sanctions" edge.
+func (_q *UserQuery) QueryIssuedSanctions() *SanctionQuery {
+	query := (&SanctionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(sanction.Table, sanction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.IssuedSanctionsTable, user.IssuedSanctionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLiftedSanctions chains the current query on the "lifted_sanctions" edge.
+func (_q *UserQuery) QueryLiftedSanctions() *SanctionQuery {
+	query := (&SanctionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(sanction.Table, sanction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LiftedSanctionsTable, user.LiftedSanctionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (_q *UserQuery) First(ctx context.Context) (*User, error) {
@@ -486,24 +676,33 @@ func (_q *UserQuery) Clone() *UserQuery {
 		return nil
 	}
 	return &UserQuery{
-		config:                  _q.config,
-		ctx:                     _q.ctx.Clone(),
-		order:                   append([]user.OrderOption{}, _q.order...),
-		inters:                  append([]Interceptor{}, _q.inters...),
-		predicates:              append([]predicate.User{}, _q.predicates...),
-		withUserVotes:           _q.withUserVotes.Clone(),
-		withCreatedVotes:        _q.withCreatedVotes.Clone(),
-		withApps:                _q.withApps.Clone(),
-		withConsents:            _q.withConsents.Clone(),
-		withTeamMemberships:     _q.withTeamMemberships.Clone(),
-		withReceivedInvitations: _q.withReceivedInvitations.Clone(),
-		withCreatedTeams:        _q.withCreatedTeams.Clone(),
-		withCreatedTournaments:  _q.withCreatedTournaments.Clone(),
-		withTournamentAdmins:    _q.withTournamentAdmins.Clone(),
-		withNotifications:       _q.withNotifications.Clone(),
+		config:                    _q.config,
+		ctx:                       _q.ctx.Clone(),
+		order:                     append([]user.OrderOption{}, _q.order...),
+		inters:                    append([]Interceptor{}, _q.inters...),
+		predicates:                append([]predicate.User{}, _q.predicates...),
+		withUserVotes:             _q.withUserVotes.Clone(),
+		withCreatedVotes:          _q.withCreatedVotes.Clone(),
+		withCreatedVoteTemplates:  _q.withCreatedVoteTemplates.Clone(),
+		withApps:                  _q.withApps.Clone(),
+		withConsents:              _q.withConsents.Clone(),
+		withTeamMemberships:       _q.withTeamMemberships.Clone(),
+		withReceivedInvitations:   _q.withReceivedInvitations.Clone(),
+		withCreatedTeams:          _q.withCreatedTeams.Clone(),
+		withCreatedTournaments:    _q.withCreatedTournaments.Clone(),
+		withTournamentAdmins:      _q.withTournamentAdmins.Clone(),
+		withNotifications:         _q.withNotifications.Clone(),
+		withAuditLogs:             _q.withAuditLogs.Clone(),
+		withImpersonatedAuditLogs: _q.withImpersonatedAuditLogs.Clone(),
+		withRoleGrants:            _q.withRoleGrants.Clone(),
+		withGivenRoleGrants:       _q.withGivenRoleGrants.Clone(),
+		withSanctions:             _q.withSanctions.Clone(),
+		withIssuedSanctions:       _q.withIssuedSanctions.Clone(),
+		withLiftedSanctions:       _q.withLiftedSanctions.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -529,6 +728,17 @@ func (_q *UserQuery) WithCreatedVotes(opts ...func(*VoteQuery)) *UserQuery {
 	return _q
 }
 
+// WithCreatedVoteTemplates tells the query-builder to eager-load the nodes that are connected to
+// the "created_vote_templates" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithCreatedVoteTemplates(opts ...func(*VoteTemplateQuery)) *UserQuery {
+	query := (&VoteTemplateClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCreatedVoteTemplates = query
+	return _q
+}
+
 // WithApps tells the query-builder to eager-load the nodes that are connected to
 // the "apps" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *UserQuery) WithApps(opts ...func(*AppQuery)) *UserQuery {
@@ -617,6 +827,83 @@ func (_q *UserQuery) WithNotifications(opts ...func(*NotificationQuery)) *UserQu
 	return _q
 }
 
+// WithAuditLogs tells the query-builder to eager-load the nodes that are connected to
+// the "audit_logs" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithAuditLogs(opts ...func(*AuditLogQuery)) *UserQuery {
+	query := (&AuditLogClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAuditLogs = query
+	return _q
+}
+
+// WithImpersonatedAuditLogs tells the query-builder to eager-load the nodes that are connected to
+// the "impersonated_audit_logs" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithImpersonatedAuditLogs(opts ...func(*AuditLogQuery)) *UserQuery {
+	query := (&AuditLogClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withImpersonatedAuditLogs = query
+	return _q
+}
+
+// WithRoleGrants tells the query-builder to eager-load the nodes that are connected to
+// the "role_grants" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithRoleGrants(opts ...func(*RoleGrantQuery)) *UserQuery {
+	query := (&RoleGrantClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRoleGrants = query
+	return _q
+}
+
+// WithGivenRoleGrants tells the query-builder to eager-load the nodes that are connected to
+// the "given_role_grants" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithGivenRoleGrants(opts ...func(*RoleGrantQuery)) *UserQuery {
+	query := (&RoleGrantClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withGivenRoleGrants = query
+	return _q
+}
+
+// WithSanctions tells the query-builder to eager-load the nodes that are connected to
+// the "sanctions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithSanctions(opts ...func(*SanctionQuery)) *UserQuery {
+	query := (&SanctionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSanctions = query
+	return _q
+}
+
+// WithIssuedSanctions tells the query-builder to eager-load the nodes that are connected to
+// the "issued_sanctions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithIssuedSanctions(opts ...func(*SanctionQuery)) *UserQuery {
+	query := (&SanctionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withIssuedSanctions = query
+	return _q
+}
+
+// WithLiftedSanctions tells the query-builder to eager-load the nodes that are connected to
+// the "lifted_sanctions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithLiftedSanctions(opts ...func(*SanctionQuery)) *UserQuery {
+	query := (&SanctionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLiftedSanctions = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -695,9 +982,10 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = _q.querySpec()
-		loadedTypes = [10]bool{
+		loadedTypes = [18]bool{
 			_q.withUserVotes != nil,
 			_q.withCreatedVotes != nil,
+			_q.withCreatedVoteTemplates != nil,
 			_q.withApps != nil,
 			_q.withConsents != nil,
 			_q.withTeamMemberships != nil,
@@ -706,6 +994,13 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			_q.withCreatedTournaments != nil,
 			_q.withTournamentAdmins != nil,
 			_q.withNotifications != nil,
+			_q.withAuditLogs != nil,
+			_q.withImpersonatedAuditLogs != nil,
+			_q.withRoleGrants != nil,
+			_q.withGivenRoleGrants != nil,
+			_q.withSanctions != nil,
+			_q.withIssuedSanctions != nil,
+			_q.withLiftedSanctions != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -717,6 +1012,9 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -740,6 +1038,13 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withCreatedVoteTemplates; query != nil {
+		if err := _q.loadCreatedVoteTemplates(ctx, query, nodes,
+			func(n *User) { n.Edges.CreatedVoteTemplates = []*VoteTemplate{} },
+			func(n *User, e *VoteTemplate) { n.Edges.CreatedVoteTemplates = append(n.Edges.CreatedVoteTemplates, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withApps; query != nil {
 		if err := _q.loadApps(ctx, query, nodes,
 			func(n *User) { n.Edges.Apps = []*App{} },
@@ -796,6 +1101,55 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withAuditLogs; query != nil {
+		if err := _q.loadAuditLogs(ctx, query, nodes,
+			func(n *User) { n.Edges.AuditLogs = []*AuditLog{} },
+			func(n *User, e *AuditLog) { n.Edges.AuditLogs = append(n.Edges.AuditLogs, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withImpersonatedAuditLogs; query != nil {
+		if err := _q.loadImpersonatedAuditLogs(ctx, query, nodes,
+			func(n *User) { n.Edges.ImpersonatedAuditLogs = []*AuditLog{} },
+			func(n *User, e *AuditLog) { n.Edges.ImpersonatedAuditLogs = append(n.Edges.ImpersonatedAuditLogs, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRoleGrants; query != nil {
+		if err := _q.loadRoleGrants(ctx, query, nodes,
+			func(n *User) { n.Edges.RoleGrants = []*RoleGrant{} },
+			func(n *User, e *RoleGrant) { n.Edges.RoleGrants = append(n.Edges.RoleGrants, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withGivenRoleGrants; query != nil {
+		if err := _q.loadGivenRoleGrants(ctx, query, nodes,
+			func(n *User) { n.Edges.GivenRoleGrants = []*RoleGrant{} },
+			func(n *User, e *RoleGrant) { n.Edges.GivenRoleGrants = append(n.Edges.GivenRoleGrants, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSanctions; query != nil {
+		if err := _q.loadSanctions(ctx, query, nodes,
+			func(n *User) { n.Edges.Sanctions = []*Sanction{} },
+			func(n *User, e *Sanction) { n.Edges.Sanctions = append(n.Edges.Sanctions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withIssuedSanctions; query != nil {
+		if err := _q.loadIssuedSanctions(ctx, query, nodes,
+			func(n *User) { n.Edges.IssuedSanctions = []*Sanction{} },
+			func(n *User, e *Sanction) { n.Edges.IssuedSanctions = append(n.Edges.IssuedSanctions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLiftedSanctions; query != nil {
+		if err := _q.loadLiftedSanctions(ctx, query, nodes,
+			func(n *User) { n.Edges.LiftedSanctions = []*Sanction{} },
+			func(n *User, e *Sanction) { n.Edges.LiftedSanctions = append(n.Edges.LiftedSanctions, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -861,6 +1215,37 @@ func (_q *UserQuery) loadCreatedVotes(ctx context.Context, query *VoteQuery, nod
 	}
 	return nil
 }
+func (_q *UserQuery) loadCreatedVoteTemplates(ctx context.Context, query *VoteTemplateQuery, nodes []*User, init func(*User), assign func(*User, *VoteTemplate)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.VoteTemplate(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.CreatedVoteTemplatesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_created_vote_templates
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_created_vote_templates" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_created_vote_templates" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *UserQuery) loadApps(ctx context.Context, query *AppQuery, nodes []*User, init func(*User), assign func(*User, *App)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*User)
@@ -1107,9 +1492,229 @@ func (_q *UserQuery) loadNotifications(ctx context.Context, query *NotificationQ
 	}
 	return nil
 }
+func (_q *UserQuery) loadAuditLogs(ctx context.Context, query *AuditLogQuery, nodes []*User, init func(*User), assign func(*User, *AuditLog)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.AuditLogsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_audit_logs
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_audit_logs" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_audit_logs" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadImpersonatedAuditLogs(ctx context.Context, query *AuditLogQuery, nodes []*User, init func(*User), assign func(*User, *AuditLog)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ImpersonatedAuditLogsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_impersonated_audit_logs
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_impersonated_audit_logs" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_impersonated_audit_logs" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadRoleGrants(ctx context.Context, query *RoleGrantQuery, nodes []*User, init func(*User), assign func(*User, *RoleGrant)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.RoleGrant(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.RoleGrantsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_role_grants
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_role_grants" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_role_grants" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadGivenRoleGrants(ctx context.Context, query *RoleGrantQuery, nodes []*User, init func(*User), assign func(*User, *RoleGrant)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.RoleGrant(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.GivenRoleGrantsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_given_role_grants
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_given_role_grants" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_given_role_grants" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadSanctions(ctx context.Context, query *SanctionQuery, nodes []*User, init func(*User), assign func(*User, *Sanction)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Sanction(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.SanctionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_sanctions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_sanctions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_sanctions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadIssuedSanctions(ctx context.Context, query *SanctionQuery, nodes []*User, init func(*User), assign func(*User, *Sanction)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Sanction(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.IssuedSanctionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_issued_sanctions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_issued_sanctions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_issued_sanctions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadLiftedSanctions(ctx context.Context, query *SanctionQuery, nodes []*User, init func(*User), assign func(*User, *Sanction)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Sanction(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.LiftedSanctionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_lifted_sanctions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_lifted_sanctions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_lifted_sanctions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -1172,6 +1777,9 @@ func (_q *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -1189,6 +1797,38 @@ func (_q *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *UserQuery) ForUpdate(opts ...sql.LockOption) *UserQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *UserQuery) ForShare(opts ...sql.LockOption) *UserQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *UserQuery) Modify(modifiers ...func(s *sql.Selector)) *UserSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // UserGroupBy is the group-by builder for User entities.
 type UserGroupBy struct {
 	selector
@@ -1278,3 +1918,9 @@ func (_s *UserSelect) sqlScan(ctx context.Context, root *UserQuery, v any) error
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *UserSelect) Modify(modifiers ...func(s *sql.Selector)) *UserSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
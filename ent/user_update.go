@@ -4,10 +4,13 @@ package ent
 
 import (
 	"base-website/ent/app"
+	"base-website/ent/auditlog"
 	"base-website/ent/consent"
 	"base-website/ent/invitation"
 	"base-website/ent/notification"
 	"base-website/ent/predicate"
+	"base-website/ent/rolegrant"
+	"base-website/ent/sanction"
 	"base-website/ent/team"
 	"base-website/ent/teammember"
 	"base-website/ent/tournament"
@@ -15,6 +18,7 @@ import (
 	"base-website/ent/user"
 	"base-website/ent/uservote"
 	"base-website/ent/vote"
+	"base-website/ent/votetemplate"
 	"context"
 	"errors"
 	"fmt"
@@ -29,8 +33,9 @@ import (
 // UserUpdate is the builder for updating User entities.
 type UserUpdate struct {
 	config
-	hooks    []Hook
-	mutation *UserMutation
+	hooks     []Hook
+	mutation  *UserMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the UserUpdate builder.
@@ -187,6 +192,46 @@ func (_u *UserUpdate) AddElo(v int) *UserUpdate {
 	return _u
 }
 
+// SetCampus sets the "campus" field.
+func (_u *UserUpdate) SetCampus(v string) *UserUpdate {
+	_u.mutation.SetCampus(v)
+	return _u
+}
+
+// SetNillableCampus sets the "campus" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableCampus(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetCampus(*v)
+	}
+	return _u
+}
+
+// ClearCampus clears the value of the "campus" field.
+func (_u *UserUpdate) ClearCampus() *UserUpdate {
+	_u.mutation.ClearCampus()
+	return _u
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (_u *UserUpdate) SetLastLoginAt(v time.Time) *UserUpdate {
+	_u.mutation.SetLastLoginAt(v)
+	return _u
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableLastLoginAt(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetLastLoginAt(*v)
+	}
+	return _u
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (_u *UserUpdate) ClearLastLoginAt() *UserUpdate {
+	_u.mutation.ClearLastLoginAt()
+	return _u
+}
+
 // AddUserVoteIDs adds the "user_votes" edge to the UserVote entity by IDs.
 func (_u *UserUpdate) AddUserVoteIDs(ids ...int) *UserUpdate {
 	_u.mutation.AddUserVoteIDs(ids...)
@@ -217,6 +262,21 @@ func (_u *UserUpdate) AddCreatedVotes(v ...*Vote) *UserUpdate {
 	return _u.AddCreatedVoteIDs(ids...)
 }
 
+// AddCreatedVoteTemplateIDs adds the "created_vote_templates" edge to the VoteTemplate entity by IDs.
+func (_u *UserUpdate) AddCreatedVoteTemplateIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddCreatedVoteTemplateIDs(ids...)
+	return _u
+}
+
+// AddCreatedVoteTemplates adds the "created_vote_templates" edges to the VoteTemplate entity.
+func (_u *UserUpdate) AddCreatedVoteTemplates(v ...*VoteTemplate) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCreatedVoteTemplateIDs(ids...)
+}
+
 // AddAppIDs adds the "apps" edge to the App entity by IDs.
 func (_u *UserUpdate) AddAppIDs(ids ...string) *UserUpdate {
 	_u.mutation.AddAppIDs(ids...)
@@ -337,6 +397,111 @@ func (_u *UserUpdate) AddNotifications(v ...*Notification) *UserUpdate {
 	return _u.AddNotificationIDs(ids...)
 }
 
+// AddAuditLogIDs adds the "audit_logs" edge to the AuditLog entity by IDs.
+func (_u *UserUpdate) AddAuditLogIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddAuditLogIDs(ids...)
+	return _u
+}
+
+// AddAuditLogs adds the "audit_logs" edges to the AuditLog entity.
+func (_u *UserUpdate) AddAuditLogs(v ...*AuditLog) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAuditLogIDs(ids...)
+}
+
+// AddImpersonatedAuditLogIDs adds the "impersonated_audit_logs" edge to the AuditLog entity by IDs.
+func (_u *UserUpdate) AddImpersonatedAuditLogIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddImpersonatedAuditLogIDs(ids...)
+	return _u
+}
+
+// AddImpersonatedAuditLogs adds the "impersonated_audit_logs" edges to the AuditLog entity.
+func (_u *UserUpdate) AddImpersonatedAuditLogs(v ...*AuditLog) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddImpersonatedAuditLogIDs(ids...)
+}
+
+// AddRoleGrantIDs adds the "role_grants" edge to the RoleGrant entity by IDs.
+func (_u *UserUpdate) AddRoleGrantIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddRoleGrantIDs(ids...)
+	return _u
+}
+
+// AddRoleGrants adds the "role_grants" edges to the RoleGrant entity.
+func (_u *UserUpdate) AddRoleGrants(v ...*RoleGrant) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRoleGrantIDs(ids...)
+}
+
+// AddGivenRoleGrantIDs adds the "given_role_grants" edge to the RoleGrant entity by IDs.
+func (_u *UserUpdate) AddGivenRoleGrantIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddGivenRoleGrantIDs(ids...)
+	return _u
+}
+
+// AddGivenRoleGrants adds the "given_role_grants" edges to the RoleGrant entity.
+func (_u *UserUpdate) AddGivenRoleGrants(v ...*RoleGrant) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddGivenRoleGrantIDs(ids...)
+}
+
+// AddSanctionIDs adds the "sanctions" edge to the Sanction entity by IDs.
+func (_u *UserUpdate) AddSanctionIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddSanctionIDs(ids...)
+	return _u
+}
+
+// AddSanctions adds the "sanctions" edges to the Sanction entity.
+func (_u *UserUpdate) AddSanctions(v ...*Sanction) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSanctionIDs(ids...)
+}
+
+// AddIssuedSanctionIDs adds the "issued_sanctions" edge to the Sanction entity by IDs.
+func (_u *UserUpdate) AddIssuedSanctionIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddIssuedSanctionIDs(ids...)
+	return _u
+}
+
+// AddIssuedSanctions adds the "issued_sanctions" edges to the Sanction entity.
+func (_u *UserUpdate) AddIssuedSanctions(v ...*Sanction) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddIssuedSanctionIDs(ids...)
+}
+
+// AddLiftedSanctionIDs adds the "lifted_sanctions" edge to the Sanction entity by IDs.
+func (_u *UserUpdate) AddLiftedSanctionIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddLiftedSanctionIDs(ids...)
+	return _u
+}
+
+// AddLiftedSanctions adds the "lifted_sanctions" edges to the Sanction entity.
+func (_u *UserUpdate) AddLiftedSanctions(v ...*Sanction) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLiftedSanctionIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
@@ -384,6 +549,27 @@ func (_u *UserUpdate) RemoveCreatedVotes(v ...*Vote) *UserUpdate {
 	return _u.RemoveCreatedVoteIDs(ids...)
 }
 
+// ClearCreatedVoteTemplates clears all "created_vote_templates" edges to the VoteTemplate entity.
+func (_u *UserUpdate) ClearCreatedVoteTemplates() *UserUpdate {
+	_u.mutation.ClearCreatedVoteTemplates()
+	return _u
+}
+
+// RemoveCreatedVoteTemplateIDs removes the "created_vote_templates" edge to VoteTemplate entities by IDs.
+func (_u *UserUpdate) RemoveCreatedVoteTemplateIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveCreatedVoteTemplateIDs(ids...)
+	return _u
+}
+
+// RemoveCreatedVoteTemplates removes "created_vote_templates" edges to VoteTemplate entities.
+func (_u *UserUpdate) RemoveCreatedVoteTemplates(v ...*VoteTemplate) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCreatedVoteTemplateIDs(ids...)
+}
+
 // ClearApps clears all "apps" edges to the App entity.
 func (_u *UserUpdate) ClearApps() *UserUpdate {
 	_u.mutation.ClearApps()
@@ -552,6 +738,153 @@ func (_u *UserUpdate) RemoveNotifications(v ...*Notification) *UserUpdate {
 	return _u.RemoveNotificationIDs(ids...)
 }
 
+// ClearAuditLogs clears all "audit_logs" edges to the AuditLog entity.
+func (_u *UserUpdate) ClearAuditLogs() *UserUpdate {
+	_u.mutation.ClearAuditLogs()
+	return _u
+}
+
+// RemoveAuditLogIDs removes the "audit_logs" edge to AuditLog entities by IDs.
+func (_u *UserUpdate) RemoveAuditLogIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveAuditLogIDs(ids...)
+	return _u
+}
+
+// RemoveAuditLogs removes "audit_logs" edges to AuditLog entities.
+func (_u *UserUpdate) RemoveAuditLogs(v ...*AuditLog) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAuditLogIDs(ids...)
+}
+
+// ClearImpersonatedAuditLogs clears all "impersonated_audit_logs" edges to the AuditLog entity.
+func (_u *UserUpdate) ClearImpersonatedAuditLogs() *UserUpdate {
+	_u.mutation.ClearImpersonatedAuditLogs()
+	return _u
+}
+
+// RemoveImpersonatedAuditLogIDs removes the "impersonated_audit_logs" edge to AuditLog entities by IDs.
+func (_u *UserUpdate) RemoveImpersonatedAuditLogIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveImpersonatedAuditLogIDs(ids...)
+	return _u
+}
+
+// RemoveImpersonatedAuditLogs removes "impersonated_audit_logs" edges to AuditLog entities.
+func (_u *UserUpdate) RemoveImpersonatedAuditLogs(v ...*AuditLog) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveImpersonatedAuditLogIDs(ids...)
+}
+
+// ClearRoleGrants clears all "role_grants" edges to the RoleGrant entity.
+func (_u *UserUpdate) ClearRoleGrants() *UserUpdate {
+	_u.mutation.ClearRoleGrants()
+	return _u
+}
+
+// RemoveRoleGrantIDs removes the "role_grants" edge to RoleGrant entities by IDs.
+func (_u *UserUpdate) RemoveRoleGrantIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveRoleGrantIDs(ids...)
+	return _u
+}
+
+// RemoveRoleGrants removes "role_grants" edges to RoleGrant entities.
+func (_u *UserUpdate) RemoveRoleGrants(v ...*RoleGrant) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRoleGrantIDs(ids...)
+}
+
+// ClearGivenRoleGrants clears all "given_role_grants" edges to the RoleGrant entity.
+func (_u *UserUpdate) ClearGivenRoleGrants() *UserUpdate {
+	_u.mutation.ClearGivenRoleGrants()
+	return _u
+}
+
+// RemoveGivenRoleGrantIDs removes the "given_role_grants" edge to RoleGrant entities by IDs.
+func (_u *UserUpdate) RemoveGivenRoleGrantIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveGivenRoleGrantIDs(ids...)
+	return _u
+}
+
+// RemoveGivenRoleGrants removes "given_role_grants" edges to RoleGrant entities.
+func (_u *UserUpdate) RemoveGivenRoleGrants(v ...*RoleGrant) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveGivenRoleGrantIDs(ids...)
+}
+
+// ClearSanctions clears all "sanctions" edges to the Sanction entity.
+func (_u *UserUpdate) ClearSanctions() *UserUpdate {
+	_u.mutation.ClearSanctions()
+	return _u
+}
+
+// RemoveSanctionIDs removes the "sanctions" edge to Sanction entities by IDs.
+func (_u *UserUpdate) RemoveSanctionIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveSanctionIDs(ids...)
+	return _u
+}
+
+// RemoveSanctions removes "sanctions" edges to Sanction entities.
+func (_u *UserUpdate) RemoveSanctions(v ...*Sanction) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSanctionIDs(ids...)
+}
+
+// ClearIssuedSanctions clears all "issued_sanctions" edges to the Sanction entity.
+func (_u *UserUpdate) ClearIssuedSanctions() *UserUpdate {
+	_u.mutation.ClearIssuedSanctions()
+	return _u
+}
+
+// RemoveIssuedSanctionIDs removes the "issued_sanctions" edge to Sanction entities by IDs.
+func (_u *UserUpdate) RemoveIssuedSanctionIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveIssuedSanctionIDs(ids...)
+	return _u
+}
+
+// RemoveIssuedSanctions removes "issued_sanctions" edges to Sanction entities.
+func (_u *UserUpdate) RemoveIssuedSanctions(v ...*Sanction) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveIssuedSanctionIDs(ids...)
+}
+
+// ClearLiftedSanctions clears all "lifted_sanctions" edges to the Sanction entity.
+func (_u *UserUpdate) ClearLiftedSanctions() *UserUpdate {
+	_u.mutation.ClearLiftedSanctions()
+	return _u
+}
+
+// RemoveLiftedSanctionIDs removes the "lifted_sanctions" edge to Sanction entities by IDs.
+func (_u *UserUpdate) RemoveLiftedSanctionIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveLiftedSanctionIDs(ids...)
+	return _u
+}
+
+// RemoveLiftedSanctions removes "lifted_sanctions" edges to Sanction entities.
+func (_u *UserUpdate) RemoveLiftedSanctions(v ...*Sanction) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLiftedSanctionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -598,6 +931,12 @@ func (_u *UserUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UserUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -657,6 +996,18 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedElo(); ok {
 		_spec.AddField(user.FieldElo, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Campus(); ok {
+		_spec.SetField(user.FieldCampus, field.TypeString, value)
+	}
+	if _u.mutation.CampusCleared() {
+		_spec.ClearField(user.FieldCampus, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastLoginAt(); ok {
+		_spec.SetField(user.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastLoginAtCleared() {
+		_spec.ClearField(user.FieldLastLoginAt, field.TypeTime)
+	}
 	if _u.mutation.UserVotesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -747,6 +1098,51 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.CreatedVoteTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreatedVoteTemplatesTable,
+			Columns: []string{user.CreatedVoteTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(votetemplate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCreatedVoteTemplatesIDs(); len(nodes) > 0 && !_u.mutation.CreatedVoteTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreatedVoteTemplatesTable,
+			Columns: []string{user.CreatedVoteTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(votetemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CreatedVoteTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreatedVoteTemplatesTable,
+			Columns: []string{user.CreatedVoteTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(votetemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.AppsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1107,30 +1503,347 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.AuditLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditLogsTable,
+			Columns: []string{user.AuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
-}
-
-// SetUsername sets the "username" field.
-func (_u *UserUpdateOne) SetUsername(v string) *UserUpdateOne {
-	_u.mutation.SetUsername(v)
-	return _u
+	if nodes := _u.mutation.RemovedAuditLogsIDs(); len(nodes) > 0 && !_u.mutation.AuditLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditLogsTable,
+			Columns: []string{user.AuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AuditLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditLogsTable,
+			Columns: []string{user.AuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ImpersonatedAuditLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ImpersonatedAuditLogsTable,
+			Columns: []string{user.ImpersonatedAuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedImpersonatedAuditLogsIDs(); len(nodes) > 0 && !_u.mutation.ImpersonatedAuditLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ImpersonatedAuditLogsTable,
+			Columns: []string{user.ImpersonatedAuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ImpersonatedAuditLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ImpersonatedAuditLogsTable,
+			Columns: []string{user.ImpersonatedAuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RoleGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RoleGrantsTable,
+			Columns: []string{user.RoleGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolegrant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRoleGrantsIDs(); len(nodes) > 0 && !_u.mutation.RoleGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RoleGrantsTable,
+			Columns: []string{user.RoleGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolegrant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RoleGrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RoleGrantsTable,
+			Columns: []string{user.RoleGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolegrant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GivenRoleGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GivenRoleGrantsTable,
+			Columns: []string{user.GivenRoleGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolegrant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedGivenRoleGrantsIDs(); len(nodes) > 0 && !_u.mutation.GivenRoleGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GivenRoleGrantsTable,
+			Columns: []string{user.GivenRoleGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolegrant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GivenRoleGrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GivenRoleGrantsTable,
+			Columns: []string{user.GivenRoleGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolegrant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SanctionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SanctionsTable,
+			Columns: []string{user.SanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSanctionsIDs(); len(nodes) > 0 && !_u.mutation.SanctionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SanctionsTable,
+			Columns: []string{user.SanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SanctionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SanctionsTable,
+			Columns: []string{user.SanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.IssuedSanctionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IssuedSanctionsTable,
+			Columns: []string{user.IssuedSanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedIssuedSanctionsIDs(); len(nodes) > 0 && !_u.mutation.IssuedSanctionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IssuedSanctionsTable,
+			Columns: []string{user.IssuedSanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.IssuedSanctionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IssuedSanctionsTable,
+			Columns: []string{user.IssuedSanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LiftedSanctionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LiftedSanctionsTable,
+			Columns: []string{user.LiftedSanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLiftedSanctionsIDs(); len(nodes) > 0 && !_u.mutation.LiftedSanctionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LiftedSanctionsTable,
+			Columns: []string{user.LiftedSanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LiftedSanctionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LiftedSanctionsTable,
+			Columns: []string{user.LiftedSanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *UserMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUsername sets the "username" field.
+func (_u *UserUpdateOne) SetUsername(v string) *UserUpdateOne {
+	_u.mutation.SetUsername(v)
+	return _u
 }
 
 // SetNillableUsername sets the "username" field if the given value is not nil.
@@ -1275,6 +1988,46 @@ func (_u *UserUpdateOne) AddElo(v int) *UserUpdateOne {
 	return _u
 }
 
+// SetCampus sets the "campus" field.
+func (_u *UserUpdateOne) SetCampus(v string) *UserUpdateOne {
+	_u.mutation.SetCampus(v)
+	return _u
+}
+
+// SetNillableCampus sets the "campus" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableCampus(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetCampus(*v)
+	}
+	return _u
+}
+
+// ClearCampus clears the value of the "campus" field.
+func (_u *UserUpdateOne) ClearCampus() *UserUpdateOne {
+	_u.mutation.ClearCampus()
+	return _u
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (_u *UserUpdateOne) SetLastLoginAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetLastLoginAt(v)
+	return _u
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableLastLoginAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetLastLoginAt(*v)
+	}
+	return _u
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (_u *UserUpdateOne) ClearLastLoginAt() *UserUpdateOne {
+	_u.mutation.ClearLastLoginAt()
+	return _u
+}
+
 // AddUserVoteIDs adds the "user_votes" edge to the UserVote entity by IDs.
 func (_u *UserUpdateOne) AddUserVoteIDs(ids ...int) *UserUpdateOne {
 	_u.mutation.AddUserVoteIDs(ids...)
@@ -1305,6 +2058,21 @@ func (_u *UserUpdateOne) AddCreatedVotes(v ...*Vote) *UserUpdateOne {
 	return _u.AddCreatedVoteIDs(ids...)
 }
 
+// AddCreatedVoteTemplateIDs adds the "created_vote_templates" edge to the VoteTemplate entity by IDs.
+func (_u *UserUpdateOne) AddCreatedVoteTemplateIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddCreatedVoteTemplateIDs(ids...)
+	return _u
+}
+
+// AddCreatedVoteTemplates adds the "created_vote_templates" edges to the VoteTemplate entity.
+func (_u *UserUpdateOne) AddCreatedVoteTemplates(v ...*VoteTemplate) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCreatedVoteTemplateIDs(ids...)
+}
+
 // AddAppIDs adds the "apps" edge to the App entity by IDs.
 func (_u *UserUpdateOne) AddAppIDs(ids ...string) *UserUpdateOne {
 	_u.mutation.AddAppIDs(ids...)
@@ -1425,6 +2193,111 @@ func (_u *UserUpdateOne) AddNotifications(v ...*Notification) *UserUpdateOne {
 	return _u.AddNotificationIDs(ids...)
 }
 
+// AddAuditLogIDs adds the "audit_logs" edge to the AuditLog entity by IDs.
+func (_u *UserUpdateOne) AddAuditLogIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddAuditLogIDs(ids...)
+	return _u
+}
+
+// AddAuditLogs adds the "audit_logs" edges to the AuditLog entity.
+func (_u *UserUpdateOne) AddAuditLogs(v ...*AuditLog) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAuditLogIDs(ids...)
+}
+
+// AddImpersonatedAuditLogIDs adds the "impersonated_audit_logs" edge to the AuditLog entity by IDs.
+func (_u *UserUpdateOne) AddImpersonatedAuditLogIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddImpersonatedAuditLogIDs(ids...)
+	return _u
+}
+
+// AddImpersonatedAuditLogs adds the "impersonated_audit_logs" edges to the AuditLog entity.
+func (_u *UserUpdateOne) AddImpersonatedAuditLogs(v ...*AuditLog) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddImpersonatedAuditLogIDs(ids...)
+}
+
+// AddRoleGrantIDs adds the "role_grants" edge to the RoleGrant entity by IDs.
+func (_u *UserUpdateOne) AddRoleGrantIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddRoleGrantIDs(ids...)
+	return _u
+}
+
+// AddRoleGrants adds the "role_grants" edges to the RoleGrant entity.
+func (_u *UserUpdateOne) AddRoleGrants(v ...*RoleGrant) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRoleGrantIDs(ids...)
+}
+
+// AddGivenRoleGrantIDs adds the "given_role_grants" edge to the RoleGrant entity by IDs.
+func (_u *UserUpdateOne) AddGivenRoleGrantIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddGivenRoleGrantIDs(ids...)
+	return _u
+}
+
+// AddGivenRoleGrants adds the "given_role_grants" edges to the RoleGrant entity.
+func (_u *UserUpdateOne) AddGivenRoleGrants(v ...*RoleGrant) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddGivenRoleGrantIDs(ids...)
+}
+
+// AddSanctionIDs adds the "sanctions" edge to the Sanction entity by IDs.
+func (_u *UserUpdateOne) AddSanctionIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddSanctionIDs(ids...)
+	return _u
+}
+
+// AddSanctions adds the "sanctions" edges to the Sanction entity.
+func (_u *UserUpdateOne) AddSanctions(v ...*Sanction) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSanctionIDs(ids...)
+}
+
+// AddIssuedSanctionIDs adds the "issued_sanctions" edge to the Sanction entity by IDs.
+func (_u *UserUpdateOne) AddIssuedSanctionIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddIssuedSanctionIDs(ids...)
+	return _u
+}
+
+// AddIssuedSanctions adds the "issued_sanctions" edges to the Sanction entity.
+func (_u *UserUpdateOne) AddIssuedSanctions(v ...*Sanction) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddIssuedSanctionIDs(ids...)
+}
+
+// AddLiftedSanctionIDs adds the "lifted_sanctions" edge to the Sanction entity by IDs.
+func (_u *UserUpdateOne) AddLiftedSanctionIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddLiftedSanctionIDs(ids...)
+	return _u
+}
+
+// AddLiftedSanctions adds the "lifted_sanctions" edges to the Sanction entity.
+func (_u *UserUpdateOne) AddLiftedSanctions(v ...*Sanction) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLiftedSanctionIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdateOne) Mutation() *UserMutation {
 	return _u.mutation
@@ -1472,6 +2345,27 @@ func (_u *UserUpdateOne) RemoveCreatedVotes(v ...*Vote) *UserUpdateOne {
 	return _u.RemoveCreatedVoteIDs(ids...)
 }
 
+// ClearCreatedVoteTemplates clears all "created_vote_templates" edges to the VoteTemplate entity.
+func (_u *UserUpdateOne) ClearCreatedVoteTemplates() *UserUpdateOne {
+	_u.mutation.ClearCreatedVoteTemplates()
+	return _u
+}
+
+// RemoveCreatedVoteTemplateIDs removes the "created_vote_templates" edge to VoteTemplate entities by IDs.
+func (_u *UserUpdateOne) RemoveCreatedVoteTemplateIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveCreatedVoteTemplateIDs(ids...)
+	return _u
+}
+
+// RemoveCreatedVoteTemplates removes "created_vote_templates" edges to VoteTemplate entities.
+func (_u *UserUpdateOne) RemoveCreatedVoteTemplates(v ...*VoteTemplate) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCreatedVoteTemplateIDs(ids...)
+}
+
 // ClearApps clears all "apps" edges to the App entity.
 func (_u *UserUpdateOne) ClearApps() *UserUpdateOne {
 	_u.mutation.ClearApps()
@@ -1640,6 +2534,153 @@ func (_u *UserUpdateOne) RemoveNotifications(v ...*Notification) *UserUpdateOne
 	return _u.RemoveNotificationIDs(ids...)
 }
 
+// ClearAuditLogs clears all "audit_logs" edges to the AuditLog entity.
+func (_u *UserUpdateOne) ClearAuditLogs() *UserUpdateOne {
+	_u.mutation.ClearAuditLogs()
+	return _u
+}
+
+// RemoveAuditLogIDs removes the "audit_logs" edge to AuditLog entities by IDs.
+func (_u *UserUpdateOne) RemoveAuditLogIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveAuditLogIDs(ids...)
+	return _u
+}
+
+// RemoveAuditLogs removes "audit_logs" edges to AuditLog entities.
+func (_u *UserUpdateOne) RemoveAuditLogs(v ...*AuditLog) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAuditLogIDs(ids...)
+}
+
+// ClearImpersonatedAuditLogs clears all "impersonated_audit_logs" edges to the AuditLog entity.
+func (_u *UserUpdateOne) ClearImpersonatedAuditLogs() *UserUpdateOne {
+	_u.mutation.ClearImpersonatedAuditLogs()
+	return _u
+}
+
+// RemoveImpersonatedAuditLogIDs removes the "impersonated_audit_logs" edge to AuditLog entities by IDs.
+func (_u *UserUpdateOne) RemoveImpersonatedAuditLogIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveImpersonatedAuditLogIDs(ids...)
+	return _u
+}
+
+// RemoveImpersonatedAuditLogs removes "impersonated_audit_logs" edges to AuditLog entities.
+func (_u *UserUpdateOne) RemoveImpersonatedAuditLogs(v ...*AuditLog) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveImpersonatedAuditLogIDs(ids...)
+}
+
+// ClearRoleGrants clears all "role_grants" edges to the RoleGrant entity.
+func (_u *UserUpdateOne) ClearRoleGrants() *UserUpdateOne {
+	_u.mutation.ClearRoleGrants()
+	return _u
+}
+
+// RemoveRoleGrantIDs removes the "role_grants" edge to RoleGrant entities by IDs.
+func (_u *UserUpdateOne) RemoveRoleGrantIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveRoleGrantIDs(ids...)
+	return _u
+}
+
+// RemoveRoleGrants removes "role_grants" edges to RoleGrant entities.
+func (_u *UserUpdateOne) RemoveRoleGrants(v ...*RoleGrant) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRoleGrantIDs(ids...)
+}
+
+// ClearGivenRoleGrants clears all "given_role_grants" edges to the RoleGrant entity.
+func (_u *UserUpdateOne) ClearGivenRoleGrants() *UserUpdateOne {
+	_u.mutation.ClearGivenRoleGrants()
+	return _u
+}
+
+// RemoveGivenRoleGrantIDs removes the "given_role_grants" edge to RoleGrant entities by IDs.
+func (_u *UserUpdateOne) RemoveGivenRoleGrantIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveGivenRoleGrantIDs(ids...)
+	return _u
+}
+
+// RemoveGivenRoleGrants removes "given_role_grants" edges to RoleGrant entities.
+func (_u *UserUpdateOne) RemoveGivenRoleGrants(v ...*RoleGrant) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveGivenRoleGrantIDs(ids...)
+}
+
+// ClearSanctions clears all "sanctions" edges to the Sanction entity.
+func (_u *UserUpdateOne) ClearSanctions() *UserUpdateOne {
+	_u.mutation.ClearSanctions()
+	return _u
+}
+
+// RemoveSanctionIDs removes the "sanctions" edge to Sanction entities by IDs.
+func (_u *UserUpdateOne) RemoveSanctionIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveSanctionIDs(ids...)
+	return _u
+}
+
+// RemoveSanctions removes "sanctions" edges to Sanction entities.
+func (_u *UserUpdateOne) RemoveSanctions(v ...*Sanction) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSanctionIDs(ids...)
+}
+
+// ClearIssuedSanctions clears all "issued_sanctions" edges to the Sanction entity.
+func (_u *UserUpdateOne) ClearIssuedSanctions() *UserUpdateOne {
+	_u.mutation.ClearIssuedSanctions()
+	return _u
+}
+
+// RemoveIssuedSanctionIDs removes the "issued_sanctions" edge to Sanction entities by IDs.
+func (_u *UserUpdateOne) RemoveIssuedSanctionIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveIssuedSanctionIDs(ids...)
+	return _u
+}
+
+// RemoveIssuedSanctions removes "issued_sanctions" edges to Sanction entities.
+func (_u *UserUpdateOne) RemoveIssuedSanctions(v ...*Sanction) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveIssuedSanctionIDs(ids...)
+}
+
+// ClearLiftedSanctions clears all "lifted_sanctions" edges to the Sanction entity.
+func (_u *UserUpdateOne) ClearLiftedSanctions() *UserUpdateOne {
+	_u.mutation.ClearLiftedSanctions()
+	return _u
+}
+
+// RemoveLiftedSanctionIDs removes the "lifted_sanctions" edge to Sanction entities by IDs.
+func (_u *UserUpdateOne) RemoveLiftedSanctionIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveLiftedSanctionIDs(ids...)
+	return _u
+}
+
+// RemoveLiftedSanctions removes "lifted_sanctions" edges to Sanction entities.
+func (_u *UserUpdateOne) RemoveLiftedSanctions(v ...*Sanction) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLiftedSanctionIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (_u *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1699,6 +2740,12 @@ func (_u *UserUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UserUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -1775,6 +2822,18 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if value, ok := _u.mutation.AddedElo(); ok {
 		_spec.AddField(user.FieldElo, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Campus(); ok {
+		_spec.SetField(user.FieldCampus, field.TypeString, value)
+	}
+	if _u.mutation.CampusCleared() {
+		_spec.ClearField(user.FieldCampus, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastLoginAt(); ok {
+		_spec.SetField(user.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastLoginAtCleared() {
+		_spec.ClearField(user.FieldLastLoginAt, field.TypeTime)
+	}
 	if _u.mutation.UserVotesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1796,7 +2855,52 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 			Columns: []string{user.UserVotesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(uservote.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(uservote.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserVotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UserVotesTable,
+			Columns: []string{user.UserVotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(uservote.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CreatedVotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreatedVotesTable,
+			Columns: []string{user.CreatedVotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCreatedVotesIDs(); len(nodes) > 0 && !_u.mutation.CreatedVotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreatedVotesTable,
+			Columns: []string{user.CreatedVotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1804,15 +2908,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.UserVotesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.CreatedVotesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.UserVotesTable,
-			Columns: []string{user.UserVotesColumn},
+			Table:   user.CreatedVotesTable,
+			Columns: []string{user.CreatedVotesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(uservote.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1820,28 +2924,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.CreatedVotesCleared() {
+	if _u.mutation.CreatedVoteTemplatesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CreatedVotesTable,
-			Columns: []string{user.CreatedVotesColumn},
+			Table:   user.CreatedVoteTemplatesTable,
+			Columns: []string{user.CreatedVoteTemplatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(votetemplate.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedCreatedVotesIDs(); len(nodes) > 0 && !_u.mutation.CreatedVotesCleared() {
+	if nodes := _u.mutation.RemovedCreatedVoteTemplatesIDs(); len(nodes) > 0 && !_u.mutation.CreatedVoteTemplatesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CreatedVotesTable,
-			Columns: []string{user.CreatedVotesColumn},
+			Table:   user.CreatedVoteTemplatesTable,
+			Columns: []string{user.CreatedVoteTemplatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(votetemplate.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1849,15 +2953,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.CreatedVotesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.CreatedVoteTemplatesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CreatedVotesTable,
-			Columns: []string{user.CreatedVotesColumn},
+			Table:   user.CreatedVoteTemplatesTable,
+			Columns: []string{user.CreatedVoteTemplatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(votetemplate.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -2225,6 +3329,322 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AuditLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditLogsTable,
+			Columns: []string{user.AuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAuditLogsIDs(); len(nodes) > 0 && !_u.mutation.AuditLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditLogsTable,
+			Columns: []string{user.AuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AuditLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditLogsTable,
+			Columns: []string{user.AuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ImpersonatedAuditLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ImpersonatedAuditLogsTable,
+			Columns: []string{user.ImpersonatedAuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedImpersonatedAuditLogsIDs(); len(nodes) > 0 && !_u.mutation.ImpersonatedAuditLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ImpersonatedAuditLogsTable,
+			Columns: []string{user.ImpersonatedAuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ImpersonatedAuditLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ImpersonatedAuditLogsTable,
+			Columns: []string{user.ImpersonatedAuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RoleGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RoleGrantsTable,
+			Columns: []string{user.RoleGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolegrant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRoleGrantsIDs(); len(nodes) > 0 && !_u.mutation.RoleGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RoleGrantsTable,
+			Columns: []string{user.RoleGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolegrant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RoleGrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RoleGrantsTable,
+			Columns: []string{user.RoleGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolegrant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GivenRoleGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GivenRoleGrantsTable,
+			Columns: []string{user.GivenRoleGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolegrant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedGivenRoleGrantsIDs(); len(nodes) > 0 && !_u.mutation.GivenRoleGrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GivenRoleGrantsTable,
+			Columns: []string{user.GivenRoleGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolegrant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GivenRoleGrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GivenRoleGrantsTable,
+			Columns: []string{user.GivenRoleGrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolegrant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SanctionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SanctionsTable,
+			Columns: []string{user.SanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSanctionsIDs(); len(nodes) > 0 && !_u.mutation.SanctionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SanctionsTable,
+			Columns: []string{user.SanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SanctionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SanctionsTable,
+			Columns: []string{user.SanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.IssuedSanctionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IssuedSanctionsTable,
+			Columns: []string{user.IssuedSanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedIssuedSanctionsIDs(); len(nodes) > 0 && !_u.mutation.IssuedSanctionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IssuedSanctionsTable,
+			Columns: []string{user.IssuedSanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.IssuedSanctionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IssuedSanctionsTable,
+			Columns: []string{user.IssuedSanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LiftedSanctionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LiftedSanctionsTable,
+			Columns: []string{user.LiftedSanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLiftedSanctionsIDs(); len(nodes) > 0 && !_u.mutation.LiftedSanctionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LiftedSanctionsTable,
+			Columns: []string{user.LiftedSanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LiftedSanctionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LiftedSanctionsTable,
+			Columns: []string{user.LiftedSanctionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sanction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
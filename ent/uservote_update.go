@@ -20,8 +20,9 @@ import (
 // UserVoteUpdate is the builder for updating UserVote entities.
 type UserVoteUpdate struct {
 	config
-	hooks    []Hook
-	mutation *UserVoteMutation
+	hooks     []Hook
+	mutation  *UserVoteMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the UserVoteUpdate builder.
@@ -121,6 +122,12 @@ func (_u *UserVoteUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UserVoteUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserVoteUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *UserVoteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -194,6 +201,7 @@ func (_u *UserVoteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{uservote.Label}
@@ -209,9 +217,10 @@ func (_u *UserVoteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // UserVoteUpdateOne is the builder for updating a single UserVote entity.
 type UserVoteUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserVoteMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *UserVoteMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -318,6 +327,12 @@ func (_u *UserVoteUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UserVoteUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserVoteUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *UserVoteUpdateOne) sqlSave(ctx context.Context) (_node *UserVote, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -408,6 +423,7 @@ func (_u *UserVoteUpdateOne) sqlSave(ctx context.Context) (_node *UserVote, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &UserVote{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -24,10 +24,16 @@ type Vote struct {
 	Description string `json:"description,omitempty"`
 	// Visible holds the value of the "visible" field.
 	Visible bool `json:"visible,omitempty"`
+	// Anonymous holds the value of the "anonymous" field.
+	Anonymous bool `json:"anonymous,omitempty"`
 	// StartAt holds the value of the "start_at" field.
 	StartAt time.Time `json:"start_at,omitempty"`
 	// EndAt holds the value of the "end_at" field.
 	EndAt time.Time `json:"end_at,omitempty"`
+	// ResultsRevealAt holds the value of the "results_reveal_at" field.
+	ResultsRevealAt *time.Time `json:"results_reveal_at,omitempty"`
+	// ResultsPublishedAt holds the value of the "results_published_at" field.
+	ResultsPublishedAt *time.Time `json:"results_published_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -75,13 +81,13 @@ func (*Vote) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case vote.FieldVisible:
+		case vote.FieldVisible, vote.FieldAnonymous:
 			values[i] = new(sql.NullBool)
 		case vote.FieldID:
 			values[i] = new(sql.NullInt64)
 		case vote.FieldTitle, vote.FieldDescription:
 			values[i] = new(sql.NullString)
-		case vote.FieldStartAt, vote.FieldEndAt, vote.FieldCreatedAt, vote.FieldUpdatedAt:
+		case vote.FieldStartAt, vote.FieldEndAt, vote.FieldResultsRevealAt, vote.FieldResultsPublishedAt, vote.FieldCreatedAt, vote.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case vote.ForeignKeys[0]: // user_created_votes
 			values[i] = new(sql.NullInt64)
@@ -124,6 +130,12 @@ func (_m *Vote) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Visible = value.Bool
 			}
+		case vote.FieldAnonymous:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field anonymous", values[i])
+			} else if value.Valid {
+				_m.Anonymous = value.Bool
+			}
 		case vote.FieldStartAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field start_at", values[i])
@@ -136,6 +148,20 @@ func (_m *Vote) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.EndAt = value.Time
 			}
+		case vote.FieldResultsRevealAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field results_reveal_at", values[i])
+			} else if value.Valid {
+				_m.ResultsRevealAt = new(time.Time)
+				*_m.ResultsRevealAt = value.Time
+			}
+		case vote.FieldResultsPublishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field results_published_at", values[i])
+			} else if value.Valid {
+				_m.ResultsPublishedAt = new(time.Time)
+				*_m.ResultsPublishedAt = value.Time
+			}
 		case vote.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -210,12 +236,25 @@ func (_m *Vote) String() string {
 	builder.WriteString("visible=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Visible))
 	builder.WriteString(", ")
+	builder.WriteString("anonymous=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Anonymous))
+	builder.WriteString(", ")
 	builder.WriteString("start_at=")
 	builder.WriteString(_m.StartAt.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("end_at=")
 	builder.WriteString(_m.EndAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	if v := _m.ResultsRevealAt; v != nil {
+		builder.WriteString("results_reveal_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ResultsPublishedAt; v != nil {
+		builder.WriteString("results_published_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
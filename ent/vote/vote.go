@@ -20,10 +20,16 @@ const (
 	FieldDescription = "description"
 	// FieldVisible holds the string denoting the visible field in the database.
 	FieldVisible = "visible"
+	// FieldAnonymous holds the string denoting the anonymous field in the database.
+	FieldAnonymous = "anonymous"
 	// FieldStartAt holds the string denoting the start_at field in the database.
 	FieldStartAt = "start_at"
 	// FieldEndAt holds the string denoting the end_at field in the database.
 	FieldEndAt = "end_at"
+	// FieldResultsRevealAt holds the string denoting the results_reveal_at field in the database.
+	FieldResultsRevealAt = "results_reveal_at"
+	// FieldResultsPublishedAt holds the string denoting the results_published_at field in the database.
+	FieldResultsPublishedAt = "results_published_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -56,8 +62,11 @@ var Columns = []string{
 	FieldTitle,
 	FieldDescription,
 	FieldVisible,
+	FieldAnonymous,
 	FieldStartAt,
 	FieldEndAt,
+	FieldResultsRevealAt,
+	FieldResultsPublishedAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -86,6 +95,8 @@ func ValidColumn(column string) bool {
 var (
 	// DefaultVisible holds the default value on creation for the "visible" field.
 	DefaultVisible bool
+	// DefaultAnonymous holds the default value on creation for the "anonymous" field.
+	DefaultAnonymous bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -117,6 +128,11 @@ func ByVisible(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVisible, opts...).ToFunc()
 }
 
+// ByAnonymous orders the results by the anonymous field.
+func ByAnonymous(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnonymous, opts...).ToFunc()
+}
+
 // ByStartAt orders the results by the start_at field.
 func ByStartAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStartAt, opts...).ToFunc()
@@ -127,6 +143,16 @@ func ByEndAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEndAt, opts...).ToFunc()
 }
 
+// ByResultsRevealAt orders the results by the results_reveal_at field.
+func ByResultsRevealAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResultsRevealAt, opts...).ToFunc()
+}
+
+// ByResultsPublishedAt orders the results by the results_published_at field.
+func ByResultsPublishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResultsPublishedAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
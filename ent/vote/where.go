@@ -70,6 +70,11 @@ func Visible(v bool) predicate.Vote {
 	return predicate.Vote(sql.FieldEQ(FieldVisible, v))
 }
 
+// Anonymous applies equality check predicate on the "anonymous" field. It's identical to AnonymousEQ.
+func Anonymous(v bool) predicate.Vote {
+	return predicate.Vote(sql.FieldEQ(FieldAnonymous, v))
+}
+
 // StartAt applies equality check predicate on the "start_at" field. It's identical to StartAtEQ.
 func StartAt(v time.Time) predicate.Vote {
 	return predicate.Vote(sql.FieldEQ(FieldStartAt, v))
@@ -80,6 +85,16 @@ func EndAt(v time.Time) predicate.Vote {
 	return predicate.Vote(sql.FieldEQ(FieldEndAt, v))
 }
 
+// ResultsRevealAt applies equality check predicate on the "results_reveal_at" field. It's identical to ResultsRevealAtEQ.
+func ResultsRevealAt(v time.Time) predicate.Vote {
+	return predicate.Vote(sql.FieldEQ(FieldResultsRevealAt, v))
+}
+
+// ResultsPublishedAt applies equality check predicate on the "results_published_at" field. It's identical to ResultsPublishedAtEQ.
+func ResultsPublishedAt(v time.Time) predicate.Vote {
+	return predicate.Vote(sql.FieldEQ(FieldResultsPublishedAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Vote {
 	return predicate.Vote(sql.FieldEQ(FieldCreatedAt, v))
@@ -240,6 +255,16 @@ func VisibleNEQ(v bool) predicate.Vote {
 	return predicate.Vote(sql.FieldNEQ(FieldVisible, v))
 }
 
+// AnonymousEQ applies the EQ predicate on the "anonymous" field.
+func AnonymousEQ(v bool) predicate.Vote {
+	return predicate.Vote(sql.FieldEQ(FieldAnonymous, v))
+}
+
+// AnonymousNEQ applies the NEQ predicate on the "anonymous" field.
+func AnonymousNEQ(v bool) predicate.Vote {
+	return predicate.Vote(sql.FieldNEQ(FieldAnonymous, v))
+}
+
 // StartAtEQ applies the EQ predicate on the "start_at" field.
 func StartAtEQ(v time.Time) predicate.Vote {
 	return predicate.Vote(sql.FieldEQ(FieldStartAt, v))
@@ -320,6 +345,106 @@ func EndAtLTE(v time.Time) predicate.Vote {
 	return predicate.Vote(sql.FieldLTE(FieldEndAt, v))
 }
 
+// ResultsRevealAtEQ applies the EQ predicate on the "results_reveal_at" field.
+func ResultsRevealAtEQ(v time.Time) predicate.Vote {
+	return predicate.Vote(sql.FieldEQ(FieldResultsRevealAt, v))
+}
+
+// ResultsRevealAtNEQ applies the NEQ predicate on the "results_reveal_at" field.
+func ResultsRevealAtNEQ(v time.Time) predicate.Vote {
+	return predicate.Vote(sql.FieldNEQ(FieldResultsRevealAt, v))
+}
+
+// ResultsRevealAtIn applies the In predicate on the "results_reveal_at" field.
+func ResultsRevealAtIn(vs ...time.Time) predicate.Vote {
+	return predicate.Vote(sql.FieldIn(FieldResultsRevealAt, vs...))
+}
+
+// ResultsRevealAtNotIn applies the NotIn predicate on the "results_reveal_at" field.
+func ResultsRevealAtNotIn(vs ...time.Time) predicate.Vote {
+	return predicate.Vote(sql.FieldNotIn(FieldResultsRevealAt, vs...))
+}
+
+// ResultsRevealAtGT applies the GT predicate on the "results_reveal_at" field.
+func ResultsRevealAtGT(v time.Time) predicate.Vote {
+	return predicate.Vote(sql.FieldGT(FieldResultsRevealAt, v))
+}
+
+// ResultsRevealAtGTE applies the GTE predicate on the "results_reveal_at" field.
+func ResultsRevealAtGTE(v time.Time) predicate.Vote {
+	return predicate.Vote(sql.FieldGTE(FieldResultsRevealAt, v))
+}
+
+// ResultsRevealAtLT applies the LT predicate on the "results_reveal_at" field.
+func ResultsRevealAtLT(v time.Time) predicate.Vote {
+	return predicate.Vote(sql.FieldLT(FieldResultsRevealAt, v))
+}
+
+// ResultsRevealAtLTE applies the LTE predicate on the "results_reveal_at" field.
+func ResultsRevealAtLTE(v time.Time) predicate.Vote {
+	return predicate.Vote(sql.FieldLTE(FieldResultsRevealAt, v))
+}
+
+// ResultsRevealAtIsNil applies the IsNil predicate on the "results_reveal_at" field.
+func ResultsRevealAtIsNil() predicate.Vote {
+	return predicate.Vote(sql.FieldIsNull(FieldResultsRevealAt))
+}
+
+// ResultsRevealAtNotNil applies the NotNil predicate on the "results_reveal_at" field.
+func ResultsRevealAtNotNil() predicate.Vote {
+	return predicate.Vote(sql.FieldNotNull(FieldResultsRevealAt))
+}
+
+// ResultsPublishedAtEQ applies the EQ predicate on the "results_published_at" field.
+func ResultsPublishedAtEQ(v time.Time) predicate.Vote {
+	return predicate.Vote(sql.FieldEQ(FieldResultsPublishedAt, v))
+}
+
+// ResultsPublishedAtNEQ applies the NEQ predicate on the "results_published_at" field.
+func ResultsPublishedAtNEQ(v time.Time) predicate.Vote {
+	return predicate.Vote(sql.FieldNEQ(FieldResultsPublishedAt, v))
+}
+
+// ResultsPublishedAtIn applies the In predicate on the "results_published_at" field.
+func ResultsPublishedAtIn(vs ...time.Time) predicate.Vote {
+	return predicate.Vote(sql.FieldIn(FieldResultsPublishedAt, vs...))
+}
+
+// ResultsPublishedAtNotIn applies the NotIn predicate on the "results_published_at" field.
+func ResultsPublishedAtNotIn(vs ...time.Time) predicate.Vote {
+	return predicate.Vote(sql.FieldNotIn(FieldResultsPublishedAt, vs...))
+}
+
+// ResultsPublishedAtGT applies the GT predicate on the "results_published_at" field.
+func ResultsPublishedAtGT(v time.Time) predicate.Vote {
+	return predicate.Vote(sql.FieldGT(FieldResultsPublishedAt, v))
+}
+
+// ResultsPublishedAtGTE applies the GTE predicate on the "results_published_at" field.
+func ResultsPublishedAtGTE(v time.Time) predicate.Vote {
+	return predicate.Vote(sql.FieldGTE(FieldResultsPublishedAt, v))
+}
+
+// ResultsPublishedAtLT applies the LT predicate on the "results_published_at" field.
+func ResultsPublishedAtLT(v time.Time) predicate.Vote {
+	return predicate.Vote(sql.FieldLT(FieldResultsPublishedAt, v))
+}
+
+// ResultsPublishedAtLTE applies the LTE predicate on the "results_published_at" field.
+func ResultsPublishedAtLTE(v time.Time) predicate.Vote {
+	return predicate.Vote(sql.FieldLTE(FieldResultsPublishedAt, v))
+}
+
+// ResultsPublishedAtIsNil applies the IsNil predicate on the "results_published_at" field.
+func ResultsPublishedAtIsNil() predicate.Vote {
+	return predicate.Vote(sql.FieldIsNull(FieldResultsPublishedAt))
+}
+
+// ResultsPublishedAtNotNil applies the NotNil predicate on the "results_published_at" field.
+func ResultsPublishedAtNotNil() predicate.Vote {
+	return predicate.Vote(sql.FieldNotNull(FieldResultsPublishedAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Vote {
 	return predicate.Vote(sql.FieldEQ(FieldCreatedAt, v))
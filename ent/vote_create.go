@@ -56,6 +56,20 @@ func (_c *VoteCreate) SetNillableVisible(v *bool) *VoteCreate {
 	return _c
 }
 
+// SetAnonymous sets the "anonymous" field.
+func (_c *VoteCreate) SetAnonymous(v bool) *VoteCreate {
+	_c.mutation.SetAnonymous(v)
+	return _c
+}
+
+// SetNillableAnonymous sets the "anonymous" field if the given value is not nil.
+func (_c *VoteCreate) SetNillableAnonymous(v *bool) *VoteCreate {
+	if v != nil {
+		_c.SetAnonymous(*v)
+	}
+	return _c
+}
+
 // SetStartAt sets the "start_at" field.
 func (_c *VoteCreate) SetStartAt(v time.Time) *VoteCreate {
 	_c.mutation.SetStartAt(v)
@@ -68,6 +82,34 @@ func (_c *VoteCreate) SetEndAt(v time.Time) *VoteCreate {
 	return _c
 }
 
+// SetResultsRevealAt sets the "results_reveal_at" field.
+func (_c *VoteCreate) SetResultsRevealAt(v time.Time) *VoteCreate {
+	_c.mutation.SetResultsRevealAt(v)
+	return _c
+}
+
+// SetNillableResultsRevealAt sets the "results_reveal_at" field if the given value is not nil.
+func (_c *VoteCreate) SetNillableResultsRevealAt(v *time.Time) *VoteCreate {
+	if v != nil {
+		_c.SetResultsRevealAt(*v)
+	}
+	return _c
+}
+
+// SetResultsPublishedAt sets the "results_published_at" field.
+func (_c *VoteCreate) SetResultsPublishedAt(v time.Time) *VoteCreate {
+	_c.mutation.SetResultsPublishedAt(v)
+	return _c
+}
+
+// SetNillableResultsPublishedAt sets the "results_published_at" field if the given value is not nil.
+func (_c *VoteCreate) SetNillableResultsPublishedAt(v *time.Time) *VoteCreate {
+	if v != nil {
+		_c.SetResultsPublishedAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *VoteCreate) SetCreatedAt(v time.Time) *VoteCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -161,6 +203,10 @@ func (_c *VoteCreate) defaults() {
 		v := vote.DefaultVisible
 		_c.mutation.SetVisible(v)
 	}
+	if _, ok := _c.mutation.Anonymous(); !ok {
+		v := vote.DefaultAnonymous
+		_c.mutation.SetAnonymous(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := vote.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -179,6 +225,9 @@ func (_c *VoteCreate) check() error {
 	if _, ok := _c.mutation.Visible(); !ok {
 		return &ValidationError{Name: "visible", err: errors.New(`ent: missing required field "Vote.visible"`)}
 	}
+	if _, ok := _c.mutation.Anonymous(); !ok {
+		return &ValidationError{Name: "anonymous", err: errors.New(`ent: missing required field "Vote.anonymous"`)}
+	}
 	if _, ok := _c.mutation.StartAt(); !ok {
 		return &ValidationError{Name: "start_at", err: errors.New(`ent: missing required field "Vote.start_at"`)}
 	}
@@ -232,6 +281,10 @@ func (_c *VoteCreate) createSpec() (*Vote, *sqlgraph.CreateSpec) {
 		_spec.SetField(vote.FieldVisible, field.TypeBool, value)
 		_node.Visible = value
 	}
+	if value, ok := _c.mutation.Anonymous(); ok {
+		_spec.SetField(vote.FieldAnonymous, field.TypeBool, value)
+		_node.Anonymous = value
+	}
 	if value, ok := _c.mutation.StartAt(); ok {
 		_spec.SetField(vote.FieldStartAt, field.TypeTime, value)
 		_node.StartAt = value
@@ -240,6 +293,14 @@ func (_c *VoteCreate) createSpec() (*Vote, *sqlgraph.CreateSpec) {
 		_spec.SetField(vote.FieldEndAt, field.TypeTime, value)
 		_node.EndAt = value
 	}
+	if value, ok := _c.mutation.ResultsRevealAt(); ok {
+		_spec.SetField(vote.FieldResultsRevealAt, field.TypeTime, value)
+		_node.ResultsRevealAt = &value
+	}
+	if value, ok := _c.mutation.ResultsPublishedAt(); ok {
+		_spec.SetField(vote.FieldResultsPublishedAt, field.TypeTime, value)
+		_node.ResultsPublishedAt = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(vote.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
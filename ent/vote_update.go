@@ -20,8 +20,9 @@ import (
 // VoteUpdate is the builder for updating Vote entities.
 type VoteUpdate struct {
 	config
-	hooks    []Hook
-	mutation *VoteMutation
+	hooks     []Hook
+	mutation  *VoteMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the VoteUpdate builder.
@@ -78,6 +79,20 @@ func (_u *VoteUpdate) SetNillableVisible(v *bool) *VoteUpdate {
 	return _u
 }
 
+// SetAnonymous sets the "anonymous" field.
+func (_u *VoteUpdate) SetAnonymous(v bool) *VoteUpdate {
+	_u.mutation.SetAnonymous(v)
+	return _u
+}
+
+// SetNillableAnonymous sets the "anonymous" field if the given value is not nil.
+func (_u *VoteUpdate) SetNillableAnonymous(v *bool) *VoteUpdate {
+	if v != nil {
+		_u.SetAnonymous(*v)
+	}
+	return _u
+}
+
 // SetStartAt sets the "start_at" field.
 func (_u *VoteUpdate) SetStartAt(v time.Time) *VoteUpdate {
 	_u.mutation.SetStartAt(v)
@@ -106,6 +121,46 @@ func (_u *VoteUpdate) SetNillableEndAt(v *time.Time) *VoteUpdate {
 	return _u
 }
 
+// SetResultsRevealAt sets the "results_reveal_at" field.
+func (_u *VoteUpdate) SetResultsRevealAt(v time.Time) *VoteUpdate {
+	_u.mutation.SetResultsRevealAt(v)
+	return _u
+}
+
+// SetNillableResultsRevealAt sets the "results_reveal_at" field if the given value is not nil.
+func (_u *VoteUpdate) SetNillableResultsRevealAt(v *time.Time) *VoteUpdate {
+	if v != nil {
+		_u.SetResultsRevealAt(*v)
+	}
+	return _u
+}
+
+// ClearResultsRevealAt clears the value of the "results_reveal_at" field.
+func (_u *VoteUpdate) ClearResultsRevealAt() *VoteUpdate {
+	_u.mutation.ClearResultsRevealAt()
+	return _u
+}
+
+// SetResultsPublishedAt sets the "results_published_at" field.
+func (_u *VoteUpdate) SetResultsPublishedAt(v time.Time) *VoteUpdate {
+	_u.mutation.SetResultsPublishedAt(v)
+	return _u
+}
+
+// SetNillableResultsPublishedAt sets the "results_published_at" field if the given value is not nil.
+func (_u *VoteUpdate) SetNillableResultsPublishedAt(v *time.Time) *VoteUpdate {
+	if v != nil {
+		_u.SetResultsPublishedAt(*v)
+	}
+	return _u
+}
+
+// ClearResultsPublishedAt clears the value of the "results_published_at" field.
+func (_u *VoteUpdate) ClearResultsPublishedAt() *VoteUpdate {
+	_u.mutation.ClearResultsPublishedAt()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *VoteUpdate) SetCreatedAt(v time.Time) *VoteUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -228,6 +283,12 @@ func (_u *VoteUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *VoteUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *VoteUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *VoteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -252,12 +313,27 @@ func (_u *VoteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Visible(); ok {
 		_spec.SetField(vote.FieldVisible, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Anonymous(); ok {
+		_spec.SetField(vote.FieldAnonymous, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.StartAt(); ok {
 		_spec.SetField(vote.FieldStartAt, field.TypeTime, value)
 	}
 	if value, ok := _u.mutation.EndAt(); ok {
 		_spec.SetField(vote.FieldEndAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.ResultsRevealAt(); ok {
+		_spec.SetField(vote.FieldResultsRevealAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResultsRevealAtCleared() {
+		_spec.ClearField(vote.FieldResultsRevealAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResultsPublishedAt(); ok {
+		_spec.SetField(vote.FieldResultsPublishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResultsPublishedAtCleared() {
+		_spec.ClearField(vote.FieldResultsPublishedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(vote.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -338,6 +414,7 @@ func (_u *VoteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{vote.Label}
@@ -353,9 +430,10 @@ func (_u *VoteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // VoteUpdateOne is the builder for updating a single Vote entity.
 type VoteUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *VoteMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *VoteMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetTitle sets the "title" field.
@@ -406,6 +484,20 @@ func (_u *VoteUpdateOne) SetNillableVisible(v *bool) *VoteUpdateOne {
 	return _u
 }
 
+// SetAnonymous sets the "anonymous" field.
+func (_u *VoteUpdateOne) SetAnonymous(v bool) *VoteUpdateOne {
+	_u.mutation.SetAnonymous(v)
+	return _u
+}
+
+// SetNillableAnonymous sets the "anonymous" field if the given value is not nil.
+func (_u *VoteUpdateOne) SetNillableAnonymous(v *bool) *VoteUpdateOne {
+	if v != nil {
+		_u.SetAnonymous(*v)
+	}
+	return _u
+}
+
 // SetStartAt sets the "start_at" field.
 func (_u *VoteUpdateOne) SetStartAt(v time.Time) *VoteUpdateOne {
 	_u.mutation.SetStartAt(v)
@@ -434,6 +526,46 @@ func (_u *VoteUpdateOne) SetNillableEndAt(v *time.Time) *VoteUpdateOne {
 	return _u
 }
 
+// SetResultsRevealAt sets the "results_reveal_at" field.
+func (_u *VoteUpdateOne) SetResultsRevealAt(v time.Time) *VoteUpdateOne {
+	_u.mutation.SetResultsRevealAt(v)
+	return _u
+}
+
+// SetNillableResultsRevealAt sets the "results_reveal_at" field if the given value is not nil.
+func (_u *VoteUpdateOne) SetNillableResultsRevealAt(v *time.Time) *VoteUpdateOne {
+	if v != nil {
+		_u.SetResultsRevealAt(*v)
+	}
+	return _u
+}
+
+// ClearResultsRevealAt clears the value of the "results_reveal_at" field.
+func (_u *VoteUpdateOne) ClearResultsRevealAt() *VoteUpdateOne {
+	_u.mutation.ClearResultsRevealAt()
+	return _u
+}
+
+// SetResultsPublishedAt sets the "results_published_at" field.
+func (_u *VoteUpdateOne) SetResultsPublishedAt(v time.Time) *VoteUpdateOne {
+	_u.mutation.SetResultsPublishedAt(v)
+	return _u
+}
+
+// SetNillableResultsPublishedAt sets the "results_published_at" field if the given value is not nil.
+func (_u *VoteUpdateOne) SetNillableResultsPublishedAt(v *time.Time) *VoteUpdateOne {
+	if v != nil {
+		_u.SetResultsPublishedAt(*v)
+	}
+	return _u
+}
+
+// ClearResultsPublishedAt clears the value of the "results_published_at" field.
+func (_u *VoteUpdateOne) ClearResultsPublishedAt() *VoteUpdateOne {
+	_u.mutation.ClearResultsPublishedAt()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *VoteUpdateOne) SetCreatedAt(v time.Time) *VoteUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -569,6 +701,12 @@ func (_u *VoteUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *VoteUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *VoteUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *VoteUpdateOne) sqlSave(ctx context.Context) (_node *Vote, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -610,12 +748,27 @@ func (_u *VoteUpdateOne) sqlSave(ctx context.Context) (_node *Vote, err error) {
 	if value, ok := _u.mutation.Visible(); ok {
 		_spec.SetField(vote.FieldVisible, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Anonymous(); ok {
+		_spec.SetField(vote.FieldAnonymous, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.StartAt(); ok {
 		_spec.SetField(vote.FieldStartAt, field.TypeTime, value)
 	}
 	if value, ok := _u.mutation.EndAt(); ok {
 		_spec.SetField(vote.FieldEndAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.ResultsRevealAt(); ok {
+		_spec.SetField(vote.FieldResultsRevealAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResultsRevealAtCleared() {
+		_spec.ClearField(vote.FieldResultsRevealAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResultsPublishedAt(); ok {
+		_spec.SetField(vote.FieldResultsPublishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResultsPublishedAtCleared() {
+		_spec.ClearField(vote.FieldResultsPublishedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(vote.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -696,6 +849,7 @@ func (_u *VoteUpdateOne) sqlSave(ctx context.Context) (_node *Vote, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Vote{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
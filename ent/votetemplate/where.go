@@ -0,0 +1,519 @@
+// Code generated by ent, DO NOT EDIT.
+
+package votetemplate
+
+import (
+	"base-website/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldEQ(FieldName, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldEQ(FieldTitle, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldEQ(FieldDescription, v))
+}
+
+// Anonymous applies equality check predicate on the "anonymous" field. It's identical to AnonymousEQ.
+func Anonymous(v bool) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldEQ(FieldAnonymous, v))
+}
+
+// DurationMinutes applies equality check predicate on the "duration_minutes" field. It's identical to DurationMinutesEQ.
+func DurationMinutes(v int) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldEQ(FieldDurationMinutes, v))
+}
+
+// ResultsDelayMinutes applies equality check predicate on the "results_delay_minutes" field. It's identical to ResultsDelayMinutesEQ.
+func ResultsDelayMinutes(v int) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldEQ(FieldResultsDelayMinutes, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldContainsFold(FieldName, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// AnonymousEQ applies the EQ predicate on the "anonymous" field.
+func AnonymousEQ(v bool) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldEQ(FieldAnonymous, v))
+}
+
+// AnonymousNEQ applies the NEQ predicate on the "anonymous" field.
+func AnonymousNEQ(v bool) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldNEQ(FieldAnonymous, v))
+}
+
+// DurationMinutesEQ applies the EQ predicate on the "duration_minutes" field.
+func DurationMinutesEQ(v int) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldEQ(FieldDurationMinutes, v))
+}
+
+// DurationMinutesNEQ applies the NEQ predicate on the "duration_minutes" field.
+func DurationMinutesNEQ(v int) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldNEQ(FieldDurationMinutes, v))
+}
+
+// DurationMinutesIn applies the In predicate on the "duration_minutes" field.
+func DurationMinutesIn(vs ...int) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldIn(FieldDurationMinutes, vs...))
+}
+
+// DurationMinutesNotIn applies the NotIn predicate on the "duration_minutes" field.
+func DurationMinutesNotIn(vs ...int) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldNotIn(FieldDurationMinutes, vs...))
+}
+
+// DurationMinutesGT applies the GT predicate on the "duration_minutes" field.
+func DurationMinutesGT(v int) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldGT(FieldDurationMinutes, v))
+}
+
+// DurationMinutesGTE applies the GTE predicate on the "duration_minutes" field.
+func DurationMinutesGTE(v int) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldGTE(FieldDurationMinutes, v))
+}
+
+// DurationMinutesLT applies the LT predicate on the "duration_minutes" field.
+func DurationMinutesLT(v int) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldLT(FieldDurationMinutes, v))
+}
+
+// DurationMinutesLTE applies the LTE predicate on the "duration_minutes" field.
+func DurationMinutesLTE(v int) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldLTE(FieldDurationMinutes, v))
+}
+
+// ResultsDelayMinutesEQ applies the EQ predicate on the "results_delay_minutes" field.
+func ResultsDelayMinutesEQ(v int) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldEQ(FieldResultsDelayMinutes, v))
+}
+
+// ResultsDelayMinutesNEQ applies the NEQ predicate on the "results_delay_minutes" field.
+func ResultsDelayMinutesNEQ(v int) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldNEQ(FieldResultsDelayMinutes, v))
+}
+
+// ResultsDelayMinutesIn applies the In predicate on the "results_delay_minutes" field.
+func ResultsDelayMinutesIn(vs ...int) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldIn(FieldResultsDelayMinutes, vs...))
+}
+
+// ResultsDelayMinutesNotIn applies the NotIn predicate on the "results_delay_minutes" field.
+func ResultsDelayMinutesNotIn(vs ...int) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldNotIn(FieldResultsDelayMinutes, vs...))
+}
+
+// ResultsDelayMinutesGT applies the GT predicate on the "results_delay_minutes" field.
+func ResultsDelayMinutesGT(v int) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldGT(FieldResultsDelayMinutes, v))
+}
+
+// ResultsDelayMinutesGTE applies the GTE predicate on the "results_delay_minutes" field.
+func ResultsDelayMinutesGTE(v int) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldGTE(FieldResultsDelayMinutes, v))
+}
+
+// ResultsDelayMinutesLT applies the LT predicate on the "results_delay_minutes" field.
+func ResultsDelayMinutesLT(v int) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldLT(FieldResultsDelayMinutes, v))
+}
+
+// ResultsDelayMinutesLTE applies the LTE predicate on the "results_delay_minutes" field.
+func ResultsDelayMinutesLTE(v int) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldLTE(FieldResultsDelayMinutes, v))
+}
+
+// ResultsDelayMinutesIsNil applies the IsNil predicate on the "results_delay_minutes" field.
+func ResultsDelayMinutesIsNil() predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldIsNull(FieldResultsDelayMinutes))
+}
+
+// ResultsDelayMinutesNotNil applies the NotNil predicate on the "results_delay_minutes" field.
+func ResultsDelayMinutesNotNil() predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldNotNull(FieldResultsDelayMinutes))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasCreator applies the HasEdge predicate on the "creator" edge.
+func HasCreator() predicate.VoteTemplate {
+	return predicate.VoteTemplate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CreatorTable, CreatorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCreatorWith applies the HasEdge predicate on the "creator" edge with a given conditions (other predicates).
+func HasCreatorWith(preds ...predicate.User) predicate.VoteTemplate {
+	return predicate.VoteTemplate(func(s *sql.Selector) {
+		step := newCreatorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.VoteTemplate) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.VoteTemplate) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.VoteTemplate) predicate.VoteTemplate {
+	return predicate.VoteTemplate(sql.NotPredicates(p))
+}
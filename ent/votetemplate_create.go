@@ -0,0 +1,378 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"base-website/ent/schema"
+	"base-website/ent/user"
+	"base-website/ent/votetemplate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// VoteTemplateCreate is the builder for creating a VoteTemplate entity.
+type VoteTemplateCreate struct {
+	config
+	mutation *VoteTemplateMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *VoteTemplateCreate) SetName(v string) *VoteTemplateCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *VoteTemplateCreate) SetTitle(v string) *VoteTemplateCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *VoteTemplateCreate) SetDescription(v string) *VoteTemplateCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *VoteTemplateCreate) SetNillableDescription(v *string) *VoteTemplateCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetAnonymous sets the "anonymous" field.
+func (_c *VoteTemplateCreate) SetAnonymous(v bool) *VoteTemplateCreate {
+	_c.mutation.SetAnonymous(v)
+	return _c
+}
+
+// SetNillableAnonymous sets the "anonymous" field if the given value is not nil.
+func (_c *VoteTemplateCreate) SetNillableAnonymous(v *bool) *VoteTemplateCreate {
+	if v != nil {
+		_c.SetAnonymous(*v)
+	}
+	return _c
+}
+
+// SetDurationMinutes sets the "duration_minutes" field.
+func (_c *VoteTemplateCreate) SetDurationMinutes(v int) *VoteTemplateCreate {
+	_c.mutation.SetDurationMinutes(v)
+	return _c
+}
+
+// SetResultsDelayMinutes sets the "results_delay_minutes" field.
+func (_c *VoteTemplateCreate) SetResultsDelayMinutes(v int) *VoteTemplateCreate {
+	_c.mutation.SetResultsDelayMinutes(v)
+	return _c
+}
+
+// SetNillableResultsDelayMinutes sets the "results_delay_minutes" field if the given value is not nil.
+func (_c *VoteTemplateCreate) SetNillableResultsDelayMinutes(v *int) *VoteTemplateCreate {
+	if v != nil {
+		_c.SetResultsDelayMinutes(*v)
+	}
+	return _c
+}
+
+// SetComponents sets the "components" field.
+func (_c *VoteTemplateCreate) SetComponents(v []schema.VoteTemplateComponent) *VoteTemplateCreate {
+	_c.mutation.SetComponents(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *VoteTemplateCreate) SetCreatedAt(v time.Time) *VoteTemplateCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *VoteTemplateCreate) SetNillableCreatedAt(v *time.Time) *VoteTemplateCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *VoteTemplateCreate) SetUpdatedAt(v time.Time) *VoteTemplateCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *VoteTemplateCreate) SetNillableUpdatedAt(v *time.Time) *VoteTemplateCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatorID sets the "creator" edge to the User entity by ID.
+func (_c *VoteTemplateCreate) SetCreatorID(id int) *VoteTemplateCreate {
+	_c.mutation.SetCreatorID(id)
+	return _c
+}
+
+// SetCreator sets the "creator" edge to the User entity.
+func (_c *VoteTemplateCreate) SetCreator(v *User) *VoteTemplateCreate {
+	return _c.SetCreatorID(v.ID)
+}
+
+// Mutation returns the VoteTemplateMutation object of the builder.
+func (_c *VoteTemplateCreate) Mutation() *VoteTemplateMutation {
+	return _c.mutation
+}
+
+// Save creates the VoteTemplate in the database.
+func (_c *VoteTemplateCreate) Save(ctx context.Context) (*VoteTemplate, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *VoteTemplateCreate) SaveX(ctx context.Context) *VoteTemplate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VoteTemplateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VoteTemplateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *VoteTemplateCreate) defaults() {
+	if _, ok := _c.mutation.Anonymous(); !ok {
+		v := votetemplate.DefaultAnonymous
+		_c.mutation.SetAnonymous(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := votetemplate.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := votetemplate.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *VoteTemplateCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "VoteTemplate.name"`)}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "VoteTemplate.title"`)}
+	}
+	if _, ok := _c.mutation.Anonymous(); !ok {
+		return &ValidationError{Name: "anonymous", err: errors.New(`ent: missing required field "VoteTemplate.anonymous"`)}
+	}
+	if _, ok := _c.mutation.DurationMinutes(); !ok {
+		return &ValidationError{Name: "duration_minutes", err: errors.New(`ent: missing required field "VoteTemplate.duration_minutes"`)}
+	}
+	if v, ok := _c.mutation.DurationMinutes(); ok {
+		if err := votetemplate.DurationMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "duration_minutes", err: fmt.Errorf(`ent: validator failed for field "VoteTemplate.duration_minutes": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Components(); !ok {
+		return &ValidationError{Name: "components", err: errors.New(`ent: missing required field "VoteTemplate.components"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "VoteTemplate.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "VoteTemplate.updated_at"`)}
+	}
+	if len(_c.mutation.CreatorIDs()) == 0 {
+		return &ValidationError{Name: "creator", err: errors.New(`ent: missing required edge "VoteTemplate.creator"`)}
+	}
+	return nil
+}
+
+func (_c *VoteTemplateCreate) sqlSave(ctx context.Context) (*VoteTemplate, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *VoteTemplateCreate) createSpec() (*VoteTemplate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VoteTemplate{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(votetemplate.Table, sqlgraph.NewFieldSpec(votetemplate.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(votetemplate.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(votetemplate.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(votetemplate.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Anonymous(); ok {
+		_spec.SetField(votetemplate.FieldAnonymous, field.TypeBool, value)
+		_node.Anonymous = value
+	}
+	if value, ok := _c.mutation.DurationMinutes(); ok {
+		_spec.SetField(votetemplate.FieldDurationMinutes, field.TypeInt, value)
+		_node.DurationMinutes = value
+	}
+	if value, ok := _c.mutation.ResultsDelayMinutes(); ok {
+		_spec.SetField(votetemplate.FieldResultsDelayMinutes, field.TypeInt, value)
+		_node.ResultsDelayMinutes = &value
+	}
+	if value, ok := _c.mutation.Components(); ok {
+		_spec.SetField(votetemplate.FieldComponents, field.TypeJSON, value)
+		_node.Components = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(votetemplate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(votetemplate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   votetemplate.CreatorTable,
+			Columns: []string{votetemplate.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_created_vote_templates = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// VoteTemplateCreateBulk is the builder for creating many VoteTemplate entities in bulk.
+type VoteTemplateCreateBulk struct {
+	config
+	err      error
+	builders []*VoteTemplateCreate
+}
+
+// Save creates the VoteTemplate entities in the database.
+func (_c *VoteTemplateCreateBulk) Save(ctx context.Context) ([]*VoteTemplate, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*VoteTemplate, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VoteTemplateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *VoteTemplateCreateBulk) SaveX(ctx context.Context) []*VoteTemplate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VoteTemplateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VoteTemplateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
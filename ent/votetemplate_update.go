@@ -0,0 +1,709 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"base-website/ent/predicate"
+	"base-website/ent/schema"
+	"base-website/ent/user"
+	"base-website/ent/votetemplate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// VoteTemplateUpdate is the builder for updating VoteTemplate entities.
+type VoteTemplateUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *VoteTemplateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the VoteTemplateUpdate builder.
+func (_u *VoteTemplateUpdate) Where(ps ...predicate.VoteTemplate) *VoteTemplateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *VoteTemplateUpdate) SetName(v string) *VoteTemplateUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *VoteTemplateUpdate) SetNillableName(v *string) *VoteTemplateUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *VoteTemplateUpdate) SetTitle(v string) *VoteTemplateUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *VoteTemplateUpdate) SetNillableTitle(v *string) *VoteTemplateUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *VoteTemplateUpdate) SetDescription(v string) *VoteTemplateUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *VoteTemplateUpdate) SetNillableDescription(v *string) *VoteTemplateUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *VoteTemplateUpdate) ClearDescription() *VoteTemplateUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetAnonymous sets the "anonymous" field.
+func (_u *VoteTemplateUpdate) SetAnonymous(v bool) *VoteTemplateUpdate {
+	_u.mutation.SetAnonymous(v)
+	return _u
+}
+
+// SetNillableAnonymous sets the "anonymous" field if the given value is not nil.
+func (_u *VoteTemplateUpdate) SetNillableAnonymous(v *bool) *VoteTemplateUpdate {
+	if v != nil {
+		_u.SetAnonymous(*v)
+	}
+	return _u
+}
+
+// SetDurationMinutes sets the "duration_minutes" field.
+func (_u *VoteTemplateUpdate) SetDurationMinutes(v int) *VoteTemplateUpdate {
+	_u.mutation.ResetDurationMinutes()
+	_u.mutation.SetDurationMinutes(v)
+	return _u
+}
+
+// SetNillableDurationMinutes sets the "duration_minutes" field if the given value is not nil.
+func (_u *VoteTemplateUpdate) SetNillableDurationMinutes(v *int) *VoteTemplateUpdate {
+	if v != nil {
+		_u.SetDurationMinutes(*v)
+	}
+	return _u
+}
+
+// AddDurationMinutes adds value to the "duration_minutes" field.
+func (_u *VoteTemplateUpdate) AddDurationMinutes(v int) *VoteTemplateUpdate {
+	_u.mutation.AddDurationMinutes(v)
+	return _u
+}
+
+// SetResultsDelayMinutes sets the "results_delay_minutes" field.
+func (_u *VoteTemplateUpdate) SetResultsDelayMinutes(v int) *VoteTemplateUpdate {
+	_u.mutation.ResetResultsDelayMinutes()
+	_u.mutation.SetResultsDelayMinutes(v)
+	return _u
+}
+
+// SetNillableResultsDelayMinutes sets the "results_delay_minutes" field if the given value is not nil.
+func (_u *VoteTemplateUpdate) SetNillableResultsDelayMinutes(v *int) *VoteTemplateUpdate {
+	if v != nil {
+		_u.SetResultsDelayMinutes(*v)
+	}
+	return _u
+}
+
+// AddResultsDelayMinutes adds value to the "results_delay_minutes" field.
+func (_u *VoteTemplateUpdate) AddResultsDelayMinutes(v int) *VoteTemplateUpdate {
+	_u.mutation.AddResultsDelayMinutes(v)
+	return _u
+}
+
+// ClearResultsDelayMinutes clears the value of the "results_delay_minutes" field.
+func (_u *VoteTemplateUpdate) ClearResultsDelayMinutes() *VoteTemplateUpdate {
+	_u.mutation.ClearResultsDelayMinutes()
+	return _u
+}
+
+// SetComponents sets the "components" field.
+func (_u *VoteTemplateUpdate) SetComponents(v []schema.VoteTemplateComponent) *VoteTemplateUpdate {
+	_u.mutation.SetComponents(v)
+	return _u
+}
+
+// AppendComponents appends value to the "components" field.
+func (_u *VoteTemplateUpdate) AppendComponents(v []schema.VoteTemplateComponent) *VoteTemplateUpdate {
+	_u.mutation.AppendComponents(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *VoteTemplateUpdate) SetCreatedAt(v time.Time) *VoteTemplateUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *VoteTemplateUpdate) SetNillableCreatedAt(v *time.Time) *VoteTemplateUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *VoteTemplateUpdate) SetUpdatedAt(v time.Time) *VoteTemplateUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCreatorID sets the "creator" edge to the User entity by ID.
+func (_u *VoteTemplateUpdate) SetCreatorID(id int) *VoteTemplateUpdate {
+	_u.mutation.SetCreatorID(id)
+	return _u
+}
+
+// SetCreator sets the "creator" edge to the User entity.
+func (_u *VoteTemplateUpdate) SetCreator(v *User) *VoteTemplateUpdate {
+	return _u.SetCreatorID(v.ID)
+}
+
+// Mutation returns the VoteTemplateMutation object of the builder.
+func (_u *VoteTemplateUpdate) Mutation() *VoteTemplateMutation {
+	return _u.mutation
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (_u *VoteTemplateUpdate) ClearCreator() *VoteTemplateUpdate {
+	_u.mutation.ClearCreator()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *VoteTemplateUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VoteTemplateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *VoteTemplateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VoteTemplateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *VoteTemplateUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := votetemplate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VoteTemplateUpdate) check() error {
+	if v, ok := _u.mutation.DurationMinutes(); ok {
+		if err := votetemplate.DurationMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "duration_minutes", err: fmt.Errorf(`ent: validator failed for field "VoteTemplate.duration_minutes": %w`, err)}
+		}
+	}
+	if _u.mutation.CreatorCleared() && len(_u.mutation.CreatorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "VoteTemplate.creator"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *VoteTemplateUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *VoteTemplateUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *VoteTemplateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(votetemplate.Table, votetemplate.Columns, sqlgraph.NewFieldSpec(votetemplate.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(votetemplate.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(votetemplate.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(votetemplate.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(votetemplate.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Anonymous(); ok {
+		_spec.SetField(votetemplate.FieldAnonymous, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DurationMinutes(); ok {
+		_spec.SetField(votetemplate.FieldDurationMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMinutes(); ok {
+		_spec.AddField(votetemplate.FieldDurationMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ResultsDelayMinutes(); ok {
+		_spec.SetField(votetemplate.FieldResultsDelayMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedResultsDelayMinutes(); ok {
+		_spec.AddField(votetemplate.FieldResultsDelayMinutes, field.TypeInt, value)
+	}
+	if _u.mutation.ResultsDelayMinutesCleared() {
+		_spec.ClearField(votetemplate.FieldResultsDelayMinutes, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Components(); ok {
+		_spec.SetField(votetemplate.FieldComponents, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedComponents(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, votetemplate.FieldComponents, value)
+		})
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(votetemplate.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(votetemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   votetemplate.CreatorTable,
+			Columns: []string{votetemplate.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   votetemplate.CreatorTable,
+			Columns: []string{votetemplate.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{votetemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// VoteTemplateUpdateOne is the builder for updating a single VoteTemplate entity.
+type VoteTemplateUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *VoteTemplateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (_u *VoteTemplateUpdateOne) SetName(v string) *VoteTemplateUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *VoteTemplateUpdateOne) SetNillableName(v *string) *VoteTemplateUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *VoteTemplateUpdateOne) SetTitle(v string) *VoteTemplateUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *VoteTemplateUpdateOne) SetNillableTitle(v *string) *VoteTemplateUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *VoteTemplateUpdateOne) SetDescription(v string) *VoteTemplateUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *VoteTemplateUpdateOne) SetNillableDescription(v *string) *VoteTemplateUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *VoteTemplateUpdateOne) ClearDescription() *VoteTemplateUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetAnonymous sets the "anonymous" field.
+func (_u *VoteTemplateUpdateOne) SetAnonymous(v bool) *VoteTemplateUpdateOne {
+	_u.mutation.SetAnonymous(v)
+	return _u
+}
+
+// SetNillableAnonymous sets the "anonymous" field if the given value is not nil.
+func (_u *VoteTemplateUpdateOne) SetNillableAnonymous(v *bool) *VoteTemplateUpdateOne {
+	if v != nil {
+		_u.SetAnonymous(*v)
+	}
+	return _u
+}
+
+// SetDurationMinutes sets the "duration_minutes" field.
+func (_u *VoteTemplateUpdateOne) SetDurationMinutes(v int) *VoteTemplateUpdateOne {
+	_u.mutation.ResetDurationMinutes()
+	_u.mutation.SetDurationMinutes(v)
+	return _u
+}
+
+// SetNillableDurationMinutes sets the "duration_minutes" field if the given value is not nil.
+func (_u *VoteTemplateUpdateOne) SetNillableDurationMinutes(v *int) *VoteTemplateUpdateOne {
+	if v != nil {
+		_u.SetDurationMinutes(*v)
+	}
+	return _u
+}
+
+// AddDurationMinutes adds value to the "duration_minutes" field.
+func (_u *VoteTemplateUpdateOne) AddDurationMinutes(v int) *VoteTemplateUpdateOne {
+	_u.mutation.AddDurationMinutes(v)
+	return _u
+}
+
+// SetResultsDelayMinutes sets the "results_delay_minutes" field.
+func (_u *VoteTemplateUpdateOne) SetResultsDelayMinutes(v int) *VoteTemplateUpdateOne {
+	_u.mutation.ResetResultsDelayMinutes()
+	_u.mutation.SetResultsDelayMinutes(v)
+	return _u
+}
+
+// SetNillableResultsDelayMinutes sets the "results_delay_minutes" field if the given value is not nil.
+func (_u *VoteTemplateUpdateOne) SetNillableResultsDelayMinutes(v *int) *VoteTemplateUpdateOne {
+	if v != nil {
+		_u.SetResultsDelayMinutes(*v)
+	}
+	return _u
+}
+
+// AddResultsDelayMinutes adds value to the "results_delay_minutes" field.
+func (_u *VoteTemplateUpdateOne) AddResultsDelayMinutes(v int) *VoteTemplateUpdateOne {
+	_u.mutation.AddResultsDelayMinutes(v)
+	return _u
+}
+
+// ClearResultsDelayMinutes clears the value of the "results_delay_minutes" field.
+func (_u *VoteTemplateUpdateOne) ClearResultsDelayMinutes() *VoteTemplateUpdateOne {
+	_u.mutation.ClearResultsDelayMinutes()
+	return _u
+}
+
+// SetComponents sets the "components" field.
+func (_u *VoteTemplateUpdateOne) SetComponents(v []schema.VoteTemplateComponent) *VoteTemplateUpdateOne {
+	_u.mutation.SetComponents(v)
+	return _u
+}
+
+// AppendComponents appends value to the "components" field.
+func (_u *VoteTemplateUpdateOne) AppendComponents(v []schema.VoteTemplateComponent) *VoteTemplateUpdateOne {
+	_u.mutation.AppendComponents(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *VoteTemplateUpdateOne) SetCreatedAt(v time.Time) *VoteTemplateUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *VoteTemplateUpdateOne) SetNillableCreatedAt(v *time.Time) *VoteTemplateUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *VoteTemplateUpdateOne) SetUpdatedAt(v time.Time) *VoteTemplateUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCreatorID sets the "creator" edge to the User entity by ID.
+func (_u *VoteTemplateUpdateOne) SetCreatorID(id int) *VoteTemplateUpdateOne {
+	_u.mutation.SetCreatorID(id)
+	return _u
+}
+
+// SetCreator sets the "creator" edge to the User entity.
+func (_u *VoteTemplateUpdateOne) SetCreator(v *User) *VoteTemplateUpdateOne {
+	return _u.SetCreatorID(v.ID)
+}
+
+// Mutation returns the VoteTemplateMutation object of the builder.
+func (_u *VoteTemplateUpdateOne) Mutation() *VoteTemplateMutation {
+	return _u.mutation
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (_u *VoteTemplateUpdateOne) ClearCreator() *VoteTemplateUpdateOne {
+	_u.mutation.ClearCreator()
+	return _u
+}
+
+// Where appends a list predicates to the VoteTemplateUpdate builder.
+func (_u *VoteTemplateUpdateOne) Where(ps ...predicate.VoteTemplate) *VoteTemplateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *VoteTemplateUpdateOne) Select(field string, fields ...string) *VoteTemplateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated VoteTemplate entity.
+func (_u *VoteTemplateUpdateOne) Save(ctx context.Context) (*VoteTemplate, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VoteTemplateUpdateOne) SaveX(ctx context.Context) *VoteTemplate {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *VoteTemplateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VoteTemplateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *VoteTemplateUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := votetemplate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VoteTemplateUpdateOne) check() error {
+	if v, ok := _u.mutation.DurationMinutes(); ok {
+		if err := votetemplate.DurationMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "duration_minutes", err: fmt.Errorf(`ent: validator failed for field "VoteTemplate.duration_minutes": %w`, err)}
+		}
+	}
+	if _u.mutation.CreatorCleared() && len(_u.mutation.CreatorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "VoteTemplate.creator"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *VoteTemplateUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *VoteTemplateUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *VoteTemplateUpdateOne) sqlSave(ctx context.Context) (_node *VoteTemplate, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(votetemplate.Table, votetemplate.Columns, sqlgraph.NewFieldSpec(votetemplate.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "VoteTemplate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, votetemplate.FieldID)
+		for _, f := range fields {
+			if !votetemplate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != votetemplate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(votetemplate.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(votetemplate.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(votetemplate.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(votetemplate.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Anonymous(); ok {
+		_spec.SetField(votetemplate.FieldAnonymous, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DurationMinutes(); ok {
+		_spec.SetField(votetemplate.FieldDurationMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMinutes(); ok {
+		_spec.AddField(votetemplate.FieldDurationMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ResultsDelayMinutes(); ok {
+		_spec.SetField(votetemplate.FieldResultsDelayMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedResultsDelayMinutes(); ok {
+		_spec.AddField(votetemplate.FieldResultsDelayMinutes, field.TypeInt, value)
+	}
+	if _u.mutation.ResultsDelayMinutesCleared() {
+		_spec.ClearField(votetemplate.FieldResultsDelayMinutes, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Components(); ok {
+		_spec.SetField(votetemplate.FieldComponents, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedComponents(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, votetemplate.FieldComponents, value)
+		})
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(votetemplate.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(votetemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   votetemplate.CreatorTable,
+			Columns: []string{votetemplate.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   votetemplate.CreatorTable,
+			Columns: []string{votetemplate.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &VoteTemplate{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{votetemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -122,6 +122,33 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
+    "/auth/device": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /**
+         * Get Device Authorization
+         * @description This endpoint is used to get the application and scopes requested by a device from the code it displays.
+         *     		⚠️ **You are probably not interested in this endpoint as it is should be only used by the frontend**
+         */
+        get: operations["getDeviceAuthorization"];
+        put?: never;
+        /**
+         * Approve Device
+         * @description This endpoint is used to sign the current user in on a device, or to deny it.
+         *     		The device receives its tokens on its next poll of the token endpoint.
+         *     		⚠️ **You are probably not interested in this endpoint as it is should be only used by the frontend**
+         */
+        post: operations["approveDevice"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
     "/auth/logout": {
         parameters: {
             query?: never;
@@ -1285,6 +1312,55 @@ export interface components {
             /** @example Best Programming Language 2025 */
             title: string;
         };
+        Decision: {
+            allowed: boolean;
+            /**
+             * @example [
+             *       "super_admin",
+             *       "basic_admin",
+             *       "user"
+             *     ]
+             */
+            chain?: string[] | null;
+            /** @example super_admin */
+            role?: string;
+            rule?: components["schemas"]["Permission"];
+        };
+        DeviceApprovalParams: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example /api/schemas/DeviceApprovalParams.json
+             */
+            readonly $schema?: string;
+            /** @example true */
+            accept: boolean;
+            /** @example BCDF-GHJK */
+            user_code: string;
+        };
+        DeviceAuthorization: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example /api/schemas/DeviceAuthorization.json
+             */
+            readonly $schema?: string;
+            application: components["schemas"]["App"];
+            /**
+             * Format: date-time
+             * @example 2024-09-01T00:00:00Z
+             */
+            expires_at: string;
+            /**
+             * @example [
+             *       "openid",
+             *       "profile"
+             *     ]
+             */
+            scopes: string[];
+            /** @example BCDF-GHJK */
+            user_code: string;
+        };
         EnvResponse: {
             /**
              * Format: uri
@@ -1591,6 +1667,16 @@ export interface components {
             title: string;
             type: string;
         };
+        OperationAuthorization: {
+            /** @example false */
+            allowed: boolean;
+            decision: components["schemas"]["Decision"];
+            /** @example PATCH */
+            method: string;
+            /** @example /tournaments/{id} */
+            path: string;
+            scope?: components["schemas"]["ScopeCheck"];
+        };
         Permission: {
             /**
              * @example [
@@ -1836,6 +1922,23 @@ export interface components {
             name: string;
             permissions: components["schemas"]["Permission"][];
         };
+        ScopeCheck: {
+            /** @example SUPER_ADMIN */
+            min_role: string;
+            /** @example id */
+            param: string;
+            /** @example tournament */
+            resource: string;
+            /**
+             * Format: int64
+             * @example 42
+             */
+            resource_id?: number;
+            /** @example ADMIN */
+            role?: string;
+            /** @example false */
+            satisfied: boolean;
+        };
         TeamStructure: {
             /** Format: int64 */
             max: number;
@@ -1985,6 +2088,16 @@ export interface components {
             /** @example froz */
             username: string;
         };
+        UserPermissions: {
+            /**
+             * Format: uri
+             * @description A URL to the JSON Schema for this object.
+             * @example /api/schemas/UserPermissions.json
+             */
+            readonly $schema?: string;
+            operations: components["schemas"]["OperationAuthorization"][];
+            permissions: components["schemas"]["Permission"][];
+        };
         Vote: {
             /**
              * Format: uri
@@ -2396,6 +2509,72 @@ export interface operations {
             };
         };
     };
+    getDeviceAuthorization: {
+        parameters: {
+            query: {
+                /**
+                 * @description The code displayed on the device
+                 * @example BCDF-GHJK
+                 */
+                user_code: string;
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description OK */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["DeviceAuthorization"];
+                };
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
+        };
+    };
+    approveDevice: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["DeviceApprovalParams"];
+            };
+        };
+        responses: {
+            /** @description No Content */
+            204: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            /** @description Error */
+            default: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/problem+json": components["schemas"]["ErrorModel"];
+                };
+            };
+        };
+    };
     logout: {
         parameters: {
             query?: never;
@@ -2915,7 +3094,7 @@ export interface operations {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["Permission"][];
+                    "application/json": components["schemas"]["UserPermissions"];
                 };
             };
             /** @description Error */
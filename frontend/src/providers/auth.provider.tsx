@@ -104,7 +104,7 @@ export default function AuthProvider({ VITE_OAUTH_AUTHORIZE_URL,
 			}
 			setAuth({
 				me,
-				permissions,
+				permissions: permissions.permissions,
 			});
 			setShowLoginPrompt(false);
 		}
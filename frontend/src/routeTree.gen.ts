@@ -22,6 +22,7 @@ import { Route as VotesVoteidIndexRouteImport } from './routes/votes/$voteid/ind
 import { Route as UsersMeIndexRouteImport } from './routes/users/me/index'
 import { Route as UsersUseridIndexRouteImport } from './routes/users/$userid/index'
 import { Route as TournamentsTournamentidIndexRouteImport } from './routes/tournaments/$tournamentid/index'
+import { Route as AuthDeviceIndexRouteImport } from './routes/auth/device/index'
 import { Route as AuthCallbackIndexRouteImport } from './routes/auth/callback/index'
 import { Route as AdminVotesIndexRouteImport } from './routes/admin/votes/index'
 import { Route as AdminUsersIndexRouteImport } from './routes/admin/users/index'
@@ -110,6 +111,11 @@ const TournamentsTournamentidIndexRoute =
     path: '/tournaments/$tournamentid/',
     getParentRoute: () => rootRouteImport,
   } as any)
+const AuthDeviceIndexRoute = AuthDeviceIndexRouteImport.update({
+  id: '/auth/device/',
+  path: '/auth/device/',
+  getParentRoute: () => rootRouteImport,
+} as any)
 const AuthCallbackIndexRoute = AuthCallbackIndexRouteImport.update({
   id: '/auth/callback/',
   path: '/auth/callback/',
@@ -241,6 +247,7 @@ export interface FileRoutesByFullPath {
   '/admin/users': typeof AdminUsersIndexRoute
   '/admin/votes': typeof AdminVotesIndexRoute
   '/auth/callback': typeof AuthCallbackIndexRoute
+  '/auth/device': typeof AuthDeviceIndexRoute
   '/tournaments/$tournamentid': typeof TournamentsTournamentidIndexRoute
   '/users/$userid': typeof UsersUseridIndexRoute
   '/users/me': typeof UsersMeIndexRoute
@@ -276,6 +283,7 @@ export interface FileRoutesByTo {
   '/admin/users': typeof AdminUsersIndexRoute
   '/admin/votes': typeof AdminVotesIndexRoute
   '/auth/callback': typeof AuthCallbackIndexRoute
+  '/auth/device': typeof AuthDeviceIndexRoute
   '/tournaments/$tournamentid': typeof TournamentsTournamentidIndexRoute
   '/users/$userid': typeof UsersUseridIndexRoute
   '/users/me': typeof UsersMeIndexRoute
@@ -313,6 +321,7 @@ export interface FileRoutesById {
   '/admin/users/': typeof AdminUsersIndexRoute
   '/admin/votes/': typeof AdminVotesIndexRoute
   '/auth/callback/': typeof AuthCallbackIndexRoute
+  '/auth/device/': typeof AuthDeviceIndexRoute
   '/tournaments/$tournamentid/': typeof TournamentsTournamentidIndexRoute
   '/users/$userid/': typeof UsersUseridIndexRoute
   '/users/me/': typeof UsersMeIndexRoute
@@ -351,6 +360,7 @@ export interface FileRouteTypes {
     | '/admin/users'
     | '/admin/votes'
     | '/auth/callback'
+    | '/auth/device'
     | '/tournaments/$tournamentid'
     | '/users/$userid'
     | '/users/me'
@@ -386,6 +396,7 @@ export interface FileRouteTypes {
     | '/admin/users'
     | '/admin/votes'
     | '/auth/callback'
+    | '/auth/device'
     | '/tournaments/$tournamentid'
     | '/users/$userid'
     | '/users/me'
@@ -422,6 +433,7 @@ export interface FileRouteTypes {
     | '/admin/users/'
     | '/admin/votes/'
     | '/auth/callback/'
+    | '/auth/device/'
     | '/tournaments/$tournamentid/'
     | '/users/$userid/'
     | '/users/me/'
@@ -453,6 +465,7 @@ export interface RootRouteChildren {
   UsersIndexRoute: typeof UsersIndexRoute
   VotesIndexRoute: typeof VotesIndexRoute
   AuthCallbackIndexRoute: typeof AuthCallbackIndexRoute
+  AuthDeviceIndexRoute: typeof AuthDeviceIndexRoute
   TournamentsTournamentidIndexRoute: typeof TournamentsTournamentidIndexRoute
   UsersUseridIndexRoute: typeof UsersUseridIndexRoute
   UsersMeIndexRoute: typeof UsersMeIndexRoute
@@ -559,6 +572,13 @@ declare module '@tanstack/react-router' {
       preLoaderRoute: typeof TournamentsTournamentidIndexRouteImport
       parentRoute: typeof rootRouteImport
     }
+    '/auth/device/': {
+      id: '/auth/device/'
+      path: '/auth/device'
+      fullPath: '/auth/device'
+      preLoaderRoute: typeof AuthDeviceIndexRouteImport
+      parentRoute: typeof rootRouteImport
+    }
     '/auth/callback/': {
       id: '/auth/callback/'
       path: '/auth/callback'
@@ -759,6 +779,7 @@ const rootRouteChildren: RootRouteChildren = {
   UsersIndexRoute: UsersIndexRoute,
   VotesIndexRoute: VotesIndexRoute,
   AuthCallbackIndexRoute: AuthCallbackIndexRoute,
+  AuthDeviceIndexRoute: AuthDeviceIndexRoute,
   TournamentsTournamentidIndexRoute: TournamentsTournamentidIndexRoute,
   UsersUseridIndexRoute: UsersUseridIndexRoute,
   UsersMeIndexRoute: UsersMeIndexRoute,
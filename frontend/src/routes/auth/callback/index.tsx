@@ -49,12 +49,13 @@ function RouteComponent() {
 
 					authenticate({
 						me,
-						permissions,
+						permissions: permissions.permissions,
 					});
 
 					if (searchParams.redirect) {
 						const redirectUrl = new URL(searchParams.redirect);
-						router.navigate({ to: redirectUrl.pathname });
+						// The search is kept, the device page reads the code the device linked to from it
+						router.history.push(redirectUrl.pathname + redirectUrl.search);
 					}
 				} catch (err) {
 					console.error("Post-auth error:", err);
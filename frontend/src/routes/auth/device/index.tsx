@@ -0,0 +1,125 @@
+import { Button } from '@/components/ui/button';
+import { Card, CardContent, CardHeader, CardTitle } from '@/components/ui/card';
+import { Input } from '@/components/ui/input';
+import useQueryClient from '@/hooks/use-query-client';
+import { errorModelToDescription } from '@/lib/utils';
+import { createFileRoute, useSearch } from '@tanstack/react-router'
+import { useState } from 'react';
+import { toast } from 'sonner';
+
+export const Route = createFileRoute('/auth/device/')({
+	component: RouteComponent,
+	validateSearch: (search: Record<string, unknown>) => {
+		return {
+			user_code: (search.user_code as string) || '',
+		}
+	},
+})
+
+function RouteComponent() {
+	const searchParams = useSearch({ from: '/auth/device/' });
+	const client = useQueryClient();
+
+	const [input, setInput] = useState(searchParams.user_code);
+	const [userCode, setUserCode] = useState(searchParams.user_code);
+	const [answer, setAnswer] = useState<boolean | null>(null);
+
+	const { data: device, error, isLoading } = client.useQuery("get", "/auth/device", {
+		params: {
+			query: {
+				user_code: userCode,
+			},
+		},
+	}, {
+		enabled: userCode !== '' && answer === null,
+		retry: false,
+	});
+
+	const { mutate, isPending } = client.useMutation("post", "/auth/device", {
+		onSuccess: (_, variables) => {
+			setAnswer(variables.body.accept);
+		},
+		onError: (error) => {
+			toast.error(errorModelToDescription(error));
+		},
+	});
+
+	if (answer !== null) {
+		return (
+			<div className="flex justify-center p-10">
+				<Card className="w-full max-w-md">
+					<CardHeader>
+						<CardTitle>{answer ? "Device signed in" : "Device denied"}</CardTitle>
+					</CardHeader>
+					<CardContent>
+						<p className="text-muted-foreground">
+							{answer ? "You can go back to your device, it signs in by itself." : "The device was not signed in."}
+						</p>
+					</CardContent>
+				</Card>
+			</div>
+		);
+	}
+
+	return (
+		<div className="flex justify-center p-10">
+			<Card className="w-full max-w-md">
+				<CardHeader>
+					<CardTitle>Sign in on a device</CardTitle>
+				</CardHeader>
+				<CardContent className="space-y-4">
+					<form
+						className="flex gap-2"
+						onSubmit={(e) => {
+							e.preventDefault();
+							setUserCode(input.trim().toUpperCase());
+						}}
+					>
+						<Input
+							placeholder="BCDF-GHJK"
+							value={input}
+							onChange={(e) => setInput(e.target.value)}
+						/>
+						<Button type="submit" disabled={input.trim() === '' || isLoading}>
+							Continue
+						</Button>
+					</form>
+					{error && (
+						<p className="text-sm text-destructive">{errorModelToDescription(error)}</p>
+					)}
+					{device && (
+						<div className="space-y-4">
+							<p>
+								<span className="font-bold">{device.application.name}</span> by{" "}
+								<span className="font-bold">{device.application.owner.username}</span> wants to access your account
+							</p>
+							<ul className="list-disc pl-5 text-sm text-muted-foreground">
+								{device.scopes.map((scope) => (
+									<li key={scope}>{scope}</li>
+								))}
+							</ul>
+							<p className="text-xs text-muted-foreground">
+								The code expires at {new Date(device.expires_at).toLocaleTimeString()}
+							</p>
+							<div className="flex justify-end gap-2">
+								<Button
+									variant="destructive"
+									disabled={isPending}
+									onClick={() => mutate({ body: { user_code: device.user_code, accept: false } })}
+								>
+									Deny
+								</Button>
+								<Button
+									disabled={isPending}
+									onClick={() => mutate({ body: { user_code: device.user_code, accept: true } })}
+								>
+									Approve
+								</Button>
+							</div>
+						</div>
+					)}
+				</CardContent>
+			</Card>
+		</div>
+	);
+}
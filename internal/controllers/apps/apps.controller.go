@@ -92,6 +92,7 @@ func (ctrl *appsController) Register(api huma.API) {
 		Tags:        []string{"Apps"},
 		OperationID: "deleteApp",
 		Security:    security.WithAuth("security"),
+		Metadata:    security.ForbidImpersonation(),
 	}, ctrl.delete)
 
 	huma.Register(api, huma.Operation{
@@ -102,6 +103,7 @@ func (ctrl *appsController) Register(api huma.API) {
 		Tags:        []string{"Apps"},
 		OperationID: "rotateAppSecret",
 		Security:    security.WithAuth("security"),
+		Metadata:    security.ForbidImpersonation(),
 	}, ctrl.rotateSecret)
 }
 
@@ -0,0 +1,47 @@
+package auditcontroller
+
+import (
+	"context"
+
+	"base-website/internal/security"
+	auditservice "base-website/internal/services/audit"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/samber/do"
+)
+
+type auditController struct {
+	auditService auditservice.AuditService
+}
+
+func Init(api huma.API, injector *do.Injector) {
+	controller := &auditController{
+		auditService: do.MustInvoke[auditservice.AuditService](injector),
+	}
+	controller.Register(api)
+}
+
+func (ctrl *auditController) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		Method:      "GET",
+		Path:        "/audit",
+		Summary:     "Search audit logs",
+		Description: `This endpoint is used to search the administrative actions that were done on the website.`,
+		Tags:        []string{"Audit"},
+		OperationID: "searchAuditLogs",
+		Security:    security.WithAuth("profile"),
+	}, ctrl.searchAuditLogs)
+}
+
+func (ctrl *auditController) searchAuditLogs(
+	ctx context.Context,
+	input *searchAuditLogsInput,
+) (*searchAuditLogsOutput, error) {
+	result, err := ctrl.auditService.Search(ctx, &input.SearchAuditLogsParams)
+	if err != nil {
+		return nil, err
+	}
+	return &searchAuditLogsOutput{
+		Body: result,
+	}, nil
+}
@@ -0,0 +1,14 @@
+package auditcontroller
+
+import (
+	auditmodels "base-website/internal/services/audit/models"
+	"base-website/pkg/paging"
+)
+
+type searchAuditLogsInput struct {
+	auditmodels.SearchAuditLogsParams
+}
+
+type searchAuditLogsOutput struct {
+	Body *paging.Response[*auditmodels.AuditLog] `nullable:"false"`
+}
@@ -48,7 +48,68 @@ func (ctrl *authController) Register(api huma.API) {
 		Tags:        []string{"Authentification"},
 		OperationID: "logout",
 		Security:    security.WithAuth("security"),
+		Metadata:    security.AllowBanned(),
 	}, ctrl.logout)
+
+	huma.Register(api, huma.Operation{
+		Method:  "GET",
+		Path:    "/auth/authorize",
+		Summary: "Authorize Application",
+		Description: `This endpoint is used to log the current user in a registered application.
+		It completes the pending OpenID auth request and returns the URL to redirect the user agent to,
+		unless the user has not consented yet to the requested scopes.
+		⚠️ **You are probably not interested in this endpoint as it is should be only used by the frontend**`,
+		Tags:        []string{"Authentification"},
+		OperationID: "authorize",
+		Security:    security.WithAuth("security"),
+	}, ctrl.authorize)
+
+	huma.Register(api, huma.Operation{
+		Method:  "POST",
+		Path:    "/auth/authorize/consent",
+		Summary: "Consent to Application",
+		Description: `This endpoint is used to grant or deny the scopes requested by a registered application.
+		Granted scopes are remembered for the configured number of days.
+		⚠️ **You are probably not interested in this endpoint as it is should be only used by the frontend**`,
+		Tags:        []string{"Authentification"},
+		OperationID: "consentAuthorize",
+		Security:    security.WithAuth("security"),
+	}, ctrl.consent)
+
+	huma.Register(api, huma.Operation{
+		Method:  "GET",
+		Path:    "/auth/device",
+		Summary: "Get Device Authorization",
+		Description: `This endpoint is used to get the application and scopes requested by a device from the code it displays.
+		⚠️ **You are probably not interested in this endpoint as it is should be only used by the frontend**`,
+		Tags:        []string{"Authentification"},
+		OperationID: "getDeviceAuthorization",
+		Security:    security.WithAuth("security"),
+	}, ctrl.getDeviceAuthorization)
+
+	huma.Register(api, huma.Operation{
+		Method:  "POST",
+		Path:    "/auth/device",
+		Summary: "Approve Device",
+		Description: `This endpoint is used to sign the current user in on a device, or to deny it.
+		The device receives its tokens on its next poll of the token endpoint.
+		⚠️ **You are probably not interested in this endpoint as it is should be only used by the frontend**`,
+		Tags:        []string{"Authentification"},
+		OperationID: "approveDevice",
+		Security:    security.WithAuth("security"),
+	}, ctrl.approveDevice)
+
+	huma.Register(api, huma.Operation{
+		Method:  "POST",
+		Path:    "/users/{id}/impersonate",
+		Summary: "Impersonate User",
+		Description: `This endpoint is used by a super admin to get a short-lived access token acting as the user, to reproduce what they see.
+		The token cannot be refreshed nor used for destructive actions. Its issuance is recorded in the audit log, and the audited actions done with it name the super admin as the impersonator.`,
+		Tags:        []string{"Authentification", "Users"},
+		OperationID: "impersonateUser",
+		Security:    security.WithAuth("security"),
+		Metadata:    security.ForbidImpersonation(),
+	}, ctrl.impersonate)
 }
 
 func (ctrl *authController) getOAuthCallback(
@@ -92,3 +153,66 @@ func (ctrl *authController) logout(
 	}
 	return resp, nil
 }
+
+func (ctrl *authController) authorize(
+	ctx context.Context,
+	input *authorizeInput,
+) (*authorizeOutput, error) {
+	resp, err := ctrl.authService.Authorize(ctx, input.AuthRequestID)
+	if err != nil {
+		return nil, err
+	}
+	return &authorizeOutput{
+		Body: resp,
+	}, nil
+}
+
+func (ctrl *authController) consent(
+	ctx context.Context,
+	input *consentInput,
+) (*authorizeOutput, error) {
+	resp, err := ctrl.authService.Consent(ctx, &input.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &authorizeOutput{
+		Body: resp,
+	}, nil
+}
+
+func (ctrl *authController) getDeviceAuthorization(
+	ctx context.Context,
+	input *deviceInput,
+) (*deviceOutput, error) {
+	device, err := ctrl.authService.GetDeviceAuthorization(ctx, input.UserCode)
+	if err != nil {
+		return nil, err
+	}
+	return &deviceOutput{
+		Body: device,
+	}, nil
+}
+
+func (ctrl *authController) approveDevice(
+	ctx context.Context,
+	input *approveDeviceInput,
+) (*struct{}, error) {
+	err := ctrl.authService.ApproveDevice(ctx, &input.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &struct{}{}, nil
+}
+
+func (ctrl *authController) impersonate(
+	ctx context.Context,
+	input *impersonateInput,
+) (*impersonateOutput, error) {
+	tokenSet, err := ctrl.authService.Impersonate(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &impersonateOutput{
+		Body: tokenSet,
+	}, nil
+}
@@ -2,6 +2,8 @@ package authcontroller
 
 import (
 	"net/http"
+
+	authmodels "base-website/internal/services/auth/models"
 )
 
 type logoutOutput struct {
@@ -16,3 +18,35 @@ type oAuthClientCallbackInput struct {
 	Code        string `query:"code" required:"true" doc:"The OAuth2 code to exchange for a token" example:"123456"`
 	RedirectURI string `query:"redirect_uri" doc:"The redirect URI to use for the OAuth2 callback" example:"http://localhost:8080/auth/callback"`
 }
+
+type authorizeInput struct {
+	AuthRequestID string `query:"auth_request_id" required:"true" doc:"The ID of the pending auth request" example:"4f0b2f9c-5d4e-4d0b-9c55-1d6f2b1d7c3e"`
+}
+
+type authorizeOutput struct {
+	Body *authmodels.AuthorizeResponse
+}
+
+type consentInput struct {
+	Body authmodels.ConsentParams
+}
+
+type deviceInput struct {
+	UserCode string `query:"user_code" required:"true" doc:"The code displayed on the device" example:"BCDF-GHJK"`
+}
+
+type deviceOutput struct {
+	Body *authmodels.DeviceAuthorization
+}
+
+type approveDeviceInput struct {
+	Body authmodels.DeviceApprovalParams
+}
+
+type impersonateInput struct {
+	UserID int `path:"id" required:"true" doc:"The ID of the user to impersonate" example:"42"`
+}
+
+type impersonateOutput struct {
+	Body *authmodels.TokenSet
+}
@@ -2,6 +2,7 @@ package controllers
 
 import (
 	appsccontroller "base-website/internal/controllers/apps"
+	auditcontroller "base-website/internal/controllers/audit"
 	authcontroller "base-website/internal/controllers/auth"
 	consentscontroller "base-website/internal/controllers/consents"
 	envcontroller "base-website/internal/controllers/env"
@@ -9,6 +10,8 @@ import (
 	notificationscontroller "base-website/internal/controllers/notifications"
 	rankgroupcontroller "base-website/internal/controllers/rank_group"
 	rbaccrontroller "base-website/internal/controllers/rbac"
+	sanctionscontroller "base-website/internal/controllers/sanctions"
+	sessionscontroller "base-website/internal/controllers/sessions"
 	teamscontroller "base-website/internal/controllers/teams"
 	tournamentscontroller "base-website/internal/controllers/tournaments"
 	userscontroller "base-website/internal/controllers/users"
@@ -37,6 +40,9 @@ func controllersList() []controllerInitFunc {
 		consentscontroller.Init,
 		appsccontroller.Init,
 		notificationscontroller.Init,
+		sessionscontroller.Init,
+		auditcontroller.Init,
+		sanctionscontroller.Init,
 	}
 }
 
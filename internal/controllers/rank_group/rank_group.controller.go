@@ -1,6 +1,7 @@
 package rankgroupcontroller
 
 import (
+	"base-website/ent/tournamentadmin"
 	"base-website/internal/security"
 	pubsubservice "base-website/internal/services/pubsub"
 	rankgroupservice "base-website/internal/services/rank_group"
@@ -42,6 +43,7 @@ func (ctrl *rankGroupController) Register(api huma.API) {
 		Tags:        []string{"RankGroups"},
 		OperationID: "updateRankGroupsTournament",
 		Security:    security.WithAuth("profile"),
+		Metadata:    security.WithTournamentRole(security.ScopeTournament, "id", tournamentadmin.RoleSUPER_ADMIN),
 	}, ctrl.updateRankGroupsTournament)
 
 	huma.Register(api, huma.Operation{
@@ -52,6 +54,7 @@ func (ctrl *rankGroupController) Register(api huma.API) {
 		Tags:        []string{"RankGroups"},
 		OperationID: "updateTeamRankGroup",
 		Security:    security.WithAuth("profile"),
+		Metadata:    security.WithTournamentRole(security.ScopeTeam, "id", tournamentadmin.RoleADMIN),
 	}, ctrl.updateTeamRankGroup)
 }
 
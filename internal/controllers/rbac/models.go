@@ -1,6 +1,13 @@
 package rbaccrontroller
 
-import "base-website/pkg/rbac"
+import (
+	rbacmodels "base-website/internal/services/rbac/models"
+	"base-website/pkg/rbac"
+)
+
+type currentUserRbacOutput struct {
+	Body *rbacmodels.UserPermissions
+}
 
 type userRbacOutput struct {
 	Body []*rbac.Permission `nullable:"false"`
@@ -13,3 +20,14 @@ type userRbacInput struct {
 type rolesOutput struct {
 	Body []*rbac.Role `nullable:"false"`
 }
+
+type checkInput struct {
+	UserID int      `query:"user_id" description:"The user whose roles are checked, takes precedence over roles." example:"1"`
+	Roles  []string `query:"roles" description:"The roles checked when no user is given." example:"basic_admin,vote_admin"`
+	Method string   `query:"method" required:"true" description:"The HTTP method of the request." example:"PATCH"`
+	Path   string   `query:"path" required:"true" description:"The path of the request, either concrete or as declared in the API spec." example:"/tournaments/42"`
+}
+
+type checkOutput struct {
+	Body *rbacmodels.CheckResult
+}
@@ -5,6 +5,7 @@ import (
 
 	"base-website/internal/security"
 	rbacservice "base-website/internal/services/rbac"
+	rbacmodels "base-website/internal/services/rbac/models"
 
 	"github.com/danielgtaylor/huma/v2"
 	"github.com/samber/do"
@@ -26,11 +27,20 @@ func (ctrl *rbacController) Register(api huma.API) {
 		Method:      "GET",
 		Path:        "/me/permissions",
 		Summary:     "Get current user RBAC permissions",
-		Description: `This endpoint is used to get the current user RBAC permissions.`,
+		Description: `This endpoint is used to get the current user RBAC permissions, and to know for every operation whether the current user can send it, which role and which permission decide it and which tournament role it requires.`,
 		Tags:        []string{"RBAC", "Users"},
 		OperationID: "getCurrentUserRBACPermissions",
 		Security:    security.WithAuth("profile"),
 	}, ctrl.getCurrentUserRBACPermissions)
+	huma.Register(api, huma.Operation{
+		Method:      "GET",
+		Path:        "/rbac/check",
+		Summary:     "Check RBAC decision",
+		Description: `This endpoint is used to know whether a user or a set of roles can send a request, which role and which permission decide it and which tournament role it requires.`,
+		Tags:        []string{"RBAC"},
+		OperationID: "checkRBACPermission",
+		Security:    security.WithAuth("profile"),
+	}, ctrl.checkRBACPermission)
 	huma.Register(api, huma.Operation{
 		Method:      "GET",
 		Path:        "/users/{id}/permissions",
@@ -53,13 +63,35 @@ func (ctrl *rbacController) Register(api huma.API) {
 
 func (ctrl *rbacController) getCurrentUserRBACPermissions(
 	ctx context.Context,
-	input *struct{}) (*userRbacOutput, error) {
+	input *struct{}) (*currentUserRbacOutput, error) {
 	userID, err := security.GetUserIDFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
-	output := &userRbacOutput{}
-	output.Body, err = ctrl.rbacService.GetPermissionsFromUserID(ctx, userID)
+	output := &currentUserRbacOutput{}
+	output.Body, err = ctrl.rbacService.GetUserPermissions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+func (ctrl *rbacController) checkRBACPermission(
+	ctx context.Context,
+	input *checkInput) (*checkOutput, error) {
+	params := &rbacmodels.CheckParams{
+		Roles:  input.Roles,
+		Method: input.Method,
+		Path:   input.Path,
+	}
+	if input.UserID != 0 {
+		params.UserID = &input.UserID
+	} else if len(input.Roles) == 0 {
+		return nil, huma.Error400BadRequest("either user_id or roles is required")
+	}
+	output := &checkOutput{}
+	var err error
+	output.Body, err = ctrl.rbacService.Check(ctx, params)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,33 @@
+package sanctionscontroller
+
+import (
+	sanctionsmodels "base-website/internal/services/sanctions/models"
+	"base-website/pkg/paging"
+)
+
+type sanctionsOutput struct {
+	Body []*sanctionsmodels.Sanction `nullable:"false"`
+}
+
+type oneSanctionOutput struct {
+	Body *sanctionsmodels.Sanction `required:"true"`
+}
+
+type listUserSanctionsInput struct {
+	UserID int `path:"id" required:"true" example:"42" description:"The User ID"`
+	sanctionsmodels.ListSanctionsParams
+}
+
+type listUserSanctionsOutput struct {
+	Body *paging.Response[*sanctionsmodels.Sanction] `nullable:"false"`
+}
+
+type issueSanctionInput struct {
+	UserID int `path:"id" required:"true" example:"42" description:"The User ID"`
+	Body   sanctionsmodels.IssueSanctionParams
+}
+
+type liftSanctionInput struct {
+	SanctionID int `path:"id" required:"true" example:"1" description:"The Sanction ID"`
+	Body       sanctionsmodels.LiftSanctionParams
+}
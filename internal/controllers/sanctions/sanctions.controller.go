@@ -0,0 +1,119 @@
+package sanctionscontroller
+
+import (
+	"context"
+
+	"base-website/internal/security"
+	sanctionsservice "base-website/internal/services/sanctions"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/samber/do"
+)
+
+type sanctionsController struct {
+	sanctionsService sanctionsservice.SanctionsService
+}
+
+func Init(api huma.API, injector *do.Injector) {
+	controller := &sanctionsController{
+		sanctionsService: do.MustInvoke[sanctionsservice.SanctionsService](injector),
+	}
+	controller.Register(api)
+}
+
+func (ctrl *sanctionsController) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		Method:      "GET",
+		Path:        "/me/sanctions",
+		Summary:     "List my sanctions",
+		Description: `This endpoint is used to list the active sanctions of the current user.`,
+		Tags:        []string{"Sanctions"},
+		OperationID: "listMySanctions",
+		Security:    security.WithAuth("profile"),
+		Metadata:    security.AllowBanned(),
+	}, ctrl.listMySanctions)
+
+	huma.Register(api, huma.Operation{
+		Method:      "GET",
+		Path:        "/users/{id}/sanctions",
+		Summary:     "List user sanctions",
+		Description: `This endpoint is used to list the sanctions of a user, lifted and expired ones included.`,
+		Tags:        []string{"Sanctions"},
+		OperationID: "listUserSanctions",
+		Security:    security.WithAuth("profile"),
+	}, ctrl.listUserSanctions)
+
+	huma.Register(api, huma.Operation{
+		Method:      "POST",
+		Path:        "/users/{id}/sanctions",
+		Summary:     "Sanction user",
+		Description: `This endpoint is used to issue a global ban, tournament ban, vote ban or warning to a user, the issuer must hold more roles than them.`,
+		Tags:        []string{"Sanctions"},
+		OperationID: "issueSanction",
+		Security:    security.WithAuth("profile"),
+		Metadata:    security.ForbidImpersonation(),
+	}, ctrl.issueSanction)
+
+	huma.Register(api, huma.Operation{
+		Method:      "POST",
+		Path:        "/sanctions/{id}/lift",
+		Summary:     "Lift sanction",
+		Description: `This endpoint is used to end an active sanction before its expiry, the current user must hold more roles than the sanctioned user.`,
+		Tags:        []string{"Sanctions"},
+		OperationID: "liftSanction",
+		Security:    security.WithAuth("profile"),
+		Metadata:    security.ForbidImpersonation(),
+	}, ctrl.liftSanction)
+}
+
+func (ctrl *sanctionsController) listMySanctions(
+	ctx context.Context,
+	input *struct{},
+) (*sanctionsOutput, error) {
+	sanctions, err := ctrl.sanctionsService.ListMySanctions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &sanctionsOutput{
+		Body: sanctions,
+	}, nil
+}
+
+func (ctrl *sanctionsController) listUserSanctions(
+	ctx context.Context,
+	input *listUserSanctionsInput,
+) (*listUserSanctionsOutput, error) {
+	result, err := ctrl.sanctionsService.ListUserSanctions(ctx, input.UserID, &input.ListSanctionsParams)
+	if err != nil {
+		return nil, err
+	}
+	return &listUserSanctionsOutput{
+		Body: result,
+	}, nil
+}
+
+func (ctrl *sanctionsController) issueSanction(
+	ctx context.Context,
+	input *issueSanctionInput,
+) (*oneSanctionOutput, error) {
+	sanction, err := ctrl.sanctionsService.IssueSanction(ctx, input.UserID, &input.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &oneSanctionOutput{
+		Body: sanction,
+	}, nil
+}
+
+func (ctrl *sanctionsController) liftSanction(
+	ctx context.Context,
+	input *liftSanctionInput,
+) (*oneSanctionOutput, error) {
+	sanction, err := ctrl.sanctionsService.LiftSanction(ctx, input.SanctionID, &input.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &oneSanctionOutput{
+		Body: sanction,
+	}, nil
+}
@@ -0,0 +1,17 @@
+package sessionscontroller
+
+import (
+	sessionsmodels "base-website/internal/services/sessions/models"
+)
+
+type appSessionsInput struct {
+	AppID string `path:"app_id" example:"c-e4v2-3b1f" description:"The ID of the app"`
+}
+
+type sessionByIDInput struct {
+	ID string `path:"id" example:"4f0b2f9c-5d4e-4d0b-9c55-1d6f2b1d7c3e" description:"The ID of the session"`
+}
+
+type sessionsOutput struct {
+	Body []*sessionsmodels.Session `nullable:"false"`
+}
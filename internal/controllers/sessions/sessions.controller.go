@@ -0,0 +1,133 @@
+package sessionscontroller
+
+import (
+	"context"
+
+	"base-website/internal/security"
+	sessionsservice "base-website/internal/services/sessions"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/samber/do"
+)
+
+type sessionsController struct {
+	sessionsService sessionsservice.SessionService
+}
+
+func Init(api huma.API, injector *do.Injector) {
+	sessionsController := &sessionsController{
+		sessionsService: do.MustInvoke[sessionsservice.SessionService](injector),
+	}
+	sessionsController.Register(api)
+}
+
+func (ctrl *sessionsController) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		Method:      "GET",
+		Path:        "/me/sessions",
+		Summary:     "Get my sessions",
+		Description: `This endpoint is used to list the active sessions of the current user on the website and on every app.`,
+		Tags:        []string{"Sessions"},
+		OperationID: "getMySessions",
+		Security:    security.WithAuth("security"),
+	}, ctrl.getMySessions)
+
+	huma.Register(api, huma.Operation{
+		Method:      "DELETE",
+		Path:        "/me/sessions",
+		Summary:     "Revoke my other sessions",
+		Description: `This endpoint is used to log the current user out everywhere except from the session making the request.`,
+		Tags:        []string{"Sessions"},
+		OperationID: "revokeMyOtherSessions",
+		Security:    security.WithAuth("security"),
+	}, ctrl.revokeMyOtherSessions)
+
+	huma.Register(api, huma.Operation{
+		Method:      "DELETE",
+		Path:        "/me/sessions/{id}",
+		Summary:     "Revoke my session",
+		Description: `This endpoint is used to revoke one session of the current user.`,
+		Tags:        []string{"Sessions"},
+		OperationID: "revokeMySession",
+		Security:    security.WithAuth("security"),
+	}, ctrl.revokeMySession)
+
+	huma.Register(api, huma.Operation{
+		Method:      "GET",
+		Path:        "/apps/{app_id}/me/sessions",
+		Summary:     "Get my sessions for an app",
+		Description: `This endpoint is used to list the active sessions of the current user for an app.`,
+		Tags:        []string{"Sessions", "Apps"},
+		OperationID: "getMySessionsForApp",
+		Security:    security.WithAuth("security"),
+	}, ctrl.getMySessionsForApp)
+
+	huma.Register(api, huma.Operation{
+		Method:      "DELETE",
+		Path:        "/apps/{app_id}/me/sessions",
+		Summary:     "Revoke my sessions for an app",
+		Description: `This endpoint is used to revoke every session of the current user for an app.`,
+		Tags:        []string{"Sessions", "Apps"},
+		OperationID: "revokeMySessionsForApp",
+		Security:    security.WithAuth("security"),
+	}, ctrl.revokeMySessionsForApp)
+}
+
+func (ctrl *sessionsController) getMySessions(
+	ctx context.Context,
+	input *struct{},
+) (*sessionsOutput, error) {
+	sessions, err := ctrl.sessionsService.ListMine(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	return &sessionsOutput{
+		Body: sessions,
+	}, nil
+}
+
+func (ctrl *sessionsController) revokeMyOtherSessions(
+	ctx context.Context,
+	input *struct{},
+) (*struct{}, error) {
+	err := ctrl.sessionsService.RevokeMineExceptCurrent(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &struct{}{}, nil
+}
+
+func (ctrl *sessionsController) revokeMySession(
+	ctx context.Context,
+	input *sessionByIDInput,
+) (*struct{}, error) {
+	err := ctrl.sessionsService.RevokeMine(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &struct{}{}, nil
+}
+
+func (ctrl *sessionsController) getMySessionsForApp(
+	ctx context.Context,
+	input *appSessionsInput,
+) (*sessionsOutput, error) {
+	sessions, err := ctrl.sessionsService.ListMine(ctx, input.AppID)
+	if err != nil {
+		return nil, err
+	}
+	return &sessionsOutput{
+		Body: sessions,
+	}, nil
+}
+
+func (ctrl *sessionsController) revokeMySessionsForApp(
+	ctx context.Context,
+	input *appSessionsInput,
+) (*struct{}, error) {
+	err := ctrl.sessionsService.RevokeMineForApp(ctx, input.AppID)
+	if err != nil {
+		return nil, err
+	}
+	return &struct{}{}, nil
+}
@@ -83,6 +83,7 @@ func (ctrl *teamController) Register(api huma.API) {
 		Tags:        []string{"Teams"},
 		OperationID: "deleteTeam",
 		Security:    security.WithAuth("profile"),
+		Metadata:    security.ForbidImpersonation(),
 	}, ctrl.deleteTeam)
 
 	huma.Register(api, huma.Operation{
@@ -1,6 +1,7 @@
 package tournamentscontroller
 
 import (
+	"base-website/ent/tournamentadmin"
 	"base-website/internal/security"
 	pubsubservice "base-website/internal/services/pubsub"
 	tournamentsservice "base-website/internal/services/tournaments"
@@ -66,6 +67,7 @@ func (ctrl *tournamentController) Register(api huma.API) {
 		Tags:        []string{"Tournament"},
 		OperationID: "updateTournament",
 		Security:    security.WithAuth("profile"),
+		Metadata:    security.WithTournamentRole(security.ScopeTournament, "id", tournamentadmin.RoleSUPER_ADMIN),
 	}, ctrl.updateTournament)
 
 	huma.Register(api, huma.Operation{
@@ -76,6 +78,7 @@ func (ctrl *tournamentController) Register(api huma.API) {
 		Tags:        []string{"Tournament"},
 		OperationID: "deleteTournament",
 		Security:    security.WithAuth("profile"),
+		Metadata:    security.ForbidImpersonation(security.WithTournamentRole(security.ScopeTournament, "id", tournamentadmin.RoleCREATOR)),
 	}, ctrl.deleteTournament)
 
 	huma.Register(api, huma.Operation{
@@ -86,6 +89,7 @@ func (ctrl *tournamentController) Register(api huma.API) {
 		Tags:        []string{"Tournament"},
 		OperationID: "addTournamentAdmin",
 		Security:    security.WithAuth("profile"),
+		Metadata:    security.WithTournamentRole(security.ScopeTournament, "id", tournamentadmin.RoleSUPER_ADMIN),
 	}, ctrl.addTournamentAdmin)
 
 	huma.Register(api, huma.Operation{
@@ -96,6 +100,7 @@ func (ctrl *tournamentController) Register(api huma.API) {
 		Tags:        []string{"Tournament"},
 		OperationID: "editTournamentAdmin",
 		Security:    security.WithAuth("profile"),
+		Metadata:    security.WithTournamentRole(security.ScopeTournament, "id", tournamentadmin.RoleSUPER_ADMIN),
 	}, ctrl.editTournamentAdmin)
 
 	huma.Register(api, huma.Operation{
@@ -106,6 +111,7 @@ func (ctrl *tournamentController) Register(api huma.API) {
 		Tags:        []string{"Tournament"},
 		OperationID: "deleteTournamentAdmin",
 		Security:    security.WithAuth("profile"),
+		Metadata:    security.WithTournamentRole(security.ScopeTournament, "id", tournamentadmin.RoleSUPER_ADMIN),
 	}, ctrl.deleteTournamentAdmin)
 
 	huma.Register(api, huma.Operation{
@@ -116,6 +122,7 @@ func (ctrl *tournamentController) Register(api huma.API) {
 		Tags:        []string{"Tournament"},
 		OperationID: "endTournament",
 		Security:    security.WithAuth("profile"),
+		Metadata:    security.WithTournamentRole(security.ScopeTournament, "id", tournamentadmin.RoleSUPER_ADMIN),
 	}, ctrl.endTournament)
 }
 
@@ -33,3 +33,25 @@ type changeUserRolesInput struct {
 	UserID int      `path:"id" required:"true" example:"42" description:"The User ID"`
 	Body   []string `required:"true"`
 }
+
+type userRolesInput struct {
+	UserID int `path:"id" required:"true" example:"42" description:"The User ID"`
+}
+
+type roleGrantsOutput struct {
+	Body []*usersmodels.RoleGrant `nullable:"false"`
+}
+
+type userRoleInput struct {
+	UserID int    `path:"id" required:"true" example:"42" description:"The User ID"`
+	Role   string `path:"role" required:"true" example:"vote_admin" description:"The role"`
+}
+
+type grantRoleInput struct {
+	userRoleInput
+	Body usersmodels.GrantRoleParams
+}
+
+type oneRoleGrantOutput struct {
+	Body *usersmodels.RoleGrant `required:"true"`
+}
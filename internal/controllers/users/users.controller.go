@@ -56,6 +56,7 @@ func (ctrl *userController) Register(api huma.API) {
 		Tags:        []string{"Users"},
 		OperationID: "getCurrentUser",
 		Security:    security.WithAuth("profile"),
+		Metadata:    security.AllowBanned(),
 	}, ctrl.getCurrentUser)
 
 	huma.Register(api, huma.Operation{
@@ -66,8 +67,41 @@ func (ctrl *userController) Register(api huma.API) {
 		Tags:        []string{"Users"},
 		OperationID: "changeUserRoles",
 		Security:    security.WithAuth("profile"),
+		Metadata:    security.ForbidImpersonation(),
 	}, ctrl.changeUserRoles)
 
+	huma.Register(api, huma.Operation{
+		Method:      "GET",
+		Path:        "/users/{id}/roles",
+		Summary:     "List user role grants",
+		Description: `This endpoint is used to list the roles of a user with their reason and expiry.`,
+		Tags:        []string{"Users"},
+		OperationID: "listUserRoleGrants",
+		Security:    security.WithAuth("profile"),
+	}, ctrl.listRoleGrants)
+
+	huma.Register(api, huma.Operation{
+		Method:      "PUT",
+		Path:        "/users/{id}/roles/{role}",
+		Summary:     "Grant user role",
+		Description: `This endpoint is used to grant a role to a user, the role is revoked once expired.`,
+		Tags:        []string{"Users"},
+		OperationID: "grantUserRole",
+		Security:    security.WithAuth("profile"),
+		Metadata:    security.ForbidImpersonation(),
+	}, ctrl.grantRole)
+
+	huma.Register(api, huma.Operation{
+		Method:      "DELETE",
+		Path:        "/users/{id}/roles/{role}",
+		Summary:     "Revoke user role",
+		Description: `This endpoint is used to revoke a role of a user.`,
+		Tags:        []string{"Users"},
+		OperationID: "revokeUserRole",
+		Security:    security.WithAuth("profile"),
+		Metadata:    security.ForbidImpersonation(),
+	}, ctrl.revokeRole)
+
 	huma.Register(api, huma.Operation{
 		Method:      "POST",
 		Path:        "/me/anonymize",
@@ -76,6 +110,7 @@ func (ctrl *userController) Register(api huma.API) {
 		Tags:        []string{"Users"},
 		OperationID: "anonymizeCurrentUser",
 		Security:    security.WithAuth("profile"),
+		Metadata:    security.ForbidImpersonation(),
 	}, ctrl.anonymizeUser)
 }
 
@@ -146,6 +181,43 @@ func (ctrl *userController) changeUserRoles(
 	}, nil
 }
 
+func (ctrl *userController) listRoleGrants(
+	ctx context.Context,
+	input *userRolesInput,
+) (*roleGrantsOutput, error) {
+	roleGrants, err := ctrl.usersService.ListRoleGrants(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &roleGrantsOutput{
+		Body: roleGrants,
+	}, nil
+}
+
+func (ctrl *userController) grantRole(
+	ctx context.Context,
+	input *grantRoleInput,
+) (*oneRoleGrantOutput, error) {
+	roleGrant, err := ctrl.usersService.GrantRole(ctx, input.UserID, input.Role, &input.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &oneRoleGrantOutput{
+		Body: roleGrant,
+	}, nil
+}
+
+func (ctrl *userController) revokeRole(
+	ctx context.Context,
+	input *userRoleInput,
+) (*struct{}, error) {
+	err := ctrl.usersService.RevokeRole(ctx, input.UserID, input.Role)
+	if err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
 func (ctrl *userController) anonymizeUser(
 	ctx context.Context,
 	input *struct{},
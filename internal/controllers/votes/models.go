@@ -57,8 +57,63 @@ type createComponentInput struct {
 	RawBody huma.MultipartFormFiles[votesmodels.CreateComponent] `required:"true"`
 }
 
+type importComponentsInput struct {
+	VoteID int `path:"id" required:"true" example:"42" description:"The vote ID"`
+
+	RawBody huma.MultipartFormFiles[votesmodels.ImportComponents] `required:"true"`
+}
+
+type importComponentsOutput struct {
+	Body *votesmodels.ComponentImportReport `required:"true"`
+}
+
 type updateComponentInput struct {
 	ComponentID int `path:"id" required:"true" example:"42" description:"The vote ID"`
 
 	RawBody huma.MultipartFormFiles[votesmodels.UpdateComponent] `required:"true"`
 }
+
+type exportVoteOutput struct {
+	Body *votesmodels.VoteExport `required:"true"`
+}
+
+type voteAnalyticsOutput struct {
+	Body *votesmodels.VoteAnalytics `required:"true"`
+}
+
+type exportVoteCSVOutput struct {
+	ContentType        string `header:"Content-Type"`
+	ContentDisposition string `header:"Content-Disposition"`
+
+	Body []byte
+}
+
+type cloneVoteInput struct {
+	VoteID int `path:"id" required:"true" example:"42" description:"The vote ID"`
+
+	Body *votesmodels.CloneVote `required:"true"`
+}
+
+type createVoteTemplateInput struct {
+	VoteID int `path:"id" required:"true" example:"42" description:"The vote ID"`
+
+	Body *votesmodels.CreateVoteTemplate `required:"true"`
+}
+
+type VoteTemplateIDInput struct {
+	TemplateID int `path:"id" required:"true" example:"42" description:"The vote template ID"`
+}
+
+type createVoteFromTemplateInput struct {
+	TemplateID int `path:"id" required:"true" example:"42" description:"The vote template ID"`
+
+	Body *votesmodels.CreateVoteFromTemplate `required:"true"`
+}
+
+type oneVoteTemplateOutput struct {
+	Body *lightmodels.VoteTemplate `required:"true"`
+}
+
+type multipleVoteTemplatesOutput struct {
+	Body *paging.Response[*lightmodels.VoteTemplate] `nullable:"false"`
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/danielgtaylor/huma/v2"
 	"github.com/danielgtaylor/huma/v2/sse"
@@ -98,8 +99,100 @@ func (ctrl *voteController) Register(api huma.API) {
 		Tags:        []string{"Vote"},
 		OperationID: "deleteVote",
 		Security:    security.WithAuth("profile"),
+		Metadata:    security.ForbidImpersonation(),
 	}, ctrl.deleteVote)
 
+	huma.Register(api, huma.Operation{
+		Method:      "POST",
+		Path:        "/votes/{id}/clone",
+		Summary:     "Clone Vote",
+		Description: `This endpoint is used to clone a vote with its components and images into a new hidden vote. The dates of the vote are shifted to the given start date.`,
+		Tags:        []string{"Vote"},
+		OperationID: "cloneVote",
+		Security:    security.WithAuth("profile"),
+	}, ctrl.cloneVote)
+
+	huma.Register(api, huma.Operation{
+		Method:      "POST",
+		Path:        "/votes/{id}/template",
+		Summary:     "Save Vote as Template",
+		Description: `This endpoint is used to save a vote with its components and images as a reusable template.`,
+		Tags:        []string{"Vote"},
+		OperationID: "createVoteTemplate",
+		Security:    security.WithAuth("profile"),
+	}, ctrl.createVoteTemplate)
+
+	huma.Register(api, huma.Operation{
+		Method:      "GET",
+		Path:        "/vote-templates",
+		Summary:     "Get All Vote Templates",
+		Description: `This endpoint is used to get all vote templates.`,
+		Tags:        []string{"Vote"},
+		OperationID: "getAllVoteTemplates",
+		Security:    security.WithAuth("profile"),
+	}, ctrl.getAllVoteTemplates)
+
+	huma.Register(api, huma.Operation{
+		Method:      "GET",
+		Path:        "/vote-templates/{id}",
+		Summary:     "Get Vote Template by ID",
+		Description: `This endpoint is used to get a vote template.`,
+		Tags:        []string{"Vote"},
+		OperationID: "getVoteTemplateByID",
+		Security:    security.WithAuth("profile"),
+	}, ctrl.getVoteTemplateByID)
+
+	huma.Register(api, huma.Operation{
+		Method:      "DELETE",
+		Path:        "/vote-templates/{id}",
+		Summary:     "Delete Vote Template",
+		Description: `This endpoint is used to delete a vote template.`,
+		Tags:        []string{"Vote"},
+		OperationID: "deleteVoteTemplate",
+		Security:    security.WithAuth("profile"),
+		Metadata:    security.ForbidImpersonation(),
+	}, ctrl.deleteVoteTemplate)
+
+	huma.Register(api, huma.Operation{
+		Method:      "POST",
+		Path:        "/vote-templates/{id}/votes",
+		Summary:     "Create Vote from Template",
+		Description: `This endpoint is used to create a new hidden vote with the components and images of a template.`,
+		Tags:        []string{"Vote"},
+		OperationID: "createVoteFromTemplate",
+		Security:    security.WithAuth("profile"),
+	}, ctrl.createVoteFromTemplate)
+
+	huma.Register(api, huma.Operation{
+		Method:      "GET",
+		Path:        "/votes/{id}/export",
+		Summary:     "Export Vote",
+		Description: `This endpoint is used to export the results and participation of a vote. Ballots are only included for non anonymous votes.`,
+		Tags:        []string{"Vote"},
+		OperationID: "exportVote",
+		Security:    security.WithAuth("profile"),
+	}, ctrl.exportVote)
+
+	huma.Register(api, huma.Operation{
+		Method:      "GET",
+		Path:        "/votes/{id}/analytics",
+		Summary:     "Get Vote analytics",
+		Description: `This endpoint is used to get the turnout of a vote over time, the share of active users who voted and the participation per role and campus. Active users are the users who logged in recently or voted.`,
+		Tags:        []string{"Vote"},
+		OperationID: "getVoteAnalytics",
+		Security:    security.WithAuth("profile"),
+	}, ctrl.getVoteAnalytics)
+
+	huma.Register(api, huma.Operation{
+		Method:      "GET",
+		Path:        "/votes/{id}/export/csv",
+		Summary:     "Export Vote as CSV",
+		Description: `This endpoint is used to export one dataset of a vote (results, turnout or ballots) as CSV.`,
+		Tags:        []string{"Vote"},
+		OperationID: "exportVoteCSV",
+		Security:    security.WithAuth("profile"),
+	}, ctrl.exportVoteCSV)
+
 	huma.Register(api, huma.Operation{
 		Method:      "POST",
 		Path:        "/votes/{id}/components",
@@ -110,6 +203,18 @@ func (ctrl *voteController) Register(api huma.API) {
 		Security:    security.WithAuth("profile"),
 	}, ctrl.createComponent)
 
+	huma.Register(api, huma.Operation{
+		Method:      "POST",
+		Path:        "/votes/{id}/components/import",
+		Summary:     "Import Components",
+		Description: `This endpoint is used to create many components of a vote at once from a JSON or CSV file. Images are fetched from the image_url column or read from a zip of images. Components are only created when every row is valid, the report details the errors of each row.`,
+		Tags:        []string{"Vote"},
+		OperationID: "importComponents",
+		Security:    security.WithAuth("profile"),
+		// The zip of images can be much larger than the default body limit
+		MaxBodyBytes: 64 << 20,
+	}, ctrl.importComponents)
+
 	huma.Register(api, huma.Operation{
 		Method:      "PATCH",
 		Path:        "/components/{id}",
@@ -134,12 +239,14 @@ func (ctrl *voteController) Register(api huma.API) {
 		Method:      "GET",
 		Path:        "/votes/{id}/live",
 		Summary:     "Live updates for a vote",
-		Description: `Server-Sent Events stream that sends live vote results in real-time when votes are submitted. First sends a connection confirmation message, then streams updated results as they occur.`,
+		Description: `Server-Sent Events stream that sends live vote results in real-time when votes are submitted. First sends a connection confirmation message, then streams updated results as they occur. A final_results event is sent once the results of the closed vote are published. The users allowed to get the analytics of the vote also receive its analytics events.`,
 		Tags:        []string{"Vote"},
 		OperationID: "liveVote",
 		Security:    security.WithAuth("profile"),
 	}, map[string]any{
-		"message": votesmodels.ResultsResponse{},
+		"message":       votesmodels.ResultsResponse{},
+		"final_results": votesmodels.FinalResults{},
+		"analytics":     votesmodels.VoteAnalytics{},
 	}, ctrl.liveVote)
 }
 
@@ -178,9 +285,11 @@ func (ctrl *voteController) submitVote(
 		return nil, err
 	}
 
-	resultsJSON, err := json.Marshal(results)
-	if err == nil {
-		ctrl.pubsubService.Publish(ctx, fmt.Sprintf("Vote:%d", input.VoteID), resultsJSON)
+	if results != nil {
+		event, err := votesmodels.NewLiveEvent(votesmodels.LiveEventResults, results)
+		if err == nil {
+			ctrl.pubsubService.Publish(ctx, fmt.Sprintf("Vote:%d", input.VoteID), event)
+		}
 	}
 
 	return &BodyMessage{
@@ -244,6 +353,139 @@ func (ctrl *voteController) deleteVote(
 	}, nil
 }
 
+func (ctrl *voteController) cloneVote(
+	ctx context.Context,
+	input *cloneVoteInput,
+) (*oneVoteOutput, error) {
+	vote, err := ctrl.votesService.CloneVote(ctx, input.VoteID, *input.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &oneVoteOutput{
+		Body: vote,
+	}, nil
+}
+
+func (ctrl *voteController) createVoteTemplate(
+	ctx context.Context,
+	input *createVoteTemplateInput,
+) (*oneVoteTemplateOutput, error) {
+	template, err := ctrl.votesService.CreateVoteTemplate(ctx, input.VoteID, *input.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &oneVoteTemplateOutput{
+		Body: template,
+	}, nil
+}
+
+func (ctrl *voteController) getAllVoteTemplates(
+	ctx context.Context,
+	input *votesmodels.ListVoteTemplatesParams,
+) (*multipleVoteTemplatesOutput, error) {
+	result, err := ctrl.votesService.ListVoteTemplates(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &multipleVoteTemplatesOutput{
+		Body: result,
+	}, nil
+}
+
+func (ctrl *voteController) getVoteTemplateByID(
+	ctx context.Context,
+	input *VoteTemplateIDInput,
+) (*oneVoteTemplateOutput, error) {
+	template, err := ctrl.votesService.GetVoteTemplate(ctx, input.TemplateID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &oneVoteTemplateOutput{
+		Body: template,
+	}, nil
+}
+
+func (ctrl *voteController) deleteVoteTemplate(
+	ctx context.Context,
+	input *VoteTemplateIDInput,
+) (*BodyMessage, error) {
+	err := ctrl.votesService.DeleteVoteTemplate(ctx, input.TemplateID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BodyMessage{
+		Body: "Success",
+	}, nil
+}
+
+func (ctrl *voteController) createVoteFromTemplate(
+	ctx context.Context,
+	input *createVoteFromTemplateInput,
+) (*oneVoteOutput, error) {
+	vote, err := ctrl.votesService.CreateVoteFromTemplate(ctx, input.TemplateID, *input.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &oneVoteOutput{
+		Body: vote,
+	}, nil
+}
+
+func (ctrl *voteController) exportVote(
+	ctx context.Context,
+	input *votesmodels.ExportVoteParams,
+) (*exportVoteOutput, error) {
+	export, err := ctrl.votesService.ExportVote(ctx, input.VoteID, time.Duration(input.Interval)*time.Minute)
+	if err != nil {
+		return nil, err
+	}
+
+	return &exportVoteOutput{
+		Body: export,
+	}, nil
+}
+
+func (ctrl *voteController) getVoteAnalytics(
+	ctx context.Context,
+	input *votesmodels.VoteAnalyticsParams,
+) (*voteAnalyticsOutput, error) {
+	analytics, err := ctrl.votesService.GetAnalytics(ctx, input.VoteID, time.Duration(input.Interval)*time.Minute)
+	if err != nil {
+		return nil, err
+	}
+
+	return &voteAnalyticsOutput{
+		Body: analytics,
+	}, nil
+}
+
+func (ctrl *voteController) exportVoteCSV(
+	ctx context.Context,
+	input *votesmodels.ExportVoteCSVParams,
+) (*exportVoteCSVOutput, error) {
+	export, err := ctrl.votesService.ExportVote(ctx, input.VoteID, time.Duration(input.Interval)*time.Minute)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := export.CSV(input.Dataset)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+
+	return &exportVoteCSVOutput{
+		ContentType:        "text/csv; charset=utf-8",
+		ContentDisposition: fmt.Sprintf(`attachment; filename="vote-%d-%s.csv"`, input.VoteID, input.Dataset),
+		Body:               data,
+	}, nil
+}
+
 func (ctrl *voteController) createComponent(
 	ctx context.Context,
 	input *createComponentInput,
@@ -260,6 +502,22 @@ func (ctrl *voteController) createComponent(
 	}, nil
 }
 
+func (ctrl *voteController) importComponents(
+	ctx context.Context,
+	input *importComponentsInput,
+) (*importComponentsOutput, error) {
+	RawBody := input.RawBody.Data()
+
+	report, err := ctrl.votesService.ImportComponents(ctx, input.VoteID, *RawBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return &importComponentsOutput{
+		Body: report,
+	}, nil
+}
+
 func (ctrl *voteController) updateComponent(
 	ctx context.Context,
 	input *updateComponentInput,
@@ -295,19 +553,48 @@ func (ctrl *voteController) liveVote(
 	input *VoteIDInput,
 	send sse.Sender,
 ) {
-	result, err := ctrl.votesService.GetResults(ctx, input.VoteID, true)
-	if err != nil {
+	if _, err := ctrl.votesService.GetVoteByID(ctx, input.VoteID); err != nil {
 		return
 	}
 
-	_ = send.Data(result)
+	// Embargoed votes only stream their final results once revealed
+	if result, err := ctrl.votesService.GetResults(ctx, input.VoteID, true); err == nil {
+		_ = send.Data(result)
+	}
+
+	// Analytics are only streamed to the users allowed to get them, the current ones come from the analytics operation
+	canViewAnalytics := security.PermissionsFromContext(ctx).Can("/votes/{id}/analytics", "GET")
 
 	_ = ctrl.pubsubService.Subscribe(ctx, fmt.Sprintf("Vote:%d", input.VoteID), func(message []byte) error {
-		var results votesmodels.ResultsResponse
-		if err := json.Unmarshal(message, &results); err != nil {
+		var event votesmodels.LiveEvent
+		if err := json.Unmarshal(message, &event); err != nil {
 			return err
 		}
 
-		return send.Data(results)
+		switch event.Type {
+		case votesmodels.LiveEventResults:
+			var results votesmodels.ResultsResponse
+			if err := json.Unmarshal(event.Data, &results); err != nil {
+				return err
+			}
+			return send.Data(results)
+		case votesmodels.LiveEventFinalResults:
+			var results votesmodels.FinalResults
+			if err := json.Unmarshal(event.Data, &results); err != nil {
+				return err
+			}
+			return send.Data(results)
+		case votesmodels.LiveEventAnalytics:
+			if !canViewAnalytics {
+				return nil
+			}
+			var analytics votesmodels.VoteAnalytics
+			if err := json.Unmarshal(event.Data, &analytics); err != nil {
+				return err
+			}
+			return send.Data(analytics)
+		}
+
+		return nil
 	})
 }
@@ -17,6 +17,7 @@ type LightUser struct {
 	Kind      user.Kind `json:"kind" example:"user" description:"The kind of the user" enum:"user,admin"`
 	Roles     []string  `json:"roles" example:"[\"user\"]" description:"The roles of the user" nullable:"false"`
 	Elo       int       `json:"elo" example:"0" description:"The ELO rating of the user"`
+	Campus    *string   `json:"campus,omitempty" example:"Paris" description:"The primary intra campus of the user"`
 }
 
 func NewLightUserFromEnt(entUser *ent.User) *LightUser {
@@ -33,6 +34,7 @@ func NewLightUserFromEnt(entUser *ent.User) *LightUser {
 		Kind:      entUser.Kind,
 		Roles:     entUser.Roles,
 		Elo:       entUser.Elo,
+		Campus:    entUser.Campus,
 	}
 }
 
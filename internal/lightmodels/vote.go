@@ -17,7 +17,11 @@ type LightVote struct {
 	CreatedAt       time.Time `json:"created_at" example:"2025-10-01T12:00:00Z" description:"The creation date of the vote"`
 	ComponentsCount int       `json:"components_count" example:"4" description:"The number of components in the vote"`
 	Visible         bool      `json:"visible" example:"true" description:"Whether the vote is visible"`
+	Anonymous       bool      `json:"anonymous" example:"true" description:"Whether the ballots of the vote are anonymous"`
 	Creator         LightUser `json:"creator" description:"The user who created this vote"`
+
+	ResultsRevealAt    *time.Time `json:"results_reveal_at,omitempty" example:"2025-10-21T20:00:00Z" description:"The date the results are revealed, if different from end_at"`
+	ResultsPublishedAt *time.Time `json:"results_published_at,omitempty" example:"2025-10-21T20:00:00Z" description:"The date the results were published"`
 }
 
 type Vote struct {
@@ -27,8 +31,12 @@ type Vote struct {
 	StartAt     time.Time    `json:"start_at" example:"2025-10-10T00:00:00Z" description:"The start date of the vote"`
 	EndAt       time.Time    `json:"end_at" example:"2025-10-20T23:59:59Z" description:"The end date of the vote"`
 	Visible     bool         `json:"visible" example:"true" description:"Whether the vote is visible"`
+	Anonymous   bool         `json:"anonymous" example:"true" description:"Whether the ballots of the vote are anonymous"`
 	Components  []*Component `json:"components" description:"The list of components in the vote"`
 	Creator     LightUser    `json:"creator" description:"The user who created this vote"`
+
+	ResultsRevealAt    *time.Time `json:"results_reveal_at,omitempty" example:"2025-10-21T20:00:00Z" description:"The date the results are revealed, if different from end_at"`
+	ResultsPublishedAt *time.Time `json:"results_published_at,omitempty" example:"2025-10-21T20:00:00Z" description:"The date the results were published"`
 }
 
 func NewLightVoteFromEnt(entVote *ent.Vote) *LightVote {
@@ -50,7 +58,11 @@ func NewLightVoteFromEnt(entVote *ent.Vote) *LightVote {
 		CreatedAt:       entVote.CreatedAt,
 		ComponentsCount: componentsCount,
 		Visible:         entVote.Visible,
+		Anonymous:       entVote.Anonymous,
 		Creator:         *NewLightUserFromEnt(entVote.Edges.Creator),
+
+		ResultsRevealAt:    entVote.ResultsRevealAt,
+		ResultsPublishedAt: entVote.ResultsPublishedAt,
 	}
 }
 
@@ -77,7 +89,11 @@ func NewVoteFromEnt(ctx context.Context, entVote *ent.Vote, S3Service s3service.
 		EndAt:       entVote.EndAt,
 		Components:  components,
 		Visible:     entVote.Visible,
+		Anonymous:   entVote.Anonymous,
 		Creator:     *NewLightUserFromEnt(entVote.Edges.Creator),
+
+		ResultsRevealAt:    entVote.ResultsRevealAt,
+		ResultsPublishedAt: entVote.ResultsPublishedAt,
 	}
 }
 
@@ -0,0 +1,79 @@
+package lightmodels
+
+import (
+	"base-website/ent"
+	s3service "base-website/internal/services/s3"
+	"context"
+	"time"
+)
+
+type VoteTemplateComponent struct {
+	Name        string  `json:"name" example:"Network" description:"The name of the component"`
+	Description string  `json:"description" example:"Network infrastructure and connectivity" description:"The description of the component"`
+	ImageURL    *string `json:"image_url" example:"https://example.com/network.png" description:"The image URL of the component"`
+	Color       string  `json:"color" example:"#FF5733" description:"The color of the component"`
+}
+
+type VoteTemplate struct {
+	ID                  int                      `json:"id" example:"1" description:"The ID of the template"`
+	Name                string                   `json:"name" example:"MVP of the LAN" description:"The name of the template"`
+	Title               string                   `json:"title" example:"MVP of the LAN" description:"The title of the votes created from the template"`
+	Description         string                   `json:"description" example:"Vote for the MVP of the LAN!" description:"The description of the votes created from the template"`
+	Anonymous           bool                     `json:"anonymous" example:"true" description:"Whether the votes created from the template are anonymous"`
+	DurationMinutes     int                      `json:"duration_minutes" example:"1440" description:"The duration of the votes created from the template"`
+	ResultsDelayMinutes *int                     `json:"results_delay_minutes,omitempty" example:"60" description:"The delay between the end of the vote and the reveal of its results"`
+	Components          []*VoteTemplateComponent `json:"components" description:"The components of the template" nullable:"false"`
+	Creator             LightUser                `json:"creator" description:"The user who created this template"`
+	CreatedAt           time.Time                `json:"created_at" example:"2025-10-01T12:00:00Z" description:"The creation date of the template"`
+	UpdatedAt           time.Time                `json:"updated_at" example:"2025-10-01T12:00:00Z" description:"The last update date of the template"`
+}
+
+func NewVoteTemplateFromEnt(ctx context.Context, entTemplate *ent.VoteTemplate, S3Service s3service.S3Service) *VoteTemplate {
+	if entTemplate == nil {
+		return nil
+	}
+
+	components := make([]*VoteTemplateComponent, len(entTemplate.Components))
+	for i, c := range entTemplate.Components {
+		var imageUrl *string
+		if c.ImageURL != nil {
+			tmp, err := S3Service.PresignedGet(ctx, *c.ImageURL, time.Hour)
+			if err == nil {
+				imageUrl = &tmp
+			}
+		}
+		components[i] = &VoteTemplateComponent{
+			Name:        c.Name,
+			Description: c.Description,
+			ImageURL:    imageUrl,
+			Color:       c.Color,
+		}
+	}
+
+	var creator LightUser
+	if entTemplate.Edges.Creator != nil {
+		creator = *NewLightUserFromEnt(entTemplate.Edges.Creator)
+	}
+
+	return &VoteTemplate{
+		ID:                  entTemplate.ID,
+		Name:                entTemplate.Name,
+		Title:               entTemplate.Title,
+		Description:         entTemplate.Description,
+		Anonymous:           entTemplate.Anonymous,
+		DurationMinutes:     entTemplate.DurationMinutes,
+		ResultsDelayMinutes: entTemplate.ResultsDelayMinutes,
+		Components:          components,
+		Creator:             creator,
+		CreatedAt:           entTemplate.CreatedAt,
+		UpdatedAt:           entTemplate.UpdatedAt,
+	}
+}
+
+func NewVoteTemplatesFromEnt(ctx context.Context, entTemplates []*ent.VoteTemplate, S3Service s3service.S3Service) []*VoteTemplate {
+	templates := make([]*VoteTemplate, len(entTemplates))
+	for i, entTemplate := range entTemplates {
+		templates[i] = NewVoteTemplateFromEnt(ctx, entTemplate, S3Service)
+	}
+	return templates
+}
@@ -15,6 +15,9 @@ import (
 	"github.com/zitadel/oidc/v3/pkg/op"
 )
 
+// lastUsedUpdateInterval is the precision of the last use date of the tokens
+const lastUsedUpdateInterval = time.Minute
+
 func NewAuthMiddleware(
 	api huma.API,
 	configService configservice.ConfigService,
@@ -105,11 +108,19 @@ func verifyToken(
 	if authTokenEnt.Expiration.Before(time.Now()) {
 		return nil, fmt.Errorf("token expired")
 	}
-	return &security.Claims{
+	// Track the last use of the session, at most once per interval to spare the database
+	if authTokenEnt.LastUsedAt == nil || time.Since(*authTokenEnt.LastUsedAt) > lastUsedUpdateInterval {
+		_ = entClient.AuthToken.UpdateOneID(tokenID).SetLastUsedAt(time.Now()).Exec(ctx)
+	}
+	claims := &security.Claims{
 		TokenID: tokenID,
 		Scopes:  authTokenEnt.Scopes,
 		Subject: subject,
-	}, nil
+	}
+	if authTokenEnt.Actor != nil {
+		claims.Actor = *authTokenEnt.Actor
+	}
+	return claims, nil
 }
 
 // checkScopes checks if the token has the required scopes
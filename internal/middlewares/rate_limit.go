@@ -0,0 +1,73 @@
+package middlewares
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+
+	"base-website/internal/security"
+	ratelimitservice "base-website/internal/services/ratelimit"
+	"base-website/pkg/logger"
+	"base-website/pkg/ratelimit"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+// NewRateLimitMiddleware counts the requests against the token bucket of their user, app or IP,
+// a request over the limit is rejected with the time to wait before the next one.
+// It must run after the auth and roles middlewares, which tell who the request comes from.
+// Valkey failures let the requests through, the limits are not worth an outage.
+func NewRateLimitMiddleware(
+	api huma.API,
+	rateLimitService ratelimitservice.RateLimitService,
+) HumaMiddleware {
+	logger := logger.New().WithContext("RateLimitMiddleware")
+	return func(ctx huma.Context, next func(huma.Context)) {
+		request := &ratelimitservice.Request{
+			Route: ratelimit.Route{
+				OperationID: ctx.Operation().OperationID,
+				Method:      ctx.Operation().Method,
+				Path:        ctx.Operation().Path,
+			},
+		}
+
+		claims, err := security.GetClaimsFromHumaContext(ctx)
+		if err != nil {
+			request.Principal = "ip:" + security.RequestInfoFromContext(ctx.Context()).IP
+			request.Anonymous = true
+		} else {
+			if clientID, err := claims.GetClientID(); err == nil {
+				request.Principal = "app:" + clientID
+			} else {
+				request.Principal = "user:" + claims.Subject
+			}
+			request.Roles = security.RolesFromHumaContext(ctx)
+		}
+
+		result, err := rateLimitService.Take(ctx.Context(), request)
+		if err != nil {
+			logger.Error("failed to take a token for %s on %s: %v", request.Principal, request.Route.OperationID, err)
+			next(ctx)
+			return
+		}
+		if result.Limit > 0 {
+			ctx.SetHeader("X-RateLimit-Limit", strconv.Itoa(result.Limit))
+			ctx.SetHeader("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		}
+		if !result.Allowed {
+			retryAfter := int(math.Ceil(result.RetryAfter.Seconds()))
+			ctx.SetHeader("Retry-After", strconv.Itoa(retryAfter))
+			logger.Warn("[%s] rate limited on %s %s", request.Principal, ctx.Operation().Method, ctx.Operation().Path)
+			_ = huma.WriteErr(
+				api,
+				ctx,
+				http.StatusTooManyRequests,
+				"too many requests",
+				fmt.Errorf("rate limit exceeded, retry after %d seconds", retryAfter),
+			)
+			return
+		}
+		next(ctx)
+	}
+}
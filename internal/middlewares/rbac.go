@@ -4,19 +4,20 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 
-	"base-website/ent"
-	"base-website/ent/user"
 	"base-website/internal/security"
 	rbacservice "base-website/internal/services/rbac"
+	rbacmodels "base-website/internal/services/rbac/models"
 	"base-website/pkg/logger"
+	"base-website/pkg/rbac"
 
 	"github.com/danielgtaylor/huma/v2"
 )
 
+// NewRbacMiddleware must run after the roles middleware, which resolves the roles of the request
 func NewRbacMiddleware(
 	api huma.API,
-	entClient *ent.Client,
 	rbacService rbacservice.RBACService,
 ) HumaMiddleware {
 	logger := logger.New().WithContext("RbacMiddleware")
@@ -27,53 +28,51 @@ func NewRbacMiddleware(
 			return
 		}
 
-		var roles []string
-		var notFoundErr error
-		var missingIDErr error
+		if claims.IsImpersonated() && security.IsImpersonationForbidden(ctx.Operation().Metadata) {
+			_ = huma.WriteErr(
+				api,
+				ctx,
+				http.StatusForbidden,
+				"forbidden",
+				errors.New("this operation cannot be done while impersonating a user"),
+			)
+			return
+		}
 
-		userID, err := claims.GetUserID()
-		if err != nil {
-			missingIDErr = errors.New("missing user ID")
+		roles := security.RolesFromHumaContext(ctx)
+		route := &rbac.Route{
+			Method: ctx.Operation().Method,
+			Path:   ctx.Operation().Path,
+			Scope:  rbac.RequirementFromMetadata(ctx.Operation().Metadata),
 		}
-		user, err := entClient.User.Query().
-			Where(user.ID(userID)).
-			Select(user.FieldRoles).
-			First(ctx.Context())
-		if err != nil {
-			notFoundErr = errors.New("user not found")
+		var userID *int
+		if id, err := claims.GetUserID(); err == nil {
+			userID = &id
 		}
-		roles = user.Roles
-
-		if missingIDErr != nil {
-			_ = huma.WriteErr(api, ctx, http.StatusForbidden, "forbidden", missingIDErr)
-			return
+		var resourceID *int
+		if route.Scope != nil {
+			id, err := strconv.Atoi(ctx.Param(route.Scope.Param))
+			if err != nil {
+				_ = huma.WriteErr(api, ctx, http.StatusForbidden, "forbidden", fmt.Errorf("invalid %s id", route.Scope.Resource))
+				return
+			}
+			resourceID = &id
 		}
 
-		if notFoundErr != nil {
-			_ = huma.WriteErr(api, ctx, http.StatusForbidden, "forbidden", notFoundErr)
+		authorization, err := rbacService.Authorize(ctx.Context(), userID, roles, route, resourceID)
+		if err != nil {
+			_ = huma.WriteErr(api, ctx, http.StatusForbidden, "forbidden", err)
 			return
 		}
-
-		if !rbacService.Can(roles, ctx.Operation().Path, ctx.Operation().Method) {
-			logger.Warn(
-				"[%s] missing permission %s on %s",
-				claims.Subject,
-				ctx.Operation().Method,
-				ctx.Operation().Path,
-			)
-			_ = huma.WriteErr(
-				api,
-				ctx,
-				http.StatusForbidden,
-				"forbidden",
-				fmt.Errorf(
-					"missing permission %s on %s",
-					ctx.Operation().Method,
-					ctx.Operation().Path,
-				),
-			)
+		if !authorization.Allowed {
+			reason := denialReason(route, authorization)
+			logger.Warn("[%s] %s", claims.Subject, reason.Error())
+			_ = huma.WriteErr(api, ctx, http.StatusForbidden, "forbidden", reason)
 			return
 		}
+		if authorization.TournamentRole != nil {
+			ctx = huma.WithValue(ctx, security.TournamentRoleKey, authorization.TournamentRole)
+		}
 
 		ctx = huma.WithValue(ctx, security.PermissionsKey, &security.RequestPermissions{
 			Permissions: rbacService.GetPermissions(roles),
@@ -81,3 +80,15 @@ func NewRbacMiddleware(
 		next(ctx)
 	}
 }
+
+// denialReason tells whether the policy or the tournament role denied the operation
+func denialReason(route *rbac.Route, authorization *rbacmodels.Authorization) error {
+	switch {
+	case authorization.Decision.Rule != nil && !authorization.Decision.Allowed:
+		return fmt.Errorf("permission %s on %s denied by role %s", route.Method, route.Path, authorization.Decision.Rule.Role)
+	case !authorization.Decision.Allowed:
+		return fmt.Errorf("missing permission %s on %s", route.Method, route.Path)
+	default:
+		return fmt.Errorf("missing tournament role %s", route.Scope.MinRole)
+	}
+}
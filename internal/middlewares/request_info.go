@@ -0,0 +1,94 @@
+package middlewares
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+
+	"base-website/internal/security"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+// ParseTrustedProxies reads a comma-separated list of IPs and CIDRs, a bare IP trusts that address only
+func ParseTrustedProxies(value string) ([]netip.Prefix, error) {
+	proxies := make([]netip.Prefix, 0)
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if addr, err := netip.ParseAddr(entry); err == nil {
+			addr = addr.Unmap()
+			proxies = append(proxies, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q", entry)
+		}
+		proxies = append(proxies, prefix.Masked())
+	}
+	return proxies, nil
+}
+
+// RequestInfoMiddleware stores the client of the request in the context, for the audit log and the rate limits.
+// The forwarding headers are only read when the peer is one of the trusted proxies.
+func RequestInfoMiddleware(trustedProxies []netip.Prefix) HumaMiddleware {
+	return func(ctx huma.Context, next func(huma.Context)) {
+		ctx = huma.WithValue(ctx, security.RequestInfoKey, &security.RequestInfo{
+			IP:        clientIP(ctx, trustedProxies),
+			UserAgent: ctx.Header("User-Agent"),
+		})
+		next(ctx)
+	}
+}
+
+// clientIP walks X-Forwarded-For from the peer back to the first address that isn't a trusted proxy,
+// the leftmost entries are written by the client and can't be trusted
+func clientIP(ctx huma.Context, trustedProxies []netip.Prefix) string {
+	peer, _, err := net.SplitHostPort(ctx.RemoteAddr())
+	if err != nil {
+		peer = ctx.RemoteAddr()
+	}
+	if !isTrustedProxy(peer, trustedProxies) {
+		return peer
+	}
+
+	forwarded := strings.Split(ctx.Header("X-Forwarded-For"), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(forwarded[i])
+		if hop == "" {
+			continue
+		}
+		if _, err := netip.ParseAddr(hop); err != nil {
+			// A malformed hop ends the chain, the last trusted address is kept
+			return peer
+		}
+		if !isTrustedProxy(hop, trustedProxies) {
+			return hop
+		}
+		peer = hop
+	}
+	if realIP := strings.TrimSpace(ctx.Header("X-Real-IP")); realIP != "" {
+		if _, err := netip.ParseAddr(realIP); err == nil {
+			return realIP
+		}
+	}
+	return peer
+}
+
+func isTrustedProxy(ip string, trustedProxies []netip.Prefix) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,121 @@
+package middlewares
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/humatest"
+)
+
+func TestParseTrustedProxies(t *testing.T) {
+	proxies, err := ParseTrustedProxies(" 10.0.0.0/8, 192.168.1.1 ,,::ffff:172.16.0.1, fd00::/8")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{"10.0.0.0/8", "192.168.1.1/32", "172.16.0.1/32", "fd00::/8"}
+	if len(proxies) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, proxies)
+	}
+	for i, proxy := range proxies {
+		if proxy.String() != expected[i] {
+			t.Errorf("expected %s, got %s", expected[i], proxy)
+		}
+	}
+
+	if _, err := ParseTrustedProxies("10.0.0.0/8,proxy.local"); err == nil {
+		t.Error("expected an error for a host name")
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	trustedProxies, err := ParseTrustedProxies("10.0.0.0/8,192.168.1.1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tests := []struct {
+		name      string
+		peer      string
+		forwarded string
+		realIP    string
+		expected  string
+	}{
+		{
+			name:     "direct client",
+			peer:     "203.0.113.7:51234",
+			expected: "203.0.113.7",
+		},
+		{
+			name:      "headers of an untrusted peer are ignored",
+			peer:      "203.0.113.7:51234",
+			forwarded: "198.51.100.1",
+			realIP:    "198.51.100.2",
+			expected:  "203.0.113.7",
+		},
+		{
+			name:      "client behind one proxy",
+			peer:      "10.0.0.2:443",
+			forwarded: "198.51.100.1",
+			expected:  "198.51.100.1",
+		},
+		{
+			name:      "client behind a chain of proxies",
+			peer:      "10.0.0.2:443",
+			forwarded: "198.51.100.1, 192.168.1.1, 10.0.0.3",
+			expected:  "198.51.100.1",
+		},
+		{
+			name:      "spoofed leftmost entries are skipped",
+			peer:      "10.0.0.2:443",
+			forwarded: "127.0.0.1, 198.51.100.1",
+			expected:  "198.51.100.1",
+		},
+		{
+			name:      "malformed hop keeps the last trusted address",
+			peer:      "10.0.0.2:443",
+			forwarded: "198.51.100.1, not-an-ip, 10.0.0.3",
+			expected:  "10.0.0.3",
+		},
+		{
+			name:      "only trusted hops fall back to X-Real-IP",
+			peer:      "10.0.0.2:443",
+			forwarded: "10.0.0.4, 10.0.0.3",
+			realIP:    "198.51.100.2",
+			expected:  "198.51.100.2",
+		},
+		{
+			name:     "X-Real-IP of a trusted peer",
+			peer:     "10.0.0.2:443",
+			realIP:   "198.51.100.2",
+			expected: "198.51.100.2",
+		},
+		{
+			name:     "malformed X-Real-IP is ignored",
+			peer:     "10.0.0.2:443",
+			realIP:   "unknown",
+			expected: "10.0.0.2",
+		},
+		{
+			name:      "mapped IPv4 peer",
+			peer:      "[::ffff:10.0.0.2]:443",
+			forwarded: "198.51.100.1",
+			expected:  "198.51.100.1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest("GET", "/", nil)
+			request.RemoteAddr = tt.peer
+			if tt.forwarded != "" {
+				request.Header.Set("X-Forwarded-For", tt.forwarded)
+			}
+			if tt.realIP != "" {
+				request.Header.Set("X-Real-IP", tt.realIP)
+			}
+			ctx := humatest.NewContext(&huma.Operation{}, request, httptest.NewRecorder())
+			if ip := clientIP(ctx, trustedProxies); ip != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, ip)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 func RequestLoggerMiddleware(logger *logger.Logger) HumaMiddleware {
 	return func(ctx huma.Context, next func(huma.Context)) {
 		claims, _ := security.GetClaimsFromHumaContext(ctx)
+		ip := security.RequestInfoFromContext(ctx.Context()).IP
 		url := ctx.URL()
 		path := url.Path
 		if url.RawQuery != "" {
@@ -18,13 +19,13 @@ func RequestLoggerMiddleware(logger *logger.Logger) HumaMiddleware {
 		if claims != nil {
 			logger.Http(
 				"ip=%s subject=%s method=%s path=%s",
-				ctx.RemoteAddr(),
+				ip,
 				claims.Subject,
 				ctx.Method(),
 				path,
 			)
 		} else {
-			logger.Http("ip=%s method=%s path=%s", ctx.RemoteAddr(), ctx.Method(), path)
+			logger.Http("ip=%s method=%s path=%s", ip, ctx.Method(), path)
 		}
 		next(ctx)
 	}
@@ -0,0 +1,70 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"base-website/ent"
+	"base-website/ent/app"
+	"base-website/internal/security"
+	rbacservice "base-website/internal/services/rbac"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+// NewRolesMiddleware resolves the RBAC roles of the token subject once per request,
+// the rate limit and RBAC middlewares read them from the context.
+// It must run after the auth middleware, a request whose roles can't be resolved is rejected.
+func NewRolesMiddleware(
+	api huma.API,
+	entClient *ent.Client,
+	rbacService rbacservice.RBACService,
+) HumaMiddleware {
+	return func(ctx huma.Context, next func(huma.Context)) {
+		claims, err := security.GetClaimsFromHumaContext(ctx)
+		if err != nil {
+			next(ctx)
+			return
+		}
+		roles, err := principalRoles(ctx.Context(), entClient, rbacService, claims)
+		if err != nil {
+			_ = huma.WriteErr(api, ctx, http.StatusForbidden, "forbidden", err)
+			return
+		}
+		next(huma.WithValue(ctx, security.RolesKey, roles))
+	}
+}
+
+// principalRoles returns the RBAC roles of the token subject, a user or an app acting on its own behalf
+func principalRoles(
+	ctx context.Context,
+	entClient *ent.Client,
+	rbacService rbacservice.RBACService,
+	claims *security.Claims,
+) ([]string, error) {
+	if claims.GetSubjectType() == security.SubjectTypeClient {
+		clientID, err := claims.GetClientID()
+		if err != nil {
+			return nil, errors.New("missing client ID")
+		}
+		appEnt, err := entClient.App.Query().
+			Where(app.ID(clientID)).
+			Select(app.FieldRoles).
+			Only(ctx)
+		if err != nil {
+			return nil, errors.New("app not found")
+		}
+		return appEnt.Roles, nil
+	}
+
+	userID, err := claims.GetUserID()
+	if err != nil {
+		return nil, errors.New("missing user ID")
+	}
+	roles, err := rbacService.GetUserRoles(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+	return roles, nil
+}
@@ -0,0 +1,51 @@
+package middlewares
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"base-website/internal/security"
+	sanctionsservice "base-website/internal/services/sanctions"
+	"base-website/pkg/logger"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+// NewSanctionsMiddleware rejects the requests of globally banned users,
+// except on the operations that let them see why they are banned
+func NewSanctionsMiddleware(
+	api huma.API,
+	sanctionsService sanctionsservice.SanctionsService,
+) HumaMiddleware {
+	logger := logger.New().WithContext("SanctionsMiddleware")
+	return func(ctx huma.Context, next func(huma.Context)) {
+		claims, err := security.GetClaimsFromHumaContext(ctx)
+		if err != nil || security.IsBannedAllowed(ctx.Operation().Metadata) {
+			next(ctx)
+			return
+		}
+		userID, err := claims.GetUserID()
+		if err != nil {
+			next(ctx)
+			return
+		}
+
+		ban, err := sanctionsService.GetActiveBan(ctx.Context(), userID)
+		if err != nil {
+			logger.Error("failed to check the sanctions of user %d: %v", userID, err)
+			_ = huma.WriteErr(api, ctx, http.StatusInternalServerError, "failed to check sanctions")
+			return
+		}
+		if ban == nil {
+			next(ctx)
+			return
+		}
+
+		reason := fmt.Errorf("you are banned: %s", ban.Reason)
+		if ban.ExpiresAt != nil {
+			reason = fmt.Errorf("you are banned until %s: %s", ban.ExpiresAt.Format(time.RFC3339), ban.Reason)
+		}
+		_ = huma.WriteErr(api, ctx, http.StatusForbidden, "forbidden", reason)
+	}
+}
@@ -0,0 +1,38 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+// Operation is an operation registered on the API with the method and path it is served at
+type Operation struct {
+	Method string
+	Path   string
+	*huma.Operation
+}
+
+// Operations lists the operations registered on the API, the policies are validated against them
+func Operations(oapi *huma.OpenAPI) []Operation {
+	operations := make([]Operation, 0)
+	for path, item := range oapi.Paths {
+		methods := map[string]*huma.Operation{
+			http.MethodGet:    item.Get,
+			http.MethodPost:   item.Post,
+			http.MethodPut:    item.Put,
+			http.MethodPatch:  item.Patch,
+			http.MethodDelete: item.Delete,
+		}
+		for method, operation := range methods {
+			if operation != nil {
+				operations = append(operations, Operation{
+					Method:    method,
+					Path:      path,
+					Operation: operation,
+				})
+			}
+		}
+	}
+	return operations
+}
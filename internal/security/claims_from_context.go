@@ -14,6 +14,8 @@ type Claims struct {
 	TokenID string
 	Scopes  []string
 	Subject string
+	// Actor is the subject of the super admin impersonating the subject, empty otherwise
+	Actor string
 }
 
 type SubjectType string
@@ -23,8 +25,16 @@ const (
 	SubjectTypeClient SubjectType = "client"
 )
 
+// ClientSubjectPrefix prefixes the subject of the tokens issued to an application on its own behalf
+const ClientSubjectPrefix = "clients/"
+
+// ClientSubject returns the token subject of an application
+func ClientSubject(clientID string) string {
+	return ClientSubjectPrefix + clientID
+}
+
 func (c *Claims) GetSubjectType() SubjectType {
-	if strings.HasPrefix(c.Subject, "clients/") {
+	if strings.HasPrefix(c.Subject, ClientSubjectPrefix) {
 		return SubjectTypeClient
 	}
 	return SubjectTypeUser
@@ -39,11 +49,23 @@ func (c *Claims) GetUserID() (int, error) {
 
 func (c *Claims) GetClientID() (string, error) {
 	if c.GetSubjectType() == SubjectTypeClient {
-		return strings.TrimPrefix(c.Subject, "client;"), nil
+		return strings.TrimPrefix(c.Subject, ClientSubjectPrefix), nil
 	}
 	return "", huma.Error403Forbidden("this endpoint is only available for applications")
 }
 
+// IsImpersonated reports whether the token was minted for a super admin acting as the subject
+func (c *Claims) IsImpersonated() bool {
+	return c.Actor != ""
+}
+
+func (c *Claims) GetActorID() (int, error) {
+	if c.IsImpersonated() {
+		return strconv.Atoi(c.Actor)
+	}
+	return 0, huma.Error403Forbidden("this token is not an impersonation token")
+}
+
 func GetClaimsFromHumaContext(ctx huma.Context) (*Claims, error) {
 	return GetClaimsFromContext(ctx.Context())
 }
@@ -0,0 +1,13 @@
+package security
+
+const ImpersonationForbiddenKey = "impersonation_forbidden"
+
+// ForbidImpersonation marks an operation a super admin cannot do while acting as another user
+func ForbidImpersonation(metadata ...map[string]any) map[string]any {
+	return withFlag(ImpersonationForbiddenKey, metadata...)
+}
+
+// IsImpersonationForbidden reports whether the operation rejects impersonation tokens
+func IsImpersonationForbidden(metadata map[string]any) bool {
+	return hasFlag(metadata, ImpersonationForbiddenKey)
+}
@@ -0,0 +1,21 @@
+package security
+
+import "maps"
+
+// withFlag returns operation metadata setting the flag key, merged with the metadata
+// of the other requirements of the operation
+func withFlag(key string, metadata ...map[string]any) map[string]any {
+	merged := map[string]any{
+		key: true,
+	}
+	for _, m := range metadata {
+		maps.Copy(merged, m)
+	}
+	return merged
+}
+
+// hasFlag reports whether the operation metadata sets the flag key
+func hasFlag(metadata map[string]any, key string) bool {
+	set, _ := metadata[key].(bool)
+	return set
+}
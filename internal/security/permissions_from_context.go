@@ -15,12 +15,7 @@ type RequestPermissions struct {
 }
 
 func (p *RequestPermissions) Can(path string, method string) bool {
-	for _, permission := range p.Permissions {
-		if permission.Can(path, method) {
-			return true
-		}
-	}
-	return false
+	return rbac.CheckPermission(p.Permissions, path, method)
 }
 
 func PermissionsFromHumaContext(ctx huma.Context) *RequestPermissions {
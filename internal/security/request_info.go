@@ -0,0 +1,20 @@
+package security
+
+import "context"
+
+const RequestInfoKey = "request_info"
+
+// RequestInfo describes the client that sent the request
+type RequestInfo struct {
+	IP        string
+	UserAgent string
+}
+
+// RequestInfoFromContext returns the client of the request, empty outside of one
+func RequestInfoFromContext(ctx context.Context) RequestInfo {
+	info, ok := ctx.Value(RequestInfoKey).(*RequestInfo)
+	if !ok {
+		return RequestInfo{}
+	}
+	return *info
+}
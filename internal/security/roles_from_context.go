@@ -0,0 +1,19 @@
+package security
+
+import (
+	"context"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+const RolesKey = "roles"
+
+func RolesFromHumaContext(ctx huma.Context) []string {
+	return RolesFromContext(ctx.Context())
+}
+
+// RolesFromContext returns the RBAC roles of the token subject, none for an anonymous request
+func RolesFromContext(ctx context.Context) []string {
+	roles, _ := ctx.Value(RolesKey).([]string)
+	return roles
+}
@@ -0,0 +1,13 @@
+package security
+
+const BannedAllowedKey = "banned_allowed"
+
+// AllowBanned marks an operation a globally banned user can still use, such as reading their sanctions or logging out
+func AllowBanned(metadata ...map[string]any) map[string]any {
+	return withFlag(BannedAllowedKey, metadata...)
+}
+
+// IsBannedAllowed reports whether the sanctions middleware lets globally banned users through the operation
+func IsBannedAllowed(metadata map[string]any) bool {
+	return hasFlag(metadata, BannedAllowedKey)
+}
@@ -0,0 +1,44 @@
+package security
+
+import (
+	"context"
+
+	"base-website/ent/tournamentadmin"
+	"base-website/pkg/rbac"
+)
+
+const TournamentRoleKey = "tournament_role"
+
+// Resources a tournament role can be required on, the role is the one held on their tournament
+const (
+	ScopeTournament = "tournament"
+	ScopeTeam       = "team"
+)
+
+// TournamentRoles orders the roles of a tournament admin
+var TournamentRoles = rbac.ScopeRoles{
+	string(tournamentadmin.RoleADMIN),
+	string(tournamentadmin.RoleSUPER_ADMIN),
+	string(tournamentadmin.RoleCREATOR),
+}
+
+// WithTournamentRole returns the operation metadata requiring at least minRole on the tournament
+// of the resource identified by the param path parameter
+func WithTournamentRole(resource string, param string, minRole tournamentadmin.Role) map[string]any {
+	return map[string]any{
+		rbac.ScopeMetadataKey: &rbac.ScopeRequirement{
+			Resource: resource,
+			Param:    param,
+			MinRole:  string(minRole),
+		},
+	}
+}
+
+// TournamentRoleFromContext returns the tournament role resolved for an operation declared with WithTournamentRole
+func TournamentRoleFromContext(ctx context.Context) *tournamentadmin.Role {
+	role, ok := ctx.Value(TournamentRoleKey).(*tournamentadmin.Role)
+	if !ok {
+		return nil
+	}
+	return role
+}
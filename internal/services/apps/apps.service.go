@@ -12,6 +12,8 @@ import (
 	"base-website/ent/user"
 	"base-website/internal/security"
 	appsmodels "base-website/internal/services/apps/models"
+	auditservice "base-website/internal/services/audit"
+	auditmodels "base-website/internal/services/audit/models"
 	databaseservice "base-website/internal/services/database"
 	"base-website/pkg/errorfilters"
 	"base-website/pkg/paging"
@@ -55,6 +57,7 @@ type AppsService interface {
 }
 
 type appsService struct {
+	auditService    auditservice.AuditService
 	databaseService databaseservice.DatabaseService
 	errorFilter     errorfilters.ErrorFilter
 }
@@ -62,15 +65,18 @@ type appsService struct {
 func NewProvider() func(i *do.Injector) (AppsService, error) {
 	return func(i *do.Injector) (AppsService, error) {
 		return New(
+			do.MustInvoke[auditservice.AuditService](i),
 			do.MustInvoke[databaseservice.DatabaseService](i),
 		)
 	}
 }
 
 func New(
+	auditService auditservice.AuditService,
 	databaseService databaseservice.DatabaseService,
 ) (AppsService, error) {
 	return &appsService{
+		auditService:    auditService,
 		databaseService: databaseService,
 		errorFilter:     errorfilters.NewEntErrorFilter().WithEntityTypeName("App"),
 	}, nil
@@ -118,6 +124,8 @@ func (svc *appsService) Create(
 		SetOwnerID(*ownerID).
 		SetRoles(appPayload.Roles).
 		SetRedirectUris(appPayload.RedirectUris).
+		SetNillableAccessTokenLifetime(appPayload.AccessTokenLifetime).
+		SetNillableRefreshTokenLifetime(appPayload.RefreshTokenLifetime).
 		Save(ctx)
 	if err != nil {
 		return nil, svc.errorFilter.Filter(err, "create")
@@ -175,14 +183,25 @@ func (svc *appsService) Update(
 		return nil, huma.Error403Forbidden("owner_id is required if you are not a user")
 	}
 
-	app, err = svc.databaseService.App.UpdateOneID(appID).
+	update := svc.databaseService.App.UpdateOneID(appID).
 		SetName(appPayload.Name).
 		SetDescription(appPayload.Description).
 		SetImplicitConsent(appPayload.ImplicitConsent).
 		SetOwnerID(*ownerID).
 		SetRoles(appPayload.Roles).
-		SetRedirectUris(appPayload.RedirectUris).
-		Save(ctx)
+		SetRedirectUris(appPayload.RedirectUris)
+	// Omitted lifetimes fall back to the defaults of the config
+	if appPayload.AccessTokenLifetime != nil {
+		update.SetAccessTokenLifetime(*appPayload.AccessTokenLifetime)
+	} else {
+		update.ClearAccessTokenLifetime()
+	}
+	if appPayload.RefreshTokenLifetime != nil {
+		update.SetRefreshTokenLifetime(*appPayload.RefreshTokenLifetime)
+	} else {
+		update.ClearRefreshTokenLifetime()
+	}
+	app, err = update.Save(ctx)
 	if err != nil {
 		return nil, svc.errorFilter.Filter(err, "update")
 	}
@@ -264,11 +283,19 @@ func (svc *appsService) RotateSecret(
 		)
 	}
 	secretID := fmt.Sprintf("%s%s", secretIDPrefix, randStr(lengthOfRandStr))
-	app, err = svc.databaseService.App.UpdateOneID(appID).
-		SetSecret(secretID).
-		Save(ctx)
+	err = databaseservice.WithTx(ctx, svc.databaseService, func(tx *ent.Tx) error {
+		if err := tx.App.UpdateOneID(appID).SetSecret(secretID).Exec(ctx); err != nil {
+			return svc.errorFilter.Filter(err, "rotate secret")
+		}
+		// The secrets themselves are never recorded
+		return svc.auditService.Record(ctx, tx, &auditmodels.Entry{
+			Action:     auditmodels.ActionAppSecretRotate,
+			TargetType: auditmodels.TargetApp,
+			TargetID:   appID,
+		})
+	})
 	if err != nil {
-		return nil, svc.errorFilter.Filter(err, "rotate secret")
+		return nil, err
 	}
 	return svc.Get(ctx, app.ID)
 }
@@ -8,13 +8,15 @@ import (
 )
 
 type AppPayload struct {
-	Name            string   `json:"name" description:"Name of the app" example:"My App"`
-	RedirectUris    []string `json:"redirect_uris" description:"The URIs to redirect to after login" example:"[\"https://myapp.com/login\"]" nullable:"false"`
-	Description     string   `json:"description" example:"My app is the best app"`
-	ImplicitConsent bool     `json:"implicit_consent" description:"Whether the app can access user data without asking for consent" example:"false" default:"false"`
-	OwnerID         *int     `json:"owner_id,omitempty" description:"ID of the user that owns the app"`
-	Roles           []string `json:"roles" description:"The roles of the app" example:"[\"student-app\"]" nullable:"false"`
-	_               struct{} `json:"-" additionalProperties:"true"`
+	Name                 string   `json:"name" description:"Name of the app" example:"My App"`
+	RedirectUris         []string `json:"redirect_uris" description:"The URIs to redirect to after login" example:"[\"https://myapp.com/login\"]" nullable:"false"`
+	Description          string   `json:"description" example:"My app is the best app"`
+	ImplicitConsent      bool     `json:"implicit_consent" description:"Whether the app can access user data without asking for consent" example:"false" default:"false"`
+	OwnerID              *int     `json:"owner_id,omitempty" description:"ID of the user that owns the app"`
+	Roles                []string `json:"roles" description:"The roles of the app" example:"[\"student-app\"]" nullable:"false"`
+	AccessTokenLifetime  *int     `json:"access_token_lifetime,omitempty" description:"Lifetime of the access tokens in seconds, the server default is used when omitted" minimum:"60" maximum:"86400" example:"300"`
+	RefreshTokenLifetime *int     `json:"refresh_token_lifetime,omitempty" description:"Lifetime of the refresh tokens in seconds, the server default is used when omitted" minimum:"300" maximum:"2592000" example:"18000"`
+	_                    struct{} `json:"-" additionalProperties:"true"`
 }
 
 type App struct {
@@ -31,12 +33,14 @@ func NewAppFromEnt(entApp *ent.App, censorSecret bool) *App {
 	app := &App{
 		ID: entApp.ID,
 		AppPayload: AppPayload{
-			Name:            entApp.Name,
-			RedirectUris:    entApp.RedirectUris,
-			Description:     entApp.Description,
-			ImplicitConsent: entApp.ImplicitConsent,
-			OwnerID:         &entApp.OwnerID,
-			Roles:           entApp.Roles,
+			Name:                 entApp.Name,
+			RedirectUris:         entApp.RedirectUris,
+			Description:          entApp.Description,
+			ImplicitConsent:      entApp.ImplicitConsent,
+			OwnerID:              &entApp.OwnerID,
+			Roles:                entApp.Roles,
+			AccessTokenLifetime:  entApp.AccessTokenLifetime,
+			RefreshTokenLifetime: entApp.RefreshTokenLifetime,
 		},
 		Secret:      entApp.Secret,
 		CreatedAt:   entApp.CreatedAt,
@@ -0,0 +1,158 @@
+package auditservice
+
+import (
+	"context"
+	"reflect"
+
+	"base-website/ent"
+	"base-website/ent/auditlog"
+	"base-website/ent/user"
+	"base-website/internal/security"
+	auditmodels "base-website/internal/services/audit/models"
+	databaseservice "base-website/internal/services/database"
+	"base-website/pkg/errorfilters"
+	"base-website/pkg/logger"
+	"base-website/pkg/paging"
+
+	"github.com/samber/do"
+)
+
+type AuditService interface {
+	// Record writes an entry in the transaction of the audited action, so that neither exists without the other
+	Record(ctx context.Context, tx *ent.Tx, entry *auditmodels.Entry) error
+	// Search returns the audit logs matching the filters, most recent first by default
+	Search(ctx context.Context, params *auditmodels.SearchAuditLogsParams) (*paging.Response[*auditmodels.AuditLog], error)
+}
+
+type auditService struct {
+	databaseService databaseservice.DatabaseService
+	errorFilter     errorfilters.ErrorFilter
+	logger          *logger.Logger
+}
+
+func NewProvider() func(i *do.Injector) (AuditService, error) {
+	return func(i *do.Injector) (AuditService, error) {
+		return New(
+			do.MustInvoke[databaseservice.DatabaseService](i),
+		)
+	}
+}
+
+func New(
+	databaseService databaseservice.DatabaseService,
+) (AuditService, error) {
+	return &auditService{
+		databaseService: databaseService,
+		errorFilter:     errorfilters.NewEntErrorFilter().WithEntityTypeName("audit_log"),
+		logger:          logger.New().WithContext("Audit"),
+	}, nil
+}
+
+func (svc *auditService) Record(
+	ctx context.Context,
+	tx *ent.Tx,
+	entry *auditmodels.Entry,
+) error {
+	info := security.RequestInfoFromContext(ctx)
+	before, after := changedFields(entry.Before, entry.After)
+
+	create := tx.AuditLog.Create().
+		SetAction(entry.Action).
+		SetTargetType(entry.TargetType).
+		SetTargetID(entry.TargetID).
+		SetIP(info.IP).
+		SetUserAgent(info.UserAgent)
+	if before != nil {
+		create.SetBefore(before)
+	}
+	if after != nil {
+		create.SetAfter(after)
+	}
+	if claims, err := security.GetClaimsFromContext(ctx); err == nil {
+		if actorID, err := claims.GetUserID(); err == nil {
+			create.SetActorID(actorID)
+		}
+		if impersonatorID, err := claims.GetActorID(); err == nil {
+			create.SetImpersonatorID(impersonatorID)
+		}
+	}
+	if err := create.Exec(ctx); err != nil {
+		svc.logger.Error("failed to record %s on %s %s: %s", entry.Action, entry.TargetType, entry.TargetID, err.Error())
+		return svc.errorFilter.Filter(err, "create")
+	}
+	return nil
+}
+
+func (svc *auditService) Search(
+	ctx context.Context,
+	params *auditmodels.SearchAuditLogsParams,
+) (*paging.Response[*auditmodels.AuditLog], error) {
+	query := svc.databaseService.AuditLog.Query()
+
+	if params.Action != "" {
+		query.Where(auditlog.Action(params.Action))
+	}
+	if params.ActorID != 0 {
+		query.Where(auditlog.HasActorWith(user.ID(params.ActorID)))
+	}
+	if params.ImpersonatorID != 0 {
+		query.Where(auditlog.HasImpersonatorWith(user.ID(params.ImpersonatorID)))
+	}
+	if params.TargetType != "" {
+		query.Where(auditlog.TargetType(params.TargetType))
+	}
+	if params.TargetID != "" {
+		query.Where(auditlog.TargetID(params.TargetID))
+	}
+	if !params.From.IsZero() {
+		query.Where(auditlog.CreatedAtGTE(params.From))
+	}
+	if !params.To.IsZero() {
+		query.Where(auditlog.CreatedAtLT(params.To))
+	}
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, svc.errorFilter.Filter(err, "count")
+	}
+
+	query = paging.ApplyQueryPaging(query, params.Input)
+
+	if params.Order == "asc" {
+		query = query.Order(ent.Asc(auditlog.FieldID))
+	} else {
+		query = query.Order(ent.Desc(auditlog.FieldID))
+	}
+
+	auditLogs, err := query.WithActor().WithImpersonator().All(ctx)
+	if err != nil {
+		return nil, svc.errorFilter.Filter(err, "search")
+	}
+
+	return paging.CreatePagingResponse(auditmodels.NewAuditLogsFromEnt(auditLogs), total, params.Page, params.Limit), nil
+}
+
+//// HELPERS ////
+
+// changedFields drops the fields that are the same before and after the action
+func changedFields(before map[string]any, after map[string]any) (map[string]any, map[string]any) {
+	changedBefore := make(map[string]any)
+	changedAfter := make(map[string]any)
+	for key, value := range before {
+		if afterValue, ok := after[key]; !ok || !reflect.DeepEqual(value, afterValue) {
+			changedBefore[key] = value
+		}
+	}
+	for key, value := range after {
+		if beforeValue, ok := before[key]; !ok || !reflect.DeepEqual(value, beforeValue) {
+			changedAfter[key] = value
+		}
+	}
+	if len(changedBefore) == 0 {
+		changedBefore = nil
+	}
+	if len(changedAfter) == 0 {
+		changedAfter = nil
+	}
+	return changedBefore, changedAfter
+}
@@ -0,0 +1,109 @@
+package auditmodels
+
+import (
+	"time"
+
+	"base-website/ent"
+	"base-website/internal/lightmodels"
+	"base-website/pkg/paging"
+)
+
+// Audited actions
+const (
+	ActionUserRolesChange         = "user.roles.change"
+	ActionUserRoleGrant           = "user.role.grant"
+	ActionUserRoleRevoke          = "user.role.revoke"
+	ActionUserRoleExpire          = "user.role.expire"
+	ActionUserAnonymize           = "user.anonymize"
+	ActionUserImpersonate         = "user.impersonate"
+	ActionTournamentDelete        = "tournament.delete"
+	ActionTournamentAdminAdd      = "tournament.admin.add"
+	ActionTournamentAdminEdit     = "tournament.admin.edit"
+	ActionTournamentAdminRemove   = "tournament.admin.remove"
+	ActionTournamentRankGroupsSet = "tournament.rank_groups.set"
+	ActionTeamRankGroupChange     = "team.rank_group.change"
+	ActionTeamUnlock              = "team.unlock"
+	ActionAppSecretRotate         = "app.secret.rotate"
+	ActionSanctionIssue           = "sanction.issue"
+	ActionSanctionLift            = "sanction.lift"
+)
+
+// Types of the audited entities
+const (
+	TargetUser       = "user"
+	TargetTournament = "tournament"
+	TargetTeam       = "team"
+	TargetApp        = "app"
+)
+
+// Entry is an audited action, the actor and the client are taken from the request context
+type Entry struct {
+	Action     string
+	TargetType string
+	TargetID   string
+	// Before and After are the state of the target, only the fields that differ are recorded
+	Before map[string]any
+	After  map[string]any
+}
+
+type AuditLog struct {
+	ID           int                    `json:"id" example:"1" description:"The ID of the audit log"`
+	CreatedAt    time.Time              `json:"created_at" example:"2024-01-01T00:00:00Z" description:"When the action was done"`
+	Action       string                 `json:"action" example:"user.roles.change" description:"The action that was done"`
+	TargetType   string                 `json:"target_type" example:"user" description:"The type of the entity the action was done on"`
+	TargetID     string                 `json:"target_id" example:"42" description:"The ID of the entity the action was done on"`
+	Before       map[string]any         `json:"before,omitempty" description:"The changed fields before the action"`
+	After        map[string]any         `json:"after,omitempty" description:"The changed fields after the action"`
+	IP           string                 `json:"ip" example:"10.0.0.1" description:"The IP address the action was requested from"`
+	UserAgent    string                 `json:"user_agent" example:"Mozilla/5.0" description:"The user agent the action was requested with"`
+	Actor        *lightmodels.LightUser `json:"actor,omitempty" description:"The user who did the action"`
+	Impersonator *lightmodels.LightUser `json:"impersonator,omitempty" description:"The super admin who did the action as the actor"`
+}
+
+func NewAuditLogFromEnt(entAuditLog *ent.AuditLog) *AuditLog {
+	if entAuditLog == nil {
+		return nil
+	}
+	return &AuditLog{
+		ID:           entAuditLog.ID,
+		CreatedAt:    entAuditLog.CreatedAt,
+		Action:       entAuditLog.Action,
+		TargetType:   entAuditLog.TargetType,
+		TargetID:     entAuditLog.TargetID,
+		Before:       entAuditLog.Before,
+		After:        entAuditLog.After,
+		IP:           entAuditLog.IP,
+		UserAgent:    entAuditLog.UserAgent,
+		Actor:        lightmodels.NewLightUserFromEnt(entAuditLog.Edges.Actor),
+		Impersonator: lightmodels.NewLightUserFromEnt(entAuditLog.Edges.Impersonator),
+	}
+}
+
+func NewAuditLogsFromEnt(entAuditLogs []*ent.AuditLog) []*AuditLog {
+	auditLogs := make([]*AuditLog, len(entAuditLogs))
+	for i, entAuditLog := range entAuditLogs {
+		auditLogs[i] = NewAuditLogFromEnt(entAuditLog)
+	}
+	return auditLogs
+}
+
+type SearchAuditLogsParams struct {
+	//// PAGINATION AND ORDER ////
+	paging.Input
+
+	//// FILTERS ////
+	// The action that was done
+	Action string `query:"action" example:"user.roles.change" description:"The action that was done"`
+	// The user who did the action
+	ActorID int `query:"actor_id" example:"42" description:"The ID of the user who did the action"`
+	// The super admin who impersonated the actor
+	ImpersonatorID int `query:"impersonator_id" example:"1" description:"The ID of the super admin who did the action as another user"`
+	// The type of the target
+	TargetType string `query:"target_type" example:"user" description:"The type of the entity the action was done on" enum:"user,tournament,team,app"`
+	// The ID of the target
+	TargetID string `query:"target_id" example:"42" description:"The ID of the entity the action was done on"`
+	// The start of the period
+	From time.Time `query:"from" example:"2024-01-01T00:00:00Z" description:"Only the actions done at or after this date"`
+	// The end of the period
+	To time.Time `query:"to" example:"2024-12-31T00:00:00Z" description:"Only the actions done before this date"`
+}
@@ -0,0 +1,242 @@
+package authservice
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	"base-website/ent"
+	"base-website/ent/app"
+	"base-website/ent/consent"
+	"base-website/internal/security"
+	appsmodels "base-website/internal/services/apps/models"
+	authmodels "base-website/internal/services/auth/models"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+)
+
+func (s *authService) Authorize(
+	ctx context.Context,
+	authRequestID string,
+) (*authmodels.AuthorizeResponse, error) {
+	userID, authReq, err := s.pendingAuthRequest(ctx, authRequestID)
+	if err != nil {
+		return nil, err
+	}
+	appEnt, err := s.databaseService.App.Query().
+		Where(app.ID(authReq.ApplicationID)).
+		WithOwner().
+		Only(ctx)
+	if err != nil {
+		return nil, huma.Error404NotFound("application not found")
+	}
+
+	if appEnt.ImplicitConsent && !slices.Contains(authReq.Prompt, oidc.PromptConsent) {
+		return s.completeAuthRequest(ctx, userID, authReq)
+	}
+
+	userConsent, err := s.currentConsent(ctx, userID, authReq.ApplicationID)
+	if err != nil {
+		return nil, err
+	}
+	var granted []string
+	if userConsent != nil {
+		granted = userConsent.Scopes
+	}
+	if userConsent != nil &&
+		!slices.Contains(authReq.Prompt, oidc.PromptConsent) &&
+		coversScopes(granted, authReq.Scopes) {
+		return s.completeAuthRequest(ctx, userID, authReq)
+	}
+
+	return &authmodels.AuthorizeResponse{
+		ConsentRequired: true,
+		Application:     appsmodels.NewAppFromEnt(appEnt, true),
+		Scopes:          authReq.Scopes,
+		GrantedScopes:   granted,
+	}, nil
+}
+
+func (s *authService) Consent(
+	ctx context.Context,
+	params *authmodels.ConsentParams,
+) (*authmodels.AuthorizeResponse, error) {
+	userID, authReq, err := s.pendingAuthRequest(ctx, params.AuthRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !params.Accept {
+		err = s.openIDService.Storage().DeleteAuthRequest(ctx, authReq.ID)
+		if err != nil {
+			s.logger.Error("failed to delete auth request %s", err.Error())
+		}
+		return &authmodels.AuthorizeResponse{
+			RedirectURL: deniedRedirectURL(authReq),
+		}, nil
+	}
+
+	if err := s.grantConsent(ctx, userID, authReq.ApplicationID, authReq.Scopes); err != nil {
+		return nil, err
+	}
+
+	return s.completeAuthRequest(ctx, userID, authReq)
+}
+
+// pendingAuthRequest loads an auth request that can still be completed by the current user.
+func (s *authService) pendingAuthRequest(
+	ctx context.Context,
+	authRequestID string,
+) (int, *ent.AuthRequest, error) {
+	userID, err := security.GetUserIDFromContext(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	authReq, err := s.databaseService.AuthRequest.Get(ctx, authRequestID)
+	if err != nil || authReq.Expiration.Before(time.Now()) {
+		return 0, nil, huma.Error404NotFound("auth request not found or expired")
+	}
+	if authReq.Done {
+		return 0, nil, huma.Error409Conflict("auth request already completed")
+	}
+	// The app asked for a specific user through an id_token_hint
+	if authReq.UserID != "" && authReq.UserID != strconv.Itoa(userID) {
+		return 0, nil, huma.Error403Forbidden("auth request was issued for another user")
+	}
+	return userID, authReq, nil
+}
+
+// currentConsent returns the non expired consent of the user for the app, if any.
+func (s *authService) currentConsent(
+	ctx context.Context,
+	userID int,
+	applicationID string,
+) (*ent.Consent, error) {
+	userConsent, err := s.databaseService.Consent.Query().
+		Where(
+			consent.UserID(userID),
+			consent.ApplicationID(applicationID),
+			consent.ExpirationDateGT(time.Now()),
+		).
+		Order(ent.Desc(consent.FieldExpirationDate)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		s.logger.Error("failed to get consent %s", err.Error())
+		return nil, huma.Error500InternalServerError("failed to get consent")
+	}
+	return userConsent, nil
+}
+
+// grantConsent records that the user consented to the scopes for the app,
+// the scopes are added to the ones already granted and the consent expiration is extended.
+func (s *authService) grantConsent(
+	ctx context.Context,
+	userID int,
+	applicationID string,
+	scopes []string,
+) error {
+	config := s.configService.GetConfig()
+	expiration := time.Now().AddDate(0, 0, config.ConsentDayExpiration)
+
+	userConsent, err := s.currentConsent(ctx, userID, applicationID)
+	if err != nil {
+		return err
+	}
+	if userConsent == nil {
+		// Drop expired consents so that only one consent per user and app remains
+		_, err = s.databaseService.Consent.Delete().
+			Where(
+				consent.UserID(userID),
+				consent.ApplicationID(applicationID),
+			).
+			Exec(ctx)
+		if err == nil {
+			_, err = s.databaseService.Consent.Create().
+				SetUserID(userID).
+				SetApplicationID(applicationID).
+				SetScopes(scopes).
+				SetExpirationDate(expiration).
+				Save(ctx)
+		}
+	} else {
+		granted := slices.Clone(userConsent.Scopes)
+		for _, scope := range scopes {
+			if !slices.Contains(granted, scope) {
+				granted = append(granted, scope)
+			}
+		}
+		_, err = userConsent.Update().
+			SetScopes(granted).
+			SetExpirationDate(expiration).
+			Save(ctx)
+	}
+	if err != nil {
+		s.logger.Error("failed to save consent %s", err.Error())
+		return huma.Error500InternalServerError("failed to save consent")
+	}
+	return nil
+}
+
+func (s *authService) completeAuthRequest(
+	ctx context.Context,
+	userID int,
+	authReq *ent.AuthRequest,
+) (*authmodels.AuthorizeResponse, error) {
+	now := time.Now()
+	_, err := authReq.Update().
+		SetUserID(strconv.Itoa(userID)).
+		SetDone(true).
+		SetAuthTime(now).
+		SetAmr([]string{"intra42"}).
+		Save(ctx)
+	if err != nil {
+		s.logger.Error("failed to complete auth request %s", err.Error())
+		return nil, huma.Error500InternalServerError("failed to complete auth request")
+	}
+
+	err = s.databaseService.App.UpdateOneID(authReq.ApplicationID).
+		SetLastLoginAt(now).
+		Exec(ctx)
+	if err != nil {
+		s.logger.Error("failed to update app last login %s", err.Error())
+	}
+
+	config := s.configService.GetConfig()
+	return &authmodels.AuthorizeResponse{
+		RedirectURL: s.openIDService.AuthorizationEndpoint().Absolute(config.OpenIDIssuer) +
+			"/callback?id=" + url.QueryEscape(authReq.ID),
+	}, nil
+}
+
+func coversScopes(granted []string, requested []string) bool {
+	for _, scope := range requested {
+		if !slices.Contains(granted, scope) {
+			return false
+		}
+	}
+	return true
+}
+
+// deniedRedirectURL sends the access_denied error back to the app as described in RFC 6749 section 4.1.2.1.
+func deniedRedirectURL(authReq *ent.AuthRequest) string {
+	params := url.Values{}
+	params.Set("error", string(oidc.AccessDenied))
+	params.Set("error_description", "the user denied the access to the application")
+	if authReq.State != "" {
+		params.Set("state", authReq.State)
+	}
+	separator := "?"
+	if authReq.ResponseMode == string(oidc.ResponseModeFragment) {
+		separator = "#"
+	} else if u, err := url.Parse(authReq.RedirectURI); err == nil && u.RawQuery != "" {
+		separator = "&"
+	}
+	return authReq.RedirectURI + separator + params.Encode()
+}
@@ -0,0 +1,230 @@
+package authservice
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"base-website/ent/consent"
+	"base-website/ent/enttest"
+	"base-website/internal/security"
+	authmodels "base-website/internal/services/auth/models"
+	configservice "base-website/internal/services/config"
+	openidservice "base-website/internal/services/openid"
+	rbacservice "base-website/internal/services/rbac"
+	schedulerservice "base-website/internal/services/scheduler"
+	"base-website/pkg/logger"
+	"base-website/pkg/rbac"
+
+	"github.com/go-chi/chi/v5"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/mcuadros/go-defaults"
+	"github.com/zitadel/oidc/v3/pkg/client/rp"
+	httphelper "github.com/zitadel/oidc/v3/pkg/http"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+)
+
+const (
+	testClientID     = "club-app"
+	testClientSecret = "club-app-secret"
+)
+
+type testConfigService struct {
+	config configservice.Config
+}
+
+func (c *testConfigService) GetConfig() configservice.Config {
+	return c.config
+}
+
+// testRBACService decides the permissions with the real policy, without the Valkey roles cache
+type testRBACService struct {
+	rbacservice.RBACService
+	rbac *rbac.Rbac
+}
+
+func (r *testRBACService) Can(roles []string, path string, verb string) bool {
+	return r.rbac.Can(roles, path, verb)
+}
+
+type testSchedulerService struct{}
+
+func (testSchedulerService) Every(string, time.Duration, schedulerservice.Job) {}
+
+// TestAuthorizationCodeFlow logs a user in to an app through the OP with the authorization code flow and PKCE,
+// the login page of the website is played by a handler calling Authorize and Consent.
+func TestAuthorizationCodeFlow(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	entUser := client.User.Create().
+		SetUsername("froz").
+		SetEmail("froz@student.42.fr").
+		SaveX(ctx)
+
+	router := chi.NewRouter()
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	client.App.Create().
+		SetID(testClientID).
+		SetSecret(testClientSecret).
+		SetName("Club App").
+		SetDescription("The app of a club").
+		SetRedirectUris([]string{server.URL + "/app/callback"}).
+		SetImplicitConsent(false).
+		SetOwnerID(entUser.ID).
+		SaveX(ctx)
+
+	var config configservice.Config
+	defaults.SetDefaults(&config)
+	config.JWTSecret = "test-secret"
+	config.OpenIDIssuer = server.URL + "/openid"
+	config.LoginURL = server.URL + "/login"
+	configService := &testConfigService{config: config}
+
+	policyFile, err := os.Open("../../../configs/rbac.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer policyFile.Close()
+	policy, err := rbac.New(policyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	openIDService, err := openidservice.New(
+		configService,
+		client,
+		testSchedulerService{},
+		&testRBACService{rbac: policy},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := &authService{
+		configService:   configService,
+		databaseService: client,
+		openIDService:   openIDService,
+		logger:          logger.New().WithContext("AuthService"),
+	}
+
+	router.Mount("/openid", openIDService)
+
+	// The login page of the website: the user is already logged in and accepts the requested scopes
+	router.Get("/login", func(w http.ResponseWriter, r *http.Request) {
+		authRequestID := r.URL.Query().Get("auth_request_id")
+		authReq, err := client.AuthRequest.Get(r.Context(), authRequestID)
+		if err != nil {
+			t.Errorf("auth request %q not stored: %v", authRequestID, err)
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if authReq.CodeChallenge == "" || authReq.CodeChallengeMethod != string(oidc.CodeChallengeMethodS256) {
+			t.Errorf("auth request has no S256 code challenge: %q %q", authReq.CodeChallenge, authReq.CodeChallengeMethod)
+		}
+
+		userCtx := context.WithValue(r.Context(), security.ClaimsKey, &security.Claims{
+			Subject: strconv.Itoa(entUser.ID),
+		})
+		response, err := svc.Authorize(userCtx, authRequestID)
+		if err != nil {
+			t.Errorf("authorize: %v", err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if !response.ConsentRequired {
+			t.Errorf("the app has no implicit consent, the user must consent")
+		}
+		response, err = svc.Consent(userCtx, &authmodels.ConsentParams{
+			AuthRequestID: authRequestID,
+			Accept:        true,
+		})
+		if err != nil {
+			t.Errorf("consent: %v", err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http.Redirect(w, r, response.RedirectURL, http.StatusFound)
+	})
+
+	cookieHandler := httphelper.NewCookieHandler([]byte("test-hash-key-32-bytes-long-1234"), nil, httphelper.WithUnsecure())
+	relyingParty, err := rp.NewRelyingPartyOIDC(
+		ctx,
+		config.OpenIDIssuer,
+		testClientID,
+		testClientSecret,
+		server.URL+"/app/callback",
+		[]string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail},
+		rp.WithPKCE(cookieHandler),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	router.Get("/app/login", rp.AuthURLHandler(func() string { return "state" }, relyingParty))
+	router.Get("/app/callback", rp.CodeExchangeHandler(rp.UserinfoCallback(
+		func(
+			w http.ResponseWriter,
+			r *http.Request,
+			tokens *oidc.Tokens[*oidc.IDTokenClaims],
+			state string,
+			provider rp.RelyingParty,
+			info *oidc.UserInfo,
+		) {
+			if tokens.IDTokenClaims.Subject != strconv.Itoa(entUser.ID) {
+				t.Errorf("id token subject = %q, want %d", tokens.IDTokenClaims.Subject, entUser.ID)
+			}
+			_ = json.NewEncoder(w).Encode(info)
+		},
+	), relyingParty))
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	browser := &http.Client{Jar: jar}
+	resp, err := browser.Get(server.URL + "/app/login")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("the flow ended with status %d at %s", resp.StatusCode, resp.Request.URL)
+	}
+
+	var info oidc.UserInfo
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		t.Fatal(err)
+	}
+	if info.Subject != strconv.Itoa(entUser.ID) {
+		t.Errorf("userinfo subject = %q, want %d", info.Subject, entUser.ID)
+	}
+	if info.PreferredUsername != entUser.Username {
+		t.Errorf("userinfo preferred_username = %q, want %q", info.PreferredUsername, entUser.Username)
+	}
+	if info.Email != entUser.Email {
+		t.Errorf("userinfo email = %q, want %q", info.Email, entUser.Email)
+	}
+
+	consented, err := client.Consent.Query().
+		Where(consent.UserID(entUser.ID), consent.ApplicationID(testClientID)).
+		Only(ctx)
+	if err != nil {
+		t.Fatalf("consent not recorded: %v", err)
+	}
+	if len(consented.Scopes) != 3 {
+		t.Errorf("consented scopes = %v, want the 3 requested scopes", consented.Scopes)
+	}
+
+	// The auth request and its code are deleted once exchanged
+	if count := client.AuthRequest.Query().CountX(ctx); count != 0 {
+		t.Errorf("%d auth requests left after the code exchange", count)
+	}
+}
@@ -0,0 +1,117 @@
+package authservice
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"base-website/ent"
+	"base-website/ent/app"
+	"base-website/ent/deviceauthorization"
+	"base-website/internal/security"
+	appsmodels "base-website/internal/services/apps/models"
+	authmodels "base-website/internal/services/auth/models"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+func (s *authService) GetDeviceAuthorization(
+	ctx context.Context,
+	userCode string,
+) (*authmodels.DeviceAuthorization, error) {
+	device, err := s.pendingDeviceAuthorization(ctx, userCode)
+	if err != nil {
+		return nil, err
+	}
+	appEnt, err := s.databaseService.App.Query().
+		Where(app.ID(device.ApplicationID)).
+		WithOwner().
+		Only(ctx)
+	if err != nil {
+		return nil, huma.Error404NotFound("application not found")
+	}
+	return &authmodels.DeviceAuthorization{
+		UserCode:    device.UserCode,
+		Application: appsmodels.NewAppFromEnt(appEnt, true),
+		Scopes:      device.Scopes,
+		ExpiresAt:   device.Expiration,
+	}, nil
+}
+
+func (s *authService) ApproveDevice(
+	ctx context.Context,
+	params *authmodels.DeviceApprovalParams,
+) error {
+	userID, err := security.GetUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	device, err := s.pendingDeviceAuthorization(ctx, params.UserCode)
+	if err != nil {
+		return err
+	}
+
+	update := s.databaseService.DeviceAuthorization.Update().
+		Where(
+			deviceauthorization.ID(device.ID),
+			deviceauthorization.Done(false),
+			deviceauthorization.Denied(false),
+		)
+	if params.Accept {
+		// Approving the device is an explicit consent to the requested scopes
+		if err := s.grantConsent(ctx, userID, device.ApplicationID, device.Scopes); err != nil {
+			return err
+		}
+		update.
+			SetDone(true).
+			SetSubject(strconv.Itoa(userID)).
+			SetAuthTime(time.Now()).
+			SetAmr([]string{"intra42"})
+	} else {
+		update.SetDenied(true)
+	}
+	updated, err := update.Save(ctx)
+	if err != nil {
+		s.logger.Error("failed to update device authorization %s", err.Error())
+		return huma.Error500InternalServerError("failed to update device authorization")
+	}
+	if updated == 0 {
+		return huma.Error409Conflict("device already approved or denied")
+	}
+
+	if params.Accept {
+		err = s.databaseService.App.UpdateOneID(device.ApplicationID).
+			SetLastLoginAt(time.Now()).
+			Exec(ctx)
+		if err != nil {
+			s.logger.Error("failed to update app last login %s", err.Error())
+		}
+	}
+	return nil
+}
+
+func (s *authService) pendingDeviceAuthorization(
+	ctx context.Context,
+	userCode string,
+) (*ent.DeviceAuthorization, error) {
+	device, err := s.databaseService.DeviceAuthorization.Query().
+		Where(deviceauthorization.UserCode(normalizeUserCode(userCode))).
+		Only(ctx)
+	if err != nil || device.Expiration.Before(time.Now()) {
+		return nil, huma.Error404NotFound("device code not found or expired")
+	}
+	if device.Done || device.Denied {
+		return nil, huma.Error409Conflict("device already approved or denied")
+	}
+	return device, nil
+}
+
+// normalizeUserCode accepts the user code in lower case, with spaces or without the dash
+func normalizeUserCode(userCode string) string {
+	userCode = strings.ToUpper(strings.Join(strings.Fields(userCode), ""))
+	if len(userCode) == 8 && !strings.Contains(userCode, "-") {
+		userCode = userCode[:4] + "-" + userCode[4:]
+	}
+	return userCode
+}
@@ -4,13 +4,18 @@ import (
 	"context"
 	"errors"
 	"strconv"
+	"strings"
 	"time"
 
+	"base-website/ent"
 	"base-website/internal/security"
+	auditservice "base-website/internal/services/audit"
+	auditmodels "base-website/internal/services/audit/models"
 	authmodels "base-website/internal/services/auth/models"
 	configservice "base-website/internal/services/config"
 	databaseservice "base-website/internal/services/database"
 	openidservice "base-website/internal/services/openid"
+	"base-website/internal/services/openid/storage"
 	usersservice "base-website/internal/services/users"
 	"base-website/pkg/intraclient"
 	"base-website/pkg/logger"
@@ -28,9 +33,21 @@ type AuthService interface {
 		redirectURI string,
 	) (*authmodels.TokenSet, error)
 	Logout(ctx context.Context) error
+	// Authorize completes a pending OpenID auth request of a registered app for the current user,
+	// unless the user has to consent to the requested scopes first.
+	Authorize(ctx context.Context, authRequestID string) (*authmodels.AuthorizeResponse, error)
+	// Consent records the decision of the current user on a pending OpenID auth request.
+	Consent(ctx context.Context, params *authmodels.ConsentParams) (*authmodels.AuthorizeResponse, error)
+	// GetDeviceAuthorization returns the pending device authorization matching the user code.
+	GetDeviceAuthorization(ctx context.Context, userCode string) (*authmodels.DeviceAuthorization, error)
+	// ApproveDevice signs the current user in on the device, or denies it.
+	ApproveDevice(ctx context.Context, params *authmodels.DeviceApprovalParams) error
+	// Impersonate mints a short-lived token for the current super admin to act as the user.
+	Impersonate(ctx context.Context, userID int) (*authmodels.TokenSet, error)
 }
 
 type authService struct {
+	auditService    auditservice.AuditService
 	configService   configservice.ConfigService
 	userService     usersservice.UserService
 	databaseService databaseservice.DatabaseService
@@ -41,6 +58,7 @@ type authService struct {
 func NewProvider() func(i *do.Injector) (AuthService, error) {
 	return func(i *do.Injector) (AuthService, error) {
 		return New(
+			do.MustInvoke[auditservice.AuditService](i),
 			do.MustInvoke[configservice.ConfigService](i),
 			do.MustInvoke[usersservice.UserService](i),
 			do.MustInvoke[databaseservice.DatabaseService](i),
@@ -50,12 +68,14 @@ func NewProvider() func(i *do.Injector) (AuthService, error) {
 }
 
 func New(
+	auditService auditservice.AuditService,
 	configService configservice.ConfigService,
 	usersService usersservice.UserService,
 	databaseService databaseservice.DatabaseService,
 	openIDService openidservice.OpenIDService,
 ) (AuthService, error) {
 	return &authService{
+		auditService:    auditService,
 		configService:   configService,
 		userService:     usersService,
 		databaseService: databaseService,
@@ -146,6 +166,85 @@ func (s *authService) Logout(ctx context.Context) error {
 	return nil
 }
 
+// impersonationScopes leave out security, an impersonation cannot sign the user in elsewhere nor manage their apps
+var impersonationScopes = []string{"openid", "profile", "email"}
+
+func (s *authService) Impersonate(ctx context.Context, userID int) (*authmodels.TokenSet, error) {
+	actorID, err := security.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if actorID == userID {
+		return nil, huma.Error400BadRequest("you cannot impersonate yourself")
+	}
+	target, err := s.databaseService.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, huma.Error404NotFound("user not found")
+		}
+		s.logger.Error("failed to get user %s", err.Error())
+		return nil, huma.Error500InternalServerError("failed to get user")
+	}
+	if target.AnonymizedAt != nil {
+		return nil, huma.Error400BadRequest("an anonymized user cannot be impersonated")
+	}
+
+	lifetime := time.Duration(s.configService.GetConfig().OpenIDImpersonationTokenLifetime) * time.Second
+	subject := strconv.Itoa(userID)
+	// The token is created once the audit entry is, and deleted if the entry isn't committed,
+	// so that no impersonation goes unrecorded
+	var tokenID string
+	err = databaseservice.WithTx(ctx, s.databaseService, func(tx *ent.Tx) error {
+		err := s.auditService.Record(ctx, tx, &auditmodels.Entry{
+			Action:     auditmodels.ActionUserImpersonate,
+			TargetType: auditmodels.TargetUser,
+			TargetID:   subject,
+			After:      map[string]any{"expires_at": time.Now().Add(lifetime)},
+		})
+		if err != nil {
+			return err
+		}
+		tokenID, _, err = s.openIDService.Storage().CreateAccessToken(ctx, &storage.ImpersonationRequest{
+			Subject: subject,
+			Actor:   strconv.Itoa(actorID),
+			Scopes:  impersonationScopes,
+		})
+		if err != nil {
+			s.logger.Error("failed to create impersonation token %s", err.Error())
+			return huma.Error500InternalServerError("failed to create impersonation token")
+		}
+		return nil
+	})
+	if err != nil {
+		if tokenID != "" {
+			s.revokeImpersonationToken(ctx, tokenID, subject)
+		}
+		return nil, err
+	}
+	accessToken, err := op.CreateBearerToken(tokenID, subject, s.openIDService.Crypto())
+	if err != nil {
+		s.logger.Error("failed to encrypt impersonation token %s", err.Error())
+		s.revokeImpersonationToken(ctx, tokenID, subject)
+		return nil, huma.Error500InternalServerError("failed to create impersonation token")
+	}
+
+	s.logger.Warn("user %d impersonates user %d", actorID, userID)
+
+	return &authmodels.TokenSet{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   uint64(lifetime.Seconds()),
+		Scope:       strings.Join(impersonationScopes, " "),
+	}, nil
+}
+
+// revokeImpersonationToken deletes a token whose impersonation couldn't be completed
+func (s *authService) revokeImpersonationToken(ctx context.Context, tokenID string, subject string) {
+	if oidcErr := s.openIDService.Storage().RevokeToken(ctx, tokenID, subject, "builtin"); oidcErr != nil {
+		s.logger.Error("failed to revoke impersonation token %s: %s", tokenID, oidcErr.Error())
+	}
+}
+
 type tokenRequest struct {
 	ID string
 }
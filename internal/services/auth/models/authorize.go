@@ -0,0 +1,18 @@
+package authmodels
+
+import (
+	appsmodels "base-website/internal/services/apps/models"
+)
+
+type AuthorizeResponse struct {
+	RedirectURL     string          `json:"redirect_url,omitempty" example:"http://localhost:8080/api/openid/authorize/callback?id=4f0b2f9c" description:"The URL the user agent must be redirected to in order to finish the authorization"`
+	ConsentRequired bool            `json:"consent_required" example:"false" description:"Whether the user has to consent to the requested scopes before being redirected"`
+	Application     *appsmodels.App `json:"application,omitempty" description:"The application requesting access"`
+	Scopes          []string        `json:"scopes,omitempty" example:"[\"openid\", \"profile\"]" description:"The scopes requested by the application"`
+	GrantedScopes   []string        `json:"granted_scopes,omitempty" example:"[\"openid\"]" description:"The scopes the user already consented to for this application"`
+}
+
+type ConsentParams struct {
+	AuthRequestID string `json:"auth_request_id" example:"4f0b2f9c-5d4e-4d0b-9c55-1d6f2b1d7c3e" description:"The ID of the pending auth request" required:"true"`
+	Accept        bool   `json:"accept" example:"true" description:"Whether the user grants the requested scopes to the application" required:"true"`
+}
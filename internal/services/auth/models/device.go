@@ -0,0 +1,19 @@
+package authmodels
+
+import (
+	"time"
+
+	appsmodels "base-website/internal/services/apps/models"
+)
+
+type DeviceAuthorization struct {
+	UserCode    string          `json:"user_code" example:"BCDF-GHJK" description:"The code displayed on the device"`
+	Application *appsmodels.App `json:"application" description:"The application the device signs in to"`
+	Scopes      []string        `json:"scopes" example:"[\"openid\", \"profile\"]" description:"The scopes requested by the device" nullable:"false"`
+	ExpiresAt   time.Time       `json:"expires_at" example:"2024-09-01T00:00:00Z" description:"The date after which the code can no longer be approved"`
+}
+
+type DeviceApprovalParams struct {
+	UserCode string `json:"user_code" example:"BCDF-GHJK" description:"The code displayed on the device" required:"true"`
+	Accept   bool   `json:"accept" example:"true" description:"Whether the user signs the device in or denies it" required:"true"`
+}
@@ -18,9 +18,20 @@ type Config struct {
 
 	CookieSecure bool `mapstructure:"COOKIE_SECURE" default:"false"`
 
-	JWTSecret      string `mapstructure:"JWT_SECRET"                validate:"required"`
-	JWTExp         int    `mapstructure:"JWT_EXPIRATION" default:"3600" validate:"required"`
-	RBACConfigPath string `mapstructure:"RBAC_CONFIG_PATH" default:"./configs/rbac.yaml" validate:"required"`
+	// Comma-separated IPs and CIDRs of the reverse proxies allowed to forward the client IP,
+	// the forwarding headers of any other peer are ignored
+	TrustedProxies string `mapstructure:"TRUSTED_PROXIES" default:""`
+
+	JWTSecret         string `mapstructure:"JWT_SECRET"                validate:"required"`
+	JWTExp            int    `mapstructure:"JWT_EXPIRATION" default:"3600" validate:"required"`
+	RBACConfigPath    string `mapstructure:"RBAC_CONFIG_PATH" default:"./configs/rbac.yaml" validate:"required"`
+	RBACHotReload     bool   `mapstructure:"RBAC_HOT_RELOAD" default:"true"`
+	RBACRolesCacheTTL int    `mapstructure:"RBAC_ROLES_CACHE_TTL" default:"30" validate:"gte=0"`
+
+	// Seconds the global ban state of a user checked on every request is cached
+	SanctionsBanCacheTTL int `mapstructure:"SANCTIONS_BAN_CACHE_TTL" default:"30" validate:"gte=0"`
+
+	RateLimitConfigPath string `mapstructure:"RATE_LIMIT_CONFIG_PATH" default:"./configs/rate_limits.yaml" validate:"required"`
 
 	DBHost string `mapstructure:"DB_HOST" validate:"required"`
 	DBPort string `mapstructure:"DB_PORT" validate:"required"`
@@ -40,8 +51,32 @@ type Config struct {
 	ConsentDayExpiration int    `mapstructure:"CONSENT_DAY_EXPIRATION" default:"30" validate:"required"`
 	LoginURL             string `mapstructure:"LOGIN_URL" validate:"required"`
 
+	OpenIDKeyRotationDays int `mapstructure:"OPENID_KEY_ROTATION_DAYS" default:"30" validate:"gt=0"`
+	OpenIDKeyOverlapHours int `mapstructure:"OPENID_KEY_OVERLAP_HOURS" default:"24" validate:"gt=0"`
+
+	// Token lifetimes in seconds, apps can override the access and refresh ones
+	OpenIDAccessTokenLifetime  int `mapstructure:"OPENID_ACCESS_TOKEN_LIFETIME" default:"300" validate:"gt=0"`
+	OpenIDRefreshTokenLifetime int `mapstructure:"OPENID_REFRESH_TOKEN_LIFETIME" default:"18000" validate:"gt=0"`
+	OpenIDSessionMaxLifetime   int `mapstructure:"OPENID_SESSION_MAX_LIFETIME" default:"2592000" validate:"gt=0"`
+
+	// Lifetime in seconds of the impersonation tokens, they are never refreshed
+	OpenIDImpersonationTokenLifetime int `mapstructure:"OPENID_IMPERSONATION_TOKEN_LIFETIME" default:"900" validate:"gt=0"`
+
+	// Intervals in seconds of the cleanup jobs, rows are deleted by batches of JanitorBatchSize
+	JanitorExpiredInterval            int `mapstructure:"JANITOR_EXPIRED_INTERVAL" default:"3600" validate:"gt=0"`
+	JanitorNotificationsInterval      int `mapstructure:"JANITOR_NOTIFICATIONS_INTERVAL" default:"86400" validate:"gt=0"`
+	JanitorBatchSize                  int `mapstructure:"JANITOR_BATCH_SIZE" default:"1000" validate:"gt=0"`
+	JanitorNotificationsRetentionDays int `mapstructure:"JANITOR_NOTIFICATIONS_RETENTION_DAYS" default:"30" validate:"gt=0"`
+
 	AccountAnonymizeMinAgeDays int `mapstructure:"ACCOUNT_ANONYMIZE_MIN_AGE_DAYS" default:"7" validate:"gte=0"`
 
+	// Interval in seconds of the job revoking the expired role grants
+	RoleGrantsExpiryInterval int `mapstructure:"ROLE_GRANTS_EXPIRY_INTERVAL" default:"300" validate:"gt=0"`
+
+	VotesResultsCheckInterval int `mapstructure:"VOTES_RESULTS_CHECK_INTERVAL" default:"30" validate:"gt=0"`
+	VotesAnalyticsInterval    int `mapstructure:"VOTES_ANALYTICS_INTERVAL" default:"15" validate:"gt=0"`
+	VotesActiveUserDays       int `mapstructure:"VOTES_ACTIVE_USER_DAYS" default:"30" validate:"gt=0"`
+
 	IntraTokenURL     string `mapstructure:"INTRA_TOKEN_URL" validate:"required"`
 	IntraAPIURL       string `mapstructure:"INTRA_API_URL" validate:"required"`
 	IntraClientID     string `mapstructure:"INTRA_CLIENT_ID" validate:"required"`
@@ -53,3 +53,24 @@ func New(configService configservice.ConfigService) (DatabaseService, error) {
 	logger.Info("Connected to database")
 	return client, nil
 }
+
+// WithTx runs fn in a transaction, committed if fn succeeds and rolled back otherwise.
+func WithTx(ctx context.Context, db DatabaseService, fn func(tx *ent.Tx) error) error {
+	tx, err := (*ent.Client)(db).Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
@@ -3,6 +3,7 @@ package invitationsservice
 import (
 	"base-website/ent"
 	"base-website/ent/invitation"
+	"base-website/ent/sanction"
 	"base-website/ent/team"
 	"base-website/ent/teammember"
 	"base-website/ent/tournament"
@@ -13,6 +14,7 @@ import (
 	invitationsmodels "base-website/internal/services/invitations/models"
 	rbacservice "base-website/internal/services/rbac"
 	s3service "base-website/internal/services/s3"
+	sanctionsservice "base-website/internal/services/sanctions"
 	tournamentsservice "base-website/internal/services/tournaments"
 	"base-website/pkg/errorfilters"
 	"base-website/pkg/paging"
@@ -39,6 +41,7 @@ type invitationsService struct {
 	rbacService        rbacservice.RBACService
 	s3service          s3service.S3Service
 	tournamentsService tournamentsservice.TournamentsService
+	sanctionsService   sanctionsservice.SanctionsService
 }
 
 func NewProvider() func(i *do.Injector) (InvitationsService, error) {
@@ -48,6 +51,7 @@ func NewProvider() func(i *do.Injector) (InvitationsService, error) {
 			do.MustInvoke[rbacservice.RBACService](i),
 			do.MustInvoke[s3service.S3Service](i),
 			do.MustInvoke[tournamentsservice.TournamentsService](i),
+			do.MustInvoke[sanctionsservice.SanctionsService](i),
 		)
 	}
 }
@@ -57,6 +61,7 @@ func New(
 	rbacService rbacservice.RBACService,
 	s3service s3service.S3Service,
 	tournamentsService tournamentsservice.TournamentsService,
+	sanctionsService sanctionsservice.SanctionsService,
 ) (InvitationsService, error) {
 	return &invitationsService{
 		databaseService:    databaseService,
@@ -64,6 +69,7 @@ func New(
 		rbacService:        rbacService,
 		s3service:          s3service,
 		tournamentsService: tournamentsService,
+		sanctionsService:   sanctionsService,
 	}, nil
 }
 
@@ -150,6 +156,17 @@ func (svc *invitationsService) CreateInvitationForTeam(
 		return nil, huma.Error400BadRequest(fmt.Sprintf("role '%s' doesn't exist for this tournament", input.Role))
 	}
 
+	if err := svc.sanctionsService.CheckSanctions(ctx, userID, &entTeam.Edges.Tournament.ID, sanction.TypeTournamentBan); err != nil {
+		return nil, err
+	}
+	inviteeSanction, err := svc.sanctionsService.GetActiveSanction(ctx, input.UserID, &entTeam.Edges.Tournament.ID, sanction.TypeTournamentBan)
+	if err != nil {
+		return nil, err
+	}
+	if inviteeSanction != nil {
+		return nil, huma.Error403Forbidden("the invited user is banned from this tournament")
+	}
+
 	tmExists, err := svc.databaseService.TeamMember.Query().Where(
 		teammember.HasUserWith(user.IDEQ(input.UserID)),
 		teammember.HasTournamentWith(tournament.IDEQ(entTeam.Edges.Tournament.ID)),
@@ -295,6 +312,10 @@ func (svc *invitationsService) AcceptInvitation(ctx context.Context, invitationI
 		return huma.Error401Unauthorized("Only Invitee can accept invitation")
 	}
 
+	if err := svc.sanctionsService.CheckSanctions(ctx, userID, &entInvitation.Edges.Team.Edges.Tournament.ID, sanction.TypeTournamentBan); err != nil {
+		return err
+	}
+
 	if _, err := svc.databaseService.TeamMember.Create().
 		SetRole(entInvitation.Role).
 		SetTeamID(entInvitation.Edges.Team.ID).
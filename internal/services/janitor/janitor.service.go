@@ -0,0 +1,214 @@
+package janitorservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"base-website/ent/authcode"
+	"base-website/ent/authrefreshtoken"
+	"base-website/ent/authrequest"
+	"base-website/ent/authtoken"
+	"base-website/ent/consent"
+	"base-website/ent/deviceauthorization"
+	"base-website/ent/notification"
+	configservice "base-website/internal/services/config"
+	databaseservice "base-website/internal/services/database"
+	schedulerservice "base-website/internal/services/scheduler"
+	"base-website/pkg/logger"
+
+	"github.com/samber/do"
+)
+
+type JanitorService interface {
+	// PurgeExpired deletes the expired tokens, codes, authorization requests and consents.
+	PurgeExpired(ctx context.Context) error
+	// PurgeReadNotifications deletes the notifications read before the retention period.
+	PurgeReadNotifications(ctx context.Context) error
+}
+
+type janitorService struct {
+	databaseService databaseservice.DatabaseService
+	batchSize       int
+	retention       time.Duration
+	logger          *logger.Logger
+}
+
+// purgeFunc deletes at most limit rows and returns the number of deleted rows
+type purgeFunc func(ctx context.Context, limit int) (int, error)
+
+func NewProvider() func(i *do.Injector) (JanitorService, error) {
+	return func(i *do.Injector) (JanitorService, error) {
+		return New(
+			do.MustInvoke[configservice.ConfigService](i),
+			do.MustInvoke[databaseservice.DatabaseService](i),
+			do.MustInvoke[schedulerservice.SchedulerService](i),
+		)
+	}
+}
+
+// New creates a new instance of the janitor service.
+// The cleanup jobs are scheduled as soon as it is created.
+func New(
+	configService configservice.ConfigService,
+	databaseService databaseservice.DatabaseService,
+	schedulerService schedulerservice.SchedulerService,
+) (JanitorService, error) {
+	config := configService.GetConfig()
+	svc := &janitorService{
+		databaseService: databaseService,
+		batchSize:       config.JanitorBatchSize,
+		retention:       time.Duration(config.JanitorNotificationsRetentionDays) * 24 * time.Hour,
+		logger:          logger.New().WithContext("JanitorService"),
+	}
+
+	schedulerService.Every(
+		"purge-expired",
+		time.Duration(config.JanitorExpiredInterval)*time.Second,
+		svc.PurgeExpired,
+	)
+	schedulerService.Every(
+		"purge-read-notifications",
+		time.Duration(config.JanitorNotificationsInterval)*time.Second,
+		svc.PurgeReadNotifications,
+	)
+
+	return svc, nil
+}
+
+func (svc *janitorService) PurgeExpired(ctx context.Context) error {
+	now := time.Now()
+	purges := []struct {
+		name  string
+		purge purgeFunc
+	}{
+		{"access tokens", func(ctx context.Context, limit int) (int, error) {
+			ids, err := svc.databaseService.AuthToken.Query().
+				Where(authtoken.ExpirationLT(now)).
+				Limit(limit).
+				IDs(ctx)
+			if err != nil || len(ids) == 0 {
+				return 0, err
+			}
+			return svc.databaseService.AuthToken.Delete().
+				Where(authtoken.IDIn(ids...)).
+				Exec(ctx)
+		}},
+		{"refresh tokens", func(ctx context.Context, limit int) (int, error) {
+			ids, err := svc.databaseService.AuthRefreshToken.Query().
+				Where(authrefreshtoken.ExpirationLT(now)).
+				Limit(limit).
+				IDs(ctx)
+			if err != nil || len(ids) == 0 {
+				return 0, err
+			}
+			return svc.databaseService.AuthRefreshToken.Delete().
+				Where(authrefreshtoken.IDIn(ids...)).
+				Exec(ctx)
+		}},
+		{"auth codes", func(ctx context.Context, limit int) (int, error) {
+			ids, err := svc.databaseService.AuthCode.Query().
+				Where(authcode.ExpirationLT(now)).
+				Limit(limit).
+				IDs(ctx)
+			if err != nil || len(ids) == 0 {
+				return 0, err
+			}
+			return svc.databaseService.AuthCode.Delete().
+				Where(authcode.IDIn(ids...)).
+				Exec(ctx)
+		}},
+		{"auth requests", func(ctx context.Context, limit int) (int, error) {
+			ids, err := svc.databaseService.AuthRequest.Query().
+				Where(authrequest.ExpirationLT(now)).
+				Limit(limit).
+				IDs(ctx)
+			if err != nil || len(ids) == 0 {
+				return 0, err
+			}
+			return svc.databaseService.AuthRequest.Delete().
+				Where(authrequest.IDIn(ids...)).
+				Exec(ctx)
+		}},
+		{"device authorizations", func(ctx context.Context, limit int) (int, error) {
+			ids, err := svc.databaseService.DeviceAuthorization.Query().
+				Where(deviceauthorization.ExpirationLT(now)).
+				Limit(limit).
+				IDs(ctx)
+			if err != nil || len(ids) == 0 {
+				return 0, err
+			}
+			return svc.databaseService.DeviceAuthorization.Delete().
+				Where(deviceauthorization.IDIn(ids...)).
+				Exec(ctx)
+		}},
+		{"consents", func(ctx context.Context, limit int) (int, error) {
+			ids, err := svc.databaseService.Consent.Query().
+				Where(consent.ExpirationDateLT(now)).
+				Limit(limit).
+				IDs(ctx)
+			if err != nil || len(ids) == 0 {
+				return 0, err
+			}
+			return svc.databaseService.Consent.Delete().
+				Where(consent.IDIn(ids...)).
+				Exec(ctx)
+		}},
+	}
+
+	for _, p := range purges {
+		if err := svc.purge(ctx, p.name, p.purge); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (svc *janitorService) PurgeReadNotifications(ctx context.Context) error {
+	cutoff := time.Now().Add(-svc.retention)
+	return svc.purge(ctx, "read notifications", func(ctx context.Context, limit int) (int, error) {
+		ids, err := svc.databaseService.Notification.Query().
+			Where(
+				notification.Read(true),
+				notification.Or(
+					notification.ReadAtLT(cutoff),
+					notification.And(
+						notification.ReadAtIsNil(),
+						notification.CreatedAtLT(cutoff),
+					),
+				),
+			).
+			Limit(limit).
+			IDs(ctx)
+		if err != nil || len(ids) == 0 {
+			return 0, err
+		}
+		return svc.databaseService.Notification.Delete().
+			Where(notification.IDIn(ids...)).
+			Exec(ctx)
+	})
+}
+
+// purge runs the purge function by batches until a batch is not full, so that a large backlog
+// does not lock the table in a single statement
+func (svc *janitorService) purge(ctx context.Context, name string, purge purgeFunc) error {
+	start := time.Now()
+	total, batches := 0, 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		deleted, err := purge(ctx, svc.batchSize)
+		if err != nil {
+			svc.logger.Error("failed to purge %s after %d rows: %s", name, total, err.Error())
+			return fmt.Errorf("failed to purge %s: %w", name, err)
+		}
+		total += deleted
+		batches++
+		if deleted < svc.batchSize {
+			break
+		}
+	}
+	svc.logger.Info("purged %d %s in %d batches (%s)", total, name, batches, time.Since(start).Round(time.Millisecond))
+	return nil
+}
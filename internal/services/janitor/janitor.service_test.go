@@ -0,0 +1,109 @@
+package janitorservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"base-website/ent/authtoken"
+	"base-website/ent/enttest"
+	"base-website/pkg/logger"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestPurgeBatches(t *testing.T) {
+	tests := []struct {
+		name    string
+		rows    int
+		batches int
+	}{
+		{"nothing to purge", 0, 1},
+		{"partial batch", 3, 1},
+		{"full batch", 5, 2},
+		{"several batches", 12, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &janitorService{batchSize: 5, logger: logger.New()}
+			remaining, batches := tt.rows, 0
+			err := svc.purge(context.Background(), "rows", func(ctx context.Context, limit int) (int, error) {
+				batches++
+				deleted := min(limit, remaining)
+				remaining -= deleted
+				return deleted, nil
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if remaining != 0 {
+				t.Errorf("expected every row to be purged, %d left", remaining)
+			}
+			if batches != tt.batches {
+				t.Errorf("expected %d batches, got %d", tt.batches, batches)
+			}
+		})
+	}
+}
+
+func TestPurgeStops(t *testing.T) {
+	svc := &janitorService{batchSize: 5, logger: logger.New()}
+
+	batches := 0
+	err := svc.purge(context.Background(), "rows", func(ctx context.Context, limit int) (int, error) {
+		batches++
+		if batches == 2 {
+			return 0, errors.New("connection lost")
+		}
+		return limit, nil
+	})
+	if err == nil || err.Error() != "failed to purge rows: connection lost" || batches != 2 {
+		t.Errorf("expected the purge to stop on the failing batch, got %v after %d batches", err, batches)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	batches = 0
+	err = svc.purge(ctx, "rows", func(ctx context.Context, limit int) (int, error) {
+		batches++
+		cancel()
+		return limit, nil
+	})
+	if !errors.Is(err, context.Canceled) || batches != 1 {
+		t.Errorf("expected the purge to stop once cancelled, got %v after %d batches", err, batches)
+	}
+}
+
+func TestPurgeExpired(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	now := time.Now()
+	for i := range 7 {
+		expiration := now.Add(-time.Hour)
+		if i%3 == 0 {
+			expiration = now.Add(time.Hour)
+		}
+		client.AuthToken.Create().
+			SetID(fmt.Sprintf("token-%d", i)).
+			SetApplicationID("club-app").
+			SetSubject("user").
+			SetRefreshTokenID("").
+			SetAudience([]string{"club-app"}).
+			SetScopes([]string{"openid"}).
+			SetExpiration(expiration).
+			SaveX(ctx)
+	}
+
+	svc := &janitorService{databaseService: client, batchSize: 2, logger: logger.New()}
+	if err := svc.PurgeExpired(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ids := client.AuthToken.Query().Order(authtoken.ByID()).IDsX(ctx)
+	if fmt.Sprint(ids) != "[token-0 token-3 token-6]" {
+		t.Errorf("expected the unexpired tokens only, got %v", ids)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"slices"
 	"time"
 
 	"github.com/samber/do"
@@ -15,10 +16,17 @@ import (
 	configservice "base-website/internal/services/config"
 	databaseservice "base-website/internal/services/database"
 	"base-website/internal/services/openid/storage"
+	rbacservice "base-website/internal/services/rbac"
+	schedulerservice "base-website/internal/services/scheduler"
 )
 
 const (
 	pathLoggedOut = "/logged-out"
+	// pathDevice is the frontend page where the user enters the code displayed by a device,
+	// it replaces the path of the issuer in the verification URI
+	pathDevice = "/auth/device"
+
+	signingKeysCheckInterval = time.Hour
 )
 
 type OpenIDService op.OpenIDProvider
@@ -32,6 +40,8 @@ func NewProvider() func(i *do.Injector) (OpenIDService, error) {
 		return New(
 			do.MustInvoke[configservice.ConfigService](injector),
 			do.MustInvoke[databaseservice.DatabaseService](injector),
+			do.MustInvoke[schedulerservice.SchedulerService](injector),
+			do.MustInvoke[rbacservice.RBACService](injector),
 		)
 	}
 }
@@ -39,15 +49,20 @@ func NewProvider() func(i *do.Injector) (OpenIDService, error) {
 func New(
 	configService configservice.ConfigService,
 	databaseService databaseservice.DatabaseService,
+	schedulerService schedulerservice.SchedulerService,
+	rbacService rbacservice.RBACService,
 ) (OpenIDService, error) {
 	config := configService.GetConfig()
-	storage, err := storage.NewStorage(
+	opStorage, err := storage.NewStorage(
 		(*ent.Client)(databaseService),
 		&config,
+		rbacService,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create OpenID storage: %w", err)
 	}
+	schedulerService.Every("openid-signing-keys", signingKeysCheckInterval, opStorage.RotateSigningKeys)
+
 	key := sha256.Sum256([]byte(config.JWTSecret))
 	opConfig := &op.Config{
 		CryptoKey:                key,
@@ -58,16 +73,22 @@ func New(
 		GrantTypeRefreshToken:    true,
 		RequestObjectSupported:   true,
 		SupportedUILocales:       []language.Tag{language.English},
+		SupportedScopes: append(
+			slices.Clone(op.DefaultSupportedScopes),
+			storage.ScopeRoles,
+			storage.ScopeElo,
+			storage.ScopeTeams,
+		),
 		DeviceAuthorization: op.DeviceAuthorizationConfig{
 			Lifetime:     5 * time.Minute,
 			PollInterval: 5 * time.Second,
-			UserFormPath: "/device",
+			UserFormPath: pathDevice,
 			UserCode:     op.UserCodeBase20,
 		},
 	}
 	provider, err := op.NewProvider(
 		opConfig,
-		storage,
+		opStorage,
 		op.StaticIssuer(config.OpenIDIssuer),
 		op.WithAllowInsecure(),
 		op.WithLogger(slog.New(slog.NewJSONHandler(io.Discard, nil))),
@@ -0,0 +1,104 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"base-website/ent"
+	"base-website/ent/teammember"
+	"base-website/ent/tournament"
+	"base-website/ent/user"
+)
+
+// Custom scopes giving access to private claims, an app can only request the ones
+// allowed to its roles by the RBAC config on the /scopes/<scope> path
+const (
+	ScopeRoles = "roles"
+	ScopeElo   = "elo"
+	ScopeTeams = "teams"
+)
+
+var customScopes = []string{ScopeRoles, ScopeElo, ScopeTeams}
+
+// TeamClaim describes the team of the user in a tournament that is not over yet
+type TeamClaim struct {
+	TournamentID   int    `json:"tournament_id"`
+	TournamentSlug string `json:"tournament_slug"`
+	TeamID         int    `json:"team_id"`
+	TeamName       string `json:"team_name"`
+	Role           string `json:"role"`
+}
+
+// privateClaims returns the claims of the custom scopes for the user
+func (s *Storage) privateClaims(
+	ctx context.Context,
+	userID string,
+	scopes []string,
+) (map[string]any, error) {
+	claims := map[string]any{}
+	id, err := strconv.Atoi(userID)
+	if err != nil {
+		// Apps acting on their own behalf have no user claims
+		return claims, nil
+	}
+	var userEnt *ent.User
+	for _, scope := range scopes {
+		switch scope {
+		case ScopeRoles, ScopeElo:
+			if userEnt == nil {
+				userEnt, err = s.entClient.User.Query().
+					Where(user.ID(id)).
+					Select(user.FieldRoles, user.FieldElo).
+					Only(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("user not found")
+				}
+			}
+			if scope == ScopeRoles {
+				claims[ScopeRoles] = userEnt.Roles
+			} else {
+				claims[ScopeElo] = userEnt.Elo
+			}
+		case ScopeTeams:
+			teams, err := s.teamClaims(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			claims[ScopeTeams] = teams
+		}
+	}
+	return claims, nil
+}
+
+func (s *Storage) teamClaims(ctx context.Context, userID int) ([]TeamClaim, error) {
+	memberships, err := s.entClient.TeamMember.Query().
+		Where(
+			teammember.HasUserWith(user.ID(userID)),
+			teammember.HasTournamentWith(
+				tournament.IsVisible(true),
+				tournament.Or(
+					tournament.TournamentEndIsNil(),
+					tournament.TournamentEndGT(time.Now()),
+				),
+			),
+		).
+		WithTeam().
+		WithTournament().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get teams: %w", err)
+	}
+	teams := make([]TeamClaim, 0, len(memberships))
+	for _, membership := range memberships {
+		teams = append(teams, TeamClaim{
+			TournamentID:   membership.Edges.Tournament.ID,
+			TournamentSlug: membership.Edges.Tournament.Slug,
+			TeamID:         membership.Edges.Team.ID,
+			TeamName:       membership.Edges.Team.Name,
+			Role:           membership.Role,
+		})
+	}
+	return teams, nil
+}
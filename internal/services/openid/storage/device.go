@@ -0,0 +1,119 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"base-website/ent"
+	"base-website/ent/authrefreshtoken"
+	"base-website/ent/deviceauthorization"
+
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+)
+
+var _ op.DeviceAuthorizationStorage = (*Storage)(nil)
+
+// StoreDeviceAuthorization implements op.DeviceAuthorizationStorage.
+// it will be called when a device starts the device authorization flow
+func (s *Storage) StoreDeviceAuthorization(
+	ctx context.Context,
+	clientID, deviceCode, userCode string,
+	expires time.Time,
+	scopes []string,
+) error {
+	err := s.entClient.DeviceAuthorization.Create().
+		SetID(deviceCode).
+		SetUserCode(userCode).
+		SetApplicationID(clientID).
+		SetScopes(scopes).
+		SetExpiration(expires).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		return op.ErrDuplicateUserCode
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save device authorization: %w", err)
+	}
+	return nil
+}
+
+// GetDeviceAuthorizatonState implements op.DeviceAuthorizationStorage.
+// it is polled by the device until the user approved or denied the authorization, or until it expired.
+// A denied or expired authorization is deleted, a completed one is kept until its tokens are issued
+// so that a failure to issue them doesn't lose the grant, see consumeDeviceAuthorization
+func (s *Storage) GetDeviceAuthorizatonState(
+	ctx context.Context,
+	clientID, deviceCode string,
+) (*op.DeviceAuthorizationState, error) {
+	device, err := s.entClient.DeviceAuthorization.Query().
+		Where(
+			deviceauthorization.ID(deviceCode),
+			deviceauthorization.ApplicationID(clientID),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, errors.New("device code not found")
+	}
+
+	state := &op.DeviceAuthorizationState{
+		ClientID: device.ApplicationID,
+		Audience: []string{device.ApplicationID},
+		Scopes:   device.Scopes,
+		Expires:  device.Expiration,
+		Done:     device.Done,
+		Denied:   device.Denied,
+		Subject:  device.Subject,
+		AMR:      device.Amr,
+	}
+	if device.AuthTime != nil {
+		state.AuthTime = *device.AuthTime
+	}
+
+	if !device.Done && (device.Denied || device.Expiration.Before(time.Now())) {
+		err := s.entClient.DeviceAuthorization.DeleteOneID(deviceCode).Exec(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to delete device authorization: %w", err)
+		}
+	}
+	return state, nil
+}
+
+// consumeDeviceAuthorization deletes the completed device authorization once its tokens are stored,
+// so that the device code can only be exchanged once. When a concurrent poll already exchanged it,
+// or it can't be deleted, the tokens just stored are deleted and the exchange fails
+func (s *Storage) consumeDeviceAuthorization(
+	ctx context.Context,
+	state *op.DeviceAuthorizationState,
+	accessTokenID, refreshToken string,
+) error {
+	deleted, err := s.entClient.DeviceAuthorization.Delete().
+		Where(
+			deviceauthorization.ApplicationID(state.ClientID),
+			deviceauthorization.Subject(state.Subject),
+			deviceauthorization.AuthTime(state.AuthTime),
+			deviceauthorization.Done(true),
+		).
+		Exec(ctx)
+	if err == nil && deleted > 0 {
+		return nil
+	}
+
+	if err := s.entClient.AuthToken.DeleteOneID(accessTokenID).Exec(ctx); err != nil && !ent.IsNotFound(err) {
+		s.logger.Error("failed to delete access token of an unconsumed device authorization: %v", err)
+	}
+	if refreshToken != "" {
+		_, err := s.entClient.AuthRefreshToken.Delete().
+			Where(authrefreshtoken.Token(refreshToken)).
+			Exec(ctx)
+		if err != nil {
+			s.logger.Error("failed to delete refresh token of an unconsumed device authorization: %v", err)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to consume device authorization: %w", err)
+	}
+	return oidc.ErrInvalidGrant().WithDescription("device code already exchanged")
+}
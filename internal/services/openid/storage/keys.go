@@ -0,0 +1,240 @@
+package storage
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"base-website/ent"
+	"base-website/ent/signingkey"
+
+	jose "github.com/go-jose/go-jose/v4"
+	"github.com/google/uuid"
+)
+
+const (
+	signingKeyAlgorithm = jose.RS256
+	signingKeyBits      = 2048
+	// signingKeysCipherContext separates the signing keys cipher key from the other keys derived from JWT_SECRET
+	signingKeysCipherContext = "openid-signing-keys:"
+	// signingKeysCacheTTL bounds how long a replica may take to notice keys created by another one
+	signingKeysCacheTTL = time.Minute
+)
+
+// keyRing caches the decrypted signing keys stored in the database.
+type keyRing struct {
+	lock      sync.RWMutex
+	cipherKey [32]byte
+	keys      []*signingKey
+	loadedAt  time.Time
+}
+
+type signingKey struct {
+	id        string
+	algorithm jose.SignatureAlgorithm
+	key       *rsa.PrivateKey
+	activeAt  time.Time
+	expiresAt time.Time
+}
+
+func (s *signingKey) SignatureAlgorithm() jose.SignatureAlgorithm {
+	return s.algorithm
+}
+
+func (s *signingKey) Key() any {
+	return s.key
+}
+
+func (s *signingKey) ID() string {
+	return s.id
+}
+
+type publicKey struct {
+	*signingKey
+}
+
+func (s *publicKey) ID() string {
+	return s.id
+}
+
+func (s *publicKey) Algorithm() jose.SignatureAlgorithm {
+	return s.algorithm
+}
+
+func (s *publicKey) Use() string {
+	return "sig"
+}
+
+func (s *publicKey) Key() any {
+	return &s.key.PublicKey
+}
+
+// RotateSigningKeys makes sure a signing key is active and pre-publishes its successor.
+// Each key signs for the rotation period and stays published for the overlap period before
+// becoming active and after being replaced, so that relying parties always know the keys in use.
+func (s *Storage) RotateSigningKeys(ctx context.Context) error {
+	rotation := time.Duration(s.config.OpenIDKeyRotationDays) * 24 * time.Hour
+	overlap := min(time.Duration(s.config.OpenIDKeyOverlapHours)*time.Hour, rotation/2)
+	now := time.Now()
+
+	_, err := s.entClient.SigningKey.Delete().
+		Where(signingkey.ExpiresAtLTE(now)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete expired signing keys: %w", err)
+	}
+
+	latest, err := s.entClient.SigningKey.Query().
+		Where(signingkey.ExpiresAtGT(now)).
+		Order(ent.Desc(signingkey.FieldActiveAt), ent.Desc(signingkey.FieldID)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("failed to get latest signing key: %w", err)
+	}
+
+	var activeAt time.Time
+	switch {
+	case latest == nil || latest.ExpiresAt.Before(now.Add(overlap)):
+		// No usable key left, the new one has to sign right away
+		activeAt = now
+	case latest.ActiveAt.After(now):
+		// The successor is already published
+		return s.reloadSigningKeys(ctx)
+	case now.After(latest.ActiveAt.Add(rotation - overlap)):
+		// Same activation date on every replica so that concurrent rotations agree on the signer
+		activeAt = latest.ActiveAt.Add(rotation)
+	default:
+		return s.reloadSigningKeys(ctx)
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, signingKeyBits)
+	if err != nil {
+		return fmt.Errorf("failed to generate rsa key: %w", err)
+	}
+	encrypted, err := s.keys.encrypt(key)
+	if err != nil {
+		return err
+	}
+	created, err := s.entClient.SigningKey.Create().
+		SetID(uuid.NewString()).
+		SetAlgorithm(string(signingKeyAlgorithm)).
+		SetPrivateKey(encrypted).
+		SetActiveAt(activeAt).
+		SetExpiresAt(activeAt.Add(rotation + overlap)).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save signing key: %w", err)
+	}
+	s.logger.Info("created signing key %s active at %s", created.ID, created.ActiveAt.Format(time.RFC3339))
+	return s.reloadSigningKeys(ctx)
+}
+
+// signingKeys returns the non expired keys, newest activation first.
+func (s *Storage) signingKeys(ctx context.Context) ([]*signingKey, error) {
+	s.keys.lock.RLock()
+	keys, loadedAt := s.keys.keys, s.keys.loadedAt
+	s.keys.lock.RUnlock()
+	if time.Since(loadedAt) < signingKeysCacheTTL {
+		return keys, nil
+	}
+	if err := s.reloadSigningKeys(ctx); err != nil {
+		return nil, err
+	}
+	s.keys.lock.RLock()
+	defer s.keys.lock.RUnlock()
+	return s.keys.keys, nil
+}
+
+func (s *Storage) reloadSigningKeys(ctx context.Context) error {
+	entKeys, err := s.entClient.SigningKey.Query().
+		Where(signingkey.ExpiresAtGT(time.Now())).
+		Order(ent.Desc(signingkey.FieldActiveAt), ent.Desc(signingkey.FieldID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load signing keys: %w", err)
+	}
+
+	s.keys.lock.Lock()
+	defer s.keys.lock.Unlock()
+	cached := make(map[string]*signingKey, len(s.keys.keys))
+	for _, key := range s.keys.keys {
+		cached[key.id] = key
+	}
+	keys := make([]*signingKey, 0, len(entKeys))
+	for _, entKey := range entKeys {
+		if key, ok := cached[entKey.ID]; ok {
+			keys = append(keys, key)
+			continue
+		}
+		privateKey, err := s.keys.decrypt(entKey.PrivateKey)
+		if err != nil {
+			s.logger.Error("failed to decrypt signing key %s: %s", entKey.ID, err.Error())
+			continue
+		}
+		keys = append(keys, &signingKey{
+			id:        entKey.ID,
+			algorithm: jose.SignatureAlgorithm(entKey.Algorithm),
+			key:       privateKey,
+			activeAt:  entKey.ActiveAt,
+			expiresAt: entKey.ExpiresAt,
+		})
+	}
+	s.keys.keys = keys
+	s.keys.loadedAt = time.Now()
+	return nil
+}
+
+func (k *keyRing) encrypt(key *rsa.PrivateKey) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal signing key: %w", err)
+	}
+	aead, err := k.aead()
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return aead.Seal(nonce, nonce, der, nil), nil
+}
+
+func (k *keyRing) decrypt(data []byte) (*rsa.PrivateKey, error) {
+	aead, err := k.aead()
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < aead.NonceSize() {
+		return nil, errors.New("encrypted signing key is too short")
+	}
+	nonce, ciphertext := data[:aead.NonceSize()], data[aead.NonceSize():]
+	der, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt signing key, was JWT_SECRET changed? %w", err)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse signing key: %w", err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("signing key is not an rsa key")
+	}
+	return rsaKey, nil
+}
+
+func (k *keyRing) aead() (cipher.AEAD, error) {
+	block, err := aes.NewCipher(k.cipherKey[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
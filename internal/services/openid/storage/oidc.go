@@ -1,17 +1,27 @@
 package storage
 
 import (
+	"net/url"
+	"slices"
 	"time"
 
 	"base-website/ent"
+	"base-website/internal/security"
+	configservice "base-website/internal/services/config"
+	rbacservice "base-website/internal/services/rbac"
 
 	"github.com/zitadel/oidc/v3/pkg/oidc"
 	"github.com/zitadel/oidc/v3/pkg/op"
 )
 
 const (
-	// CustomScope is an example for how to use custom scopes in this library
-	CustomScope = "custom_scope"
+	// securityScope grants access to the account security endpoints, it is reserved to the builtin client
+	securityScope = "security"
+
+	// builtinClientID is the client used by the website itself
+	builtinClientID = "builtin"
+	// builtinAccessTokenLifetime keeps website logins alive without refresh tokens
+	builtinAccessTokenLifetime = 30 * 24 * time.Hour
 )
 
 type OIDCCodeChallenge struct {
@@ -69,3 +79,231 @@ func (r *RefreshTokenRequest) GetSubject() string {
 func (r *RefreshTokenRequest) SetCurrentScopes(scopes []string) {
 	r.Scopes = scopes
 }
+
+// AuthRequestFromBusiness wraps the storage AuthRequest to implement the op.AuthRequest interface
+func AuthRequestFromBusiness(authReq *ent.AuthRequest) op.AuthRequest {
+	return &AuthRequest{authReq}
+}
+
+type AuthRequest struct {
+	*ent.AuthRequest
+}
+
+func (a *AuthRequest) GetID() string {
+	return a.ID
+}
+
+func (a *AuthRequest) GetACR() string {
+	return "" // we won't handle acr in this example
+}
+
+func (a *AuthRequest) GetAMR() []string {
+	return a.Amr
+}
+
+func (a *AuthRequest) GetAudience() []string {
+	return []string{a.ApplicationID}
+}
+
+func (a *AuthRequest) GetAuthTime() time.Time {
+	if a.AuthTime == nil {
+		return time.Time{}
+	}
+	return *a.AuthTime
+}
+
+func (a *AuthRequest) GetClientID() string {
+	return a.ApplicationID
+}
+
+func (a *AuthRequest) GetCodeChallenge() *oidc.CodeChallenge {
+	if a.CodeChallenge == "" {
+		return nil
+	}
+	return CodeChallengeToOIDC(&OIDCCodeChallenge{
+		Challenge: a.CodeChallenge,
+		Method:    a.CodeChallengeMethod,
+	})
+}
+
+func (a *AuthRequest) GetNonce() string {
+	return a.Nonce
+}
+
+func (a *AuthRequest) GetRedirectURI() string {
+	return a.RedirectURI
+}
+
+func (a *AuthRequest) GetResponseType() oidc.ResponseType {
+	return oidc.ResponseType(a.ResponseType)
+}
+
+func (a *AuthRequest) GetResponseMode() oidc.ResponseMode {
+	return oidc.ResponseMode(a.ResponseMode)
+}
+
+func (a *AuthRequest) GetScopes() []string {
+	return a.Scopes
+}
+
+func (a *AuthRequest) GetState() string {
+	return a.State
+}
+
+func (a *AuthRequest) GetSubject() string {
+	return a.UserID
+}
+
+func (a *AuthRequest) Done() bool {
+	return a.AuthRequest.Done
+}
+
+// ClientFromBusiness wraps the storage App to implement the op.Client interface
+func ClientFromBusiness(
+	app *ent.App,
+	config *configservice.Config,
+	rbacService rbacservice.RBACService,
+) op.Client {
+	return &Client{app, config, rbacService}
+}
+
+type Client struct {
+	*ent.App
+	config      *configservice.Config
+	rbacService rbacservice.RBACService
+}
+
+func (c *Client) GetID() string {
+	return c.ID
+}
+
+func (c *Client) RedirectURIs() []string {
+	return c.App.RedirectUris
+}
+
+func (c *Client) PostLogoutRedirectURIs() []string {
+	return c.App.RedirectUris
+}
+
+func (c *Client) ApplicationType() op.ApplicationType {
+	return op.ApplicationTypeWeb
+}
+
+// AuthMethod is basic, the token endpoint also accepts the secret as a post parameter
+func (c *Client) AuthMethod() oidc.AuthMethod {
+	return oidc.AuthMethodBasic
+}
+
+func (c *Client) ResponseTypes() []oidc.ResponseType {
+	return []oidc.ResponseType{oidc.ResponseTypeCode}
+}
+
+func (c *Client) GrantTypes() []oidc.GrantType {
+	return []oidc.GrantType{
+		oidc.GrantTypeCode,
+		oidc.GrantTypeRefreshToken,
+		oidc.GrantTypeClientCredentials,
+		oidc.GrantTypeDeviceCode,
+	}
+}
+
+// LoginURL is the page of the website where the user logs in and completes the auth request
+func (c *Client) LoginURL(id string) string {
+	loginURL, err := url.Parse(c.config.LoginURL)
+	if err != nil {
+		return c.config.LoginURL
+	}
+	query := loginURL.Query()
+	query.Set("auth_request_id", id)
+	loginURL.RawQuery = query.Encode()
+	return loginURL.String()
+}
+
+func (c *Client) AccessTokenType() op.AccessTokenType {
+	return op.AccessTokenTypeBearer
+}
+
+func (c *Client) IDTokenLifetime() time.Duration {
+	return time.Hour
+}
+
+// DevMode allows plain http redirect URIs outside of production
+func (c *Client) DevMode() bool {
+	return !c.config.CookieSecure
+}
+
+func (c *Client) RestrictAdditionalIdTokenScopes() func(scopes []string) []string {
+	return func(scopes []string) []string {
+		return scopes
+	}
+}
+
+func (c *Client) RestrictAdditionalAccessTokenScopes() func(scopes []string) []string {
+	return func(scopes []string) []string {
+		return scopes
+	}
+}
+
+// IsScopeAllowed allows the custom scopes granted to the roles of the app
+func (c *Client) IsScopeAllowed(scope string) bool {
+	if !slices.Contains(customScopes, scope) {
+		return false
+	}
+	return c.rbacService.Can(c.Roles, rbacservice.ScopesPathPrefix+scope, "GET")
+}
+
+func (c *Client) IDTokenUserinfoClaimsAssertion() bool {
+	return false
+}
+
+func (c *Client) ClockSkew() time.Duration {
+	return 0
+}
+
+// ClientCredentialsRequest is the token request of an app authenticated with the client_credentials grant,
+// the app is the subject of the issued tokens
+type ClientCredentialsRequest struct {
+	ApplicationID string
+	Scopes        []string
+}
+
+var _ op.TokenRequest = (*ClientCredentialsRequest)(nil)
+
+func (r *ClientCredentialsRequest) GetSubject() string {
+	return security.ClientSubject(r.ApplicationID)
+}
+
+func (r *ClientCredentialsRequest) GetAudience() []string {
+	return []string{r.ApplicationID}
+}
+
+func (r *ClientCredentialsRequest) GetScopes() []string {
+	return r.Scopes
+}
+
+// ImpersonationRequest is the token request of a super admin acting as a user on the website,
+// the super admin is the actor of the issued token
+type ImpersonationRequest struct {
+	Subject string
+	Actor   string
+	Scopes  []string
+}
+
+var _ op.TokenRequest = (*ImpersonationRequest)(nil)
+var _ op.TokenActorRequest = (*ImpersonationRequest)(nil)
+
+func (r *ImpersonationRequest) GetSubject() string {
+	return r.Subject
+}
+
+func (r *ImpersonationRequest) GetAudience() []string {
+	return []string{builtinClientID}
+}
+
+func (r *ImpersonationRequest) GetScopes() []string {
+	return r.Scopes
+}
+
+func (r *ImpersonationRequest) GetActor() *oidc.ActorClaims {
+	return &oidc.ActorClaims{Subject: r.Actor}
+}
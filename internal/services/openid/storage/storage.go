@@ -2,18 +2,24 @@ package storage
 
 import (
 	"context"
-	"crypto/rand"
-	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"base-website/ent"
+	"base-website/ent/app"
+	"base-website/ent/authcode"
 	"base-website/ent/authrefreshtoken"
 	"base-website/ent/authtoken"
+	"base-website/internal/security"
 	configservice "base-website/internal/services/config"
+	rbacservice "base-website/internal/services/rbac"
 	"base-website/pkg/logger"
 
 	jose "github.com/go-jose/go-jose/v4"
@@ -24,105 +30,204 @@ import (
 	"github.com/zitadel/oidc/v3/pkg/op"
 )
 
-func NewStorage(entClient *ent.Client, config *configservice.Config) (op.Storage, error) {
-	key, err := rsa.GenerateKey(rand.Reader, 2048)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate rsa key: %w", err)
-	}
-	return &Storage{
-		entClient: entClient,
-		config:    config,
-		logger:    logger.New().WithContext("OpenIDStorage"),
-		signingKey: signingKey{
-			id:        "key-id",
-			algorithm: jose.RS256,
-			key:       key,
+func NewStorage(
+	entClient *ent.Client,
+	config *configservice.Config,
+	rbacService rbacservice.RBACService,
+) (*Storage, error) {
+	storage := &Storage{
+		entClient:   entClient,
+		config:      config,
+		rbacService: rbacService,
+		logger:      logger.New().WithContext("OpenIDStorage"),
+		keys: keyRing{
+			cipherKey: sha256.Sum256([]byte(signingKeysCipherContext + config.JWTSecret)),
 		},
-	}, nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := storage.RotateSigningKeys(ctx); err != nil {
+		return nil, fmt.Errorf("failed to initialize signing keys: %w", err)
+	}
+	return storage, nil
 }
 
 // storage implements the op.Storage interface
 // typically you would implement this as a layer on top of your database
 // for simplicity this example keeps everything in-memory
 type Storage struct {
-	entClient  *ent.Client
-	config     *configservice.Config
-	lock       sync.Mutex
-	signingKey signingKey
-	logger     *logger.Logger
+	entClient   *ent.Client
+	config      *configservice.Config
+	rbacService rbacservice.RBACService
+	lock        sync.Mutex
+	keys        keyRing
+	logger      *logger.Logger
 }
 
 // AuthorizeClientIDSecret implements op.Storage.
+// it will be called for validating the client_id, client_secret on token or introspection requests
 func (s *Storage) AuthorizeClientIDSecret(ctx context.Context, clientID string, clientSecret string) error {
-	panic("unimplemented")
+	app, err := s.entClient.App.Get(ctx, clientID)
+	if err != nil {
+		return fmt.Errorf("client not found")
+	}
+	if subtle.ConstantTimeCompare([]byte(app.Secret), []byte(clientSecret)) != 1 {
+		return fmt.Errorf("invalid secret")
+	}
+	return nil
+}
+
+// ClientCredentials implements op.ClientCredentialsStorage.
+// it will be called for validating the client_id, client_secret of a client_credentials grant
+func (s *Storage) ClientCredentials(ctx context.Context, clientID, clientSecret string) (op.Client, error) {
+	if err := s.AuthorizeClientIDSecret(ctx, clientID, clientSecret); err != nil {
+		return nil, oidc.ErrInvalidClient().WithParent(err)
+	}
+	return s.GetClientByClientID(ctx, clientID)
+}
+
+// ClientCredentialsTokenRequest implements op.ClientCredentialsStorage.
+// the app acts on its own behalf, so user only scopes are dropped and its permissions come from App.roles
+func (s *Storage) ClientCredentialsTokenRequest(
+	ctx context.Context,
+	clientID string,
+	scopes []string,
+) (op.TokenRequest, error) {
+	granted := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		switch scope {
+		case oidc.ScopeOpenID, oidc.ScopeOfflineAccess, securityScope:
+			continue
+		}
+		granted = append(granted, scope)
+	}
+	if len(granted) == 0 {
+		granted = append(granted, oidc.ScopeProfile)
+	}
+	return &ClientCredentialsRequest{
+		ApplicationID: clientID,
+		Scopes:        granted,
+	}, nil
 }
 
 // AuthRequestByCode implements op.Storage.
-func (s *Storage) AuthRequestByCode(context.Context, string) (op.AuthRequest, error) {
-	panic("unimplemented")
+// it will be called after parsing and validation of the token request (in an authorization code flow)
+func (s *Storage) AuthRequestByCode(ctx context.Context, code string) (op.AuthRequest, error) {
+	authCode, err := s.entClient.AuthCode.Get(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("code invalid or expired")
+	}
+	if authCode.Expiration.Before(time.Now()) {
+		return nil, fmt.Errorf("code invalid or expired")
+	}
+	return s.AuthRequestByID(ctx, authCode.AuthRequestID)
 }
 
 // AuthRequestByID implements op.Storage.
-func (s *Storage) AuthRequestByID(context.Context, string) (op.AuthRequest, error) {
-	panic("unimplemented")
+// it will be called after the Login UI redirects back to the OIDC endpoint
+func (s *Storage) AuthRequestByID(ctx context.Context, id string) (op.AuthRequest, error) {
+	authReq, err := s.entClient.AuthRequest.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("request not found")
+	}
+	if authReq.Expiration.Before(time.Now()) {
+		return nil, fmt.Errorf("request expired")
+	}
+	return AuthRequestFromBusiness(authReq), nil
 }
 
 // CreateAccessToken implements op.Storage.
-func (s *Storage) CreateAccessToken(context.Context, op.TokenRequest) (accessTokenID string, expiration time.Time, err error) {
-	panic("unimplemented")
+// it will be called for all requests able to return an access token (Authorization Code Flow, Implicit Flow, JWT Profile, ...)
+func (s *Storage) CreateAccessToken(ctx context.Context, request op.TokenRequest) (accessTokenID string, expiration time.Time, err error) {
+	applicationID, _, _ := getInfoFromRequest(request)
+	actor := tokenActor(request)
+	var notAfter time.Time
+	if actor != "" {
+		// Impersonation tokens are short-lived whatever the app they are issued to
+		notAfter = time.Now().Add(time.Duration(s.config.OpenIDImpersonationTokenLifetime) * time.Second)
+	}
+	token, err := s.accessToken(
+		ctx,
+		applicationID,
+		"",
+		request.GetSubject(),
+		actor,
+		request.GetAudience(),
+		request.GetScopes(),
+		notAfter,
+	)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if device, ok := request.(*op.DeviceAuthorizationState); ok {
+		if err := s.consumeDeviceAuthorization(ctx, device, token.ID, ""); err != nil {
+			return "", time.Time{}, err
+		}
+	}
+	return token.ID, token.Expiration, nil
 }
 
 // CreateAuthRequest implements op.Storage.
-func (s *Storage) CreateAuthRequest(context.Context, *oidc.AuthRequest, string) (op.AuthRequest, error) {
-	panic("unimplemented")
+// it will be called after parsing and validation of the authentication request
+func (s *Storage) CreateAuthRequest(ctx context.Context, authReq *oidc.AuthRequest, userID string) (op.AuthRequest, error) {
+	if len(authReq.Prompt) == 1 && authReq.Prompt[0] == "none" {
+		// With prompt=none, there is no way for the user to log in
+		// so return error right away.
+		return nil, oidc.ErrLoginRequired()
+	}
+
+	create := s.entClient.AuthRequest.Create().
+		SetID(uuid.NewString()).
+		SetApplicationID(authReq.ClientID).
+		SetRedirectURI(authReq.RedirectURI).
+		SetState(authReq.State).
+		SetNonce(authReq.Nonce).
+		SetScopes(authReq.Scopes).
+		SetPrompt(authReq.Prompt).
+		SetResponseType(string(authReq.ResponseType)).
+		SetResponseMode(string(authReq.ResponseMode)).
+		SetLoginHint(authReq.LoginHint).
+		SetUserID(userID)
+	if authReq.CodeChallenge != "" {
+		create.
+			SetCodeChallenge(authReq.CodeChallenge).
+			SetCodeChallengeMethod(string(authReq.CodeChallengeMethod))
+	}
+	if authReq.MaxAge != nil {
+		create.SetMaxAuthAge(int64(*authReq.MaxAge))
+	}
+
+	request, err := create.Save(ctx)
+	if err != nil {
+		s.logger.Error("failed to save auth request: %v", err)
+		return nil, oidc.ErrServerError().WithDescription("failed to save auth request")
+	}
+	return AuthRequestFromBusiness(request), nil
 }
 
 // DeleteAuthRequest implements op.Storage.
-func (s *Storage) DeleteAuthRequest(context.Context, string) error {
-	panic("unimplemented")
+// it will be called after creating the token response (id and access tokens) for a valid
+// authentication request (in an authorization code flow)
+func (s *Storage) DeleteAuthRequest(ctx context.Context, id string) error {
+	_, err := s.entClient.AuthCode.Delete().Where(authcode.AuthRequestID(id)).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete auth codes: %w", err)
+	}
+	err = s.entClient.AuthRequest.DeleteOneID(id).Exec(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("failed to delete auth request: %w", err)
+	}
+	return nil
 }
 
 // GetClientByClientID implements op.Storage.
+// it will be called whenever information (type, redirect_uris, ...) about the client behind the client_id is needed
 func (s *Storage) GetClientByClientID(ctx context.Context, clientID string) (op.Client, error) {
-	panic("unimplemented")
-}
-
-type signingKey struct {
-	id        string
-	algorithm jose.SignatureAlgorithm
-	key       *rsa.PrivateKey
-}
-
-func (s *signingKey) SignatureAlgorithm() jose.SignatureAlgorithm {
-	return s.algorithm
-}
-
-func (s *signingKey) Key() any {
-	return s.key
-}
-
-func (s *signingKey) ID() string {
-	return s.id
-}
-
-type publicKey struct {
-	signingKey
-}
-
-func (s *publicKey) ID() string {
-	return s.id
-}
-
-func (s *publicKey) Algorithm() jose.SignatureAlgorithm {
-	return s.algorithm
-}
-
-func (s *publicKey) Use() string {
-	return "sig"
-}
-
-func (s *publicKey) Key() any {
-	return &s.key.PublicKey
+	app, err := s.entClient.App.Get(ctx, clientID)
+	if err != nil {
+		return nil, fmt.Errorf("client not found")
+	}
+	return ClientFromBusiness(app, s.config, s.rbacService), nil
 }
 
 func (s *Storage) JWTProfileTokenType(
@@ -158,74 +263,82 @@ func (s *Storage) CreateAccessAndRefreshTokens(
 ) (accessTokenID string, newRefreshToken string, expiration time.Time, err error) {
 	// generate tokens via token exchange flow if request is relevant
 	if teReq, ok := request.(op.TokenExchangeRequest); ok {
-		return s.exchangeRefreshToken(teReq)
+		return s.exchangeRefreshToken(ctx, teReq)
 	}
 
 	// get the information depending on the request type / implementation
 	applicationID, authTime, amr := getInfoFromRequest(request)
 
-	// if currentRefreshToken is empty (Code Flow) we will have to create a new refresh token
+	// if currentRefreshToken is empty (Code Flow) we will have to create a new refresh token family
 	if currentRefreshToken == "" {
-		refreshTokenID := uuid.NewString()
-		accessToken, err := s.accessToken(
-			applicationID,
-			refreshTokenID,
-			request.GetSubject(),
-			request.GetAudience(),
-			request.GetScopes(),
-		)
+		accessTokenID, newRefreshToken, expiration, err := s.newRefreshTokenFamily(ctx, request, applicationID, amr, authTime)
 		if err != nil {
 			return "", "", time.Time{}, err
 		}
-		refreshToken, err := s.createRefreshToken(accessToken, amr, authTime)
-		if err != nil {
-			return "", "", time.Time{}, err
+		if device, ok := request.(*op.DeviceAuthorizationState); ok {
+			if err := s.consumeDeviceAuthorization(ctx, device, accessTokenID, newRefreshToken); err != nil {
+				return "", "", time.Time{}, err
+			}
 		}
-		return accessToken.ID, refreshToken, accessToken.Expiration, nil
+		return accessTokenID, newRefreshToken, expiration, nil
 	}
 
 	// if we get here, the currentRefreshToken was not empty, so the call is a refresh token request
 	// we therefore will have to check the currentRefreshToken and renew the refresh token
-	refreshToken, refreshTokenID, err := s.renewRefreshToken(currentRefreshToken)
+	refreshToken, err := s.renewRefreshToken(ctx, currentRefreshToken)
 	if err != nil {
 		return "", "", time.Time{}, err
 	}
 	accessToken, err := s.accessToken(
+		ctx,
 		applicationID,
-		refreshTokenID,
+		refreshToken.ID,
 		request.GetSubject(),
+		"",
 		request.GetAudience(),
 		request.GetScopes(),
+		refreshToken.SessionExpiration,
 	)
 	if err != nil {
 		return "", "", time.Time{}, err
 	}
-	return accessToken.ID, refreshToken, accessToken.Expiration, nil
+	return accessToken.ID, refreshToken.Token, accessToken.Expiration, nil
 }
 
 func (s *Storage) exchangeRefreshToken(
+	ctx context.Context,
 	request op.TokenExchangeRequest,
 ) (accessTokenID string, newRefreshToken string, expiration time.Time, err error) {
-	applicationID := request.GetClientID()
-	authTime := request.GetAuthTime()
+	return s.newRefreshTokenFamily(ctx, request, request.GetClientID(), nil, request.GetAuthTime())
+}
 
+// newRefreshTokenFamily starts a session: an access token and the first refresh token of a new family
+func (s *Storage) newRefreshTokenFamily(
+	ctx context.Context,
+	request op.TokenRequest,
+	applicationID string,
+	amr []string,
+	authTime time.Time,
+) (accessTokenID string, newRefreshToken string, expiration time.Time, err error) {
 	refreshTokenID := uuid.NewString()
+	sessionExpiration := time.Now().Add(time.Duration(s.config.OpenIDSessionMaxLifetime) * time.Second)
 	accessToken, err := s.accessToken(
+		ctx,
 		applicationID,
 		refreshTokenID,
 		request.GetSubject(),
+		"",
 		request.GetAudience(),
 		request.GetScopes(),
+		sessionExpiration,
 	)
 	if err != nil {
 		return "", "", time.Time{}, err
 	}
-
-	refreshToken, err := s.createRefreshToken(accessToken, nil, authTime)
+	refreshToken, err := s.createRefreshToken(ctx, accessToken, amr, authTime, sessionExpiration)
 	if err != nil {
 		return "", "", time.Time{}, err
 	}
-
 	return accessToken.ID, refreshToken, accessToken.Expiration, nil
 }
 
@@ -235,12 +348,17 @@ func (s *Storage) TokenRequestByRefreshToken(
 	ctx context.Context,
 	refreshToken string,
 ) (op.RefreshTokenRequest, error) {
-	s.lock.Lock()
-	defer s.lock.Unlock()
 	token, err := s.entClient.AuthRefreshToken.Get(ctx, refreshToken)
 	if err != nil {
 		return nil, fmt.Errorf("invalid refresh_token")
 	}
+	if token.Used {
+		s.revokeReusedRefreshToken(ctx, token)
+		return nil, fmt.Errorf("invalid refresh_token")
+	}
+	if token.Expiration.Before(time.Now()) {
+		return nil, fmt.Errorf("refresh_token expired")
+	}
 	return RefreshTokenRequestFromBusiness(token), nil
 }
 
@@ -249,12 +367,22 @@ func (s *Storage) TokenRequestByRefreshToken(
 func (s *Storage) TerminateSession(ctx context.Context, userID string, clientID string) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
-	_, err := s.entClient.AuthToken.Delete().Where(authtoken.Subject(userID)).Exec(ctx)
+	_, err := s.entClient.AuthToken.Delete().
+		Where(
+			authtoken.Subject(userID),
+			authtoken.ApplicationID(clientID),
+		).
+		Exec(ctx)
 	if err != nil {
 		s.logger.Error("failed to delete tokens: %v", err)
 		return fmt.Errorf("failed to delete tokens: %w", err)
 	}
-	_, err = s.entClient.AuthRefreshToken.Delete().Where(authrefreshtoken.UserID(userID)).Exec(ctx)
+	_, err = s.entClient.AuthRefreshToken.Delete().
+		Where(
+			authrefreshtoken.UserID(userID),
+			authrefreshtoken.ApplicationID(clientID),
+		).
+		Exec(ctx)
 	if err != nil {
 		s.logger.Error("failed to delete refresh tokens: %v", err)
 		return fmt.Errorf("failed to delete refresh tokens: %w", err)
@@ -278,61 +406,96 @@ func (s *Storage) GetRefreshTokenInfo(
 }
 
 // RevokeToken implements the op.Storage interface
-// it will be called after parsing and validation of the token revocation request
+// it will be called after parsing and validation of the token revocation request,
+// tokenIDOrToken is either the ID of an access token or the ID of a refresh token (RFC 7009)
 func (s *Storage) RevokeToken(
 	ctx context.Context,
 	tokenIDOrToken string,
 	userID string,
 	clientID string,
 ) *oidc.Error {
-	// a single token was requested to be removed
 	s.lock.Lock()
 	defer s.lock.Unlock()
+
+	refreshTokenID := ""
 	accessToken, err := s.entClient.AuthToken.Get(ctx, tokenIDOrToken)
-	if err != nil {
-		return oidc.ErrAccessDenied().WithDescription("token not found")
+	if err == nil {
+		if accessToken.ApplicationID != clientID {
+			return oidc.ErrInvalidClient().WithDescription("token was not issued for this client")
+		}
+		err = s.entClient.AuthToken.DeleteOneID(accessToken.ID).Exec(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return oidc.ErrServerError().WithDescription("failed to delete token")
+		}
+		refreshTokenID = accessToken.RefreshTokenID
+	} else {
+		refreshToken, err := s.entClient.AuthRefreshToken.Get(ctx, tokenIDOrToken)
+		if err != nil {
+			// Invalid tokens do not cause an error response, see RFC 7009 section 2.2
+			return nil
+		}
+		if refreshToken.ApplicationID != clientID {
+			return oidc.ErrInvalidClient().WithDescription("token was not issued for this client")
+		}
+		refreshTokenID = refreshToken.ID
 	}
-	if accessToken.ApplicationID != clientID {
-		return oidc.ErrInvalidClient().WithDescription("token was not issued for this client")
+	if refreshTokenID == "" {
+		return nil
 	}
-	refreshtoken, _ := s.entClient.AuthRefreshToken.Get(ctx, accessToken.RefreshTokenID)
 
-	err = s.entClient.AuthToken.DeleteOneID(tokenIDOrToken).Exec(ctx)
+	// Revoking one token of a grant revokes the whole refresh token family
+	refreshToken, err := s.entClient.AuthRefreshToken.Get(ctx, refreshTokenID)
+	if ent.IsNotFound(err) {
+		return nil
+	}
 	if err != nil {
-		return oidc.ErrServerError().WithDescription("failed to delete token")
+		return oidc.ErrServerError().WithDescription("failed to get token")
 	}
-	if refreshtoken != nil {
-		err = s.entClient.AuthRefreshToken.DeleteOneID(accessToken.RefreshTokenID).Exec(ctx)
-		if err != nil {
-			return oidc.ErrServerError().WithDescription("failed to delete refresh token")
-		}
+	if err := s.revokeRefreshTokenFamily(ctx, refreshToken.FamilyID); err != nil {
+		s.logger.Error("failed to revoke refresh token family: %v", err)
+		return oidc.ErrServerError().WithDescription("failed to delete token")
 	}
 	return nil
 }
 
 // SigningKey implements the op.Storage interface
-// it will be called when creating the OpenID Provider
+// it will be called when creating the OpenID Provider and to sign tokens, it returns the currently active key
 func (s *Storage) SigningKey(ctx context.Context) (op.SigningKey, error) {
-	// in this example the signing key is a static rsa.PrivateKey and the algorithm used is RS256
-	// you would obviously have a more complex implementation and store / retrieve the key from your database as well
-	return &s.signingKey, nil
+	keys, err := s.signingKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for _, key := range keys {
+		if !key.activeAt.After(now) && key.expiresAt.After(now) {
+			return key, nil
+		}
+	}
+	return nil, errors.New("no active signing key")
 }
 
 // SignatureAlgorithms implements the op.Storage interface
 // it will be called to get the sign
 func (s *Storage) SignatureAlgorithms(context.Context) ([]jose.SignatureAlgorithm, error) {
-	return []jose.SignatureAlgorithm{s.signingKey.algorithm}, nil
+	return []jose.SignatureAlgorithm{signingKeyAlgorithm}, nil
 }
 
 // KeySet implements the op.Storage interface
 // it will be called to get the current (public) keys, among others for the keys_endpoint or for validating access_tokens on the userinfo_endpoint, ...
+// keys that are not active yet or already retired are published as well until they expire
 func (s *Storage) KeySet(ctx context.Context) ([]op.Key, error) {
-	// as mentioned above, this example only has a single signing key without key rotation,
-	// so it will directly use its public key
-	//
-	// when using key rotation you typically would store the public keys alongside the private keys in your database
-	// and give both of them an expiration date, with the public key having a longer lifetime
-	return []op.Key{&publicKey{s.signingKey}}, nil
+	keys, err := s.signingKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	set := make([]op.Key, 0, len(keys))
+	for _, key := range keys {
+		if key.expiresAt.After(now) {
+			set = append(set, &publicKey{key})
+		}
+	}
+	return set, nil
 }
 
 // SetUserinfoFromScopes implements the op.Storage interface.
@@ -374,7 +537,8 @@ func (s *Storage) SetUserinfoFromToken(
 }
 
 // SetIntrospectionFromToken implements the op.Storage interface
-// it will be called for the introspection endpoint, so we read the token and pass the information from that to the private function
+// it will be called for the introspection endpoint, so we read the token and pass the information from that to the private function.
+// A client can only introspect the tokens issued to it or for which it is an audience
 func (s *Storage) SetIntrospectionFromToken(
 	ctx context.Context,
 	introspection *oidc.IntrospectionResponse,
@@ -387,14 +551,28 @@ func (s *Storage) SetIntrospectionFromToken(
 	if token.Expiration.Before(time.Now()) {
 		return oidc.ErrAccessDenied().WithDescription("token expired")
 	}
-	userInfo := new(oidc.UserInfo)
-	err = s.setUserinfo(ctx, userInfo, subject, token.Scopes)
-	if err != nil {
-		return err
+	if token.ApplicationID != clientID && !slices.Contains(token.Audience, clientID) {
+		return oidc.ErrAccessDenied().WithDescription("token was not issued for this client")
+	}
+	if !strings.HasPrefix(token.Subject, security.ClientSubjectPrefix) {
+		userInfo := new(oidc.UserInfo)
+		err = s.setUserinfo(ctx, userInfo, token.Subject, token.Scopes)
+		if err != nil {
+			return err
+		}
+		introspection.SetUserInfo(userInfo)
 	}
-	introspection.SetUserInfo(userInfo)
+	introspection.Subject = token.Subject
 	introspection.Scope = token.Scopes
 	introspection.ClientID = token.ApplicationID
+	introspection.Audience = token.Audience
+	introspection.TokenType = oidc.BearerToken
+	introspection.Expiration = oidc.FromTime(token.Expiration)
+	introspection.IssuedAt = oidc.FromTime(token.CreatedAt)
+	introspection.JWTID = token.ID
+	if token.Actor != nil {
+		introspection.Actor = &oidc.ActorClaims{Subject: *token.Actor}
+	}
 	return nil
 }
 
@@ -405,7 +583,7 @@ func (s *Storage) GetPrivateClaimsFromScopes(
 	userID, clientID string,
 	scopes []string,
 ) (claims map[string]any, err error) {
-	return map[string]any{}, nil
+	return s.privateClaims(ctx, userID, scopes)
 }
 
 // GetKeyByIDAndClientID implements the op.Storage interface
@@ -438,26 +616,30 @@ func (s *Storage) Health(ctx context.Context) error {
 	return nil
 }
 
-// createRefreshToken will store a refresh_token in-memory based on the provided information
+// createRefreshToken stores the first refresh token of a family for the access token.
+// The family gets its own random ID, it is shown as the session ID and must never be a usable token
 func (s *Storage) createRefreshToken(
+	ctx context.Context,
 	accessToken *ent.AuthToken,
 	amr []string,
 	authTime time.Time,
+	sessionExpiration time.Time,
 ) (string, error) {
-	s.lock.Lock()
-	defer s.lock.Unlock()
+	_, refreshLifetime := s.tokenLifetimes(ctx, accessToken.ApplicationID)
 	token, err := s.entClient.AuthRefreshToken.Create().
 		SetID(accessToken.RefreshTokenID).
 		SetToken(accessToken.RefreshTokenID).
+		SetFamilyID(uuid.NewString()).
 		SetAuthTime(authTime).
 		SetAmr(amr).
 		SetApplicationID(accessToken.ApplicationID).
 		SetUserID(accessToken.Subject).
 		SetAudience(accessToken.Audience).
-		SetExpiration(time.Now().Add(5 * time.Hour)).
+		SetExpiration(earliest(time.Now().Add(refreshLifetime), sessionExpiration)).
+		SetSessionExpiration(sessionExpiration).
 		SetScopes(accessToken.Scopes).
 		SetSubject(accessToken.Subject).
-		Save(context.Background())
+		Save(ctx)
 	if err != nil {
 		s.logger.Error("failed to save refresh token: %v", err)
 		return "", fmt.Errorf("failed to save refresh token: %w", err)
@@ -465,54 +647,120 @@ func (s *Storage) createRefreshToken(
 	return token.Token, nil
 }
 
-// renewRefreshToken checks the provided refresh_token and creates a new one based on the current
-func (s *Storage) renewRefreshToken(currentRefreshToken string) (string, string, error) {
-	s.lock.Lock()
-	defer s.lock.Unlock()
-	refreshToken, err := s.entClient.AuthRefreshToken.Get(context.Background(), currentRefreshToken)
+// renewRefreshToken rotates the provided refresh_token, the new one belongs to the same family.
+// The rotated token is kept as used until it expires so that a replay can be detected
+func (s *Storage) renewRefreshToken(
+	ctx context.Context,
+	currentRefreshToken string,
+) (*ent.AuthRefreshToken, error) {
+	refreshToken, err := s.entClient.AuthRefreshToken.Get(ctx, currentRefreshToken)
+	if err != nil {
+		return nil, oidc.ErrInvalidGrant().WithDescription("refresh token not found")
+	}
+	if refreshToken.Expiration.Before(time.Now()) {
+		return nil, oidc.ErrInvalidGrant().WithDescription("refresh token expired")
+	}
+	// Marking the token used is atomic so that two concurrent refreshes cannot both succeed
+	updated, err := s.entClient.AuthRefreshToken.Update().
+		Where(
+			authrefreshtoken.ID(refreshToken.ID),
+			authrefreshtoken.Used(false),
+		).
+		SetUsed(true).
+		Save(ctx)
 	if err != nil {
-		return "", "", oidc.ErrAccessDenied().WithDescription("refresh token not found")
+		s.logger.Error("failed to rotate refresh token: %v", err)
+		return nil, fmt.Errorf("failed to rotate refresh token: %w", err)
 	}
+	if updated == 0 {
+		s.revokeReusedRefreshToken(ctx, refreshToken)
+		return nil, oidc.ErrInvalidGrant().WithDescription("refresh token already used")
+	}
+
+	_, refreshLifetime := s.tokenLifetimes(ctx, refreshToken.ApplicationID)
 	refreshTokenID := uuid.NewString()
-	_, err = s.entClient.AuthRefreshToken.Create().
+	renewed, err := s.entClient.AuthRefreshToken.Create().
 		SetID(refreshTokenID).
 		SetToken(refreshTokenID).
+		SetFamilyID(refreshToken.FamilyID).
 		SetAuthTime(refreshToken.AuthTime).
 		SetAmr(refreshToken.Amr).
 		SetApplicationID(refreshToken.ApplicationID).
 		SetUserID(refreshToken.UserID).
 		SetAudience(refreshToken.Audience).
-		SetExpiration(time.Now().Add(5 * time.Hour)).
+		SetExpiration(earliest(time.Now().Add(refreshLifetime), refreshToken.SessionExpiration)).
+		SetSessionExpiration(refreshToken.SessionExpiration).
 		SetScopes(refreshToken.Scopes).
-		Save(context.Background())
+		SetSubject(refreshToken.Subject).
+		Save(ctx)
 	if err != nil {
 		s.logger.Error("failed to save refresh token: %v", err)
-		return "", "", fmt.Errorf("failed to save refresh token: %w", err)
+		return nil, fmt.Errorf("failed to save refresh token: %w", err)
+	}
+	return renewed, nil
+}
+
+// revokeReusedRefreshToken revokes the family of a refresh token presented after its rotation,
+// either the client or an attacker holds a stolen token and the session can no longer be trusted
+func (s *Storage) revokeReusedRefreshToken(ctx context.Context, refreshToken *ent.AuthRefreshToken) {
+	s.logger.Warn(
+		"refresh token reuse detected for subject %s on app %s, revoking family %s",
+		refreshToken.Subject,
+		refreshToken.ApplicationID,
+		refreshToken.FamilyID,
+	)
+	if err := s.revokeRefreshTokenFamily(ctx, refreshToken.FamilyID); err != nil {
+		s.logger.Error("failed to revoke refresh token family: %v", err)
+	}
+}
+
+// revokeRefreshTokenFamily deletes every refresh token of the family and the access tokens issued from them
+func (s *Storage) revokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	refreshTokenIDs, err := s.entClient.AuthRefreshToken.Query().
+		Where(authrefreshtoken.FamilyID(familyID)).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query refresh tokens: %w", err)
+	}
+	_, err = s.entClient.AuthToken.Delete().
+		Where(authtoken.RefreshTokenIDIn(refreshTokenIDs...)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete access tokens: %w", err)
+	}
+	_, err = s.entClient.AuthRefreshToken.Delete().
+		Where(authrefreshtoken.FamilyID(familyID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete refresh tokens: %w", err)
 	}
-	_ = s.entClient.AuthRefreshToken.DeleteOneID(currentRefreshToken).Exec(context.Background())
-	return refreshTokenID, refreshToken.Token, nil
+	return nil
 }
 
-// accessToken will store an access_token in-memory based on the provided information
+// accessToken stores an access_token, its expiration never goes past notAfter when set
 func (s *Storage) accessToken(
-	applicationID, refreshTokenID, subject string,
+	ctx context.Context,
+	applicationID, refreshTokenID, subject, actor string,
 	audience, scopes []string,
+	notAfter time.Time,
 ) (*ent.AuthToken, error) {
-	s.lock.Lock()
-	defer s.lock.Unlock()
-	expiration := time.Now().Add(5 * time.Minute)
-	if applicationID == "builtin" {
-		expiration = time.Now().Add(30 * 24 * time.Hour)
+	accessLifetime, _ := s.tokenLifetimes(ctx, applicationID)
+	expiration := time.Now().Add(accessLifetime)
+	if !notAfter.IsZero() {
+		expiration = earliest(expiration, notAfter)
 	}
-	token, err := s.entClient.AuthToken.Create().
+	create := s.entClient.AuthToken.Create().
 		SetID(uuid.NewString()).
 		SetApplicationID(applicationID).
 		SetRefreshTokenID(refreshTokenID).
 		SetSubject(subject).
 		SetAudience(audience).
 		SetExpiration(expiration).
-		SetScopes(scopes).
-		Save(context.Background())
+		SetScopes(scopes)
+	if actor != "" {
+		create.SetActor(actor)
+	}
+	token, err := create.Save(ctx)
 	if err != nil {
 		s.logger.Error("failed to save access token: %v", err)
 		return nil, fmt.Errorf("failed to save access token: %w", err)
@@ -520,6 +768,36 @@ func (s *Storage) accessToken(
 	return token, nil
 }
 
+// tokenLifetimes returns the access and refresh token lifetimes of the app, falling back to the config defaults
+func (s *Storage) tokenLifetimes(ctx context.Context, applicationID string) (time.Duration, time.Duration) {
+	accessLifetime := time.Duration(s.config.OpenIDAccessTokenLifetime) * time.Second
+	refreshLifetime := time.Duration(s.config.OpenIDRefreshTokenLifetime) * time.Second
+	if applicationID == builtinClientID {
+		return builtinAccessTokenLifetime, refreshLifetime
+	}
+	appEnt, err := s.entClient.App.Query().
+		Where(app.ID(applicationID)).
+		Select(app.FieldAccessTokenLifetime, app.FieldRefreshTokenLifetime).
+		Only(ctx)
+	if err != nil {
+		return accessLifetime, refreshLifetime
+	}
+	if appEnt.AccessTokenLifetime != nil {
+		accessLifetime = time.Duration(*appEnt.AccessTokenLifetime) * time.Second
+	}
+	if appEnt.RefreshTokenLifetime != nil {
+		refreshLifetime = time.Duration(*appEnt.RefreshTokenLifetime) * time.Second
+	}
+	return accessLifetime, refreshLifetime
+}
+
+func earliest(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
 // setUserinfo sets the info based on the user, scopes and if necessary the clientID
 func (s *Storage) setUserinfo(
 	ctx context.Context,
@@ -554,6 +832,13 @@ func (s *Storage) setUserinfo(
 			}
 		}
 	}
+	claims, err := s.privateClaims(ctx, userID, scopes)
+	if err != nil {
+		return err
+	}
+	for key, value := range claims {
+		userInfo.AppendClaims(key, value)
+	}
 	return nil
 }
 
@@ -563,6 +848,10 @@ func (s *Storage) ValidateTokenExchangeRequest(
 	ctx context.Context,
 	request op.TokenExchangeRequest,
 ) error {
+	if err := s.validateExchangeImpersonation(ctx, request); err != nil {
+		return err
+	}
+
 	if request.GetRequestedTokenType() == "" {
 		request.SetRequestedTokenType(oidc.RefreshTokenType)
 	}
@@ -578,6 +867,47 @@ func (s *Storage) ValidateTokenExchangeRequest(
 	return nil
 }
 
+// validateExchangeImpersonation prevents an exchange from extending an impersonation,
+// an exchange with an actor token is an impersonation that only a super admin can start
+func (s *Storage) validateExchangeImpersonation(
+	ctx context.Context,
+	request op.TokenExchangeRequest,
+) error {
+	exchangedTokenIDs := []string{request.GetExchangeSubjectTokenIDOrToken()}
+	if request.GetExchangeActorTokenIDOrToken() != "" {
+		exchangedTokenIDs = append(exchangedTokenIDs, request.GetExchangeActorTokenIDOrToken())
+	}
+	impersonated, err := s.entClient.AuthToken.Query().
+		Where(authtoken.IDIn(exchangedTokenIDs...), authtoken.ActorNotNil()).
+		Exist(ctx)
+	if err != nil {
+		return oidc.ErrServerError().WithDescription("failed to check the exchanged tokens")
+	}
+	if impersonated {
+		return oidc.ErrInvalidRequest().WithDescription("impersonation tokens cannot be exchanged")
+	}
+
+	actor := tokenActor(request)
+	if actor == "" {
+		return nil
+	}
+	if request.GetRequestedTokenType() == "" {
+		request.SetRequestedTokenType(oidc.AccessTokenType)
+	}
+	if request.GetRequestedTokenType() != oidc.AccessTokenType {
+		return oidc.ErrInvalidRequest().WithDescription("impersonation tokens can only be access tokens")
+	}
+	actorID, err := strconv.Atoi(actor)
+	if err != nil {
+		return oidc.ErrAccessDenied().WithDescription("only users can impersonate")
+	}
+	roles, err := s.rbacService.GetUserRoles(ctx, actorID)
+	if err != nil || !slices.Contains(roles, "super_admin") {
+		return oidc.ErrAccessDenied().WithDescription("only super admins can impersonate a user")
+	}
+	return nil
+}
+
 // ValidateTokenExchangeRequest implements the op.TokenExchangeStorage interface
 // Common use case is to store request for audit purposes. For this example we skip the storing.
 func (s *Storage) CreateTokenExchangeRequest(
@@ -594,7 +924,11 @@ func (s *Storage) GetPrivateClaimsFromTokenExchangeRequest(
 	ctx context.Context,
 	request op.TokenExchangeRequest,
 ) (claims map[string]any, err error) {
-	return make(map[string]any), nil
+	claims = make(map[string]any)
+	if actor := tokenActor(request); actor != "" {
+		claims["act"] = &oidc.ActorClaims{Subject: actor}
+	}
+	return claims, nil
 }
 
 // SetUserinfoFromScopesForTokenExchange implements the op.TokenExchangeStorage interface
@@ -613,8 +947,36 @@ func (s *Storage) SetUserinfoFromTokenExchangeRequest(
 	return nil
 }
 
+// tokenActor returns the subject acting as the subject of the request, if any
+func tokenActor(request op.TokenRequest) string {
+	if actorReq, ok := request.(op.TokenActorRequest); ok && actorReq.GetActor() != nil {
+		return actorReq.GetActor().Subject
+	}
+	if exchangeReq, ok := request.(op.TokenExchangeRequest); ok &&
+		exchangeReq.GetExchangeActor() != exchangeReq.GetSubject() {
+		return exchangeReq.GetExchangeActor()
+	}
+	return ""
+}
+
 // getInfoFromRequest returns the clientID, authTime and amr depending on the op.TokenRequest type / implementation
 func getInfoFromRequest(req op.TokenRequest) (clientID string, authTime time.Time, amr []string) {
+	authReq, ok := req.(*AuthRequest) // Code Flow (with scope offline_access)
+	if ok {
+		return authReq.ApplicationID, authReq.GetAuthTime(), authReq.Amr
+	}
+	deviceReq, ok := req.(*op.DeviceAuthorizationState) // Device Authorization Request
+	if ok {
+		return deviceReq.ClientID, deviceReq.AuthTime, deviceReq.AMR
+	}
+	_, ok = req.(*ImpersonationRequest) // Impersonation Request
+	if ok {
+		return builtinClientID, time.Now(), nil
+	}
+	clientReq, ok := req.(*ClientCredentialsRequest) // Client Credentials Request
+	if ok {
+		return clientReq.ApplicationID, time.Time{}, nil
+	}
 	refreshReq, ok := req.(*RefreshTokenRequest) // Refresh Token Request
 	if ok {
 		return refreshReq.ApplicationID, refreshReq.AuthTime, refreshReq.Amr
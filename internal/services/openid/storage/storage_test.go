@@ -0,0 +1,109 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"base-website/ent"
+	"base-website/ent/authrefreshtoken"
+	"base-website/ent/authtoken"
+	"base-website/ent/enttest"
+	configservice "base-website/internal/services/config"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/mcuadros/go-defaults"
+)
+
+func newTestStorage(t *testing.T) (*Storage, *ent.Client) {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	var config configservice.Config
+	defaults.SetDefaults(&config)
+	config.JWTSecret = "test-secret"
+	storage, err := NewStorage(client, &config, nil)
+	if err != nil {
+		t.Fatalf("failed to create storage: %v", err)
+	}
+	return storage, client
+}
+
+// TestRefreshTokenReuse rotates a refresh token, then presents the rotated one again:
+// the whole family is revoked, the token issued by the rotation included
+func TestRefreshTokenReuse(t *testing.T) {
+	ctx := context.Background()
+	storage, client := newTestStorage(t)
+	request := &ClientCredentialsRequest{ApplicationID: "club-app", Scopes: []string{"openid", "offline_access"}}
+
+	// An unrelated session of the same app must survive the revocation
+	_, otherRefreshToken, _, err := storage.CreateAccessAndRefreshTokens(ctx, request, "")
+	if err != nil {
+		t.Fatalf("failed to start the other session: %v", err)
+	}
+
+	_, firstRefreshToken, _, err := storage.CreateAccessAndRefreshTokens(ctx, request, "")
+	if err != nil {
+		t.Fatalf("failed to start the session: %v", err)
+	}
+	refreshRequest, err := storage.TokenRequestByRefreshToken(ctx, firstRefreshToken)
+	if err != nil {
+		t.Fatalf("failed to read the refresh token: %v", err)
+	}
+	_, secondRefreshToken, _, err := storage.CreateAccessAndRefreshTokens(ctx, refreshRequest, firstRefreshToken)
+	if err != nil {
+		t.Fatalf("failed to rotate the refresh token: %v", err)
+	}
+	if secondRefreshToken == firstRefreshToken {
+		t.Fatal("expected the rotation to issue a new refresh token")
+	}
+	if _, err := storage.TokenRequestByRefreshToken(ctx, secondRefreshToken); err != nil {
+		t.Fatalf("expected the rotated refresh token to be valid: %v", err)
+	}
+
+	if _, err := storage.TokenRequestByRefreshToken(ctx, firstRefreshToken); err == nil {
+		t.Fatal("expected the reused refresh token to be rejected")
+	}
+	if _, err := storage.TokenRequestByRefreshToken(ctx, secondRefreshToken); err == nil {
+		t.Error("expected the family of the reused refresh token to be revoked")
+	}
+	if _, err := storage.TokenRequestByRefreshToken(ctx, otherRefreshToken); err != nil {
+		t.Errorf("expected the other session to be kept: %v", err)
+	}
+
+	refreshTokens := client.AuthRefreshToken.Query().CountX(ctx)
+	accessTokens := client.AuthToken.Query().CountX(ctx)
+	if refreshTokens != 1 || accessTokens != 1 {
+		t.Errorf("expected the tokens of the other session only, got %d refresh and %d access tokens", refreshTokens, accessTokens)
+	}
+}
+
+// TestConcurrentRefreshTokenRotation presents a refresh token twice to the rotation, as two concurrent
+// refreshes would: the second one fails and revokes the family
+func TestConcurrentRefreshTokenRotation(t *testing.T) {
+	ctx := context.Background()
+	storage, client := newTestStorage(t)
+	request := &ClientCredentialsRequest{ApplicationID: "club-app", Scopes: []string{"openid", "offline_access"}}
+
+	_, refreshToken, _, err := storage.CreateAccessAndRefreshTokens(ctx, request, "")
+	if err != nil {
+		t.Fatalf("failed to start the session: %v", err)
+	}
+	refreshRequest, err := storage.TokenRequestByRefreshToken(ctx, refreshToken)
+	if err != nil {
+		t.Fatalf("failed to read the refresh token: %v", err)
+	}
+	if _, _, _, err := storage.CreateAccessAndRefreshTokens(ctx, refreshRequest, refreshToken); err != nil {
+		t.Fatalf("failed to rotate the refresh token: %v", err)
+	}
+	if _, _, _, err := storage.CreateAccessAndRefreshTokens(ctx, refreshRequest, refreshToken); err == nil {
+		t.Fatal("expected the second rotation of the refresh token to fail")
+	}
+
+	if exists := client.AuthRefreshToken.Query().Where(authrefreshtoken.UserID(request.GetSubject())).ExistX(ctx); exists {
+		t.Error("expected the refresh token family to be revoked")
+	}
+	if exists := client.AuthToken.Query().Where(authtoken.Subject(request.GetSubject())).ExistX(ctx); exists {
+		t.Error("expected the access tokens of the family to be revoked")
+	}
+}
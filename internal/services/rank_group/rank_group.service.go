@@ -5,8 +5,9 @@ import (
 	"base-website/ent/rankgroup"
 	"base-website/ent/team"
 	"base-website/ent/tournament"
-	"base-website/ent/tournamentadmin"
 	"base-website/internal/lightmodels"
+	auditservice "base-website/internal/services/audit"
+	auditmodels "base-website/internal/services/audit/models"
 	databaseservice "base-website/internal/services/database"
 	rankgroupmodels "base-website/internal/services/rank_group/models"
 	rbacservice "base-website/internal/services/rbac"
@@ -14,8 +15,8 @@ import (
 	tournamentsservice "base-website/internal/services/tournaments"
 	"base-website/pkg/errorfilters"
 	"context"
+	"strconv"
 
-	"github.com/danielgtaylor/huma/v2"
 	"github.com/samber/do"
 )
 
@@ -26,6 +27,7 @@ type RankGroupService interface {
 }
 
 type rankGroupService struct {
+	auditService       auditservice.AuditService
 	databaseService    databaseservice.DatabaseService
 	errorFilter        errorfilters.ErrorFilter
 	rbacService        rbacservice.RBACService
@@ -36,6 +38,7 @@ type rankGroupService struct {
 func NewProvider() func(i *do.Injector) (RankGroupService, error) {
 	return func(i *do.Injector) (RankGroupService, error) {
 		return New(
+			do.MustInvoke[auditservice.AuditService](i),
 			do.MustInvoke[databaseservice.DatabaseService](i),
 			do.MustInvoke[rbacservice.RBACService](i),
 			do.MustInvoke[s3service.S3Service](i),
@@ -45,12 +48,14 @@ func NewProvider() func(i *do.Injector) (RankGroupService, error) {
 }
 
 func New(
+	auditService auditservice.AuditService,
 	databaseService databaseservice.DatabaseService,
 	rbacService rbacservice.RBACService,
 	s3service s3service.S3Service,
 	tournamentsService tournamentsservice.TournamentsService,
 ) (RankGroupService, error) {
 	return &rankGroupService{
+		auditService:       auditService,
 		databaseService:    databaseService,
 		errorFilter:        errorfilters.NewEntErrorFilter().WithEntityTypeName("rank_group"),
 		rbacService:        rbacService,
@@ -77,28 +82,46 @@ func (svc *rankGroupService) UpdateRankGroupsByTournament(
 	tournamentID int,
 	rankgroups []rankgroupmodels.UpdateRankGroup,
 ) ([]*lightmodels.LightRankGroup, error) {
-	myRole, err := svc.tournamentsService.GetTournamentUserRole(ctx, tournamentID)
+	oldRankGroups, err := svc.databaseService.RankGroup.Query().
+		Where(rankgroup.HasTournamentWith(tournament.IDEQ(tournamentID))).
+		All(ctx)
 	if err != nil {
-		return nil, err
-	}
-	if myRole == nil || *myRole == tournamentadmin.RoleADMIN {
-		return nil, huma.Error401Unauthorized("don't have required role")
+		return nil, svc.errorFilter.Filter(err, "get rank_groups")
 	}
 
-	_, err = svc.databaseService.RankGroup.Delete().Where(rankgroup.HasTournamentWith(tournament.IDEQ(tournamentID))).Exec(ctx)
+	err = databaseservice.WithTx(ctx, svc.databaseService, func(tx *ent.Tx) error {
+		_, err := tx.RankGroup.Delete().Where(rankgroup.HasTournamentWith(tournament.IDEQ(tournamentID))).Exec(ctx)
+		if err != nil {
+			return svc.errorFilter.Filter(err, "delete rank_groups")
+		}
+
+		newRankGroups := make([]*ent.RankGroup, 0, len(rankgroups))
+		for _, rankgroup := range rankgroups {
+			newRankGroup, err := tx.RankGroup.Create().
+				SetName(GetNameFromRankRange(rankgroup.RankMin, rankgroup.RankMax)).
+				SetPosition(rankgroup.Position).
+				SetRankMin(rankgroup.RankMin).
+				SetRankMax(rankgroup.RankMax).
+				SetTournamentID(tournamentID).
+				Save(ctx)
+			if err != nil {
+				return svc.errorFilter.Filter(err, "create rank_group")
+			}
+			newRankGroups = append(newRankGroups, newRankGroup)
+		}
+
+		return svc.auditService.Record(ctx, tx, &auditmodels.Entry{
+			Action:     auditmodels.ActionTournamentRankGroupsSet,
+			TargetType: auditmodels.TargetTournament,
+			TargetID:   strconv.Itoa(tournamentID),
+			Before:     map[string]any{"rank_groups": rankGroupsAuditState(oldRankGroups)},
+			After:      map[string]any{"rank_groups": rankGroupsAuditState(newRankGroups)},
+		})
+	})
 	if err != nil {
-		return nil, svc.errorFilter.Filter(err, "delete rank_groups")
+		return nil, err
 	}
 
-	for _, rankgroup := range rankgroups {
-		svc.databaseService.RankGroup.Create().
-			SetName(GetNameFromRankRange(rankgroup.RankMin, rankgroup.RankMax)).
-			SetPosition(rankgroup.Position).
-			SetRankMin(rankgroup.RankMin).
-			SetRankMax(rankgroup.RankMax).
-			SetTournamentID(tournamentID).
-			Exec(ctx)
-	}
 	newRankGroups, err := svc.databaseService.RankGroup.Query().
 		Where(rankgroup.HasTournamentWith(tournament.IDEQ(tournamentID))).
 		All(ctx)
@@ -114,28 +137,34 @@ func (svc *rankGroupService) UpdateTeamRankGroup(
 	teamID int,
 	rankGroupID int,
 ) (*lightmodels.LightTeam, error) {
-	entTeam, err := svc.databaseService.Team.Query().
+	var previousRankGroupID *int
+	previous, err := svc.databaseService.Team.Query().
 		Where(team.IDEQ(teamID)).
-		WithTournament().
-		Only(ctx)
-	if err != nil {
-		return nil, svc.errorFilter.Filter(err, "get team")
+		QueryRankGroup().
+		OnlyID(ctx)
+	if err == nil {
+		previousRankGroupID = &previous
+	} else if !ent.IsNotFound(err) {
+		return nil, svc.errorFilter.Filter(err, "get team rank group")
 	}
 
-	myRole, err := svc.tournamentsService.GetTournamentUserRole(ctx, entTeam.Edges.Tournament.ID)
+	err = databaseservice.WithTx(ctx, svc.databaseService, func(tx *ent.Tx) error {
+		if err := tx.Team.UpdateOneID(teamID).SetRankGroupID(rankGroupID).Exec(ctx); err != nil {
+			return svc.errorFilter.Filter(err, "update team rank group")
+		}
+		return svc.auditService.Record(ctx, tx, &auditmodels.Entry{
+			Action:     auditmodels.ActionTeamRankGroupChange,
+			TargetType: auditmodels.TargetTeam,
+			TargetID:   strconv.Itoa(teamID),
+			Before:     map[string]any{"rank_group_id": previousRankGroupID},
+			After:      map[string]any{"rank_group_id": &rankGroupID},
+		})
+	})
 	if err != nil {
 		return nil, err
 	}
-	if myRole == nil {
-		return nil, huma.Error401Unauthorized("don't have required role")
-	}
-
-	entTeam, err = svc.databaseService.Team.UpdateOneID(teamID).SetRankGroupID(rankGroupID).Save(ctx)
-	if err != nil {
-		return nil, svc.errorFilter.Filter(err, "update team rank group")
-	}
 
-	entTeam, err = svc.databaseService.Team.Query().
+	entTeam, err := svc.databaseService.Team.Query().
 		Where(team.IDEQ(teamID)).
 		WithMembers(func(q *ent.TeamMemberQuery) {
 			q.WithUser()
@@ -149,3 +178,18 @@ func (svc *rankGroupService) UpdateTeamRankGroup(
 
 	return lightmodels.NewLightTeamFromEnt(ctx, entTeam, svc.s3service), nil
 }
+
+//// HELPERS ////
+
+// rankGroupsAuditState is the audited state of the rank groups of a tournament
+func rankGroupsAuditState(rankGroups []*ent.RankGroup) []map[string]any {
+	state := make([]map[string]any, len(rankGroups))
+	for i, rankGroup := range rankGroups {
+		state[i] = map[string]any{
+			"position": rankGroup.Position,
+			"rank_min": rankGroup.RankMin,
+			"rank_max": rankGroup.RankMax,
+		}
+	}
+	return state
+}
@@ -0,0 +1,169 @@
+package ratelimitservice
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"base-website/internal/openapi"
+	configservice "base-website/internal/services/config"
+	rbacservice "base-website/internal/services/rbac"
+	"base-website/pkg/ratelimit"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/samber/do"
+	"github.com/valkey-io/valkey-go"
+)
+
+// bucketKeyPrefix prefixes the Valkey keys holding the token buckets
+const bucketKeyPrefix = "ratelimit:"
+
+// takeTokenScript refills the bucket for the time elapsed since its last request, then takes a token.
+// It runs atomically and reads the Valkey clock, so that every replica shares the same buckets.
+// It returns whether the token was taken, the tokens left and the milliseconds until the next one.
+var takeTokenScript = valkey.NewLuaScript(`
+local requests = tonumber(ARGV[1])
+local period = tonumber(ARGV[2])
+local burst = tonumber(ARGV[3])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'updated_at')
+local tokens = tonumber(bucket[1]) or burst
+local updated_at = tonumber(bucket[2]) or now
+tokens = math.min(burst, tokens + math.max(0, now - updated_at) * requests / period)
+local allowed = 0
+local retry_after = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	retry_after = math.ceil((1 - tokens) * period / requests)
+end
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'updated_at', tostring(now))
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst * period / requests))
+return {allowed, math.floor(tokens), retry_after}
+`)
+
+// Request is a request to count against the limit of its operation
+type Request struct {
+	Route ratelimit.Route
+	// Principal identifies who the bucket belongs to: a user, an app or an IP
+	Principal string
+	Roles     []string
+	Anonymous bool
+}
+
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// RetryAfter is the time until the next request is allowed, when it isn't
+	RetryAfter time.Duration
+}
+
+type RateLimitService interface {
+	// Take counts the request against the bucket of its principal, a request no bucket applies to is allowed
+	Take(ctx context.Context, request *Request) (*Result, error)
+	// Validate checks the policy against the registered operations
+	Validate(routes []ratelimit.Route) error
+}
+
+type rateLimitService struct {
+	policy       *ratelimit.Policy
+	roles        []string
+	valkeyClient valkey.Client
+}
+
+func NewProvider() func(i *do.Injector) (RateLimitService, error) {
+	return func(i *do.Injector) (RateLimitService, error) {
+		return New(
+			do.MustInvoke[configservice.ConfigService](i),
+			do.MustInvoke[rbacservice.RBACService](i),
+		)
+	}
+}
+
+func New(
+	config configservice.ConfigService,
+	rbacService rbacservice.RBACService,
+) (RateLimitService, error) {
+	path := config.GetConfig().RateLimitConfigPath
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	policy, err := ratelimit.New(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	roles := rbacService.ListRoles()
+	if err := policy.Validate(roles, nil); err != nil {
+		return nil, fmt.Errorf("invalid rate limits %s:\n%w", path, err)
+	}
+
+	valkeyClient, err := valkey.NewClient(valkey.MustParseURL(config.GetConfig().ValkeyAddress))
+	if err != nil {
+		return nil, err
+	}
+	return &rateLimitService{
+		policy:       policy,
+		roles:        roles,
+		valkeyClient: valkeyClient,
+	}, nil
+}
+
+func (svc *rateLimitService) Take(ctx context.Context, request *Request) (*Result, error) {
+	limit := svc.policy.Find(request.Route)
+	if limit == nil {
+		return &Result{Allowed: true}, nil
+	}
+	bucket := limit.Bucket(request.Roles, request.Anonymous)
+	if bucket == nil {
+		return &Result{Allowed: true}, nil
+	}
+
+	key := bucketKeyPrefix + limit.Name() + ":" + request.Principal
+	reply, err := takeTokenScript.Exec(ctx, svc.valkeyClient, []string{key}, []string{
+		strconv.Itoa(bucket.Requests),
+		strconv.FormatInt(bucket.Period.Milliseconds(), 10),
+		strconv.Itoa(bucket.Burst),
+	}).AsIntSlice()
+	if err != nil {
+		return nil, err
+	}
+	if len(reply) != 3 {
+		return nil, fmt.Errorf("unexpected rate limit reply %v", reply)
+	}
+	return &Result{
+		Allowed:    reply[0] == 1,
+		Limit:      bucket.Burst,
+		Remaining:  int(reply[1]),
+		RetryAfter: time.Duration(reply[2]) * time.Millisecond,
+	}, nil
+}
+
+func (svc *rateLimitService) Validate(routes []ratelimit.Route) error {
+	return svc.policy.Validate(svc.roles, routes)
+}
+
+// RoutesFromOpenAPI lists the operations registered on the API, for Validate
+func RoutesFromOpenAPI(oapi *huma.OpenAPI) []ratelimit.Route {
+	routes := make([]ratelimit.Route, 0)
+	for _, operation := range openapi.Operations(oapi) {
+		routes = append(routes, ratelimit.Route{
+			OperationID: operation.OperationID,
+			Method:      operation.Method,
+			Path:        operation.Path,
+		})
+	}
+	return routes
+}
+
+// Shutdown closes the Valkey client.
+func (svc *rateLimitService) Shutdown() error {
+	svc.valkeyClient.Close()
+	return nil
+}
@@ -0,0 +1,51 @@
+package rbacmodels
+
+import (
+	"base-website/ent/tournamentadmin"
+	"base-website/pkg/rbac"
+)
+
+type CheckParams struct {
+	UserID *int
+	Roles  []string
+	Method string
+	Path   string
+}
+
+type CheckResult struct {
+	Authorization
+	Roles     []string `json:"roles" description:"The roles that were checked" example:"[\"basic_admin\"]" nullable:"false"`
+	Path      string   `json:"path" description:"The checked path" example:"/tournaments/42"`
+	Operation string   `json:"operation" description:"The declared path of the operation the request belongs to, the checked path if none" example:"/tournaments/{id}"`
+}
+
+// Authorization is the outcome of the RBAC middleware for an operation: the policy decision,
+// and the tournament role when the operation requires one on top of it
+type Authorization struct {
+	Allowed  bool           `json:"allowed" description:"Whether the request would be allowed, an operation requiring a tournament role is only allowed on a concrete resource" example:"false"`
+	Decision *rbac.Decision `json:"decision" description:"The decision of the RBAC policy"`
+	Scope    *ScopeCheck    `json:"scope,omitempty" description:"The tournament role the operation requires on top of the policy decision"`
+	// TournamentRole is the role resolved for the scope, the middleware hands it to the operation
+	TournamentRole *tournamentadmin.Role `json:"-"`
+}
+
+type ScopeCheck struct {
+	Resource   string `json:"resource" description:"The resource the role is held on the tournament of" example:"tournament"`
+	Param      string `json:"param" description:"The path parameter identifying the resource" example:"id"`
+	MinRole    string `json:"min_role" description:"The lowest tournament role allowed" example:"SUPER_ADMIN"`
+	ResourceID *int   `json:"resource_id,omitempty" description:"The ID of the resource, absent when the path is not concrete" example:"42"`
+	Role       string `json:"role,omitempty" description:"The tournament role of the user on the resource" example:"ADMIN"`
+	Satisfied  bool   `json:"satisfied" description:"Whether the tournament role is high enough" example:"false"`
+}
+
+// OperationAuthorization is the authorization of a user on a registered operation
+type OperationAuthorization struct {
+	Authorization
+	Method string `json:"method" description:"The HTTP method of the operation" example:"PATCH"`
+	Path   string `json:"path" description:"The path of the operation, as declared in the API spec" example:"/tournaments/{id}"`
+}
+
+type UserPermissions struct {
+	Permissions []*rbac.Permission        `json:"permissions" description:"The permissions granted by the roles of the user" nullable:"false"`
+	Operations  []*OperationAuthorization `json:"operations" description:"The authorization of the user on every operation of the API" nullable:"false"`
+}
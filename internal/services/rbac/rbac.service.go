@@ -1,21 +1,48 @@
 package rbacservice
 
 import (
+	"cmp"
 	"context"
+	"encoding/json"
+	"fmt"
 	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
 
+	"base-website/ent"
+	"base-website/ent/team"
+	"base-website/ent/tournament"
+	"base-website/ent/tournamentadmin"
 	"base-website/ent/user"
+	"base-website/internal/openapi"
+	"base-website/internal/security"
 	configservice "base-website/internal/services/config"
 	databaseservice "base-website/internal/services/database"
+	rbacmodels "base-website/internal/services/rbac/models"
+	"base-website/pkg/logger"
 	"base-website/pkg/rbac"
 
 	"github.com/danielgtaylor/huma/v2"
+	"github.com/fsnotify/fsnotify"
 	"github.com/samber/do"
+	"github.com/valkey-io/valkey-go"
 )
 
+// ScopesPathPrefix prefixes the virtual paths granting the OpenID scopes, they match no operation
+const ScopesPathPrefix = "/scopes/"
+
+// userRolesKeyPrefix prefixes the Valkey keys caching the roles of a user
+const userRolesKeyPrefix = "rbac:roles:user:"
+
 type RBACService interface {
 	// This method is used to check if a user has the permission to access a path with a verb.
 	Can(roles []string, path string, verb string) bool
+	// Explain returns the permission deciding whether the roles can access a path with a verb.
+	Explain(roles []string, path string, verb string) *rbac.Decision
 	// GetRoles
 	GetPermissions(roles []string) []*rbac.Permission
 	// GetPermissionsFromClaims
@@ -24,11 +51,46 @@ type RBACService interface {
 	List() []*rbac.Role
 	// List of existing roles
 	ListRoles() []string
+	// Outranks reports whether the roles grant strictly more than the other roles, inheritance included.
+	Outranks(roles []string, otherRoles []string) bool
+	// Validate checks the policy against the registered operations, they are also used to validate reloads.
+	Validate(routes []rbac.Route) error
+	// GetUserRoles returns the roles of a user, cached for a short time.
+	GetUserRoles(ctx context.Context, userID int) ([]string, error)
+	// InvalidateUserRoles drops the cached roles of a user, it must be called when they change.
+	InvalidateUserRoles(ctx context.Context, userID int)
+	// GetTournamentRole returns the role of a user on the tournament of the resource of a scope requirement.
+	GetTournamentRole(
+		ctx context.Context,
+		userID *int,
+		roles []string,
+		requirement *rbac.ScopeRequirement,
+		resourceID int,
+	) (*tournamentadmin.Role, error)
+	// Authorize decides an operation as the RBAC middleware does, with the policy and the tournament role
+	// it requires on the resource, if any. The resource is nil when the request doesn't target a concrete one.
+	Authorize(
+		ctx context.Context,
+		userID *int,
+		roles []string,
+		route *rbac.Route,
+		resourceID *int,
+	) (*rbacmodels.Authorization, error)
+	// Check explains whether a user or a role set can run the operation at a method and a path.
+	Check(ctx context.Context, params *rbacmodels.CheckParams) (*rbacmodels.CheckResult, error)
+	// GetUserPermissions returns the permissions of a user and explains their authorization on every operation.
+	GetUserPermissions(ctx context.Context, userID int) (*rbacmodels.UserPermissions, error)
 }
 
 type rbacService struct {
-	rbac            *rbac.Rbac
+	rbac            atomic.Pointer[rbac.Rbac]
+	routes          atomic.Pointer[[]rbac.Route]
+	path            string
+	watcher         *fsnotify.Watcher
+	valkeyClient    valkey.Client
+	rolesCacheTTL   time.Duration
 	databaseService databaseservice.DatabaseService
+	logger          *logger.Logger
 }
 
 func NewProvider() func(i *do.Injector) (RBACService, error) {
@@ -44,33 +106,104 @@ func New(
 	config configservice.ConfigService,
 	databaseService databaseservice.DatabaseService,
 ) (RBACService, error) {
-	file, err := os.Open(config.GetConfig().RBACConfigPath)
+	valkeyClient, err := valkey.NewClient(valkey.MustParseURL(config.GetConfig().ValkeyAddress))
+	if err != nil {
+		return nil, err
+	}
+	svc := &rbacService{
+		path:            config.GetConfig().RBACConfigPath,
+		valkeyClient:    valkeyClient,
+		rolesCacheTTL:   time.Duration(config.GetConfig().RBACRolesCacheTTL) * time.Second,
+		databaseService: databaseService,
+		logger:          logger.New().WithContext("RBACService"),
+	}
+	policy, err := Load(svc.path, nil)
 	if err != nil {
 		panic(err)
 	}
+	svc.rbac.Store(policy)
+
+	if config.GetConfig().RBACHotReload {
+		if err := svc.watch(); err != nil {
+			svc.logger.Error("failed to watch %s, hot reload disabled: %s", svc.path, err.Error())
+		}
+	}
+	return svc, nil
+}
+
+// Load reads and validates the policy at path, routes are checked when given
+func Load(path string, routes []rbac.Route) (*rbac.Rbac, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
 	defer file.Close()
-	rbac, err := rbac.New(file)
+	policy, err := rbac.New(file)
 	if err != nil {
-		panic(err)
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
 	}
-	return &rbacService{
-		rbac:            rbac,
-		databaseService: databaseService,
-	}, nil
+	if err := policy.Validate(routes, ScopesPathPrefix); err != nil {
+		return nil, fmt.Errorf("invalid policy %s:\n%w", path, err)
+	}
+	return policy, nil
+}
+
+// RoutesFromOpenAPI returns the operations registered on the API
+func RoutesFromOpenAPI(oapi *huma.OpenAPI) []rbac.Route {
+	routes := make([]rbac.Route, 0)
+	for _, operation := range openapi.Operations(oapi) {
+		routes = append(routes, rbac.Route{
+			Method: operation.Method,
+			Path:   operation.Path,
+			Scope:  rbac.RequirementFromMetadata(operation.Metadata),
+		})
+	}
+	return routes
 }
 
 func (svc *rbacService) Can(roles []string, path string, verb string) bool {
-	return svc.rbac.Can(roles, path, verb)
+	return svc.rbac.Load().Can(roles, path, verb)
+}
+
+func (svc *rbacService) Explain(roles []string, path string, verb string) *rbac.Decision {
+	return svc.rbac.Load().Explain(roles, path, verb)
+}
+
+func (svc *rbacService) Outranks(roles []string, otherRoles []string) bool {
+	return svc.rbac.Load().Outranks(roles, otherRoles)
 }
 
 func (svc *rbacService) GetPermissions(roles []string) []*rbac.Permission {
-	return svc.rbac.GetPermissions(roles)
+	return svc.rbac.Load().GetPermissions(roles)
 }
 
 func (svc *rbacService) GetPermissionsFromUserID(
 	ctx context.Context,
 	userID int,
 ) ([]*rbac.Permission, error) {
+	roles, err := svc.GetUserRoles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return svc.GetPermissions(roles), nil
+}
+
+// GetUserRoles reads the roles from Valkey so that every replica sees an invalidation,
+// a Valkey failure falls back to the database
+func (svc *rbacService) GetUserRoles(ctx context.Context, userID int) ([]string, error) {
+	key := userRolesKeyPrefix + strconv.Itoa(userID)
+	if svc.rolesCacheTTL > 0 {
+		cached, err := svc.valkeyClient.Do(ctx, svc.valkeyClient.B().Get().Key(key).Build()).AsBytes()
+		if err == nil {
+			var roles []string
+			if err := json.Unmarshal(cached, &roles); err == nil {
+				return roles, nil
+			}
+		} else if !valkey.IsValkeyNil(err) {
+			svc.logger.Error("failed to get cached roles %s", err.Error())
+		}
+	}
+
 	user, err := svc.databaseService.User.Query().Where(user.ID(userID)).
 		Select(user.FieldRoles).
 		Only(ctx)
@@ -79,21 +212,310 @@ func (svc *rbacService) GetPermissionsFromUserID(
 			"user not found",
 		)
 	}
-	return svc.GetPermissions(user.Roles), nil
+
+	if svc.rolesCacheTTL > 0 {
+		encoded, err := json.Marshal(user.Roles)
+		if err == nil {
+			err = svc.valkeyClient.Do(ctx, svc.valkeyClient.B().Set().
+				Key(key).
+				Value(string(encoded)).
+				Ex(svc.rolesCacheTTL).
+				Build()).
+				Error()
+		}
+		if err != nil {
+			svc.logger.Error("failed to cache roles %s", err.Error())
+		}
+	}
+	return user.Roles, nil
+}
+
+func (svc *rbacService) InvalidateUserRoles(ctx context.Context, userID int) {
+	err := svc.valkeyClient.Do(ctx, svc.valkeyClient.B().Del().
+		Key(userRolesKeyPrefix+strconv.Itoa(userID)).
+		Build()).
+		Error()
+	if err != nil {
+		// The cached roles expire with the TTL anyway
+		svc.logger.Error("failed to invalidate cached roles of user %d: %s", userID, err.Error())
+	}
 }
 
 func (svc *rbacService) List() []*rbac.Role {
-	roles := make([]*rbac.Role, 0, len(svc.rbac.Roles))
-	for _, role := range svc.rbac.Roles {
+	policy := svc.rbac.Load()
+	roles := make([]*rbac.Role, 0, len(policy.Roles))
+	for _, role := range policy.Roles {
 		roles = append(roles, role)
 	}
 	return roles
 }
 
 func (svc *rbacService) ListRoles() []string {
-	roles := make([]string, 0, len(svc.rbac.Roles))
-	for _, role := range svc.rbac.Roles {
+	policy := svc.rbac.Load()
+	roles := make([]string, 0, len(policy.Roles))
+	for _, role := range policy.Roles {
 		roles = append(roles, role.Name)
 	}
 	return roles
 }
+
+func (svc *rbacService) Validate(routes []rbac.Route) error {
+	svc.routes.Store(&routes)
+	return svc.rbac.Load().Validate(routes, ScopesPathPrefix)
+}
+
+// Shutdown stops watching the policy file and closes the Valkey client.
+func (svc *rbacService) Shutdown() error {
+	svc.valkeyClient.Close()
+	if svc.watcher == nil {
+		return nil
+	}
+	return svc.watcher.Close()
+}
+
+// watch reloads the policy when its file changes. The directory is watched rather than the file
+// because editors and config map updates replace the file instead of writing to it.
+func (svc *rbacService) watch() error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	if err := watcher.Add(filepath.Dir(svc.path)); err != nil {
+		watcher.Close()
+		return err
+	}
+	svc.watcher = watcher
+
+	go func() {
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(event.Name) != filepath.Clean(svc.path) ||
+					!event.Has(fsnotify.Write) && !event.Has(fsnotify.Create) {
+					continue
+				}
+				svc.reload()
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				svc.logger.Error("policy watcher failed: %s", err.Error())
+			}
+		}
+	}()
+	return nil
+}
+
+// reload swaps the policy, an invalid one is rejected and the current one is kept
+func (svc *rbacService) reload() {
+	var routes []rbac.Route
+	if stored := svc.routes.Load(); stored != nil {
+		routes = *stored
+	}
+	policy, err := Load(svc.path, routes)
+	if err != nil {
+		svc.logger.Error("policy not reloaded: %s", err.Error())
+		return
+	}
+	svc.rbac.Store(policy)
+	svc.logger.Info("policy reloaded from %s", svc.path)
+}
+
+// GetTournamentRole considers a super_admin the creator of every tournament
+func (svc *rbacService) GetTournamentRole(
+	ctx context.Context,
+	userID *int,
+	roles []string,
+	requirement *rbac.ScopeRequirement,
+	resourceID int,
+) (*tournamentadmin.Role, error) {
+	var tournamentID int
+	switch requirement.Resource {
+	case security.ScopeTournament:
+		tournamentID = resourceID
+	case security.ScopeTeam:
+		var err error
+		tournamentID, err = svc.databaseService.Tournament.Query().
+			Where(tournament.HasTeamsWith(team.ID(resourceID))).
+			OnlyID(ctx)
+		if err != nil {
+			return nil, huma.Error404NotFound("team not found")
+		}
+	default:
+		return nil, fmt.Errorf("unknown scope resource %s", requirement.Resource)
+	}
+
+	if slices.Contains(roles, "super_admin") {
+		role := tournamentadmin.RoleCREATOR
+		return &role, nil
+	}
+	if userID == nil {
+		return nil, nil
+	}
+	admin, err := svc.databaseService.TournamentAdmin.Query().
+		Where(
+			tournamentadmin.HasTournamentWith(tournament.ID(tournamentID)),
+			tournamentadmin.HasUserWith(user.ID(*userID)),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		svc.logger.Error("failed to get tournament role %s", err.Error())
+		return nil, huma.Error500InternalServerError("failed to get tournament role")
+	}
+	return &admin.Role, nil
+}
+
+func (svc *rbacService) Authorize(
+	ctx context.Context,
+	userID *int,
+	roles []string,
+	route *rbac.Route,
+	resourceID *int,
+) (*rbacmodels.Authorization, error) {
+	authorization := &rbacmodels.Authorization{
+		Decision: svc.Explain(roles, route.Path, route.Method),
+	}
+	authorization.Allowed = authorization.Decision.Allowed
+	if route.Scope == nil {
+		return authorization, nil
+	}
+
+	// A scoped requirement restricts the operation further, the policy and its deny rules still apply
+	authorization.Scope = &rbacmodels.ScopeCheck{
+		Resource:   route.Scope.Resource,
+		Param:      route.Scope.Param,
+		MinRole:    route.Scope.MinRole,
+		ResourceID: resourceID,
+	}
+	authorization.Allowed = false
+	if resourceID == nil || !authorization.Decision.Allowed {
+		return authorization, nil
+	}
+	role, err := svc.GetTournamentRole(ctx, userID, roles, route.Scope, *resourceID)
+	if err != nil {
+		return nil, err
+	}
+	if role != nil {
+		authorization.Scope.Role = string(*role)
+	}
+	authorization.Scope.Satisfied = role != nil && security.TournamentRoles.Satisfies(string(*role), route.Scope.MinRole)
+	authorization.Allowed = authorization.Scope.Satisfied
+	authorization.TournamentRole = role
+	return authorization, nil
+}
+
+func (svc *rbacService) Check(
+	ctx context.Context,
+	params *rbacmodels.CheckParams,
+) (*rbacmodels.CheckResult, error) {
+	roles := params.Roles
+	if params.UserID != nil {
+		var err error
+		roles, err = svc.GetUserRoles(ctx, *params.UserID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	method := strings.ToUpper(params.Method)
+
+	// The policy is written against the declared paths, a concrete path is resolved to its operation
+	route, pathParams := svc.matchRoute(method, params.Path)
+	if route == nil {
+		route = &rbac.Route{Method: method, Path: params.Path}
+	}
+	var resourceID *int
+	if route.Scope != nil {
+		if id, err := strconv.Atoi(pathParams[route.Scope.Param]); err == nil {
+			resourceID = &id
+		}
+	}
+	authorization, err := svc.Authorize(ctx, params.UserID, roles, route, resourceID)
+	if err != nil {
+		return nil, err
+	}
+	return &rbacmodels.CheckResult{
+		Authorization: *authorization,
+		Roles:         roles,
+		Path:          params.Path,
+		Operation:     route.Path,
+	}, nil
+}
+
+func (svc *rbacService) GetUserPermissions(ctx context.Context, userID int) (*rbacmodels.UserPermissions, error) {
+	roles, err := svc.GetUserRoles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	var routes []rbac.Route
+	if stored := svc.routes.Load(); stored != nil {
+		routes = slices.Clone(*stored)
+	}
+	slices.SortFunc(routes, func(a, b rbac.Route) int {
+		return cmp.Or(strings.Compare(a.Path, b.Path), strings.Compare(a.Method, b.Method))
+	})
+
+	operations := make([]*rbacmodels.OperationAuthorization, 0, len(routes))
+	for i := range routes {
+		// The operations are declared ones, a scoped one is explained without its resource
+		authorization, err := svc.Authorize(ctx, &userID, roles, &routes[i], nil)
+		if err != nil {
+			return nil, err
+		}
+		operations = append(operations, &rbacmodels.OperationAuthorization{
+			Authorization: *authorization,
+			Method:        routes[i].Method,
+			Path:          routes[i].Path,
+		})
+	}
+	return &rbacmodels.UserPermissions{
+		Permissions: svc.GetPermissions(roles),
+		Operations:  operations,
+	}, nil
+}
+
+// matchRoute returns the registered operation the path belongs to and the values of its path parameters,
+// the operation with the most literal segments wins
+func (svc *rbacService) matchRoute(method string, path string) (*rbac.Route, map[string]string) {
+	stored := svc.routes.Load()
+	if stored == nil {
+		return nil, nil
+	}
+	segments := strings.Split(path, "/")
+	var best *rbac.Route
+	var bestParams map[string]string
+	bestLiterals := -1
+	for i := range *stored {
+		route := &(*stored)[i]
+		if route.Method != method {
+			continue
+		}
+		routeSegments := strings.Split(route.Path, "/")
+		if len(routeSegments) != len(segments) {
+			continue
+		}
+		params := make(map[string]string)
+		literals := 0
+		matched := true
+		for j, segment := range routeSegments {
+			if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+				params[strings.Trim(segment, "{}")] = segments[j]
+				continue
+			}
+			if segment != segments[j] {
+				matched = false
+				break
+			}
+			literals++
+		}
+		if matched && literals > bestLiterals {
+			best, bestParams, bestLiterals = route, params, literals
+		}
+	}
+	return best, bestParams
+}
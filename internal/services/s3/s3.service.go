@@ -20,6 +20,7 @@ type S3Service interface {
 	UploadObject(ctx context.Context, objectName string, reader io.Reader, size int64, contentType string) error
 	PresignedGet(ctx context.Context, objectName string, expiry time.Duration) (string, error)
 	RemoveObject(ctx context.Context, objectName string) error
+	CopyObject(ctx context.Context, srcObjectName string, dstObjectName string) error
 }
 
 type s3 struct {
@@ -93,3 +94,11 @@ func (s *s3) PresignedGet(ctx context.Context, objectName string, expiry time.Du
 func (s *s3) RemoveObject(ctx context.Context, objectName string) error {
 	return s.client.RemoveObject(ctx, s.cfg.MinioBucket, objectName, minio.RemoveObjectOptions{})
 }
+
+func (s *s3) CopyObject(ctx context.Context, srcObjectName string, dstObjectName string) error {
+	_, err := s.client.CopyObject(ctx,
+		minio.CopyDestOptions{Bucket: s.cfg.MinioBucket, Object: dstObjectName},
+		minio.CopySrcOptions{Bucket: s.cfg.MinioBucket, Object: srcObjectName},
+	)
+	return err
+}
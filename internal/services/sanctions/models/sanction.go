@@ -0,0 +1,83 @@
+package sanctionsmodels
+
+import (
+	"time"
+
+	"base-website/ent"
+	"base-website/ent/sanction"
+	"base-website/internal/lightmodels"
+	"base-website/pkg/paging"
+)
+
+type Sanction struct {
+	ID           int                    `json:"id" example:"1" description:"The ID of the sanction"`
+	Type         sanction.Type          `json:"type" example:"vote_ban" description:"The type of the sanction" enum:"global_ban,tournament_ban,vote_ban,warning"`
+	Reason       string                 `json:"reason" example:"Spamming the votes" description:"Why the user was sanctioned"`
+	ExpiresAt    *time.Time             `json:"expires_at" example:"2024-01-01T00:00:00Z" description:"When the sanction ends, null if it never expires"`
+	CreatedAt    time.Time              `json:"created_at" example:"2024-01-01T00:00:00Z" description:"When the sanction was issued"`
+	LiftedAt     *time.Time             `json:"lifted_at,omitempty" example:"2024-01-01T00:00:00Z" description:"When the sanction was lifted by an admin"`
+	LiftReason   string                 `json:"lift_reason,omitempty" example:"Appeal accepted" description:"Why the sanction was lifted"`
+	Active       bool                   `json:"active" example:"true" description:"Whether the sanction is neither expired nor lifted"`
+	TournamentID *int                   `json:"tournament_id,omitempty" example:"1" description:"The tournament of a tournament ban, all tournaments if omitted"`
+	User         *lightmodels.LightUser `json:"user,omitempty" description:"The sanctioned user"`
+	Issuer       *lightmodels.LightUser `json:"issuer,omitempty" description:"The admin who issued the sanction"`
+	LiftedBy     *lightmodels.LightUser `json:"lifted_by,omitempty" description:"The admin who lifted the sanction"`
+}
+
+type IssueSanctionParams struct {
+	Type         sanction.Type `json:"type" example:"vote_ban" description:"The type of the sanction" enum:"global_ban,tournament_ban,vote_ban,warning"`
+	Reason       string        `json:"reason" example:"Spamming the votes" description:"Why the user is sanctioned" validate:"min=3"`
+	ExpiresAt    *time.Time    `json:"expires_at,omitempty" example:"2024-01-01T00:00:00Z" description:"When the sanction ends, it never expires if omitted" required:"false"`
+	TournamentID *int          `json:"tournament_id,omitempty" example:"1" description:"Restricts a tournament ban to one tournament" required:"false"`
+}
+
+type LiftSanctionParams struct {
+	Reason string `json:"reason,omitempty" example:"Appeal accepted" description:"Why the sanction is lifted" required:"false"`
+}
+
+type ListSanctionsParams struct {
+	//// PAGINATION AND ORDER ////
+	paging.Input
+
+	//// FILTERS ////
+	// Only the active sanctions
+	Active bool `query:"active" example:"true" description:"Only the sanctions that are neither expired nor lifted"`
+	// The type of the sanction
+	Type string `query:"type" example:"vote_ban" description:"The type of the sanction" enum:"global_ban,tournament_ban,vote_ban,warning"`
+}
+
+// IsActive reports whether the sanction is neither expired nor lifted
+func IsActive(entSanction *ent.Sanction, now time.Time) bool {
+	return entSanction.LiftedAt == nil && (entSanction.ExpiresAt == nil || entSanction.ExpiresAt.After(now))
+}
+
+func NewSanctionFromEnt(entSanction *ent.Sanction) *Sanction {
+	if entSanction == nil {
+		return nil
+	}
+	result := &Sanction{
+		ID:         entSanction.ID,
+		Type:       entSanction.Type,
+		Reason:     entSanction.Reason,
+		ExpiresAt:  entSanction.ExpiresAt,
+		CreatedAt:  entSanction.CreatedAt,
+		LiftedAt:   entSanction.LiftedAt,
+		LiftReason: entSanction.LiftReason,
+		Active:     IsActive(entSanction, time.Now()),
+		User:       lightmodels.NewLightUserFromEnt(entSanction.Edges.User),
+		Issuer:     lightmodels.NewLightUserFromEnt(entSanction.Edges.Issuer),
+		LiftedBy:   lightmodels.NewLightUserFromEnt(entSanction.Edges.LiftedBy),
+	}
+	if entSanction.Edges.Tournament != nil {
+		result.TournamentID = &entSanction.Edges.Tournament.ID
+	}
+	return result
+}
+
+func NewSanctionsFromEnt(entSanctions []*ent.Sanction) []*Sanction {
+	sanctions := make([]*Sanction, len(entSanctions))
+	for i, entSanction := range entSanctions {
+		sanctions[i] = NewSanctionFromEnt(entSanction)
+	}
+	return sanctions
+}
@@ -0,0 +1,511 @@
+package sanctionsservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"base-website/ent"
+	"base-website/ent/predicate"
+	"base-website/ent/sanction"
+	"base-website/ent/tournament"
+	"base-website/ent/user"
+	"base-website/internal/lightmodels"
+	"base-website/internal/security"
+	auditservice "base-website/internal/services/audit"
+	auditmodels "base-website/internal/services/audit/models"
+	configservice "base-website/internal/services/config"
+	databaseservice "base-website/internal/services/database"
+	notificationsservice "base-website/internal/services/notifications"
+	pubsubservice "base-website/internal/services/pubsub"
+	rbacservice "base-website/internal/services/rbac"
+	sanctionsmodels "base-website/internal/services/sanctions/models"
+	"base-website/pkg/errorfilters"
+	"base-website/pkg/logger"
+	"base-website/pkg/paging"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/samber/do"
+	"github.com/valkey-io/valkey-go"
+)
+
+type SanctionsService interface {
+	// GetActiveSanction returns the latest active sanction of one of the types or global ban of the user, nil if there is none.
+	// A tournament ban restricted to another tournament than tournamentID is ignored and warnings never block.
+	GetActiveSanction(ctx context.Context, userID int, tournamentID *int, types ...sanction.Type) (*ent.Sanction, error)
+	// CheckSanctions returns a forbidden error describing the active sanction found by GetActiveSanction
+	CheckSanctions(ctx context.Context, userID int, tournamentID *int, types ...sanction.Type) error
+	// GetActiveBan returns the active global ban of the user, nil if there is none, cached for a short time
+	GetActiveBan(ctx context.Context, userID int) (*ent.Sanction, error)
+	// ListMySanctions returns the active sanctions of the current user
+	ListMySanctions(ctx context.Context) ([]*sanctionsmodels.Sanction, error)
+	// ListUserSanctions returns the sanctions of a user, most recent first by default
+	ListUserSanctions(ctx context.Context, userID int, params *sanctionsmodels.ListSanctionsParams) (*paging.Response[*sanctionsmodels.Sanction], error)
+	// IssueSanction sanctions a user on behalf of the current user
+	IssueSanction(ctx context.Context, userID int, params *sanctionsmodels.IssueSanctionParams) (*sanctionsmodels.Sanction, error)
+	// LiftSanction ends an active sanction before its expiry
+	LiftSanction(ctx context.Context, sanctionID int, params *sanctionsmodels.LiftSanctionParams) (*sanctionsmodels.Sanction, error)
+}
+
+// banKeyPrefix prefixes the Valkey keys caching the global ban of a user
+const banKeyPrefix = "sanctions:ban:user:"
+
+type sanctionsService struct {
+	auditService         auditservice.AuditService
+	configService        configservice.ConfigService
+	databaseService      databaseservice.DatabaseService
+	notificationsService notificationsservice.NotificationsService
+	pubsubService        pubsubservice.PubSubService
+	rbacService          rbacservice.RBACService
+	valkeyClient         valkey.Client
+	banCacheTTL          time.Duration
+	errorFilter          errorfilters.ErrorFilter
+	logger               *logger.Logger
+}
+
+func NewProvider() func(i *do.Injector) (SanctionsService, error) {
+	return func(i *do.Injector) (SanctionsService, error) {
+		return New(
+			do.MustInvoke[auditservice.AuditService](i),
+			do.MustInvoke[configservice.ConfigService](i),
+			do.MustInvoke[databaseservice.DatabaseService](i),
+			do.MustInvoke[notificationsservice.NotificationsService](i),
+			do.MustInvoke[pubsubservice.PubSubService](i),
+			do.MustInvoke[rbacservice.RBACService](i),
+		)
+	}
+}
+
+func New(
+	auditService auditservice.AuditService,
+	configService configservice.ConfigService,
+	databaseService databaseservice.DatabaseService,
+	notificationsService notificationsservice.NotificationsService,
+	pubsubService pubsubservice.PubSubService,
+	rbacService rbacservice.RBACService,
+) (SanctionsService, error) {
+	valkeyClient, err := valkey.NewClient(valkey.MustParseURL(configService.GetConfig().ValkeyAddress))
+	if err != nil {
+		return nil, err
+	}
+	return &sanctionsService{
+		auditService:         auditService,
+		configService:        configService,
+		databaseService:      databaseService,
+		notificationsService: notificationsService,
+		pubsubService:        pubsubService,
+		rbacService:          rbacService,
+		valkeyClient:         valkeyClient,
+		banCacheTTL:          time.Duration(configService.GetConfig().SanctionsBanCacheTTL) * time.Second,
+		errorFilter:          errorfilters.NewEntErrorFilter().WithEntityTypeName("sanction"),
+		logger:               logger.New().WithContext("SanctionsService"),
+	}, nil
+}
+
+func (svc *sanctionsService) GetActiveSanction(
+	ctx context.Context,
+	userID int,
+	tournamentID *int,
+	types ...sanction.Type,
+) (*ent.Sanction, error) {
+	types = append(slices.DeleteFunc(slices.Clone(types), func(t sanction.Type) bool {
+		return t == sanction.TypeWarning
+	}), sanction.TypeGlobalBan)
+	query := svc.databaseService.Sanction.Query().
+		Where(
+			sanction.HasUserWith(user.ID(userID)),
+			sanction.TypeIn(types...),
+			isActive(time.Now()),
+		)
+	if tournamentID != nil {
+		query.Where(sanction.Or(
+			sanction.TypeNEQ(sanction.TypeTournamentBan),
+			sanction.Not(sanction.HasTournament()),
+			sanction.HasTournamentWith(tournament.ID(*tournamentID)),
+		))
+	}
+
+	entSanction, err := query.
+		Order(ent.Desc(sanction.FieldID)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, svc.errorFilter.Filter(err, "get")
+	}
+	return entSanction, nil
+}
+
+func (svc *sanctionsService) CheckSanctions(
+	ctx context.Context,
+	userID int,
+	tournamentID *int,
+	types ...sanction.Type,
+) error {
+	entSanction, err := svc.GetActiveSanction(ctx, userID, tournamentID, types...)
+	if err != nil {
+		return err
+	}
+	if entSanction != nil {
+		return sanctionError(entSanction)
+	}
+	return nil
+}
+
+// GetActiveBan reads the ban from Valkey so that every replica sees a lift, the absence of a ban is cached too.
+// A Valkey failure falls back to the database.
+func (svc *sanctionsService) GetActiveBan(ctx context.Context, userID int) (*ent.Sanction, error) {
+	key := banKeyPrefix + strconv.Itoa(userID)
+	if svc.banCacheTTL > 0 {
+		cached, err := svc.valkeyClient.Do(ctx, svc.valkeyClient.B().Get().Key(key).Build()).AsBytes()
+		if err == nil {
+			var ban *ent.Sanction
+			if err := json.Unmarshal(cached, &ban); err == nil {
+				return ban, nil
+			}
+		} else if !valkey.IsValkeyNil(err) {
+			svc.logger.Error("failed to get cached ban %s", err.Error())
+		}
+	}
+
+	ban, err := svc.GetActiveSanction(ctx, userID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if svc.banCacheTTL > 0 {
+		// A ban is not cached past its expiry
+		ttl := svc.banCacheTTL
+		if ban != nil && ban.ExpiresAt != nil {
+			ttl = min(ttl, time.Until(*ban.ExpiresAt))
+		}
+		encoded, err := json.Marshal(ban)
+		if err == nil && ttl >= time.Second {
+			err = svc.valkeyClient.Do(ctx, svc.valkeyClient.B().Set().
+				Key(key).
+				Value(string(encoded)).
+				Ex(ttl).
+				Build()).
+				Error()
+		}
+		if err != nil {
+			svc.logger.Error("failed to cache ban %s", err.Error())
+		}
+	}
+	return ban, nil
+}
+
+// invalidateBan drops the cached ban of a user, it must be called when a global ban is issued or lifted
+func (svc *sanctionsService) invalidateBan(ctx context.Context, userID int) {
+	err := svc.valkeyClient.Do(ctx, svc.valkeyClient.B().Del().
+		Key(banKeyPrefix+strconv.Itoa(userID)).
+		Build()).
+		Error()
+	if err != nil {
+		// The cached ban expires with the TTL anyway
+		svc.logger.Error("failed to invalidate cached ban of user %d: %s", userID, err.Error())
+	}
+}
+
+func (svc *sanctionsService) ListMySanctions(ctx context.Context) ([]*sanctionsmodels.Sanction, error) {
+	userID, err := security.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The issuer isn't loaded, the sanctioned user doesn't need to know which admin sanctioned them
+	entSanctions, err := svc.databaseService.Sanction.Query().
+		Where(
+			sanction.HasUserWith(user.ID(userID)),
+			isActive(time.Now()),
+		).
+		WithTournament().
+		Order(ent.Desc(sanction.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, svc.errorFilter.Filter(err, "list")
+	}
+	return sanctionsmodels.NewSanctionsFromEnt(entSanctions), nil
+}
+
+func (svc *sanctionsService) ListUserSanctions(
+	ctx context.Context,
+	userID int,
+	params *sanctionsmodels.ListSanctionsParams,
+) (*paging.Response[*sanctionsmodels.Sanction], error) {
+	query := svc.databaseService.Sanction.Query().
+		Where(sanction.HasUserWith(user.ID(userID)))
+
+	if params.Active {
+		query.Where(isActive(time.Now()))
+	}
+	if params.Type != "" {
+		query.Where(sanction.TypeEQ(sanction.Type(params.Type)))
+	}
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, svc.errorFilter.Filter(err, "count")
+	}
+
+	query = paging.ApplyQueryPaging(query, params.Input)
+
+	if params.Order == "asc" {
+		query = query.Order(ent.Asc(sanction.FieldID))
+	} else {
+		query = query.Order(ent.Desc(sanction.FieldID))
+	}
+
+	entSanctions, err := query.
+		WithTournament().
+		WithIssuer().
+		WithLiftedBy().
+		All(ctx)
+	if err != nil {
+		return nil, svc.errorFilter.Filter(err, "list")
+	}
+
+	return paging.CreatePagingResponse(sanctionsmodels.NewSanctionsFromEnt(entSanctions), total, params.Page, params.Limit), nil
+}
+
+func (svc *sanctionsService) IssueSanction(
+	ctx context.Context,
+	userID int,
+	params *sanctionsmodels.IssueSanctionParams,
+) (*sanctionsmodels.Sanction, error) {
+	if err := sanction.TypeValidator(params.Type); err != nil {
+		return nil, huma.Error400BadRequest(fmt.Sprintf("sanction type %s doesn't exist", params.Type))
+	}
+	if params.TournamentID != nil && params.Type != sanction.TypeTournamentBan {
+		return nil, huma.Error400BadRequest("only a tournament ban can be restricted to a tournament")
+	}
+	if params.ExpiresAt != nil && !params.ExpiresAt.After(time.Now()) {
+		return nil, huma.Error422UnprocessableEntity("expires_at must be in the future")
+	}
+
+	issuerID, err := security.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if issuerID == userID {
+		return nil, huma.Error403Forbidden("can't sanction yourself")
+	}
+
+	entUser, err := svc.databaseService.User.Get(ctx, userID)
+	if err != nil {
+		return nil, svc.errorFilter.Filter(err, "get_user")
+	}
+	if entUser.IntraID != nil && *entUser.IntraID == svc.configService.GetConfig().SuperAdminUser {
+		return nil, huma.Error403Forbidden("can't sanction super admin user")
+	}
+	if err := svc.checkOutranks(ctx, issuerID, userID); err != nil {
+		return nil, err
+	}
+	if params.TournamentID != nil {
+		exists, err := svc.databaseService.Tournament.Query().
+			Where(tournament.ID(*params.TournamentID)).
+			Exist(ctx)
+		if err != nil {
+			return nil, svc.errorFilter.Filter(err, "check_tournament")
+		}
+		if !exists {
+			return nil, huma.Error404NotFound("tournament not found")
+		}
+	}
+
+	var sanctionID int
+	err = databaseservice.WithTx(ctx, svc.databaseService, func(tx *ent.Tx) error {
+		entSanction, err := tx.Sanction.Create().
+			SetType(params.Type).
+			SetReason(params.Reason).
+			SetNillableExpiresAt(params.ExpiresAt).
+			SetUserID(userID).
+			SetIssuerID(issuerID).
+			SetNillableTournamentID(params.TournamentID).
+			Save(ctx)
+		if err != nil {
+			return svc.errorFilter.Filter(err, "create")
+		}
+		sanctionID = entSanction.ID
+		return svc.auditService.Record(ctx, tx, &auditmodels.Entry{
+			Action:     auditmodels.ActionSanctionIssue,
+			TargetType: auditmodels.TargetUser,
+			TargetID:   strconv.Itoa(userID),
+			After: map[string]any{
+				"sanction_id":   entSanction.ID,
+				"type":          entSanction.Type,
+				"reason":        entSanction.Reason,
+				"expires_at":    entSanction.ExpiresAt,
+				"tournament_id": params.TournamentID,
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	if params.Type == sanction.TypeGlobalBan {
+		svc.invalidateBan(ctx, userID)
+	}
+	svc.logger.Info("user %d issued a %s to user %d", issuerID, params.Type, userID)
+
+	message := fmt.Sprintf("You received a %s: %s", typeLabel(params.Type), params.Reason)
+	if params.ExpiresAt != nil {
+		message = fmt.Sprintf("%s (until %s)", message, params.ExpiresAt.Format(time.RFC1123))
+	}
+	svc.sendSanctionNotification(ctx, userID, "Sanction", message)
+
+	return svc.getSanction(ctx, sanctionID)
+}
+
+func (svc *sanctionsService) LiftSanction(
+	ctx context.Context,
+	sanctionID int,
+	params *sanctionsmodels.LiftSanctionParams,
+) (*sanctionsmodels.Sanction, error) {
+	entSanction, err := svc.databaseService.Sanction.Query().
+		Where(sanction.ID(sanctionID)).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		return nil, svc.errorFilter.Filter(err, "get")
+	}
+	if !sanctionsmodels.IsActive(entSanction, time.Now()) {
+		return nil, huma.Error409Conflict("sanction is already expired or lifted")
+	}
+
+	liftedBy, err := security.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if entSanction.Edges.User.ID == liftedBy {
+		return nil, huma.Error403Forbidden("can't lift your own sanction")
+	}
+	if err := svc.checkOutranks(ctx, liftedBy, entSanction.Edges.User.ID); err != nil {
+		return nil, err
+	}
+
+	err = databaseservice.WithTx(ctx, svc.databaseService, func(tx *ent.Tx) error {
+		liftedSanction, err := tx.Sanction.UpdateOneID(sanctionID).
+			SetLiftedAt(time.Now()).
+			SetLiftReason(params.Reason).
+			SetLiftedByID(liftedBy).
+			Save(ctx)
+		if err != nil {
+			return svc.errorFilter.Filter(err, "update")
+		}
+		return svc.auditService.Record(ctx, tx, &auditmodels.Entry{
+			Action:     auditmodels.ActionSanctionLift,
+			TargetType: auditmodels.TargetUser,
+			TargetID:   strconv.Itoa(entSanction.Edges.User.ID),
+			Before:     map[string]any{"sanction_id": sanctionID, "lifted_at": entSanction.LiftedAt},
+			After:      map[string]any{"sanction_id": sanctionID, "lifted_at": liftedSanction.LiftedAt, "lift_reason": params.Reason},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if entSanction.Type == sanction.TypeGlobalBan {
+		svc.invalidateBan(ctx, entSanction.Edges.User.ID)
+	}
+
+	svc.sendSanctionNotification(
+		ctx,
+		entSanction.Edges.User.ID,
+		"Sanction Lifted",
+		fmt.Sprintf("Your %s has been lifted", typeLabel(entSanction.Type)),
+	)
+
+	return svc.getSanction(ctx, sanctionID)
+}
+
+// Shutdown closes the Valkey client.
+func (svc *sanctionsService) Shutdown() error {
+	svc.valkeyClient.Close()
+	return nil
+}
+
+// checkOutranks forbids sanctioning a user, or lifting their sanction, without holding strictly more roles than them
+func (svc *sanctionsService) checkOutranks(ctx context.Context, issuerID int, userID int) error {
+	issuerRoles, err := svc.rbacService.GetUserRoles(ctx, issuerID)
+	if err != nil {
+		return err
+	}
+	userRoles, err := svc.rbacService.GetUserRoles(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !svc.rbacService.Outranks(issuerRoles, userRoles) {
+		return huma.Error403Forbidden("can't sanction a user with equal or higher roles")
+	}
+	return nil
+}
+
+func (svc *sanctionsService) getSanction(ctx context.Context, sanctionID int) (*sanctionsmodels.Sanction, error) {
+	entSanction, err := svc.databaseService.Sanction.Query().
+		Where(sanction.ID(sanctionID)).
+		WithUser().
+		WithTournament().
+		WithIssuer().
+		WithLiftedBy().
+		Only(ctx)
+	if err != nil {
+		return nil, svc.errorFilter.Filter(err, "get")
+	}
+	return sanctionsmodels.NewSanctionFromEnt(entSanction), nil
+}
+
+func (svc *sanctionsService) sendSanctionNotification(ctx context.Context, userID int, title, message string) {
+	notif, err := svc.notificationsService.CreateNotification(
+		ctx,
+		userID,
+		"sanction",
+		title,
+		message,
+		"/users/me",
+	)
+	if err != nil {
+		svc.logger.Error("failed to notify user %d of a sanction: %v", userID, err)
+		return
+	}
+
+	if data, err := json.Marshal(lightmodels.NewNotificationFromEnt(notif)); err == nil {
+		svc.pubsubService.Publish(ctx, fmt.Sprintf("Notification:%d", userID), data)
+	}
+}
+
+//// HELPERS ////
+
+// isActive matches the sanctions that are neither expired nor lifted
+func isActive(now time.Time) predicate.Sanction {
+	return sanction.And(
+		sanction.LiftedAtIsNil(),
+		sanction.Or(sanction.ExpiresAtIsNil(), sanction.ExpiresAtGT(now)),
+	)
+}
+
+// sanctionError describes the sanction blocking the user
+func sanctionError(entSanction *ent.Sanction) error {
+	message := fmt.Sprintf("you are under a %s: %s", typeLabel(entSanction.Type), entSanction.Reason)
+	if entSanction.ExpiresAt != nil {
+		message = fmt.Sprintf("%s (until %s)", message, entSanction.ExpiresAt.Format(time.RFC3339))
+	}
+	return huma.Error403Forbidden(message)
+}
+
+func typeLabel(sanctionType sanction.Type) string {
+	labels := map[sanction.Type]string{
+		sanction.TypeGlobalBan:     "global ban",
+		sanction.TypeTournamentBan: "tournament ban",
+		sanction.TypeVoteBan:       "vote ban",
+		sanction.TypeWarning:       "warning",
+	}
+	if label, ok := labels[sanctionType]; ok {
+		return label
+	}
+	return string(sanctionType)
+}
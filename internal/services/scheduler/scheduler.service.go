@@ -0,0 +1,79 @@
+package schedulerservice
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"base-website/pkg/logger"
+
+	"github.com/samber/do"
+)
+
+// Job is a unit of background work periodically run by the scheduler.
+type Job func(ctx context.Context) error
+
+type SchedulerService interface {
+	// Every registers a job that is run every interval until the scheduler shuts down.
+	Every(name string, interval time.Duration, job Job)
+}
+
+type schedulerService struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	logger *logger.Logger
+}
+
+func NewProvider() func(i *do.Injector) (SchedulerService, error) {
+	return func(i *do.Injector) (SchedulerService, error) {
+		return New()
+	}
+}
+
+// New creates a new instance of the scheduler service.
+// Jobs are started as soon as they are registered.
+func New() (SchedulerService, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &schedulerService{
+		ctx:    ctx,
+		cancel: cancel,
+		logger: logger.New().WithContext("SchedulerService"),
+	}, nil
+}
+
+func (s *schedulerService) Every(name string, interval time.Duration, job Job) {
+	s.logger.Info("scheduling job %s every %s", name, interval)
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-ticker.C:
+				s.run(name, job)
+			}
+		}
+	}()
+}
+
+// Shutdown stops every scheduled job and waits for the running ones to return.
+func (s *schedulerService) Shutdown() error {
+	s.cancel()
+	s.wg.Wait()
+	return nil
+}
+
+func (s *schedulerService) run(name string, job Job) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.logger.Error("job %s panicked: %v", name, r)
+		}
+	}()
+	if err := job(s.ctx); err != nil {
+		s.logger.Error("job %s failed: %v", name, err)
+	}
+}
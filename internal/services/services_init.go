@@ -2,18 +2,24 @@ package services
 
 import (
 	appsservice "base-website/internal/services/apps"
+	auditservice "base-website/internal/services/audit"
 	authservice "base-website/internal/services/auth"
 	configservice "base-website/internal/services/config"
 	consentsservice "base-website/internal/services/consents"
 	databaseservice "base-website/internal/services/database"
 	intraservice "base-website/internal/services/intra"
 	invitationsservice "base-website/internal/services/invitations"
+	janitorservice "base-website/internal/services/janitor"
 	notificationsservice "base-website/internal/services/notifications"
 	openidservice "base-website/internal/services/openid"
 	pubsubservice "base-website/internal/services/pubsub"
 	rankgroupservice "base-website/internal/services/rank_group"
+	ratelimitservice "base-website/internal/services/ratelimit"
 	rbacservice "base-website/internal/services/rbac"
 	s3service "base-website/internal/services/s3"
+	sanctionsservice "base-website/internal/services/sanctions"
+	schedulerservice "base-website/internal/services/scheduler"
+	sessionsservice "base-website/internal/services/sessions"
 	teamsservice "base-website/internal/services/teams"
 	tournamentsservice "base-website/internal/services/tournaments"
 	usersservice "base-website/internal/services/users"
@@ -25,7 +31,11 @@ import (
 func InitServices(i *do.Injector) error {
 	do.Provide(i, configservice.NewProvider())
 	do.Provide(i, rbacservice.NewProvider())
+	do.Provide(i, ratelimitservice.NewProvider())
 	do.Provide(i, databaseservice.NewProvider())
+	do.Provide(i, auditservice.NewProvider())
+	do.Provide(i, sanctionsservice.NewProvider())
+	do.Provide(i, schedulerservice.NewProvider())
 	do.Provide(i, openidservice.NewProvider())
 	do.Provide(i, s3service.NewProvider())
 	do.Provide(i, authservice.NewProvider())
@@ -40,5 +50,7 @@ func InitServices(i *do.Injector) error {
 	do.Provide(i, invitationsservice.NewProvider())
 	do.Provide(i, rankgroupservice.NewProvider())
 	do.Provide(i, notificationsservice.NewProvider())
+	do.Provide(i, sessionsservice.NewProvider())
+	do.Provide(i, janitorservice.NewProvider())
 	return nil
 }
@@ -0,0 +1,16 @@
+package sessionsmodels
+
+import (
+	"time"
+)
+
+type Session struct {
+	ID              string     `json:"id" example:"4f0b2f9c-5d4e-4d0b-9c55-1d6f2b1d7c3e" description:"The ID of the session"`
+	ApplicationID   string     `json:"application_id" example:"c-e4v2-3b1f" description:"The ID of the application the session was opened for"`
+	ApplicationName string     `json:"application_name" example:"Club bot" description:"The name of the application the session was opened for"`
+	Scopes          []string   `json:"scopes" example:"[\"openid\", \"profile\"]" description:"The scopes granted to the session" nullable:"false"`
+	CreatedAt       time.Time  `json:"created_at" example:"2024-09-01T00:00:00Z" description:"The date the session was opened"`
+	ExpiresAt       time.Time  `json:"expires_at" example:"2024-09-01T00:00:00Z" description:"The date the session expires if it is not renewed"`
+	LastUsedAt      *time.Time `json:"last_used_at" example:"2024-09-01T00:00:00Z" description:"The last date a token of the session was used on the API"`
+	Current         bool       `json:"current" example:"true" description:"Whether the session is the one making the request"`
+}
@@ -0,0 +1,392 @@
+package sessionsservice
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"time"
+
+	"base-website/ent"
+	"base-website/ent/app"
+	"base-website/ent/authrefreshtoken"
+	"base-website/ent/authtoken"
+	"base-website/internal/security"
+	databaseservice "base-website/internal/services/database"
+	sessionsmodels "base-website/internal/services/sessions/models"
+	"base-website/pkg/logger"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/samber/do"
+)
+
+// builtinApplicationID is the client of the tokens issued by the website login
+const builtinApplicationID = "builtin"
+
+type SessionService interface {
+	// ListMine returns the active sessions of the current user, optionally restricted to one app.
+	ListMine(ctx context.Context, applicationID string) ([]*sessionsmodels.Session, error)
+	// RevokeMineForApp revokes every session of the current user for the app.
+	RevokeMineForApp(ctx context.Context, applicationID string) error
+	// RevokeMine revokes one session of the current user.
+	RevokeMine(ctx context.Context, id string) error
+	// RevokeMineExceptCurrent revokes every session of the current user but the one making the request.
+	RevokeMineExceptCurrent(ctx context.Context) error
+}
+
+type sessionService struct {
+	databaseService databaseservice.DatabaseService
+	logger          *logger.Logger
+}
+
+func NewProvider() func(i *do.Injector) (SessionService, error) {
+	return func(i *do.Injector) (SessionService, error) {
+		return New(
+			do.MustInvoke[databaseservice.DatabaseService](i),
+		)
+	}
+}
+
+func New(
+	databaseService databaseservice.DatabaseService,
+) (SessionService, error) {
+	return &sessionService{
+		databaseService: databaseService,
+		logger:          logger.New().WithContext("SessionService"),
+	}, nil
+}
+
+// ListMine groups the tokens of the user by refresh token family: the refresh tokens rotated from the same
+// login and the access tokens issued from them form one session, an access token issued without refresh token
+// is a session on its own.
+func (svc *sessionService) ListMine(
+	ctx context.Context,
+	applicationID string,
+) ([]*sessionsmodels.Session, error) {
+	subject, err := currentSubject(ctx)
+	if err != nil {
+		return nil, err
+	}
+	current, err := svc.currentSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+
+	refreshQuery := svc.databaseService.AuthRefreshToken.Query().
+		Where(
+			authrefreshtoken.UserID(subject),
+			authrefreshtoken.ExpirationGT(now),
+		)
+	accessQuery := svc.databaseService.AuthToken.Query().
+		Where(
+			authtoken.Subject(subject),
+			authtoken.ExpirationGT(now),
+		)
+	if applicationID != "" {
+		refreshQuery.Where(authrefreshtoken.ApplicationID(applicationID))
+		accessQuery.Where(authtoken.ApplicationID(applicationID))
+	}
+	refreshTokens, err := refreshQuery.All(ctx)
+	if err != nil {
+		svc.logger.Error("failed to list refresh tokens %s", err.Error())
+		return nil, huma.Error500InternalServerError("failed to list sessions")
+	}
+	accessTokens, err := accessQuery.All(ctx)
+	if err != nil {
+		svc.logger.Error("failed to list access tokens %s", err.Error())
+		return nil, huma.Error500InternalServerError("failed to list sessions")
+	}
+
+	sessions := make([]*sessionsmodels.Session, 0, len(refreshTokens)+len(accessTokens))
+	families := make(map[string]*sessionsmodels.Session, len(refreshTokens))
+	tokenFamilies := make(map[string]string, len(refreshTokens))
+	for _, refreshToken := range refreshTokens {
+		tokenFamilies[refreshToken.ID] = refreshToken.FamilyID
+		session, ok := families[refreshToken.FamilyID]
+		if !ok {
+			session = &sessionsmodels.Session{
+				ID:            refreshToken.FamilyID,
+				ApplicationID: refreshToken.ApplicationID,
+				Scopes:        refreshToken.Scopes,
+				CreatedAt:     refreshToken.CreatedAt,
+				ExpiresAt:     refreshToken.Expiration,
+				Current:       refreshToken.FamilyID == current,
+			}
+			families[refreshToken.FamilyID] = session
+			sessions = append(sessions, session)
+		}
+		if refreshToken.CreatedAt.Before(session.CreatedAt) {
+			session.CreatedAt = refreshToken.CreatedAt
+		}
+		if !refreshToken.Used {
+			// The unused token is the latest of the family
+			session.Scopes = refreshToken.Scopes
+			session.ExpiresAt = refreshToken.Expiration
+		}
+	}
+	for _, accessToken := range accessTokens {
+		if session, ok := families[tokenFamilies[accessToken.RefreshTokenID]]; ok {
+			if accessToken.LastUsedAt != nil &&
+				(session.LastUsedAt == nil || accessToken.LastUsedAt.After(*session.LastUsedAt)) {
+				session.LastUsedAt = accessToken.LastUsedAt
+			}
+			continue
+		}
+		sessions = append(sessions, &sessionsmodels.Session{
+			ID:            accessToken.ID,
+			ApplicationID: accessToken.ApplicationID,
+			Scopes:        accessToken.Scopes,
+			CreatedAt:     accessToken.CreatedAt,
+			ExpiresAt:     accessToken.Expiration,
+			LastUsedAt:    accessToken.LastUsedAt,
+			Current:       accessToken.ID == current,
+		})
+	}
+
+	if err := svc.setApplicationNames(ctx, sessions); err != nil {
+		return nil, err
+	}
+	slices.SortFunc(sessions, func(a, b *sessionsmodels.Session) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+	return sessions, nil
+}
+
+func (svc *sessionService) RevokeMineForApp(ctx context.Context, applicationID string) error {
+	subject, err := currentSubject(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = svc.databaseService.AuthToken.Delete().
+		Where(
+			authtoken.Subject(subject),
+			authtoken.ApplicationID(applicationID),
+		).
+		Exec(ctx)
+	if err != nil {
+		svc.logger.Error("failed to delete access tokens %s", err.Error())
+		return huma.Error500InternalServerError("failed to revoke sessions")
+	}
+	_, err = svc.databaseService.AuthRefreshToken.Delete().
+		Where(
+			authrefreshtoken.UserID(subject),
+			authrefreshtoken.ApplicationID(applicationID),
+		).
+		Exec(ctx)
+	if err != nil {
+		svc.logger.Error("failed to delete refresh tokens %s", err.Error())
+		return huma.Error500InternalServerError("failed to revoke sessions")
+	}
+	return nil
+}
+
+func (svc *sessionService) RevokeMine(ctx context.Context, id string) error {
+	subject, err := currentSubject(ctx)
+	if err != nil {
+		return err
+	}
+
+	familyID := ""
+	exists, err := svc.databaseService.AuthRefreshToken.Query().
+		Where(
+			authrefreshtoken.FamilyID(id),
+			authrefreshtoken.UserID(subject),
+		).
+		Exist(ctx)
+	if err != nil {
+		svc.logger.Error("failed to get refresh tokens %s", err.Error())
+		return huma.Error500InternalServerError("failed to revoke session")
+	}
+	if exists {
+		familyID = id
+	} else {
+		accessToken, err := svc.databaseService.AuthToken.Query().
+			Where(
+				authtoken.ID(id),
+				authtoken.Subject(subject),
+			).
+			Only(ctx)
+		if err != nil {
+			return huma.Error404NotFound("session not found")
+		}
+		err = svc.databaseService.AuthToken.DeleteOneID(accessToken.ID).Exec(ctx)
+		if err != nil {
+			svc.logger.Error("failed to delete access token %s", err.Error())
+			return huma.Error500InternalServerError("failed to revoke session")
+		}
+		familyID, err = svc.tokenFamily(ctx, accessToken.RefreshTokenID)
+		if err != nil {
+			return err
+		}
+	}
+	if familyID == "" {
+		return nil
+	}
+
+	refreshTokenIDs, err := svc.familyTokenIDs(ctx, subject, familyID)
+	if err != nil {
+		return err
+	}
+	_, err = svc.databaseService.AuthToken.Delete().
+		Where(
+			authtoken.Subject(subject),
+			authtoken.RefreshTokenIDIn(refreshTokenIDs...),
+		).
+		Exec(ctx)
+	if err != nil {
+		svc.logger.Error("failed to delete access tokens %s", err.Error())
+		return huma.Error500InternalServerError("failed to revoke session")
+	}
+	_, err = svc.databaseService.AuthRefreshToken.Delete().
+		Where(
+			authrefreshtoken.FamilyID(familyID),
+			authrefreshtoken.UserID(subject),
+		).
+		Exec(ctx)
+	if err != nil {
+		svc.logger.Error("failed to delete refresh tokens %s", err.Error())
+		return huma.Error500InternalServerError("failed to revoke session")
+	}
+	return nil
+}
+
+func (svc *sessionService) RevokeMineExceptCurrent(ctx context.Context) error {
+	subject, err := currentSubject(ctx)
+	if err != nil {
+		return err
+	}
+	claims, err := security.GetClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	current, err := svc.currentSession(ctx)
+	if err != nil {
+		return err
+	}
+	// Keep the access tokens refreshed from the current session
+	currentRefreshTokenIDs, err := svc.familyTokenIDs(ctx, subject, current)
+	if err != nil {
+		return err
+	}
+
+	_, err = svc.databaseService.AuthToken.Delete().
+		Where(
+			authtoken.Subject(subject),
+			authtoken.IDNEQ(claims.TokenID),
+			authtoken.RefreshTokenIDNotIn(currentRefreshTokenIDs...),
+		).
+		Exec(ctx)
+	if err != nil {
+		svc.logger.Error("failed to delete access tokens %s", err.Error())
+		return huma.Error500InternalServerError("failed to revoke sessions")
+	}
+	_, err = svc.databaseService.AuthRefreshToken.Delete().
+		Where(
+			authrefreshtoken.UserID(subject),
+			authrefreshtoken.FamilyIDNEQ(current),
+		).
+		Exec(ctx)
+	if err != nil {
+		svc.logger.Error("failed to delete refresh tokens %s", err.Error())
+		return huma.Error500InternalServerError("failed to revoke sessions")
+	}
+	return nil
+}
+
+// currentSession returns the ID of the session of the token making the request
+func (svc *sessionService) currentSession(ctx context.Context) (string, error) {
+	claims, err := security.GetClaimsFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	accessToken, err := svc.databaseService.AuthToken.Query().
+		Where(authtoken.ID(claims.TokenID)).
+		Select(authtoken.FieldRefreshTokenID).
+		Only(ctx)
+	if err != nil {
+		return "", huma.Error401Unauthorized("token not found")
+	}
+	familyID, err := svc.tokenFamily(ctx, accessToken.RefreshTokenID)
+	if err != nil {
+		return "", err
+	}
+	if familyID != "" {
+		return familyID, nil
+	}
+	return claims.TokenID, nil
+}
+
+// tokenFamily returns the family of a refresh token, or an empty string when it no longer exists
+func (svc *sessionService) tokenFamily(ctx context.Context, refreshTokenID string) (string, error) {
+	if refreshTokenID == "" {
+		return "", nil
+	}
+	refreshToken, err := svc.databaseService.AuthRefreshToken.Query().
+		Where(authrefreshtoken.ID(refreshTokenID)).
+		Select(authrefreshtoken.FieldFamilyID).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		svc.logger.Error("failed to get refresh token %s", err.Error())
+		return "", huma.Error500InternalServerError("failed to get session")
+	}
+	return refreshToken.FamilyID, nil
+}
+
+// familyTokenIDs returns the IDs of the refresh tokens of the family
+func (svc *sessionService) familyTokenIDs(ctx context.Context, subject, familyID string) ([]string, error) {
+	ids, err := svc.databaseService.AuthRefreshToken.Query().
+		Where(
+			authrefreshtoken.FamilyID(familyID),
+			authrefreshtoken.UserID(subject),
+		).
+		IDs(ctx)
+	if err != nil {
+		svc.logger.Error("failed to list refresh tokens %s", err.Error())
+		return nil, huma.Error500InternalServerError("failed to get session")
+	}
+	return ids, nil
+}
+
+func (svc *sessionService) setApplicationNames(
+	ctx context.Context,
+	sessions []*sessionsmodels.Session,
+) error {
+	ids := make([]string, 0, len(sessions))
+	for _, session := range sessions {
+		if session.ApplicationID != builtinApplicationID && !slices.Contains(ids, session.ApplicationID) {
+			ids = append(ids, session.ApplicationID)
+		}
+	}
+	apps, err := svc.databaseService.App.Query().
+		Where(app.IDIn(ids...)).
+		Select(app.FieldName).
+		All(ctx)
+	if err != nil {
+		svc.logger.Error("failed to get apps %s", err.Error())
+		return huma.Error500InternalServerError("failed to list sessions")
+	}
+	names := make(map[string]string, len(apps))
+	for _, appEnt := range apps {
+		names[appEnt.ID] = appEnt.Name
+	}
+	for _, session := range sessions {
+		if session.ApplicationID == builtinApplicationID {
+			session.ApplicationName = "Website"
+			continue
+		}
+		session.ApplicationName = names[session.ApplicationID]
+	}
+	return nil
+}
+
+// currentSubject returns the token subject of the current user
+func currentSubject(ctx context.Context) (string, error) {
+	userID, err := security.GetUserIDFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(userID), nil
+}
@@ -2,17 +2,21 @@ package teamsservice
 
 import (
 	"base-website/ent"
+	"base-website/ent/sanction"
 	"base-website/ent/team"
 	"base-website/ent/teammember"
 	"base-website/ent/tournament"
 	"base-website/ent/user"
 	"base-website/internal/lightmodels"
 	"base-website/internal/security"
+	auditservice "base-website/internal/services/audit"
+	auditmodels "base-website/internal/services/audit/models"
 	databaseservice "base-website/internal/services/database"
 	notificationsservice "base-website/internal/services/notifications"
 	pubsubservice "base-website/internal/services/pubsub"
 	rbacservice "base-website/internal/services/rbac"
 	s3service "base-website/internal/services/s3"
+	sanctionsservice "base-website/internal/services/sanctions"
 	teamsmodels "base-website/internal/services/teams/models"
 	tournamentsservice "base-website/internal/services/tournaments"
 	"base-website/pkg/errorfilters"
@@ -21,6 +25,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -41,6 +46,7 @@ type TeamsService interface {
 }
 
 type teamsService struct {
+	auditService         auditservice.AuditService
 	databaseService      databaseservice.DatabaseService
 	errorFilter          errorfilters.ErrorFilter
 	rbacService          rbacservice.RBACService
@@ -48,30 +54,36 @@ type teamsService struct {
 	tournamentsService   tournamentsservice.TournamentsService
 	notificationsService notificationsservice.NotificationsService
 	pubsubService        pubsubservice.PubSubService
+	sanctionsService     sanctionsservice.SanctionsService
 }
 
 func NewProvider() func(i *do.Injector) (TeamsService, error) {
 	return func(i *do.Injector) (TeamsService, error) {
 		return New(
+			do.MustInvoke[auditservice.AuditService](i),
 			do.MustInvoke[databaseservice.DatabaseService](i),
 			do.MustInvoke[rbacservice.RBACService](i),
 			do.MustInvoke[s3service.S3Service](i),
 			do.MustInvoke[tournamentsservice.TournamentsService](i),
 			do.MustInvoke[notificationsservice.NotificationsService](i),
 			do.MustInvoke[pubsubservice.PubSubService](i),
+			do.MustInvoke[sanctionsservice.SanctionsService](i),
 		)
 	}
 }
 
 func New(
+	auditService auditservice.AuditService,
 	databaseService databaseservice.DatabaseService,
 	rbacService rbacservice.RBACService,
 	s3service s3service.S3Service,
 	tournamentsService tournamentsservice.TournamentsService,
 	notificationsService notificationsservice.NotificationsService,
 	pubsubService pubsubservice.PubSubService,
+	sanctionsService sanctionsservice.SanctionsService,
 ) (TeamsService, error) {
 	return &teamsService{
+		auditService:         auditService,
 		databaseService:      databaseService,
 		errorFilter:          errorfilters.NewEntErrorFilter().WithEntityTypeName("team"),
 		rbacService:          rbacService,
@@ -79,6 +91,7 @@ func New(
 		tournamentsService:   tournamentsService,
 		notificationsService: notificationsService,
 		pubsubService:        pubsubService,
+		sanctionsService:     sanctionsService,
 	}, nil
 }
 
@@ -146,6 +159,10 @@ func (svc *teamsService) CreateTeam(
 		return nil, err
 	}
 
+	if err := svc.sanctionsService.CheckSanctions(ctx, userID, &tournamentID, sanction.TypeTournamentBan); err != nil {
+		return nil, err
+	}
+
 	entTeamMember, err := svc.databaseService.TeamMember.Query().Where(
 		teammember.HasUserWith(user.IDEQ(userID)),
 		teammember.HasTournamentWith(tournament.IDEQ(tournamentID)),
@@ -634,14 +651,38 @@ func (svc *teamsService) UnlockTeam(
 	wasRegistered := entTeam.IsRegistered
 	wasWaitlisted := entTeam.IsWaitlisted
 
-	entTeam, err = svc.databaseService.Team.UpdateOneID(entTeam.ID).
-		SetIsLocked(false).
-		SetIsRegistered(false).
-		SetIsWaitlisted(false).
-		ClearWaitlistPosition().
-		Save(ctx)
+	before := map[string]any{
+		"is_locked":         entTeam.IsLocked,
+		"is_registered":     entTeam.IsRegistered,
+		"is_waitlisted":     entTeam.IsWaitlisted,
+		"waitlist_position": entTeam.WaitlistPosition,
+	}
+	err = databaseservice.WithTx(ctx, svc.databaseService, func(tx *ent.Tx) error {
+		var err error
+		entTeam, err = tx.Team.UpdateOneID(entTeam.ID).
+			SetIsLocked(false).
+			SetIsRegistered(false).
+			SetIsWaitlisted(false).
+			ClearWaitlistPosition().
+			Save(ctx)
+		if err != nil {
+			return svc.errorFilter.Filter(err, "unlock_team")
+		}
+		return svc.auditService.Record(ctx, tx, &auditmodels.Entry{
+			Action:     auditmodels.ActionTeamUnlock,
+			TargetType: auditmodels.TargetTeam,
+			TargetID:   strconv.Itoa(teamID),
+			Before:     before,
+			After: map[string]any{
+				"is_locked":         entTeam.IsLocked,
+				"is_registered":     entTeam.IsRegistered,
+				"is_waitlisted":     entTeam.IsWaitlisted,
+				"waitlist_position": entTeam.WaitlistPosition,
+			},
+		})
+	})
 	if err != nil {
-		return nil, svc.errorFilter.Filter(err, "unlock_team")
+		return nil, err
 	}
 
 	if wasRegistered {
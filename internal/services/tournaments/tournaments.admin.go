@@ -8,12 +8,15 @@ import (
 	"base-website/ent/user"
 	"base-website/internal/lightmodels"
 	"base-website/internal/security"
+	auditmodels "base-website/internal/services/audit/models"
+	databaseservice "base-website/internal/services/database"
 	tournamentsmodels "base-website/internal/services/tournaments/models"
 	"base-website/pkg/authz"
 	"context"
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -193,14 +196,6 @@ func (svc *tournamentsService) CreateTournament(
 }
 
 func (svc *tournamentsService) UpdateTournament(ctx context.Context, tournamentID int, input tournamentsmodels.UpdateTournament) (*lightmodels.Tournament, error) {
-	myRole, err := svc.GetTournamentUserRole(ctx, tournamentID)
-	if err != nil {
-		return nil, err
-	}
-	if myRole == nil || *myRole == tournamentadmin.RoleADMIN {
-		return nil, huma.Error401Unauthorized("don't have required role")
-	}
-
 	entTournament, err := svc.databaseService.Tournament.Get(ctx, tournamentID)
 	if err != nil {
 		return nil, svc.errorFilter.Filter(err, "retrieve")
@@ -313,14 +308,6 @@ func (svc *tournamentsService) DeleteTournament(
 	ctx context.Context,
 	tournamentID int,
 ) error {
-	myRole, err := svc.GetTournamentUserRole(ctx, tournamentID)
-	if err != nil {
-		return err
-	}
-	if myRole == nil || *myRole != tournamentadmin.RoleCREATOR {
-		return huma.Error401Unauthorized("don't have required role")
-	}
-
 	entTournament, err := svc.databaseService.Tournament.Get(ctx, tournamentID)
 	if err != nil {
 		return svc.errorFilter.Filter(err, "retrieve")
@@ -330,11 +317,21 @@ func (svc *tournamentsService) DeleteTournament(
 		return huma.Error401Unauthorized("Can't delete tournament that is finished and visible")
 	}
 
-	if err := svc.databaseService.Tournament.DeleteOneID(tournamentID).Exec(ctx); err != nil {
-		return svc.errorFilter.Filter(err, "delete")
-	}
-
-	return nil
+	return databaseservice.WithTx(ctx, svc.databaseService, func(tx *ent.Tx) error {
+		if err := tx.Tournament.DeleteOneID(tournamentID).Exec(ctx); err != nil {
+			return svc.errorFilter.Filter(err, "delete")
+		}
+		return svc.auditService.Record(ctx, tx, &auditmodels.Entry{
+			Action:     auditmodels.ActionTournamentDelete,
+			TargetType: auditmodels.TargetTournament,
+			TargetID:   strconv.Itoa(tournamentID),
+			Before: map[string]any{
+				"slug":       entTournament.Slug,
+				"name":       entTournament.Name,
+				"is_visible": entTournament.IsVisible,
+			},
+		})
+	})
 }
 
 func (svc *tournamentsService) AddAdminToTournament(
@@ -343,11 +340,9 @@ func (svc *tournamentsService) AddAdminToTournament(
 	userID int,
 	role string,
 ) (*lightmodels.Tournament, error) {
-	myRole, err := svc.GetTournamentUserRole(ctx, tournamentID)
-	if err != nil {
-		return nil, err
-	}
-	if myRole == nil || *myRole == tournamentadmin.RoleADMIN {
+	// The role was resolved by the RBAC middleware from the operation scope
+	myRole := security.TournamentRoleFromContext(ctx)
+	if myRole == nil {
 		return nil, huma.Error401Unauthorized("don't have required role")
 	}
 	if role == "SUPER_ADMIN" && *myRole != tournamentadmin.RoleCREATOR {
@@ -368,13 +363,24 @@ func (svc *tournamentsService) AddAdminToTournament(
 		return nil, huma.Error400BadRequest("user is already an admin for this tournament")
 	}
 
-	if _, err := svc.databaseService.TournamentAdmin.
-		Create().
-		SetTournamentID(tournamentID).
-		SetUserID(userID).
-		SetRole(tournamentadmin.Role(role)).
-		Save(ctx); err != nil {
-		return nil, svc.errorFilter.Filter(err, "create")
+	err = databaseservice.WithTx(ctx, svc.databaseService, func(tx *ent.Tx) error {
+		if _, err := tx.TournamentAdmin.
+			Create().
+			SetTournamentID(tournamentID).
+			SetUserID(userID).
+			SetRole(tournamentadmin.Role(role)).
+			Save(ctx); err != nil {
+			return svc.errorFilter.Filter(err, "create")
+		}
+		return svc.auditService.Record(ctx, tx, &auditmodels.Entry{
+			Action:     auditmodels.ActionTournamentAdminAdd,
+			TargetType: auditmodels.TargetTournament,
+			TargetID:   strconv.Itoa(tournamentID),
+			After:      adminAuditState(userID, role),
+		})
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	reloaded, err := svc.databaseService.Tournament.
@@ -401,11 +407,8 @@ func (svc *tournamentsService) EditAdminToTournament(
 	userID int,
 	role string,
 ) (*lightmodels.Tournament, error) {
-	myRole, err := svc.GetTournamentUserRole(ctx, tournamentID)
-	if err != nil {
-		return nil, err
-	}
-	if myRole == nil || *myRole == tournamentadmin.RoleADMIN {
+	myRole := security.TournamentRoleFromContext(ctx)
+	if myRole == nil {
 		return nil, huma.Error401Unauthorized("don't have required role")
 	}
 	if role == "SUPER_ADMIN" && *myRole != tournamentadmin.RoleCREATOR {
@@ -427,11 +430,23 @@ func (svc *tournamentsService) EditAdminToTournament(
 		return nil, huma.Error401Unauthorized("don't have required role to edit this user")
 	}
 
-	if _, err := svc.databaseService.TournamentAdmin.
-		UpdateOneID(existing.ID).
-		SetRole(tournamentadmin.Role(role)).
-		Save(ctx); err != nil {
-		return nil, svc.errorFilter.Filter(err, "update")
+	err = databaseservice.WithTx(ctx, svc.databaseService, func(tx *ent.Tx) error {
+		if _, err := tx.TournamentAdmin.
+			UpdateOneID(existing.ID).
+			SetRole(tournamentadmin.Role(role)).
+			Save(ctx); err != nil {
+			return svc.errorFilter.Filter(err, "update")
+		}
+		return svc.auditService.Record(ctx, tx, &auditmodels.Entry{
+			Action:     auditmodels.ActionTournamentAdminEdit,
+			TargetType: auditmodels.TargetTournament,
+			TargetID:   strconv.Itoa(tournamentID),
+			Before:     adminAuditState(userID, string(existing.Role)),
+			After:      adminAuditState(userID, role),
+		})
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	reloaded, err := svc.databaseService.Tournament.
@@ -457,11 +472,8 @@ func (svc *tournamentsService) DeleteAdminToTournament(
 	tournamentID int,
 	userID int,
 ) (*lightmodels.Tournament, error) {
-	myRole, err := svc.GetTournamentUserRole(ctx, tournamentID)
-	if err != nil {
-		return nil, err
-	}
-	if myRole == nil || *myRole == tournamentadmin.RoleADMIN {
+	myRole := security.TournamentRoleFromContext(ctx)
+	if myRole == nil {
 		return nil, huma.Error401Unauthorized("don't have required role")
 	}
 
@@ -480,8 +492,19 @@ func (svc *tournamentsService) DeleteAdminToTournament(
 		return nil, huma.Error401Unauthorized("don't have required role to delete this user")
 	}
 
-	if err := svc.databaseService.TournamentAdmin.DeleteOneID(existing.ID).Exec(ctx); err != nil {
-		return nil, svc.errorFilter.Filter(err, "delete")
+	err = databaseservice.WithTx(ctx, svc.databaseService, func(tx *ent.Tx) error {
+		if err := tx.TournamentAdmin.DeleteOneID(existing.ID).Exec(ctx); err != nil {
+			return svc.errorFilter.Filter(err, "delete")
+		}
+		return svc.auditService.Record(ctx, tx, &auditmodels.Entry{
+			Action:     auditmodels.ActionTournamentAdminRemove,
+			TargetType: auditmodels.TargetTournament,
+			TargetID:   strconv.Itoa(tournamentID),
+			Before:     adminAuditState(userID, string(existing.Role)),
+		})
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	reloaded, err := svc.databaseService.Tournament.
@@ -506,14 +529,6 @@ func (svc *tournamentsService) EndTournament(
 	ctx context.Context,
 	tournamentID int,
 ) error {
-	myRole, err := svc.GetTournamentUserRole(ctx, tournamentID)
-	if err != nil {
-		return err
-	}
-	if myRole == nil || *myRole == tournamentadmin.RoleADMIN {
-		return huma.Error401Unauthorized("don't have required role")
-	}
-
 	entTournament, err := svc.databaseService.Tournament.Get(ctx, tournamentID)
 	if err != nil {
 		return svc.errorFilter.Filter(err, "get tournament")
@@ -555,3 +570,10 @@ func (svc *tournamentsService) EndTournament(
 
 	return nil
 }
+
+// adminAuditState is the audited state of a tournament admin, the user stays in the diff when only the role changes
+func adminAuditState(userID int, role string) map[string]any {
+	return map[string]any{
+		"admin": map[string]any{"user_id": userID, "role": role},
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"base-website/ent/tournamentadmin"
 	"base-website/internal/lightmodels"
 	"base-website/internal/security"
+	auditservice "base-website/internal/services/audit"
 	databaseservice "base-website/internal/services/database"
 	rbacservice "base-website/internal/services/rbac"
 	s3service "base-website/internal/services/s3"
@@ -36,6 +37,7 @@ type TournamentsService interface {
 }
 
 type tournamentsService struct {
+	auditService    auditservice.AuditService
 	databaseService databaseservice.DatabaseService
 	errorFilter     errorfilters.ErrorFilter
 	rbacService     rbacservice.RBACService
@@ -45,6 +47,7 @@ type tournamentsService struct {
 func NewProvider() func(i *do.Injector) (TournamentsService, error) {
 	return func(i *do.Injector) (TournamentsService, error) {
 		return New(
+			do.MustInvoke[auditservice.AuditService](i),
 			do.MustInvoke[databaseservice.DatabaseService](i),
 			do.MustInvoke[rbacservice.RBACService](i),
 			do.MustInvoke[s3service.S3Service](i),
@@ -53,11 +56,13 @@ func NewProvider() func(i *do.Injector) (TournamentsService, error) {
 }
 
 func New(
+	auditService auditservice.AuditService,
 	databaseService databaseservice.DatabaseService,
 	rbacService rbacservice.RBACService,
 	s3service s3service.S3Service,
 ) (TournamentsService, error) {
 	return &tournamentsService{
+		auditService:    auditService,
 		databaseService: databaseService,
 		errorFilter:     errorfilters.NewEntErrorFilter().WithEntityTypeName("user"),
 		rbacService:     rbacService,
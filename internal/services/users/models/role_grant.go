@@ -0,0 +1,50 @@
+package usersmodels
+
+import (
+	"time"
+
+	"base-website/ent"
+	"base-website/internal/lightmodels"
+)
+
+type RoleGrant struct {
+	Role      string                 `json:"role" example:"vote_admin" description:"The granted role"`
+	Reason    string                 `json:"reason" example:"Organizing the BDE elections" description:"Why the role was granted"`
+	ExpiresAt *time.Time             `json:"expires_at" example:"2024-01-01T00:00:00Z" description:"When the role is revoked, null if the role never expires"`
+	CreatedAt time.Time              `json:"created_at" example:"2024-01-01T00:00:00Z" description:"When the role was granted"`
+	GrantedBy *lightmodels.LightUser `json:"granted_by,omitempty" description:"The admin who granted the role"`
+}
+
+type GrantRoleParams struct {
+	Reason    string     `json:"reason,omitempty" example:"Organizing the BDE elections" description:"Why the role is granted" required:"false"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty" example:"2024-01-01T00:00:00Z" description:"When the role is revoked, the role never expires if omitted" required:"false"`
+}
+
+func NewRoleGrantFromEnt(entRoleGrant *ent.RoleGrant) *RoleGrant {
+	if entRoleGrant == nil {
+		return nil
+	}
+	return &RoleGrant{
+		Role:      entRoleGrant.Role,
+		Reason:    entRoleGrant.Reason,
+		ExpiresAt: entRoleGrant.ExpiresAt,
+		CreatedAt: entRoleGrant.CreatedAt,
+		GrantedBy: lightmodels.NewLightUserFromEnt(entRoleGrant.Edges.GrantedBy),
+	}
+}
+
+// NewRoleGrantsFromRoles lists every role of the user, the roles without a grant are permanent
+func NewRoleGrantsFromRoles(roles []string, entRoleGrants []*ent.RoleGrant) []*RoleGrant {
+	roleGrants := make([]*RoleGrant, 0, len(roles))
+	for _, role := range roles {
+		roleGrant := &RoleGrant{Role: role}
+		for _, entRoleGrant := range entRoleGrants {
+			if entRoleGrant.Role == role {
+				roleGrant = NewRoleGrantFromEnt(entRoleGrant)
+				break
+			}
+		}
+		roleGrants = append(roleGrants, roleGrant)
+	}
+	return roleGrants
+}
@@ -0,0 +1,398 @@
+package usersservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"base-website/ent"
+	"base-website/ent/app"
+	"base-website/ent/predicate"
+	"base-website/ent/rolegrant"
+	"base-website/ent/user"
+	"base-website/internal/lightmodels"
+	"base-website/internal/security"
+	auditmodels "base-website/internal/services/audit/models"
+	databaseservice "base-website/internal/services/database"
+	usersmodels "base-website/internal/services/users/models"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/danielgtaylor/huma/v2"
+)
+
+// baseRole is the role every user has, it can't be granted nor revoked
+const baseRole = "user"
+
+func (svc *usersService) ListRoleGrants(ctx context.Context, id int) ([]*usersmodels.RoleGrant, error) {
+	entUser, err := svc.databaseService.User.Query().
+		Where(user.ID(id)).
+		WithRoleGrants(func(q *ent.RoleGrantQuery) {
+			q.WithGrantedBy()
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, svc.errorFilter.Filter(err, "get")
+	}
+	return usersmodels.NewRoleGrantsFromRoles(entUser.Roles, entUser.Edges.RoleGrants), nil
+}
+
+func (svc *usersService) GrantRole(
+	ctx context.Context,
+	id int,
+	role string,
+	params *usersmodels.GrantRoleParams,
+) (*usersmodels.RoleGrant, error) {
+	if role == baseRole {
+		return nil, huma.Error400BadRequest("the user role can't be granted")
+	}
+	if err := checkRoles(svc.rbacService.ListRoles(), []string{role}); err != nil {
+		return nil, err
+	}
+	if params.ExpiresAt != nil && !params.ExpiresAt.After(time.Now()) {
+		return nil, huma.Error422UnprocessableEntity("expires_at must be in the future")
+	}
+
+	entUser, err := svc.databaseService.User.Get(ctx, id)
+	if err != nil {
+		return nil, svc.errorFilter.Filter(err, "get")
+	}
+	if svc.isSuperAdminUser(entUser) {
+		return nil, huma.Error403Forbidden("can't change super admin user roles")
+	}
+	if entUser.AnonymizedAt != nil {
+		return nil, huma.Error403Forbidden("can't grant a role to an anonymized user")
+	}
+
+	grantedBy, err := security.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var roleGrantID int
+	err = databaseservice.WithTx(ctx, svc.databaseService, func(tx *ent.Tx) error {
+		// The roles are read again under a row lock, a concurrent grant or revocation would be lost otherwise
+		entUser, err := lockUser(ctx, tx, id)
+		if err != nil {
+			return svc.errorFilter.Filter(err, "get")
+		}
+		previousGrant, err := tx.RoleGrant.Query().
+			Where(rolegrant.Role(role), rolegrant.HasUserWith(user.ID(id))).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return svc.errorFilter.Filter(err, "get")
+		}
+
+		before := map[string]any{"roles": entUser.Roles, "reason": "", "expires_at": (*time.Time)(nil)}
+		if previousGrant != nil {
+			before["reason"] = previousGrant.Reason
+			before["expires_at"] = previousGrant.ExpiresAt
+			update := tx.RoleGrant.UpdateOne(previousGrant).
+				SetReason(params.Reason).
+				SetGrantedByID(grantedBy)
+			if params.ExpiresAt != nil {
+				update.SetExpiresAt(*params.ExpiresAt)
+			} else {
+				update.ClearExpiresAt()
+			}
+			if err := update.Exec(ctx); err != nil {
+				return svc.errorFilter.Filter(err, "update")
+			}
+			roleGrantID = previousGrant.ID
+		} else {
+			created, err := tx.RoleGrant.Create().
+				SetRole(role).
+				SetReason(params.Reason).
+				SetNillableExpiresAt(params.ExpiresAt).
+				SetUserID(id).
+				SetGrantedByID(grantedBy).
+				Save(ctx)
+			if err != nil {
+				return svc.errorFilter.Filter(err, "create")
+			}
+			roleGrantID = created.ID
+		}
+
+		roles := entUser.Roles
+		if !slices.Contains(roles, role) {
+			roles = append(slices.Clone(roles), role)
+		}
+		updatedUser, err := setUserRoles(ctx, tx, id, roles)
+		if err != nil {
+			return svc.errorFilter.Filter(err, "update")
+		}
+
+		return svc.auditService.Record(ctx, tx, &auditmodels.Entry{
+			Action:     auditmodels.ActionUserRoleGrant,
+			TargetType: auditmodels.TargetUser,
+			TargetID:   strconv.Itoa(id),
+			Before:     before,
+			After:      map[string]any{"roles": updatedUser.Roles, "reason": params.Reason, "expires_at": params.ExpiresAt},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	svc.rbacService.InvalidateUserRoles(ctx, id)
+
+	message := fmt.Sprintf("You have been granted the role '%s'", role)
+	if params.ExpiresAt != nil {
+		message = fmt.Sprintf("%s until %s", message, params.ExpiresAt.Format(time.RFC1123))
+	}
+	svc.sendRoleNotification(ctx, id, "Role Granted", message)
+
+	entRoleGrant, err := svc.databaseService.RoleGrant.Query().
+		Where(rolegrant.ID(roleGrantID)).
+		WithGrantedBy().
+		Only(ctx)
+	if err != nil {
+		return nil, svc.errorFilter.Filter(err, "get")
+	}
+	return usersmodels.NewRoleGrantFromEnt(entRoleGrant), nil
+}
+
+func (svc *usersService) RevokeRole(ctx context.Context, id int, role string) error {
+	if role == baseRole {
+		return huma.Error400BadRequest("the user role can't be revoked")
+	}
+
+	entUser, err := svc.databaseService.User.Get(ctx, id)
+	if err != nil {
+		return svc.errorFilter.Filter(err, "get")
+	}
+	if svc.isSuperAdminUser(entUser) {
+		return huma.Error403Forbidden("can't change super admin user roles")
+	}
+	if !slices.Contains(entUser.Roles, role) {
+		return huma.Error404NotFound(fmt.Sprintf("the user doesn't have the role %s", role))
+	}
+
+	err = databaseservice.WithTx(ctx, svc.databaseService, func(tx *ent.Tx) error {
+		previousUser, updatedUser, err := svc.removeRole(ctx, tx, id, role)
+		if err != nil {
+			return err
+		}
+		return svc.auditService.Record(ctx, tx, &auditmodels.Entry{
+			Action:     auditmodels.ActionUserRoleRevoke,
+			TargetType: auditmodels.TargetUser,
+			TargetID:   strconv.Itoa(id),
+			Before:     map[string]any{"kind": previousUser.Kind, "roles": previousUser.Roles},
+			After:      map[string]any{"kind": updatedUser.Kind, "roles": updatedUser.Roles},
+		})
+	})
+	if err != nil {
+		return err
+	}
+	svc.rbacService.InvalidateUserRoles(ctx, id)
+
+	svc.sendRoleNotification(ctx, id, "Role Revoked", fmt.Sprintf("Your role '%s' has been revoked", role))
+	return nil
+}
+
+// revokeExpiredRoleGrants removes the expired roles from their users
+// and notifies them, the admin who granted the role and the super admins.
+func (svc *usersService) revokeExpiredRoleGrants(ctx context.Context) error {
+	expiredGrants, err := svc.databaseService.RoleGrant.Query().
+		Where(rolegrant.ExpiresAtLTE(time.Now())).
+		WithUser().
+		WithGrantedBy().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	if len(expiredGrants) == 0 {
+		return nil
+	}
+
+	superAdmins, err := svc.databaseService.User.Query().
+		Where(hasRole("super_admin")).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, expiredGrant := range expiredGrants {
+		entUser := expiredGrant.Edges.User
+		err := databaseservice.WithTx(ctx, svc.databaseService, func(tx *ent.Tx) error {
+			previousUser, updatedUser, err := svc.removeRole(ctx, tx, entUser.ID, expiredGrant.Role)
+			if err != nil {
+				return err
+			}
+			return svc.auditService.Record(ctx, tx, &auditmodels.Entry{
+				Action:     auditmodels.ActionUserRoleExpire,
+				TargetType: auditmodels.TargetUser,
+				TargetID:   strconv.Itoa(entUser.ID),
+				Before:     map[string]any{"kind": previousUser.Kind, "roles": previousUser.Roles},
+				After:      map[string]any{"kind": updatedUser.Kind, "roles": updatedUser.Roles},
+			})
+		})
+		if err != nil {
+			svc.logger.Error("failed to revoke expired role %s of user %d: %v", expiredGrant.Role, entUser.ID, err)
+			continue
+		}
+		svc.rbacService.InvalidateUserRoles(ctx, entUser.ID)
+		svc.logger.Info("revoked expired role %s of user %d", expiredGrant.Role, entUser.ID)
+
+		svc.sendRoleNotification(
+			ctx,
+			entUser.ID,
+			"Role Expired",
+			fmt.Sprintf("Your role '%s' has expired", expiredGrant.Role),
+		)
+
+		admins := slices.Clone(superAdmins)
+		if expiredGrant.Edges.GrantedBy != nil {
+			admins = append(admins, expiredGrant.Edges.GrantedBy.ID)
+		}
+		slices.Sort(admins)
+		for _, adminID := range slices.Compact(admins) {
+			if adminID == entUser.ID {
+				continue
+			}
+			svc.sendRoleNotification(
+				ctx,
+				adminID,
+				"Role Expired",
+				fmt.Sprintf("The role '%s' of %s has expired", expiredGrant.Role, entUser.Username),
+			)
+		}
+	}
+	return nil
+}
+
+// syncRoleGrants keeps the grants of the roles the user still has and records who gave the new ones,
+// the new roles are permanent.
+func syncRoleGrants(ctx context.Context, tx *ent.Tx, id int, previousRoles []string, roles []string) error {
+	keptRoles := slices.DeleteFunc(slices.Clone(previousRoles), func(role string) bool {
+		return !slices.Contains(roles, role)
+	})
+	_, err := tx.RoleGrant.Delete().
+		Where(rolegrant.RoleNotIn(keptRoles...), rolegrant.HasUserWith(user.ID(id))).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	grantedBy, err := security.GetUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	for _, role := range roles {
+		if role == baseRole || slices.Contains(previousRoles, role) {
+			continue
+		}
+		err := tx.RoleGrant.Create().
+			SetRole(role).
+			SetUserID(id).
+			SetGrantedByID(grantedBy).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeRole removes the role and its grant from the user and from the apps they own,
+// it returns the user before and after the change
+func (svc *usersService) removeRole(ctx context.Context, tx *ent.Tx, id int, role string) (*ent.User, *ent.User, error) {
+	entUser, err := lockUser(ctx, tx, id)
+	if err != nil {
+		return nil, nil, svc.errorFilter.Filter(err, "get")
+	}
+	_, err = tx.RoleGrant.Delete().
+		Where(rolegrant.Role(role), rolegrant.HasUserWith(user.ID(id))).
+		Exec(ctx)
+	if err != nil {
+		return nil, nil, svc.errorFilter.Filter(err, "delete")
+	}
+	roles := slices.DeleteFunc(slices.Clone(entUser.Roles), func(r string) bool {
+		return r == role
+	})
+	updatedUser, err := setUserRoles(ctx, tx, id, roles)
+	if err != nil {
+		return nil, nil, svc.errorFilter.Filter(err, "update")
+	}
+	if err := capOwnedAppRoles(ctx, tx, id, updatedUser.Roles); err != nil {
+		return nil, nil, svc.errorFilter.Filter(err, "update apps")
+	}
+	return entUser, updatedUser, nil
+}
+
+// lockUser reads the user and locks its row until the end of the transaction
+func lockUser(ctx context.Context, tx *ent.Tx, id int) (*ent.User, error) {
+	return tx.User.Query().
+		Where(user.ID(id)).
+		ForUpdate().
+		Only(ctx)
+}
+
+// capOwnedAppRoles drops from the apps of the user the roles the user no longer has,
+// an app never has more roles than its owner
+func capOwnedAppRoles(ctx context.Context, tx *ent.Tx, id int, roles []string) error {
+	apps, err := tx.App.Query().
+		Where(app.OwnerID(id)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, entApp := range apps {
+		capped := slices.DeleteFunc(slices.Clone(entApp.Roles), func(role string) bool {
+			return !slices.Contains(roles, role)
+		})
+		if len(capped) == len(entApp.Roles) {
+			continue
+		}
+		if err := tx.App.UpdateOne(entApp).SetRoles(capped).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (svc *usersService) sendRoleNotification(ctx context.Context, userID int, title, message string) {
+	notif, err := svc.notificationsService.CreateNotification(
+		ctx,
+		userID,
+		"role",
+		title,
+		message,
+		"/users/me",
+	)
+	if err != nil {
+		svc.logger.Error("failed to notify user %d of a role change: %v", userID, err)
+		return
+	}
+
+	if data, err := json.Marshal(lightmodels.NewNotificationFromEnt(notif)); err == nil {
+		svc.pubsubService.Publish(ctx, fmt.Sprintf("Notification:%d", userID), data)
+	}
+}
+
+// setUserRoles saves the roles of the user, a user without any admin role is a plain user
+func setUserRoles(ctx context.Context, tx *ent.Tx, id int, roles []string) (*ent.User, error) {
+	kind := user.KindAdmin
+	if len(roles) == 0 || (len(roles) == 1 && roles[0] == baseRole) {
+		roles = []string{baseRole}
+		kind = user.KindUser
+	}
+	return tx.User.UpdateOneID(id).
+		SetKind(kind).
+		SetRoles(roles).
+		Save(ctx)
+}
+
+// hasRole matches the users having the role
+func hasRole(role string) predicate.User {
+	return func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(user.FieldRoles, role))
+	}
+}
+
+// isSuperAdminUser reports whether the user is the super admin set in the config, its roles can't be changed
+func (svc *usersService) isSuperAdminUser(entUser *ent.User) bool {
+	return entUser.IntraID != nil && *entUser.IntraID == svc.configService.GetConfig().SuperAdminUser
+}
@@ -11,12 +11,19 @@ import (
 	"base-website/ent/predicate"
 	"base-website/ent/user"
 	"base-website/internal/lightmodels"
+	auditservice "base-website/internal/services/audit"
+	auditmodels "base-website/internal/services/audit/models"
 	configservice "base-website/internal/services/config"
 	databaseservice "base-website/internal/services/database"
 	intraservice "base-website/internal/services/intra"
+	notificationsservice "base-website/internal/services/notifications"
+	pubsubservice "base-website/internal/services/pubsub"
 	rbacservice "base-website/internal/services/rbac"
+	schedulerservice "base-website/internal/services/scheduler"
 	usersmodels "base-website/internal/services/users/models"
 	"base-website/pkg/errorfilters"
+	intraclientmodels "base-website/pkg/intraclient/models"
+	"base-website/pkg/logger"
 	"base-website/pkg/paging"
 
 	"github.com/danielgtaylor/huma/v2"
@@ -24,7 +31,7 @@ import (
 )
 
 type UserService interface {
-	// This method is used to create a new user from intra data.
+	// This method is used to create a new user from intra data when they log in, it records their last login.
 	UpsertUserFromIntra(ctx context.Context, id int) (*usersmodels.User, error)
 	// This method is used to get a user by its login.
 	GetUserByLogin(ctx context.Context, login string) (*usersmodels.User, error)
@@ -38,40 +45,70 @@ type UserService interface {
 	AnonymizeUserByID(ctx context.Context, id int) (*usersmodels.User, error)
 	// This method is used to search users
 	SearchUsers(ctx context.Context, params *usersmodels.SearchUsersParams) (*paging.Response[*lightmodels.LightUser], error)
+	// This method is used to list the roles of a user with their grant
+	ListRoleGrants(ctx context.Context, id int) ([]*usersmodels.RoleGrant, error)
+	// This method is used to grant a role to a user, until an optional expiry
+	GrantRole(ctx context.Context, id int, role string, params *usersmodels.GrantRoleParams) (*usersmodels.RoleGrant, error)
+	// This method is used to revoke a role of a user
+	RevokeRole(ctx context.Context, id int, role string) error
 }
 
 type usersService struct {
-	configService   configservice.ConfigService
-	databaseService databaseservice.DatabaseService
-	intraService    intraservice.IntraService
-	errorFilter     errorfilters.ErrorFilter
-	rbacService     rbacservice.RBACService
+	auditService         auditservice.AuditService
+	configService        configservice.ConfigService
+	databaseService      databaseservice.DatabaseService
+	intraService         intraservice.IntraService
+	errorFilter          errorfilters.ErrorFilter
+	rbacService          rbacservice.RBACService
+	notificationsService notificationsservice.NotificationsService
+	pubsubService        pubsubservice.PubSubService
+	logger               *logger.Logger
 }
 
 func NewProvider() func(i *do.Injector) (UserService, error) {
 	return func(i *do.Injector) (UserService, error) {
 		return New(
+			do.MustInvoke[auditservice.AuditService](i),
 			do.MustInvoke[configservice.ConfigService](i),
 			do.MustInvoke[databaseservice.DatabaseService](i),
 			do.MustInvoke[intraservice.IntraService](i),
 			do.MustInvoke[rbacservice.RBACService](i),
+			do.MustInvoke[notificationsservice.NotificationsService](i),
+			do.MustInvoke[pubsubservice.PubSubService](i),
+			do.MustInvoke[schedulerservice.SchedulerService](i),
 		)
 	}
 }
 
 func New(
+	auditService auditservice.AuditService,
 	configService configservice.ConfigService,
 	databaseService databaseservice.DatabaseService,
 	intraService intraservice.IntraService,
 	rbacService rbacservice.RBACService,
+	notificationsService notificationsservice.NotificationsService,
+	pubsubService pubsubservice.PubSubService,
+	schedulerService schedulerservice.SchedulerService,
 ) (UserService, error) {
-	return &usersService{
-		configService:   configService,
-		databaseService: databaseService,
-		intraService:    intraService,
-		errorFilter:     errorfilters.NewEntErrorFilter().WithEntityTypeName("user"),
-		rbacService:     rbacService,
-	}, nil
+	svc := &usersService{
+		auditService:         auditService,
+		configService:        configService,
+		databaseService:      databaseService,
+		intraService:         intraService,
+		errorFilter:          errorfilters.NewEntErrorFilter().WithEntityTypeName("user"),
+		rbacService:          rbacService,
+		notificationsService: notificationsService,
+		pubsubService:        pubsubService,
+		logger:               logger.New().WithContext("UsersService"),
+	}
+
+	schedulerService.Every(
+		"revoke-expired-role-grants",
+		time.Duration(configService.GetConfig().RoleGrantsExpiryInterval)*time.Second,
+		svc.revokeExpiredRoleGrants,
+	)
+
+	return svc, nil
 }
 
 func (svc *usersService) UpsertUserFromIntra(
@@ -88,10 +125,14 @@ func (svc *usersService) UpsertUserFromIntra(
 		updateQuery := svc.databaseService.User.UpdateOneID(entUser.ID).
 			SetUsername(intraUser.Login).
 			SetEmail(intraUser.Email).
-			SetNillableIntraID(&intraUser.ID)
+			SetNillableIntraID(&intraUser.ID).
+			SetLastLoginAt(time.Now())
 		if intraUser.Image != nil {
 			updateQuery.SetPicture(intraUser.Image.Versions.Medium)
 		}
+		if campus := primaryCampus(intraUser); campus != nil {
+			updateQuery.SetCampus(*campus)
+		}
 		_, err = updateQuery.Save(ctx)
 		if err != nil {
 			return nil, svc.errorFilter.Filter(err, "update")
@@ -102,7 +143,9 @@ func (svc *usersService) UpsertUserFromIntra(
 	userCreateQuery := svc.databaseService.User.Create().
 		SetUsername(intraUser.Login).
 		SetEmail(intraUser.Email).
-		SetNillableIntraID(&intraUser.ID)
+		SetNillableIntraID(&intraUser.ID).
+		SetNillableCampus(primaryCampus(intraUser)).
+		SetLastLoginAt(time.Now())
 
 	if intraUser.ID == svc.configService.GetConfig().SuperAdminUser {
 		userCreateQuery.SetKind(user.KindAdmin)
@@ -216,14 +259,8 @@ func (svc *usersService) ChangeUserRolesByID(ctx context.Context, id int, roles
 	if err != nil {
 		return nil, err
 	}
-	if *minimalUser.IntraID == svc.configService.GetConfig().SuperAdminUser {
-		return nil, huma.Error403Forbidden("can't anonymize super admin user")
-	}
-
-	kind := user.KindAdmin
-	if len(roles) == 0 {
-		roles = append(roles, "user")
-		kind = user.KindUser
+	if svc.isSuperAdminUser(minimalUser) {
+		return nil, huma.Error403Forbidden("can't change super admin user roles")
 	}
 
 	rbacRoles := svc.rbacService.ListRoles()
@@ -232,13 +269,34 @@ func (svc *usersService) ChangeUserRolesByID(ctx context.Context, id int, roles
 		return nil, err
 	}
 
-	updatedUser, err := svc.databaseService.User.UpdateOneID(id).
-		SetKind(kind).
-		SetRoles(roles).
-		Save(ctx)
+	var updatedUser *ent.User
+	err = databaseservice.WithTx(ctx, svc.databaseService, func(tx *ent.Tx) error {
+		previousUser, err := lockUser(ctx, tx, id)
+		if err != nil {
+			return svc.errorFilter.Filter(err, "get")
+		}
+		updatedUser, err = setUserRoles(ctx, tx, id, roles)
+		if err != nil {
+			return svc.errorFilter.Filter(err, "update")
+		}
+		if err := syncRoleGrants(ctx, tx, id, previousUser.Roles, updatedUser.Roles); err != nil {
+			return svc.errorFilter.Filter(err, "update")
+		}
+		if err := capOwnedAppRoles(ctx, tx, id, updatedUser.Roles); err != nil {
+			return svc.errorFilter.Filter(err, "update apps")
+		}
+		return svc.auditService.Record(ctx, tx, &auditmodels.Entry{
+			Action:     auditmodels.ActionUserRolesChange,
+			TargetType: auditmodels.TargetUser,
+			TargetID:   strconv.Itoa(id),
+			Before:     map[string]any{"kind": previousUser.Kind, "roles": previousUser.Roles},
+			After:      map[string]any{"kind": updatedUser.Kind, "roles": updatedUser.Roles},
+		})
+	})
 	if err != nil {
-		return nil, svc.errorFilter.Filter(err, "update")
+		return nil, err
 	}
+	svc.rbacService.InvalidateUserRoles(ctx, id)
 	return usersmodels.NewUserFromEnt(updatedUser), nil
 }
 
@@ -268,24 +326,59 @@ func (svc *usersService) AnonymizeUserByID(ctx context.Context, id int) (*usersm
 	anonUsername := fmt.Sprintf("anonymous-%d-%d", id, time.Now().Unix())
 
 	now := time.Now()
-	updatedUser, err := svc.databaseService.User.UpdateOneID(id).
-		SetKind(user.KindUser).
-		SetRoles([]string{}).
-		SetUsername(anonUsername).
-		SetEmail("").
-		SetPicture("").
-		SetNillableAnonymizedAt(&now).
-		ClearIntraID().
-		Save(ctx)
+	var updatedUser *ent.User
+	err = databaseservice.WithTx(ctx, svc.databaseService, func(tx *ent.Tx) error {
+		var err error
+		updatedUser, err = tx.User.UpdateOneID(id).
+			SetKind(user.KindUser).
+			SetRoles([]string{}).
+			SetUsername(anonUsername).
+			SetEmail("").
+			SetPicture("").
+			SetNillableAnonymizedAt(&now).
+			ClearIntraID().
+			ClearCampus().
+			Save(ctx)
+		if err != nil {
+			return svc.errorFilter.Filter(err, "update")
+		}
+		// The personal data must not survive in the audit log, only the access the user lost is recorded
+		return svc.auditService.Record(ctx, tx, &auditmodels.Entry{
+			Action:     auditmodels.ActionUserAnonymize,
+			TargetType: auditmodels.TargetUser,
+			TargetID:   strconv.Itoa(id),
+			Before:     map[string]any{"kind": minimalUser.Kind, "roles": minimalUser.Roles},
+			After:      map[string]any{"kind": updatedUser.Kind, "roles": updatedUser.Roles},
+		})
+	})
 	if err != nil {
-		return nil, svc.errorFilter.Filter(err, "update")
+		return nil, err
 	}
+	svc.rbacService.InvalidateUserRoles(ctx, id)
 
 	return usersmodels.NewUserFromEnt(updatedUser), nil
 }
 
 //// HELPERS ////
 
+// primaryCampus returns the name of the primary campus of the intra user, if any.
+func primaryCampus(intraUser *intraclientmodels.IntraUser) *string {
+	for _, campusUser := range intraUser.CampusUsers {
+		if !campusUser.IsPrimary {
+			continue
+		}
+		for _, campus := range intraUser.Campus {
+			if campus.ID == campusUser.CampusID {
+				return &campus.Name
+			}
+		}
+	}
+	if len(intraUser.Campus) == 1 {
+		return &intraUser.Campus[0].Name
+	}
+	return nil
+}
+
 func checkRoles(rbacRoles []string, roles []string) error {
 	for _, role := range roles {
 		found := slices.Contains(rbacRoles, role)
@@ -0,0 +1,30 @@
+package votesmodels
+
+import "time"
+
+type VoteAnalyticsParams struct {
+	VoteID int `path:"id" required:"true" example:"42" description:"The vote ID"`
+
+	// The size of the turnout buckets, in minutes.
+	Interval int `query:"interval" default:"1" minimum:"1" example:"1" description:"The size of the turnout buckets in minutes, raised for long votes to keep at most 500 buckets"`
+}
+
+// TurnoutBreakdown is the participation of a group of users (a role or a campus).
+type TurnoutBreakdown struct {
+	Key         string  `json:"key" example:"user" description:"The role or campus of the group"`
+	Voters      int     `json:"voters" example:"120" description:"The number of users of the group who voted"`
+	ActiveUsers int     `json:"active_users" example:"400" description:"The number of active users of the group"`
+	Rate        float64 `json:"rate" example:"0.3" description:"The share of active users of the group who voted"`
+}
+
+// VoteAnalytics is the participation of a vote over time and per group of users.
+type VoteAnalytics struct {
+	VoteID      int                `json:"vote_id" example:"1" description:"The ID of the vote"`
+	TotalVotes  int                `json:"total_votes" example:"290" description:"The total number of ballots"`
+	ActiveUsers int                `json:"active_users" example:"850" description:"The number of users seen recently"`
+	TurnoutRate float64            `json:"turnout_rate" example:"0.34" description:"The share of active users who voted"`
+	Turnout     []TurnoutPoint     `json:"turnout" description:"The ballots cast per bucket and the cumulative turnout" nullable:"false"`
+	ByRole      []TurnoutBreakdown `json:"by_role" description:"The participation per role" nullable:"false"`
+	ByCampus    []TurnoutBreakdown `json:"by_campus" description:"The participation per intra campus" nullable:"false"`
+	ComputedAt  time.Time          `json:"computed_at" example:"2025-10-10T12:00:00Z" description:"The date the analytics were computed"`
+}
@@ -0,0 +1,48 @@
+package votesmodels
+
+import "time"
+
+type ExportVoteParams struct {
+	VoteID int `path:"id" required:"true" example:"42" description:"The vote ID"`
+
+	// The size of the turnout buckets, in minutes. It is raised for long votes to keep at most 500 buckets.
+	Interval int `query:"interval" default:"60" minimum:"1" example:"60" description:"The size of the turnout buckets in minutes, raised for long votes to keep at most 500 buckets"`
+}
+
+type ExportVoteCSVParams struct {
+	ExportVoteParams
+
+	// The dataset exported as CSV. Ballots are only available for non anonymous votes.
+	Dataset string `query:"dataset" default:"results" enum:"results,turnout,ballots" example:"results" description:"The dataset to export"`
+}
+
+// TurnoutPoint is the number of ballots cast in a time bucket of a vote.
+type TurnoutPoint struct {
+	At         time.Time `json:"at" example:"2025-10-10T12:00:00Z" description:"The start of the bucket"`
+	Votes      int       `json:"votes" example:"12" description:"The number of ballots cast during the bucket"`
+	Cumulative int       `json:"cumulative" example:"48" description:"The number of ballots cast since the start of the vote"`
+}
+
+// Ballot is a single ballot of a non anonymous vote.
+type Ballot struct {
+	UserID      int       `json:"user_id" example:"42" description:"The ID of the voter"`
+	Username    string    `json:"username" example:"froz" description:"The username of the voter"`
+	ComponentID int       `json:"component_id" example:"1" description:"The ID of the chosen component"`
+	Component   string    `json:"component" example:"Go" description:"The name of the chosen component"`
+	CreatedAt   time.Time `json:"created_at" example:"2025-10-10T12:34:56Z" description:"The date the ballot was cast"`
+}
+
+// VoteExport is the full export of the results and participation of a vote.
+type VoteExport struct {
+	VoteID         int               `json:"vote_id" example:"1" description:"The ID of the vote"`
+	Title          string            `json:"title" example:"Best Programming Language 2025" description:"The title of the vote"`
+	StartAt        time.Time         `json:"start_at" example:"2025-10-10T00:00:00Z" description:"The start date of the vote"`
+	EndAt          time.Time         `json:"end_at" example:"2025-10-20T23:59:59Z" description:"The end date of the vote"`
+	Anonymous      bool              `json:"anonymous" example:"true" description:"Whether the ballots of the vote are anonymous"`
+	EligibleVoters int               `json:"eligible_voters" example:"850" description:"The number of users allowed to vote"`
+	TotalVotes     int               `json:"total_votes" example:"290" description:"The total number of ballots"`
+	Results        []ComponentResult `json:"results" description:"The list of results per component" nullable:"false"`
+	Turnout        []TurnoutPoint    `json:"turnout" description:"The ballots cast over time" nullable:"false"`
+	Ballots        []Ballot          `json:"ballots,omitempty" description:"The ballots of the vote, omitted for anonymous votes"`
+	ExportedAt     time.Time         `json:"exported_at" example:"2025-10-21T20:00:00Z" description:"The date of the export"`
+}
@@ -0,0 +1,69 @@
+package votesmodels
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// CSV renders one dataset of the export ("results", "turnout" or "ballots") as CSV.
+func (e *VoteExport) CSV(dataset string) ([]byte, error) {
+	var rows [][]string
+
+	switch dataset {
+	case "results":
+		rows = append(rows, []string{"component_id", "name", "votes", "share", "eligible_voters"})
+		for _, r := range e.Results {
+			share := 0.0
+			if e.TotalVotes > 0 {
+				share = float64(r.Votes) / float64(e.TotalVotes)
+			}
+			rows = append(rows, []string{
+				strconv.Itoa(r.ComponentID),
+				r.Name,
+				strconv.Itoa(r.Votes),
+				strconv.FormatFloat(share, 'f', 4, 64),
+				strconv.Itoa(e.EligibleVoters),
+			})
+		}
+	case "turnout":
+		rows = append(rows, []string{"at", "votes", "cumulative", "turnout"})
+		for _, p := range e.Turnout {
+			turnout := 0.0
+			if e.EligibleVoters > 0 {
+				turnout = float64(p.Cumulative) / float64(e.EligibleVoters)
+			}
+			rows = append(rows, []string{
+				p.At.UTC().Format(time.RFC3339),
+				strconv.Itoa(p.Votes),
+				strconv.Itoa(p.Cumulative),
+				strconv.FormatFloat(turnout, 'f', 4, 64),
+			})
+		}
+	case "ballots":
+		if e.Anonymous {
+			return nil, fmt.Errorf("ballots of an anonymous vote cannot be exported")
+		}
+		rows = append(rows, []string{"user_id", "username", "component_id", "component", "created_at"})
+		for _, b := range e.Ballots {
+			rows = append(rows, []string{
+				strconv.Itoa(b.UserID),
+				b.Username,
+				strconv.Itoa(b.ComponentID),
+				b.Component,
+				b.CreatedAt.UTC().Format(time.RFC3339),
+			})
+		}
+	default:
+		return nil, fmt.Errorf("unknown dataset %q", dataset)
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
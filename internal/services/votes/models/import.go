@@ -0,0 +1,39 @@
+package votesmodels
+
+import (
+	"github.com/danielgtaylor/huma/v2"
+)
+
+type ImportComponents struct {
+	File   huma.FormFile `form:"file" contentType:"application/json,text/csv,text/plain,application/vnd.ms-excel" description:"A JSON array or a CSV file (name,description,color,image_url,image) of components" required:"true"`
+	Images huma.FormFile `form:"images" contentType:"application/zip,application/x-zip-compressed" description:"A zip of the images referenced by the image column"`
+	DryRun bool          `form:"dry_run" example:"false" description:"Only validate the components without creating them"`
+}
+
+// ComponentImportRow is a component to import, either from a JSON array or a CSV row.
+type ComponentImportRow struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Color       string `json:"color"`
+	// ImageURL is fetched when set
+	ImageURL string `json:"image_url"`
+	// Image is the name of a file in the images zip
+	Image string `json:"image"`
+}
+
+type ComponentImportRowReport struct {
+	Row         int      `json:"row" example:"1" description:"The position of the row in the file, starting at 1"`
+	Name        string   `json:"name" example:"Network" description:"The name of the component"`
+	Valid       bool     `json:"valid" example:"true" description:"Whether the row is valid"`
+	Errors      []string `json:"errors" example:"[\"name must be at least 3 characters\"]" description:"The validation errors of the row" nullable:"false"`
+	ComponentID *int     `json:"component_id,omitempty" example:"42" description:"The ID of the created component"`
+}
+
+type ComponentImportReport struct {
+	VoteID   int                        `json:"vote_id" example:"1" description:"The ID of the vote"`
+	Total    int                        `json:"total" example:"30" description:"The number of rows in the file"`
+	Valid    int                        `json:"valid" example:"29" description:"The number of valid rows"`
+	Invalid  int                        `json:"invalid" example:"1" description:"The number of invalid rows"`
+	Imported bool                       `json:"imported" example:"false" description:"Whether the components were created, only when every row is valid"`
+	Rows     []ComponentImportRowReport `json:"rows" description:"The report of each row" nullable:"false"`
+}
@@ -0,0 +1,27 @@
+package votesmodels
+
+import "encoding/json"
+
+const (
+	LiveEventResults      = "results"
+	LiveEventFinalResults = "final_results"
+	LiveEventAnalytics    = "analytics"
+)
+
+// LiveEvent is the envelope of the messages published on the Vote:%d channel.
+type LiveEvent struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// NewLiveEvent marshals a live event of the given type, ready to be published.
+func NewLiveEvent(eventType string, data any) ([]byte, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(LiveEvent{
+		Type: eventType,
+		Data: raw,
+	})
+}
@@ -1,5 +1,7 @@
 package votesmodels
 
+import "time"
+
 // ComponentResult represents the aggregated vote count for a component.
 type ComponentResult struct {
 	ComponentID int    `json:"component_id" example:"1" description:"The ID of the component"`
@@ -13,3 +15,9 @@ type ResultsResponse struct {
 	Results    []ComponentResult `json:"results" description:"The list of results per component"`
 	TotalVotes int               `json:"total_votes" example:"290" description:"The total number of votes across all components"`
 }
+
+// FinalResults is sent on the live stream once the results of a closed vote are published.
+type FinalResults struct {
+	ResultsResponse
+	PublishedAt time.Time `json:"published_at" example:"2025-10-21T20:00:00Z" description:"The date the results were published"`
+}
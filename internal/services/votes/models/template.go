@@ -0,0 +1,27 @@
+package votesmodels
+
+import (
+	"time"
+
+	"base-website/pkg/paging"
+)
+
+type CloneVote struct {
+	Title   *string   `json:"title,omitempty" example:"Best Programming Language 2026" description:"The title of the new vote, defaults to the title of the cloned vote" required:"false" validate:"min=3"`
+	StartAt time.Time `json:"start_at" example:"2026-10-10T00:00:00Z" description:"The start date of the new vote, the other dates are shifted accordingly" required:"true"`
+}
+
+type CreateVoteTemplate struct {
+	Name string `json:"name" example:"MVP of the LAN" description:"The name of the template" required:"true" validate:"min=3"`
+}
+
+type CreateVoteFromTemplate struct {
+	Title   *string   `json:"title,omitempty" example:"MVP of the LAN #12" description:"The title of the new vote, defaults to the title of the template" required:"false" validate:"min=3"`
+	StartAt time.Time `json:"start_at" example:"2026-10-10T00:00:00Z" description:"The start date of the new vote" required:"true"`
+}
+
+type ListVoteTemplatesParams struct {
+	//// PAGINATION AND ORDER ////
+	// The offset of the search
+	paging.Input
+}
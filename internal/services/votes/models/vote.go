@@ -7,6 +7,9 @@ type CreateVote struct {
 	Description string    `json:"description" example:"Vote for your favorite language!" description:"The description of the vote" required:"true" validate:"min=3"`
 	StartAt     time.Time `json:"start_at" example:"2025-10-10T00:00:00Z" description:"The start date of the vote" required:"true"`
 	EndAt       time.Time `json:"end_at" example:"2025-10-20T23:59:59Z" description:"The end date of the vote" required:"true"`
+	Anonymous   *bool     `json:"anonymous,omitempty" example:"true" description:"Whether the ballots are anonymous, defaults to true" required:"false"`
+
+	ResultsRevealAt *time.Time `json:"results_reveal_at,omitempty" example:"2025-10-21T20:00:00Z" description:"The date the results are revealed, defaults to end_at" required:"false"`
 }
 
 type UpdateVote struct {
@@ -15,4 +18,8 @@ type UpdateVote struct {
 	StartAt     *time.Time `json:"start_at" example:"2025-10-10T00:00:00Z" description:"The start date of the vote" required:"false"`
 	EndAt       *time.Time `json:"end_at" example:"2025-10-20T23:59:59Z" description:"The end date of the vote" required:"false"`
 	Visible     *bool      `json:"visible" example:"true" description:"Whether the vote is visible" required:"false"`
+	Anonymous   *bool      `json:"anonymous,omitempty" example:"true" description:"Whether the ballots are anonymous" required:"false"`
+
+	ResultsRevealAt      *time.Time `json:"results_reveal_at,omitempty" example:"2025-10-21T20:00:00Z" description:"The date the results are revealed, defaults to end_at" required:"false"`
+	ClearResultsRevealAt bool       `json:"clear_results_reveal_at,omitempty" example:"false" description:"Remove the results embargo so results are revealed at end_at" required:"false"`
 }
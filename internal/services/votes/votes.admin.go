@@ -23,6 +23,9 @@ func (svc *votesService) CreateVote(
 	if !input.StartAt.Before(input.EndAt) {
 		return nil, fmt.Errorf("start_at must be before end_at")
 	}
+	if input.ResultsRevealAt != nil && input.ResultsRevealAt.Before(input.EndAt) {
+		return nil, fmt.Errorf("results_reveal_at must not be before end_at")
+	}
 
 	userID, err := security.GetUserIDFromContext(ctx)
 	if err != nil {
@@ -35,6 +38,8 @@ func (svc *votesService) CreateVote(
 		SetDescription(input.Description).
 		SetStartAt(input.StartAt).
 		SetEndAt(input.EndAt).
+		SetNillableResultsRevealAt(input.ResultsRevealAt).
+		SetNillableAnonymous(input.Anonymous).
 		SetCreatorID(userID).
 		Save(ctx)
 	if err != nil {
@@ -112,10 +117,49 @@ func (svc *votesService) UpdateVote(
 	if input.Visible != nil {
 		update.SetVisible(*input.Visible)
 	}
+	if input.Anonymous != nil {
+		if existing.Anonymous && !*input.Anonymous {
+			ballots, err := svc.databaseService.UserVote.
+				Query().
+				Where(uservote.HasComponentWith(component.HasVoteWith(vote.IDEQ(voteID)))).
+				Exist(ctx)
+			if err != nil {
+				return nil, svc.errorFilter.Filter(err, "get_uservotes")
+			}
+			if ballots {
+				return nil, fmt.Errorf("cannot lift the anonymity of a vote that already has ballots")
+			}
+		}
+		update.SetAnonymous(*input.Anonymous)
+	}
+
+	desiredReveal := existing.ResultsRevealAt
+	if input.ClearResultsRevealAt {
+		desiredReveal = nil
+		update.ClearResultsRevealAt()
+	} else if input.ResultsRevealAt != nil {
+		desiredReveal = input.ResultsRevealAt
+		update.SetResultsRevealAt(*input.ResultsRevealAt)
+	}
 
 	if !desiredStart.Before(desiredEnd) {
 		return nil, fmt.Errorf("start_at must be before end_at")
 	}
+	if desiredReveal != nil && desiredReveal.Before(desiredEnd) {
+		return nil, fmt.Errorf("results_reveal_at must not be before end_at")
+	}
+
+	// Results pushed back behind an embargo get published again once revealed
+	if existing.ResultsPublishedAt != nil {
+		desiredVisible := existing.Visible
+		if input.Visible != nil {
+			desiredVisible = *input.Visible
+		}
+		desired := &ent.Vote{Visible: desiredVisible, EndAt: desiredEnd, ResultsRevealAt: desiredReveal}
+		if !resultsAvailable(desired, time.Now()) {
+			update.ClearResultsPublishedAt()
+		}
+	}
 
 	if _, err := update.Save(ctx); err != nil {
 		return nil, svc.errorFilter.Filter(err, "update")
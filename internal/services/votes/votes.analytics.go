@@ -0,0 +1,288 @@
+package votesservice
+
+import (
+	"base-website/ent"
+	"base-website/ent/component"
+	"base-website/ent/predicate"
+	"base-website/ent/user"
+	"base-website/ent/uservote"
+	"base-website/ent/vote"
+	"base-website/internal/security"
+	votesmodels "base-website/internal/services/votes/models"
+	"base-website/pkg/authz"
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const unknownCampus = "unknown"
+
+func (svc *votesService) GetAnalytics(
+	ctx context.Context,
+	voteID int,
+	interval time.Duration,
+) (*votesmodels.VoteAnalytics, error) {
+	userID, err := security.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := authz.CheckRoles(ctx, svc.databaseService, userID, "vote_admin", "super_admin"); err != nil {
+		return nil, err
+	}
+
+	entVote, err := svc.databaseService.Vote.
+		Query().
+		Where(vote.IDEQ(voteID)).
+		Only(ctx)
+	if err != nil {
+		return nil, svc.errorFilter.Filter(err, "get_vote")
+	}
+
+	return svc.computeAnalytics(ctx, entVote, interval)
+}
+
+func (svc *votesService) computeAnalytics(
+	ctx context.Context,
+	entVote *ent.Vote,
+	interval time.Duration,
+) (*votesmodels.VoteAnalytics, error) {
+	now := time.Now()
+	end := minTime(entVote.EndAt, now)
+	interval = turnoutInterval(entVote.StartAt, end, interval)
+	turnout, totalVotes, err := svc.countTurnout(ctx, entVote, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	// Active users logged in recently, voters always count as active whenever they last logged in
+	activeDays := svc.configService.GetConfig().VotesActiveUserDays
+	voted := user.HasUserVotesWith(uservote.HasComponentWith(component.HasVoteWith(vote.IDEQ(entVote.ID))))
+	active := user.Or(
+		voted,
+		user.And(
+			user.AnonymizedAtIsNil(),
+			user.LastLoginAtGTE(now.AddDate(0, 0, -activeDays)),
+		),
+	)
+
+	activeUsers, err := svc.databaseService.User.Query().Where(active).Count(ctx)
+	if err != nil {
+		return nil, svc.errorFilter.Filter(err, "count_users")
+	}
+	voters, err := svc.databaseService.User.Query().Where(voted).Count(ctx)
+	if err != nil {
+		return nil, svc.errorFilter.Filter(err, "count_users")
+	}
+
+	byRole := newBreakdown()
+	activeByRole, err := svc.countByRole(ctx, active)
+	if err != nil {
+		return nil, err
+	}
+	votersByRole, err := svc.countByRole(ctx, voted)
+	if err != nil {
+		return nil, err
+	}
+	for role, count := range activeByRole {
+		byRole.add(role, count, votersByRole[role])
+	}
+
+	byCampus := newBreakdown()
+	activeByCampus, err := svc.countByCampus(ctx, active)
+	if err != nil {
+		return nil, err
+	}
+	votersByCampus, err := svc.countByCampus(ctx, voted)
+	if err != nil {
+		return nil, err
+	}
+	for campus, count := range activeByCampus {
+		byCampus.add(campus, count, votersByCampus[campus])
+	}
+
+	return &votesmodels.VoteAnalytics{
+		VoteID:      entVote.ID,
+		TotalVotes:  totalVotes,
+		ActiveUsers: activeUsers,
+		TurnoutRate: rate(voters, activeUsers),
+		Turnout:     turnoutPoints(entVote.StartAt, end, interval, turnout),
+		ByRole:      byRole.list(),
+		ByCampus:    byCampus.list(),
+		ComputedAt:  now,
+	}, nil
+}
+
+// countTurnout counts the ballots of the vote per turnout bucket, it returns them with their total
+func (svc *votesService) countTurnout(
+	ctx context.Context,
+	entVote *ent.Vote,
+	interval time.Duration,
+) (map[int]int, int, error) {
+	rows := make([]struct {
+		Bucket int `json:"bucket"`
+		Count  int `json:"count"`
+	}, 0)
+	err := svc.databaseService.UserVote.Query().
+		Where(uservote.HasComponentWith(component.HasVoteWith(vote.IDEQ(entVote.ID)))).
+		Modify(func(s *sql.Selector) {
+			bucket := sql.ExprFunc(func(b *sql.Builder) {
+				b.WriteString("CAST(FLOOR(EXTRACT(EPOCH FROM ").
+					WriteString(s.C(uservote.FieldCreatedAt)).
+					WriteString(" - CAST(").
+					Arg(entVote.StartAt).
+					WriteString(" AS timestamptz)) / ").
+					WriteString(strconv.FormatInt(int64(interval/time.Second), 10)).
+					WriteString(") AS bigint)")
+			})
+			s.Select().
+				AppendSelectExprAs(bucket, "bucket").
+				AppendSelectAs(sql.Count("*"), "count").
+				GroupBy("bucket")
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, 0, svc.errorFilter.Filter(err, "count_uservotes")
+	}
+
+	counts := make(map[int]int, len(rows))
+	total := 0
+	for _, row := range rows {
+		// The ballots cast before the start, if any, count in the first bucket
+		counts[max(row.Bucket, 0)] += row.Count
+		total += row.Count
+	}
+	return counts, total, nil
+}
+
+// countByRole counts the users matching the predicate per role
+func (svc *votesService) countByRole(ctx context.Context, predicate predicate.User) (map[string]int, error) {
+	rows := make([]struct {
+		Role  string `json:"role"`
+		Count int    `json:"count"`
+	}, 0)
+	err := svc.databaseService.User.Query().
+		Where(predicate).
+		Modify(func(s *sql.Selector) {
+			s.AppendFromExpr(sql.Expr("jsonb_array_elements_text(" + s.C(user.FieldRoles) + ") AS role"))
+			s.Select("role").
+				AppendSelectAs(sql.Count("*"), "count").
+				GroupBy("role")
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, svc.errorFilter.Filter(err, "count_users")
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.Role] = row.Count
+	}
+	return counts, nil
+}
+
+// countByCampus counts the users matching the predicate per campus
+func (svc *votesService) countByCampus(ctx context.Context, predicate predicate.User) (map[string]int, error) {
+	rows := make([]struct {
+		Campus *string `json:"campus"`
+		Count  int     `json:"count"`
+	}, 0)
+	err := svc.databaseService.User.Query().
+		Where(predicate).
+		GroupBy(user.FieldCampus).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, svc.errorFilter.Filter(err, "count_users")
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		campus := unknownCampus
+		if row.Campus != nil && *row.Campus != "" {
+			campus = *row.Campus
+		}
+		counts[campus] += row.Count
+	}
+	return counts, nil
+}
+
+// publishLiveAnalytics streams fresh analytics of the ongoing votes that
+// received ballots since the last run.
+func (svc *votesService) publishLiveAnalytics(ctx context.Context) error {
+	now := time.Now()
+	since := now.Add(-time.Duration(svc.configService.GetConfig().VotesAnalyticsInterval) * time.Second)
+
+	votes, err := svc.databaseService.Vote.Query().
+		Where(
+			vote.VisibleEQ(true),
+			vote.StartAtLTE(now),
+			vote.EndAtGT(since),
+			vote.HasComponentsWith(component.HasUserVotesWith(uservote.CreatedAtGTE(since))),
+		).
+		All(ctx)
+	if err != nil {
+		return svc.errorFilter.Filter(err, "get")
+	}
+
+	for _, entVote := range votes {
+		analytics, err := svc.computeAnalytics(ctx, entVote, time.Minute)
+		if err != nil {
+			svc.logger.Error("failed to compute analytics of vote %d: %v", entVote.ID, err)
+			continue
+		}
+
+		event, err := votesmodels.NewLiveEvent(votesmodels.LiveEventAnalytics, analytics)
+		if err != nil {
+			continue
+		}
+		if err := svc.pubsubService.Publish(ctx, fmt.Sprintf("Vote:%d", entVote.ID), event); err != nil {
+			svc.logger.Error("failed to publish analytics of vote %d: %v", entVote.ID, err)
+		}
+	}
+
+	return nil
+}
+
+type breakdown map[string]*votesmodels.TurnoutBreakdown
+
+func newBreakdown() breakdown {
+	return make(breakdown)
+}
+
+func (b breakdown) get(key string) *votesmodels.TurnoutBreakdown {
+	entry, ok := b[key]
+	if !ok {
+		entry = &votesmodels.TurnoutBreakdown{Key: key}
+		b[key] = entry
+	}
+	return entry
+}
+
+func (b breakdown) add(key string, activeUsers, voters int) {
+	entry := b.get(key)
+	entry.ActiveUsers += activeUsers
+	entry.Voters += voters
+}
+
+func (b breakdown) list() []votesmodels.TurnoutBreakdown {
+	list := make([]votesmodels.TurnoutBreakdown, 0, len(b))
+	for _, entry := range b {
+		entry.Rate = rate(entry.Voters, entry.ActiveUsers)
+		list = append(list, *entry)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Key < list[j].Key
+	})
+	return list
+}
+
+func rate(part, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(part) / float64(total)
+}
@@ -0,0 +1,151 @@
+package votesservice
+
+import (
+	"base-website/ent"
+	"base-website/ent/component"
+	"base-website/ent/user"
+	"base-website/ent/uservote"
+	"base-website/ent/vote"
+	"base-website/internal/security"
+	votesmodels "base-website/internal/services/votes/models"
+	"base-website/pkg/authz"
+	"context"
+	"time"
+)
+
+func (svc *votesService) ExportVote(
+	ctx context.Context,
+	voteID int,
+	interval time.Duration,
+) (*votesmodels.VoteExport, error) {
+	userID, err := security.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := authz.CheckRoles(ctx, svc.databaseService, userID, "vote_admin", "super_admin"); err != nil {
+		return nil, err
+	}
+
+	entVote, err := svc.databaseService.Vote.
+		Query().
+		Where(vote.IDEQ(voteID)).
+		Only(ctx)
+	if err != nil {
+		return nil, svc.errorFilter.Filter(err, "get_vote")
+	}
+
+	results, err := svc.computeResults(ctx, voteID)
+	if err != nil {
+		return nil, err
+	}
+
+	eligible, err := svc.databaseService.User.
+		Query().
+		Where(user.AnonymizedAtIsNil()).
+		Count(ctx)
+	if err != nil {
+		return nil, svc.errorFilter.Filter(err, "count_users")
+	}
+
+	userVotes, err := svc.databaseService.UserVote.
+		Query().
+		Where(uservote.HasComponentWith(component.HasVoteWith(vote.IDEQ(voteID)))).
+		WithUser().
+		WithComponent().
+		Order(ent.Asc(uservote.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, svc.errorFilter.Filter(err, "get_uservotes")
+	}
+
+	castAt := make([]time.Time, len(userVotes))
+	for i, uv := range userVotes {
+		castAt[i] = uv.CreatedAt
+	}
+
+	now := time.Now()
+	export := &votesmodels.VoteExport{
+		VoteID:         entVote.ID,
+		Title:          entVote.Title,
+		StartAt:        entVote.StartAt,
+		EndAt:          entVote.EndAt,
+		Anonymous:      entVote.Anonymous,
+		EligibleVoters: eligible,
+		TotalVotes:     results.TotalVotes,
+		Results:        results.Results,
+		Turnout:        turnoutBuckets(entVote.StartAt, minTime(entVote.EndAt, now), castAt, interval),
+		ExportedAt:     now,
+	}
+
+	// Ballots would reveal who voted for what
+	if !entVote.Anonymous {
+		export.Ballots = make([]votesmodels.Ballot, 0, len(userVotes))
+		for _, uv := range userVotes {
+			if uv.Edges.User == nil || uv.Edges.Component == nil {
+				continue
+			}
+			export.Ballots = append(export.Ballots, votesmodels.Ballot{
+				UserID:      uv.Edges.User.ID,
+				Username:    uv.Edges.User.Username,
+				ComponentID: uv.Edges.Component.ID,
+				Component:   uv.Edges.Component.Name,
+				CreatedAt:   uv.CreatedAt,
+			})
+		}
+	}
+
+	return export, nil
+}
+
+// maxTurnoutBuckets bounds the size of the turnout of long votes
+const maxTurnoutBuckets = 500
+
+// turnoutInterval raises the bucket size to a whole number of minutes
+// when the vote would need more than maxTurnoutBuckets buckets.
+func turnoutInterval(start, end time.Time, interval time.Duration) time.Duration {
+	if minInterval := end.Sub(start) / maxTurnoutBuckets; interval < minInterval {
+		interval = (minInterval + time.Minute).Truncate(time.Minute)
+	}
+	return interval
+}
+
+// turnoutBuckets groups the ballot dates in buckets of the given size,
+// from the start of the vote up to end.
+func turnoutBuckets(start, end time.Time, castAt []time.Time, interval time.Duration) []votesmodels.TurnoutPoint {
+	if interval <= 0 {
+		return make([]votesmodels.TurnoutPoint, 0)
+	}
+	interval = turnoutInterval(start, end, interval)
+	counts := make(map[int]int)
+	for _, at := range castAt {
+		counts[max(int(at.Sub(start)/interval), 0)]++
+	}
+	return turnoutPoints(start, end, interval, counts)
+}
+
+// turnoutPoints lays the ballots counted per bucket out from start to end, with the cumulative turnout
+func turnoutPoints(start, end time.Time, interval time.Duration, counts map[int]int) []votesmodels.TurnoutPoint {
+	points := make([]votesmodels.TurnoutPoint, 0)
+	if interval <= 0 || end.Before(start) {
+		return points
+	}
+
+	cumulative := 0
+	for i, at := 0, start; at.Before(end) || at.Equal(start); i, at = i+1, at.Add(interval) {
+		cumulative += counts[i]
+		points = append(points, votesmodels.TurnoutPoint{
+			At:         at,
+			Votes:      counts[i],
+			Cumulative: cumulative,
+		})
+	}
+
+	return points
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
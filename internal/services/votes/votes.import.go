@@ -0,0 +1,388 @@
+package votesservice
+
+import (
+	"archive/zip"
+	"base-website/ent"
+	"base-website/ent/vote"
+	databaseservice "base-website/internal/services/database"
+	votesmodels "base-website/internal/services/votes/models"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+const (
+	maxImportRows       = 200
+	maxImportImageSize  = 5 << 20
+	maxImportImagesSize = 50 << 20
+	importFetchTimeout  = 10 * time.Second
+	// importImagesTimeout bounds the time spent fetching the images of a whole import
+	importImagesTimeout = 60 * time.Second
+)
+
+// errForbiddenAddress is returned when an image_url resolves to an address that isn't public
+var errForbiddenAddress = errors.New("forbidden address")
+
+// importImage is an image resolved from an URL or the images zip, ready to be uploaded.
+type importImage struct {
+	filename    string
+	contentType string
+	data        []byte
+}
+
+func (svc *votesService) ImportComponents(
+	ctx context.Context,
+	voteID int,
+	input votesmodels.ImportComponents,
+) (*votesmodels.ComponentImportReport, error) {
+	exists, err := svc.databaseService.Vote.Query().Where(vote.IDEQ(voteID)).Exist(ctx)
+	if err != nil {
+		return nil, svc.errorFilter.Filter(err, "get")
+	}
+	if !exists {
+		return nil, huma.Error404NotFound("vote not found")
+	}
+
+	rows, err := parseComponentImportRows(input.File)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	if err := checkImportRowCount(rows); err != nil {
+		return nil, err
+	}
+
+	var archive *zip.Reader
+	if input.Images.IsSet {
+		archive, err = zip.NewReader(input.Images.File, input.Images.Size)
+		if err != nil {
+			return nil, huma.Error400BadRequest("images must be a valid zip archive")
+		}
+	}
+
+	fetchCtx, cancel := context.WithTimeout(ctx, importImagesTimeout)
+	defer cancel()
+	report, images := resolveComponentImportRows(fetchCtx, newImportClient(), archive, rows)
+	report.VoteID = voteID
+
+	// Nothing is created unless every row is valid
+	if report.Invalid > 0 || input.DryRun {
+		return report, nil
+	}
+
+	uploaded := make([]string, 0)
+	err = databaseservice.WithTx(ctx, svc.databaseService, func(tx *ent.Tx) error {
+		for i, row := range rows {
+			entComponent, err := tx.Component.
+				Create().
+				SetName(row.Name).
+				SetDescription(row.Description).
+				SetColor(row.Color).
+				SetVoteID(voteID).
+				Save(ctx)
+			if err != nil {
+				return svc.errorFilter.Filter(err, "create component")
+			}
+			report.Rows[i].ComponentID = &entComponent.ID
+
+			image := images[i]
+			if image == nil {
+				continue
+			}
+
+			objectName := fmt.Sprintf("components/%d/%d_%s", entComponent.ID, time.Now().UnixNano(), image.filename)
+			if err := svc.s3service.UploadObject(ctx, objectName, bytes.NewReader(image.data), int64(len(image.data)), image.contentType); err != nil {
+				return svc.errorFilter.Filter(err, "upload image")
+			}
+			uploaded = append(uploaded, objectName)
+
+			if _, err := tx.Component.UpdateOneID(entComponent.ID).SetImageURL(objectName).Save(ctx); err != nil {
+				return svc.errorFilter.Filter(err, "update component image")
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		svc.removeObjects(ctx, uploaded)
+		return nil, err
+	}
+
+	report.Imported = true
+	return report, nil
+}
+
+// checkImportRowCount rejects an import without components or with more than maxImportRows
+func checkImportRowCount(rows []votesmodels.ComponentImportRow) error {
+	if len(rows) == 0 {
+		return huma.Error400BadRequest("the file contains no component")
+	}
+	if len(rows) > maxImportRows {
+		return huma.Error400BadRequest(fmt.Sprintf("at most %d components can be imported at once", maxImportRows))
+	}
+	return nil
+}
+
+// resolveComponentImportRows validates the rows and resolves their images into a report,
+// an image past the total size of the images of the import is dropped and fails its row
+func resolveComponentImportRows(
+	ctx context.Context,
+	client *http.Client,
+	archive *zip.Reader,
+	rows []votesmodels.ComponentImportRow,
+) (*votesmodels.ComponentImportReport, []*importImage) {
+	report := &votesmodels.ComponentImportReport{
+		Total: len(rows),
+		Rows:  make([]votesmodels.ComponentImportRowReport, len(rows)),
+	}
+	images := make([]*importImage, len(rows))
+
+	imagesSize := 0
+	for i, row := range rows {
+		rowErrors := validateComponentImportRow(row)
+
+		image, err := resolveImportImage(ctx, client, archive, row)
+		switch {
+		case err != nil && ctx.Err() != nil:
+			rowErrors = append(rowErrors, fmt.Sprintf("the images took more than %s to fetch", importImagesTimeout))
+		case err != nil:
+			rowErrors = append(rowErrors, err.Error())
+		case image != nil && imagesSize+len(image.data) > maxImportImagesSize:
+			// The image is dropped so that the import never holds more than the limit
+			rowErrors = append(rowErrors, fmt.Sprintf("the images must be at most %d MB in total", maxImportImagesSize>>20))
+			image = nil
+		case image != nil:
+			imagesSize += len(image.data)
+		}
+		images[i] = image
+
+		report.Rows[i] = votesmodels.ComponentImportRowReport{
+			Row:    i + 1,
+			Name:   row.Name,
+			Valid:  len(rowErrors) == 0,
+			Errors: rowErrors,
+		}
+		if report.Rows[i].Valid {
+			report.Valid++
+		} else {
+			report.Invalid++
+		}
+	}
+	return report, images
+}
+
+// parseComponentImportRows reads the components of a JSON array or a CSV file with a header row.
+func parseComponentImportRows(file huma.FormFile) ([]votesmodels.ComponentImportRow, error) {
+	data, err := io.ReadAll(file.File)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the file")
+	}
+
+	isJSON := strings.EqualFold(filepath.Ext(file.Filename), ".json") ||
+		strings.HasPrefix(file.ContentType, "application/json") ||
+		bytes.HasPrefix(bytes.TrimSpace(data), []byte("["))
+
+	if isJSON {
+		var rows []votesmodels.ComponentImportRow
+		if err := json.Unmarshal(data, &rows); err != nil {
+			return nil, fmt.Errorf("invalid JSON: %v", err)
+		}
+		return rows, nil
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV: %v", err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	columns := make(map[string]int)
+	for i, name := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["name"]; !ok {
+		return nil, fmt.Errorf("the CSV header must contain a name column")
+	}
+
+	get := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	rows := make([]votesmodels.ComponentImportRow, 0, len(records)-1)
+	for _, record := range records[1:] {
+		rows = append(rows, votesmodels.ComponentImportRow{
+			Name:        get(record, "name"),
+			Description: get(record, "description"),
+			Color:       get(record, "color"),
+			ImageURL:    get(record, "image_url"),
+			Image:       get(record, "image"),
+		})
+	}
+	return rows, nil
+}
+
+func validateComponentImportRow(row votesmodels.ComponentImportRow) []string {
+	rowErrors := make([]string, 0)
+	if len(row.Name) < 3 {
+		rowErrors = append(rowErrors, "name must be at least 3 characters")
+	}
+	if len(row.Description) < 3 {
+		rowErrors = append(rowErrors, "description must be at least 3 characters")
+	}
+	if row.Color == "" {
+		rowErrors = append(rowErrors, "color is required")
+	}
+	if row.ImageURL != "" && row.Image != "" {
+		rowErrors = append(rowErrors, "only one of image_url and image can be set")
+	}
+	return rowErrors
+}
+
+// resolveImportImage fetches the image of a row from its URL or the images zip.
+func resolveImportImage(
+	ctx context.Context,
+	client *http.Client,
+	archive *zip.Reader,
+	row votesmodels.ComponentImportRow,
+) (*importImage, error) {
+	switch {
+	case row.ImageURL != "":
+		return fetchImportImage(ctx, client, row.ImageURL)
+	case row.Image != "":
+		if archive == nil {
+			return nil, fmt.Errorf("image %q requires an images zip", row.Image)
+		}
+		return readImportImage(archive, row.Image)
+	}
+	return nil, nil
+}
+
+// newImportClient returns a client that only connects to public addresses and doesn't follow redirects,
+// so that an image_url can't reach the internal network.
+func newImportClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: importFetchTimeout,
+		// The address is checked once resolved, a host name can't point to an internal address
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil || !isPublicAddr(addrPort.Addr()) {
+				return errForbiddenAddress
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: importFetchTimeout,
+		Transport: &http.Transport{
+			// No proxy, it would connect to the address instead of the dialer
+			Proxy:                 nil,
+			DialContext:           dialer.DialContext,
+			TLSHandshakeTimeout:   importFetchTimeout,
+			ResponseHeaderTimeout: importFetchTimeout,
+			MaxIdleConns:          10,
+			IdleConnTimeout:       30 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+func isPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsGlobalUnicast() &&
+		!addr.IsPrivate() &&
+		!addr.IsLoopback() &&
+		!addr.IsLinkLocalUnicast() &&
+		!sharedAddressSpace.Contains(addr)
+}
+
+// sharedAddressSpace is the carrier-grade NAT range, IsPrivate doesn't include it
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+func fetchImportImage(ctx context.Context, client *http.Client, rawURL string) (*importImage, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil, fmt.Errorf("image_url must be an http or https URL")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("image_url is invalid")
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch image_url")
+	}
+	defer resp.Body.Close()
+
+	// The status isn't reported, it would tell what answers behind the URL
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch image_url")
+	}
+
+	return newImportImage(path.Base(u.Path), resp.Body)
+}
+
+func readImportImage(archive *zip.Reader, name string) (*importImage, error) {
+	for _, f := range archive.File {
+		if f.FileInfo().IsDir() || (f.Name != name && path.Base(f.Name) != name) {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read image %q from the zip", name)
+		}
+		defer rc.Close()
+		return newImportImage(path.Base(f.Name), rc)
+	}
+	return nil, fmt.Errorf("image %q not found in the zip", name)
+}
+
+func newImportImage(filename string, reader io.Reader) (*importImage, error) {
+	data, err := io.ReadAll(io.LimitReader(reader, maxImportImageSize+1))
+	if err != nil {
+		return nil, errors.New("failed to read image")
+	}
+	if len(data) > maxImportImageSize {
+		return nil, fmt.Errorf("image must be at most %d MB", maxImportImageSize>>20)
+	}
+
+	contentType := http.DetectContentType(data)
+	if !strings.HasPrefix(contentType, "image/") {
+		return nil, fmt.Errorf("image must be an image, got %s", contentType)
+	}
+
+	filename = strings.ReplaceAll(filename, " ", "_")
+	if filename == "" || filename == "." || filename == "/" {
+		filename = "file"
+	}
+
+	return &importImage{
+		filename:    filename,
+		contentType: contentType,
+		data:        data,
+	}, nil
+}
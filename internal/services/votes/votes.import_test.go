@@ -0,0 +1,354 @@
+package votesservice
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+
+	votesmodels "base-website/internal/services/votes/models"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+// pngHeader is enough for http.DetectContentType to see an image
+var pngHeader = []byte("\x89PNG\r\n\x1a\n")
+
+// memoryFile is an uploaded file held in memory
+type memoryFile struct {
+	*strings.Reader
+}
+
+func (memoryFile) Close() error {
+	return nil
+}
+
+func formFile(filename, contentType, content string) huma.FormFile {
+	return huma.FormFile{
+		File:        memoryFile{strings.NewReader(content)},
+		ContentType: contentType,
+		Filename:    filename,
+		Size:        int64(len(content)),
+		IsSet:       true,
+	}
+}
+
+func TestParseComponentImportRows(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     huma.FormFile
+		expected []votesmodels.ComponentImportRow
+		err      string
+	}{
+		{
+			name: "JSON by extension",
+			file: formFile("components.json", "application/octet-stream", `[{"name":"Network","description":"The network","color":"#ff0000","image_url":"https://example.com/network.png"}]`),
+			expected: []votesmodels.ComponentImportRow{
+				{Name: "Network", Description: "The network", Color: "#ff0000", ImageURL: "https://example.com/network.png"},
+			},
+		},
+		{
+			name: "JSON by content",
+			file: formFile("components.txt", "text/plain", ` [{"name":"Network","image":"network.png"}]`),
+			expected: []votesmodels.ComponentImportRow{
+				{Name: "Network", Image: "network.png"},
+			},
+		},
+		{
+			name: "invalid JSON",
+			file: formFile("components.json", "application/json", `[{"name":`),
+			err:  "invalid JSON",
+		},
+		{
+			name: "CSV with reordered and missing columns",
+			file: formFile("components.csv", "text/csv", "Color, NAME ,description\n#00ff00, Network ,The network\n#0000ff,Security\n"),
+			expected: []votesmodels.ComponentImportRow{
+				{Name: "Network", Description: "The network", Color: "#00ff00"},
+				{Name: "Security", Color: "#0000ff"},
+			},
+		},
+		{
+			name:     "CSV header only",
+			file:     formFile("components.csv", "text/csv", "name,description,color\n"),
+			expected: []votesmodels.ComponentImportRow{},
+		},
+		{
+			name: "CSV without a name column",
+			file: formFile("components.csv", "text/csv", "title,color\nNetwork,#ff0000\n"),
+			err:  "the CSV header must contain a name column",
+		},
+		{
+			name: "invalid CSV",
+			file: formFile("components.csv", "text/csv", "name,color\n\"Network,#ff0000\n"),
+			err:  "invalid CSV",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := parseComponentImportRows(tt.file)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("expected error %q, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(rows) != len(tt.expected) {
+				t.Fatalf("expected %d rows, got %d", len(tt.expected), len(rows))
+			}
+			for i := range rows {
+				if rows[i] != tt.expected[i] {
+					t.Errorf("row %d: expected %+v, got %+v", i, tt.expected[i], rows[i])
+				}
+			}
+		})
+	}
+}
+
+func TestValidateComponentImportRow(t *testing.T) {
+	tests := []struct {
+		name   string
+		row    votesmodels.ComponentImportRow
+		errors []string
+	}{
+		{
+			name:   "valid",
+			row:    votesmodels.ComponentImportRow{Name: "Network", Description: "The network", Color: "#ff0000"},
+			errors: []string{},
+		},
+		{
+			name: "every error",
+			row:  votesmodels.ComponentImportRow{Name: "N", Description: "T", ImageURL: "https://example.com/n.png", Image: "n.png"},
+			errors: []string{
+				"name must be at least 3 characters",
+				"description must be at least 3 characters",
+				"color is required",
+				"only one of image_url and image can be set",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateComponentImportRow(tt.row)
+			if strings.Join(errs, "; ") != strings.Join(tt.errors, "; ") {
+				t.Errorf("expected %q, got %q", tt.errors, errs)
+			}
+		})
+	}
+}
+
+func TestCheckImportRowCount(t *testing.T) {
+	if err := checkImportRowCount(nil); err == nil {
+		t.Error("expected an error for an empty import")
+	}
+	if err := checkImportRowCount(make([]votesmodels.ComponentImportRow, maxImportRows)); err != nil {
+		t.Errorf("expected %d rows to be accepted, got %v", maxImportRows, err)
+	}
+	if err := checkImportRowCount(make([]votesmodels.ComponentImportRow, maxImportRows+1)); err == nil {
+		t.Errorf("expected an error for %d rows", maxImportRows+1)
+	}
+}
+
+func TestIsPublicAddr(t *testing.T) {
+	tests := []struct {
+		addr     string
+		expected bool
+	}{
+		{"8.8.8.8", true},
+		{"2001:4860:4860::8888", true},
+		{"::ffff:8.8.8.8", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"::ffff:127.0.0.1", false},
+		{"0.0.0.0", false},
+		{"::", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"fd00::1", false},
+		{"100.64.0.1", false},
+		{"100.127.255.254", false},
+		{"224.0.0.1", false},
+		{"255.255.255.255", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			if public := isPublicAddr(netip.MustParseAddr(tt.addr)); public != tt.expected {
+				t.Errorf("expected public %v, got %v", tt.expected, public)
+			}
+		})
+	}
+}
+
+func TestImportClientRefusesInternalAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(pngHeader)
+	}))
+	defer server.Close()
+
+	_, err := newImportClient().Get(server.URL)
+	if !errors.Is(err, errForbiddenAddress) {
+		t.Fatalf("expected the loopback server to be refused, got %v", err)
+	}
+
+	_, err = fetchImportImage(context.Background(), newImportClient(), server.URL+"/image.png")
+	if err == nil || err.Error() != "failed to fetch image_url" {
+		t.Errorf("expected the fetch to fail without details, got %v", err)
+	}
+}
+
+func TestFetchImportImage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/redirect":
+			http.Redirect(w, r, "/image.png", http.StatusFound)
+		case "/image.png":
+			_, _ = w.Write(pngHeader)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	// The transport of the test server bypasses the address check, the rest of the client is kept
+	client := newImportClient()
+	client.Transport = server.Client().Transport
+
+	image, err := fetchImportImage(context.Background(), client, server.URL+"/image.png")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if image.filename != "image.png" || image.contentType != "image/png" {
+		t.Errorf("unexpected image %s %s", image.filename, image.contentType)
+	}
+
+	tests := []struct {
+		name string
+		url  string
+		err  string
+	}{
+		{"redirects are not followed", server.URL + "/redirect", "failed to fetch image_url"},
+		{"status", server.URL + "/missing.png", "failed to fetch image_url"},
+		{"scheme", "file:///etc/passwd", "image_url must be an http or https URL"},
+		{"not an URL", "://", "image_url must be an http or https URL"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := fetchImportImage(context.Background(), client, tt.url)
+			if err == nil || err.Error() != tt.err {
+				t.Errorf("expected error %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestNewImportImage(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		data     []byte
+		expected string
+		err      string
+	}{
+		{"image", "my image.png", pngHeader, "my_image.png", ""},
+		{"empty name", "/", pngHeader, "file", ""},
+		{"not an image", "image.png", []byte("<html></html>"), "", "image must be an image, got text/html; charset=utf-8"},
+		{"too large", "image.png", append(bytes.Clone(pngHeader), make([]byte, maxImportImageSize)...), "", "image must be at most 5 MB"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			image, err := newImportImage(tt.filename, bytes.NewReader(tt.data))
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("expected error %q, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if image.filename != tt.expected {
+				t.Errorf("expected filename %q, got %q", tt.expected, image.filename)
+			}
+		})
+	}
+}
+
+func TestResolveComponentImportRows(t *testing.T) {
+	image := append(bytes.Clone(pngHeader), make([]byte, maxImportImageSize-len(pngHeader))...)
+	var buffer bytes.Buffer
+	archive := zip.NewWriter(&buffer)
+	for _, name := range []string{"images/a.png", "b.png"} {
+		w, err := archive.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write(image); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		t.Fatal(err)
+	}
+	reader, err := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Enough rows referencing full size images to go past the total size of the images
+	rows := make([]votesmodels.ComponentImportRow, 0)
+	for i := 0; i < maxImportImagesSize/maxImportImageSize+1; i++ {
+		name := "a.png"
+		if i%2 == 1 {
+			name = "b.png"
+		}
+		rows = append(rows, votesmodels.ComponentImportRow{Name: "Component", Description: "A component", Color: "#ffffff", Image: name})
+	}
+	rows = append(rows,
+		votesmodels.ComponentImportRow{Name: "Missing", Description: "A component", Color: "#ffffff", Image: "c.png"},
+		votesmodels.ComponentImportRow{Name: "No image", Description: "A component", Color: "#ffffff"},
+	)
+
+	report, images := resolveComponentImportRows(context.Background(), newImportClient(), reader, rows)
+	if report.Total != len(rows) || report.Valid != len(rows)-2 || report.Invalid != 2 {
+		t.Fatalf("unexpected counts total %d valid %d invalid %d", report.Total, report.Valid, report.Invalid)
+	}
+	overLimit := len(rows) - 3
+	for i, row := range report.Rows {
+		if row.Row != i+1 {
+			t.Errorf("row %d is numbered %d", i, row.Row)
+		}
+		switch i {
+		case overLimit:
+			if row.Valid || images[i] != nil || row.Errors[0] != "the images must be at most 50 MB in total" {
+				t.Errorf("expected the image of row %d to be dropped, got %+v", i+1, row)
+			}
+		case len(rows) - 2:
+			if row.Valid || row.Errors[0] != `image "c.png" not found in the zip` {
+				t.Errorf("expected the missing image to fail row %d, got %+v", i+1, row)
+			}
+		case len(rows) - 1:
+			if !row.Valid || images[i] != nil {
+				t.Errorf("expected row %d to be valid without an image, got %+v", i+1, row)
+			}
+		default:
+			if !row.Valid || images[i] == nil {
+				t.Errorf("expected row %d to be valid with an image, got %+v", i+1, row)
+			}
+		}
+	}
+
+	report, _ = resolveComponentImportRows(context.Background(), newImportClient(), nil, rows[:1])
+	if report.Valid != 0 || report.Rows[0].Errors[0] != `image "a.png" requires an images zip` {
+		t.Errorf("expected the image to require a zip, got %+v", report.Rows[0])
+	}
+}
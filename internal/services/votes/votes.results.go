@@ -0,0 +1,133 @@
+package votesservice
+
+import (
+	"base-website/ent"
+	"base-website/ent/component"
+	"base-website/ent/user"
+	"base-website/ent/uservote"
+	"base-website/ent/vote"
+	"base-website/internal/lightmodels"
+	votesmodels "base-website/internal/services/votes/models"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// resultsRevealDate returns the date the final results of the vote become public.
+func resultsRevealDate(entVote *ent.Vote) time.Time {
+	if entVote.ResultsRevealAt != nil {
+		return *entVote.ResultsRevealAt
+	}
+	return entVote.EndAt
+}
+
+// resultsAvailable reports whether the final results of the vote can be shown.
+func resultsAvailable(entVote *ent.Vote, now time.Time) bool {
+	return entVote.Visible && !entVote.EndAt.After(now) && !resultsRevealDate(entVote).After(now)
+}
+
+// resultsEmbargoed reports whether the vote hides its results, live ones included,
+// until an explicit reveal date.
+func resultsEmbargoed(entVote *ent.Vote, now time.Time) bool {
+	return entVote.ResultsRevealAt != nil && entVote.ResultsRevealAt.After(now)
+}
+
+// publishClosedVotesResults publishes the final results of every closed vote
+// whose reveal date has passed and notifies its participants.
+func (svc *votesService) publishClosedVotesResults(ctx context.Context) error {
+	now := time.Now()
+
+	votes, err := svc.databaseService.Vote.Query().
+		Where(
+			vote.VisibleEQ(true),
+			vote.EndAtLTE(now),
+			vote.ResultsPublishedAtIsNil(),
+			vote.Or(
+				vote.ResultsRevealAtIsNil(),
+				vote.ResultsRevealAtLTE(now),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return svc.errorFilter.Filter(err, "get")
+	}
+
+	for _, entVote := range votes {
+		if err := svc.publishVoteResults(ctx, entVote, now); err != nil {
+			svc.logger.Error("failed to publish results of vote %d: %v", entVote.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (svc *votesService) publishVoteResults(ctx context.Context, entVote *ent.Vote, now time.Time) error {
+	// The results are computed before the vote is claimed, a failure leaves it to be retried on the next run
+	results, err := svc.computeResults(ctx, entVote.ID)
+	if err != nil {
+		return err
+	}
+
+	event, err := votesmodels.NewLiveEvent(votesmodels.LiveEventFinalResults, votesmodels.FinalResults{
+		ResultsResponse: *results,
+		PublishedAt:     now,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Claim the vote so that a single instance publishes its results
+	claimed, err := svc.databaseService.Vote.Update().
+		Where(
+			vote.IDEQ(entVote.ID),
+			vote.ResultsPublishedAtIsNil(),
+		).
+		SetResultsPublishedAt(now).
+		Save(ctx)
+	if err != nil {
+		return svc.errorFilter.Filter(err, "update")
+	}
+	if claimed != 1 {
+		return nil
+	}
+
+	if err := svc.pubsubService.Publish(ctx, fmt.Sprintf("Vote:%d", entVote.ID), event); err != nil {
+		svc.logger.Error("failed to publish final results of vote %d: %v", entVote.ID, err)
+	}
+
+	svc.sendResultsNotifications(ctx, entVote)
+	return nil
+}
+
+func (svc *votesService) sendResultsNotifications(ctx context.Context, entVote *ent.Vote) {
+	participants, err := svc.databaseService.User.Query().
+		Where(user.HasUserVotesWith(
+			uservote.HasComponentWith(component.HasVoteWith(vote.IDEQ(entVote.ID))),
+		)).
+		All(ctx)
+	if err != nil {
+		svc.logger.Error("failed to get participants of vote %d: %v", entVote.ID, err)
+		return
+	}
+
+	href := fmt.Sprintf("/votes/%d", entVote.ID)
+
+	for _, participant := range participants {
+		notif, err := svc.notificationsService.CreateNotification(
+			ctx,
+			participant.ID,
+			"vote",
+			"Vote Results",
+			fmt.Sprintf("The results of the vote '%s' are available", entVote.Title),
+			href,
+		)
+		if err != nil {
+			continue
+		}
+
+		if data, err := json.Marshal(lightmodels.NewNotificationFromEnt(notif)); err == nil {
+			svc.pubsubService.Publish(ctx, fmt.Sprintf("Notification:%d", participant.ID), data)
+		}
+	}
+}
@@ -3,17 +3,24 @@ package votesservice
 import (
 	"base-website/ent"
 	"base-website/ent/component"
+	"base-website/ent/sanction"
 	"base-website/ent/user"
 	"base-website/ent/uservote"
 	"base-website/ent/vote"
 	"base-website/internal/lightmodels"
 	"base-website/internal/security"
+	configservice "base-website/internal/services/config"
 	databaseservice "base-website/internal/services/database"
+	notificationsservice "base-website/internal/services/notifications"
+	pubsubservice "base-website/internal/services/pubsub"
 	rbacservice "base-website/internal/services/rbac"
 	s3service "base-website/internal/services/s3"
+	sanctionsservice "base-website/internal/services/sanctions"
+	schedulerservice "base-website/internal/services/scheduler"
 	votesmodels "base-website/internal/services/votes/models"
 	"base-website/pkg/authz"
 	"base-website/pkg/errorfilters"
+	"base-website/pkg/logger"
 	"base-website/pkg/paging"
 	"context"
 	"errors"
@@ -29,45 +36,90 @@ type VotesService interface {
 	GetVoteByID(ctx context.Context, voteID int) (*lightmodels.Vote, error)
 	SubmitVote(ctx context.Context, componentID int, voteID int) (*votesmodels.ResultsResponse, error)
 	GetResults(ctx context.Context, voteID int, live bool) (*votesmodels.ResultsResponse, error)
+	GetAnalytics(ctx context.Context, voteID int, interval time.Duration) (*votesmodels.VoteAnalytics, error)
 
 	// Admins
 	CreateVote(ctx context.Context, input votesmodels.CreateVote) (*lightmodels.Vote, error)
 	UpdateVote(ctx context.Context, voteID int, input *votesmodels.UpdateVote) (*lightmodels.Vote, error)
 	DeleteVote(ctx context.Context, voteID int) error
+	ExportVote(ctx context.Context, voteID int, interval time.Duration) (*votesmodels.VoteExport, error)
+
+	CloneVote(ctx context.Context, voteID int, input votesmodels.CloneVote) (*lightmodels.Vote, error)
+
+	CreateVoteTemplate(ctx context.Context, voteID int, input votesmodels.CreateVoteTemplate) (*lightmodels.VoteTemplate, error)
+	ListVoteTemplates(ctx context.Context, params *votesmodels.ListVoteTemplatesParams) (*paging.Response[*lightmodels.VoteTemplate], error)
+	GetVoteTemplate(ctx context.Context, templateID int) (*lightmodels.VoteTemplate, error)
+	DeleteVoteTemplate(ctx context.Context, templateID int) error
+	CreateVoteFromTemplate(ctx context.Context, templateID int, input votesmodels.CreateVoteFromTemplate) (*lightmodels.Vote, error)
 
 	CreateComponent(ctx context.Context, input votesmodels.CreateComponent, VoteID int) (*lightmodels.Component, error)
+	ImportComponents(ctx context.Context, voteID int, input votesmodels.ImportComponents) (*votesmodels.ComponentImportReport, error)
 	UpdateComponent(ctx context.Context, componentID int, input *votesmodels.UpdateComponent) (*lightmodels.Component, error)
 	DeleteComponent(ctx context.Context, componentID int) error
 }
 
 type votesService struct {
-	databaseService databaseservice.DatabaseService
-	errorFilter     errorfilters.ErrorFilter
-	rbacService     rbacservice.RBACService
-	s3service       s3service.S3Service
+	configService        configservice.ConfigService
+	databaseService      databaseservice.DatabaseService
+	errorFilter          errorfilters.ErrorFilter
+	rbacService          rbacservice.RBACService
+	s3service            s3service.S3Service
+	pubsubService        pubsubservice.PubSubService
+	notificationsService notificationsservice.NotificationsService
+	sanctionsService     sanctionsservice.SanctionsService
+	logger               *logger.Logger
 }
 
 func NewProvider() func(i *do.Injector) (VotesService, error) {
 	return func(i *do.Injector) (VotesService, error) {
 		return New(
+			do.MustInvoke[configservice.ConfigService](i),
 			do.MustInvoke[databaseservice.DatabaseService](i),
 			do.MustInvoke[rbacservice.RBACService](i),
 			do.MustInvoke[s3service.S3Service](i),
+			do.MustInvoke[pubsubservice.PubSubService](i),
+			do.MustInvoke[notificationsservice.NotificationsService](i),
+			do.MustInvoke[schedulerservice.SchedulerService](i),
+			do.MustInvoke[sanctionsservice.SanctionsService](i),
 		)
 	}
 }
 
 func New(
+	configService configservice.ConfigService,
 	databaseService databaseservice.DatabaseService,
 	rbacService rbacservice.RBACService,
 	s3service s3service.S3Service,
+	pubsubService pubsubservice.PubSubService,
+	notificationsService notificationsservice.NotificationsService,
+	schedulerService schedulerservice.SchedulerService,
+	sanctionsService sanctionsservice.SanctionsService,
 ) (VotesService, error) {
-	return &votesService{
-		databaseService: databaseService,
-		errorFilter:     errorfilters.NewEntErrorFilter().WithEntityTypeName("user"),
-		rbacService:     rbacService,
-		s3service:       s3service,
-	}, nil
+	svc := &votesService{
+		configService:        configService,
+		databaseService:      databaseService,
+		errorFilter:          errorfilters.NewEntErrorFilter().WithEntityTypeName("user"),
+		rbacService:          rbacService,
+		s3service:            s3service,
+		pubsubService:        pubsubService,
+		notificationsService: notificationsService,
+		sanctionsService:     sanctionsService,
+		logger:               logger.New().WithContext("VotesService"),
+	}
+
+	config := configService.GetConfig()
+	schedulerService.Every(
+		"publish-vote-results",
+		time.Duration(config.VotesResultsCheckInterval)*time.Second,
+		svc.publishClosedVotesResults,
+	)
+	schedulerService.Every(
+		"publish-vote-analytics",
+		time.Duration(config.VotesAnalyticsInterval)*time.Second,
+		svc.publishLiveAnalytics,
+	)
+
+	return svc, nil
 }
 
 func (svc *votesService) ListVotes(
@@ -173,6 +225,10 @@ func (svc *votesService) SubmitVote(
 		return nil, err
 	}
 
+	if err := svc.sanctionsService.CheckSanctions(ctx, userID, nil, sanction.TypeVoteBan); err != nil {
+		return nil, err
+	}
+
 	_, err = svc.databaseService.UserVote.
 		Query().
 		Where(
@@ -193,6 +249,10 @@ func (svc *votesService) SubmitVote(
 		return nil, svc.errorFilter.Filter(err, "create_uservote")
 	}
 
+	if resultsEmbargoed(entVote, time.Now()) {
+		return nil, nil
+	}
+
 	return svc.GetResults(ctx, voteID, true)
 }
 
@@ -210,11 +270,20 @@ func (svc *votesService) GetResults(
 	}
 
 	if live == false {
-		if !entVote.Visible || entVote.EndAt.After(time.Now()) {
+		if !resultsAvailable(entVote, time.Now()) {
 			return nil, fmt.Errorf("results are not available yet")
 		}
+	} else if resultsEmbargoed(entVote, time.Now()) {
+		return nil, fmt.Errorf("results are under embargo")
 	}
 
+	return svc.computeResults(ctx, voteID)
+}
+
+func (svc *votesService) computeResults(
+	ctx context.Context,
+	voteID int,
+) (*votesmodels.ResultsResponse, error) {
 	comps, err := svc.databaseService.Component.
 		Query().
 		Where(component.HasVoteWith(vote.IDEQ(voteID))).
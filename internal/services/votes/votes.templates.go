@@ -0,0 +1,366 @@
+package votesservice
+
+import (
+	"base-website/ent"
+	"base-website/ent/schema"
+	"base-website/ent/user"
+	"base-website/ent/vote"
+	"base-website/ent/votetemplate"
+	"base-website/internal/lightmodels"
+	"base-website/internal/security"
+	databaseservice "base-website/internal/services/database"
+	votesmodels "base-website/internal/services/votes/models"
+	"base-website/pkg/authz"
+	"base-website/pkg/paging"
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+// voteDraft holds everything needed to create a new draft vote.
+type voteDraft struct {
+	Title           string
+	Description     string
+	Anonymous       bool
+	StartAt         time.Time
+	EndAt           time.Time
+	ResultsRevealAt *time.Time
+	Components      []schema.VoteTemplateComponent
+}
+
+func (svc *votesService) CloneVote(
+	ctx context.Context,
+	voteID int,
+	input votesmodels.CloneVote,
+) (*lightmodels.Vote, error) {
+	userID, err := security.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := svc.databaseService.Vote.
+		Query().
+		Where(vote.IDEQ(voteID)).
+		WithComponents().
+		Only(ctx)
+	if err != nil {
+		return nil, svc.errorFilter.Filter(err, "get")
+	}
+
+	shift := input.StartAt.Sub(source.StartAt)
+	draft := voteDraft{
+		Title:       source.Title,
+		Description: source.Description,
+		Anonymous:   source.Anonymous,
+		StartAt:     input.StartAt,
+		EndAt:       source.EndAt.Add(shift),
+		Components:  make([]schema.VoteTemplateComponent, 0, len(source.Edges.Components)),
+	}
+	if input.Title != nil {
+		draft.Title = *input.Title
+	}
+	if source.ResultsRevealAt != nil {
+		revealAt := source.ResultsRevealAt.Add(shift)
+		draft.ResultsRevealAt = &revealAt
+	}
+	for _, comp := range source.Edges.Components {
+		draft.Components = append(draft.Components, schema.VoteTemplateComponent{
+			Name:        comp.Name,
+			Description: comp.Description,
+			Color:       comp.Color,
+			ImageURL:    comp.ImageURL,
+		})
+	}
+
+	return svc.createDraftVote(ctx, userID, draft)
+}
+
+func (svc *votesService) CreateVoteTemplate(
+	ctx context.Context,
+	voteID int,
+	input votesmodels.CreateVoteTemplate,
+) (*lightmodels.VoteTemplate, error) {
+	userID, err := security.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := svc.databaseService.Vote.
+		Query().
+		Where(vote.IDEQ(voteID)).
+		WithComponents().
+		Only(ctx)
+	if err != nil {
+		return nil, svc.errorFilter.Filter(err, "get")
+	}
+
+	var templateID int
+	copied := make([]string, 0)
+	err = databaseservice.WithTx(ctx, svc.databaseService, func(tx *ent.Tx) error {
+		create := tx.VoteTemplate.
+			Create().
+			SetName(input.Name).
+			SetTitle(source.Title).
+			SetDescription(source.Description).
+			SetAnonymous(source.Anonymous).
+			SetDurationMinutes(max(1, int(source.EndAt.Sub(source.StartAt).Minutes()))).
+			SetComponents([]schema.VoteTemplateComponent{}).
+			SetCreatorID(userID)
+		if source.ResultsRevealAt != nil {
+			create.SetResultsDelayMinutes(int(source.ResultsRevealAt.Sub(source.EndAt).Minutes()))
+		}
+
+		entTemplate, err := create.Save(ctx)
+		if err != nil {
+			return svc.errorFilter.Filter(err, "create_template")
+		}
+		templateID = entTemplate.ID
+
+		// Templates keep their own copy of the images, they outlive the cloned vote
+		components := make([]schema.VoteTemplateComponent, 0, len(source.Edges.Components))
+		for _, comp := range source.Edges.Components {
+			templateComponent := schema.VoteTemplateComponent{
+				Name:        comp.Name,
+				Description: comp.Description,
+				Color:       comp.Color,
+			}
+			if comp.ImageURL != nil {
+				objectName := copiedObjectName(fmt.Sprintf("vote-templates/%d", entTemplate.ID), *comp.ImageURL)
+				if err := svc.s3service.CopyObject(ctx, *comp.ImageURL, objectName); err != nil {
+					return svc.errorFilter.Filter(err, "copy image")
+				}
+				copied = append(copied, objectName)
+				templateComponent.ImageURL = &objectName
+			}
+			components = append(components, templateComponent)
+		}
+
+		if _, err := tx.VoteTemplate.UpdateOneID(entTemplate.ID).SetComponents(components).Save(ctx); err != nil {
+			return svc.errorFilter.Filter(err, "update_template")
+		}
+		return nil
+	})
+	if err != nil {
+		svc.removeObjects(ctx, copied)
+		return nil, err
+	}
+
+	return svc.GetVoteTemplate(ctx, templateID)
+}
+
+func (svc *votesService) ListVoteTemplates(
+	ctx context.Context,
+	params *votesmodels.ListVoteTemplatesParams,
+) (*paging.Response[*lightmodels.VoteTemplate], error) {
+	query := svc.databaseService.VoteTemplate.Query()
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, svc.errorFilter.Filter(err, "count")
+	}
+
+	query = paging.ApplyQueryPaging(query, params.Input)
+
+	if params.Order == "asc" {
+		query = query.Order(ent.Asc(votetemplate.FieldID))
+	} else {
+		query = query.Order(ent.Desc(votetemplate.FieldID))
+	}
+
+	templates, err := query.WithCreator().All(ctx)
+	if err != nil {
+		return nil, svc.errorFilter.Filter(err, "get")
+	}
+
+	return paging.CreatePagingResponse(
+		lightmodels.NewVoteTemplatesFromEnt(ctx, templates, svc.s3service),
+		total,
+		params.Input.Page,
+		params.Input.Limit,
+	), nil
+}
+
+func (svc *votesService) GetVoteTemplate(
+	ctx context.Context,
+	templateID int,
+) (*lightmodels.VoteTemplate, error) {
+	entTemplate, err := svc.databaseService.VoteTemplate.
+		Query().
+		Where(votetemplate.IDEQ(templateID)).
+		WithCreator().
+		Only(ctx)
+	if err != nil {
+		return nil, svc.errorFilter.Filter(err, "get")
+	}
+	return lightmodels.NewVoteTemplateFromEnt(ctx, entTemplate, svc.s3service), nil
+}
+
+func (svc *votesService) DeleteVoteTemplate(
+	ctx context.Context,
+	templateID int,
+) error {
+	userID, err := security.GetUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	entTemplate, err := svc.databaseService.VoteTemplate.
+		Query().
+		Where(votetemplate.IDEQ(templateID)).
+		Only(ctx)
+	if err != nil {
+		return svc.errorFilter.Filter(err, "get")
+	}
+
+	isCreator, err := svc.databaseService.VoteTemplate.
+		Query().
+		Where(
+			votetemplate.IDEQ(templateID),
+			votetemplate.HasCreatorWith(user.IDEQ(userID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return svc.errorFilter.Filter(err, "get")
+	}
+	if !isCreator {
+		if err := authz.CheckRoles(ctx, svc.databaseService, userID, "super_admin"); err != nil {
+			return huma.Error403Forbidden("only the creator or an admin can delete this template")
+		}
+	}
+
+	if err := svc.databaseService.VoteTemplate.DeleteOneID(templateID).Exec(ctx); err != nil {
+		return svc.errorFilter.Filter(err, "delete")
+	}
+
+	for _, comp := range entTemplate.Components {
+		if comp.ImageURL != nil {
+			svc.s3service.RemoveObject(ctx, *comp.ImageURL)
+		}
+	}
+
+	return nil
+}
+
+func (svc *votesService) CreateVoteFromTemplate(
+	ctx context.Context,
+	templateID int,
+	input votesmodels.CreateVoteFromTemplate,
+) (*lightmodels.Vote, error) {
+	userID, err := security.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entTemplate, err := svc.databaseService.VoteTemplate.
+		Query().
+		Where(votetemplate.IDEQ(templateID)).
+		Only(ctx)
+	if err != nil {
+		return nil, svc.errorFilter.Filter(err, "get")
+	}
+
+	draft := voteDraft{
+		Title:       entTemplate.Title,
+		Description: entTemplate.Description,
+		Anonymous:   entTemplate.Anonymous,
+		StartAt:     input.StartAt,
+		EndAt:       input.StartAt.Add(time.Duration(entTemplate.DurationMinutes) * time.Minute),
+		Components:  entTemplate.Components,
+	}
+	if input.Title != nil {
+		draft.Title = *input.Title
+	}
+	if entTemplate.ResultsDelayMinutes != nil {
+		revealAt := draft.EndAt.Add(time.Duration(*entTemplate.ResultsDelayMinutes) * time.Minute)
+		draft.ResultsRevealAt = &revealAt
+	}
+
+	return svc.createDraftVote(ctx, userID, draft)
+}
+
+// createDraftVote creates a hidden vote and its components in a single transaction,
+// copying the images of the components.
+func (svc *votesService) createDraftVote(
+	ctx context.Context,
+	userID int,
+	draft voteDraft,
+) (*lightmodels.Vote, error) {
+	if !draft.StartAt.Before(draft.EndAt) {
+		return nil, huma.Error400BadRequest("start_at must be before end_at")
+	}
+
+	var voteID int
+	copied := make([]string, 0)
+	err := databaseservice.WithTx(ctx, svc.databaseService, func(tx *ent.Tx) error {
+		entVote, err := tx.Vote.
+			Create().
+			SetTitle(draft.Title).
+			SetDescription(draft.Description).
+			SetAnonymous(draft.Anonymous).
+			SetStartAt(draft.StartAt).
+			SetEndAt(draft.EndAt).
+			SetNillableResultsRevealAt(draft.ResultsRevealAt).
+			SetVisible(false).
+			SetCreatorID(userID).
+			Save(ctx)
+		if err != nil {
+			return svc.errorFilter.Filter(err, "create")
+		}
+		voteID = entVote.ID
+
+		for _, comp := range draft.Components {
+			entComponent, err := tx.Component.
+				Create().
+				SetName(comp.Name).
+				SetDescription(comp.Description).
+				SetColor(comp.Color).
+				SetVoteID(entVote.ID).
+				Save(ctx)
+			if err != nil {
+				return svc.errorFilter.Filter(err, "create component")
+			}
+
+			if comp.ImageURL == nil {
+				continue
+			}
+
+			objectName := copiedObjectName(fmt.Sprintf("components/%d", entComponent.ID), *comp.ImageURL)
+			if err := svc.s3service.CopyObject(ctx, *comp.ImageURL, objectName); err != nil {
+				return svc.errorFilter.Filter(err, "copy image")
+			}
+			copied = append(copied, objectName)
+
+			if _, err := tx.Component.UpdateOneID(entComponent.ID).SetImageURL(objectName).Save(ctx); err != nil {
+				return svc.errorFilter.Filter(err, "update component image")
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		svc.removeObjects(ctx, copied)
+		return nil, err
+	}
+
+	return svc.GetVoteByID(ctx, voteID)
+}
+
+func (svc *votesService) removeObjects(ctx context.Context, objectNames []string) {
+	for _, objectName := range objectNames {
+		svc.s3service.RemoveObject(ctx, objectName)
+	}
+}
+
+// copiedObjectName names the copy of an uploaded object under the given prefix,
+// keeping its original file name.
+func copiedObjectName(prefix string, objectName string) string {
+	base := path.Base(objectName)
+	// Uploaded objects are named <unix nano>_<file name>
+	if _, name, found := strings.Cut(base, "_"); found && name != "" {
+		base = name
+	}
+	return fmt.Sprintf("%s/%d_%s", prefix, time.Now().UnixNano(), base)
+}
@@ -0,0 +1,191 @@
+package ratelimit
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// RoleAnonymous is the role of the requests without a token
+	RoleAnonymous = "anonymous"
+	// RoleDefault applies to the authenticated principals none of whose roles has a bucket,
+	// and to the requests without a token when there is no anonymous bucket
+	RoleDefault = "default"
+)
+
+// Bucket is a token bucket, it holds up to Burst requests and regains Requests requests every Period
+type Bucket struct {
+	Requests int           `yaml:"requests"`
+	Period   time.Duration `yaml:"period"`
+	Burst    int           `yaml:"burst"`
+}
+
+// Limit applies its buckets to the operation or to the path and methods it names,
+// the bucket of a request is picked by the RBAC roles of its principal
+type Limit struct {
+	Operation string             `yaml:"operation"`
+	Path      string             `yaml:"path"`
+	Methods   []string           `yaml:"methods"`
+	Roles     map[string]*Bucket `yaml:"roles"`
+}
+
+type Policy struct {
+	Limits []*Limit `yaml:"limits"`
+}
+
+// Route is an operation a limit can apply to
+type Route struct {
+	OperationID string
+	Method      string
+	Path        string
+}
+
+func New(stream io.Reader) (*Policy, error) {
+	decoder := yaml.NewDecoder(stream)
+	var policy Policy
+	if err := decoder.Decode(&policy); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	for _, limit := range policy.Limits {
+		for _, bucket := range limit.Roles {
+			if bucket != nil && bucket.Burst == 0 {
+				bucket.Burst = bucket.Requests
+			}
+		}
+	}
+	return &policy, nil
+}
+
+// Validate checks that every limit names one operation or path and only known roles,
+// and that its buckets are positive. Operations and paths are not checked when no routes are given.
+func (p *Policy) Validate(roles []string, routes []Route) error {
+	errs := make([]error, 0)
+	for i, limit := range p.Limits {
+		name := limit.Name()
+		if (limit.Operation == "") == (limit.Path == "") {
+			errs = append(errs, fmt.Errorf("limit %d must name either an operation or a path", i))
+			continue
+		}
+		if limit.Operation != "" && len(limit.Methods) > 0 {
+			errs = append(errs, fmt.Errorf("limit %s names an operation, its methods are ignored", name))
+		}
+		if len(limit.Roles) == 0 {
+			errs = append(errs, fmt.Errorf("limit %s has no bucket", name))
+		}
+		for role, bucket := range limit.Roles {
+			if role != RoleAnonymous && role != RoleDefault && !slices.Contains(roles, role) {
+				errs = append(errs, fmt.Errorf("limit %s has a bucket for unknown role %s", name, role))
+			}
+			if bucket == nil || bucket.Requests <= 0 || bucket.Period <= 0 || bucket.Burst <= 0 {
+				errs = append(errs, fmt.Errorf("limit %s has a bucket for role %s without positive requests, period and burst", name, role))
+			}
+		}
+		if len(routes) > 0 && !slices.ContainsFunc(routes, limit.matches) {
+			errs = append(errs, fmt.Errorf("limit %s matches no operation", name))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Find returns the limit applying to the route, a limit naming the operation wins over the paths,
+// then the path with the most literal segments wins
+func (p *Policy) Find(route Route) *Limit {
+	var found *Limit
+	for _, limit := range p.Limits {
+		if !limit.matches(route) {
+			continue
+		}
+		if limit.Operation != "" {
+			return limit
+		}
+		if found == nil || limit.pathSpecificity() > found.pathSpecificity() {
+			found = limit
+		}
+	}
+	return found
+}
+
+// Name identifies the limit, the buckets of two limits never share a key
+func (l *Limit) Name() string {
+	if l.Operation != "" {
+		return l.Operation
+	}
+	if len(l.Methods) == 0 {
+		return l.Path
+	}
+	return strings.Join(l.Methods, ",") + " " + l.Path
+}
+
+// Bucket returns the most generous bucket among the roles of the principal,
+// a principal none of whose roles has one gets the default bucket, if any.
+// An anonymous request gets the anonymous bucket, or the default one when the limit has none
+func (l *Limit) Bucket(roles []string, anonymous bool) *Bucket {
+	if anonymous {
+		if bucket, ok := l.Roles[RoleAnonymous]; ok && bucket != nil {
+			return bucket
+		}
+		return l.Roles[RoleDefault]
+	}
+	var found *Bucket
+	for _, role := range roles {
+		bucket, ok := l.Roles[role]
+		if !ok || bucket == nil {
+			continue
+		}
+		if found == nil || bucket.moreGenerous(found) {
+			found = bucket
+		}
+	}
+	if found == nil {
+		return l.Roles[RoleDefault]
+	}
+	return found
+}
+
+//////////////////////// Helper functions ////////////////////////
+
+func (b *Bucket) moreGenerous(other *Bucket) bool {
+	rate := float64(b.Requests) / b.Period.Seconds()
+	otherRate := float64(other.Requests) / other.Period.Seconds()
+	if rate != otherRate {
+		return rate > otherRate
+	}
+	return b.Burst > other.Burst
+}
+
+func (l *Limit) matches(route Route) bool {
+	if l.Operation != "" {
+		return l.Operation == route.OperationID
+	}
+	if len(l.Methods) > 0 && !slices.Contains(l.Methods, route.Method) {
+		return false
+	}
+	// A "*" segment matches any path parameter of the route
+	limitParts := strings.Split(l.Path, "/")
+	routeParts := strings.Split(route.Path, "/")
+	if len(limitParts) != len(routeParts) {
+		return false
+	}
+	for i, part := range limitParts {
+		if part != "*" && part != routeParts[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (l *Limit) pathSpecificity() int {
+	literals := 0
+	for _, segment := range strings.Split(l.Path, "/") {
+		if segment != "*" {
+			literals++
+		}
+	}
+	return literals
+}
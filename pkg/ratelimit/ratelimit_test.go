@@ -0,0 +1,237 @@
+package ratelimit
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func mustNew(t *testing.T, policy string) *Policy {
+	t.Helper()
+	p, err := New(strings.NewReader(policy))
+	if err != nil {
+		t.Fatalf("failed to parse policy: %v", err)
+	}
+	return p
+}
+
+func TestFind(t *testing.T) {
+	policy := mustNew(t, `
+limits:
+    - path: /users/*
+      roles:
+          default: {requests: 1, period: 1m}
+    - path: /users/*/sanctions
+      methods: [POST]
+      roles:
+          default: {requests: 1, period: 1m}
+    - path: /users/me
+      methods: [GET]
+      roles:
+          default: {requests: 1, period: 1m}
+    - operation: getMe
+      roles:
+          default: {requests: 1, period: 1m}
+`)
+	tests := []struct {
+		name     string
+		route    Route
+		expected string
+	}{
+		{"operation wins over the paths", Route{OperationID: "getMe", Method: "GET", Path: "/users/me"}, "getMe"},
+		{"literal path wins over '*'", Route{OperationID: "getUser", Method: "GET", Path: "/users/me"}, "GET /users/me"},
+		{"'*' matches a path parameter", Route{OperationID: "getUser", Method: "GET", Path: "/users/{id}"}, "/users/*"},
+		{"limit without methods matches every method", Route{OperationID: "deleteUser", Method: "DELETE", Path: "/users/{id}"}, "/users/*"},
+		{"methods restrict the limit", Route{OperationID: "listSanctions", Method: "GET", Path: "/users/{id}/sanctions"}, ""},
+		{"path with methods", Route{OperationID: "issueSanction", Method: "POST", Path: "/users/{id}/sanctions"}, "POST /users/*/sanctions"},
+		{"'*' matches one segment only", Route{OperationID: "listUsers", Method: "GET", Path: "/users"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name := ""
+			if limit := policy.Find(tt.route); limit != nil {
+				name = limit.Name()
+			}
+			if name != tt.expected {
+				t.Errorf("expected limit %q, got %q", tt.expected, name)
+			}
+		})
+	}
+}
+
+func TestBucket(t *testing.T) {
+	slow := &Bucket{Requests: 10, Period: time.Minute, Burst: 10}
+	fast := &Bucket{Requests: 60, Period: time.Minute, Burst: 5}
+	fastBurst := &Bucket{Requests: 60, Period: time.Minute, Burst: 20}
+	anonymous := &Bucket{Requests: 1, Period: time.Minute, Burst: 1}
+	tests := []struct {
+		name      string
+		buckets   map[string]*Bucket
+		roles     []string
+		anonymous bool
+		expected  *Bucket
+	}{
+		{"role bucket", map[string]*Bucket{"user": slow, RoleDefault: fast}, []string{"user"}, false, slow},
+		{"most generous rate", map[string]*Bucket{"user": slow, "admin": fast}, []string{"user", "admin"}, false, fast},
+		{"burst breaks rate ties", map[string]*Bucket{"user": fast, "admin": fastBurst}, []string{"admin", "user"}, false, fastBurst},
+		{"default without a role bucket", map[string]*Bucket{"admin": fast, RoleDefault: slow}, []string{"user"}, false, slow},
+		{"no bucket", map[string]*Bucket{"admin": fast}, []string{"user"}, false, nil},
+		{"anonymous bucket", map[string]*Bucket{RoleAnonymous: anonymous, RoleDefault: slow}, nil, true, anonymous},
+		{"anonymous falls back to default", map[string]*Bucket{"user": fast, RoleDefault: slow}, nil, true, slow},
+		{"anonymous ignores the roles", map[string]*Bucket{"user": fast}, []string{"user"}, true, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limit := &Limit{Operation: "op", Roles: tt.buckets}
+			if bucket := limit.Bucket(tt.roles, tt.anonymous); bucket != tt.expected {
+				t.Errorf("expected bucket %+v, got %+v", tt.expected, bucket)
+			}
+		})
+	}
+}
+
+func TestNewDefaultsBurst(t *testing.T) {
+	policy := mustNew(t, `
+limits:
+    - operation: getMe
+      roles:
+          default: {requests: 7, period: 1m}
+`)
+	if burst := policy.Limits[0].Roles[RoleDefault].Burst; burst != 7 {
+		t.Errorf("expected the burst to default to the requests, got %d", burst)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	roles := []string{"user", "basic_admin"}
+	routes := []Route{
+		{OperationID: "getMe", Method: "GET", Path: "/me"},
+		{OperationID: "getUser", Method: "GET", Path: "/users/{id}"},
+	}
+	tests := []struct {
+		name   string
+		policy string
+		routes []Route
+		// errs are the substrings the error must contain, none means the policy is valid
+		errs []string
+	}{
+		{
+			name: "valid",
+			policy: `
+limits:
+    - operation: getMe
+      roles:
+          anonymous: {requests: 1, period: 1m}
+          default: {requests: 10, period: 1m}
+          basic_admin: {requests: 100, period: 1m, burst: 20}
+    - path: /users/*
+      methods: [GET]
+      roles:
+          user: {requests: 10, period: 1m}
+`,
+			routes: routes,
+		},
+		{
+			name: "operation and path",
+			policy: `
+limits:
+    - operation: getMe
+      path: /me
+      roles:
+          default: {requests: 1, period: 1m}
+`,
+			errs: []string{"limit 0 must name either an operation or a path"},
+		},
+		{
+			name: "neither operation nor path",
+			policy: `
+limits:
+    - roles:
+          default: {requests: 1, period: 1m}
+`,
+			errs: []string{"limit 0 must name either an operation or a path"},
+		},
+		{
+			name: "methods of an operation",
+			policy: `
+limits:
+    - operation: getMe
+      methods: [GET]
+      roles:
+          default: {requests: 1, period: 1m}
+`,
+			errs: []string{"limit getMe names an operation, its methods are ignored"},
+		},
+		{
+			name: "no bucket",
+			policy: `
+limits:
+    - operation: getMe
+`,
+			errs: []string{"limit getMe has no bucket"},
+		},
+		{
+			name: "unknown role",
+			policy: `
+limits:
+    - operation: getMe
+      roles:
+          moderator: {requests: 1, period: 1m}
+`,
+			errs: []string{"limit getMe has a bucket for unknown role moderator"},
+		},
+		{
+			name: "non positive bucket",
+			policy: `
+limits:
+    - operation: getMe
+      roles:
+          default: {requests: 0, period: 1m}
+          user: {requests: 1}
+          basic_admin:
+`,
+			errs: []string{
+				"limit getMe has a bucket for role default without positive requests, period and burst",
+				"limit getMe has a bucket for role user without positive requests, period and burst",
+				"limit getMe has a bucket for role basic_admin without positive requests, period and burst",
+			},
+		},
+		{
+			name: "unmatched operation and path",
+			policy: `
+limits:
+    - operation: getYou
+      roles:
+          default: {requests: 1, period: 1m}
+    - path: /users/*
+      methods: [DELETE]
+      roles:
+          default: {requests: 1, period: 1m}
+`,
+			routes: routes,
+			errs: []string{
+				"limit getYou matches no operation",
+				"limit DELETE /users/* matches no operation",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := mustNew(t, tt.policy).Validate(roles, tt.routes)
+			if len(tt.errs) == 0 {
+				if err != nil {
+					t.Fatalf("expected a valid policy, got %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected errors %q, got none", tt.errs)
+			}
+			for _, expected := range tt.errs {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected error %q in %q", expected, err.Error())
+				}
+			}
+		})
+	}
+}
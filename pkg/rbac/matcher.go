@@ -0,0 +1,95 @@
+package rbac
+
+import (
+	"slices"
+	"strings"
+)
+
+// matcher is a trie of the permission paths of a role, one node per path segment.
+// It gives the same decisions as Decide without scanning every permission.
+type matcher struct {
+	root *matcherNode
+	// anyPath holds the "*" permissions, they match every path
+	anyPath []*Permission
+}
+
+type matcherNode struct {
+	children map[string]*matcherNode
+	// wildcard matches any single segment
+	wildcard *matcherNode
+	// permissions holds the permissions whose path ends at this node
+	permissions []*Permission
+}
+
+func newMatcher(permissions []*Permission) *matcher {
+	m := &matcher{
+		root: &matcherNode{},
+	}
+	for _, permission := range permissions {
+		if permission.Path == "*" {
+			m.anyPath = append(m.anyPath, permission)
+			continue
+		}
+		node := m.root
+		for _, segment := range strings.Split(permission.Path, "/") {
+			node = node.child(segment)
+		}
+		node.permissions = append(node.permissions, permission)
+	}
+	return m
+}
+
+func (n *matcherNode) child(segment string) *matcherNode {
+	if segment == "*" {
+		if n.wildcard == nil {
+			n.wildcard = &matcherNode{}
+		}
+		return n.wildcard
+	}
+	if n.children == nil {
+		n.children = make(map[string]*matcherNode)
+	}
+	child, ok := n.children[segment]
+	if !ok {
+		child = &matcherNode{}
+		n.children[segment] = child
+	}
+	return child
+}
+
+// decide returns the permission taking precedence among the ones matching the request, if any
+func (m *matcher) decide(path string, verb string) *Permission {
+	decisive := Decide(m.anyPath, path, verb)
+	return m.root.decide(strings.Split(path, "/"), verb, decisive)
+}
+
+func (n *matcherNode) decide(segments []string, verb string, decisive *Permission) *Permission {
+	if len(segments) == 0 {
+		for _, permission := range n.permissions {
+			if slices.Contains(permission.Methods, "*") || slices.Contains(permission.Methods, verb) {
+				if decisive == nil || permission.Outranks(decisive) {
+					decisive = permission
+				}
+			}
+		}
+		return decisive
+	}
+	if child, ok := n.children[segments[0]]; ok {
+		decisive = child.decide(segments[1:], verb, decisive)
+	}
+	if n.wildcard != nil {
+		decisive = n.wildcard.decide(segments[1:], verb, decisive)
+	}
+	return decisive
+}
+
+// compile resolves the inheritance of every role and builds their matchers, it runs once at load time
+func (r *Rbac) compile() {
+	r.resolved = make(map[string][]*Permission, len(r.Roles))
+	r.matchers = make(map[string]*matcher, len(r.Roles))
+	for name, role := range r.Roles {
+		permissions := slices.Clip(resolvePermissions(role, r, nil))
+		r.resolved[name] = permissions
+		r.matchers[name] = newMatcher(permissions)
+	}
+}
@@ -0,0 +1,115 @@
+package rbac
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+const matcherPolicy = `
+roles:
+    user:
+        name: user
+        permissions:
+            - path: /users
+              methods: [GET]
+            - path: /users/*
+              methods: [GET]
+            - path: /users/me
+              methods: [PATCH]
+            - path: /votes/*/vote
+              methods: [POST]
+    moderator:
+        name: moderator
+        inherits: [user]
+        permissions:
+            - path: /users/*/sanctions
+              methods: ['*']
+            - path: /votes/*/*
+              methods: [GET]
+    admin:
+        name: admin
+        inherits: [moderator]
+        permissions:
+            - path: '*'
+              methods: [GET]
+            - path: /tournaments
+              methods: [POST]
+`
+
+// TestMatcherDecisions checks the decisions of the trie on a policy with overlapping paths
+func TestMatcherDecisions(t *testing.T) {
+	policy := mustNew(t, matcherPolicy)
+	tests := []struct {
+		roles   []string
+		method  string
+		path    string
+		allowed bool
+	}{
+		{[]string{"user"}, "GET", "/users", true},
+		{[]string{"user"}, "GET", "/users/42", true},
+		{[]string{"user"}, "PATCH", "/users/me", true},
+		{[]string{"user"}, "PATCH", "/users/42", false},
+		{[]string{"user"}, "GET", "/users/42/sanctions", false},
+		{[]string{"user"}, "POST", "/votes/1/vote", true},
+		{[]string{"user"}, "GET", "/votes/1/vote", false},
+		{[]string{"user"}, "GET", "/votes", false},
+		{[]string{"moderator"}, "DELETE", "/users/42/sanctions", true},
+		{[]string{"moderator"}, "GET", "/votes/1/results", true},
+		{[]string{"moderator"}, "GET", "/votes/1/results/2", false},
+		{[]string{"moderator"}, "POST", "/votes/1/vote", true},
+		{[]string{"admin"}, "GET", "/anything/at/all", true},
+		{[]string{"admin"}, "POST", "/tournaments", true},
+		{[]string{"admin"}, "DELETE", "/tournaments", false},
+		{[]string{"unknown"}, "GET", "/users", false},
+		{[]string{"unknown", "user"}, "GET", "/users", true},
+		{nil, "GET", "/users", false},
+	}
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.roles, ",")+" "+tt.method+" "+tt.path, func(t *testing.T) {
+			if allowed := policy.Can(tt.roles, tt.path, tt.method); allowed != tt.allowed {
+				t.Errorf("expected allowed %v, got %v", tt.allowed, allowed)
+			}
+			if allowed := CheckPermission(policy.GetPermissions(tt.roles), tt.path, tt.method); allowed != tt.allowed {
+				t.Errorf("expected the linear check to allow %v, got %v", tt.allowed, allowed)
+			}
+		})
+	}
+}
+
+// TestMatcherMatchesLinearCheck compares the trie with the linear check of every permission
+// for every role of the policies, on requests built from the paths of their permissions
+func TestMatcherMatchesLinearCheck(t *testing.T) {
+	shipped, err := os.ReadFile("../../configs/rbac.yaml")
+	if err != nil {
+		t.Fatalf("failed to read the shipped policy: %v", err)
+	}
+	for name, source := range map[string]string{"test": matcherPolicy, "shipped": string(shipped)} {
+		t.Run(name, func(t *testing.T) {
+			policy := mustNew(t, source)
+			paths := []string{"/", "/unknown", "/users/42/unknown/path"}
+			for _, role := range policy.Roles {
+				for _, permission := range role.Permissions {
+					paths = append(paths,
+						permission.Path,
+						strings.ReplaceAll(permission.Path, "*", "42"),
+						strings.ReplaceAll(permission.Path, "*", "42")+"/extra",
+						strings.TrimSuffix(permission.Path, "/*"),
+					)
+				}
+			}
+			methods := []string{"GET", "POST", "PUT", "PATCH", "DELETE"}
+			for roleName := range policy.Roles {
+				roles := []string{roleName}
+				for _, path := range paths {
+					for _, method := range methods {
+						expected := CheckPermission(policy.GetPermissions(roles), path, method)
+						if allowed := policy.Can(roles, path, method); allowed != expected {
+							t.Errorf("%s %s %s: trie allows %v, linear check allows %v", roleName, method, path, allowed, expected)
+						}
+					}
+				}
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package rbac
 
-import "strings"
+import (
+	"slices"
+	"strings"
+)
 
 type Role struct {
 	Name        string        `json:"name" description:"role name" example:"admin"`
@@ -10,20 +13,31 @@ type Role struct {
 }
 
 func (r *Role) Can(path string, verb string) bool {
-	for _, permission := range r.Permissions {
-		if permission.Can(path, verb) {
-			return true
-		}
-	}
-	return false
+	return CheckPermission(r.Permissions, path, verb)
 }
 
+// Effect tells whether a permission allows or denies the methods on its path
+type Effect string
+
+const (
+	EffectAllow Effect = "allow"
+	EffectDeny  Effect = "deny"
+)
+
 type Permission struct {
 	Path    string   `json:"path" description:"permission path" example:"/users"`
 	Methods []string `json:"methods" description:"permission methods" example:"[\"GET\"]" nullable:"false"`
+	Effect  Effect   `json:"effect" yaml:"effect" description:"whether the permission allows or denies the methods" enum:"allow,deny" example:"allow"`
+	Role    string   `json:"role" yaml:"-" description:"role declaring the permission" example:"user"`
 }
 
+// Can reports whether the permission alone allows the method on the path
 func (p *Permission) Can(path string, verb string) bool {
+	return p.Effect != EffectDeny && p.Matches(path, verb)
+}
+
+// Matches reports whether the permission applies to the method on the path, whatever its effect
+func (p *Permission) Matches(path string, verb string) bool {
 	if !p.wildcardMatch(path) {
 		return false
 	}
@@ -35,6 +49,36 @@ func (p *Permission) Can(path string, verb string) bool {
 	return false
 }
 
+// Outranks reports whether the permission takes precedence over other when both match a request.
+// The most specific path wins, then a listed method wins over "*", then deny wins over allow.
+func (p *Permission) Outranks(other *Permission) bool {
+	if a, b := p.pathSpecificity(), other.pathSpecificity(); a != b {
+		return a > b
+	}
+	if a, b := p.methodSpecific(), other.methodSpecific(); a != b {
+		return a
+	}
+	return p.Effect == EffectDeny && other.Effect != EffectDeny
+}
+
+// pathSpecificity is the number of literal segments of the path, "*" alone is the least specific
+func (p *Permission) pathSpecificity() int {
+	if p.Path == "*" {
+		return -1
+	}
+	literals := 0
+	for _, segment := range strings.Split(p.Path, "/") {
+		if segment != "*" {
+			literals++
+		}
+	}
+	return literals
+}
+
+func (p *Permission) methodSpecific() bool {
+	return !slices.Contains(p.Methods, "*")
+}
+
 //////////////////////// Helper functions ////////////////////////
 
 func (p *Permission) wildcardMatch(path string) bool {
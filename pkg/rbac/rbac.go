@@ -3,6 +3,7 @@ package rbac
 import (
 	"fmt"
 	"io"
+	"slices"
 
 	"gopkg.in/yaml.v3"
 )
@@ -10,6 +11,10 @@ import (
 type Rbac struct {
 	Roles      map[string]*Role `json:"roles" description:"roles"`
 	RoleKeyMap map[string]string
+
+	// resolved and matchers are computed by compile from Roles
+	resolved map[string][]*Permission
+	matchers map[string]*matcher
 }
 
 func New(stream io.Reader) (*Rbac, error) {
@@ -26,10 +31,17 @@ func New(stream io.Reader) (*Rbac, error) {
 		if role != nil {
 			newRoles[role.Name] = role
 			roleKeyMap[originalKey] = role.Name
+			for _, permission := range role.Permissions {
+				permission.Role = role.Name
+				if permission.Effect == "" {
+					permission.Effect = EffectAllow
+				}
+			}
 		}
 	}
 	rbac.Roles = newRoles
 	rbac.RoleKeyMap = roleKeyMap
+	rbac.compile()
 	return &rbac, nil
 }
 
@@ -46,37 +58,124 @@ func (r *Rbac) GetRoleNameFromKeycloackRole(name string) (string, error) {
 }
 
 func (c *Rbac) Can(roleNames []string, path string, verb string) bool {
-	permissions := c.GetPermissions(roleNames)
-	for _, permission := range permissions {
-		if permission.Can(path, verb) {
-			return true
+	return c.Explain(roleNames, path, verb).Allowed
+}
+
+// Decision explains the outcome of a permission check
+type Decision struct {
+	Allowed bool        `json:"allowed" description:"whether the request is allowed"`
+	Rule    *Permission `json:"rule,omitempty" description:"the permission deciding, absent when none matches"`
+	Role    string      `json:"role,omitempty" description:"the role of the checked set the permission comes from" example:"super_admin"`
+	Chain   []string    `json:"chain,omitempty" description:"the inheritance chain from role to the role declaring the permission" example:"[\"super_admin\",\"basic_admin\",\"user\"]"`
+}
+
+// Explain returns the permission deciding the request among every permission of the roles,
+// a request no permission matches is denied
+func (c *Rbac) Explain(roleNames []string, path string, verb string) *Decision {
+	decision := &Decision{}
+	for _, roleName := range roleNames {
+		matcher, ok := c.matchers[roleName]
+		if !ok {
+			continue
 		}
+		rule := matcher.decide(path, verb)
+		if rule != nil && (decision.Rule == nil || rule.Outranks(decision.Rule)) {
+			decision.Rule = rule
+			decision.Role = roleName
+		}
+	}
+	if decision.Rule != nil {
+		decision.Allowed = decision.Rule.Effect != EffectDeny
+		decision.Chain = c.inheritanceChain(decision.Role, decision.Rule.Role, nil)
 	}
-	return false
+	return decision
 }
 
 func (c *Rbac) GetPermissions(roleNames []string) []*Permission {
 	permissions := make([]*Permission, 0)
 	for _, roleName := range roleNames {
-		role, ok := c.Roles[roleName]
-		if !ok {
-			continue
-		}
-		permissions = append(permissions, resolvePermissions(role, c)...)
+		permissions = append(permissions, c.resolved[roleName]...)
 	}
 	return permissions
 }
 
 func CheckPermission(permissions []*Permission, path string, verb string) bool {
+	rule := Decide(permissions, path, verb)
+	return rule != nil && rule.Effect != EffectDeny
+}
+
+// Decide returns the permission taking precedence among the ones matching the request, if any
+func Decide(permissions []*Permission, path string, verb string) *Permission {
+	var decisive *Permission
 	for _, permission := range permissions {
-		if permission.Can(path, verb) {
-			return true
+		if permission.Matches(path, verb) && (decisive == nil || permission.Outranks(decisive)) {
+			decisive = permission
+		}
+	}
+	return decisive
+}
+
+// Outranks reports whether the roles grant strictly more than the other roles: every role the others
+// hold or inherit is held or inherited by the roles, and the roles hold or inherit at least one more
+func (c *Rbac) Outranks(roleNames []string, otherRoleNames []string) bool {
+	closure := c.inheritedRoles(roleNames)
+	otherClosure := c.inheritedRoles(otherRoleNames)
+	for name := range otherClosure {
+		if !closure[name] {
+			return false
 		}
 	}
-	return false
+	return len(closure) > len(otherClosure)
 }
 
-func resolvePermissions(role *Role, rbac *Rbac) []*Permission {
+// inheritedRoles returns the known roles among the names and every role they inherit
+func (c *Rbac) inheritedRoles(roleNames []string) map[string]bool {
+	closure := make(map[string]bool)
+	pending := slices.Clone(roleNames)
+	for len(pending) > 0 {
+		name := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		role, ok := c.Roles[name]
+		if !ok || closure[name] {
+			continue
+		}
+		closure[name] = true
+		pending = append(pending, role.Inherits...)
+	}
+	return closure
+}
+
+// inheritanceChain returns the roles leading from a role to one it inherits, itself included
+func (c *Rbac) inheritanceChain(from string, to string, visited map[string]bool) []string {
+	if from == to {
+		return []string{from}
+	}
+	if visited == nil {
+		visited = make(map[string]bool)
+	}
+	role, ok := c.Roles[from]
+	if !ok || visited[from] {
+		return nil
+	}
+	visited[from] = true
+	for _, inherited := range role.Inherits {
+		if chain := c.inheritanceChain(inherited, to, visited); chain != nil {
+			return append([]string{from}, chain...)
+		}
+	}
+	return nil
+}
+
+// resolvePermissions collects the permissions of the role and the roles it inherits,
+// visited guards against inheritance cycles that Validate reports
+func resolvePermissions(role *Role, rbac *Rbac, visited map[string]bool) []*Permission {
+	if visited == nil {
+		visited = make(map[string]bool)
+	}
+	if visited[role.Name] {
+		return nil
+	}
+	visited[role.Name] = true
 	permissions := make([]*Permission, 0)
 	permissions = append(permissions, role.Permissions...)
 	for _, inheritRoleName := range role.Inherits {
@@ -84,7 +183,7 @@ func resolvePermissions(role *Role, rbac *Rbac) []*Permission {
 		if !ok {
 			continue
 		}
-		permissions = append(permissions, resolvePermissions(inheritedPerms, rbac)...)
+		permissions = append(permissions, resolvePermissions(inheritedPerms, rbac, visited)...)
 	}
 	return permissions
 }
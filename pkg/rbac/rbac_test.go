@@ -0,0 +1,229 @@
+package rbac
+
+import (
+	"os"
+	"slices"
+	"strings"
+	"testing"
+)
+
+const denyPolicy = `
+roles:
+    user:
+        name: user
+        permissions:
+            - path: /users/*
+              methods: [GET]
+            - path: /votes/*
+              methods: ['*']
+            - path: /votes/*/vote
+              methods: [POST]
+    restricted:
+        name: restricted
+        inherits: [user]
+        permissions:
+            - path: /votes/*/vote
+              methods: [POST]
+              effect: deny
+    basic_admin:
+        name: basic_admin
+        inherits: [user]
+        permissions:
+            - path: '*'
+              methods: ['*']
+              effect: deny
+            - path: /admin/*
+              methods: [GET]
+            - path: /users/*
+              methods: [DELETE]
+              effect: deny
+    super_admin:
+        name: super_admin
+        inherits: [basic_admin]
+        permissions:
+            - path: /votes/*
+              methods: [DELETE]
+              effect: deny
+`
+
+func TestExplain(t *testing.T) {
+	policy := mustNew(t, denyPolicy)
+	tests := []struct {
+		name    string
+		roles   []string
+		method  string
+		path    string
+		allowed bool
+		// rule is the role declaring the deciding permission and its path, empty when none matches
+		rule  string
+		role  string
+		chain []string
+	}{
+		{
+			name:    "allow",
+			roles:   []string{"user"},
+			method:  "GET",
+			path:    "/users/42",
+			allowed: true,
+			rule:    "user /users/*",
+			role:    "user",
+			chain:   []string{"user"},
+		},
+		{
+			name:   "nothing matches",
+			roles:  []string{"user"},
+			method: "GET",
+			path:   "/admin/users",
+		},
+		{
+			name:   "deny wins over allow at equal specificity",
+			roles:  []string{"restricted"},
+			method: "POST",
+			path:   "/votes/1/vote",
+			rule:   "restricted /votes/*/vote",
+			role:   "restricted",
+			chain:  []string{"restricted"},
+		},
+		{
+			name:   "deny wins over the allow of another role of the set",
+			roles:  []string{"user", "restricted"},
+			method: "POST",
+			path:   "/votes/1/vote",
+			rule:   "restricted /votes/*/vote",
+			role:   "restricted",
+			chain:  []string{"restricted"},
+		},
+		{
+			name:    "specific allow wins over a '*' deny",
+			roles:   []string{"basic_admin"},
+			method:  "GET",
+			path:    "/admin/users",
+			allowed: true,
+			rule:    "basic_admin /admin/*",
+			role:    "basic_admin",
+			chain:   []string{"basic_admin"},
+		},
+		{
+			name:    "inherited specific allow wins over a '*' deny",
+			roles:   []string{"basic_admin"},
+			method:  "GET",
+			path:    "/users/42",
+			allowed: true,
+			rule:    "user /users/*",
+			role:    "basic_admin",
+			chain:   []string{"basic_admin", "user"},
+		},
+		{
+			name:   "'*' deny wins where nothing more specific matches",
+			roles:  []string{"basic_admin"},
+			method: "POST",
+			path:   "/tournaments",
+			rule:   "basic_admin *",
+			role:   "basic_admin",
+			chain:  []string{"basic_admin"},
+		},
+		{
+			name:   "listed method wins over '*'",
+			roles:  []string{"super_admin"},
+			method: "DELETE",
+			path:   "/votes/1",
+			rule:   "super_admin /votes/*",
+			role:   "super_admin",
+			chain:  []string{"super_admin"},
+		},
+		{
+			name:    "'*' method applies to the other methods",
+			roles:   []string{"super_admin"},
+			method:  "PATCH",
+			path:    "/votes/1",
+			allowed: true,
+			rule:    "user /votes/*",
+			role:    "super_admin",
+			chain:   []string{"super_admin", "basic_admin", "user"},
+		},
+		{
+			name:   "inherited deny",
+			roles:  []string{"super_admin"},
+			method: "DELETE",
+			path:   "/users/42",
+			rule:   "basic_admin /users/*",
+			role:   "super_admin",
+			chain:  []string{"super_admin", "basic_admin"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decision := policy.Explain(tt.roles, tt.path, tt.method)
+			if decision.Allowed != tt.allowed {
+				t.Errorf("expected allowed %v, got %v", tt.allowed, decision.Allowed)
+			}
+			if allowed := policy.Can(tt.roles, tt.path, tt.method); allowed != tt.allowed {
+				t.Errorf("expected Can %v, got %v", tt.allowed, allowed)
+			}
+			rule := ""
+			if decision.Rule != nil {
+				rule = decision.Rule.Role + " " + decision.Rule.Path
+			}
+			if rule != tt.rule {
+				t.Errorf("expected rule %q, got %q", tt.rule, rule)
+			}
+			if decision.Role != tt.role {
+				t.Errorf("expected role %q, got %q", tt.role, decision.Role)
+			}
+			if !slices.Equal(decision.Chain, tt.chain) {
+				t.Errorf("expected chain %v, got %v", tt.chain, decision.Chain)
+			}
+		})
+	}
+}
+
+// TestExplainMatchesDecide compares the rule chosen by the trie with the one Decide picks
+// among every permission of the role, deny rules included
+func TestExplainMatchesDecide(t *testing.T) {
+	shipped, err := os.ReadFile("../../configs/rbac.yaml")
+	if err != nil {
+		t.Fatalf("failed to read the shipped policy: %v", err)
+	}
+	for name, source := range map[string]string{"deny": denyPolicy, "shipped": string(shipped)} {
+		t.Run(name, func(t *testing.T) {
+			policy := mustNew(t, source)
+			paths := []string{"/", "/unknown"}
+			for _, role := range policy.Roles {
+				for _, permission := range role.Permissions {
+					paths = append(paths,
+						strings.ReplaceAll(permission.Path, "*", "42"),
+						strings.ReplaceAll(permission.Path, "*", "42")+"/extra",
+					)
+				}
+			}
+			methods := []string{"GET", "POST", "PUT", "PATCH", "DELETE"}
+			for roleName := range policy.Roles {
+				roles := []string{roleName}
+				for _, path := range paths {
+					for _, method := range methods {
+						expected := Decide(policy.GetPermissions(roles), path, method)
+						if rule := policy.Explain(roles, path, method).Rule; rule != expected {
+							t.Errorf("%s %s %s: trie decides %+v, Decide %+v", roleName, method, path, rule, expected)
+						}
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestValidateEffect(t *testing.T) {
+	policy := mustNew(t, `
+roles:
+    user:
+        name: user
+        permissions:
+            - path: /users
+              methods: [GET]
+              effect: forbid
+`)
+	err := policy.Validate(nil)
+	if err == nil || !strings.Contains(err.Error(), "role user has an unknown effect forbid on /users") {
+		t.Errorf("expected an unknown effect error, got %v", err)
+	}
+}
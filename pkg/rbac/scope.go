@@ -0,0 +1,33 @@
+package rbac
+
+import "slices"
+
+// ScopeMetadataKey is the huma operation metadata key holding the ScopeRequirement of the operation
+const ScopeMetadataKey = "rbac_scope"
+
+// ScopeRequirement declares the role a caller must hold on the resource an operation acts on.
+// The resource ID is read from the Param path parameter.
+type ScopeRequirement struct {
+	Resource string
+	Param    string
+	MinRole  string
+}
+
+// ScopeRoles orders the roles that can be held on a resource, from the lowest to the highest
+type ScopeRoles []string
+
+// Satisfies reports whether role is at least minRole, unknown roles never satisfy a requirement
+func (r ScopeRoles) Satisfies(role string, minRole string) bool {
+	have := slices.Index(r, role)
+	need := slices.Index(r, minRole)
+	return have >= 0 && need >= 0 && have >= need
+}
+
+// RequirementFromMetadata returns the scope requirement declared in the operation metadata, if any
+func RequirementFromMetadata(metadata map[string]any) *ScopeRequirement {
+	requirement, ok := metadata[ScopeMetadataKey].(*ScopeRequirement)
+	if !ok {
+		return nil
+	}
+	return requirement
+}
@@ -0,0 +1,96 @@
+package rbac
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Route is an operation the policy can grant access to
+type Route struct {
+	Method string
+	Path   string
+	// Scope is the scoped role the operation requires on top of a permission, if any
+	Scope *ScopeRequirement
+}
+
+// Validate checks that every inherited role exists, that inheritance has no cycle and that every
+// permission path matches one of the routes. Paths starting with one of the virtual prefixes do not
+// belong to an operation and are not checked, routes are not checked at all when none are given.
+func (r *Rbac) Validate(routes []Route, virtualPrefixes ...string) error {
+	errs := make([]error, 0)
+	for _, name := range r.sortedRoleNames() {
+		role := r.Roles[name]
+		for _, inherited := range role.Inherits {
+			if _, ok := r.Roles[inherited]; !ok {
+				errs = append(errs, fmt.Errorf("role %s inherits unknown role %s", name, inherited))
+			}
+		}
+		if cycle := r.inheritanceCycle(name, nil); cycle != nil {
+			errs = append(errs, fmt.Errorf("role %s has an inheritance cycle: %s", name, strings.Join(cycle, " -> ")))
+		}
+		for _, permission := range role.Permissions {
+			if permission.Effect != EffectAllow && permission.Effect != EffectDeny {
+				errs = append(errs, fmt.Errorf("role %s has an unknown effect %s on %s", name, permission.Effect, permission.Path))
+			}
+		}
+		if len(routes) == 0 {
+			continue
+		}
+		for _, permission := range role.Permissions {
+			if permission.Path == "*" || hasAnyPrefix(permission.Path, virtualPrefixes) {
+				continue
+			}
+			if !permission.matchesAnyRoute(routes) {
+				errs = append(errs, fmt.Errorf("role %s grants %s which matches no operation", name, permission.Path))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// inheritanceCycle returns the chain of roles leading back to a role already in path, if any
+func (r *Rbac) inheritanceCycle(name string, path []string) []string {
+	if i := slices.Index(path, name); i >= 0 {
+		return append(slices.Clone(path[i:]), name)
+	}
+	role, ok := r.Roles[name]
+	if !ok {
+		return nil
+	}
+	path = append(path, name)
+	for _, inherited := range role.Inherits {
+		if cycle := r.inheritanceCycle(inherited, path); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
+
+func (r *Rbac) sortedRoleNames() []string {
+	names := make([]string, 0, len(r.Roles))
+	for name := range r.Roles {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func (p *Permission) matchesAnyRoute(routes []Route) bool {
+	for _, route := range routes {
+		if p.wildcardMatch(route.Path) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasAnyPrefix(path string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,152 @@
+package rbac
+
+import (
+	"strings"
+	"testing"
+)
+
+func mustNew(t *testing.T, policy string) *Rbac {
+	t.Helper()
+	r, err := New(strings.NewReader(policy))
+	if err != nil {
+		t.Fatalf("failed to parse policy: %v", err)
+	}
+	return r
+}
+
+func TestValidate(t *testing.T) {
+	routes := []Route{
+		{Method: "GET", Path: "/users"},
+		{Method: "GET", Path: "/users/{id}"},
+		{Method: "POST", Path: "/votes/{id}/vote"},
+	}
+	tests := []struct {
+		name   string
+		policy string
+		routes []Route
+		// errs are the substrings the error must contain, none means the policy is valid
+		errs []string
+	}{
+		{
+			name: "valid",
+			policy: `
+roles:
+    user:
+        name: user
+        permissions:
+            - path: /users
+              methods: [GET]
+            - path: /users/*
+              methods: [GET]
+    admin:
+        name: admin
+        inherits: [user]
+        permissions:
+            - path: '*'
+              methods: ['*']
+`,
+			routes: routes,
+		},
+		{
+			name: "unknown inherited role",
+			policy: `
+roles:
+    admin:
+        name: admin
+        inherits: [moderator]
+`,
+			routes: routes,
+			errs:   []string{"role admin inherits unknown role moderator"},
+		},
+		{
+			name: "inheritance cycle",
+			policy: `
+roles:
+    a:
+        name: a
+        inherits: [b]
+    b:
+        name: b
+        inherits: [c]
+    c:
+        name: c
+        inherits: [a]
+`,
+			routes: routes,
+			errs:   []string{"role a has an inheritance cycle: a -> b -> c -> a"},
+		},
+		{
+			name: "self inheritance",
+			policy: `
+roles:
+    a:
+        name: a
+        inherits: [a]
+`,
+			routes: routes,
+			errs:   []string{"role a has an inheritance cycle: a -> a"},
+		},
+		{
+			name: "unmatched paths",
+			policy: `
+roles:
+    user:
+        name: user
+        permissions:
+            - path: /user
+              methods: [GET]
+            - path: /votes/*/results
+              methods: [GET]
+            - path: /votes/*/vote
+              methods: [POST]
+`,
+			routes: routes,
+			errs: []string{
+				"role user grants /user which matches no operation",
+				"role user grants /votes/*/results which matches no operation",
+			},
+		},
+		{
+			name: "scope paths are virtual",
+			policy: `
+roles:
+    user:
+        name: user
+        permissions:
+            - path: /scopes/elo
+              methods: [GET]
+`,
+			routes: routes,
+		},
+		{
+			name: "paths are not checked without routes",
+			policy: `
+roles:
+    user:
+        name: user
+        permissions:
+            - path: /user
+              methods: [GET]
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := mustNew(t, tt.policy).Validate(tt.routes, "/scopes/")
+			if len(tt.errs) == 0 {
+				if err != nil {
+					t.Fatalf("expected a valid policy, got %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected errors %q, got none", tt.errs)
+			}
+			for _, expected := range tt.errs {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected error %q in %q", expected, err.Error())
+				}
+			}
+		})
+	}
+}